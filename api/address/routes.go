@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"sort"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/gin-gonic/gin"
 )
 
@@ -18,6 +21,8 @@ const (
 	getBalancePath  = "/:address/balance"
 	getUsernamePath = "/:address/username"
 	getKeyPath      = "/:address/key/:key"
+	getESDTNFTPath  = "/:address/esdt/:token/nonce/:nonce"
+	getAllESDTPath  = "/:address/esdt"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
@@ -26,6 +31,8 @@ type FacadeHandler interface {
 	GetUsername(address string) (string, error)
 	GetValueForKey(address string, key string) (string, error)
 	GetAccount(address string) (state.UserAccountHandler, error)
+	GetESDTNFTToken(address string, tokenName string, nonce uint64) (*builtInFunctions.ESDigitalToken, error)
+	GetAllESDTTokens(address string) (map[string]*builtInFunctions.ESDigitalToken, error)
 	IsInterfaceNil() bool
 }
 
@@ -39,12 +46,35 @@ type accountResponse struct {
 	RootHash []byte `json:"rootHash"`
 }
 
+type esdtNFTTokenData struct {
+	TokenIdentifier string   `json:"tokenIdentifier"`
+	Balance         string   `json:"balance"`
+	Properties      string   `json:"properties"`
+	Name            string   `json:"name"`
+	Nonce           uint64   `json:"nonce"`
+	Creator         string   `json:"creator"`
+	Royalties       string   `json:"royalties"`
+	Hash            []byte   `json:"hash"`
+	URIs            [][]byte `json:"uris"`
+	Attributes      []byte   `json:"attributes"`
+}
+
+type esdtTokenData struct {
+	TokenIdentifier string `json:"tokenIdentifier"`
+	Nonce           uint64 `json:"nonce,omitempty"`
+	Balance         string `json:"balance"`
+	Properties      string `json:"properties"`
+	Frozen          bool   `json:"frozen"`
+}
+
 // Routes defines address related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getAccountPath, GetAccount)
 	router.RegisterHandler(http.MethodGet, getBalancePath, GetBalance)
 	router.RegisterHandler(http.MethodGet, getUsernamePath, GetUsername)
 	router.RegisterHandler(http.MethodGet, getKeyPath, GetValueForKey)
+	router.RegisterHandler(http.MethodGet, getESDTNFTPath, GetESDTNFTData)
+	router.RegisterHandler(http.MethodGet, getAllESDTPath, GetAllESDTData)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -249,6 +279,97 @@ func GetValueForKey(c *gin.Context) {
 	)
 }
 
+// GetESDTNFTData returns the non-fungible or semi-fungible token with the given nonce held by an address
+func GetESDTNFTData(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTNFTData.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	tokenIdentifier := c.Param("token")
+	if tokenIdentifier == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTNFTData.Error(), errors.ErrEmptyTokenIdentifier.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	nonce, err := strconv.ParseUint(c.Param("nonce"), 10, 64)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTNFTData.Error(), errors.ErrNonceInvalid.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	esdtData, err := facade.GetESDTNFTToken(addr, tokenIdentifier, nonce)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTNFTData.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"tokenData": esdtNFTTokenDataFromESDigitalToken(tokenIdentifier, esdtData)},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func esdtNFTTokenDataFromESDigitalToken(tokenIdentifier string, esdtData *builtInFunctions.ESDigitalToken) esdtNFTTokenData {
+	tokenData := esdtNFTTokenData{
+		TokenIdentifier: tokenIdentifier,
+		Balance:         "0",
+		Properties:      hex.EncodeToString(esdtData.Properties),
+	}
+	if esdtData.Value != nil {
+		tokenData.Balance = esdtData.Value.String()
+	}
+	if esdtData.TokenMetaData != nil {
+		tokenData.Name = string(esdtData.TokenMetaData.Name)
+		tokenData.Nonce = esdtData.TokenMetaData.Nonce
+		tokenData.Creator = hex.EncodeToString(esdtData.TokenMetaData.Creator)
+		tokenData.Royalties = big.NewInt(0).SetUint64(uint64(esdtData.TokenMetaData.Royalties)).String()
+		tokenData.Hash = esdtData.TokenMetaData.Hash
+		tokenData.URIs = esdtData.TokenMetaData.URIs
+		tokenData.Attributes = esdtData.TokenMetaData.Attributes
+	}
+
+	return tokenData
+}
+
 func accountResponseFromBaseAccount(address string, account state.UserAccountHandler) accountResponse {
 	return accountResponse{
 		Address:  address,
@@ -260,3 +381,80 @@ func accountResponseFromBaseAccount(address string, account state.UserAccountHan
 		RootHash: account.GetRootHash(),
 	}
 }
+
+// GetAllESDTData returns all the esdt tokens held by an address, together with their frozen status
+func GetAllESDTData(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	addr := c.Param("address")
+	if addr == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTTokens.Error(), errors.ErrEmptyAddress.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	allESDTs, err := facade.GetAllESDTTokens(addr)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetESDTTokens.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	esdtTokens := make([]esdtTokenData, 0, len(allESDTs))
+	for key, esdtData := range allESDTs {
+		esdtTokens = append(esdtTokens, esdtTokenDataFromESDigitalToken(key, esdtData))
+	}
+	sort.Slice(esdtTokens, func(i, j int) bool {
+		if esdtTokens[i].TokenIdentifier == esdtTokens[j].TokenIdentifier {
+			return esdtTokens[i].Nonce < esdtTokens[j].Nonce
+		}
+		return esdtTokens[i].TokenIdentifier < esdtTokens[j].TokenIdentifier
+	})
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"esdts": esdtTokens},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// esdtTokenDataFromESDigitalToken converts the token stored under the given data trie key (without the esdt prefix).
+// Non-fungible tokens have the nonce bytes appended to the token identifier in their key
+func esdtTokenDataFromESDigitalToken(key string, esdtData *builtInFunctions.ESDigitalToken) esdtTokenData {
+	tokenData := esdtTokenData{
+		TokenIdentifier: key,
+		Balance:         "0",
+		Properties:      hex.EncodeToString(esdtData.Properties),
+		Frozen:          builtInFunctions.ESDTUserMetadataFromBytes(esdtData.Properties).Frozen,
+	}
+	if esdtData.Value != nil {
+		tokenData.Balance = esdtData.Value.String()
+	}
+	if esdtData.TokenMetaData != nil {
+		nonceBytes := big.NewInt(0).SetUint64(esdtData.TokenMetaData.Nonce).Bytes()
+		if len(nonceBytes) <= len(key) {
+			tokenData.TokenIdentifier = key[:len(key)-len(nonceBytes)]
+		}
+		tokenData.Nonce = esdtData.TokenMetaData.Nonce
+	}
+
+	return tokenData
+}
@@ -19,9 +19,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func init() {
@@ -49,6 +51,38 @@ type valueForKeyResponse struct {
 	Code  string                  `json:"code"`
 }
 
+type esdtNFTResponseData struct {
+	TokenData struct {
+		TokenIdentifier string   `json:"tokenIdentifier"`
+		Balance         string   `json:"balance"`
+		Name            string   `json:"name"`
+		Nonce           uint64   `json:"nonce"`
+		Royalties       string   `json:"royalties"`
+		URIs            [][]byte `json:"uris"`
+	} `json:"tokenData"`
+}
+
+type esdtNFTResponse struct {
+	Data  esdtNFTResponseData `json:"data"`
+	Error string              `json:"error"`
+	Code  string              `json:"code"`
+}
+
+type esdtTokensResponseData struct {
+	ESDTs []struct {
+		TokenIdentifier string `json:"tokenIdentifier"`
+		Nonce           uint64 `json:"nonce"`
+		Balance         string `json:"balance"`
+		Frozen          bool   `json:"frozen"`
+	} `json:"esdts"`
+}
+
+type esdtTokensResponse struct {
+	Data  esdtTokensResponseData `json:"data"`
+	Error string                 `json:"error"`
+	Code  string                 `json:"code"`
+}
+
 type usernameResponseData struct {
 	Username string `json:"username"`
 }
@@ -265,6 +299,146 @@ func TestGetValueForKey_ShouldWork(t *testing.T) {
 	assert.Equal(t, testValue, valueForKeyResponseObj.Data.Value)
 }
 
+func TestGetESDTNFTData_InvalidNonceShouldError(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/esdt/token/nonce/abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	esdtNFTResponseObj := esdtNFTResponse{}
+	loadResponse(resp.Body, &esdtNFTResponseObj)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(esdtNFTResponseObj.Error, apiErrors.ErrNonceInvalid.Error()))
+}
+
+func TestGetESDTNFTData_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetESDTNFTTokenCalled: func(_ string, _ string, _ uint64) (*builtInFunctions.ESDigitalToken, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/esdt/token/nonce/1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	esdtNFTResponseObj := esdtNFTResponse{}
+	loadResponse(resp.Body, &esdtNFTResponseObj)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(esdtNFTResponseObj.Error, expectedErr.Error()))
+}
+
+func TestGetESDTNFTData_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	testToken := "token"
+	facade := mock.Facade{
+		GetESDTNFTTokenCalled: func(address string, tokenName string, nonce uint64) (*builtInFunctions.ESDigitalToken, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, testToken, tokenName)
+			assert.Equal(t, uint64(7), nonce)
+
+			return &builtInFunctions.ESDigitalToken{
+				Value: big.NewInt(10),
+				TokenMetaData: &builtInFunctions.MetaData{
+					Nonce:     nonce,
+					Name:      []byte("name"),
+					Royalties: 500,
+					URIs:      [][]byte{[]byte("uri")},
+				},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/esdt/%s/nonce/7", testAddress, testToken), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	esdtNFTResponseObj := esdtNFTResponse{}
+	loadResponse(resp.Body, &esdtNFTResponseObj)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	tokenData := esdtNFTResponseObj.Data.TokenData
+	assert.Equal(t, testToken, tokenData.TokenIdentifier)
+	assert.Equal(t, "10", tokenData.Balance)
+	assert.Equal(t, "name", tokenData.Name)
+	assert.Equal(t, uint64(7), tokenData.Nonce)
+	assert.Equal(t, "500", tokenData.Royalties)
+	assert.Equal(t, [][]byte{[]byte("uri")}, tokenData.URIs)
+}
+
+func TestGetAllESDTData_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetAllESDTTokensCalled: func(_ string) (map[string]*builtInFunctions.ESDigitalToken, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/address/address/esdt", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	esdtTokensResponseObj := esdtTokensResponse{}
+	loadResponse(resp.Body, &esdtTokensResponseObj)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(esdtTokensResponseObj.Error, apiErrors.ErrGetESDTTokens.Error()))
+	assert.True(t, strings.Contains(esdtTokensResponseObj.Error, expectedErr.Error()))
+}
+
+func TestGetAllESDTData_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	frozenProperties := builtInFunctions.ESDTUserMetadata{Frozen: true}
+	facade := mock.Facade{
+		GetAllESDTTokensCalled: func(address string) (map[string]*builtInFunctions.ESDigitalToken, error) {
+			assert.Equal(t, testAddress, address)
+
+			return map[string]*builtInFunctions.ESDigitalToken{
+				"fungible": {
+					Value:      big.NewInt(10),
+					Properties: frozenProperties.ToBytes(),
+				},
+				"nft" + string(big.NewInt(5).Bytes()): {
+					Value:         big.NewInt(1),
+					TokenMetaData: &builtInFunctions.MetaData{Nonce: 5},
+				},
+			}, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/esdt", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	esdtTokensResponseObj := esdtTokensResponse{}
+	loadResponse(resp.Body, &esdtTokensResponseObj)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	esdts := esdtTokensResponseObj.Data.ESDTs
+	require.Equal(t, 2, len(esdts))
+	assert.Equal(t, "fungible", esdts[0].TokenIdentifier)
+	assert.Equal(t, "10", esdts[0].Balance)
+	assert.True(t, esdts[0].Frozen)
+	assert.Equal(t, "nft", esdts[1].TokenIdentifier)
+	assert.Equal(t, uint64(5), esdts[1].Nonce)
+	assert.False(t, esdts[1].Frozen)
+}
+
 func TestGetUsername_NilContextShouldError(t *testing.T) {
 	t.Parallel()
 	ws := startNodeServer(nil)
@@ -453,6 +627,8 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/balance", Open: true},
 					{Name: "/:address/username", Open: true},
 					{Name: "/:address/key/:key", Open: true},
+					{Name: "/:address/esdt/:token/nonce/:nonce", Open: true},
+					{Name: "/:address/esdt", Open: true},
 				},
 			},
 		},
@@ -25,6 +25,24 @@ var ErrGetUsername = errors.New("get username error")
 // ErrGetValueForKey signals an error in getting the value of a key for an account
 var ErrGetValueForKey = errors.New("get value for key error")
 
+// ErrGetESDTNFTData signals an error in getting the non-fungible token data of an account
+var ErrGetESDTNFTData = errors.New("get esdt nft data error")
+
+// ErrEmptyTokenIdentifier signals an empty token identifier was provided
+var ErrEmptyTokenIdentifier = errors.New("token identifier is empty")
+
+// ErrNonceInvalid signals that an invalid token nonce was provided
+var ErrNonceInvalid = errors.New("nonce is invalid")
+
+// ErrGetESDTTokens signals an error in getting the esdt tokens held by an account
+var ErrGetESDTTokens = errors.New("get esdt tokens error")
+
+// ErrGetIssuedESDTs signals an error in getting the esdt tokens issued in the network
+var ErrGetIssuedESDTs = errors.New("get issued esdt tokens error")
+
+// ErrGetIssuedESDT signals an error in getting an issued esdt token
+var ErrGetIssuedESDT = errors.New("get issued esdt token error")
+
 // ErrEmptyAddress signals an empty address was provided
 var ErrEmptyAddress = errors.New("address is empty")
 
@@ -61,5 +79,29 @@ var ErrQueryError = errors.New("query error")
 // ErrGetPidInfo signals that an error occurred while getting peer ID info
 var ErrGetPidInfo = errors.New("error getting peer id info")
 
+// ErrGetP2PTopology signals that an error occurred while getting the p2p topology
+var ErrGetP2PTopology = errors.New("error getting the p2p topology")
+
+// ErrGetPeersBlacklist signals that an error occurred while getting the peers blacklist
+var ErrGetPeersBlacklist = errors.New("error getting the peers blacklist")
+
+// ErrBanPeer signals that an error occurred while banning a peer
+var ErrBanPeer = errors.New("error banning peer")
+
+// ErrUnbanPeer signals that an error occurred while unbanning a peer
+var ErrUnbanPeer = errors.New("error unbanning peer")
+
+// ErrInvalidAdminToken signals that the admin token is missing or it does not match the configured one
+var ErrInvalidAdminToken = errors.New("missing or invalid admin token")
+
+// ErrSetAntifloodLimits signals that an error occurred while setting the antiflood limits
+var ErrSetAntifloodLimits = errors.New("error setting the antiflood limits")
+
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrGetValidatorUptime signals that an error occurred while getting the uptime report of a validator
+var ErrGetValidatorUptime = errors.New("error getting validator uptime")
+
+// ErrGetSlashingHistory signals that an error occurred while getting the slashing history
+var ErrGetSlashingHistory = errors.New("error getting the slashing history")
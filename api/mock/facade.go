@@ -3,7 +3,9 @@ package mock
 import (
 	"encoding/hex"
 	"math/big"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/data/state"
@@ -13,6 +15,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 )
 
 // Facade is the mock implementation of a node router handler
@@ -33,11 +38,25 @@ type Facade struct {
 	ExecuteSCQueryHandler                   func(query *process.SCQuery) (*vm.VMOutputApi, error)
 	StatusMetricsHandler                    func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler              func() (map[string]*state.ValidatorApiResponse, error)
+	GetValidatorUptimeCalled                func(pubKey string, fromEpoch uint32, toEpoch uint32) (*data.UptimeReport, error)
+	GetSlashingHistoryCalled                func() ([]*slashing.SlashingRecordApiResponse, error)
 	ComputeTransactionGasLimitHandler       func(tx *transaction.Transaction) (uint64, error)
 	NodeConfigCalled                        func() map[string]interface{}
 	GetQueryHandlerCalled                   func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                    func(address string, key string) (string, error)
+	GetESDTNFTTokenCalled                   func(address string, tokenName string, nonce uint64) (*builtInFunctions.ESDigitalToken, error)
+	GetAllESDTTokensCalled                  func(address string) (map[string]*builtInFunctions.ESDigitalToken, error)
+	GetAllIssuedESDTsCalled                 func() (map[string]*systemSmartContracts.ESDTData, error)
+	GetIssuedESDTCalled                     func(tokenName string) (*systemSmartContracts.ESDTData, error)
+	GetActiveBuiltInFunctionsCalled         func() []process.BuiltInFunctionInfo
 	GetPeerInfoCalled                       func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetP2PTopologyCalled                    func() (*core.QueryP2PTopology, error)
+	GetPeersBlacklistCalled                 func() ([]core.PeerReputationInfo, error)
+	BanPeerCalled                           func(recordType string, identifier string, duration time.Duration, reason string) error
+	UnbanPeerCalled                         func(recordType string, identifier string) error
+	IsAntifloodAdminTokenValidCalled        func(token string) bool
+	GetAntifloodLimitsCalled                func() config.AntifloodLimitsOverrideConfig
+	SetAntifloodLimitsCalled                func(limits config.AntifloodLimitsOverrideConfig, source string) error
 	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
 	GetUsernameCalled                       func(address string) (string, error)
 	SimulateTransactionExecutionHandler     func(tx *transaction.Transaction) (*transaction.SimulationResults, error)
@@ -105,6 +124,51 @@ func (f *Facade) GetValueForKey(address string, key string) (string, error) {
 	return "", nil
 }
 
+// GetESDTNFTToken is the mock implementation of a handler's GetESDTNFTToken method
+func (f *Facade) GetESDTNFTToken(address string, tokenName string, nonce uint64) (*builtInFunctions.ESDigitalToken, error) {
+	if f.GetESDTNFTTokenCalled != nil {
+		return f.GetESDTNFTTokenCalled(address, tokenName, nonce)
+	}
+
+	return &builtInFunctions.ESDigitalToken{}, nil
+}
+
+// GetAllESDTTokens is the mock implementation of a handler's GetAllESDTTokens method
+func (f *Facade) GetAllESDTTokens(address string) (map[string]*builtInFunctions.ESDigitalToken, error) {
+	if f.GetAllESDTTokensCalled != nil {
+		return f.GetAllESDTTokensCalled(address)
+	}
+
+	return make(map[string]*builtInFunctions.ESDigitalToken), nil
+}
+
+// GetAllIssuedESDTs is the mock implementation of a handler's GetAllIssuedESDTs method
+func (f *Facade) GetAllIssuedESDTs() (map[string]*systemSmartContracts.ESDTData, error) {
+	if f.GetAllIssuedESDTsCalled != nil {
+		return f.GetAllIssuedESDTsCalled()
+	}
+
+	return make(map[string]*systemSmartContracts.ESDTData), nil
+}
+
+// GetIssuedESDT is the mock implementation of a handler's GetIssuedESDT method
+func (f *Facade) GetIssuedESDT(tokenName string) (*systemSmartContracts.ESDTData, error) {
+	if f.GetIssuedESDTCalled != nil {
+		return f.GetIssuedESDTCalled(tokenName)
+	}
+
+	return &systemSmartContracts.ESDTData{}, nil
+}
+
+// GetActiveBuiltInFunctions is the mock implementation of a handler's GetActiveBuiltInFunctions method
+func (f *Facade) GetActiveBuiltInFunctions() []process.BuiltInFunctionInfo {
+	if f.GetActiveBuiltInFunctionsCalled != nil {
+		return f.GetActiveBuiltInFunctionsCalled()
+	}
+
+	return make([]process.BuiltInFunctionInfo, 0)
+}
+
 // GetAccount is the mock implementation of a handler's GetAccount method
 func (f *Facade) GetAccount(address string) (state.UserAccountHandler, error) {
 	return f.GetAccountHandler(address)
@@ -156,6 +220,24 @@ func (f *Facade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiRespons
 	return f.ValidatorStatisticsHandler()
 }
 
+// GetValidatorUptime -
+func (f *Facade) GetValidatorUptime(pubKey string, fromEpoch uint32, toEpoch uint32) (*data.UptimeReport, error) {
+	if f.GetValidatorUptimeCalled != nil {
+		return f.GetValidatorUptimeCalled(pubKey, fromEpoch, toEpoch)
+	}
+
+	return nil, nil
+}
+
+// GetSlashingHistory -
+func (f *Facade) GetSlashingHistory() ([]*slashing.SlashingRecordApiResponse, error) {
+	if f.GetSlashingHistoryCalled != nil {
+		return f.GetSlashingHistoryCalled()
+	}
+
+	return nil, nil
+}
+
 // ExecuteSCQuery is a mock implementation.
 func (f *Facade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
 	return f.ExecuteSCQueryHandler(query)
@@ -196,6 +278,69 @@ func (f *Facade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return f.GetPeerInfoCalled(pid)
 }
 
+// GetP2PTopology -
+func (f *Facade) GetP2PTopology() (*core.QueryP2PTopology, error) {
+	if f.GetP2PTopologyCalled != nil {
+		return f.GetP2PTopologyCalled()
+	}
+
+	return &core.QueryP2PTopology{}, nil
+}
+
+// GetPeersBlacklist -
+func (f *Facade) GetPeersBlacklist() ([]core.PeerReputationInfo, error) {
+	if f.GetPeersBlacklistCalled != nil {
+		return f.GetPeersBlacklistCalled()
+	}
+
+	return make([]core.PeerReputationInfo, 0), nil
+}
+
+// BanPeer -
+func (f *Facade) BanPeer(recordType string, identifier string, duration time.Duration, reason string) error {
+	if f.BanPeerCalled != nil {
+		return f.BanPeerCalled(recordType, identifier, duration, reason)
+	}
+
+	return nil
+}
+
+// UnbanPeer -
+func (f *Facade) UnbanPeer(recordType string, identifier string) error {
+	if f.UnbanPeerCalled != nil {
+		return f.UnbanPeerCalled(recordType, identifier)
+	}
+
+	return nil
+}
+
+// IsAntifloodAdminTokenValid -
+func (f *Facade) IsAntifloodAdminTokenValid(token string) bool {
+	if f.IsAntifloodAdminTokenValidCalled != nil {
+		return f.IsAntifloodAdminTokenValidCalled(token)
+	}
+
+	return false
+}
+
+// GetAntifloodLimits -
+func (f *Facade) GetAntifloodLimits() config.AntifloodLimitsOverrideConfig {
+	if f.GetAntifloodLimitsCalled != nil {
+		return f.GetAntifloodLimitsCalled()
+	}
+
+	return config.AntifloodLimitsOverrideConfig{}
+}
+
+// SetAntifloodLimits -
+func (f *Facade) SetAntifloodLimits(limits config.AntifloodLimitsOverrideConfig, source string) error {
+	if f.SetAntifloodLimitsCalled != nil {
+		return f.SetAntifloodLimitsCalled(limits, source)
+	}
+
+	return nil
+}
+
 // GetNumCheckpointsFromAccountState -
 func (f *Facade) GetNumCheckpointsFromAccountState() uint32 {
 	if f.GetNumCheckpointsFromAccountStateCalled != nil {
@@ -1,32 +1,65 @@
 package network
 
 import (
+	"fmt"
+	"math/big"
 	"net/http"
+	"sort"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	"github.com/gin-gonic/gin"
 )
 
 const (
-	getConfigPath = "/config"
-	getStatusPath = "/status"
-	economicsPath = "/economics"
+	getConfigPath        = "/config"
+	getStatusPath        = "/status"
+	economicsPath        = "/economics"
+	getAllESDTsPath      = "/esdts"
+	getIssuedESDTPath    = "/esdt/:token"
+	builtInFunctionsPath = "/builtin-functions"
 )
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
+	GetAllIssuedESDTs() (map[string]*systemSmartContracts.ESDTData, error)
+	GetIssuedESDT(tokenName string) (*systemSmartContracts.ESDTData, error)
+	EncodeAddressPubkey(pk []byte) (string, error)
+	GetActiveBuiltInFunctions() []process.BuiltInFunctionInfo
 	IsInterfaceNil() bool
 }
 
+type issuedESDTData struct {
+	TokenName      string `json:"tokenName"`
+	TokenType      string `json:"tokenType"`
+	Owner          string `json:"owner"`
+	Supply         string `json:"supply"`
+	MintedValue    string `json:"mintedValue"`
+	BurntValue     string `json:"burntValue"`
+	Mintable       bool   `json:"mintable"`
+	Burnable       bool   `json:"burnable"`
+	CanPause       bool   `json:"canPause"`
+	IsPaused       bool   `json:"isPaused"`
+	CanFreeze      bool   `json:"canFreeze"`
+	CanWipe        bool   `json:"canWipe"`
+	CanUpgrade     bool   `json:"canUpgrade"`
+	CanChangeOwner bool   `json:"canChangeOwner"`
+}
+
 // Routes defines address related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, getConfigPath, GetNetworkConfig)
 	router.RegisterHandler(http.MethodGet, getStatusPath, GetNetworkStatus)
 	router.RegisterHandler(http.MethodGet, economicsPath, EconomicsMetrics)
+	router.RegisterHandler(http.MethodGet, getAllESDTsPath, GetAllIssuedESDTs)
+	router.RegisterHandler(http.MethodGet, getIssuedESDTPath, GetIssuedESDT)
+	router.RegisterHandler(http.MethodGet, builtInFunctionsPath, GetBuiltInFunctions)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -112,3 +145,139 @@ func EconomicsMetrics(c *gin.Context) {
 		},
 	)
 }
+
+// GetAllIssuedESDTs returns all the esdt tokens issued in the network
+func GetAllIssuedESDTs(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	issuedESDTs, err := facade.GetAllIssuedESDTs()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetIssuedESDTs.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	tokens := make([]issuedESDTData, 0, len(issuedESDTs))
+	for _, esdtData := range issuedESDTs {
+		tokens = append(tokens, issuedESDTDataFromESDTData(facade, esdtData))
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].TokenName < tokens[j].TokenName
+	})
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"tokens": tokens},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetIssuedESDT returns the properties of an esdt token issued in the network
+func GetIssuedESDT(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	tokenName := c.Param("token")
+	if tokenName == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetIssuedESDT.Error(), errors.ErrEmptyTokenIdentifier.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	esdtData, err := facade.GetIssuedESDT(tokenName)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetIssuedESDT.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"token": issuedESDTDataFromESDTData(facade, esdtData)},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// GetBuiltInFunctions returns the built-in functions active in the current epoch together with their gas costs
+func GetBuiltInFunctions(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	builtInFunctions := facade.GetActiveBuiltInFunctions()
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"builtInFunctions": builtInFunctions},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func issuedESDTDataFromESDTData(facade FacadeHandler, esdtData *systemSmartContracts.ESDTData) issuedESDTData {
+	mintedValue := big.NewInt(0)
+	if esdtData.MintedValue != nil {
+		mintedValue = esdtData.MintedValue
+	}
+	burntValue := big.NewInt(0)
+	if esdtData.BurntValue != nil {
+		burntValue = esdtData.BurntValue
+	}
+
+	owner, err := facade.EncodeAddressPubkey(esdtData.OwnerAddress)
+	if err != nil {
+		owner = ""
+	}
+	tokenType := string(esdtData.TokenType)
+	if len(tokenType) == 0 {
+		tokenType = core.FungibleESDT
+	}
+
+	return issuedESDTData{
+		TokenName:      string(esdtData.TokenName),
+		TokenType:      tokenType,
+		Owner:          owner,
+		Supply:         big.NewInt(0).Sub(mintedValue, burntValue).String(),
+		MintedValue:    mintedValue.String(),
+		BurntValue:     burntValue.String(),
+		Mintable:       esdtData.Mintable,
+		Burnable:       esdtData.Burnable,
+		CanPause:       esdtData.CanPause,
+		IsPaused:       esdtData.IsPaused,
+		CanFreeze:      esdtData.CanFreeze,
+		CanWipe:        esdtData.CanWipe,
+		CanUpgrade:     esdtData.Upgradable,
+		CanChangeOwner: esdtData.CanChangeOwner,
+	}
+}
@@ -1,16 +1,19 @@
 package network_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 
-	"github.com/ElrondNetwork/elrond-go/api/errors"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/network"
@@ -19,10 +22,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNetworkConfigMetrics_NilContextShouldError(t *testing.T) {
@@ -36,7 +42,7 @@ func TestNetworkConfigMetrics_NilContextShouldError(t *testing.T) {
 	loadResponse(resp.Body, &response)
 
 	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
-	assert.True(t, strings.Contains(response.Error, errors.ErrNilAppContext.Error()))
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
 }
 
 func TestNetworkStatusMetrics_NilContextShouldError(t *testing.T) {
@@ -50,7 +56,7 @@ func TestNetworkStatusMetrics_NilContextShouldError(t *testing.T) {
 	loadResponse(resp.Body, &response)
 
 	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
-	assert.True(t, strings.Contains(response.Error, errors.ErrNilAppContext.Error()))
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
 }
 
 func TestNetworkConfigMetrics_ShouldWork(t *testing.T) {
@@ -91,7 +97,7 @@ func TestNetwork_FailsWithWrongFacadeTypeConversion(t *testing.T) {
 	loadResponse(resp.Body, &statusRsp)
 
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
-	assert.Equal(t, statusRsp.Error, errors.ErrInvalidAppContext.Error())
+	assert.Equal(t, statusRsp.Error, apiErrors.ErrInvalidAppContext.Error())
 }
 
 func TestNetworkStatusMetrics_ShouldWork(t *testing.T) {
@@ -132,7 +138,7 @@ func TestNetworkStatus_FailsWithWrongFacadeTypeConversion(t *testing.T) {
 	loadResponse(resp.Body, &statusRsp)
 
 	assert.Equal(t, http.StatusInternalServerError, resp.Code)
-	assert.Equal(t, statusRsp.Error, errors.ErrInvalidAppContext.Error())
+	assert.Equal(t, statusRsp.Error, apiErrors.ErrInvalidAppContext.Error())
 }
 
 func TestEconomicsMetrics_NilContextShouldErr(t *testing.T) {
@@ -145,7 +151,7 @@ func TestEconomicsMetrics_NilContextShouldErr(t *testing.T) {
 	loadResponse(resp.Body, &response)
 
 	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
-	assert.True(t, strings.Contains(response.Error, errors.ErrNilAppContext.Error()))
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
 
 }
 
@@ -173,6 +179,187 @@ func TestEconomicsMetrics_ShouldWork(t *testing.T) {
 	assert.True(t, keyAndValueFoundInResponse)
 }
 
+type issuedESDTResponseData struct {
+	TokenName string `json:"tokenName"`
+	TokenType string `json:"tokenType"`
+	Owner     string `json:"owner"`
+	Supply    string `json:"supply"`
+	Mintable  bool   `json:"mintable"`
+}
+
+type issuedESDTsResponse struct {
+	Data struct {
+		Tokens []issuedESDTResponseData `json:"tokens"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type issuedESDTResponse struct {
+	Data struct {
+		Token issuedESDTResponseData `json:"token"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type builtInFunctionsResponse struct {
+	Data struct {
+		BuiltInFunctions []process.BuiltInFunctionInfo `json:"builtInFunctions"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestGetAllIssuedESDTs_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetAllIssuedESDTsCalled: func() (map[string]*systemSmartContracts.ESDTData, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdts", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := issuedESDTsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetIssuedESDTs.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetAllIssuedESDTs_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetAllIssuedESDTsCalled: func() (map[string]*systemSmartContracts.ESDTData, error) {
+			return map[string]*systemSmartContracts.ESDTData{
+				"TOKENB": {
+					OwnerAddress: []byte("owner"),
+					TokenName:    []byte("TOKENB"),
+					MintedValue:  big.NewInt(100),
+					BurntValue:   big.NewInt(30),
+					Mintable:     true,
+				},
+				"TOKENA": {
+					OwnerAddress: []byte("owner"),
+					TokenName:    []byte("TOKENA"),
+					TokenType:    []byte(core.NonFungibleESDT),
+				},
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdts", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := issuedESDTsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 2, len(response.Data.Tokens))
+	assert.Equal(t, "TOKENA", response.Data.Tokens[0].TokenName)
+	assert.Equal(t, core.NonFungibleESDT, response.Data.Tokens[0].TokenType)
+	assert.Equal(t, "0", response.Data.Tokens[0].Supply)
+	assert.Equal(t, "TOKENB", response.Data.Tokens[1].TokenName)
+	assert.Equal(t, core.FungibleESDT, response.Data.Tokens[1].TokenType)
+	assert.Equal(t, "70", response.Data.Tokens[1].Supply)
+	assert.Equal(t, hex.EncodeToString([]byte("owner")), response.Data.Tokens[1].Owner)
+	assert.True(t, response.Data.Tokens[1].Mintable)
+}
+
+func TestGetIssuedESDT_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetIssuedESDTCalled: func(tokenName string) (*systemSmartContracts.ESDTData, error) {
+			assert.Equal(t, "TOKEN", tokenName)
+			return &systemSmartContracts.ESDTData{
+				OwnerAddress: []byte("owner"),
+				TokenName:    []byte(tokenName),
+				MintedValue:  big.NewInt(10),
+				BurntValue:   big.NewInt(0),
+			}, nil
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdt/TOKEN", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := issuedESDTResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "TOKEN", response.Data.Token.TokenName)
+	assert.Equal(t, "10", response.Data.Token.Supply)
+}
+
+func TestGetIssuedESDT_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.Facade{
+		GetIssuedESDTCalled: func(_ string) (*systemSmartContracts.ESDTData, error) {
+			return nil, expectedErr
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/esdt/TOKEN", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := issuedESDTResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetIssuedESDT.Error()))
+}
+
+func TestGetBuiltInFunctions_NilContextShouldError(t *testing.T) {
+	t.Parallel()
+	ws := startNodeServer(nil)
+
+	req, _ := http.NewRequest("GET", "/network/builtin-functions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrNilAppContext.Error()))
+}
+
+func TestGetBuiltInFunctions_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedFunctions := []process.BuiltInFunctionInfo{
+		{Name: core.BuiltInFunctionESDTBurn, GasCost: 100},
+		{Name: core.BuiltInFunctionESDTTransfer, GasCost: 200, ActivationEpoch: 3},
+	}
+	facade := mock.Facade{
+		GetActiveBuiltInFunctionsCalled: func() []process.BuiltInFunctionInfo {
+			return expectedFunctions
+		},
+	}
+
+	ws := startNodeServer(&facade)
+	req, _ := http.NewRequest("GET", "/network/builtin-functions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := builtInFunctionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedFunctions, response.Data.BuiltInFunctions)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -222,6 +409,9 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/config", Open: true},
 					{Name: "/status", Open: true},
 					{Name: "/economics", Open: true},
+					{Name: "/esdts", Open: true},
+					{Name: "/esdt/:token", Open: true},
+					{Name: "/builtin-functions", Open: true},
 				},
 			},
 		},
@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"math/big"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/statistics"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -17,14 +20,21 @@ import (
 )
 
 const (
-	pidQueryParam       = "pid"
-	debugPath           = "/debug"
-	heartbeatStatusPath = "/heartbeatstatus"
-	metricsPath         = "/metrics"
-	p2pStatusPath       = "/p2pstatus"
-	peerInfoPath        = "/peerinfo"
-	statisticsPath      = "/statistics"
-	statusPath          = "/status"
+	pidQueryParam        = "pid"
+	debugPath            = "/debug"
+	heartbeatStatusPath  = "/heartbeatstatus"
+	metricsPath          = "/metrics"
+	p2pStatusPath        = "/p2pstatus"
+	peerInfoPath         = "/peerinfo"
+	p2pTopologyPath      = "/p2p/topology"
+	formatQueryParam     = "format"
+	peersBlacklistPath   = "/peers/blacklist"
+	antifloodLimitsPath  = "/antiflood/limits"
+	statisticsPath       = "/statistics"
+	statusPath           = "/status"
+	authorizationHeader  = "Authorization"
+	bearerSchemePrefix   = "Bearer "
+	antifloodAuditSource = "REST API from %s"
 )
 
 // AccStateCheckpointsKey is used as a key for the number of account state checkpoints in the api response
@@ -40,6 +50,13 @@ type FacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetP2PTopology() (*core.QueryP2PTopology, error)
+	GetPeersBlacklist() ([]core.PeerReputationInfo, error)
+	BanPeer(recordType string, identifier string, duration time.Duration, reason string) error
+	UnbanPeer(recordType string, identifier string) error
+	IsAntifloodAdminTokenValid(token string) bool
+	GetAntifloodLimits() config.AntifloodLimitsOverrideConfig
+	SetAntifloodLimits(limits config.AntifloodLimitsOverrideConfig, source string) error
 	GetNumCheckpointsFromAccountState() uint32
 	GetNumCheckpointsFromPeerState() uint32
 	IsInterfaceNil() bool
@@ -51,6 +68,14 @@ type QueryDebugRequest struct {
 	Search string `form:"search" json:"search"`
 }
 
+// PeerBlacklistRequest represents the structure on which user input for banning or unbanning a peer will validate against
+type PeerBlacklistRequest struct {
+	Type              string `form:"type" json:"type"`
+	Identifier        string `form:"identifier" json:"identifier"`
+	DurationInSeconds uint64 `form:"durationInSeconds" json:"durationInSeconds"`
+	Reason            string `form:"reason" json:"reason"`
+}
+
 type statisticsResponse struct {
 	LiveTPS               float64                   `json:"liveTPS"`
 	PeakTPS               float64                   `json:"peakTPS"`
@@ -84,6 +109,12 @@ func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, metricsPath, PrometheusMetrics)
 	router.RegisterHandler(http.MethodPost, debugPath, QueryDebug)
 	router.RegisterHandler(http.MethodGet, peerInfoPath, PeerInfo)
+	router.RegisterHandler(http.MethodGet, p2pTopologyPath, P2PTopology)
+	router.RegisterHandler(http.MethodGet, peersBlacklistPath, GetPeersBlacklist)
+	router.RegisterHandler(http.MethodPost, peersBlacklistPath, BanPeer)
+	router.RegisterHandler(http.MethodDelete, peersBlacklistPath, UnbanPeer)
+	router.RegisterHandler(http.MethodGet, antifloodLimitsPath, GetAntifloodLimits)
+	router.RegisterHandler(http.MethodPut, antifloodLimitsPath, SetAntifloodLimits)
 	// placeholder for custom routes
 }
 
@@ -313,6 +344,191 @@ func PeerInfo(c *gin.Context) {
 	)
 }
 
+// P2PTopology returns the connection graph of the node. The graph can be exported as json (default),
+// as a Graphviz DOT digraph or as a GraphML document, by using the format query parameter
+func P2PTopology(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	format := c.Request.URL.Query().Get(formatQueryParam)
+	if format == "" {
+		format = topologyFormatJSON
+	}
+	if format != topologyFormatJSON && format != topologyFormatDOT && format != topologyFormatGraphML {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	topology, err := facade.GetP2PTopology()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetP2PTopology.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	switch format {
+	case topologyFormatDOT:
+		c.Data(http.StatusOK, "text/vnd.graphviz; charset=utf-8", []byte(exportTopologyDOT(topology)))
+	case topologyFormatGraphML:
+		graphML, errExport := exportTopologyGraphML(topology)
+		if errExport != nil {
+			c.JSON(
+				http.StatusInternalServerError,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s: %s", errors.ErrGetP2PTopology.Error(), errExport.Error()),
+					Code:  shared.ReturnCodeInternalError,
+				},
+			)
+			return
+		}
+		c.Data(http.StatusOK, "application/graphml+xml; charset=utf-8", []byte(graphML))
+	default:
+		c.JSON(
+			http.StatusOK,
+			shared.GenericAPIResponse{
+				Data:  gin.H{"topology": topology},
+				Error: "",
+				Code:  shared.ReturnCodeSuccess,
+			},
+		)
+	}
+}
+
+// GetPeersBlacklist returns the persisted blacklisting decisions of the peer IDs and public keys
+func GetPeersBlacklist(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	blacklist, err := facade.GetPeersBlacklist()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetPeersBlacklist.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"blacklist": blacklist},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// BanPeer will blacklist the provided peer ID or public key
+func BanPeer(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	var request = PeerBlacklistRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	duration := time.Duration(request.DurationInSeconds) * time.Second
+	err = facade.BanPeer(request.Type, request.Identifier, duration, request.Reason)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrBanPeer.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// UnbanPeer will remove the peer ID or public key provided as query parameters from the blacklist
+func UnbanPeer(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	var request = PeerBlacklistRequest{}
+	err := c.ShouldBindQuery(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	err = facade.UnbanPeer(request.Type, request.Identifier)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrUnbanPeer.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // PrometheusMetrics is the endpoint which will return the data in the way that prometheus expects them
 func PrometheusMetrics(c *gin.Context) {
 	facade, ok := getFacade(c)
@@ -326,3 +542,90 @@ func PrometheusMetrics(c *gin.Context) {
 		metrics,
 	)
 }
+
+// GetAntifloodLimits will return the antiflood limits currently used by the node
+func GetAntifloodLimits(c *gin.Context) {
+	facade, ok := getAuthorizedFacade(c)
+	if !ok {
+		return
+	}
+
+	limits := facade.GetAntifloodLimits()
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"limits": limits},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// SetAntifloodLimits will replace all the antiflood limits of the node with the ones provided in the request body
+func SetAntifloodLimits(c *gin.Context) {
+	facade, ok := getAuthorizedFacade(c)
+	if !ok {
+		return
+	}
+
+	var limits = config.AntifloodLimitsOverrideConfig{}
+	err := c.ShouldBindJSON(&limits)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	err = facade.SetAntifloodLimits(limits, fmt.Sprintf(antifloodAuditSource, c.ClientIP()))
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrSetAntifloodLimits.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getAuthorizedFacade returns the facade only if the request carries a valid admin token in the Authorization header
+func getAuthorizedFacade(c *gin.Context) (FacadeHandler, bool) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return nil, false
+	}
+
+	authorization := c.GetHeader(authorizationHeader)
+	token := strings.TrimPrefix(authorization, bearerSchemePrefix)
+	hasBearerScheme := len(token) < len(authorization)
+	if !hasBearerScheme || !facade.IsAntifloodAdminTokenValid(token) {
+		c.JSON(
+			http.StatusUnauthorized,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrInvalidAdminToken.Error(),
+				Code:  shared.ReturnCodeUnauthorized,
+			},
+		)
+		return nil, false
+	}
+
+	return facade, true
+}
@@ -3,6 +3,7 @@ package node_test
 import (
 	"bytes"
 	"encoding/json"
+	"encoding/xml"
 	errs "errors"
 	"fmt"
 	"io"
@@ -461,6 +462,427 @@ func TestPeerInfo_PeerInfoShouldWork(t *testing.T) {
 	assert.NotNil(t, responseInfo["info"])
 }
 
+func createTestP2PTopology() *core.QueryP2PTopology {
+	return &core.QueryP2PTopology{
+		SelfPid:      "self",
+		SelfShardID:  0,
+		SelfPeerType: core.ValidatorPeer.String(),
+		IntraShardValidators: []core.QueryP2PTopologyPeer{
+			{Pid: "val", Pk: "pk", ShardID: 0, PeerType: core.ValidatorPeer.String(), Direction: "outbound", LatencyInMs: 12},
+		},
+		CrossShardObservers: []core.QueryP2PTopologyPeer{
+			{Pid: "obs", ShardID: 1, PeerType: core.ObserverPeer.String(), Direction: "inbound"},
+		},
+	}
+}
+
+func TestP2PTopology_InvalidFormatShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetP2PTopologyCalled: func() (*core.QueryP2PTopology, error) {
+			assert.Fail(t, "should have not called the facade")
+			return nil, nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/p2p/topology?format=png", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrInvalidQueryParameter.Error()))
+}
+
+func TestP2PTopology_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		GetP2PTopologyCalled: func() (*core.QueryP2PTopology, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/p2p/topology", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrGetP2PTopology.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestP2PTopology_JSONShouldWork(t *testing.T) {
+	t.Parallel()
+
+	topology := createTestP2PTopology()
+	facade := &mock.Facade{
+		GetP2PTopologyCalled: func() (*core.QueryP2PTopology, error) {
+			return topology, nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/p2p/topology", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		Data struct {
+			Topology *core.QueryP2PTopology `json:"topology"`
+		} `json:"data"`
+		Code string `json:"code"`
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, topology, response.Data.Topology)
+}
+
+func TestP2PTopology_DOTShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetP2PTopologyCalled: func() (*core.QueryP2PTopology, error) {
+			return createTestP2PTopology(), nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/p2p/topology?format=dot", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	body := resp.Body.String()
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, strings.HasPrefix(body, "digraph topology {"))
+	assert.True(t, strings.Contains(body, `"self" -> "val" [label="outbound, 12 ms"];`))
+	assert.True(t, strings.Contains(body, `"obs" -> "self" [label="inbound, 0 ms"];`))
+}
+
+func TestP2PTopology_GraphMLShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetP2PTopologyCalled: func() (*core.QueryP2PTopology, error) {
+			return createTestP2PTopology(), nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/p2p/topology?format=graphml", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	doc := struct {
+		Graph struct {
+			Nodes []struct {
+				ID string `xml:"id,attr"`
+			} `xml:"node"`
+			Edges []struct {
+				Source string `xml:"source,attr"`
+				Target string `xml:"target,attr"`
+			} `xml:"edge"`
+		} `xml:"graph"`
+	}{}
+	err := xml.Unmarshal(resp.Body.Bytes(), &doc)
+	require.Nil(t, err)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, 3, len(doc.Graph.Nodes))
+	assert.Equal(t, "self", doc.Graph.Nodes[0].ID)
+	require.Equal(t, 2, len(doc.Graph.Edges))
+	assert.Equal(t, "self", doc.Graph.Edges[0].Source)
+	assert.Equal(t, "val", doc.Graph.Edges[0].Target)
+	assert.Equal(t, "obs", doc.Graph.Edges[1].Source)
+	assert.Equal(t, "self", doc.Graph.Edges[1].Target)
+}
+
+func TestGetPeersBlacklist_ErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		GetPeersBlacklistCalled: func() ([]core.PeerReputationInfo, error) {
+			return nil, expectedErr
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/peers/blacklist", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetPeersBlacklist_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetPeersBlacklistCalled: func() ([]core.PeerReputationInfo, error) {
+			return []core.PeerReputationInfo{{Type: "pid", Identifier: "pid1", Reason: "flooding"}}, nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/peers/blacklist", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	blacklist, ok := responseData["blacklist"].([]interface{})
+	require.True(t, ok)
+	assert.Equal(t, 1, len(blacklist))
+}
+
+func TestBanPeer_InvalidBodyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ws := startNodeServerWithFacade(&mock.Facade{})
+	req, _ := http.NewRequest("POST", "/node/peers/blacklist", bytes.NewBuffer([]byte("invalid")))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrValidation.Error()))
+}
+
+func TestBanPeer_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		BanPeerCalled: func(recordType string, identifier string, duration time.Duration, reason string) error {
+			return expectedErr
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	jsonStr := `{"type":"pid", "identifier":"pid1", "durationInSeconds":60}`
+	req, _ := http.NewRequest("POST", "/node/peers/blacklist", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrBanPeer.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestBanPeer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	facade := &mock.Facade{
+		BanPeerCalled: func(recordType string, identifier string, duration time.Duration, reason string) error {
+			wasCalled = true
+			assert.Equal(t, "pk", recordType)
+			assert.Equal(t, "aabb", identifier)
+			assert.Equal(t, time.Minute, duration)
+			assert.Equal(t, "manual", reason)
+			return nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	jsonStr := `{"type":"pk", "identifier":"aabb", "durationInSeconds":60, "reason":"manual"}`
+	req, _ := http.NewRequest("POST", "/node/peers/blacklist", bytes.NewBuffer([]byte(jsonStr)))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.True(t, wasCalled)
+}
+
+func TestUnbanPeer_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := &mock.Facade{
+		UnbanPeerCalled: func(recordType string, identifier string) error {
+			return expectedErr
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("DELETE", "/node/peers/blacklist?type=pid&identifier=pid1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrUnbanPeer.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestUnbanPeer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	facade := &mock.Facade{
+		UnbanPeerCalled: func(recordType string, identifier string) error {
+			wasCalled = true
+			assert.Equal(t, "pid", recordType)
+			assert.Equal(t, "pid1", identifier)
+			return nil
+		},
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("DELETE", "/node/peers/blacklist?type=pid&identifier=pid1", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.True(t, wasCalled)
+}
+
+func createAdminTokenFacade(adminToken string) *mock.Facade {
+	return &mock.Facade{
+		IsAntifloodAdminTokenValidCalled: func(token string) bool {
+			return token == adminToken
+		},
+	}
+}
+
+func TestGetAntifloodLimits_InvalidTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := createAdminTokenFacade("token")
+	ws := startNodeServerWithFacade(facade)
+
+	for _, authorization := range []string{"", "token", "Bearer invalid"} {
+		req, _ := http.NewRequest("GET", "/node/antiflood/limits", nil)
+		req.Header.Set("Authorization", authorization)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Equal(t, shared.ReturnCodeUnauthorized, response.Code)
+		assert.Equal(t, errors.ErrInvalidAdminToken.Error(), response.Error)
+	}
+}
+
+func TestGetAntifloodLimits_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := createAdminTokenFacade("token")
+	facade.GetAntifloodLimitsCalled = func() config.AntifloodLimitsOverrideConfig {
+		return config.AntifloodLimitsOverrideConfig{
+			Topic: config.TopicAntifloodConfig{
+				DefaultMaxMessagesPerSec: 37,
+			},
+		}
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("GET", "/node/antiflood/limits", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, strings.Contains(fmt.Sprintf("%v", response.Data), "37"))
+}
+
+func TestSetAntifloodLimits_InvalidBodyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := createAdminTokenFacade("token")
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("PUT", "/node/antiflood/limits", bytes.NewBuffer([]byte("invalid")))
+	req.Header.Set("Authorization", "Bearer token")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrValidation.Error()))
+}
+
+func TestSetAntifloodLimits_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errs.New("expected error")
+	facade := createAdminTokenFacade("token")
+	facade.SetAntifloodLimitsCalled = func(limits config.AntifloodLimitsOverrideConfig, source string) error {
+		return expectedErr
+	}
+	ws := startNodeServerWithFacade(facade)
+	req, _ := http.NewRequest("PUT", "/node/antiflood/limits", bytes.NewBuffer([]byte("{}")))
+	req.Header.Set("Authorization", "Bearer token")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, errors.ErrSetAntifloodLimits.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestSetAntifloodLimits_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	facade := createAdminTokenFacade("token")
+	facade.SetAntifloodLimitsCalled = func(limits config.AntifloodLimitsOverrideConfig, source string) error {
+		wasCalled = true
+		assert.Equal(t, uint32(140), limits.FastReacting.PeerMaxInput.BaseMessagesPerInterval)
+		assert.Equal(t, float32(20), limits.FastReacting.ReservedPercent)
+		assert.Equal(t, uint32(15000), limits.Topic.DefaultMaxMessagesPerSec)
+		assert.Equal(t, []config.TopicMaxMessagesConfig{{Topic: "heartbeat", NumMessagesPerSec: 30}}, limits.Topic.MaxMessages)
+		assert.True(t, strings.Contains(source, "REST API"))
+		return nil
+	}
+	ws := startNodeServerWithFacade(facade)
+	jsonStr := `{"FastReacting":{"ReservedPercent":20,"PeerMaxInput":{"BaseMessagesPerInterval":140}},` +
+		`"Topic":{"DefaultMaxMessagesPerSec":15000,"MaxMessages":[{"Topic":"heartbeat","NumMessagesPerSec":30}]}}`
+	req, _ := http.NewRequest("PUT", "/node/antiflood/limits", bytes.NewBuffer([]byte(jsonStr)))
+	req.Header.Set("Authorization", "Bearer token")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.True(t, wasCalled)
+}
+
 func TestPrometheusMetrics_NilContextShouldErr(t *testing.T) {
 	ws := startNodeServer(nil)
 	req, _ := http.NewRequest("GET", "/node/metrics", nil)
@@ -559,6 +981,9 @@ func getRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/p2p/topology", Open: true},
+					{Name: "/peers/blacklist", Open: true},
+					{Name: "/antiflood/limits", Open: true},
 				},
 			},
 		},
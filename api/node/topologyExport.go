@@ -0,0 +1,213 @@
+package node
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+const (
+	topologyFormatJSON    = "json"
+	topologyFormatDOT     = "dot"
+	topologyFormatGraphML = "graphml"
+
+	categorySelf                = "self"
+	categoryIntraShardValidator = "intrashardvalidator"
+	categoryIntraShardObserver  = "intrashardobserver"
+	categoryCrossShardValidator = "crossshardvalidator"
+	categoryCrossShardObserver  = "crossshardobserver"
+	categoryUnknown             = "unknown"
+
+	graphMLNamespace = "http://graphml.graphdrawing.org/xmlns"
+)
+
+var categoriesColors = map[string]string{
+	categorySelf:                "black",
+	categoryIntraShardValidator: "darkgreen",
+	categoryIntraShardObserver:  "steelblue",
+	categoryCrossShardValidator: "orange",
+	categoryCrossShardObserver:  "gold",
+	categoryUnknown:             "gray",
+}
+
+type topologyNode struct {
+	pid      string
+	pk       string
+	shardID  uint32
+	peerType string
+	address  string
+	category string
+}
+
+type topologyEdge struct {
+	source      string
+	target      string
+	direction   string
+	latencyInMs int64
+}
+
+// flattenTopology converts the grouped topology in a star graph centered on the current node. The edges are oriented
+// as the connections were initiated
+func flattenTopology(topology *core.QueryP2PTopology) ([]topologyNode, []topologyEdge) {
+	nodes := []topologyNode{
+		{
+			pid:      topology.SelfPid,
+			pk:       topology.SelfPk,
+			shardID:  topology.SelfShardID,
+			peerType: topology.SelfPeerType,
+			category: categorySelf,
+		},
+	}
+	edges := make([]topologyEdge, 0)
+
+	groups := []struct {
+		category string
+		peers    []core.QueryP2PTopologyPeer
+	}{
+		{categoryIntraShardValidator, topology.IntraShardValidators},
+		{categoryIntraShardObserver, topology.IntraShardObservers},
+		{categoryCrossShardValidator, topology.CrossShardValidators},
+		{categoryCrossShardObserver, topology.CrossShardObservers},
+		{categoryUnknown, topology.UnknownPeers},
+	}
+	for _, group := range groups {
+		for _, peer := range group.peers {
+			nodes = append(nodes, topologyNode{
+				pid:      peer.Pid,
+				pk:       peer.Pk,
+				shardID:  peer.ShardID,
+				peerType: peer.PeerType,
+				address:  peer.Address,
+				category: group.category,
+			})
+
+			edge := topologyEdge{
+				source:      topology.SelfPid,
+				target:      peer.Pid,
+				direction:   peer.Direction,
+				latencyInMs: peer.LatencyInMs,
+			}
+			if peer.Direction == p2p.ConnectionDirectionInbound {
+				edge.source, edge.target = edge.target, edge.source
+			}
+			edges = append(edges, edge)
+		}
+	}
+
+	return nodes, edges
+}
+
+// exportTopologyDOT exports the topology in the Graphviz DOT language
+func exportTopologyDOT(topology *core.QueryP2PTopology) string {
+	nodes, edges := flattenTopology(topology)
+
+	builder := &strings.Builder{}
+	builder.WriteString("digraph topology {\n")
+	for _, n := range nodes {
+		label := fmt.Sprintf("%s\nshard %d, %s", n.pid, n.shardID, n.peerType)
+		_, _ = fmt.Fprintf(builder, "\t%s [label=%s, color=%s];\n",
+			strconv.Quote(n.pid), strconv.Quote(label), categoriesColors[n.category])
+	}
+	for _, e := range edges {
+		label := fmt.Sprintf("%s, %d ms", e.direction, e.latencyInMs)
+		_, _ = fmt.Fprintf(builder, "\t%s -> %s [label=%s];\n",
+			strconv.Quote(e.source), strconv.Quote(e.target), strconv.Quote(label))
+	}
+	builder.WriteString("}\n")
+
+	return builder.String()
+}
+
+type graphMLDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// exportTopologyGraphML exports the topology in the GraphML format
+func exportTopologyGraphML(topology *core.QueryP2PTopology) (string, error) {
+	nodes, edges := flattenTopology(topology)
+
+	doc := graphMLDocument{
+		Xmlns: graphMLNamespace,
+		Keys: []graphMLKey{
+			{ID: "pk", For: "node", AttrName: "pk", AttrType: "string"},
+			{ID: "shard", For: "node", AttrName: "shard", AttrType: "long"},
+			{ID: "peertype", For: "node", AttrName: "peertype", AttrType: "string"},
+			{ID: "address", For: "node", AttrName: "address", AttrType: "string"},
+			{ID: "category", For: "node", AttrName: "category", AttrType: "string"},
+			{ID: "direction", For: "edge", AttrName: "direction", AttrType: "string"},
+			{ID: "latencyms", For: "edge", AttrName: "latencyms", AttrType: "long"},
+		},
+		Graph: graphMLGraph{
+			ID:          "topology",
+			EdgeDefault: "directed",
+			Nodes:       make([]graphMLNode, 0, len(nodes)),
+			Edges:       make([]graphMLEdge, 0, len(edges)),
+		},
+	}
+	for _, n := range nodes {
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{
+			ID: n.pid,
+			Data: []graphMLData{
+				{Key: "pk", Value: n.pk},
+				{Key: "shard", Value: strconv.FormatUint(uint64(n.shardID), 10)},
+				{Key: "peertype", Value: n.peerType},
+				{Key: "address", Value: n.address},
+				{Key: "category", Value: n.category},
+			},
+		})
+	}
+	for _, e := range edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{
+			Source: e.source,
+			Target: e.target,
+			Data: []graphMLData{
+				{Key: "direction", Value: e.direction},
+				{Key: "latencyms", Value: strconv.FormatInt(e.latencyInMs, 10)},
+			},
+		})
+	}
+
+	buff, err := xml.MarshalIndent(doc, "", "\t")
+	if err != nil {
+		return "", err
+	}
+
+	return xml.Header + string(buff) + "\n", nil
+}
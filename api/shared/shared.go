@@ -29,6 +29,9 @@ const ReturnCodeRequestError ReturnCode = "bad_request"
 // ReturnCodeSystemBusy defines a request which hasn't been executed successfully due to too many requests
 const ReturnCodeSystemBusy ReturnCode = "system_busy"
 
+// ReturnCodeUnauthorized defines a request which hasn't been executed because it could not be authenticated
+const ReturnCodeUnauthorized ReturnCode = "unauthorized"
+
 // RespondWith will respond with the generic API response
 func RespondWith(c *gin.Context, status int, dataField interface{}, error string, code ReturnCode) {
 	c.JSON(
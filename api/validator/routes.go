@@ -1,26 +1,39 @@
 package validator
 
 import (
+	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
 	"github.com/gin-gonic/gin"
 )
 
-const statisticsPath = "/statistics"
+const (
+	statisticsPath = "/statistics"
+	uptimePath     = "/uptime/:pubkey"
+	slashingPath   = "/slashing"
+)
 
 // FacadeHandler interface defines methods that can be used by the gin webserver
 type FacadeHandler interface {
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetValidatorUptime(pubKey string, fromEpoch uint32, toEpoch uint32) (*data.UptimeReport, error)
+	GetSlashingHistory() ([]*slashing.SlashingRecordApiResponse, error)
 	IsInterfaceNil() bool
 }
 
 // Routes defines validators' related routes
 func Routes(router *wrapper.RouterWrapper) {
 	router.RegisterHandler(http.MethodGet, statisticsPath, Statistics)
+	router.RegisterHandler(http.MethodGet, uptimePath, Uptime)
+	router.RegisterHandler(http.MethodGet, slashingPath, Slashing)
 }
 
 func getFacade(c *gin.Context) (FacadeHandler, bool) {
@@ -82,3 +95,96 @@ func Statistics(c *gin.Context) {
 		},
 	)
 }
+
+// Uptime will return the availability report of a validator between the epochs provided as query parameters
+func Uptime(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	fromEpoch, err := getQueryParamEpoch(c, "fromEpoch", 0)
+	if err != nil {
+		respondWithInvalidQueryParameter(c)
+		return
+	}
+	toEpoch, err := getQueryParamEpoch(c, "toEpoch", math.MaxUint32)
+	if err != nil {
+		respondWithInvalidQueryParameter(c)
+		return
+	}
+
+	report, err := facade.GetValidatorUptime(c.Param("pubkey"), fromEpoch, toEpoch)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetValidatorUptime.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"uptime": report},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// Slashing will return the slashing records applied by the metachain
+func Slashing(c *gin.Context) {
+	facade, ok := getFacade(c)
+	if !ok {
+		return
+	}
+
+	records, err := facade.GetSlashingHistory()
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetSlashingHistory.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"slashing": records},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getQueryParamEpoch(c *gin.Context, name string, defaultValue uint32) (uint32, error) {
+	epochStr := c.Request.URL.Query().Get(name)
+	if epochStr == "" {
+		return defaultValue, nil
+	}
+
+	epoch, err := strconv.ParseUint(epochStr, 10, 32)
+
+	return uint32(epoch), err
+}
+
+func respondWithInvalidQueryParameter(c *gin.Context) {
+	c.JSON(
+		http.StatusBadRequest,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+			Code:  shared.ReturnCodeRequestError,
+		},
+	)
+}
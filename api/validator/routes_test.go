@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -18,9 +19,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/wrapper"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type ValidatorStatisticsResponse struct {
@@ -113,6 +117,161 @@ func TestValidatorStatistics_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, validatorStatistics.Result, mapToReturn)
 }
 
+type validatorUptimeResponseData struct {
+	Uptime *data.UptimeReport `json:"uptime"`
+}
+
+type validatorUptimeResponse struct {
+	Data  validatorUptimeResponseData `json:"data"`
+	Error string                      `json:"error"`
+	Code  string                      `json:"code"`
+}
+
+func TestValidatorUptime_InvalidEpochShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.Facade{
+		GetValidatorUptimeCalled: func(pubKey string, fromEpoch uint32, toEpoch uint32) (*data.UptimeReport, error) {
+			assert.Fail(t, "should have not called the facade")
+			return nil, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/uptime/pk?fromEpoch=abc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := validatorUptimeResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+}
+
+func TestValidatorUptime_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	errStr := "error in facade"
+	facade := mock.Facade{
+		GetValidatorUptimeCalled: func(pubKey string, fromEpoch uint32, toEpoch uint32) (*data.UptimeReport, error) {
+			return nil, errors.New(errStr)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/uptime/pk", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := validatorUptimeResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetValidatorUptime.Error()))
+	assert.True(t, strings.Contains(response.Error, errStr))
+}
+
+func TestValidatorUptime_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	report := &data.UptimeReport{
+		PublicKey:        "pk",
+		FromEpoch:        2,
+		ToEpoch:          4,
+		UptimePercentage: 90,
+		Epochs:           []data.EpochUptime{{Epoch: 2, UpTime: 90, DownTime: 10, UptimePercentage: 90}},
+		VersionChanges:   []data.VersionChangeInfo{{Epoch: 2, Timestamp: 10, Version: "v1"}},
+	}
+	facade := mock.Facade{
+		GetValidatorUptimeCalled: func(pubKey string, fromEpoch uint32, toEpoch uint32) (*data.UptimeReport, error) {
+			assert.Equal(t, "pk", pubKey)
+			assert.Equal(t, uint32(2), fromEpoch)
+			assert.Equal(t, uint32(math.MaxUint32), toEpoch)
+			return report, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/uptime/pk?fromEpoch=2", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := validatorUptimeResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.NotNil(t, response.Data.Uptime)
+	assert.Equal(t, report, response.Data.Uptime)
+}
+
+type slashingHistoryResponseData struct {
+	Slashing []*slashing.SlashingRecordApiResponse `json:"slashing"`
+}
+
+type slashingHistoryResponse struct {
+	Data  slashingHistoryResponseData `json:"data"`
+	Error string                      `json:"error"`
+	Code  string                      `json:"code"`
+}
+
+func TestValidatorSlashing_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	errStr := "error in facade"
+	facade := mock.Facade{
+		GetSlashingHistoryCalled: func() ([]*slashing.SlashingRecordApiResponse, error) {
+			return nil, errors.New(errStr)
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/slashing", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := slashingHistoryResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetSlashingHistory.Error()))
+	assert.True(t, strings.Contains(response.Error, errStr))
+}
+
+func TestValidatorSlashing_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	records := []*slashing.SlashingRecordApiResponse{
+		{
+			EvidenceID:     "aa",
+			PublicKey:      "pk",
+			Reason:         "equivocation",
+			ShardID:        1,
+			Round:          37,
+			SlashValue:     "1000",
+			MetaEpoch:      2,
+			MetaNonce:      120,
+			MetaHeaderHash: "bb",
+		},
+	}
+	facade := mock.Facade{
+		GetSlashingHistoryCalled: func() ([]*slashing.SlashingRecordApiResponse, error) {
+			return records, nil
+		},
+	}
+	ws := startNodeServer(&facade)
+
+	req, _ := http.NewRequest("GET", "/validator/slashing", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := slashingHistoryResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, records, response.Data.Slashing)
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -157,6 +316,8 @@ func getRoutesConfig() config.ApiRoutesConfig {
 			"validator": {
 				[]config.RouteConfig{
 					{Name: "/statistics", Open: true},
+					{Name: "/uptime/:pubkey", Open: true},
+					{Name: "/slashing", Open: true},
 				},
 			},
 		},
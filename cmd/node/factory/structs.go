@@ -10,6 +10,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
@@ -57,6 +58,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/peer"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
@@ -126,6 +128,8 @@ type Process struct {
 	RequestHandler           process.RequestHandler
 	TxLogsProcessor          process.TransactionLogProcessorDatabase
 	HeaderValidator          epochStart.HeaderValidator
+	EquivocationDetector     spos.EquivocationDetectorHandler
+	SlashingHistory          slashing.SlashingHistoryHandler
 }
 
 type processComponentsFactoryArgs struct {
@@ -175,6 +179,7 @@ type processComponentsFactoryArgs struct {
 	storageReolverImportPath  string
 	chanGracefullyClose       chan endProcess.ArgEndProcess
 	fallbackHeaderValidator   process.FallbackHeaderValidator
+	slashingHistory           slashing.SlashingHistoryHandler
 }
 
 // NewProcessComponentsFactoryArgs initializes the arguments necessary for creating the process components
@@ -223,6 +228,7 @@ func NewProcessComponentsFactoryArgs(
 	storageReolverImportPath string,
 	chanGracefullyClose chan endProcess.ArgEndProcess,
 	fallbackHeaderValidator process.FallbackHeaderValidator,
+	slashingHistory slashing.SlashingHistoryHandler,
 ) *processComponentsFactoryArgs {
 	return &processComponentsFactoryArgs{
 		coreComponents:            coreComponents,
@@ -270,6 +276,7 @@ func NewProcessComponentsFactoryArgs(
 		storageReolverImportPath:  storageReolverImportPath,
 		chanGracefullyClose:       chanGracefullyClose,
 		fallbackHeaderValidator:   fallbackHeaderValidator,
+		slashingHistory:           slashingHistory,
 	}
 }
 
@@ -289,6 +296,31 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		return nil, err
 	}
 
+	argsProofVerifier := headerCheck.ArgsEquivocationProofVerifier{
+		Marshalizer:       args.coreData.InternalMarshalizer,
+		Hasher:            args.coreData.Hasher,
+		NodesCoordinator:  args.nodesCoordinator,
+		SingleSigVerifier: args.crypto.SingleSigner,
+		KeyGen:            args.crypto.BlockSignKeyGen,
+	}
+	equivocationProofVerifier, err := headerCheck.NewEquivocationProofVerifier(argsProofVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	argsEquivocationDetector := spos.ArgsEquivocationDetector{
+		Marshalizer:      args.coreData.InternalMarshalizer,
+		NodesCoordinator: args.nodesCoordinator,
+		HeadersPool:      args.data.Datapool.Headers(),
+		ProofVerifier:    equivocationProofVerifier,
+		Messenger:        args.network.NetMessenger,
+		AntifloodHandler: args.network.InputAntifloodHandler,
+	}
+	equivocationDetector, err := spos.NewEquivocationDetector(argsEquivocationDetector)
+	if err != nil {
+		return nil, err
+	}
+
 	versionsCache, err := createCache(args.mainConfig.Versions.Cache)
 	if err != nil {
 		return nil, err
@@ -532,6 +564,8 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		pendingMiniBlocksHandler,
 		args.txSimulatorProcessorArgs,
 		headerIntegrityVerifier,
+		equivocationDetector,
+		equivocationProofVerifier,
 	)
 	if err != nil {
 		return nil, err
@@ -576,6 +610,8 @@ func ProcessComponentsFactory(args *processComponentsFactoryArgs) (*Process, err
 		RequestHandler:           requestHandler,
 		TxLogsProcessor:          txLogsProcessor,
 		HeaderValidator:          headerValidator,
+		EquivocationDetector:     equivocationDetector,
+		SlashingHistory:          args.slashingHistory,
 	}, nil
 }
 
@@ -1339,6 +1375,8 @@ func newBlockProcessor(
 	pendingMiniBlocksHandler process.PendingMiniBlocksHandler,
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	headerIntegrityVerifier HeaderIntegrityVerifierHandler,
+	equivocationProofsProvider process.EquivocationProofsProvider,
+	equivocationProofVerifier process.EquivocationProofVerifier,
 ) (process.BlockProcessor, error) {
 
 	shardCoordinator := processArgs.shardCoordinator
@@ -1407,6 +1445,9 @@ func newBlockProcessor(
 			txSimulatorProcessorArgs,
 			processArgs.mainConfig.GeneralSettings,
 			processArgs.rater,
+			equivocationProofsProvider,
+			equivocationProofVerifier,
+			processArgs.slashingHistory,
 		)
 	}
 
@@ -1449,10 +1490,15 @@ func newShardBlockProcessor(
 	}
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:          gasSchedule,
-		MapDNSAddresses: mapDNSAddresses,
-		Marshalizer:     core.InternalMarshalizer,
-		Accounts:        stateComponents.AccountsAdapter,
+		GasMap:                      gasSchedule,
+		MapDNSAddresses:             mapDNSAddresses,
+		Marshalizer:                 core.InternalMarshalizer,
+		Accounts:                    stateComponents.AccountsAdapter,
+		ShardCoordinator:            shardCoordinator,
+		ArgumentParser:              smartContract.NewArgumentParser(),
+		EpochNotifier:               epochNotifier,
+		BuiltInFunctionsEnableEpoch: config.GeneralSettings.BuiltInFunctionsEnableEpoch,
+		ActivationEpochs:            config.GeneralSettings.BuiltInFunctionsActivationEpochs,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -1561,7 +1607,6 @@ func newShardBlockProcessor(
 		TxLogsProcessor:                txLogsProcessor,
 		TxTypeHandler:                  txTypeHandler,
 		DeployEnableEpoch:              config.GeneralSettings.SCDeployEnableEpoch,
-		BuiltinEnableEpoch:             config.GeneralSettings.BuiltInFunctionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: config.GeneralSettings.PenalizedTooMuchGasEnableEpoch,
 		BadTxForwarder:                 badTxInterim,
 		EpochNotifier:                  epochNotifier,
@@ -1751,6 +1796,9 @@ func newMetaBlockProcessor(
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	generalSettingsConfig config.GeneralSettingsConfig,
 	rater sharding.PeerAccountListAndRatingHandler,
+	equivocationProofsProvider process.EquivocationProofsProvider,
+	equivocationProofVerifier process.EquivocationProofVerifier,
+	slashingHistory slashing.SlashingHistoryHandler,
 ) (process.BlockProcessor, error) {
 
 	builtInFuncs := builtInFunctions.NewBuiltInFunctionContainer()
@@ -1854,7 +1902,6 @@ func newMetaBlockProcessor(
 		BuiltInFunctions:               vmFactory.BlockChainHookImpl().GetBuiltInFunctions(),
 		TxLogsProcessor:                txLogsProcessor,
 		DeployEnableEpoch:              generalSettingsConfig.SCDeployEnableEpoch,
-		BuiltinEnableEpoch:             generalSettingsConfig.BuiltInFunctionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: generalSettingsConfig.PenalizedTooMuchGasEnableEpoch,
 		BadTxForwarder:                 badTxForwarder,
 		EpochNotifier:                  epochNotifier,
@@ -2004,6 +2051,7 @@ func newMetaBlockProcessor(
 		DataPool:                      data.Datapool,
 		ProtocolSustainabilityAddress: economicsData.ProtocolSustainabilityAddress(),
 		NodesConfigProvider:           nodesCoordinator,
+		DelegationEnableEpoch:         systemSCConfig.DelegationManagerSystemSCConfig.EnabledEpoch,
 	}
 	epochRewards, err := metachainEpochStart.NewEpochStartRewardsCreator(argsEpochRewards)
 	if err != nil {
@@ -2065,6 +2113,7 @@ func newMetaBlockProcessor(
 		PeerAccountsDB:                         stateComponents.PeerAccounts,
 		Marshalizer:                            core.InternalMarshalizer,
 		StartRating:                            ratingsData.StartRating(),
+		JailRating:                             ratingsData.MinRating(),
 		ValidatorInfoCreator:                   validatorStatisticsProcessor,
 		EndOfEpochCallerAddress:                vm.EndOfEpochAddress,
 		StakingSCAddress:                       vm.StakingSCAddress,
@@ -2072,6 +2121,8 @@ func newMetaBlockProcessor(
 		EpochNotifier:                          epochNotifier,
 		SwitchJailWaitingEnableEpoch:           generalSettingsConfig.SwitchJailWaitingEnableEpoch,
 		SwitchHysteresisForMinNodesEnableEpoch: generalSettingsConfig.SwitchHysteresisForMinNodesEnableEpoch,
+		ValidatorKeyRotationEnableEpoch:        systemSCConfig.StakingSystemSCConfig.ValidatorKeyRotationEnableEpoch,
+		DelegationEnableEpoch:                  systemSCConfig.DelegationManagerSystemSCConfig.EnabledEpoch,
 		GenesisNodesConfig:                     nodesSetup,
 	}
 	epochStartSystemSCProcessor, err := metachainEpochStart.NewSystemSCProcessor(argsEpochSystemSC)
@@ -2079,6 +2130,27 @@ func newMetaBlockProcessor(
 		return nil, err
 	}
 
+	conversionBase := 10
+	equivocationSlashValue, ok := big.NewInt(0).SetString(systemSCConfig.StakingSystemSCConfig.EquivocationSlashValue, conversionBase)
+	if !ok {
+		return nil, errors.New("invalid equivocation slash value")
+	}
+	argsSlashingProcessor := slashing.ArgsSlashingProcessor{
+		Marshalizer:         core.InternalMarshalizer,
+		ProofsProvider:      equivocationProofsProvider,
+		ProofVerifier:       equivocationProofVerifier,
+		Slasher:             epochStartSystemSCProcessor,
+		History:             slashingHistory,
+		TxLogsProcessor:     txLogsProcessor,
+		EpochNotifier:       epochNotifier,
+		SlashValue:          equivocationSlashValue,
+		SlashingEnableEpoch: systemSCConfig.StakingSystemSCConfig.SlashingEnableEpoch,
+	}
+	slashingProcessor, err := slashing.NewSlashingProcessor(argsSlashingProcessor)
+	if err != nil {
+		return nil, err
+	}
+
 	arguments := block.ArgMetaProcessor{
 		ArgBaseProcessor:             argumentsBaseProcessor,
 		SCToProtocol:                 smartContractToProtocol,
@@ -2089,6 +2161,7 @@ func newMetaBlockProcessor(
 		EpochValidatorInfoCreator:    validatorInfoCreator,
 		ValidatorStatisticsProcessor: validatorStatisticsProcessor,
 		EpochSystemSCProcessor:       epochStartSystemSCProcessor,
+		SlashingHandler:              slashingProcessor,
 	}
 
 	metaProcessor, err := block.NewMetaProcessor(arguments)
@@ -2298,12 +2371,12 @@ func PrepareOpenTopics(
 ) {
 	selfID := shardCoordinator.SelfId()
 	if selfID == core.MetachainShardId {
-		antiflood.SetTopicsForAll(core.HeartbeatTopic)
+		antiflood.SetTopicsForAll(core.HeartbeatTopic, core.PeerAuthenticationTopic, core.HeartbeatV2Topic)
 		return
 	}
 
 	selfShardTxTopic := factory.TransactionTopic + core.CommunicationIdentifierBetweenShards(selfID, selfID)
-	antiflood.SetTopicsForAll(core.HeartbeatTopic, selfShardTxTopic)
+	antiflood.SetTopicsForAll(core.HeartbeatTopic, core.PeerAuthenticationTopic, core.HeartbeatV2Topic, selfShardTxTopic)
 }
 
 // PrepareNetworkShardingCollector will create the network sharding collector and apply it to
@@ -2335,6 +2408,11 @@ func PrepareNetworkShardingCollector(
 		return nil, err
 	}
 
+	err = network.AdaptiveQuotaHandler.SetPeerValidatorMapper(networkShardingCollector)
+	if err != nil {
+		return nil, err
+	}
+
 	return networkShardingCollector, nil
 }
 
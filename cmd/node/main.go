@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/round"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/accumulator"
 	"github.com/ElrondNetwork/elrond-go/core/alarm"
@@ -66,6 +67,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	"github.com/ElrondNetwork/elrond-go/process/rating"
 	"github.com/ElrondNetwork/elrond-go/process/rating/peerHonesty"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
@@ -472,8 +474,8 @@ func main() {
 	}
 }
 
-func getSuite(config *config.Config) (crypto.Suite, error) {
-	switch config.Consensus.Type {
+func getSuite(signatureScheme string) (crypto.Suite, error) {
+	switch signatureScheme {
 	case consensus.BlsConsensusType:
 		return mcl.NewSuiteBLS12(), nil
 	default:
@@ -674,8 +676,14 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		"formatted", startTime.Format("Mon Jan 2 15:04:05 MST 2006"),
 		"seconds", startTime.Unix())
 
+	consensusTypesRegistry := sposFactory.NewDefaultConsensusTypesRegistry()
+	consensusTypeHandler, err := consensusTypesRegistry.Get(generalConfig.Consensus.Type)
+	if err != nil {
+		return fmt.Errorf("%w for consensus type %s", err, generalConfig.Consensus.Type)
+	}
+
 	log.Trace("getting suite")
-	suite, err := getSuite(generalConfig)
+	suite, err := getSuite(consensusTypeHandler.SignatureScheme())
 	if err != nil {
 		return err
 	}
@@ -747,6 +755,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	log.Trace("creating crypto components")
 	cryptoArgs := mainFactory.CryptoComponentsFactoryArgs{
 		Config:                               *generalConfig,
+		SignatureScheme:                      consensusTypeHandler.SignatureScheme(),
 		NodesConfig:                          genesisNodesConfig,
 		ShardCoordinator:                     genesisShardCoordinator,
 		KeyGen:                               cryptoParams.KeyGenerator,
@@ -822,13 +831,29 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	coreComponents.StatusHandler = statusHandlersInfo.StatusHandler
 
+	log.Trace("creating peer reputation store")
+	peerReputationStorer, err := createPeerReputationStorer(generalConfig, pathManager, shardId)
+	if err != nil {
+		return err
+	}
+	peerReputationStore, err := blackList.NewPeerReputationStore(blackList.ArgPeerReputationStore{
+		Storer:      peerReputationStorer,
+		Marshalizer: coreComponents.InternalMarshalizer,
+	})
+	if err != nil {
+		return err
+	}
+
 	log.Trace("creating network components")
+	machineLoadProvider := metrics.NewMachineLoadProvider()
 	networkComponentFactory, err := mainFactory.NewNetworkComponentsFactory(
 		*p2pConfig,
 		*generalConfig,
 		coreComponents.StatusHandler,
 		coreComponents.InternalMarshalizer,
 		syncer,
+		peerReputationStore,
+		machineLoadProvider,
 	)
 	if err != nil {
 		return err
@@ -1264,6 +1289,15 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		return err
 	}
 
+	var slashingHistory slashing.SlashingHistoryHandler
+	if shardCoordinator.SelfId() == core.MetachainShardId {
+		log.Trace("creating slashing history")
+		slashingHistory, err = createSlashingHistory(generalConfig, pathManager, shardId, coreComponents.InternalMarshalizer)
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Trace("creating process components")
 	processArgs := factory.NewProcessComponentsFactoryArgs(
 		&coreArgs,
@@ -1310,6 +1344,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		ctx.GlobalString(importDbDirectory.Name),
 		chanStopNodeProcess,
 		fallbackHeaderValidator,
+		slashingHistory,
 	)
 	processComponents, err := factory.ProcessComponentsFactory(processArgs)
 	if err != nil {
@@ -1385,6 +1420,8 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		hardForkTrigger,
 		historyRepository,
 		fallbackHeaderValidator,
+		statusHandlersInfo.StatusMetrics,
+		consensusTypesRegistry,
 	)
 	if err != nil {
 		return err
@@ -1442,7 +1479,12 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 	}
 
 	updateMachineStatisticsDuration := time.Second
-	err = metrics.StartMachineStatisticsPolling(coreComponents.StatusHandler, epochStartNotifier, updateMachineStatisticsDuration)
+	err = metrics.StartMachineStatisticsPolling(
+		coreComponents.StatusHandler,
+		epochStartNotifier,
+		updateMachineStatisticsDuration,
+		machineLoadProvider,
+	)
 	if err != nil {
 		return err
 	}
@@ -1457,8 +1499,9 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 		RestAPIServerDebugMode: restAPIServerDebugMode,
 		WsAntifloodConfig:      generalConfig.Antiflood.WebServer,
 		FacadeConfig: config.FacadeConfig{
-			RestApiInterface: ctx.GlobalString(restApiInterface.Name),
-			PprofEnabled:     ctx.GlobalBool(profileMode.Name),
+			RestApiInterface:    ctx.GlobalString(restApiInterface.Name),
+			PprofEnabled:        ctx.GlobalBool(profileMode.Name),
+			AntifloodAdminToken: generalConfig.Antiflood.RuntimeLimits.AdminToken,
 		},
 		ApiRoutesConfig: *apiRoutesConfig,
 		AccountsState:   stateComponents.AccountsAdapter,
@@ -1496,7 +1539,7 @@ func startNode(ctx *cli.Context, log logger.Logger, version string) error {
 
 	chanCloseComponents := make(chan struct{})
 	go func() {
-		closeAllComponents(log, healthService, dataComponents, triesComponents, networkComponents, chanCloseComponents)
+		closeAllComponents(log, healthService, dataComponents, triesComponents, networkComponents, processComponents, chanCloseComponents)
 	}()
 
 	select {
@@ -1539,6 +1582,7 @@ func closeAllComponents(
 	dataComponents *mainFactory.DataComponents,
 	triesComponents *mainFactory.TriesComponents,
 	networkComponents *mainFactory.NetworkComponents,
+	processComponents *factory.Process,
 	chanCloseComponents chan struct{},
 ) {
 	log.Debug("closing health service...")
@@ -1559,6 +1603,16 @@ func closeAllComponents(
 	err = networkComponents.NetMessenger.Close()
 	log.LogIfError(err)
 
+	log.Debug("closing the peer reputation store...")
+	err = networkComponents.PeerReputationStore.Close()
+	log.LogIfError(err)
+
+	if !check.IfNil(processComponents.SlashingHistory) {
+		log.Debug("closing the slashing history...")
+		err = processComponents.SlashingHistory.Close()
+		log.LogIfError(err)
+	}
+
 	chanCloseComponents <- struct{}{}
 }
 
@@ -2159,6 +2213,8 @@ func createNode(
 	hardForkTrigger node.HardforkTrigger,
 	historyRepository dblookupext.HistoryRepository,
 	fallbackHeaderValidator consensus.FallbackHeaderValidator,
+	statusMetrics external.StatusMetricsHandler,
+	consensusTypesRegistry sposFactory.ConsensusTypesRegistry,
 ) (*node.Node, error) {
 	var err error
 	var consensusGroupSize uint32
@@ -2211,7 +2267,7 @@ func createNode(
 		return nil, err
 	}
 
-	peerHonestyHandler, err := createPeerHonestyHandler(config, ratingConfig, network.PkTimeCache)
+	peerHonestyHandler, err := createPeerHonestyHandler(config, ratingConfig, network.PkTimeCache, network.PeerReputationStore)
 	if err != nil {
 		return nil, err
 	}
@@ -2249,6 +2305,9 @@ func createNode(
 		node.WithInterceptorsContainer(process.InterceptorsContainer),
 		node.WithResolversFinder(process.ResolversFinder),
 		node.WithConsensusType(config.Consensus.Type),
+		node.WithConsensusTypesRegistry(consensusTypesRegistry),
+		node.WithConsensusPipelining(config.Consensus.PipeliningEnabled),
+		node.WithValidatorDirectSend(config.Consensus.ValidatorDirectSendEnabled),
 		node.WithTxSingleSigner(crypto.TxSingleSigner),
 		node.WithBootstrapRoundIndex(bootstrapRoundIndex),
 		node.WithAppStatusHandler(coreData.StatusHandler),
@@ -2257,6 +2316,8 @@ func createNode(
 		node.WithEpochStartEventNotifier(epochStartRegistrationHandler),
 		node.WithBlockBlackListHandler(process.BlackListHandler),
 		node.WithPeerDenialEvaluator(peerDenialEvaluator),
+		node.WithPeerReputationStore(network.PeerReputationStore),
+		node.WithAntifloodLimitsHandler(network.AntifloodLimitsHandler),
 		node.WithNetworkShardingCollector(networkShardingCollector),
 		node.WithBootStorer(process.BootStorer),
 		node.WithRequestedItemsHandler(requestedItemsHandler),
@@ -2281,6 +2342,7 @@ func createNode(
 		node.WithWatchdogTimer(watchdogTimer),
 		node.WithPeerSignatureHandler(crypto.PeerSignatureHandler),
 		node.WithHistoryRepository(historyRepository),
+		node.WithEquivocationDetector(process.EquivocationDetector),
 	)
 	if err != nil {
 		return nil, errors.New("error creating node: " + err.Error())
@@ -2303,7 +2365,10 @@ func createNode(
 		}
 	}
 	if shardCoordinator.SelfId() == core.MetachainShardId {
-		err = nd.ApplyOptions(node.WithPendingMiniBlocksHandler(process.PendingMiniBlocksHandler))
+		err = nd.ApplyOptions(
+			node.WithPendingMiniBlocksHandler(process.PendingMiniBlocksHandler),
+			node.WithSlashingHistory(process.SlashingHistory),
+		)
 		if err != nil {
 			return nil, errors.New("error creating meta-node: " + err.Error())
 		}
@@ -2319,6 +2384,20 @@ func createNode(
 		return nil, err
 	}
 
+	roundTracer, err := nodeDebugFactory.CreateRoundTracer(
+		nd,
+		statusMetrics,
+		config.Debug.Consensus,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = nd.ApplyOptions(node.WithRoundTracer(roundTracer))
+	if err != nil {
+		return nil, err
+	}
+
 	return nd, nil
 }
 
@@ -2326,6 +2405,7 @@ func createPeerHonestyHandler(
 	config *config.Config,
 	ratingConfig config.RatingsConfig,
 	pkTimeCache process.TimeCacher,
+	scoresSaver process.PeerScoresSaver,
 ) (consensus.PeerHonestyHandler, error) {
 
 	cache, err := storageUnit.NewCache(storageFactory.GetCacherFromConfig(config.PeerHonesty))
@@ -2333,7 +2413,56 @@ func createPeerHonestyHandler(
 		return nil, err
 	}
 
-	return peerHonesty.NewP2pPeerHonesty(ratingConfig.PeerHonesty, pkTimeCache, cache)
+	peerHonestyHandler, err := peerHonesty.NewP2pPeerHonesty(ratingConfig.PeerHonesty, pkTimeCache, cache)
+	if err != nil {
+		return nil, err
+	}
+
+	err = peerHonestyHandler.SetPeerScoresSaver(scoresSaver)
+	if err != nil {
+		return nil, err
+	}
+
+	return peerHonestyHandler, nil
+}
+
+func createPeerReputationStorer(
+	config *config.Config,
+	pathManager storage.PathManagerHandler,
+	shardId string,
+) (storage.Storer, error) {
+	dbConfig := storageFactory.GetDBFromConfig(config.PeerReputationStorage.DB)
+	dbConfig.FilePath = pathManager.PathForStatic(shardId, config.PeerReputationStorage.DB.FilePath)
+
+	return storageUnit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(config.PeerReputationStorage.Cache),
+		dbConfig,
+		storageFactory.GetBloomFromConfig(config.PeerReputationStorage.Bloom),
+	)
+}
+
+func createSlashingHistory(
+	config *config.Config,
+	pathManager storage.PathManagerHandler,
+	shardId string,
+	marshalizer marshal.Marshalizer,
+) (slashing.SlashingHistoryHandler, error) {
+	dbConfig := storageFactory.GetDBFromConfig(config.SlashingHistoryStorage.DB)
+	dbConfig.FilePath = pathManager.PathForStatic(shardId, config.SlashingHistoryStorage.DB.FilePath)
+
+	storer, err := storageUnit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(config.SlashingHistoryStorage.Cache),
+		dbConfig,
+		storageFactory.GetBloomFromConfig(config.SlashingHistoryStorage.Bloom),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return slashing.NewSlashingHistory(slashing.ArgsSlashingHistory{
+		Storer:      storer,
+		Marshalizer: marshalizer,
+	})
 }
 
 func initStatsFileMonitor(
@@ -2398,10 +2527,15 @@ func createApiResolver(
 	var err error
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:          gasSchedule,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     marshalizer,
-		Accounts:        accnts,
+		GasMap:                      gasSchedule,
+		MapDNSAddresses:             make(map[string]struct{}),
+		Marshalizer:                 marshalizer,
+		Accounts:                    accnts,
+		ShardCoordinator:            shardCoordinator,
+		ArgumentParser:              smartContract.NewArgumentParser(),
+		EpochNotifier:               epochNotifier,
+		BuiltInFunctionsEnableEpoch: config.GeneralSettings.BuiltInFunctionsEnableEpoch,
+		ActivationEpochs:            config.GeneralSettings.BuiltInFunctionsActivationEpochs,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -2480,7 +2614,7 @@ func createApiResolver(
 		return nil, err
 	}
 
-	return external.NewNodeApiResolver(scQueryService, statusMetrics, txCostHandler)
+	return external.NewNodeApiResolver(scQueryService, statusMetrics, txCostHandler, builtInFuncs)
 }
 
 func createWhiteListerVerifiedTxs(generalConfig *config.Config) (process.WhiteListHandler, error) {
@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+)
+
+// MachineLoadProvider holds the last CPU and memory load values computed by the machine statistics polling
+type MachineLoadProvider struct {
+	cpuLoadPercent atomic.Uint64
+	memLoadPercent atomic.Uint64
+}
+
+// NewMachineLoadProvider creates a new machine load provider. The values are updated only after the machine
+// statistics polling has been started
+func NewMachineLoadProvider() *MachineLoadProvider {
+	return &MachineLoadProvider{}
+}
+
+// LoadPercent returns the machine load as the maximum between the CPU and the memory load percents
+func (mlp *MachineLoadProvider) LoadPercent() uint64 {
+	cpuLoad := mlp.cpuLoadPercent.Get()
+	memLoad := mlp.memLoadPercent.Get()
+	if cpuLoad > memLoad {
+		return cpuLoad
+	}
+
+	return memLoad
+}
+
+func (mlp *MachineLoadProvider) setCpuLoadPercent(value uint64) {
+	mlp.cpuLoadPercent.Set(value)
+}
+
+func (mlp *MachineLoadProvider) setMemLoadPercent(value uint64) {
+	mlp.memLoadPercent.Set(value)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mlp *MachineLoadProvider) IsInterfaceNil() bool {
+	return mlp == nil
+}
@@ -11,23 +11,32 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
-// StartMachineStatisticsPolling will start read information about current running machine
-func StartMachineStatisticsPolling(ash core.AppStatusHandler, notifier sharding.EpochStartEventNotifier, pollingInterval time.Duration) error {
+// StartMachineStatisticsPolling will start read information about current running machine. The CPU and memory
+// load values are also fed in the provided load provider
+func StartMachineStatisticsPolling(
+	ash core.AppStatusHandler,
+	notifier sharding.EpochStartEventNotifier,
+	pollingInterval time.Duration,
+	loadProvider *MachineLoadProvider,
+) error {
 	if check.IfNil(ash) {
 		return errors.New("nil AppStatusHandler")
 	}
+	if check.IfNil(loadProvider) {
+		return errors.New("nil MachineLoadProvider")
+	}
 
 	appStatusPollingHandler, err := appStatusPolling.NewAppStatusPolling(ash, pollingInterval)
 	if err != nil {
 		return errors.New("cannot init AppStatusPolling")
 	}
 
-	err = registerCpuStatistics(appStatusPollingHandler)
+	err = registerCpuStatistics(appStatusPollingHandler, loadProvider)
 	if err != nil {
 		return err
 	}
 
-	err = registerMemStatistics(appStatusPollingHandler)
+	err = registerMemStatistics(appStatusPollingHandler, loadProvider)
 	if err != nil {
 		return err
 	}
@@ -42,9 +51,10 @@ func StartMachineStatisticsPolling(ash core.AppStatusHandler, notifier sharding.
 	return nil
 }
 
-func registerMemStatistics(appStatusPollingHandler *appStatusPolling.AppStatusPolling) error {
+func registerMemStatistics(appStatusPollingHandler *appStatusPolling.AppStatusPolling, loadProvider *MachineLoadProvider) error {
 	return appStatusPollingHandler.RegisterPollingFunc(func(appStatusHandler core.AppStatusHandler) {
 		mem := machine.AcquireMemStatistics()
+		loadProvider.setMemLoadPercent(mem.PercentUsed)
 
 		appStatusHandler.SetUInt64Value(core.MetricMemLoadPercent, mem.PercentUsed)
 		appStatusHandler.SetUInt64Value(core.MetricMemTotal, mem.Total)
@@ -78,7 +88,7 @@ func registerNetStatistics(appStatusPollingHandler *appStatusPolling.AppStatusPo
 	})
 }
 
-func registerCpuStatistics(appStatusPollingHandler *appStatusPolling.AppStatusPolling) error {
+func registerCpuStatistics(appStatusPollingHandler *appStatusPolling.AppStatusPolling, loadProvider *MachineLoadProvider) error {
 	cpuStats, err := machine.NewCpuStatistics()
 	if err != nil {
 		return err
@@ -91,6 +101,8 @@ func registerCpuStatistics(appStatusPollingHandler *appStatusPolling.AppStatusPo
 	}()
 
 	return appStatusPollingHandler.RegisterPollingFunc(func(appStatusHandler core.AppStatusHandler) {
-		appStatusHandler.SetUInt64Value(core.MetricCpuLoadPercent, cpuStats.CpuPercentUsage())
+		cpuLoadPercent := cpuStats.CpuPercentUsage()
+		loadProvider.setCpuLoadPercent(cpuLoadPercent)
+		appStatusHandler.SetUInt64Value(core.MetricCpuLoadPercent, cpuLoadPercent)
 	})
 }
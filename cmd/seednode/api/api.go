@@ -5,6 +5,9 @@ import (
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/peerstore"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -13,18 +16,39 @@ import (
 
 var log = logger.GetOrCreate("seednode/api")
 
+// PeersInfoHandler defines the component able to provide the information about the peers known by the seed node
+type PeersInfoHandler interface {
+	KnownPeers() []peerstore.PeerInfo
+	Statistics() peerstore.PeersStatistics
+	IsInterfaceNil() bool
+}
+
 // Start will boot up the api and appropriate routes, handlers and validators
-func Start(restApiInterface string, marshalizer marshal.Marshalizer) error {
+func Start(restApiInterface string, marshalizer marshal.Marshalizer, peersInfoHandler PeersInfoHandler) error {
+	if check.IfNil(peersInfoHandler) {
+		return ErrNilPeersInfoHandler
+	}
+
 	ws := gin.Default()
 	ws.Use(cors.Default())
 
-	registerRoutes(ws, marshalizer)
+	registerRoutes(ws, marshalizer, peersInfoHandler)
 
 	return ws.Run(restApiInterface)
 }
 
-func registerRoutes(ws *gin.Engine, marshalizer marshal.Marshalizer) {
+func registerRoutes(ws *gin.Engine, marshalizer marshal.Marshalizer, peersInfoHandler PeersInfoHandler) {
 	registerLoggerWsRoute(ws, marshalizer)
+	registerPeersRoutes(ws, peersInfoHandler)
+}
+
+func registerPeersRoutes(ws *gin.Engine, peersInfoHandler PeersInfoHandler) {
+	ws.GET("/peers", func(c *gin.Context) {
+		shared.RespondWith(c, http.StatusOK, gin.H{"peers": peersInfoHandler.KnownPeers()}, "", shared.ReturnCodeSuccess)
+	})
+	ws.GET("/peers/statistics", func(c *gin.Context) {
+		shared.RespondWith(c, http.StatusOK, gin.H{"statistics": peersInfoHandler.Statistics()}, "", shared.ReturnCodeSuccess)
+	})
 }
 
 func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
@@ -0,0 +1,103 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/api"
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/mock"
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/peerstore"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type peersResponse struct {
+	Data struct {
+		Peers []peerstore.PeerInfo `json:"peers"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type statisticsResponse struct {
+	Data struct {
+		Statistics peerstore.PeersStatistics `json:"statistics"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func startServer(peersInfoHandler api.PeersInfoHandler) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	ws := gin.New()
+	api.RegisterPeersRoutes(ws, peersInfoHandler)
+
+	return ws
+}
+
+func TestStart_NilPeersInfoHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	err := api.Start("localhost:0", &marshal.GogoProtoMarshalizer{}, nil)
+	assert.Equal(t, api.ErrNilPeersInfoHandler, err)
+}
+
+func TestPeersRoutes_KnownPeersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	knownPeers := []peerstore.PeerInfo{
+		{
+			Pid:               "pid1",
+			Addresses:         []string{"/ip4/127.0.0.1/tcp/10000"},
+			Shard:             "0",
+			IsConnected:       true,
+			NumConnections:    3,
+			LastSeenTimestamp: 1000,
+		},
+	}
+	ws := startServer(&mock.PeersInfoHandlerStub{
+		KnownPeersCalled: func() []peerstore.PeerInfo {
+			return knownPeers
+		},
+	})
+
+	req, _ := http.NewRequest("GET", "/peers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &peersResponse{}
+	err := json.NewDecoder(resp.Body).Decode(response)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+	assert.Equal(t, knownPeers, response.Data.Peers)
+}
+
+func TestPeersRoutes_StatisticsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	stats := peerstore.PeersStatistics{
+		NumKnownPeers:          5,
+		NumConnectedPeers:      2,
+		ConnectedPeersPerShard: map[string]int{"0": 1, peerstore.UnknownShard: 1},
+	}
+	ws := startServer(&mock.PeersInfoHandlerStub{
+		StatisticsCalled: func() peerstore.PeersStatistics {
+			return stats
+		},
+	})
+
+	req, _ := http.NewRequest("GET", "/peers/statistics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &statisticsResponse{}
+	err := json.NewDecoder(resp.Body).Decode(response)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, stats, response.Data.Statistics)
+}
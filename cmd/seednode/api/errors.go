@@ -0,0 +1,6 @@
+package api
+
+import "errors"
+
+// ErrNilPeersInfoHandler signals that a nil peers info handler has been provided
+var ErrNilPeersInfoHandler = errors.New("nil peers info handler")
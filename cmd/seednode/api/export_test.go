@@ -0,0 +1,7 @@
+package api
+
+import "github.com/gin-gonic/gin"
+
+func RegisterPeersRoutes(ws *gin.Engine, peersInfoHandler PeersInfoHandler) {
+	registerPeersRoutes(ws, peersInfoHandler)
+}
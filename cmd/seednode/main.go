@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"sort"
 	"strings"
 	"syscall"
@@ -12,6 +13,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/cmd/seednode/api"
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/peerstore"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -22,10 +24,14 @@ import (
 	factoryMarshalizer "github.com/ElrondNetwork/elrond-go/marshal/factory"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/urfave/cli"
 )
 
 const defaultLogsPath = "logs"
+const defaultDBPath = "db"
 const filePathPlaceholder = "[path]"
 
 var (
@@ -142,10 +148,10 @@ func startNode(ctx *cli.Context) error {
 		return fmt.Errorf("error creating marshalizer (internal): %s", err.Error())
 	}
 
+	workingDir := getWorkingDir(log)
 	withLogFile := ctx.GlobalBool(logSaveFile.Name)
 	var fileLogging factory.FileLoggingHandler
 	if withLogFile {
-		workingDir := getWorkingDir(log)
 		fileLogging, err = logging.NewFileLogging(workingDir, defaultLogsPath)
 		if err != nil {
 			return fmt.Errorf("%w creating a log file", err)
@@ -157,8 +163,6 @@ func startNode(ctx *cli.Context) error {
 		}
 	}
 
-	startRestServices(ctx, internalMarshalizer)
-
 	log.Info("starting seednode...")
 
 	sigs := make(chan os.Signal, 1)
@@ -188,15 +192,34 @@ func startNode(ctx *cli.Context) error {
 		return err
 	}
 
+	knownPeersStorer, err := createKnownPeersStorer(generalConfig.SeedNode, workingDir)
+	if err != nil {
+		return err
+	}
+
+	peersTracker, err := createPeersTracker(generalConfig.SeedNode, messenger, knownPeersStorer, internalMarshalizer)
+	if err != nil {
+		return err
+	}
+
+	startRestServices(ctx, internalMarshalizer, peersTracker)
+
 	err = messenger.Bootstrap()
 	if err != nil {
 		return err
 	}
 
+	peersTracker.StartTracking()
+	go peersTracker.ReconnectKnownPeers()
+
 	log.Info("application is now running...")
 	mainLoop(messenger, sigs)
 
 	log.Debug("closing seednode")
+	err = peersTracker.Close()
+	log.LogIfError(err)
+	err = knownPeersStorer.Close()
+	log.LogIfError(err)
 	if !check.IfNil(fileLogging) {
 		err = fileLogging.Close()
 		log.LogIfError(err)
@@ -239,6 +262,65 @@ func createNode(p2pConfig config.P2PConfig, marshalizer marshal.Marshalizer) (p2
 	return libp2p.NewNetworkMessenger(arg)
 }
 
+func createKnownPeersStorer(seedNodeConfig config.SeedNodeConfig, workingDir string) (storage.Storer, error) {
+	dbConfig := storageFactory.GetDBFromConfig(seedNodeConfig.KnownPeersStorage.DB)
+	dbConfig.FilePath = filepath.Join(workingDir, defaultDBPath, seedNodeConfig.KnownPeersStorage.DB.FilePath)
+
+	return storageUnit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(seedNodeConfig.KnownPeersStorage.Cache),
+		dbConfig,
+		storageFactory.GetBloomFromConfig(seedNodeConfig.KnownPeersStorage.Bloom),
+	)
+}
+
+type peersTrackerHandler interface {
+	ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error
+	KnownPeers() []peerstore.PeerInfo
+	Statistics() peerstore.PeersStatistics
+	StartTracking()
+	ReconnectKnownPeers()
+	Close() error
+	IsInterfaceNil() bool
+}
+
+func createPeersTracker(
+	seedNodeConfig config.SeedNodeConfig,
+	messenger p2p.Messenger,
+	storer storage.Storer,
+	marshalizer marshal.Marshalizer,
+) (peersTrackerHandler, error) {
+	arg := peerstore.ArgPeersTracker{
+		Messenger:       messenger,
+		Storer:          storer,
+		Marshalizer:     marshalizer,
+		RefreshInterval: time.Second * time.Duration(seedNodeConfig.RefreshIntervalInSec),
+		RecordsExpiry:   time.Hour * time.Duration(seedNodeConfig.RecordsExpiryInHours),
+	}
+	peersTracker, err := peerstore.NewPeersTracker(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	if !seedNodeConfig.TrackShardsFromHeartbeats {
+		return peersTracker, nil
+	}
+
+	//both heartbeat protocol versions are observed as the network migrates from v1 to v2
+	for _, topic := range []string{core.HeartbeatTopic, core.PeerAuthenticationTopic, core.HeartbeatV2Topic} {
+		err = messenger.CreateTopic(topic, false)
+		if err != nil {
+			return nil, err
+		}
+
+		err = messenger.RegisterMessageProcessor(topic, peersTracker)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return peersTracker, nil
+}
+
 func displayMessengerInfo(messenger p2p.Messenger) {
 	headerSeedAddresses := []string{"Seednode addresses:"}
 	addresses := make([]*display.LineData, 0)
@@ -301,17 +383,17 @@ func checkExpectedPeerCount(p2pConfig config.P2PConfig) error {
 	return nil
 }
 
-func startRestServices(ctx *cli.Context, marshalizer marshal.Marshalizer) {
+func startRestServices(ctx *cli.Context, marshalizer marshal.Marshalizer, peersInfoHandler api.PeersInfoHandler) {
 	restApiInterface := ctx.GlobalString(restApiInterfaceFlag.Name)
 	if restApiInterface != facade.DefaultRestPortOff {
-		go startGinServer(restApiInterface, marshalizer)
+		go startGinServer(restApiInterface, marshalizer, peersInfoHandler)
 	} else {
 		log.Info("rest api is disabled")
 	}
 }
 
-func startGinServer(restApiInterface string, marshalizer marshal.Marshalizer) {
-	err := api.Start(restApiInterface, marshalizer)
+func startGinServer(restApiInterface string, marshalizer marshal.Marshalizer, peersInfoHandler api.PeersInfoHandler) {
+	err := api.Start(restApiInterface, marshalizer, peersInfoHandler)
 	if err != nil {
 		log.LogIfError(err)
 	}
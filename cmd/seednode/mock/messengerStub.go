@@ -0,0 +1,64 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// MessengerStub -
+type MessengerStub struct {
+	IDCalled             func() core.PeerID
+	PeersCalled          func() []core.PeerID
+	ConnectedPeersCalled func() []core.PeerID
+	PeerAddressesCalled  func(pid core.PeerID) []string
+	ConnectToPeerCalled  func(address string) error
+}
+
+// ID -
+func (ms *MessengerStub) ID() core.PeerID {
+	if ms.IDCalled != nil {
+		return ms.IDCalled()
+	}
+
+	return ""
+}
+
+// Peers -
+func (ms *MessengerStub) Peers() []core.PeerID {
+	if ms.PeersCalled != nil {
+		return ms.PeersCalled()
+	}
+
+	return nil
+}
+
+// ConnectedPeers -
+func (ms *MessengerStub) ConnectedPeers() []core.PeerID {
+	if ms.ConnectedPeersCalled != nil {
+		return ms.ConnectedPeersCalled()
+	}
+
+	return nil
+}
+
+// PeerAddresses -
+func (ms *MessengerStub) PeerAddresses(pid core.PeerID) []string {
+	if ms.PeerAddressesCalled != nil {
+		return ms.PeerAddressesCalled(pid)
+	}
+
+	return nil
+}
+
+// ConnectToPeer -
+func (ms *MessengerStub) ConnectToPeer(address string) error {
+	if ms.ConnectToPeerCalled != nil {
+		return ms.ConnectToPeerCalled(address)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (ms *MessengerStub) IsInterfaceNil() bool {
+	return ms == nil
+}
@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/peerstore"
+)
+
+// PeersInfoHandlerStub -
+type PeersInfoHandlerStub struct {
+	KnownPeersCalled func() []peerstore.PeerInfo
+	StatisticsCalled func() peerstore.PeersStatistics
+}
+
+// KnownPeers -
+func (pihs *PeersInfoHandlerStub) KnownPeers() []peerstore.PeerInfo {
+	if pihs.KnownPeersCalled != nil {
+		return pihs.KnownPeersCalled()
+	}
+
+	return nil
+}
+
+// Statistics -
+func (pihs *PeersInfoHandlerStub) Statistics() peerstore.PeersStatistics {
+	if pihs.StatisticsCalled != nil {
+		return pihs.StatisticsCalled()
+	}
+
+	return peerstore.PeersStatistics{}
+}
+
+// IsInterfaceNil -
+func (pihs *PeersInfoHandlerStub) IsInterfaceNil() bool {
+	return pihs == nil
+}
@@ -0,0 +1,25 @@
+package peerstore
+
+import "errors"
+
+// ErrNilMessenger signals that a nil messenger has been provided
+var ErrNilMessenger = errors.New("nil messenger")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNilMessage signals that a nil message has been received
+var ErrNilMessage = errors.New("nil message")
+
+// ErrPeerIDMismatch signals that the peer ID from a heartbeat or peer authentication message does not match the
+// message originator
+var ErrPeerIDMismatch = errors.New("heartbeat peer ID does not match the message originator")
+
+// ErrMessageNotRelayed is returned after processing a heartbeat message so the seed node will not propagate it
+var ErrMessageNotRelayed = errors.New("message processed by the seed node, will not be relayed")
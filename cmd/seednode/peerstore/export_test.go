@@ -0,0 +1,15 @@
+package peerstore
+
+import "time"
+
+func (pt *peersTracker) Refresh() {
+	pt.refresh()
+}
+
+func (pt *peersTracker) Persist() {
+	pt.persist()
+}
+
+func (pt *peersTracker) SetGetTimeFunc(handler func() time.Time) {
+	pt.getTimeFunc = handler
+}
@@ -0,0 +1,15 @@
+package peerstore
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// P2PMessenger defines the subset of the messenger's functionality used by the peers tracker
+type P2PMessenger interface {
+	ID() core.PeerID
+	Peers() []core.PeerID
+	ConnectedPeers() []core.PeerID
+	PeerAddresses(pid core.PeerID) []string
+	ConnectToPeer(address string) error
+	IsInterfaceNil() bool
+}
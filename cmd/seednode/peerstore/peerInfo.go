@@ -0,0 +1,23 @@
+package peerstore
+
+// UnknownShard is the shard reported for the peers that did not broadcast any heartbeat message
+const UnknownShard = "unknown"
+
+// PeerInfo holds the information known by the seed node about a peer
+type PeerInfo struct {
+	Pid                    string   `json:"pid"`
+	Addresses              []string `json:"addresses"`
+	Shard                  string   `json:"shard"`
+	IsConnected            bool     `json:"isConnected"`
+	NumConnections         uint64   `json:"numConnections"`
+	FirstSeenTimestamp     int64    `json:"firstSeenTimestamp"`
+	LastSeenTimestamp      int64    `json:"lastSeenTimestamp"`
+	LastConnectedTimestamp int64    `json:"lastConnectedTimestamp"`
+}
+
+// PeersStatistics holds the aggregated connection statistics of the known peers
+type PeersStatistics struct {
+	NumKnownPeers          int            `json:"numKnownPeers"`
+	NumConnectedPeers      int            `json:"numConnectedPeers"`
+	ConnectedPeersPerShard map[string]int `json:"connectedPeersPerShard"`
+}
@@ -0,0 +1,700 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: peerRecord.proto
+
+package peerstore
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PeerRecord holds the persisted information the seed node learned about a peer
+type PeerRecord struct {
+	Pid                    []byte   `protobuf:"bytes,1,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	Addresses              []string `protobuf:"bytes,2,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+	IsShardKnown           bool     `protobuf:"varint,3,opt,name=IsShardKnown,proto3" json:"IsShardKnown,omitempty"`
+	ShardID                uint32   `protobuf:"varint,4,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	FirstSeenTimestamp     int64    `protobuf:"varint,5,opt,name=FirstSeenTimestamp,proto3" json:"FirstSeenTimestamp,omitempty"`
+	LastSeenTimestamp      int64    `protobuf:"varint,6,opt,name=LastSeenTimestamp,proto3" json:"LastSeenTimestamp,omitempty"`
+	LastConnectedTimestamp int64    `protobuf:"varint,7,opt,name=LastConnectedTimestamp,proto3" json:"LastConnectedTimestamp,omitempty"`
+	NumConnections         uint64   `protobuf:"varint,8,opt,name=NumConnections,proto3" json:"NumConnections,omitempty"`
+}
+
+func (m *PeerRecord) Reset()      { *m = PeerRecord{} }
+func (*PeerRecord) ProtoMessage() {}
+func (*PeerRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_472dd4e51f5f3010, []int{0}
+}
+func (m *PeerRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PeerRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerRecord.Merge(m, src)
+}
+func (m *PeerRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerRecord proto.InternalMessageInfo
+
+func (m *PeerRecord) GetPid() []byte {
+	if m != nil {
+		return m.Pid
+	}
+	return nil
+}
+
+func (m *PeerRecord) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *PeerRecord) GetIsShardKnown() bool {
+	if m != nil {
+		return m.IsShardKnown
+	}
+	return false
+}
+
+func (m *PeerRecord) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *PeerRecord) GetFirstSeenTimestamp() int64 {
+	if m != nil {
+		return m.FirstSeenTimestamp
+	}
+	return 0
+}
+
+func (m *PeerRecord) GetLastSeenTimestamp() int64 {
+	if m != nil {
+		return m.LastSeenTimestamp
+	}
+	return 0
+}
+
+func (m *PeerRecord) GetLastConnectedTimestamp() int64 {
+	if m != nil {
+		return m.LastConnectedTimestamp
+	}
+	return 0
+}
+
+func (m *PeerRecord) GetNumConnections() uint64 {
+	if m != nil {
+		return m.NumConnections
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PeerRecord)(nil), "proto.PeerRecord")
+}
+
+func init() { proto.RegisterFile("peerRecord.proto", fileDescriptor_472dd4e51f5f3010) }
+
+var fileDescriptor_472dd4e51f5f3010 = []byte{
+	// 317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xbd, 0x4e, 0x42, 0x31,
+	0x14, 0xc7, 0xef, 0xe1, 0xf2, 0xd9, 0xa0, 0xc1, 0x0e, 0xa6, 0x31, 0xe6, 0xe4, 0x86, 0xc1, 0xdc,
+	0x41, 0x61, 0x30, 0x71, 0x57, 0x8c, 0x09, 0xd1, 0x18, 0x52, 0x9c, 0xdc, 0x80, 0x5b, 0xe1, 0x0e,
+	0xb7, 0x25, 0x6d, 0x89, 0xab, 0x8f, 0xe0, 0x63, 0xf8, 0x08, 0x3e, 0x82, 0x23, 0x23, 0xa3, 0x94,
+	0xc5, 0x91, 0x47, 0x30, 0xd4, 0x0f, 0x22, 0xea, 0xd4, 0xf3, 0xff, 0xfd, 0xce, 0xbf, 0xcb, 0x21,
+	0xb5, 0xb1, 0x10, 0x9a, 0x8b, 0x81, 0xd2, 0x49, 0x63, 0xac, 0x95, 0x55, 0xb4, 0xe0, 0x9f, 0xbd,
+	0xa3, 0x61, 0x6a, 0x47, 0x93, 0x7e, 0x63, 0xa0, 0xb2, 0xe6, 0x50, 0x0d, 0x55, 0xd3, 0xe3, 0xfe,
+	0xe4, 0xce, 0x27, 0x1f, 0xfc, 0xf4, 0xd1, 0xaa, 0x3f, 0xe7, 0x08, 0xe9, 0x7c, 0x7f, 0x45, 0x6b,
+	0x24, 0xec, 0xa4, 0x09, 0x83, 0x08, 0xe2, 0x2a, 0x5f, 0x8d, 0x74, 0x9f, 0x54, 0x4e, 0x93, 0x44,
+	0x0b, 0x63, 0x84, 0x61, 0xb9, 0x28, 0x8c, 0x2b, 0x7c, 0x0d, 0x68, 0x9d, 0x54, 0xdb, 0xa6, 0x3b,
+	0xea, 0xe9, 0xe4, 0x52, 0xaa, 0x7b, 0xc9, 0xc2, 0x08, 0xe2, 0x32, 0xff, 0xc1, 0x28, 0x23, 0x25,
+	0x9f, 0xda, 0xe7, 0x2c, 0x1f, 0x41, 0xbc, 0xc5, 0xbf, 0x22, 0x6d, 0x10, 0x7a, 0x91, 0x6a, 0x63,
+	0xbb, 0x42, 0xc8, 0x9b, 0x34, 0x13, 0xc6, 0xf6, 0xb2, 0x31, 0x2b, 0x44, 0x10, 0x87, 0xfc, 0x0f,
+	0x43, 0x0f, 0xc9, 0xce, 0x55, 0x6f, 0x73, 0xbd, 0xe8, 0xd7, 0x7f, 0x0b, 0x7a, 0x42, 0x76, 0x57,
+	0xb0, 0xa5, 0xa4, 0x14, 0x03, 0x2b, 0x92, 0x75, 0xa5, 0xe4, 0x2b, 0xff, 0x58, 0x7a, 0x40, 0xb6,
+	0xaf, 0x27, 0xd9, 0xa7, 0x48, 0x95, 0x34, 0xac, 0x1c, 0x41, 0x9c, 0xe7, 0x1b, 0xf4, 0xac, 0x35,
+	0x9d, 0x63, 0x30, 0x9b, 0x63, 0xb0, 0x9c, 0x23, 0x3c, 0x38, 0x84, 0x27, 0x87, 0xf0, 0xe2, 0x10,
+	0xa6, 0x0e, 0x61, 0xe6, 0x10, 0x5e, 0x1d, 0xc2, 0x9b, 0xc3, 0x60, 0xe9, 0x10, 0x1e, 0x17, 0x18,
+	0x4c, 0x17, 0x18, 0xcc, 0x16, 0x18, 0xdc, 0x56, 0x56, 0xc7, 0x33, 0x56, 0x69, 0xd1, 0x2f, 0xfa,
+	0x33, 0x1c, 0xbf, 0x0f, 0x00, 0x00, 0x35, 0x25, 0xeb, 0xd0, 0x01, 0x00, 0x00,
+}
+
+func (this *PeerRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PeerRecord)
+	if !ok {
+		that2, ok := that.(PeerRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Pid, that1.Pid) {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	if this.IsShardKnown != that1.IsShardKnown {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if this.FirstSeenTimestamp != that1.FirstSeenTimestamp {
+		return false
+	}
+	if this.LastSeenTimestamp != that1.LastSeenTimestamp {
+		return false
+	}
+	if this.LastConnectedTimestamp != that1.LastConnectedTimestamp {
+		return false
+	}
+	if this.NumConnections != that1.NumConnections {
+		return false
+	}
+	return true
+}
+func (this *PeerRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&peerstore.PeerRecord{")
+	s = append(s, "Pid: "+fmt.Sprintf("%#v", this.Pid)+",\n")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	s = append(s, "IsShardKnown: "+fmt.Sprintf("%#v", this.IsShardKnown)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "FirstSeenTimestamp: "+fmt.Sprintf("%#v", this.FirstSeenTimestamp)+",\n")
+	s = append(s, "LastSeenTimestamp: "+fmt.Sprintf("%#v", this.LastSeenTimestamp)+",\n")
+	s = append(s, "LastConnectedTimestamp: "+fmt.Sprintf("%#v", this.LastConnectedTimestamp)+",\n")
+	s = append(s, "NumConnections: "+fmt.Sprintf("%#v", this.NumConnections)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringPeerRecord(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *PeerRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumConnections != 0 {
+		i = encodeVarintPeerRecord(dAtA, i, uint64(m.NumConnections))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.LastConnectedTimestamp != 0 {
+		i = encodeVarintPeerRecord(dAtA, i, uint64(m.LastConnectedTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastSeenTimestamp != 0 {
+		i = encodeVarintPeerRecord(dAtA, i, uint64(m.LastSeenTimestamp))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FirstSeenTimestamp != 0 {
+		i = encodeVarintPeerRecord(dAtA, i, uint64(m.FirstSeenTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintPeerRecord(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsShardKnown {
+		i--
+		if m.IsShardKnown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintPeerRecord(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Pid) > 0 {
+		i -= len(m.Pid)
+		copy(dAtA[i:], m.Pid)
+		i = encodeVarintPeerRecord(dAtA, i, uint64(len(m.Pid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPeerRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPeerRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PeerRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pid)
+	if l > 0 {
+		n += 1 + l + sovPeerRecord(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovPeerRecord(uint64(l))
+		}
+	}
+	if m.IsShardKnown {
+		n += 2
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovPeerRecord(uint64(m.ShardID))
+	}
+	if m.FirstSeenTimestamp != 0 {
+		n += 1 + sovPeerRecord(uint64(m.FirstSeenTimestamp))
+	}
+	if m.LastSeenTimestamp != 0 {
+		n += 1 + sovPeerRecord(uint64(m.LastSeenTimestamp))
+	}
+	if m.LastConnectedTimestamp != 0 {
+		n += 1 + sovPeerRecord(uint64(m.LastConnectedTimestamp))
+	}
+	if m.NumConnections != 0 {
+		n += 1 + sovPeerRecord(uint64(m.NumConnections))
+	}
+	return n
+}
+
+func sovPeerRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPeerRecord(x uint64) (n int) {
+	return sovPeerRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *PeerRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PeerRecord{`,
+		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`IsShardKnown:` + fmt.Sprintf("%v", this.IsShardKnown) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`FirstSeenTimestamp:` + fmt.Sprintf("%v", this.FirstSeenTimestamp) + `,`,
+		`LastSeenTimestamp:` + fmt.Sprintf("%v", this.LastSeenTimestamp) + `,`,
+		`LastConnectedTimestamp:` + fmt.Sprintf("%v", this.LastConnectedTimestamp) + `,`,
+		`NumConnections:` + fmt.Sprintf("%v", this.NumConnections) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringPeerRecord(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *PeerRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeerRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pid = append(m.Pid[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pid == nil {
+				m.Pid = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsShardKnown", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsShardKnown = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstSeenTimestamp", wireType)
+			}
+			m.FirstSeenTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstSeenTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenTimestamp", wireType)
+			}
+			m.LastSeenTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSeenTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastConnectedTimestamp", wireType)
+			}
+			m.LastConnectedTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastConnectedTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumConnections", wireType)
+			}
+			m.NumConnections = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumConnections |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeerRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPeerRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPeerRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPeerRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeerRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPeerRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPeerRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPeerRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPeerRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPeerRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPeerRecord = fmt.Errorf("proto: unexpected end of group")
+)
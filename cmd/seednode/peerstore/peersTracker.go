@@ -0,0 +1,409 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. peerRecord.proto
+package peerstore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("seednode/peerstore")
+
+const minRefreshInterval = time.Second
+
+// ArgPeersTracker represents the argument for the peers tracker constructor
+type ArgPeersTracker struct {
+	Messenger       P2PMessenger
+	Storer          storage.Storer
+	Marshalizer     marshal.Marshalizer
+	RefreshInterval time.Duration
+	RecordsExpiry   time.Duration
+}
+
+type peersTracker struct {
+	messenger       P2PMessenger
+	storer          storage.Storer
+	marshalizer     marshal.Marshalizer
+	refreshInterval time.Duration
+	recordsExpiry   time.Duration
+	mutRecords      sync.RWMutex
+	records         map[core.PeerID]*PeerRecord
+	connectedPeers  map[core.PeerID]struct{}
+	getTimeFunc     func() time.Time
+	cancelFunc      context.CancelFunc
+}
+
+// NewPeersTracker creates a component that keeps track of all the peers the seed node learned about, persisting
+// them in the provided storer. The records saved by a previous run are reloaded on construction.
+func NewPeersTracker(arg ArgPeersTracker) (*peersTracker, error) {
+	if check.IfNil(arg.Messenger) {
+		return nil, ErrNilMessenger
+	}
+	if check.IfNil(arg.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if arg.RefreshInterval < minRefreshInterval {
+		return nil, fmt.Errorf("%w for RefreshInterval: provided %v, minimum %v",
+			ErrInvalidValue, arg.RefreshInterval, minRefreshInterval)
+	}
+	if arg.RecordsExpiry < arg.RefreshInterval {
+		return nil, fmt.Errorf("%w for RecordsExpiry: provided %v, should be at least the refresh interval %v",
+			ErrInvalidValue, arg.RecordsExpiry, arg.RefreshInterval)
+	}
+
+	pt := &peersTracker{
+		messenger:       arg.Messenger,
+		storer:          arg.Storer,
+		marshalizer:     arg.Marshalizer,
+		refreshInterval: arg.RefreshInterval,
+		recordsExpiry:   arg.RecordsExpiry,
+		records:         make(map[core.PeerID]*PeerRecord),
+		connectedPeers:  make(map[core.PeerID]struct{}),
+		getTimeFunc:     time.Now,
+	}
+	pt.loadFromStorage()
+
+	return pt, nil
+}
+
+func (pt *peersTracker) loadFromStorage() {
+	expiredKeys := make([][]byte, 0)
+	pt.storer.RangeKeys(func(key []byte, val []byte) bool {
+		record := &PeerRecord{}
+		err := pt.marshalizer.Unmarshal(record, val)
+		if err != nil {
+			log.Debug("peersTracker.loadFromStorage: unmarshal", "error", err)
+			expiredKeys = append(expiredKeys, key)
+			return true
+		}
+		if pt.isExpired(record) {
+			expiredKeys = append(expiredKeys, key)
+			return true
+		}
+
+		pt.records[core.PeerID(record.Pid)] = record
+		return true
+	})
+
+	for _, key := range expiredKeys {
+		err := pt.storer.Remove(key)
+		log.LogIfError(err, "peersTracker.loadFromStorage: remove", core.PeerID(key).Pretty())
+	}
+
+	log.Debug("peersTracker: loaded known peers", "num records", len(pt.records))
+}
+
+func (pt *peersTracker) isExpired(record *PeerRecord) bool {
+	lastActivity := record.LastSeenTimestamp
+	if lastActivity == 0 {
+		lastActivity = record.FirstSeenTimestamp
+	}
+
+	return pt.getTimeFunc().Add(-pt.recordsExpiry).Unix() > lastActivity
+}
+
+// StartTracking starts the go routine that periodically refreshes the peers information from the messenger
+// and persists it. The go routine is stopped when calling Close.
+func (pt *peersTracker) StartTracking() {
+	var ctx context.Context
+	ctx, pt.cancelFunc = context.WithCancel(context.Background())
+
+	go pt.trackingLoop(ctx)
+}
+
+func (pt *peersTracker) trackingLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("peersTracker's go routine is stopping...")
+			return
+		case <-time.After(pt.refreshInterval):
+		}
+
+		pt.refresh()
+		pt.persist()
+	}
+}
+
+// refresh updates the records with the peers found in the messenger's peerstore, including the ones learned
+// through the kad-dht discovery, and with their connection state
+func (pt *peersTracker) refresh() {
+	selfID := pt.messenger.ID()
+	now := pt.getTimeFunc().Unix()
+	connectedPeers := make(map[core.PeerID]struct{})
+	for _, pid := range pt.messenger.ConnectedPeers() {
+		connectedPeers[pid] = struct{}{}
+	}
+
+	pt.mutRecords.Lock()
+	defer pt.mutRecords.Unlock()
+
+	for _, pid := range pt.messenger.Peers() {
+		if pid == selfID {
+			continue
+		}
+
+		record := pt.getOrCreateRecordNoLock(pid)
+		addresses := pt.messenger.PeerAddresses(pid)
+		if len(addresses) > 0 {
+			record.Addresses = addresses
+		}
+
+		_, isConnected := connectedPeers[pid]
+		if !isConnected {
+			continue
+		}
+
+		record.LastSeenTimestamp = now
+		_, wasConnected := pt.connectedPeers[pid]
+		if !wasConnected {
+			record.NumConnections++
+			record.LastConnectedTimestamp = now
+		}
+	}
+
+	pt.connectedPeers = connectedPeers
+}
+
+func (pt *peersTracker) getOrCreateRecordNoLock(pid core.PeerID) *PeerRecord {
+	record, found := pt.records[pid]
+	if !found {
+		record = &PeerRecord{
+			Pid:                pid.Bytes(),
+			FirstSeenTimestamp: pt.getTimeFunc().Unix(),
+		}
+		pt.records[pid] = record
+	}
+
+	return record
+}
+
+// persist saves all the records into the storer, removing the expired ones
+func (pt *peersTracker) persist() {
+	pt.mutRecords.Lock()
+	defer pt.mutRecords.Unlock()
+
+	for pid, record := range pt.records {
+		_, isConnected := pt.connectedPeers[pid]
+		if !isConnected && pt.isExpired(record) {
+			delete(pt.records, pid)
+			err := pt.storer.Remove(pid.Bytes())
+			log.LogIfError(err, "peersTracker.persist: remove", pid.Pretty())
+			continue
+		}
+
+		buff, err := pt.marshalizer.Marshal(record)
+		if err != nil {
+			log.Debug("peersTracker.persist: marshal", "pid", pid.Pretty(), "error", err)
+			continue
+		}
+
+		err = pt.storer.Put(pid.Bytes(), buff)
+		log.LogIfError(err, "peersTracker.persist: put", pid.Pretty())
+	}
+}
+
+// ReconnectKnownPeers tries to connect to the peers loaded from the storage that are not connected yet. For each
+// peer, the known addresses are tried in order until the first successful connection
+func (pt *peersTracker) ReconnectKnownPeers() {
+	addressesToTry := make(map[core.PeerID][]string)
+	pt.mutRecords.RLock()
+	for pid, record := range pt.records {
+		_, isConnected := pt.connectedPeers[pid]
+		if isConnected || len(record.Addresses) == 0 {
+			continue
+		}
+
+		addressesToTry[pid] = append(make([]string, 0, len(record.Addresses)), record.Addresses...)
+	}
+	pt.mutRecords.RUnlock()
+
+	numReconnected := 0
+	for pid, addresses := range addressesToTry {
+		for _, address := range addresses {
+			err := pt.messenger.ConnectToPeer(address)
+			if err != nil {
+				log.Trace("peersTracker.ReconnectKnownPeers", "pid", pid.Pretty(), "address", address, "error", err)
+				continue
+			}
+
+			numReconnected++
+			break
+		}
+	}
+
+	log.Debug("peersTracker: reconnected to known peers",
+		"num tried", len(addressesToTry),
+		"num reconnected", numReconnected,
+	)
+}
+
+// ProcessReceivedMessage extracts the shard of the originator from the heartbeat v1 and the peer authentication
+// messages and marks the originator of a heartbeat v2 message as seen. It always returns an error on success paths as
+// well, so the seed node will only observe the heartbeat topics without relaying their messages
+func (pt *peersTracker) ProcessReceivedMessage(message p2p.MessageP2P, _ core.PeerID) error {
+	if check.IfNil(message) {
+		return ErrNilMessage
+	}
+
+	var err error
+	switch getTopic(message) {
+	case core.PeerAuthenticationTopic:
+		err = pt.processPeerAuthentication(message)
+	case core.HeartbeatV2Topic:
+		err = pt.processHeartbeatV2(message)
+	default:
+		err = pt.processHeartbeat(message)
+	}
+	if err != nil {
+		return err
+	}
+
+	return ErrMessageNotRelayed
+}
+
+func getTopic(message p2p.MessageP2P) string {
+	topics := message.Topics()
+	if len(topics) == 0 {
+		return ""
+	}
+
+	return topics[0]
+}
+
+func (pt *peersTracker) processHeartbeat(message p2p.MessageP2P) error {
+	heartbeat := &heartbeatData.Heartbeat{}
+	err := pt.marshalizer.Unmarshal(heartbeat, message.Data())
+	if err != nil {
+		return err
+	}
+
+	return pt.setShard(message.Peer(), heartbeat.Pid, heartbeat.ShardID)
+}
+
+func (pt *peersTracker) processPeerAuthentication(message p2p.MessageP2P) error {
+	peerAuthentication := &heartbeatData.PeerAuthentication{}
+	err := pt.marshalizer.Unmarshal(peerAuthentication, message.Data())
+	if err != nil {
+		return err
+	}
+
+	return pt.setShard(message.Peer(), peerAuthentication.Pid, peerAuthentication.ShardID)
+}
+
+func (pt *peersTracker) setShard(pid core.PeerID, messagePid []byte, shardID uint32) error {
+	if core.PeerID(messagePid) != pid {
+		return ErrPeerIDMismatch
+	}
+
+	pt.mutRecords.Lock()
+	record := pt.getOrCreateRecordNoLock(pid)
+	record.IsShardKnown = true
+	record.ShardID = shardID
+	pt.mutRecords.Unlock()
+
+	return nil
+}
+
+// processHeartbeatV2 marks the originator as seen as the compact heartbeat v2 message carries no peer information
+func (pt *peersTracker) processHeartbeatV2(message p2p.MessageP2P) error {
+	heartbeatV2 := &heartbeatData.HeartbeatV2{}
+	err := pt.marshalizer.Unmarshal(heartbeatV2, message.Data())
+	if err != nil {
+		return err
+	}
+
+	pt.mutRecords.Lock()
+	record := pt.getOrCreateRecordNoLock(message.Peer())
+	record.LastSeenTimestamp = pt.getTimeFunc().Unix()
+	pt.mutRecords.Unlock()
+
+	return nil
+}
+
+// KnownPeers returns the information about all known peers, sorted by their peer ID
+func (pt *peersTracker) KnownPeers() []PeerInfo {
+	pt.mutRecords.RLock()
+	peers := make([]PeerInfo, 0, len(pt.records))
+	for pid, record := range pt.records {
+		_, isConnected := pt.connectedPeers[pid]
+		peers = append(peers, PeerInfo{
+			Pid:                    pid.Pretty(),
+			Addresses:              append(make([]string, 0, len(record.Addresses)), record.Addresses...),
+			Shard:                  shardString(record),
+			IsConnected:            isConnected,
+			NumConnections:         record.NumConnections,
+			FirstSeenTimestamp:     record.FirstSeenTimestamp,
+			LastSeenTimestamp:      record.LastSeenTimestamp,
+			LastConnectedTimestamp: record.LastConnectedTimestamp,
+		})
+	}
+	pt.mutRecords.RUnlock()
+
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].Pid < peers[j].Pid
+	})
+
+	return peers
+}
+
+// Statistics returns the aggregated connection statistics of the known peers
+func (pt *peersTracker) Statistics() PeersStatistics {
+	pt.mutRecords.RLock()
+	defer pt.mutRecords.RUnlock()
+
+	stats := PeersStatistics{
+		NumKnownPeers:          len(pt.records),
+		NumConnectedPeers:      len(pt.connectedPeers),
+		ConnectedPeersPerShard: make(map[string]int),
+	}
+	for pid := range pt.connectedPeers {
+		shard := UnknownShard
+		record, found := pt.records[pid]
+		if found {
+			shard = shardString(record)
+		}
+
+		stats.ConnectedPeersPerShard[shard]++
+	}
+
+	return stats
+}
+
+func shardString(record *PeerRecord) string {
+	if !record.IsShardKnown {
+		return UnknownShard
+	}
+
+	return core.GetShardIDString(record.ShardID)
+}
+
+// Close stops the tracking go routine and saves the records for the last time
+func (pt *peersTracker) Close() error {
+	if pt.cancelFunc != nil {
+		pt.cancelFunc()
+	}
+
+	pt.refresh()
+	pt.persist()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pt *peersTracker) IsInterfaceNil() bool {
+	return pt == nil
+}
@@ -0,0 +1,350 @@
+package peerstore_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/mock"
+	"github.com/ElrondNetwork/elrond-go/cmd/seednode/peerstore"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	heartbeatData "github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const selfPid = core.PeerID("self")
+const pid1 = core.PeerID("pid1")
+const pid2 = core.PeerID("pid2")
+
+type messengerState struct {
+	mut       sync.Mutex
+	peers     []core.PeerID
+	connected []core.PeerID
+}
+
+func (ms *messengerState) set(peers []core.PeerID, connected []core.PeerID) {
+	ms.mut.Lock()
+	ms.peers = peers
+	ms.connected = connected
+	ms.mut.Unlock()
+}
+
+func createMessengerStub(state *messengerState) *mock.MessengerStub {
+	return &mock.MessengerStub{
+		IDCalled: func() core.PeerID {
+			return selfPid
+		},
+		PeersCalled: func() []core.PeerID {
+			state.mut.Lock()
+			defer state.mut.Unlock()
+
+			return state.peers
+		},
+		ConnectedPeersCalled: func() []core.PeerID {
+			state.mut.Lock()
+			defer state.mut.Unlock()
+
+			return state.connected
+		},
+		PeerAddressesCalled: func(pid core.PeerID) []string {
+			return []string{"/ip4/127.0.0.1/tcp/10000/p2p/" + string(pid)}
+		},
+	}
+}
+
+func createMockArgPeersTracker(storer *genericmocks.StorerMock, state *messengerState) peerstore.ArgPeersTracker {
+	return peerstore.ArgPeersTracker{
+		Messenger:       createMessengerStub(state),
+		Storer:          storer,
+		Marshalizer:     &marshal.GogoProtoMarshalizer{},
+		RefreshInterval: time.Minute,
+		RecordsExpiry:   time.Hour,
+	}
+}
+
+func createHeartbeatMessage(t *testing.T, pid core.PeerID, heartbeatPid core.PeerID, shardID uint32) *mock.P2PMessageMock {
+	buff, err := (&marshal.GogoProtoMarshalizer{}).Marshal(&heartbeatData.Heartbeat{
+		Pid:     heartbeatPid.Bytes(),
+		ShardID: shardID,
+	})
+	require.Nil(t, err)
+
+	return &mock.P2PMessageMock{
+		DataField:   buff,
+		PeerField:   pid,
+		TopicsField: []string{core.HeartbeatTopic},
+	}
+}
+
+func createPeerAuthenticationMessage(t *testing.T, pid core.PeerID, peerAuthenticationPid core.PeerID, shardID uint32) *mock.P2PMessageMock {
+	buff, err := (&marshal.GogoProtoMarshalizer{}).Marshal(&heartbeatData.PeerAuthentication{
+		Pid:     peerAuthenticationPid.Bytes(),
+		ShardID: shardID,
+	})
+	require.Nil(t, err)
+
+	return &mock.P2PMessageMock{
+		DataField:   buff,
+		PeerField:   pid,
+		TopicsField: []string{core.PeerAuthenticationTopic},
+	}
+}
+
+func TestNewPeersTracker_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("", 0)
+	arg := createMockArgPeersTracker(storer, &messengerState{})
+	arg.Messenger = nil
+	pt, err := peerstore.NewPeersTracker(arg)
+	assert.True(t, check.IfNil(pt))
+	assert.Equal(t, peerstore.ErrNilMessenger, err)
+
+	arg = createMockArgPeersTracker(storer, &messengerState{})
+	arg.Storer = nil
+	pt, err = peerstore.NewPeersTracker(arg)
+	assert.True(t, check.IfNil(pt))
+	assert.Equal(t, peerstore.ErrNilStorer, err)
+
+	arg = createMockArgPeersTracker(storer, &messengerState{})
+	arg.Marshalizer = nil
+	pt, err = peerstore.NewPeersTracker(arg)
+	assert.True(t, check.IfNil(pt))
+	assert.Equal(t, peerstore.ErrNilMarshalizer, err)
+
+	arg = createMockArgPeersTracker(storer, &messengerState{})
+	arg.RefreshInterval = time.Millisecond
+	pt, err = peerstore.NewPeersTracker(arg)
+	assert.True(t, check.IfNil(pt))
+	assert.True(t, errors.Is(err, peerstore.ErrInvalidValue))
+
+	arg = createMockArgPeersTracker(storer, &messengerState{})
+	arg.RecordsExpiry = time.Second
+	pt, err = peerstore.NewPeersTracker(arg)
+	assert.True(t, check.IfNil(pt))
+	assert.True(t, errors.Is(err, peerstore.ErrInvalidValue))
+}
+
+func TestNewPeersTracker_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pt, err := peerstore.NewPeersTracker(createMockArgPeersTracker(genericmocks.NewStorerMock("", 0), &messengerState{}))
+	assert.False(t, check.IfNil(pt))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(pt.KnownPeers()))
+}
+
+func TestPeersTracker_RefreshShouldTrackPeersAndConnections(t *testing.T) {
+	t.Parallel()
+
+	state := &messengerState{}
+	pt, _ := peerstore.NewPeersTracker(createMockArgPeersTracker(genericmocks.NewStorerMock("", 0), state))
+	currentTime := time.Unix(1000, 0)
+	pt.SetGetTimeFunc(func() time.Time {
+		return currentTime
+	})
+
+	state.set([]core.PeerID{selfPid, pid1, pid2}, []core.PeerID{pid1})
+	pt.Refresh()
+
+	currentTime = time.Unix(2000, 0)
+	state.set([]core.PeerID{selfPid, pid1, pid2}, []core.PeerID{pid1, pid2})
+	pt.Refresh()
+
+	currentTime = time.Unix(3000, 0)
+	state.set([]core.PeerID{selfPid, pid1, pid2}, []core.PeerID{pid2})
+	pt.Refresh()
+
+	currentTime = time.Unix(4000, 0)
+	state.set([]core.PeerID{selfPid, pid1, pid2}, []core.PeerID{pid1, pid2})
+	pt.Refresh()
+
+	peers := pt.KnownPeers()
+	require.Equal(t, 2, len(peers))
+	assert.Equal(t, pid1.Pretty(), peers[0].Pid)
+	assert.Equal(t, []string{"/ip4/127.0.0.1/tcp/10000/p2p/pid1"}, peers[0].Addresses)
+	assert.Equal(t, peerstore.UnknownShard, peers[0].Shard)
+	assert.True(t, peers[0].IsConnected)
+	assert.Equal(t, uint64(2), peers[0].NumConnections)
+	assert.Equal(t, int64(1000), peers[0].FirstSeenTimestamp)
+	assert.Equal(t, int64(4000), peers[0].LastSeenTimestamp)
+	assert.Equal(t, int64(4000), peers[0].LastConnectedTimestamp)
+
+	assert.Equal(t, pid2.Pretty(), peers[1].Pid)
+	assert.Equal(t, uint64(1), peers[1].NumConnections)
+	assert.Equal(t, int64(1000), peers[1].FirstSeenTimestamp)
+	assert.Equal(t, int64(4000), peers[1].LastSeenTimestamp)
+	assert.Equal(t, int64(2000), peers[1].LastConnectedTimestamp)
+}
+
+func TestPeersTracker_ProcessReceivedMessage(t *testing.T) {
+	t.Parallel()
+
+	state := &messengerState{}
+	pt, _ := peerstore.NewPeersTracker(createMockArgPeersTracker(genericmocks.NewStorerMock("", 0), state))
+
+	err := pt.ProcessReceivedMessage(nil, pid1)
+	assert.Equal(t, peerstore.ErrNilMessage, err)
+
+	err = pt.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: []byte("invalid"), PeerField: pid1}, pid1)
+	assert.NotNil(t, err)
+
+	err = pt.ProcessReceivedMessage(createHeartbeatMessage(t, pid1, pid2, 1), pid1)
+	assert.Equal(t, peerstore.ErrPeerIDMismatch, err)
+	assert.Equal(t, 0, len(pt.KnownPeers()))
+
+	err = pt.ProcessReceivedMessage(createHeartbeatMessage(t, pid1, pid1, 1), pid1)
+	assert.Equal(t, peerstore.ErrMessageNotRelayed, err)
+	err = pt.ProcessReceivedMessage(createHeartbeatMessage(t, pid2, pid2, core.MetachainShardId), pid1)
+	assert.Equal(t, peerstore.ErrMessageNotRelayed, err)
+
+	state.set([]core.PeerID{pid1, pid2}, []core.PeerID{pid1, pid2})
+	pt.Refresh()
+
+	peers := pt.KnownPeers()
+	require.Equal(t, 2, len(peers))
+	assert.Equal(t, "1", peers[0].Shard)
+	assert.Equal(t, "metachain", peers[1].Shard)
+
+	stats := pt.Statistics()
+	assert.Equal(t, 2, stats.NumKnownPeers)
+	assert.Equal(t, 2, stats.NumConnectedPeers)
+	assert.Equal(t, map[string]int{"1": 1, "metachain": 1}, stats.ConnectedPeersPerShard)
+}
+
+func TestPeersTracker_ProcessReceivedMessageHeartbeatV2(t *testing.T) {
+	t.Parallel()
+
+	state := &messengerState{}
+	pt, _ := peerstore.NewPeersTracker(createMockArgPeersTracker(genericmocks.NewStorerMock("", 0), state))
+
+	err := pt.ProcessReceivedMessage(createPeerAuthenticationMessage(t, pid1, pid2, 1), pid1)
+	assert.Equal(t, peerstore.ErrPeerIDMismatch, err)
+	assert.Equal(t, 0, len(pt.KnownPeers()))
+
+	err = pt.ProcessReceivedMessage(createPeerAuthenticationMessage(t, pid1, pid1, 2), pid1)
+	assert.Equal(t, peerstore.ErrMessageNotRelayed, err)
+
+	err = pt.ProcessReceivedMessage(&mock.P2PMessageMock{
+		DataField:   []byte("invalid"),
+		PeerField:   pid2,
+		TopicsField: []string{core.HeartbeatV2Topic},
+	}, pid1)
+	assert.NotNil(t, err)
+
+	buff, _ := (&marshal.GogoProtoMarshalizer{}).Marshal(&heartbeatData.HeartbeatV2{Nonce: 1, Timestamp: 1})
+	currentTime := time.Unix(1000, 0)
+	pt.SetGetTimeFunc(func() time.Time {
+		return currentTime
+	})
+	err = pt.ProcessReceivedMessage(&mock.P2PMessageMock{
+		DataField:   buff,
+		PeerField:   pid2,
+		TopicsField: []string{core.HeartbeatV2Topic},
+	}, pid1)
+	assert.Equal(t, peerstore.ErrMessageNotRelayed, err)
+
+	peers := pt.KnownPeers()
+	require.Equal(t, 2, len(peers))
+	assert.Equal(t, "2", peers[0].Shard)
+	assert.Equal(t, peerstore.UnknownShard, peers[1].Shard)
+	assert.Equal(t, currentTime.Unix(), peers[1].LastSeenTimestamp)
+}
+
+func TestPeersTracker_PersistedPeersShouldBeReloadedAndReconnected(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("", 0)
+	state := &messengerState{}
+	pt, _ := peerstore.NewPeersTracker(createMockArgPeersTracker(storer, state))
+	_ = pt.ProcessReceivedMessage(createHeartbeatMessage(t, pid1, pid1, 2), pid1)
+	state.set([]core.PeerID{selfPid, pid1, pid2}, []core.PeerID{pid1, pid2})
+	pt.Refresh()
+	pt.Persist()
+
+	connectedAddresses := make([]string, 0)
+	restartedState := &messengerState{}
+	arg := createMockArgPeersTracker(storer, restartedState)
+	arg.Messenger.(*mock.MessengerStub).ConnectToPeerCalled = func(address string) error {
+		connectedAddresses = append(connectedAddresses, address)
+		return nil
+	}
+	reloaded, err := peerstore.NewPeersTracker(arg)
+	require.Nil(t, err)
+
+	peers := reloaded.KnownPeers()
+	require.Equal(t, 2, len(peers))
+	assert.Equal(t, "2", peers[0].Shard)
+	assert.False(t, peers[0].IsConnected)
+	assert.Equal(t, uint64(1), peers[0].NumConnections)
+
+	reloaded.ReconnectKnownPeers()
+	assert.Equal(t, 2, len(connectedAddresses))
+	assert.Contains(t, connectedAddresses, "/ip4/127.0.0.1/tcp/10000/p2p/pid1")
+	assert.Contains(t, connectedAddresses, "/ip4/127.0.0.1/tcp/10000/p2p/pid2")
+}
+
+func TestPeersTracker_ExpiredRecordsShouldBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("", 0)
+	state := &messengerState{}
+	pt, _ := peerstore.NewPeersTracker(createMockArgPeersTracker(storer, state))
+	currentTime := time.Now()
+	pt.SetGetTimeFunc(func() time.Time {
+		return currentTime
+	})
+
+	state.set([]core.PeerID{pid1, pid2}, []core.PeerID{pid1})
+	pt.Refresh()
+	pt.Persist()
+
+	currentTime = currentTime.Add(2 * time.Hour)
+	state.set([]core.PeerID{pid1}, []core.PeerID{})
+	pt.Refresh()
+	pt.Persist()
+
+	assert.Equal(t, 0, len(pt.KnownPeers()))
+	assert.NotNil(t, storer.Has(pid1.Bytes()))
+	assert.NotNil(t, storer.Has(pid2.Bytes()))
+}
+
+func TestPeersTracker_ConnectedPeerShouldNotExpire(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("", 0)
+	state := &messengerState{}
+	pt, _ := peerstore.NewPeersTracker(createMockArgPeersTracker(storer, state))
+	currentTime := time.Now()
+	pt.SetGetTimeFunc(func() time.Time {
+		return currentTime
+	})
+
+	state.set([]core.PeerID{pid1}, []core.PeerID{pid1})
+	pt.Refresh()
+
+	currentTime = currentTime.Add(2 * time.Hour)
+	pt.Persist()
+
+	assert.Equal(t, 1, len(pt.KnownPeers()))
+	assert.Nil(t, storer.Has(pid1.Bytes()))
+}
+
+func TestPeersTracker_CloseShouldPersist(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("", 0)
+	state := &messengerState{}
+	state.set([]core.PeerID{pid1}, []core.PeerID{pid1})
+	pt, _ := peerstore.NewPeersTracker(createMockArgPeersTracker(storer, state))
+	pt.StartTracking()
+
+	err := pt.Close()
+	assert.Nil(t, err)
+	assert.Nil(t, storer.Has(pid1.Bytes()))
+}
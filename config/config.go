@@ -11,7 +11,7 @@ type CacheConfig struct {
 	Shards               uint32
 }
 
-//HeadersPoolConfig will map the headers cache configuration
+// HeadersPoolConfig will map the headers cache configuration
 type HeadersPoolConfig struct {
 	MaxHeadersPerShard            int
 	NumElementsToRemoveOnEviction int
@@ -51,6 +51,13 @@ type TypeConfig struct {
 	Type string
 }
 
+// ConsensusConfig holds the consensus type and its optional features
+type ConsensusConfig struct {
+	Type                       string
+	PipeliningEnabled          bool
+	ValidatorDirectSendEnabled bool
+}
+
 // MarshalizerConfig holds the marshalizer related configuration
 type MarshalizerConfig struct {
 	Type string
@@ -107,6 +114,8 @@ type Config struct {
 	MetaHdrNonceHashStorage    StorageConfig
 	StatusMetricsStorage       StorageConfig
 	ReceiptsStorage            StorageConfig
+	PeerReputationStorage      StorageConfig
+	SlashingHistoryStorage     StorageConfig
 
 	BootstrapStorage StorageConfig
 	MetaBlockStorage StorageConfig
@@ -147,7 +156,7 @@ type Config struct {
 	Heartbeat           HeartbeatConfig
 	ValidatorStatistics ValidatorStatisticsConfig
 	GeneralSettings     GeneralSettingsConfig
-	Consensus           TypeConfig
+	Consensus           ConsensusConfig
 	StoragePruning      StoragePruningConfig
 	TxLogsStorage       StorageConfig
 
@@ -164,6 +173,7 @@ type Config struct {
 	DbLookupExtensions    DbLookupExtensionsConfig
 	Versions              VersionsConfig
 	Logs                  LogsConfig
+	SeedNode              SeedNodeConfig
 }
 
 // LogsConfig will hold settings related to the logging sub-system
@@ -171,6 +181,14 @@ type LogsConfig struct {
 	LogFileLifeSpanInSec int
 }
 
+// SeedNodeConfig will hold the settings used by the seed node when keeping track of the known peers
+type SeedNodeConfig struct {
+	KnownPeersStorage         StorageConfig
+	RefreshIntervalInSec      uint32
+	RecordsExpiryInHours      uint32
+	TrackShardsFromHeartbeats bool
+}
+
 // StoragePruningConfig will hold settings related to storage pruning
 type StoragePruningConfig struct {
 	Enabled             bool
@@ -187,12 +205,16 @@ type ResourceStatsConfig struct {
 
 // HeartbeatConfig will hold all heartbeat settings
 type HeartbeatConfig struct {
-	MinTimeToWaitBetweenBroadcastsInSec int
-	MaxTimeToWaitBetweenBroadcastsInSec int
-	DurationToConsiderUnresponsiveInSec int
-	HeartbeatRefreshIntervalInSec       uint32
-	HideInactiveValidatorIntervalInSec  uint32
-	HeartbeatStorage                    StorageConfig
+	MinTimeToWaitBetweenBroadcastsInSec     int
+	MaxTimeToWaitBetweenBroadcastsInSec     int
+	DurationToConsiderUnresponsiveInSec     int
+	HeartbeatRefreshIntervalInSec           uint32
+	HideInactiveValidatorIntervalInSec      uint32
+	SendHeartbeatV2                         bool
+	StopSendingHeartbeatV1                  bool
+	PeerAuthenticationTimeBetweenSendsInSec int
+	PeerAuthenticationExpiryInSec           int
+	HeartbeatStorage                        StorageConfig
 }
 
 // ValidatorStatisticsConfig will hold validator statistics specific settings
@@ -207,6 +229,7 @@ type GeneralSettingsConfig struct {
 	StartInEpochEnabled                    bool
 	SCDeployEnableEpoch                    uint32
 	BuiltInFunctionsEnableEpoch            uint32
+	BuiltInFunctionsActivationEpochs       []BuiltInFunctionActivationConfig
 	RelayedTransactionsEnableEpoch         uint32
 	PenalizedTooMuchGasEnableEpoch         uint32
 	SwitchJailWaitingEnableEpoch           uint32
@@ -215,10 +238,17 @@ type GeneralSettingsConfig struct {
 	GenesisString                          string
 }
 
+// BuiltInFunctionActivationConfig will hold the epoch from which a built in function can be called
+type BuiltInFunctionActivationConfig struct {
+	Name  string
+	Epoch uint32
+}
+
 // FacadeConfig will hold different configuration option that will be passed to the main ElrondFacade
 type FacadeConfig struct {
-	RestApiInterface string
-	PprofEnabled     bool
+	RestApiInterface    string
+	PprofEnabled        bool
+	AntifloodAdminToken string
 }
 
 // StateTriesConfig will hold information about state tries
@@ -235,6 +265,8 @@ type TrieStorageManagerConfig struct {
 	PruningBufferLen   uint32
 	SnapshotsBufferLen uint32
 	MaxSnapshots       uint8
+	PruningStrategy    string
+	ReferenceCountDB   DBConfig
 }
 
 // EndpointsThrottlersConfig holds a pair of an endpoint and its maximum number of simultaneous go routines
@@ -289,6 +321,37 @@ type AntifloodConfig struct {
 	WebServer                 WebServerAntifloodConfig
 	Topic                     TopicAntifloodConfig
 	TxAccumulator             TxAccumulatorConfig
+	RuntimeLimits             AntifloodRuntimeLimitsConfig
+	Adaptive                  AdaptiveAntifloodConfig
+}
+
+// AdaptiveAntifloodConfig will hold the settings used to adapt the input flood preventers quotas to the node's load
+type AdaptiveAntifloodConfig struct {
+	Enabled            bool
+	LowLoadPercent     uint32
+	HighLoadPercent    uint32
+	MaxReservedPercent float32
+}
+
+// AntifloodRuntimeLimitsConfig will hold the settings of the admin API able to change the antiflood limits at runtime
+type AntifloodRuntimeLimitsConfig struct {
+	AdminToken       string
+	OverrideFilePath string
+}
+
+// FloodPreventerLimitsConfig will hold the flood preventer parameters that can be changed at runtime
+type FloodPreventerLimitsConfig struct {
+	ReservedPercent float32
+	PeerMaxInput    AntifloodLimitsConfig
+}
+
+// AntifloodLimitsOverrideConfig will hold the antiflood limits set at runtime. They override the values
+// loaded from the main config file
+type AntifloodLimitsOverrideConfig struct {
+	FastReacting FloodPreventerLimitsConfig
+	SlowReacting FloodPreventerLimitsConfig
+	OutOfSpecs   FloodPreventerLimitsConfig
+	Topic        TopicAntifloodConfig
 }
 
 // FloodPreventerConfig will hold all flood preventer parameters
@@ -359,6 +422,7 @@ type DbLookupExtensionsConfig struct {
 type DebugConfig struct {
 	InterceptorResolver InterceptorResolverDebugConfig
 	Antiflood           AntifloodDebugConfig
+	Consensus           ConsensusDebugConfig
 }
 
 // HealthServiceConfig will hold health service (monitoring) configuration
@@ -389,6 +453,12 @@ type AntifloodDebugConfig struct {
 	IntervalAutoPrintInSeconds int
 }
 
+// ConsensusDebugConfig will hold the consensus round tracer debug configuration
+type ConsensusDebugConfig struct {
+	Enabled         bool
+	NumRoundsToKeep int
+}
+
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
 	APIPackages map[string]APIPackageConfig
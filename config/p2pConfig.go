@@ -4,6 +4,7 @@ package config
 type P2PConfig struct {
 	Node                NodeConfig
 	KadDhtPeerDiscovery KadDhtPeerDiscoveryConfig
+	Discovery           PeerDiscoveryConfig
 	Sharding            ShardingConfig
 }
 
@@ -13,6 +14,20 @@ type NodeConfig struct {
 	Seed                       string
 	MaximumExpectedPeerCount   uint64
 	ThresholdMinConnectedPeers uint32
+	Transports                 TransportsConfig
+}
+
+// TransportsConfig will hold the settings of the optional transports, opened besides the default TCP one.
+// QUIC is not offered: the QUIC transport compatible with the libp2p version in use panics at init on the
+// supported Go toolchains, so it can be added only after the libp2p dependencies are upgraded
+type TransportsConfig struct {
+	WebSocket TransportConfig
+}
+
+// TransportConfig will hold the settings of an optional transport
+type TransportConfig struct {
+	Enabled bool
+	Port    string
 }
 
 // KadDhtPeerDiscoveryConfig will hold the kad-dht discovery config settings
@@ -25,6 +40,26 @@ type KadDhtPeerDiscoveryConfig struct {
 	RoutingTableRefreshIntervalInSec uint32
 }
 
+// PeerDiscoveryConfig will hold the settings used to select and configure the peer discovery mechanism
+type PeerDiscoveryConfig struct {
+	Type        string
+	Mdns        MdnsDiscoveryConfig
+	StaticPeers StaticPeersDiscoveryConfig
+}
+
+// MdnsDiscoveryConfig will hold the mDNS local discovery config settings
+type MdnsDiscoveryConfig struct {
+	IntervalInSec uint32
+	ServiceTag    string
+}
+
+// StaticPeersDiscoveryConfig will hold the static peers discovery config settings
+type StaticPeersDiscoveryConfig struct {
+	PeerList                 []string
+	MinReconnectBackoffInSec uint32
+	MaxReconnectBackoffInSec uint32
+}
+
 // ShardingConfig will hold the network sharding config settings
 type ShardingConfig struct {
 	TargetPeerCount         int
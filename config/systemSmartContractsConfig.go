@@ -2,9 +2,11 @@ package config
 
 // SystemSmartContractsConfig defines the system smart contract configs
 type SystemSmartContractsConfig struct {
-	ESDTSystemSCConfig       ESDTSystemSCConfig
-	GovernanceSystemSCConfig GovernanceSystemSCConfig
-	StakingSystemSCConfig    StakingSystemSCConfig
+	ESDTSystemSCConfig              ESDTSystemSCConfig
+	GovernanceSystemSCConfig        GovernanceSystemSCConfig
+	StakingSystemSCConfig           StakingSystemSCConfig
+	DelegationManagerSystemSCConfig DelegationManagerSystemSCConfig
+	DelegationSystemSCConfig        DelegationSystemSCConfig
 }
 
 // StakingSystemSCConfig will hold the staking system smart contract settings
@@ -16,6 +18,9 @@ type StakingSystemSCConfig struct {
 	UnBondPeriod                         uint64
 	AuctionEnableEpoch                   uint32
 	StakeEnableEpoch                     uint32
+	ValidatorKeyRotationEnableEpoch      uint32
+	SlashingEnableEpoch                  uint32
+	EquivocationSlashValue               string
 	NumRoundsWithoutBleed                uint64
 	MaximumPercentageToBleed             float64
 	BleedPercentagePerRound              float64
@@ -26,17 +31,35 @@ type StakingSystemSCConfig struct {
 
 // ESDTSystemSCConfig defines a set of constant to initialize the esdt system smart contract
 type ESDTSystemSCConfig struct {
-	BaseIssuingCost string
-	OwnerAddress    string
-	EnabledEpoch    uint32
+	BaseIssuingCost              string
+	OwnerAddress                 string
+	EnabledEpoch                 uint32
+	NonFungibleTokensEnableEpoch uint32
+	SpecialRolesEnableEpoch      uint32
+	ConfigChangeEnableEpoch      uint32
 }
 
 // GovernanceSystemSCConfig defines the set of constants to initialize the governance system smart contract
 type GovernanceSystemSCConfig struct {
-	ProposalCost     string
-	NumNodes         int64
-	MinQuorum        int32
-	MinPassThreshold int32
-	MinVetoThreshold int32
-	EnabledEpoch     uint32
+	ProposalCost              string
+	NumNodes                  int64
+	MinQuorum                 int32
+	MinPassThreshold          int32
+	MinVetoThreshold          int32
+	EnabledEpoch              uint32
+	VoteDelegationEnableEpoch uint32
+}
+
+// DelegationManagerSystemSCConfig defines a set of constants to initialize the delegation manager system smart contract
+type DelegationManagerSystemSCConfig struct {
+	MinCreationDeposit string
+	EnabledEpoch       uint32
+}
+
+// DelegationSystemSCConfig defines a set of constants to initialize the delegation system smart contract
+type DelegationSystemSCConfig struct {
+	MinDelegationAmount  string
+	MinServiceFee        uint64
+	MaxServiceFee        uint64
+	UnBondPeriodInEpochs uint32
 }
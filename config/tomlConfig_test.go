@@ -80,8 +80,9 @@ func TestTomlParser(t *testing.T) {
 		MultisigHasher: TypeConfig{
 			Type: multiSigHasherType,
 		},
-		Consensus: TypeConfig{
-			Type: consensusType,
+		Consensus: ConsensusConfig{
+			Type:              consensusType,
+			PipeliningEnabled: true,
 		},
 	}
 
@@ -127,6 +128,7 @@ func TestTomlParser(t *testing.T) {
 
 [Consensus]
 	Type = "` + consensusType + `"
+	PipeliningEnabled = true
 
 `
 	cfg := Config{}
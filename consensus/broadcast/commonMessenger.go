@@ -42,6 +42,7 @@ type commonMessenger struct {
 	shardCoordinator        sharding.Coordinator
 	peerSignatureHandler    crypto.PeerSignatureHandler
 	delayedBlockBroadcaster delayedBroadcaster
+	validatorDirectSender   consensus.ValidatorDirectSender
 }
 
 // CommonMessengerArgs holds the arguments for creating commonMessenger instance
@@ -52,8 +53,10 @@ type CommonMessengerArgs struct {
 	PrivateKey                 crypto.PrivateKey
 	ShardCoordinator           sharding.Coordinator
 	PeerSignatureHandler       crypto.PeerSignatureHandler
+	ValidatorDirectSender      consensus.ValidatorDirectSender
 	HeadersSubscriber          consensus.HeadersPoolSubscriber
 	InterceptorsContainer      process.InterceptorsContainer
+	RoundTracer                consensus.RoundTracer
 	MaxDelayCacheSize          uint32
 	MaxValidatorDelayCacheSize uint32
 }
@@ -79,12 +82,18 @@ func checkCommonMessengerNilParameters(
 	if check.IfNil(args.PeerSignatureHandler) {
 		return spos.ErrNilPeerSignatureHandler
 	}
+	if check.IfNil(args.ValidatorDirectSender) {
+		return spos.ErrNilValidatorDirectSender
+	}
 	if check.IfNil(args.InterceptorsContainer) {
 		return spos.ErrNilInterceptorsContainer
 	}
 	if check.IfNil(args.HeadersSubscriber) {
 		return spos.ErrNilHeadersSubscriber
 	}
+	if check.IfNil(args.RoundTracer) {
+		return spos.ErrNilRoundTracer
+	}
 	if args.MaxDelayCacheSize == 0 || args.MaxValidatorDelayCacheSize == 0 {
 		return spos.ErrInvalidCacheSize
 	}
@@ -94,26 +103,53 @@ func checkCommonMessengerNilParameters(
 
 // BroadcastConsensusMessage will send on consensus topic the consensus message
 func (cm *commonMessenger) BroadcastConsensusMessage(message *consensus.Message) error {
-	signature, err := cm.peerSignatureHandler.GetPeerSignature(cm.privateKey, message.OriginatorPid)
+	buff, err := cm.signAndMarshalConsensusMessage(message)
 	if err != nil {
 		return err
 	}
 
-	message.Signature = signature
+	go cm.messenger.Broadcast(cm.consensusTopic(), buff)
 
-	buff, err := cm.marshalizer.Marshal(message)
+	return nil
+}
+
+// SendConsensusMessageToValidator will send the consensus message directly to the validator owning the provided
+// public key. If the direct send is not possible, the message is broadcast on the consensus topic
+func (cm *commonMessenger) SendConsensusMessageToValidator(message *consensus.Message, pubKey []byte) error {
+	buff, err := cm.signAndMarshalConsensusMessage(message)
 	if err != nil {
 		return err
 	}
 
-	consensusTopic := core.ConsensusTopic +
-		cm.shardCoordinator.CommunicationIdentifier(cm.shardCoordinator.SelfId())
+	err = cm.validatorDirectSender.SendToValidator(buff, pubKey)
+	if err == nil {
+		return nil
+	}
 
-	go cm.messenger.Broadcast(consensusTopic, buff)
+	log.Trace("commonMessenger.SendConsensusMessageToValidator: falling back to broadcast",
+		"pk", pubKey,
+		"error", err.Error(),
+	)
+	go cm.messenger.Broadcast(cm.consensusTopic(), buff)
 
 	return nil
 }
 
+func (cm *commonMessenger) signAndMarshalConsensusMessage(message *consensus.Message) ([]byte, error) {
+	signature, err := cm.peerSignatureHandler.GetPeerSignature(cm.privateKey, message.OriginatorPid)
+	if err != nil {
+		return nil, err
+	}
+
+	message.Signature = signature
+
+	return cm.marshalizer.Marshal(message)
+}
+
+func (cm *commonMessenger) consensusTopic() string {
+	return core.ConsensusTopic + cm.shardCoordinator.CommunicationIdentifier(cm.shardCoordinator.SelfId())
+}
+
 // BroadcastMiniBlocks will send on miniblocks topic the cross-shard miniblocks
 func (cm *commonMessenger) BroadcastMiniBlocks(miniBlocks map[uint32][]byte) error {
 	for k, v := range miniBlocks {
@@ -1,6 +1,7 @@
 package broadcast_test
 
 import (
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -83,6 +84,76 @@ func TestCommonMessenger_BroadcastConsensusMessageShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestCommonMessenger_SendConsensusMessageToValidatorShouldSendDirectly(t *testing.T) {
+	messengerMock := &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			assert.Fail(t, "should have not broadcast the message")
+		},
+	}
+	singleSignerMock := &mock.SingleSignerMock{
+		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			return []byte("signature"), nil
+		},
+	}
+	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
+
+	cm, _ := broadcast.NewCommonMessenger(
+		&mock.MarshalizerMock{},
+		messengerMock,
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		peerSigHandler,
+	)
+	leaderPk := []byte("leader pk")
+	directSendCalled := false
+	cm.SetValidatorDirectSender(&mock.ValidatorDirectSenderStub{
+		SendToValidatorCalled: func(buff []byte, pubKey []byte) error {
+			directSendCalled = true
+			assert.Equal(t, leaderPk, pubKey)
+			return nil
+		},
+	})
+
+	msg := &consensus.Message{}
+	err := cm.SendConsensusMessageToValidator(msg, leaderPk)
+	assert.Nil(t, err)
+	assert.True(t, directSendCalled)
+	assert.Equal(t, []byte("signature"), msg.Signature)
+}
+
+func TestCommonMessenger_SendConsensusMessageToValidatorShouldFallbackToBroadcast(t *testing.T) {
+	chBroadcast := make(chan string, 1)
+	messengerMock := &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			chBroadcast <- topic
+		},
+	}
+	singleSignerMock := &mock.SingleSignerMock{
+		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			return []byte("signature"), nil
+		},
+	}
+	peerSigHandler := &mock.PeerSignatureHandler{Signer: singleSignerMock}
+
+	cm, _ := broadcast.NewCommonMessenger(
+		&mock.MarshalizerMock{},
+		messengerMock,
+		&mock.PrivateKeyMock{},
+		&mock.ShardCoordinatorMock{},
+		peerSigHandler,
+	)
+
+	err := cm.SendConsensusMessageToValidator(&consensus.Message{}, []byte("leader pk"))
+	assert.Nil(t, err)
+
+	select {
+	case topic := <-chBroadcast:
+		assert.True(t, strings.HasPrefix(topic, core.ConsensusTopic))
+	case <-time.After(time.Second):
+		assert.Fail(t, "timeout while waiting for the broadcast")
+	}
+}
+
 func TestCommonMessenger_SignMessageShouldErrWhenSignFail(t *testing.T) {
 	err := errors.New("sign message error")
 	marshalizerMock := &mock.MarshalizerMock{}
@@ -25,11 +25,16 @@ const prefixHeaderAlarm = "header_"
 const prefixDelayDataAlarm = "delay_"
 const sizeHeadersCache = 1000 // 1000 hashes in cache
 
+const leaderBlockDataBroadcast = "leader_block_data"
+const validatorBlockDataBroadcast = "validator_block_data"
+const validatorHeaderBroadcast = "validator_header"
+
 // ArgsDelayedBlockBroadcaster holds the arguments to create a delayed block broadcaster
 type ArgsDelayedBlockBroadcaster struct {
 	InterceptorsContainer process.InterceptorsContainer
 	HeadersSubscriber     consensus.HeadersPoolSubscriber
 	ShardCoordinator      sharding.Coordinator
+	RoundTracer           consensus.RoundTracer
 	LeaderCacheSize       uint32
 	ValidatorCacheSize    uint32
 }
@@ -40,6 +45,7 @@ type validatorHeaderBroadcastData struct {
 	metaMiniBlocksData   map[uint32][]byte
 	metaTransactionsData map[string][][]byte
 	order                uint32
+	timeStamp            time.Time
 }
 
 type delayedBroadcastData struct {
@@ -49,6 +55,7 @@ type delayedBroadcastData struct {
 	miniBlockHashes map[string]map[string]struct{}
 	transactions    map[string][][]byte
 	order           uint32
+	timeStamp       time.Time
 }
 
 // timersScheduler exposes functionality for scheduling multiple timers
@@ -69,6 +76,7 @@ type delayedBlockBroadcaster struct {
 	interceptorsContainer      process.InterceptorsContainer
 	shardCoordinator           sharding.Coordinator
 	headersSubscriber          consensus.HeadersPoolSubscriber
+	roundTracer                consensus.RoundTracer
 	valHeaderBroadcastData     []*validatorHeaderBroadcastData
 	valBroadcastData           []*delayedBroadcastData
 	delayedBroadcastData       []*delayedBroadcastData
@@ -93,6 +101,9 @@ func NewDelayedBlockBroadcaster(args *ArgsDelayedBlockBroadcaster) (*delayedBloc
 	if check.IfNil(args.HeadersSubscriber) {
 		return nil, spos.ErrNilHeadersSubscriber
 	}
+	if check.IfNil(args.RoundTracer) {
+		return nil, spos.ErrNilRoundTracer
+	}
 
 	cacheHeaders, err := lrucache.NewCache(sizeHeadersCache)
 	if err != nil {
@@ -104,6 +115,7 @@ func NewDelayedBlockBroadcaster(args *ArgsDelayedBlockBroadcaster) (*delayedBloc
 		shardCoordinator:           args.ShardCoordinator,
 		interceptorsContainer:      args.InterceptorsContainer,
 		headersSubscriber:          args.HeadersSubscriber,
+		roundTracer:                args.RoundTracer,
 		valHeaderBroadcastData:     make([]*validatorHeaderBroadcastData, 0),
 		valBroadcastData:           make([]*delayedBroadcastData, 0),
 		delayedBroadcastData:       make([]*delayedBroadcastData, 0),
@@ -136,6 +148,7 @@ func (dbb *delayedBlockBroadcaster) SetLeaderData(broadcastData *delayedBroadcas
 
 	log.Debug("delayedBroadcast.SetLeaderData setting leader delay data", "headerHash", broadcastData.headerHash)
 
+	broadcastData.timeStamp = time.Now()
 	dataToBroadcast := make([]*delayedBroadcastData, 0)
 
 	dbb.mutDataForBroadcast.Lock()
@@ -150,7 +163,7 @@ func (dbb *delayedBlockBroadcaster) SetLeaderData(broadcastData *delayedBroadcas
 	}
 	dbb.mutDataForBroadcast.Unlock()
 
-	dbb.broadcastDelayedData(dataToBroadcast)
+	dbb.broadcastDelayedData(dataToBroadcast, leaderBlockDataBroadcast)
 	return nil
 }
 
@@ -177,6 +190,7 @@ func (dbb *delayedBlockBroadcaster) SetHeaderForValidator(vData *validatorHeader
 		}
 
 		duration := validatorDelayPerOrder * time.Duration(vData.order)
+		vData.timeStamp = time.Now()
 		dbb.valHeaderBroadcastData = append(dbb.valHeaderBroadcastData, vData)
 		alarmID := prefixHeaderAlarm + hex.EncodeToString(vData.headerHash)
 		dbb.alarm.Add(dbb.headerAlarmExpired, duration, alarmID)
@@ -208,6 +222,7 @@ func (dbb *delayedBlockBroadcaster) SetValidatorData(broadcastData *delayedBroad
 	)
 
 	dbb.mutDataForBroadcast.Lock()
+	broadcastData.timeStamp = time.Now()
 	broadcastData.miniBlockHashes = dbb.extractMiniBlockHashesCrossFromMe(broadcastData.header)
 	dbb.valBroadcastData = append(dbb.valBroadcastData, broadcastData)
 
@@ -318,7 +333,7 @@ OuterLoop:
 	}
 	dbb.mutDataForBroadcast.Unlock()
 
-	dbb.broadcastDelayedData(dataToBroadcast)
+	dbb.broadcastDelayedData(dataToBroadcast, leaderBlockDataBroadcast)
 
 	log.Debug("delayedBroadcast.broadcastDataForHeaders did not find any registered data to broadcast")
 }
@@ -405,7 +420,7 @@ func (dbb *delayedBlockBroadcaster) alarmExpired(alarmID string) {
 	dbb.mutDataForBroadcast.Unlock()
 
 	if len(dataToBroadcast) > 0 {
-		dbb.broadcastDelayedData(dataToBroadcast)
+		dbb.broadcastDelayedData(dataToBroadcast, validatorBlockDataBroadcast)
 	}
 }
 
@@ -441,6 +456,7 @@ func (dbb *delayedBlockBroadcaster) headerAlarmExpired(alarmID string) {
 	if err != nil {
 		log.Warn("delayedBroadcast.headerAlarmExpired", "error", err.Error(), "alarmID", alarmID)
 	}
+	dbb.traceBroadcastDelay(vHeader.header, validatorHeaderBroadcast, vHeader.timeStamp)
 
 	// if metaChain broadcast meta data with extra delay
 	if dbb.shardCoordinator.SelfId() == core.MetachainShardId {
@@ -451,14 +467,23 @@ func (dbb *delayedBlockBroadcaster) headerAlarmExpired(alarmID string) {
 	}
 }
 
-func (dbb *delayedBlockBroadcaster) broadcastDelayedData(broadcastData []*delayedBroadcastData) {
+func (dbb *delayedBlockBroadcaster) broadcastDelayedData(broadcastData []*delayedBroadcastData, dataType string) {
 	for _, bData := range broadcastData {
+		dbb.traceBroadcastDelay(bData.header, dataType, bData.timeStamp)
 		go func(miniBlocks map[uint32][]byte, transactions map[string][][]byte) {
 			dbb.broadcastBlockData(miniBlocks, transactions, 0)
 		}(bData.miniBlocksData, bData.transactions)
 	}
 }
 
+func (dbb *delayedBlockBroadcaster) traceBroadcastDelay(header data.HeaderHandler, dataType string, timeStamp time.Time) {
+	if check.IfNil(header) {
+		return
+	}
+
+	dbb.roundTracer.AddBroadcastDelay(int64(header.GetRound()), dataType, time.Since(timeStamp))
+}
+
 func (dbb *delayedBlockBroadcaster) broadcastBlockData(
 	miniBlocks map[uint32][]byte,
 	transactions map[string][][]byte,
@@ -100,6 +100,7 @@ func createDefaultDelayedBroadcasterArgs() *broadcast.ArgsDelayedBlockBroadcaste
 		ShardCoordinator:      &mock.ShardCoordinatorMock{},
 		InterceptorsContainer: interceptorsContainer,
 		HeadersSubscriber:     headersSubscriber,
+		RoundTracer:           &mock.RoundTracerStub{},
 		LeaderCacheSize:       2,
 		ValidatorCacheSize:    2,
 	}
@@ -127,6 +128,16 @@ func TestNewDelayedBlockBroadcaster_NilHeadersSubscriberShouldErr(t *testing.T)
 	require.Nil(t, dbb)
 }
 
+func TestNewDelayedBlockBroadcaster_NilRoundTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	delayBroadcasterArgs := createDefaultDelayedBroadcasterArgs()
+	delayBroadcasterArgs.RoundTracer = nil
+	dbb, err := broadcast.NewDelayedBlockBroadcaster(delayBroadcasterArgs)
+	require.Equal(t, spos.ErrNilRoundTracer, err)
+	require.Nil(t, dbb)
+}
+
 func TestNewDelayedBlockBroadcaster_NilInterceptorsContainerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -449,6 +460,58 @@ func TestDelayedBlockBroadcaster_SetHeaderForValidatorShouldSetAlarmAndBroadcast
 	require.Equal(t, 0, len(vbb))
 }
 
+func TestDelayedBlockBroadcaster_HeaderAlarmExpiredShouldTraceTheBroadcastDelay(t *testing.T) {
+	t.Parallel()
+
+	mutTraces := sync.Mutex{}
+	tracedDataTypes := make([]string, 0)
+	tracedRounds := make([]int64, 0)
+	delayBroadcasterArgs := createDefaultDelayedBroadcasterArgs()
+	delayBroadcasterArgs.RoundTracer = &mock.RoundTracerStub{
+		AddBroadcastDelayCalled: func(roundIndex int64, dataType string, delay time.Duration) {
+			mutTraces.Lock()
+			tracedDataTypes = append(tracedDataTypes, dataType)
+			tracedRounds = append(tracedRounds, roundIndex)
+			mutTraces.Unlock()
+		},
+	}
+	dbb, err := broadcast.NewDelayedBlockBroadcaster(delayBroadcasterArgs)
+	require.Nil(t, err)
+
+	broadcastMiniBlocks := func(mbData map[uint32][]byte) error {
+		return nil
+	}
+	broadcastTransactions := func(txData map[string][][]byte) error {
+		return nil
+	}
+	broadcastHeader := func(header data.HeaderHandler) error {
+		return nil
+	}
+	err = dbb.SetBroadcastHandlers(broadcastMiniBlocks, broadcastTransactions, broadcastHeader)
+	require.Nil(t, err)
+
+	vArgs := createValidatorDelayArgs(0)
+	vArgs.header.SetSignature([]byte("agg sig"))
+	valHeaderData := broadcast.CreateValidatorHeaderBroadcastData(
+		vArgs.headerHash,
+		vArgs.header,
+		vArgs.metaMiniBlocks,
+		vArgs.metaTransactions,
+		vArgs.order,
+	)
+	err = dbb.SetHeaderForValidator(valHeaderData)
+	require.Nil(t, err)
+
+	sleepTime := broadcast.ValidatorDelayPerOrder()*time.Duration(vArgs.order) +
+		time.Millisecond*100
+	time.Sleep(sleepTime)
+
+	mutTraces.Lock()
+	defer mutTraces.Unlock()
+	require.Equal(t, []string{"validator_header"}, tracedDataTypes)
+	require.Equal(t, []int64{int64(vArgs.header.GetRound())}, tracedRounds)
+}
+
 func TestDelayedBlockBroadcaster_SetValidatorDataFinalizedMetaHeaderShouldSetAlarmAndBroadcastHeaderAndData(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,27 @@
+package broadcast
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+)
+
+var _ consensus.ValidatorDirectSender = (*disabledValidatorDirectSender)(nil)
+
+type disabledValidatorDirectSender struct {
+}
+
+// NewDisabledValidatorDirectSender creates a validator direct sender used when the direct send is switched off.
+// All consensus messages will be broadcast
+func NewDisabledValidatorDirectSender() *disabledValidatorDirectSender {
+	return &disabledValidatorDirectSender{}
+}
+
+// SendToValidator returns ErrValidatorDirectSendDisabled
+func (dvds *disabledValidatorDirectSender) SendToValidator(_ []byte, _ []byte) error {
+	return spos.ErrValidatorDirectSendDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dvds *disabledValidatorDirectSender) IsInterfaceNil() bool {
+	return dvds == nil
+}
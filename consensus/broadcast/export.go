@@ -180,10 +180,16 @@ func NewCommonMessenger(
 ) (*commonMessenger, error) {
 
 	return &commonMessenger{
-		marshalizer:          marshalizer,
-		messenger:            messenger,
-		privateKey:           privateKey,
-		shardCoordinator:     shardCoordinator,
-		peerSignatureHandler: peerSigHandler,
+		marshalizer:           marshalizer,
+		messenger:             messenger,
+		privateKey:            privateKey,
+		shardCoordinator:      shardCoordinator,
+		peerSignatureHandler:  peerSigHandler,
+		validatorDirectSender: NewDisabledValidatorDirectSender(),
 	}, nil
 }
+
+// SetValidatorDirectSender -
+func (cm *commonMessenger) SetValidatorDirectSender(validatorDirectSender consensus.ValidatorDirectSender) {
+	cm.validatorDirectSender = validatorDirectSender
+}
@@ -37,6 +37,7 @@ func NewMetaChainMessenger(
 		LeaderCacheSize:       args.MaxDelayCacheSize,
 		ValidatorCacheSize:    args.MaxValidatorDelayCacheSize,
 		ShardCoordinator:      args.ShardCoordinator,
+		RoundTracer:           args.RoundTracer,
 	}
 
 	dbb, err := NewDelayedBlockBroadcaster(dbbArgs)
@@ -52,6 +53,7 @@ func NewMetaChainMessenger(
 		shardCoordinator:        args.ShardCoordinator,
 		peerSignatureHandler:    args.PeerSignatureHandler,
 		delayedBlockBroadcaster: dbb,
+		validatorDirectSender:   args.ValidatorDirectSender,
 	}
 
 	mcm := &metaChainMessenger{
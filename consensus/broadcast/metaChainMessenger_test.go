@@ -33,8 +33,10 @@ func createDefaultMetaChainArgs() broadcast.MetaChainMessengerArgs {
 			PrivateKey:                 privateKeyMock,
 			ShardCoordinator:           shardCoordinatorMock,
 			PeerSignatureHandler:       peerSigHandler,
+			ValidatorDirectSender:      &mock.ValidatorDirectSenderStub{},
 			HeadersSubscriber:          headersSubscriber,
 			InterceptorsContainer:      interceptorsContainer,
+			RoundTracer:                &mock.RoundTracerStub{},
 			MaxValidatorDelayCacheSize: 2,
 			MaxDelayCacheSize:          2,
 		},
@@ -86,6 +88,15 @@ func TestMetaChainMessenger_NewMetaChainMessengerNilPeerSignatureHandlerShouldFa
 	assert.Equal(t, spos.ErrNilPeerSignatureHandler, err)
 }
 
+func TestMetaChainMessenger_NewMetaChainMessengerNilValidatorDirectSenderShouldFail(t *testing.T) {
+	args := createDefaultMetaChainArgs()
+	args.ValidatorDirectSender = nil
+	mcm, err := broadcast.NewMetaChainMessenger(args)
+
+	assert.Nil(t, mcm)
+	assert.Equal(t, spos.ErrNilValidatorDirectSender, err)
+}
+
 func TestMetaChainMessenger_NewMetaChainMessengerShouldWork(t *testing.T) {
 	args := createDefaultMetaChainArgs()
 	mcm, err := broadcast.NewMetaChainMessenger(args)
@@ -36,12 +36,13 @@ func NewShardChainMessenger(
 	}
 
 	cm := &commonMessenger{
-		marshalizer:          args.Marshalizer,
-		hasher:               args.Hasher,
-		messenger:            args.Messenger,
-		privateKey:           args.PrivateKey,
-		shardCoordinator:     args.ShardCoordinator,
-		peerSignatureHandler: args.PeerSignatureHandler,
+		marshalizer:           args.Marshalizer,
+		hasher:                args.Hasher,
+		messenger:             args.Messenger,
+		privateKey:            args.PrivateKey,
+		shardCoordinator:      args.ShardCoordinator,
+		peerSignatureHandler:  args.PeerSignatureHandler,
+		validatorDirectSender: args.ValidatorDirectSender,
 	}
 
 	dbbArgs := &ArgsDelayedBlockBroadcaster{
@@ -50,6 +51,7 @@ func NewShardChainMessenger(
 		LeaderCacheSize:       args.MaxDelayCacheSize,
 		ValidatorCacheSize:    args.MaxValidatorDelayCacheSize,
 		ShardCoordinator:      args.ShardCoordinator,
+		RoundTracer:           args.RoundTracer,
 	}
 
 	dbb, err := NewDelayedBlockBroadcaster(dbbArgs)
@@ -156,6 +158,7 @@ func (scm *shardChainMessenger) BroadcastBlockDataLeader(
 
 	broadcastData := &delayedBroadcastData{
 		headerHash:     headerHash,
+		header:         header,
 		miniBlocksData: miniBlocks,
 		transactions:   transactions,
 	}
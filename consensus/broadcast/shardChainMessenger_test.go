@@ -68,8 +68,10 @@ func createDefaultShardChainArgs() broadcast.ShardChainMessengerArgs {
 			PrivateKey:                 privateKeyMock,
 			ShardCoordinator:           shardCoordinatorMock,
 			PeerSignatureHandler:       peerSigHandler,
+			ValidatorDirectSender:      &mock.ValidatorDirectSenderStub{},
 			HeadersSubscriber:          headersSubscriber,
 			InterceptorsContainer:      interceptorsContainer,
+			RoundTracer:                &mock.RoundTracerStub{},
 			MaxDelayCacheSize:          1,
 			MaxValidatorDelayCacheSize: 1,
 		},
@@ -121,6 +123,15 @@ func TestShardChainMessenger_NewShardChainMessengerNilPeerSignatureHandlerShould
 	assert.Equal(t, spos.ErrNilPeerSignatureHandler, err)
 }
 
+func TestShardChainMessenger_NewShardChainMessengerNilValidatorDirectSenderShouldFail(t *testing.T) {
+	args := createDefaultShardChainArgs()
+	args.ValidatorDirectSender = nil
+	scm, err := broadcast.NewShardChainMessenger(args)
+
+	assert.Nil(t, scm)
+	assert.Equal(t, spos.ErrNilValidatorDirectSender, err)
+}
+
 func TestShardChainMessenger_NewShardChainMessengerNilInterceptorsContainerShouldFail(t *testing.T) {
 	args := createDefaultShardChainArgs()
 	args.InterceptorsContainer = nil
@@ -139,6 +150,15 @@ func TestShardChainMessenger_NewShardChainMessengerNilHeadersSubscriberShouldFai
 	assert.Equal(t, spos.ErrNilHeadersSubscriber, err)
 }
 
+func TestShardChainMessenger_NewShardChainMessengerNilRoundTracerShouldFail(t *testing.T) {
+	args := createDefaultShardChainArgs()
+	args.RoundTracer = nil
+	scm, err := broadcast.NewShardChainMessenger(args)
+
+	assert.Nil(t, scm)
+	assert.Equal(t, spos.ErrNilRoundTracer, err)
+}
+
 func TestShardChainMessenger_NewShardChainMessengerShouldWork(t *testing.T) {
 	args := createDefaultShardChainArgs()
 	scm, err := broadcast.NewShardChainMessenger(args)
@@ -0,0 +1,100 @@
+package broadcast
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var _ consensus.ValidatorDirectSender = (*validatorDirectSender)(nil)
+
+// ArgValidatorDirectSender holds the arguments for creating a validatorDirectSender instance
+type ArgValidatorDirectSender struct {
+	Messenger        consensus.DirectSendMessenger
+	PeerIDProvider   consensus.PeerIDProvider
+	Marshalizer      marshal.Marshalizer
+	SingleSigner     crypto.SingleSigner
+	PrivateKey       crypto.PrivateKey
+	ShardCoordinator sharding.Coordinator
+}
+
+type validatorDirectSender struct {
+	messenger      consensus.DirectSendMessenger
+	peerIDProvider consensus.PeerIDProvider
+	marshalizer    marshal.Marshalizer
+	singleSigner   crypto.SingleSigner
+	privateKey     crypto.PrivateKey
+	publicKey      []byte
+	topic          string
+}
+
+// NewValidatorDirectSender creates a component able to send consensus messages directly to a validator. Each message
+// is wrapped in an envelope signed with the BLS key of this node
+func NewValidatorDirectSender(arg ArgValidatorDirectSender) (*validatorDirectSender, error) {
+	if check.IfNil(arg.Messenger) {
+		return nil, spos.ErrNilMessenger
+	}
+	if check.IfNil(arg.PeerIDProvider) {
+		return nil, spos.ErrNilPeerIDProvider
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, spos.ErrNilMarshalizer
+	}
+	if check.IfNil(arg.SingleSigner) {
+		return nil, spos.ErrNilSingleSigner
+	}
+	if check.IfNil(arg.PrivateKey) {
+		return nil, spos.ErrNilPrivateKey
+	}
+	if check.IfNil(arg.ShardCoordinator) {
+		return nil, spos.ErrNilShardCoordinator
+	}
+
+	publicKey, err := arg.PrivateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return &validatorDirectSender{
+		messenger:      arg.Messenger,
+		peerIDProvider: arg.PeerIDProvider,
+		marshalizer:    arg.Marshalizer,
+		singleSigner:   arg.SingleSigner,
+		privateKey:     arg.PrivateKey,
+		publicKey:      publicKey,
+		topic:          spos.GetValidatorDirectSendTopicID(arg.ShardCoordinator),
+	}, nil
+}
+
+// SendToValidator signs the provided buffer and sends it directly to the peer of the validator owning the provided
+// public key. It errors if the validator's peer is not known or not connected
+func (vds *validatorDirectSender) SendToValidator(buff []byte, pubKey []byte) error {
+	pid, found := vds.peerIDProvider.GetLastKnownPeerID(pubKey)
+	if !found {
+		return spos.ErrPeerIDNotFound
+	}
+	if !vds.messenger.IsConnected(*pid) {
+		return spos.ErrPeerNotConnected
+	}
+
+	signature, err := vds.singleSigner.Sign(vds.privateKey, buff)
+	if err != nil {
+		return err
+	}
+
+	envelope := consensus.NewSignedDirectMessage(buff, vds.publicKey, signature)
+	envelopeBuff, err := vds.marshalizer.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	return vds.messenger.SendToConnectedPeer(vds.topic, envelopeBuff, *pid)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vds *validatorDirectSender) IsInterfaceNil() bool {
+	return vds == nil
+}
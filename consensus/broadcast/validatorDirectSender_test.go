@@ -0,0 +1,198 @@
+package broadcast_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgValidatorDirectSender() broadcast.ArgValidatorDirectSender {
+	return broadcast.ArgValidatorDirectSender{
+		Messenger:      &mock.DirectSendMessengerStub{},
+		PeerIDProvider: &mock.PeerIDProviderStub{},
+		Marshalizer:    &mock.MarshalizerMock{},
+		SingleSigner:   &mock.SingleSignerMock{},
+		PrivateKey: &mock.PrivateKeyMock{
+			GeneratePublicMock: func() crypto.PublicKey {
+				return &mock.PublicKeyMock{}
+			},
+		},
+		ShardCoordinator: &mock.ShardCoordinatorMock{},
+	}
+}
+
+func TestNewValidatorDirectSender_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgValidatorDirectSender()
+	arg.Messenger = nil
+	vds, err := broadcast.NewValidatorDirectSender(arg)
+	assert.True(t, check.IfNil(vds))
+	assert.Equal(t, spos.ErrNilMessenger, err)
+
+	arg = createMockArgValidatorDirectSender()
+	arg.PeerIDProvider = nil
+	vds, err = broadcast.NewValidatorDirectSender(arg)
+	assert.True(t, check.IfNil(vds))
+	assert.Equal(t, spos.ErrNilPeerIDProvider, err)
+
+	arg = createMockArgValidatorDirectSender()
+	arg.Marshalizer = nil
+	vds, err = broadcast.NewValidatorDirectSender(arg)
+	assert.True(t, check.IfNil(vds))
+	assert.Equal(t, spos.ErrNilMarshalizer, err)
+
+	arg = createMockArgValidatorDirectSender()
+	arg.SingleSigner = nil
+	vds, err = broadcast.NewValidatorDirectSender(arg)
+	assert.True(t, check.IfNil(vds))
+	assert.Equal(t, spos.ErrNilSingleSigner, err)
+
+	arg = createMockArgValidatorDirectSender()
+	arg.PrivateKey = nil
+	vds, err = broadcast.NewValidatorDirectSender(arg)
+	assert.True(t, check.IfNil(vds))
+	assert.Equal(t, spos.ErrNilPrivateKey, err)
+
+	arg = createMockArgValidatorDirectSender()
+	arg.ShardCoordinator = nil
+	vds, err = broadcast.NewValidatorDirectSender(arg)
+	assert.True(t, check.IfNil(vds))
+	assert.Equal(t, spos.ErrNilShardCoordinator, err)
+}
+
+func TestNewValidatorDirectSender_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	vds, err := broadcast.NewValidatorDirectSender(createMockArgValidatorDirectSender())
+	assert.False(t, check.IfNil(vds))
+	assert.Nil(t, err)
+}
+
+func TestValidatorDirectSender_SendToValidatorUnknownPeerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vds, _ := broadcast.NewValidatorDirectSender(createMockArgValidatorDirectSender())
+
+	err := vds.SendToValidator([]byte("buff"), []byte("pk"))
+	assert.Equal(t, spos.ErrPeerIDNotFound, err)
+}
+
+func TestValidatorDirectSender_SendToValidatorNotConnectedPeerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pid := core.PeerID("pid")
+	arg := createMockArgValidatorDirectSender()
+	arg.PeerIDProvider = &mock.PeerIDProviderStub{
+		GetLastKnownPeerIDCalled: func(pk []byte) (*core.PeerID, bool) {
+			return &pid, true
+		},
+	}
+	arg.Messenger = &mock.DirectSendMessengerStub{
+		SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+			assert.Fail(t, "should have not sent the message")
+			return nil
+		},
+	}
+	vds, _ := broadcast.NewValidatorDirectSender(arg)
+
+	err := vds.SendToValidator([]byte("buff"), []byte("pk"))
+	assert.Equal(t, spos.ErrPeerNotConnected, err)
+}
+
+func TestValidatorDirectSender_SendToValidatorSignErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	pid := core.PeerID("pid")
+	arg := createMockArgValidatorDirectSender()
+	arg.PeerIDProvider = &mock.PeerIDProviderStub{
+		GetLastKnownPeerIDCalled: func(pk []byte) (*core.PeerID, bool) {
+			return &pid, true
+		},
+	}
+	arg.Messenger = &mock.DirectSendMessengerStub{
+		IsConnectedCalled: func(peerID core.PeerID) bool {
+			return true
+		},
+	}
+	arg.SingleSigner = &mock.SingleSignerMock{
+		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	vds, _ := broadcast.NewValidatorDirectSender(arg)
+
+	err := vds.SendToValidator([]byte("buff"), []byte("pk"))
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestValidatorDirectSender_SendToValidatorShouldSendSignedEnvelope(t *testing.T) {
+	t.Parallel()
+
+	buffToSend := []byte("buff")
+	signature := []byte("signature")
+	destinationPk := []byte("destination pk")
+	pid := core.PeerID("pid")
+	marshalizer := &mock.MarshalizerMock{}
+	shardCoordinator := &mock.ShardCoordinatorMock{}
+	sendCalled := false
+	arg := createMockArgValidatorDirectSender()
+	arg.Marshalizer = marshalizer
+	arg.ShardCoordinator = shardCoordinator
+	arg.PeerIDProvider = &mock.PeerIDProviderStub{
+		GetLastKnownPeerIDCalled: func(pk []byte) (*core.PeerID, bool) {
+			assert.Equal(t, destinationPk, pk)
+			return &pid, true
+		},
+	}
+	arg.SingleSigner = &mock.SingleSignerMock{
+		SignStub: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			assert.Equal(t, buffToSend, msg)
+			return signature, nil
+		},
+	}
+	arg.Messenger = &mock.DirectSendMessengerStub{
+		IsConnectedCalled: func(peerID core.PeerID) bool {
+			return peerID == pid
+		},
+		SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+			sendCalled = true
+			assert.Equal(t, spos.GetValidatorDirectSendTopicID(shardCoordinator), topic)
+			assert.Equal(t, pid, peerID)
+
+			envelope := &consensus.SignedDirectMessage{}
+			err := marshalizer.Unmarshal(envelope, buff)
+			require.Nil(t, err)
+			assert.Equal(t, buffToSend, envelope.Payload)
+			assert.Equal(t, []byte("publicKeyMock"), envelope.PubKey)
+			assert.Equal(t, signature, envelope.Signature)
+
+			return nil
+		},
+	}
+	vds, _ := broadcast.NewValidatorDirectSender(arg)
+
+	err := vds.SendToValidator(buffToSend, destinationPk)
+	assert.Nil(t, err)
+	assert.True(t, sendCalled)
+}
+
+func TestDisabledValidatorDirectSender_SendToValidatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dvds := broadcast.NewDisabledValidatorDirectSender()
+	assert.False(t, check.IfNil(dvds))
+
+	err := dvds.SendToValidator([]byte("buff"), []byte("pk"))
+	assert.Equal(t, spos.ErrValidatorDirectSendDisabled, err)
+}
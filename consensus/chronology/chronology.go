@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/closing"
+	consensusDebug "github.com/ElrondNetwork/elrond-go/debug/consensus"
 	"github.com/ElrondNetwork/elrond-go/display"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
@@ -40,6 +41,7 @@ type chronology struct {
 	subroundHandlers []consensus.SubroundHandler
 	mutSubrounds     sync.RWMutex
 	appStatusHandler core.AppStatusHandler
+	roundTracer      consensus.RoundTracer
 	cancelFunc       func()
 
 	watchdog core.WatchdogTimer
@@ -67,6 +69,7 @@ func NewChronology(
 		rounder:          rounder,
 		syncTimer:        syncTimer,
 		appStatusHandler: statusHandler.NewNilStatusHandler(),
+		roundTracer:      consensusDebug.NewDisabledRoundTracer(),
 		watchdog:         watchdog,
 	}
 
@@ -107,6 +110,16 @@ func (chr *chronology) SetAppStatusHandler(ash core.AppStatusHandler) error {
 	return nil
 }
 
+// SetRoundTracer will set the RoundTracer which will record the time spent in each subround
+func (chr *chronology) SetRoundTracer(roundTracer consensus.RoundTracer) error {
+	if check.IfNil(roundTracer) {
+		return ErrNilRoundTracer
+	}
+
+	chr.roundTracer = roundTracer
+	return nil
+}
+
 // AddSubround adds new SubroundHandler implementation to the chronology
 func (chr *chronology) AddSubround(subroundHandler consensus.SubroundHandler) {
 	chr.mutSubrounds.Lock()
@@ -169,7 +182,11 @@ func (chr *chronology) startRound() {
 	log.Debug(display.Headline(msg, chr.syncTimer.FormattedCurrentTime(), "."))
 	logger.SetCorrelationSubround(sr.Name())
 
-	if !sr.DoWork(chr.rounder) {
+	startTime := chr.syncTimer.CurrentTime()
+	isJobDone := sr.DoWork(chr.rounder)
+	chr.roundTracer.AddSubroundTrace(chr.rounder.Index(), sr.Name(), chr.syncTimer.CurrentTime().Sub(startTime), isJobDone)
+
+	if !isJobDone {
 		chr.subroundId = srBeforeStartRound
 		return
 	}
@@ -204,6 +221,7 @@ func (chr *chronology) initRound() {
 		chr.subroundId = chr.subroundHandlers[0].Current()
 		chr.appStatusHandler.SetUInt64Value(core.MetricCurrentRound, uint64(chr.rounder.Index()))
 		chr.appStatusHandler.SetUInt64Value(core.MetricCurrentRoundTimestamp, uint64(chr.rounder.TimeStamp().Unix()))
+		chr.roundTracer.StartRound(chr.rounder.Index(), chr.rounder.TimeStamp())
 	}
 
 	chr.mutSubrounds.RUnlock()
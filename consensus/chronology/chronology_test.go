@@ -415,3 +415,57 @@ func TestChronology_CheckIfStatusHandlerWorks(t *testing.T) {
 		assert.Fail(t, "AppStatusHandler not working")
 	}
 }
+
+func TestChronology_SetRoundTracerWithNilValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rounderMock := &mock.RounderMock{}
+	syncTimerMock := &mock.SyncTimerMock{}
+	chr, _ := chronology.NewChronology(
+		syncTimerMock.CurrentTime(),
+		rounderMock,
+		syncTimerMock,
+		&mock.WatchdogMock{},
+	)
+	err := chr.SetRoundTracer(nil)
+
+	assert.Equal(t, chronology.ErrNilRoundTracer, err)
+}
+
+func TestChronology_StartRoundShouldTraceTheRoundAndTheSubround(t *testing.T) {
+	t.Parallel()
+
+	rounderMock := &mock.RounderMock{}
+	syncTimerMock := &mock.SyncTimerMock{}
+	chr, _ := chronology.NewChronology(
+		syncTimerMock.CurrentTime(),
+		rounderMock,
+		syncTimerMock,
+		&mock.WatchdogMock{},
+	)
+
+	startRoundCalled := false
+	tracedSubround := ""
+	tracedJobDone := false
+	err := chr.SetRoundTracer(&mock.RoundTracerStub{
+		StartRoundCalled: func(roundIndex int64, roundTimeStamp time.Time) {
+			startRoundCalled = true
+		},
+		AddSubroundTraceCalled: func(roundIndex int64, subroundName string, duration time.Duration, isJobDone bool) {
+			tracedSubround = subroundName
+			tracedJobDone = isJobDone
+		},
+	})
+	assert.Nil(t, err)
+
+	srm := initSubroundHandlerMock()
+	srm.DoWorkCalled = func(rounder consensus.Rounder) bool {
+		return true
+	}
+	chr.AddSubround(srm)
+	chr.StartRound()
+
+	assert.True(t, startRoundCalled)
+	assert.Equal(t, srm.Name(), tracedSubround)
+	assert.True(t, tracedJobDone)
+}
@@ -0,0 +1,27 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. equivocationProof.proto
+package consensus
+
+import "fmt"
+
+// NewEquivocationProof creates a new EquivocationProof object
+func NewEquivocationProof(
+	pubKey []byte,
+	shardID uint32,
+	round uint64,
+	firstHeader []byte,
+	secondHeader []byte,
+) *EquivocationProof {
+	return &EquivocationProof{
+		PubKey:       pubKey,
+		ShardID:      shardID,
+		Round:        round,
+		FirstHeader:  firstHeader,
+		SecondHeader: secondHeader,
+	}
+}
+
+// ID returns the identifier of the misbehaviour proven by the equivocation proof. All the proofs built for the
+// same leader, shard and round have the same identifier, regardless of the headers they hold
+func (ep *EquivocationProof) ID() []byte {
+	return []byte(fmt.Sprintf("%s_%d_%d", string(ep.PubKey), ep.ShardID, ep.Round))
+}
@@ -0,0 +1,583 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: equivocationProof.proto
+
+package consensus
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EquivocationProof holds two different headers, proposed for the same round and shard, which were both signed by
+// the same leader
+type EquivocationProof struct {
+	PubKey       []byte `protobuf:"bytes,1,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
+	ShardID      uint32 `protobuf:"varint,2,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	Round        uint64 `protobuf:"varint,3,opt,name=Round,proto3" json:"Round,omitempty"`
+	FirstHeader  []byte `protobuf:"bytes,4,opt,name=FirstHeader,proto3" json:"FirstHeader,omitempty"`
+	SecondHeader []byte `protobuf:"bytes,5,opt,name=SecondHeader,proto3" json:"SecondHeader,omitempty"`
+}
+
+func (m *EquivocationProof) Reset()      { *m = EquivocationProof{} }
+func (*EquivocationProof) ProtoMessage() {}
+func (*EquivocationProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_933e67ef2dfdd504, []int{0}
+}
+func (m *EquivocationProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EquivocationProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EquivocationProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EquivocationProof.Merge(m, src)
+}
+func (m *EquivocationProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *EquivocationProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_EquivocationProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EquivocationProof proto.InternalMessageInfo
+
+func (m *EquivocationProof) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *EquivocationProof) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *EquivocationProof) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *EquivocationProof) GetFirstHeader() []byte {
+	if m != nil {
+		return m.FirstHeader
+	}
+	return nil
+}
+
+func (m *EquivocationProof) GetSecondHeader() []byte {
+	if m != nil {
+		return m.SecondHeader
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EquivocationProof)(nil), "proto.EquivocationProof")
+}
+
+func init() { proto.RegisterFile("equivocationProof.proto", fileDescriptor_933e67ef2dfdd504) }
+
+var fileDescriptor_933e67ef2dfdd504 = []byte{
+	// 260 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0x2d, 0x2c, 0xcd,
+	0x2c, 0xcb, 0x4f, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x0b, 0x28, 0xca, 0xcf, 0x4f, 0xd3, 0x2b, 0x28,
+	0xca, 0x2f, 0xc9, 0x17, 0x62, 0x05, 0x53, 0x52, 0xba, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
+	0xc9, 0xf9, 0xb9, 0xfa, 0xe9, 0xf9, 0xe9, 0xf9, 0xfa, 0x60, 0xe1, 0xa4, 0xd2, 0x34, 0x30, 0x0f,
+	0xcc, 0x01, 0xb3, 0x20, 0xba, 0x94, 0x16, 0x32, 0x72, 0x09, 0xba, 0xa2, 0x9b, 0x28, 0x24, 0xc6,
+	0xc5, 0x16, 0x50, 0x9a, 0xe4, 0x9d, 0x5a, 0x29, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x13, 0x04, 0xe5,
+	0x09, 0x49, 0x70, 0xb1, 0x07, 0x67, 0x24, 0x16, 0xa5, 0x78, 0xba, 0x48, 0x30, 0x29, 0x30, 0x6a,
+	0xf0, 0x06, 0xc1, 0xb8, 0x42, 0x22, 0x5c, 0xac, 0x41, 0xf9, 0xa5, 0x79, 0x29, 0x12, 0xcc, 0x0a,
+	0x8c, 0x1a, 0x2c, 0x41, 0x10, 0x8e, 0x90, 0x02, 0x17, 0xb7, 0x5b, 0x66, 0x51, 0x71, 0x89, 0x47,
+	0x6a, 0x62, 0x4a, 0x6a, 0x91, 0x04, 0x0b, 0xd8, 0x30, 0x64, 0x21, 0x21, 0x25, 0x2e, 0x9e, 0xe0,
+	0xd4, 0xe4, 0xfc, 0xbc, 0x14, 0xa8, 0x12, 0x56, 0xb0, 0x12, 0x14, 0x31, 0x27, 0xe7, 0x0b, 0x0f,
+	0xe5, 0x18, 0x6e, 0x3c, 0x94, 0x63, 0xf8, 0xf0, 0x50, 0x8e, 0xb1, 0xe1, 0x91, 0x1c, 0xe3, 0x8a,
+	0x47, 0x72, 0x8c, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0x78, 0xe3, 0x91, 0x1c, 0xe3,
+	0x83, 0x47, 0x72, 0x8c, 0x2f, 0x1e, 0xc9, 0x31, 0x7c, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c,
+	0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x71, 0x26, 0xe7, 0xe7, 0x15, 0xa7,
+	0xe6, 0x15, 0x97, 0x16, 0x27, 0xb1, 0x81, 0xfd, 0x6b, 0x0c, 0x18, 0x00, 0x3a, 0x33, 0xe1, 0xab,
+	0x40, 0x01, 0x00, 0x00,
+}
+
+func (this *EquivocationProof) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EquivocationProof)
+	if !ok {
+		that2, ok := that.(EquivocationProof)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if !bytes.Equal(this.FirstHeader, that1.FirstHeader) {
+		return false
+	}
+	if !bytes.Equal(this.SecondHeader, that1.SecondHeader) {
+		return false
+	}
+	return true
+}
+func (this *EquivocationProof) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&consensus.EquivocationProof{")
+	s = append(s, "PubKey: "+fmt.Sprintf("%#v", this.PubKey)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "FirstHeader: "+fmt.Sprintf("%#v", this.FirstHeader)+",\n")
+	s = append(s, "SecondHeader: "+fmt.Sprintf("%#v", this.SecondHeader)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringEquivocationProof(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *EquivocationProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EquivocationProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EquivocationProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SecondHeader) > 0 {
+		i -= len(m.SecondHeader)
+		copy(dAtA[i:], m.SecondHeader)
+		i = encodeVarintEquivocationProof(dAtA, i, uint64(len(m.SecondHeader)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FirstHeader) > 0 {
+		i -= len(m.FirstHeader)
+		copy(dAtA[i:], m.FirstHeader)
+		i = encodeVarintEquivocationProof(dAtA, i, uint64(len(m.FirstHeader)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Round != 0 {
+		i = encodeVarintEquivocationProof(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintEquivocationProof(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintEquivocationProof(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEquivocationProof(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEquivocationProof(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EquivocationProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovEquivocationProof(uint64(l))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovEquivocationProof(uint64(m.ShardID))
+	}
+	if m.Round != 0 {
+		n += 1 + sovEquivocationProof(uint64(m.Round))
+	}
+	l = len(m.FirstHeader)
+	if l > 0 {
+		n += 1 + l + sovEquivocationProof(uint64(l))
+	}
+	l = len(m.SecondHeader)
+	if l > 0 {
+		n += 1 + l + sovEquivocationProof(uint64(l))
+	}
+	return n
+}
+
+func sovEquivocationProof(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEquivocationProof(x uint64) (n int) {
+	return sovEquivocationProof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *EquivocationProof) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EquivocationProof{`,
+		`PubKey:` + fmt.Sprintf("%v", this.PubKey) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`FirstHeader:` + fmt.Sprintf("%v", this.FirstHeader) + `,`,
+		`SecondHeader:` + fmt.Sprintf("%v", this.SecondHeader) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEquivocationProof(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *EquivocationProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEquivocationProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EquivocationProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EquivocationProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstHeader", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstHeader = append(m.FirstHeader[:0], dAtA[iNdEx:postIndex]...)
+			if m.FirstHeader == nil {
+				m.FirstHeader = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondHeader", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondHeader = append(m.SecondHeader[:0], dAtA[iNdEx:postIndex]...)
+			if m.SecondHeader == nil {
+				m.SecondHeader = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEquivocationProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEquivocationProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEquivocationProof(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEquivocationProof
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEquivocationProof
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEquivocationProof
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEquivocationProof
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEquivocationProof
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEquivocationProof        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEquivocationProof          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEquivocationProof = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,42 @@
+package consensus_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEquivocationProof_NewEquivocationProofMarshalUnmarshalShouldWork(t *testing.T) {
+	t.Parallel()
+
+	proof := consensus.NewEquivocationProof(
+		[]byte("pub key"),
+		1,
+		37,
+		[]byte("first header"),
+		[]byte("second header"),
+	)
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	buff, err := marshalizer.Marshal(proof)
+	require.Nil(t, err)
+
+	recovered := &consensus.EquivocationProof{}
+	err = marshalizer.Unmarshal(recovered, buff)
+	require.Nil(t, err)
+	assert.Equal(t, proof, recovered)
+}
+
+func TestEquivocationProof_IDShouldNotDependOnHeaders(t *testing.T) {
+	t.Parallel()
+
+	proof1 := consensus.NewEquivocationProof([]byte("pub key"), 1, 37, []byte("header A"), []byte("header B"))
+	proof2 := consensus.NewEquivocationProof([]byte("pub key"), 1, 37, []byte("header B"), []byte("header C"))
+	proof3 := consensus.NewEquivocationProof([]byte("pub key"), 1, 38, []byte("header A"), []byte("header B"))
+
+	assert.Equal(t, proof1.ID(), proof2.ID())
+	assert.NotEqual(t, proof1.ID(), proof3.ID())
+}
@@ -62,6 +62,7 @@ type BroadcastMessenger interface {
 	BroadcastMiniBlocks(map[uint32][]byte) error
 	BroadcastTransactions(map[string][][]byte) error
 	BroadcastConsensusMessage(*Message) error
+	SendConsensusMessageToValidator(message *Message, pubKey []byte) error
 	BroadcastBlockDataLeader(header data.HeaderHandler, miniBlocks map[uint32][]byte, transactions map[string][][]byte) error
 	PrepareBroadcastHeaderValidator(header data.HeaderHandler, miniBlocks map[uint32][]byte, transactions map[string][][]byte, order int)
 	PrepareBroadcastBlockDataValidator(header data.HeaderHandler, miniBlocks map[uint32][]byte, transactions map[string][][]byte, idx int)
@@ -74,6 +75,26 @@ type P2PMessenger interface {
 	IsInterfaceNil() bool
 }
 
+// DirectSendMessenger defines a subset of the p2p.Messenger interface used when sending messages to a single peer
+type DirectSendMessenger interface {
+	SendToConnectedPeer(topic string, buff []byte, peerID core.PeerID) error
+	IsConnected(peerID core.PeerID) bool
+	IsInterfaceNil() bool
+}
+
+// ValidatorDirectSender defines the behaviour of a component able to send a consensus message directly to
+// the validator owning the provided public key
+type ValidatorDirectSender interface {
+	SendToValidator(buff []byte, pubKey []byte) error
+	IsInterfaceNil() bool
+}
+
+// PeerIDProvider is able to return the most recent peer ID associated with a public key
+type PeerIDProvider interface {
+	GetLastKnownPeerID(pk []byte) (*core.PeerID, bool)
+	IsInterfaceNil() bool
+}
+
 // NetworkShardingCollector defines the updating methods used by the network sharding component
 // The interface assures that the collected data will be used by the p2p network sharding components
 type NetworkShardingCollector interface {
@@ -122,6 +143,17 @@ type HeaderSigVerifier interface {
 	IsInterfaceNil() bool
 }
 
+// RoundTracer defines the behaviour of a component able to record the timings of each consensus round
+type RoundTracer interface {
+	StartRound(roundIndex int64, roundTimeStamp time.Time)
+	AddSubroundTrace(roundIndex int64, subroundName string, duration time.Duration, isJobDone bool)
+	SetBlockReceived(roundIndex int64, receivedTime time.Time)
+	AddSignatureReceived(roundIndex int64, pubKey []byte, receivedTime time.Time)
+	SetAggregationDuration(roundIndex int64, duration time.Duration)
+	AddBroadcastDelay(roundIndex int64, dataType string, delay time.Duration)
+	IsInterfaceNil() bool
+}
+
 // FallbackHeaderValidator defines the behaviour of a component able to signal when a fallback header validation could be applied
 type FallbackHeaderValidator interface {
 	ShouldApplyFallbackValidation(headerHandler data.HeaderHandler) bool
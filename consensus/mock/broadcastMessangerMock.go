@@ -14,6 +14,7 @@ type BroadcastMessengerMock struct {
 	BroadcastMiniBlocksCalled                func(map[uint32][]byte) error
 	BroadcastTransactionsCalled              func(map[string][][]byte) error
 	BroadcastConsensusMessageCalled          func(*consensus.Message) error
+	SendConsensusMessageToValidatorCalled    func(message *consensus.Message, pubKey []byte) error
 	BroadcastBlockDataLeaderCalled           func(h data.HeaderHandler, mbs map[uint32][]byte, txs map[string][][]byte) error
 }
 
@@ -101,6 +102,15 @@ func (bmm *BroadcastMessengerMock) BroadcastConsensusMessage(message *consensus.
 	return nil
 }
 
+// SendConsensusMessageToValidator -
+func (bmm *BroadcastMessengerMock) SendConsensusMessageToValidator(message *consensus.Message, pubKey []byte) error {
+	if bmm.SendConsensusMessageToValidatorCalled != nil {
+		return bmm.SendConsensusMessageToValidatorCalled(message, pubKey)
+	}
+
+	return bmm.BroadcastConsensusMessage(message)
+}
+
 // BroadcastHeader -
 func (bmm *BroadcastMessengerMock) BroadcastHeader(headerhandler data.HeaderHandler) error {
 	if bmm.BroadcastHeaderCalled != nil {
@@ -34,6 +34,7 @@ type ConsensusCoreMock struct {
 	peerHonestyHandler      consensus.PeerHonestyHandler
 	headerSigVerifier       consensus.HeaderSigVerifier
 	fallbackHeaderValidator consensus.FallbackHeaderValidator
+	roundTracer             consensus.RoundTracer
 }
 
 // GetAntiFloodHandler -
@@ -206,6 +207,16 @@ func (ccm *ConsensusCoreMock) FallbackHeaderValidator() consensus.FallbackHeader
 	return ccm.fallbackHeaderValidator
 }
 
+// RoundTracer -
+func (ccm *ConsensusCoreMock) RoundTracer() consensus.RoundTracer {
+	return ccm.roundTracer
+}
+
+// SetRoundTracer -
+func (ccm *ConsensusCoreMock) SetRoundTracer(roundTracer consensus.RoundTracer) {
+	ccm.roundTracer = roundTracer
+}
+
 // SetFallbackHeaderValidator -
 func (ccm *ConsensusCoreMock) SetFallbackHeaderValidator(fallbackHeaderValidator consensus.FallbackHeaderValidator) {
 	ccm.fallbackHeaderValidator = fallbackHeaderValidator
@@ -0,0 +1,34 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// DirectSendMessengerStub -
+type DirectSendMessengerStub struct {
+	SendToConnectedPeerCalled func(topic string, buff []byte, peerID core.PeerID) error
+	IsConnectedCalled         func(peerID core.PeerID) bool
+}
+
+// SendToConnectedPeer -
+func (dsms *DirectSendMessengerStub) SendToConnectedPeer(topic string, buff []byte, peerID core.PeerID) error {
+	if dsms.SendToConnectedPeerCalled != nil {
+		return dsms.SendToConnectedPeerCalled(topic, buff, peerID)
+	}
+
+	return nil
+}
+
+// IsConnected -
+func (dsms *DirectSendMessengerStub) IsConnected(peerID core.PeerID) bool {
+	if dsms.IsConnectedCalled != nil {
+		return dsms.IsConnectedCalled(peerID)
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (dsms *DirectSendMessengerStub) IsInterfaceNil() bool {
+	return dsms == nil
+}
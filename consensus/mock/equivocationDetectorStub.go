@@ -0,0 +1,31 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/data"
+)
+
+// EquivocationDetectorStub -
+type EquivocationDetectorStub struct {
+	AddSignedHeaderCalled     func(header data.HeaderHandler, headerHash []byte)
+	AddConsensusMessageCalled func(cnsMsg *consensus.Message)
+}
+
+// AddSignedHeader -
+func (eds *EquivocationDetectorStub) AddSignedHeader(header data.HeaderHandler, headerHash []byte) {
+	if eds.AddSignedHeaderCalled != nil {
+		eds.AddSignedHeaderCalled(header, headerHash)
+	}
+}
+
+// AddConsensusMessage -
+func (eds *EquivocationDetectorStub) AddConsensusMessage(cnsMsg *consensus.Message) {
+	if eds.AddConsensusMessageCalled != nil {
+		eds.AddConsensusMessageCalled(cnsMsg)
+	}
+}
+
+// IsInterfaceNil -
+func (eds *EquivocationDetectorStub) IsInterfaceNil() bool {
+	return eds == nil
+}
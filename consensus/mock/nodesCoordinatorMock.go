@@ -12,6 +12,7 @@ type NodesCoordinatorMock struct {
 	ComputeValidatorsGroupCalled        func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]sharding.Validator, error)
 	GetValidatorsPublicKeysCalled       func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error)
 	GetValidatorsRewardsAddressesCalled func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]string, error)
+	GetValidatorWithPublicKeyCalled     func(publicKey []byte) (sharding.Validator, uint32, error)
 }
 
 // GetChance -
@@ -140,7 +141,11 @@ func (ncm *NodesCoordinatorMock) ComputeAdditionalLeaving([]*state.ShardValidato
 }
 
 // GetValidatorWithPublicKey -
-func (ncm *NodesCoordinatorMock) GetValidatorWithPublicKey(_ []byte) (sharding.Validator, uint32, error) {
+func (ncm *NodesCoordinatorMock) GetValidatorWithPublicKey(publicKey []byte) (sharding.Validator, uint32, error) {
+	if ncm.GetValidatorWithPublicKeyCalled != nil {
+		return ncm.GetValidatorWithPublicKeyCalled(publicKey)
+	}
+
 	panic("implement me")
 }
 
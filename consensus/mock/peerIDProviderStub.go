@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// PeerIDProviderStub -
+type PeerIDProviderStub struct {
+	GetLastKnownPeerIDCalled func(pk []byte) (*core.PeerID, bool)
+}
+
+// GetLastKnownPeerID -
+func (pips *PeerIDProviderStub) GetLastKnownPeerID(pk []byte) (*core.PeerID, bool) {
+	if pips.GetLastKnownPeerIDCalled != nil {
+		return pips.GetLastKnownPeerIDCalled(pk)
+	}
+
+	return nil, false
+}
+
+// IsInterfaceNil -
+func (pips *PeerIDProviderStub) IsInterfaceNil() bool {
+	return pips == nil
+}
@@ -0,0 +1,60 @@
+package mock
+
+import "time"
+
+// RoundTracerStub -
+type RoundTracerStub struct {
+	StartRoundCalled             func(roundIndex int64, roundTimeStamp time.Time)
+	AddSubroundTraceCalled       func(roundIndex int64, subroundName string, duration time.Duration, isJobDone bool)
+	SetBlockReceivedCalled       func(roundIndex int64, receivedTime time.Time)
+	AddSignatureReceivedCalled   func(roundIndex int64, pubKey []byte, receivedTime time.Time)
+	SetAggregationDurationCalled func(roundIndex int64, duration time.Duration)
+	AddBroadcastDelayCalled      func(roundIndex int64, dataType string, delay time.Duration)
+}
+
+// StartRound -
+func (rts *RoundTracerStub) StartRound(roundIndex int64, roundTimeStamp time.Time) {
+	if rts.StartRoundCalled != nil {
+		rts.StartRoundCalled(roundIndex, roundTimeStamp)
+	}
+}
+
+// AddSubroundTrace -
+func (rts *RoundTracerStub) AddSubroundTrace(roundIndex int64, subroundName string, duration time.Duration, isJobDone bool) {
+	if rts.AddSubroundTraceCalled != nil {
+		rts.AddSubroundTraceCalled(roundIndex, subroundName, duration, isJobDone)
+	}
+}
+
+// SetBlockReceived -
+func (rts *RoundTracerStub) SetBlockReceived(roundIndex int64, receivedTime time.Time) {
+	if rts.SetBlockReceivedCalled != nil {
+		rts.SetBlockReceivedCalled(roundIndex, receivedTime)
+	}
+}
+
+// AddSignatureReceived -
+func (rts *RoundTracerStub) AddSignatureReceived(roundIndex int64, pubKey []byte, receivedTime time.Time) {
+	if rts.AddSignatureReceivedCalled != nil {
+		rts.AddSignatureReceivedCalled(roundIndex, pubKey, receivedTime)
+	}
+}
+
+// SetAggregationDuration -
+func (rts *RoundTracerStub) SetAggregationDuration(roundIndex int64, duration time.Duration) {
+	if rts.SetAggregationDurationCalled != nil {
+		rts.SetAggregationDurationCalled(roundIndex, duration)
+	}
+}
+
+// AddBroadcastDelay -
+func (rts *RoundTracerStub) AddBroadcastDelay(roundIndex int64, dataType string, delay time.Duration) {
+	if rts.AddBroadcastDelayCalled != nil {
+		rts.AddBroadcastDelayCalled(roundIndex, dataType, delay)
+	}
+}
+
+// IsInterfaceNil -
+func (rts *RoundTracerStub) IsInterfaceNil() bool {
+	return rts == nil
+}
@@ -0,0 +1,20 @@
+package mock
+
+// ValidatorDirectSenderStub -
+type ValidatorDirectSenderStub struct {
+	SendToValidatorCalled func(buff []byte, pubKey []byte) error
+}
+
+// SendToValidator -
+func (vdss *ValidatorDirectSenderStub) SendToValidator(buff []byte, pubKey []byte) error {
+	if vdss.SendToValidatorCalled != nil {
+		return vdss.SendToValidatorCalled(buff, pubKey)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (vdss *ValidatorDirectSenderStub) IsInterfaceNil() bool {
+	return vdss == nil
+}
@@ -0,0 +1,11 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. signedDirectMessage.proto
+package consensus
+
+// NewSignedDirectMessage creates a new SignedDirectMessage object
+func NewSignedDirectMessage(payload []byte, pubKey []byte, signature []byte) *SignedDirectMessage {
+	return &SignedDirectMessage{
+		Payload:   payload,
+		PubKey:    pubKey,
+		Signature: signature,
+	}
+}
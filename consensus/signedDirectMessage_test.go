@@ -0,0 +1,25 @@
+package consensus_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedDirectMessage_NewSignedDirectMessageMarshalUnmarshalShouldWork(t *testing.T) {
+	t.Parallel()
+
+	msg := consensus.NewSignedDirectMessage([]byte("payload"), []byte("pub key"), []byte("signature"))
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	buff, err := marshalizer.Marshal(msg)
+	require.Nil(t, err)
+
+	recovered := &consensus.SignedDirectMessage{}
+	err = marshalizer.Unmarshal(recovered, buff)
+	require.Nil(t, err)
+	assert.Equal(t, msg, recovered)
+}
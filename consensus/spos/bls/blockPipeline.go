@@ -0,0 +1,211 @@
+package bls
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// blockPipeline keeps the block built by the node, as leader of the next round, on top of a block which was
+// committed but is not final yet. The block is built on the accounts state left by the committed block and its
+// state changes are kept, so the pipelined block is proposed as it is, without being executed again. It is handed
+// over only if, at the moment the next round begins, the blockchain still ends with the same header, the node did
+// not lose its synchronized state and the fork detector did not signal any fork. Otherwise the pipelined block is
+// dropped, its state changes are reverted and the leader creates a new block, as it would have done without
+// pipelining.
+type blockPipeline struct {
+	forkDetector   process.ForkDetector
+	blockProcessor process.BlockProcessor
+
+	mutBlock       sync.Mutex
+	lastRound      int64
+	header         data.HeaderHandler
+	body           data.BodyHandler
+	isStateChanged bool
+	chBuildingDone chan struct{}
+	flagOutOfSync  atomic.Flag
+}
+
+func newBlockPipeline(forkDetector process.ForkDetector, blockProcessor process.BlockProcessor) (*blockPipeline, error) {
+	if check.IfNil(forkDetector) {
+		return nil, spos.ErrNilForkDetector
+	}
+	if check.IfNil(blockProcessor) {
+		return nil, spos.ErrNilBlockProcessor
+	}
+
+	return &blockPipeline{
+		forkDetector:   forkDetector,
+		blockProcessor: blockProcessor,
+	}, nil
+}
+
+// startBuilding marks the pipeline as building the block of the given round until finishBuilding is called. It
+// returns false if the building of a block for the given round has already been started.
+func (bp *blockPipeline) startBuilding(round int64) bool {
+	bp.mutBlock.Lock()
+	defer bp.mutBlock.Unlock()
+
+	if round <= bp.lastRound || bp.chBuildingDone != nil {
+		return false
+	}
+
+	bp.lastRound = round
+	bp.header = nil
+	bp.body = nil
+	bp.isStateChanged = false
+	bp.chBuildingDone = make(chan struct{})
+	bp.flagOutOfSync.Unset()
+
+	return true
+}
+
+// finishBuilding stores the built block, if any, and releases the ones waiting for the building to finish.
+// isStateChanged tells if the accounts state was changed while building, even if no block could be built.
+func (bp *blockPipeline) finishBuilding(header data.HeaderHandler, body data.BodyHandler, isStateChanged bool) {
+	bp.mutBlock.Lock()
+	defer bp.mutBlock.Unlock()
+
+	bp.isStateChanged = isStateChanged
+	if !check.IfNil(header) && !check.IfNil(body) {
+		bp.header = header
+		bp.body = body
+	}
+
+	if bp.chBuildingDone != nil {
+		close(bp.chBuildingDone)
+		bp.chBuildingDone = nil
+	}
+}
+
+// isOutOfSync returns true if the node lost its synchronized state since the building of the block started
+func (bp *blockPipeline) isOutOfSync() bool {
+	return bp.flagOutOfSync.IsSet()
+}
+
+// receivedSyncState is called by the bootstrapper each time it computes the synchronized state of the node. The
+// block being built is abandoned as soon as the node is not synchronized, since the bootstrapper will start
+// processing blocks on the same accounts state.
+func (bp *blockPipeline) receivedSyncState(isNodeSynchronized bool) {
+	if !isNodeSynchronized {
+		bp.flagOutOfSync.Set()
+	}
+}
+
+func (bp *blockPipeline) waitBuildingToFinish() {
+	bp.mutBlock.Lock()
+	chBuildingDone := bp.chBuildingDone
+	bp.mutBlock.Unlock()
+
+	if chBuildingDone != nil {
+		<-chBuildingDone
+	}
+}
+
+func (bp *blockPipeline) pop() (data.HeaderHandler, data.BodyHandler, bool) {
+	bp.waitBuildingToFinish()
+
+	bp.mutBlock.Lock()
+	defer bp.mutBlock.Unlock()
+
+	header, body, isStateChanged := bp.header, bp.body, bp.isStateChanged
+	bp.header = nil
+	bp.body = nil
+	bp.isStateChanged = false
+
+	return header, body, isStateChanged
+}
+
+// take waits for the building in progress, if any, and returns the pipelined block if it was built for the given
+// round on top of the given header. The block is removed from the pipeline in any case, as it can not be used in
+// another round. If the block is returned, its state changes are handed over together with it, otherwise they
+// are reverted.
+func (bp *blockPipeline) take(
+	round int64,
+	currentHeader data.HeaderHandler,
+	currentHeaderHash []byte,
+) (data.HeaderHandler, data.BodyHandler, bool) {
+	header, body, isStateChanged := bp.pop()
+	if check.IfNil(header) {
+		bp.revertStateIfChanged(header, isStateChanged)
+		return nil, nil, false
+	}
+
+	isBlockValid := bp.isBuiltOnCurrentHeader(round, header, currentHeader, currentHeaderHash) &&
+		!bp.isForkDetected(round, header) &&
+		!bp.wasOutOfSync(round, header)
+	if !isBlockValid {
+		bp.revertStateIfChanged(header, isStateChanged)
+		return nil, nil, false
+	}
+
+	return header, body, true
+}
+
+// discard waits for the building in progress, if any, drops the pipelined block and reverts its state changes. It
+// is called before the accounts state is used for anything else than proposing the pipelined block.
+func (bp *blockPipeline) discard() {
+	header, _, isStateChanged := bp.pop()
+	if !check.IfNil(header) {
+		log.Debug("pipelined block dropped",
+			"pipelined block round", header.GetRound(),
+			"pipelined block nonce", header.GetNonce())
+	}
+
+	bp.revertStateIfChanged(header, isStateChanged)
+}
+
+func (bp *blockPipeline) revertStateIfChanged(header data.HeaderHandler, isStateChanged bool) {
+	if isStateChanged {
+		bp.blockProcessor.RevertAccountState(header)
+	}
+}
+
+func (bp *blockPipeline) isBuiltOnCurrentHeader(
+	round int64,
+	header data.HeaderHandler,
+	currentHeader data.HeaderHandler,
+	currentHeaderHash []byte,
+) bool {
+	isBuiltOnCurrentHeader := !check.IfNil(currentHeader) &&
+		int64(header.GetRound()) == round &&
+		header.GetNonce() == currentHeader.GetNonce()+1 &&
+		bytes.Equal(header.GetPrevHash(), currentHeaderHash)
+	if !isBuiltOnCurrentHeader {
+		log.Debug("pipelined block dropped as it was not built on the current header",
+			"round", round,
+			"pipelined block round", header.GetRound(),
+			"pipelined block nonce", header.GetNonce())
+	}
+
+	return isBuiltOnCurrentHeader
+}
+
+func (bp *blockPipeline) isForkDetected(round int64, header data.HeaderHandler) bool {
+	forkInfo := bp.forkDetector.CheckFork()
+	isForkDetected := forkInfo != nil && forkInfo.IsDetected
+	if isForkDetected {
+		log.Debug("pipelined block dropped as a fork was detected",
+			"round", round,
+			"pipelined block nonce", header.GetNonce(),
+			"fork nonce", forkInfo.Nonce)
+	}
+
+	return isForkDetected
+}
+
+func (bp *blockPipeline) wasOutOfSync(round int64, header data.HeaderHandler) bool {
+	wasOutOfSync := bp.isOutOfSync()
+	if wasOutOfSync {
+		log.Debug("pipelined block dropped as the node was not synchronized",
+			"round", round,
+			"pipelined block nonce", header.GetNonce())
+	}
+
+	return wasOutOfSync
+}
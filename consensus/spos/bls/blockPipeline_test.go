@@ -0,0 +1,273 @@
+package bls_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func createForkDetectorWithFork(isForkDetected *bool) *mock.ForkDetectorMock {
+	return &mock.ForkDetectorMock{
+		CheckForkCalled: func() *process.ForkInfo {
+			forkInfo := process.NewForkInfo()
+			forkInfo.IsDetected = *isForkDetected
+			return forkInfo
+		},
+	}
+}
+
+func createBlockProcessorWithRevert(numReverts *int) *mock.BlockProcessorMock {
+	return &mock.BlockProcessorMock{
+		RevertAccountStateCalled: func(header data.HeaderHandler) {
+			*numReverts++
+		},
+	}
+}
+
+func createPipelineWithBlock(t *testing.T, isForkDetected *bool, header *block.Header) bls.BlockPipeline {
+	pipeline, err := bls.NewBlockPipeline(createForkDetectorWithFork(isForkDetected), mock.InitBlockProcessorMock())
+	assert.Nil(t, err)
+
+	ok := pipeline.StartBuilding(int64(header.Round))
+	assert.True(t, ok)
+	pipeline.FinishBuilding(header, &block.Body{}, true)
+
+	return pipeline
+}
+
+func TestNewBlockPipeline_NilForkDetectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pipeline, err := bls.NewBlockPipeline(nil, &mock.BlockProcessorMock{})
+	assert.Nil(t, pipeline)
+	assert.Equal(t, spos.ErrNilForkDetector, err)
+}
+
+func TestNewBlockPipeline_NilBlockProcessorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pipeline, err := bls.NewBlockPipeline(&mock.ForkDetectorMock{}, nil)
+	assert.Nil(t, pipeline)
+	assert.Equal(t, spos.ErrNilBlockProcessor, err)
+}
+
+func TestBlockPipeline_TakeShouldWork(t *testing.T) {
+	t.Parallel()
+
+	isForkDetected := false
+	header := &block.Header{Round: 5, Nonce: 3, PrevHash: []byte("hash")}
+	pipeline := createPipelineWithBlock(t, &isForkDetected, header)
+
+	pipelinedHeader, pipelinedBody, ok := pipeline.Take(5, &block.Header{Nonce: 2}, []byte("hash"))
+	assert.True(t, ok)
+	assert.Equal(t, header, pipelinedHeader)
+	assert.NotNil(t, pipelinedBody)
+
+	_, _, ok = pipeline.Take(5, &block.Header{Nonce: 2}, []byte("hash"))
+	assert.False(t, ok)
+}
+
+func TestBlockPipeline_TakeForAnotherRoundShouldDropBlock(t *testing.T) {
+	t.Parallel()
+
+	isForkDetected := false
+	header := &block.Header{Round: 5, Nonce: 3, PrevHash: []byte("hash")}
+	pipeline := createPipelineWithBlock(t, &isForkDetected, header)
+
+	_, _, ok := pipeline.Take(6, &block.Header{Nonce: 2}, []byte("hash"))
+	assert.False(t, ok)
+
+	_, _, ok = pipeline.Take(5, &block.Header{Nonce: 2}, []byte("hash"))
+	assert.False(t, ok)
+}
+
+func TestBlockPipeline_TakeOnRolledBackHeaderShouldDropBlock(t *testing.T) {
+	t.Parallel()
+
+	isForkDetected := false
+	header := &block.Header{Round: 5, Nonce: 3, PrevHash: []byte("hash")}
+	pipeline := createPipelineWithBlock(t, &isForkDetected, header)
+
+	_, _, ok := pipeline.Take(5, &block.Header{Nonce: 1}, []byte("previous hash"))
+	assert.False(t, ok)
+}
+
+func TestBlockPipeline_TakeOnOtherHeaderWithSameNonceShouldDropBlock(t *testing.T) {
+	t.Parallel()
+
+	isForkDetected := false
+	header := &block.Header{Round: 5, Nonce: 3, PrevHash: []byte("hash")}
+	pipeline := createPipelineWithBlock(t, &isForkDetected, header)
+
+	_, _, ok := pipeline.Take(5, &block.Header{Nonce: 2}, []byte("other hash"))
+	assert.False(t, ok)
+}
+
+func TestBlockPipeline_TakeWhenForkIsDetectedShouldDropBlock(t *testing.T) {
+	t.Parallel()
+
+	isForkDetected := true
+	header := &block.Header{Round: 5, Nonce: 3, PrevHash: []byte("hash")}
+	pipeline := createPipelineWithBlock(t, &isForkDetected, header)
+
+	_, _, ok := pipeline.Take(5, &block.Header{Nonce: 2}, []byte("hash"))
+	assert.False(t, ok)
+}
+
+func TestBlockPipeline_StartBuildingTwiceForSameRoundShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	pipeline, _ := bls.NewBlockPipeline(&mock.ForkDetectorMock{}, mock.InitBlockProcessorMock())
+
+	ok := pipeline.StartBuilding(5)
+	assert.True(t, ok)
+	pipeline.FinishBuilding(nil, nil, false)
+
+	ok = pipeline.StartBuilding(5)
+	assert.False(t, ok)
+	ok = pipeline.StartBuilding(4)
+	assert.False(t, ok)
+
+	ok = pipeline.StartBuilding(6)
+	assert.True(t, ok)
+	pipeline.FinishBuilding(nil, nil, false)
+}
+
+func TestBlockPipeline_StartBuildingWhileBuildingShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	pipeline, _ := bls.NewBlockPipeline(&mock.ForkDetectorMock{}, mock.InitBlockProcessorMock())
+
+	ok := pipeline.StartBuilding(5)
+	assert.True(t, ok)
+
+	ok = pipeline.StartBuilding(6)
+	assert.False(t, ok)
+
+	pipeline.FinishBuilding(nil, nil, false)
+}
+
+func TestBlockPipeline_TakeShouldNotRevertTheStateOfTheHandedOverBlock(t *testing.T) {
+	t.Parallel()
+
+	isForkDetected := false
+	numReverts := 0
+	pipeline, _ := bls.NewBlockPipeline(createForkDetectorWithFork(&isForkDetected), createBlockProcessorWithRevert(&numReverts))
+	header := &block.Header{Round: 5, Nonce: 3, PrevHash: []byte("hash")}
+	_ = pipeline.StartBuilding(5)
+	pipeline.FinishBuilding(header, &block.Body{}, true)
+
+	_, _, ok := pipeline.Take(5, &block.Header{Nonce: 2}, []byte("hash"))
+	assert.True(t, ok)
+	assert.Equal(t, 0, numReverts)
+}
+
+func TestBlockPipeline_TakeOfDroppedBlockShouldRevertTheState(t *testing.T) {
+	t.Parallel()
+
+	isForkDetected := false
+	numReverts := 0
+	pipeline, _ := bls.NewBlockPipeline(createForkDetectorWithFork(&isForkDetected), createBlockProcessorWithRevert(&numReverts))
+	header := &block.Header{Round: 5, Nonce: 3, PrevHash: []byte("hash")}
+	_ = pipeline.StartBuilding(5)
+	pipeline.FinishBuilding(header, &block.Body{}, true)
+
+	_, _, ok := pipeline.Take(5, &block.Header{Nonce: 2}, []byte("other hash"))
+	assert.False(t, ok)
+	assert.Equal(t, 1, numReverts)
+
+	_, _, ok = pipeline.Take(5, &block.Header{Nonce: 2}, []byte("hash"))
+	assert.False(t, ok)
+	assert.Equal(t, 1, numReverts)
+}
+
+func TestBlockPipeline_TakeWhenStateWasNotChangedShouldNotRevert(t *testing.T) {
+	t.Parallel()
+
+	isForkDetected := false
+	numReverts := 0
+	pipeline, _ := bls.NewBlockPipeline(createForkDetectorWithFork(&isForkDetected), createBlockProcessorWithRevert(&numReverts))
+	_ = pipeline.StartBuilding(5)
+	pipeline.FinishBuilding(nil, nil, false)
+
+	_, _, ok := pipeline.Take(5, &block.Header{Nonce: 2}, []byte("hash"))
+	assert.False(t, ok)
+	assert.Equal(t, 0, numReverts)
+}
+
+func TestBlockPipeline_TakeAfterNodeWasNotSynchronizedShouldDropBlock(t *testing.T) {
+	t.Parallel()
+
+	isForkDetected := false
+	numReverts := 0
+	pipeline, _ := bls.NewBlockPipeline(createForkDetectorWithFork(&isForkDetected), createBlockProcessorWithRevert(&numReverts))
+	header := &block.Header{Round: 5, Nonce: 3, PrevHash: []byte("hash")}
+	_ = pipeline.StartBuilding(5)
+	pipeline.ReceivedSyncState(true)
+	assert.False(t, pipeline.IsOutOfSync())
+	pipeline.ReceivedSyncState(false)
+	assert.True(t, pipeline.IsOutOfSync())
+	pipeline.FinishBuilding(header, &block.Body{}, true)
+
+	_, _, ok := pipeline.Take(5, &block.Header{Nonce: 2}, []byte("hash"))
+	assert.False(t, ok)
+	assert.Equal(t, 1, numReverts)
+}
+
+func TestBlockPipeline_DiscardShouldRevertTheState(t *testing.T) {
+	t.Parallel()
+
+	isForkDetected := false
+	numReverts := 0
+	pipeline, _ := bls.NewBlockPipeline(createForkDetectorWithFork(&isForkDetected), createBlockProcessorWithRevert(&numReverts))
+	header := &block.Header{Round: 5, Nonce: 3, PrevHash: []byte("hash")}
+	_ = pipeline.StartBuilding(5)
+	pipeline.FinishBuilding(header, &block.Body{}, true)
+
+	pipeline.Discard()
+	assert.Equal(t, 1, numReverts)
+
+	pipeline.Discard()
+	assert.Equal(t, 1, numReverts)
+
+	_, _, ok := pipeline.Take(5, &block.Header{Nonce: 2}, []byte("hash"))
+	assert.False(t, ok)
+}
+
+func TestBlockPipeline_DiscardShouldWaitForTheBuildingToFinish(t *testing.T) {
+	t.Parallel()
+
+	isForkDetected := false
+	numReverts := 0
+	pipeline, _ := bls.NewBlockPipeline(createForkDetectorWithFork(&isForkDetected), createBlockProcessorWithRevert(&numReverts))
+	header := &block.Header{Round: 5, Nonce: 3, PrevHash: []byte("hash")}
+	_ = pipeline.StartBuilding(5)
+
+	chDiscarded := make(chan struct{})
+	go func() {
+		pipeline.Discard()
+		close(chDiscarded)
+	}()
+
+	select {
+	case <-chDiscarded:
+		assert.Fail(t, "should have waited for the building to finish")
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	pipeline.FinishBuilding(header, &block.Body{}, true)
+
+	select {
+	case <-chDiscarded:
+	case <-time.After(time.Second):
+		assert.Fail(t, "should have discarded the block after the building finished")
+	}
+	assert.Equal(t, 1, numReverts)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
@@ -21,6 +22,8 @@ type factory struct {
 	indexer          indexer.Indexer
 	chainID          []byte
 	currentPid       core.PeerID
+	blockPipeline    *blockPipeline
+	subroundBlock    *subroundBlock
 }
 
 // NewSubroundsFactory creates a new consensusState object
@@ -91,6 +94,29 @@ func (fct *factory) SetIndexer(indexer indexer.Indexer) {
 	fct.indexer = indexer
 }
 
+// EnableBlockPipelining makes the leader of the next round build its block as soon as the current block is
+// committed. The pipelined block is dropped if the fork detector signals a fork before it is proposed.
+func (fct *factory) EnableBlockPipelining(forkDetector process.ForkDetector) error {
+	pipeline, err := newBlockPipeline(forkDetector, fct.consensusCore.BlockProcessor())
+	if err != nil {
+		return err
+	}
+
+	fct.consensusCore.BootStrapper().AddSyncStateListener(pipeline.receivedSyncState)
+	fct.blockPipeline = pipeline
+
+	return nil
+}
+
+// extend drops the pipelined block, if any, before the worker reverts the accounts state of the extended round
+func (fct *factory) extend(subroundId int) {
+	if fct.blockPipeline != nil {
+		fct.blockPipeline.discard()
+	}
+
+	fct.worker.Extend(subroundId)
+}
+
 // GenerateSubrounds will generate the subrounds used in BLS Cns
 func (fct *factory) GenerateSubrounds() error {
 	fct.initConsensusThreshold()
@@ -150,7 +176,7 @@ func (fct *factory) generateStartRoundSubround() error {
 
 	subroundStartRound, err := NewSubroundStartRound(
 		subround,
-		fct.worker.Extend,
+		fct.extend,
 		processingThresholdPercent,
 		fct.worker.ExecuteStoredMessages,
 		fct.worker.ResetConsensusMessages,
@@ -192,13 +218,16 @@ func (fct *factory) generateBlockSubround() error {
 
 	subroundBlock, err := NewSubroundBlock(
 		subround,
-		fct.worker.Extend,
+		fct.extend,
 		processingThresholdPercent,
 	)
 	if err != nil {
 		return err
 	}
 
+	subroundBlock.blockPipeline = fct.blockPipeline
+	fct.subroundBlock = subroundBlock
+
 	fct.worker.AddReceivedMessageCall(MtBlockBodyAndHeader, subroundBlock.receivedBlockBodyAndHeader)
 	fct.worker.AddReceivedMessageCall(MtBlockBody, subroundBlock.receivedBlockBody)
 	fct.worker.AddReceivedMessageCall(MtBlockHeader, subroundBlock.receivedBlockHeader)
@@ -228,7 +257,7 @@ func (fct *factory) generateSignatureSubround() error {
 
 	subroundSignatureObject, err := NewSubroundSignature(
 		subround,
-		fct.worker.Extend,
+		fct.extend,
 	)
 	if err != nil {
 		return err
@@ -266,7 +295,7 @@ func (fct *factory) generateEndRoundSubround() error {
 
 	subroundEndRoundObject, err := NewSubroundEndRound(
 		subround,
-		fct.worker.Extend,
+		fct.extend,
 		spos.MaxThresholdPercent,
 		fct.worker.DisplayStatistics,
 	)
@@ -279,6 +308,10 @@ func (fct *factory) generateEndRoundSubround() error {
 		return err
 	}
 
+	if fct.blockPipeline != nil {
+		subroundEndRoundObject.blockCommittedHandler = fct.subroundBlock.prepareBlockForNextRound
+	}
+
 	fct.worker.AddReceivedMessageCall(MtBlockHeaderFinalInfo, subroundEndRoundObject.receivedBlockHeaderFinalInfo)
 	fct.worker.AddReceivedHeaderHandler(subroundEndRoundObject.receivedHeader)
 	fct.consensusCore.Chronology().AddSubround(subroundEndRoundObject)
@@ -213,6 +213,60 @@ func (sr *subroundBlock) ReceivedBlockHeader(cnsDta *consensus.Message) bool {
 	return sr.receivedBlockHeader(cnsDta)
 }
 
+// SetBlockPipeline sets the block pipeline used by the subround Block
+func (sr *subroundBlock) SetBlockPipeline(pipeline BlockPipeline) {
+	sr.blockPipeline = pipeline
+}
+
+// PrepareBlockForNextRound starts building the block of the next round, if the node is its leader
+func (sr *subroundBlock) PrepareBlockForNextRound(committedHeader data.HeaderHandler) {
+	sr.prepareBlockForNextRound(committedHeader)
+}
+
+// blockPipeline
+
+// BlockPipeline defines a type for the blockPipeline structure
+type BlockPipeline = *blockPipeline
+
+// NewBlockPipeline creates a new block pipeline
+func NewBlockPipeline(forkDetector process.ForkDetector, blockProcessor process.BlockProcessor) (BlockPipeline, error) {
+	return newBlockPipeline(forkDetector, blockProcessor)
+}
+
+// StartBuilding locks the pipeline for building the block of the given round
+func (bp *blockPipeline) StartBuilding(round int64) bool {
+	return bp.startBuilding(round)
+}
+
+// FinishBuilding stores the built block and releases the ones waiting for the building to finish
+func (bp *blockPipeline) FinishBuilding(header data.HeaderHandler, body data.BodyHandler, isStateChanged bool) {
+	bp.finishBuilding(header, body, isStateChanged)
+}
+
+// Take returns the pipelined block if it was built for the given round on top of the given header
+func (bp *blockPipeline) Take(
+	round int64,
+	currentHeader data.HeaderHandler,
+	currentHeaderHash []byte,
+) (data.HeaderHandler, data.BodyHandler, bool) {
+	return bp.take(round, currentHeader, currentHeaderHash)
+}
+
+// Discard drops the pipelined block and reverts its state changes
+func (bp *blockPipeline) Discard() {
+	bp.discard()
+}
+
+// ReceivedSyncState is called by the bootstrapper each time it computes the synchronized state of the node
+func (bp *blockPipeline) ReceivedSyncState(isNodeSynchronized bool) {
+	bp.receivedSyncState(isNodeSynchronized)
+}
+
+// IsOutOfSync returns true if the node lost its synchronized state since the building of the block started
+func (bp *blockPipeline) IsOutOfSync() bool {
+	return bp.isOutOfSync()
+}
+
 // subroundSignature
 
 // SubroundSignature defines a type for the subroundSignature structure
@@ -243,6 +297,11 @@ func (sr *subroundSignature) AreSignaturesCollected(threshold int) (bool, int) {
 // SubroundEndRound defines a type for the subroundEndRound structure
 type SubroundEndRound *subroundEndRound
 
+// SetBlockCommittedHandler sets the handler called after the block was committed
+func (sr *subroundEndRound) SetBlockCommittedHandler(handler func(header data.HeaderHandler)) {
+	sr.blockCommittedHandler = handler
+}
+
 // DoEndRoundJob method does the job of the subround EndRound
 func (sr *subroundEndRound) DoEndRoundJob() bool {
 	return sr.doEndRoundJob()
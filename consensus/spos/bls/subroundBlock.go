@@ -18,6 +18,7 @@ type subroundBlock struct {
 	*spos.Subround
 
 	processingThresholdPercentage int
+	blockPipeline                 *blockPipeline
 }
 
 // NewSubroundBlock creates a subroundBlock object
@@ -80,16 +81,20 @@ func (sr *subroundBlock) doBlockJob() bool {
 	metricStatTime := time.Now()
 	defer sr.computeSubroundProcessingMetric(metricStatTime, core.MetricCreatedProposedBlock)
 
-	header, err := sr.createHeader()
-	if err != nil {
-		log.Debug("doBlockJob.createHeader", "error", err.Error())
-		return false
-	}
+	header, body := sr.getPipelinedBlock()
+	if check.IfNil(header) || check.IfNil(body) {
+		var err error
+		header, err = sr.createHeader()
+		if err != nil {
+			log.Debug("doBlockJob.createHeader", "error", err.Error())
+			return false
+		}
 
-	header, body, err := sr.createBlock(header)
-	if err != nil {
-		log.Debug("doBlockJob.createBlock", "error", err.Error())
-		return false
+		header, body, err = sr.createBlock(header)
+		if err != nil {
+			log.Debug("doBlockJob.createBlock", "error", err.Error())
+			return false
+		}
 	}
 
 	sentWithSuccess := sr.sendBlock(body, header)
@@ -97,7 +102,9 @@ func (sr *subroundBlock) doBlockJob() bool {
 		return false
 	}
 
-	err = sr.SetSelfJobDone(sr.Current(), true)
+	sr.RoundTracer().SetBlockReceived(sr.Rounder().Index(), sr.SyncTimer().CurrentTime())
+
+	err := sr.SetSelfJobDone(sr.Current(), true)
 	if err != nil {
 		log.Debug("doBlockJob.SetSelfJobDone", "error", err.Error())
 		return false
@@ -106,6 +113,112 @@ func (sr *subroundBlock) doBlockJob() bool {
 	return true
 }
 
+// getPipelinedBlock returns the block which was built in advance for the current round, if it is still valid. The
+// block is proposed as it is, since its state changes were kept since it was built.
+func (sr *subroundBlock) getPipelinedBlock() (data.HeaderHandler, data.BodyHandler) {
+	if sr.blockPipeline == nil {
+		return nil, nil
+	}
+
+	header, body, ok := sr.blockPipeline.take(
+		sr.Rounder().Index(),
+		sr.Blockchain().GetCurrentBlockHeader(),
+		sr.Blockchain().GetCurrentBlockHeaderHash(),
+	)
+	if !ok {
+		return nil, nil
+	}
+
+	log.Debug("pipelined block will be proposed",
+		"round", header.GetRound(),
+		"nonce", header.GetNonce())
+
+	return header, body
+}
+
+// discardPipelinedBlock drops the block built in advance, if any, before the accounts state is used for another block
+func (sr *subroundBlock) discardPipelinedBlock() {
+	if sr.blockPipeline == nil {
+		return
+	}
+
+	sr.blockPipeline.discard()
+}
+
+// prepareBlockForNextRound starts building the block of the next round, if the node is its leader. It is called
+// right after a block was committed, outside of the end round processing, so the new block extends a header which
+// is not final yet. The block is built on its own go routine, until the next round begins, and everyone else who
+// needs the accounts state in the meantime waits for the building to finish through the block pipeline.
+func (sr *subroundBlock) prepareBlockForNextRound(committedHeader data.HeaderHandler) {
+	if sr.blockPipeline == nil || check.IfNil(committedHeader) {
+		return
+	}
+	// the consensus group could change after an epoch start block, so the leader is computed only when the round begins
+	if committedHeader.IsStartOfEpochBlock() {
+		return
+	}
+
+	nextRound := int64(committedHeader.GetRound()) + 1
+	if nextRound != sr.Rounder().Index()+1 {
+		return
+	}
+
+	nextConsensusGroup, err := sr.GetNextConsensusGroup(
+		committedHeader.GetRandSeed(),
+		uint64(nextRound),
+		sr.ShardCoordinator().SelfId(),
+		sr.NodesCoordinator(),
+		committedHeader.GetEpoch(),
+	)
+	if err != nil {
+		log.Debug("prepareBlockForNextRound.GetNextConsensusGroup", "error", err.Error())
+		return
+	}
+	if len(nextConsensusGroup) == 0 || nextConsensusGroup[0] != sr.SelfPubKey() {
+		return
+	}
+
+	if !sr.blockPipeline.startBuilding(nextRound) {
+		return
+	}
+
+	nextRoundTimeStamp := sr.Rounder().TimeStamp().Add(sr.Rounder().TimeDuration())
+	go sr.buildBlockForNextRound(nextRound, nextRoundTimeStamp)
+}
+
+func (sr *subroundBlock) buildBlockForNextRound(round int64, roundTimeStamp time.Time) {
+	var header data.HeaderHandler
+	var body data.BodyHandler
+	isStateChanged := false
+	defer func() {
+		sr.blockPipeline.finishBuilding(header, body, isStateChanged)
+	}()
+
+	haveTimeUntilRoundBegins := func() bool {
+		return sr.SyncTimer().CurrentTime().Before(roundTimeStamp) && !sr.blockPipeline.isOutOfSync()
+	}
+
+	initialHeader, err := sr.createHeaderForRound(round, roundTimeStamp)
+	if err != nil {
+		log.Debug("buildBlockForNextRound.createHeaderForRound", "error", err.Error())
+		return
+	}
+
+	header, body, err = sr.BlockProcessor().CreateBlock(initialHeader, haveTimeUntilRoundBegins)
+	if err != nil {
+		log.Debug("buildBlockForNextRound.CreateBlock", "error", err.Error())
+		sr.BlockProcessor().RevertAccountState(initialHeader)
+		header, body = nil, nil
+		return
+	}
+
+	isStateChanged = true
+
+	log.Debug("block for the next round has been built in advance",
+		"round", round,
+		"nonce", header.GetNonce())
+}
+
 func (sr *subroundBlock) sendBlock(body data.BodyHandler, header data.HeaderHandler) bool {
 	marshalizedBody, err := sr.Marshalizer().Marshal(body)
 	if err != nil {
@@ -268,6 +381,10 @@ func (sr *subroundBlock) sendBlockHeader(headerHandler data.HeaderHandler, marsh
 }
 
 func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
+	return sr.createHeaderForRound(sr.Rounder().Index(), sr.Rounder().TimeStamp())
+}
+
+func (sr *subroundBlock) createHeaderForRound(roundIndex int64, roundTimeStamp time.Time) (data.HeaderHandler, error) {
 	var nonce uint64
 	var prevHash []byte
 	var prevRandSeed []byte
@@ -283,8 +400,7 @@ func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
 		prevRandSeed = currentHeader.GetRandSeed()
 	}
 
-	round := uint64(sr.Rounder().Index())
-	hdr := sr.BlockProcessor().CreateNewHeader(round, nonce)
+	hdr := sr.BlockProcessor().CreateNewHeader(uint64(roundIndex), nonce)
 	hdr.SetPrevHash(prevHash)
 
 	randSeed, err := sr.SingleSigner().Sign(sr.PrivateKey(), prevRandSeed)
@@ -293,7 +409,7 @@ func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
 	}
 
 	hdr.SetShardID(sr.ShardCoordinator().SelfId())
-	hdr.SetTimeStamp(uint64(sr.Rounder().TimeStamp().Unix()))
+	hdr.SetTimeStamp(uint64(roundTimeStamp.Unix()))
 	hdr.SetPrevRandSeed(prevRandSeed)
 	hdr.SetRandSeed(randSeed)
 	hdr.SetChainID(sr.ChainID())
@@ -462,6 +578,8 @@ func (sr *subroundBlock) processReceivedBlock(cnsDta *consensus.Message) bool {
 		return false
 	}
 
+	sr.discardPipelinedBlock()
+
 	defer func() {
 		sr.SetProcessingBlock(false)
 	}()
@@ -479,6 +597,8 @@ func (sr *subroundBlock) processReceivedBlock(cnsDta *consensus.Message) bool {
 		return false
 	}
 
+	sr.RoundTracer().SetBlockReceived(cnsDta.RoundIndex, sr.SyncTimer().CurrentTime())
+
 	node := string(cnsDta.PubKey)
 
 	startTime := sr.RoundTimeStamp
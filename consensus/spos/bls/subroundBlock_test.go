@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -520,6 +521,41 @@ func TestSubroundBlock_ProcessReceivedBlockShouldReturnTrue(t *testing.T) {
 	assert.True(t, sr.ProcessReceivedBlock(cnsMsg))
 }
 
+func TestSubroundBlock_ProcessReceivedBlockShouldTraceTheReceivedBlock(t *testing.T) {
+	t.Parallel()
+	container := mock.InitConsensusCore()
+	blockTraced := false
+	container.SetRoundTracer(&mock.RoundTracerStub{
+		SetBlockReceivedCalled: func(roundIndex int64, receivedTime time.Time) {
+			blockTraced = true
+		},
+	})
+	sr := *initSubroundBlock(nil, container)
+	blkBody := &block.Body{
+		MiniBlocks: []*block.MiniBlock{},
+	}
+	blkBodyStr, _ := mock.MarshalizerMock{}.Marshal(blkBody)
+	cnsMsg := consensus.NewConsensusMessage(
+		nil,
+		nil,
+		blkBodyStr,
+		nil,
+		[]byte(sr.ConsensusGroup()[0]),
+		[]byte("sig"),
+		int(bls.MtBlockBody),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	sr.Header = &block.Header{}
+	sr.Body = blkBody
+	assert.True(t, sr.ProcessReceivedBlock(cnsMsg))
+	assert.True(t, blockTraced)
+}
+
 func TestSubroundBlock_RemainingTimeShouldReturnNegativeValue(t *testing.T) {
 	t.Parallel()
 	container := mock.InitConsensusCore()
@@ -919,3 +955,281 @@ func TestSubroundBlock_ReceivedBlockComputeProcessDurationWithZeroDurationShould
 
 	srBlock.ComputeSubroundProcessingMetric(time.Now(), "dummy")
 }
+
+func createBlockChainWithCurrentHeader(header data.HeaderHandler, headerHash []byte) *mock.BlockChainMock {
+	return &mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return header
+		},
+		GetCurrentBlockHeaderHashCalled: func() []byte {
+			return headerHash
+		},
+	}
+}
+
+func createPipelineForSubroundBlock(blockProcessor process.BlockProcessor) bls.BlockPipeline {
+	pipeline, _ := bls.NewBlockPipeline(
+		&mock.ForkDetectorMock{
+			CheckForkCalled: func() *process.ForkInfo {
+				return process.NewForkInfo()
+			},
+		},
+		blockProcessor,
+	)
+
+	return pipeline
+}
+
+func TestSubroundBlock_DoBlockJobShouldProposePipelinedBlockWithoutProcessingItAgain(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(createBlockChainWithCurrentHeader(&block.Header{Nonce: 1}, []byte("hash")), container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[0])
+	container.SetRounder(&mock.RounderMock{RoundIndex: 2})
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			return nil
+		},
+	})
+
+	bpm := mock.InitBlockProcessorMock()
+	bpm.ProcessBlockCalled = func(header data.HeaderHandler, body data.BodyHandler, haveTime func() time.Duration) error {
+		assert.Fail(t, "should have not processed the pipelined block again")
+		return nil
+	}
+	bpm.RevertAccountStateCalled = func(header data.HeaderHandler) {
+		assert.Fail(t, "should have not reverted the state of the pipelined block")
+	}
+	bpm.CreateBlockCalled = func(header data.HeaderHandler, haveTime func() bool) (data.HeaderHandler, data.BodyHandler, error) {
+		assert.Fail(t, "should have not created a new block")
+		return nil, nil, errors.New("error")
+	}
+	container.SetBlockProcessor(bpm)
+
+	pipelinedHeader := &block.Header{Round: 2, Nonce: 2, PrevHash: []byte("hash")}
+	pipeline := createPipelineForSubroundBlock(bpm)
+	_ = pipeline.StartBuilding(2)
+	pipeline.FinishBuilding(pipelinedHeader, &block.Body{}, true)
+	sr.SetBlockPipeline(pipeline)
+
+	r := sr.DoBlockJob()
+	assert.True(t, r)
+	assert.Equal(t, pipelinedHeader, sr.Header)
+}
+
+func TestSubroundBlock_DoBlockJobShouldCreateBlockWhenPipelinedBlockIsInvalid(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(createBlockChainWithCurrentHeader(&block.Header{Nonce: 1}, []byte("hash")), container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[0])
+	container.SetRounder(&mock.RounderMock{RoundIndex: 2})
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastConsensusMessageCalled: func(message *consensus.Message) error {
+			return nil
+		},
+	})
+
+	bpm := mock.InitBlockProcessorMock()
+	revertCalled := false
+	bpm.RevertAccountStateCalled = func(header data.HeaderHandler) {
+		revertCalled = true
+	}
+	container.SetBlockProcessor(bpm)
+
+	pipelinedHeader := &block.Header{Round: 2, Nonce: 2, PrevHash: []byte("other hash")}
+	pipeline := createPipelineForSubroundBlock(bpm)
+	_ = pipeline.StartBuilding(2)
+	pipeline.FinishBuilding(pipelinedHeader, &block.Body{}, true)
+	sr.SetBlockPipeline(pipeline)
+
+	r := sr.DoBlockJob()
+	assert.True(t, r)
+	assert.True(t, revertCalled)
+	assert.False(t, pipelinedHeader == sr.Header)
+	assert.Equal(t, uint64(2), sr.Header.GetNonce())
+}
+
+func TestSubroundBlock_ProcessReceivedBlockShouldDiscardPipelinedBlock(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(nil, container)
+
+	bpm := mock.InitBlockProcessorMock()
+	revertCalled := false
+	bpm.RevertAccountStateCalled = func(header data.HeaderHandler) {
+		revertCalled = true
+	}
+	container.SetBlockProcessor(bpm)
+
+	pipeline := createPipelineForSubroundBlock(bpm)
+	_ = pipeline.StartBuilding(2)
+	pipeline.FinishBuilding(&block.Header{Round: 2, Nonce: 2, PrevHash: []byte("hash")}, &block.Body{}, true)
+	sr.SetBlockPipeline(pipeline)
+
+	hdr := &block.Header{Nonce: 1}
+	blkBody := &block.Body{}
+	blkBodyStr, _ := mock.MarshalizerMock{}.Marshal(blkBody)
+	cnsMsg := consensus.NewConsensusMessage(
+		nil,
+		nil,
+		blkBodyStr,
+		nil,
+		[]byte(sr.ConsensusGroup()[0]),
+		[]byte("sig"),
+		int(bls.MtBlockBody),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	sr.Body = blkBody
+	sr.Header = hdr
+	_ = sr.ProcessReceivedBlock(cnsMsg)
+
+	assert.True(t, revertCalled)
+	_, _, ok := pipeline.Take(2, &block.Header{Nonce: 1}, []byte("hash"))
+	assert.False(t, ok)
+}
+
+func TestSubroundBlock_PrepareBlockForNextRoundShouldBuildBlockWhenLeader(t *testing.T) {
+	t.Parallel()
+
+	committedHeader := &block.Header{Round: 1, Nonce: 1, RandSeed: []byte("rand seed")}
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(createBlockChainWithCurrentHeader(committedHeader, []byte("hash")), container)
+	sr.SetSelfPubKey("A")
+	container.SetRounder(&mock.RounderMock{RoundIndex: 1})
+
+	bpm := mock.InitBlockProcessorMock()
+	revertCalled := false
+	bpm.RevertAccountStateCalled = func(header data.HeaderHandler) {
+		revertCalled = true
+	}
+	container.SetBlockProcessor(bpm)
+
+	pipeline := createPipelineForSubroundBlock(bpm)
+	sr.SetBlockPipeline(pipeline)
+
+	sr.PrepareBlockForNextRound(committedHeader)
+
+	header, body, ok := pipeline.Take(2, committedHeader, []byte("hash"))
+	assert.True(t, ok)
+	assert.NotNil(t, body)
+	assert.Equal(t, uint64(2), header.GetRound())
+	assert.Equal(t, uint64(2), header.GetNonce())
+	assert.Equal(t, []byte("hash"), header.GetPrevHash())
+	assert.False(t, revertCalled)
+}
+
+func TestSubroundBlock_PrepareBlockForNextRoundShouldBuildBlockOnItsOwnGoRoutine(t *testing.T) {
+	t.Parallel()
+
+	committedHeader := &block.Header{Round: 1, Nonce: 1, RandSeed: []byte("rand seed")}
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(createBlockChainWithCurrentHeader(committedHeader, []byte("hash")), container)
+	sr.SetSelfPubKey("A")
+	container.SetRounder(&mock.RounderMock{RoundIndex: 1})
+
+	chCreateBlock := make(chan struct{})
+	bpm := mock.InitBlockProcessorMock()
+	bpm.CreateBlockCalled = func(header data.HeaderHandler, haveTime func() bool) (data.HeaderHandler, data.BodyHandler, error) {
+		<-chCreateBlock
+		return header, &block.Body{}, nil
+	}
+	container.SetBlockProcessor(bpm)
+
+	pipeline := createPipelineForSubroundBlock(bpm)
+	sr.SetBlockPipeline(pipeline)
+
+	sr.PrepareBlockForNextRound(committedHeader)
+
+	assert.False(t, sr.ProcessingBlock())
+	close(chCreateBlock)
+
+	_, _, ok := pipeline.Take(2, committedHeader, []byte("hash"))
+	assert.True(t, ok)
+}
+
+func TestSubroundBlock_PrepareBlockForNextRoundWhenCreateBlockFailsShouldRevertTheState(t *testing.T) {
+	t.Parallel()
+
+	committedHeader := &block.Header{Round: 1, Nonce: 1, RandSeed: []byte("rand seed")}
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(createBlockChainWithCurrentHeader(committedHeader, []byte("hash")), container)
+	sr.SetSelfPubKey("A")
+	container.SetRounder(&mock.RounderMock{RoundIndex: 1})
+
+	numReverts := 0
+	bpm := mock.InitBlockProcessorMock()
+	bpm.CreateBlockCalled = func(header data.HeaderHandler, haveTime func() bool) (data.HeaderHandler, data.BodyHandler, error) {
+		return nil, nil, errors.New("error")
+	}
+	bpm.RevertAccountStateCalled = func(header data.HeaderHandler) {
+		numReverts++
+	}
+	container.SetBlockProcessor(bpm)
+
+	pipeline := createPipelineForSubroundBlock(bpm)
+	sr.SetBlockPipeline(pipeline)
+
+	sr.PrepareBlockForNextRound(committedHeader)
+
+	_, _, ok := pipeline.Take(2, committedHeader, []byte("hash"))
+	assert.False(t, ok)
+	assert.Equal(t, 1, numReverts)
+}
+
+func TestSubroundBlock_PrepareBlockForNextRoundShouldNotBuildBlockWhenNotLeader(t *testing.T) {
+	t.Parallel()
+
+	committedHeader := &block.Header{Round: 1, Nonce: 1, RandSeed: []byte("rand seed")}
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(createBlockChainWithCurrentHeader(committedHeader, []byte("hash")), container)
+	sr.SetSelfPubKey("B")
+	container.SetRounder(&mock.RounderMock{RoundIndex: 1})
+
+	bpm := mock.InitBlockProcessorMock()
+	bpm.CreateBlockCalled = func(header data.HeaderHandler, haveTime func() bool) (data.HeaderHandler, data.BodyHandler, error) {
+		assert.Fail(t, "should have not created a block")
+		return nil, nil, errors.New("error")
+	}
+	container.SetBlockProcessor(bpm)
+
+	pipeline := createPipelineForSubroundBlock(bpm)
+	sr.SetBlockPipeline(pipeline)
+
+	sr.PrepareBlockForNextRound(committedHeader)
+
+	_, _, ok := pipeline.Take(2, committedHeader, []byte("hash"))
+	assert.False(t, ok)
+}
+
+func TestSubroundBlock_PrepareBlockForNextRoundShouldNotBuildBlockAfterEpochStart(t *testing.T) {
+	t.Parallel()
+
+	committedHeader := &block.Header{Round: 1, Nonce: 1, EpochStartMetaHash: []byte("epoch start")}
+	container := mock.InitConsensusCore()
+	sr := *initSubroundBlock(createBlockChainWithCurrentHeader(committedHeader, []byte("hash")), container)
+	sr.SetSelfPubKey("A")
+	container.SetRounder(&mock.RounderMock{RoundIndex: 1})
+
+	bpm := mock.InitBlockProcessorMock()
+	bpm.CreateBlockCalled = func(header data.HeaderHandler, haveTime func() bool) (data.HeaderHandler, data.BodyHandler, error) {
+		assert.Fail(t, "should have not created a block")
+		return nil, nil, errors.New("error")
+	}
+	container.SetBlockProcessor(bpm)
+
+	pipeline := createPipelineForSubroundBlock(bpm)
+	sr.SetBlockPipeline(pipeline)
+
+	sr.PrepareBlockForNextRound(committedHeader)
+
+	_, _, ok := pipeline.Take(2, committedHeader, []byte("hash"))
+	assert.False(t, ok)
+}
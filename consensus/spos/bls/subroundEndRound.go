@@ -21,6 +21,10 @@ type subroundEndRound struct {
 	displayStatistics             func()
 	appStatusHandler              core.AppStatusHandler
 	mutProcessingEndRound         sync.Mutex
+	blockCommittedHandler         func(header data.HeaderHandler)
+	// jobRound is the last round in which the job of this subround prepared the block data for broadcast. The
+	// block committed in the same round is notified only afterwards, as its data must not be altered before.
+	jobRound int64
 }
 
 // SetAppStatusHandler method set appStatusHandler
@@ -53,6 +57,8 @@ func NewSubroundEndRound(
 		displayStatistics,
 		statusHandler.NewNilStatusHandler(),
 		sync.Mutex{},
+		nil,
+		-1,
 	}
 	srEndRound.Job = srEndRound.doEndRoundJob
 	srEndRound.Check = srEndRound.doEndRoundConsensusCheck
@@ -170,6 +176,15 @@ func (sr *subroundEndRound) doEndRoundJob() bool {
 			}
 		}
 
+		sr.mutProcessingEndRound.Lock()
+		sr.jobRound = sr.Rounder().Index()
+		isBlockAlreadyCommitted := sr.IsSubroundFinished(sr.Current())
+		sr.mutProcessingEndRound.Unlock()
+
+		if isBlockAlreadyCommitted {
+			sr.notifyBlockCommitted(sr.Blockchain().GetCurrentBlockHeader())
+		}
+
 		return sr.doEndRoundJobByParticipant(nil)
 	}
 
@@ -177,6 +192,7 @@ func (sr *subroundEndRound) doEndRoundJob() bool {
 }
 
 func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
+	aggregationStartTime := time.Now()
 	bitmap := sr.GenerateBitmap(SrSignature)
 	err := sr.checkSignaturesValidity(bitmap)
 	if err != nil {
@@ -190,6 +206,7 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 		log.Debug("doEndRoundJob.AggregateSigs", "error", err.Error())
 		return false
 	}
+	sr.RoundTracer().SetAggregationDuration(sr.Rounder().Index(), time.Since(aggregationStartTime))
 
 	sr.Header.SetPubKeysBitmap(bitmap)
 	sr.Header.SetSignature(sig)
@@ -244,6 +261,8 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 
 	sr.updateMetricsForLeader()
 
+	sr.notifyBlockCommitted(sr.Header)
+
 	return true
 }
 
@@ -277,25 +296,41 @@ func (sr *subroundEndRound) createAndBroadcastHeaderFinalInfo() {
 }
 
 func (sr *subroundEndRound) doEndRoundJobByParticipant(cnsDta *consensus.Message) bool {
+	committedHeader, isBlockCommittedInJobRound := sr.commitBlockByParticipant(cnsDta)
+	if check.IfNil(committedHeader) {
+		return false
+	}
+
+	// the block of the next round is prepared only after the end round processing lock is released
+	if isBlockCommittedInJobRound {
+		sr.notifyBlockCommitted(committedHeader)
+	}
+
+	return true
+}
+
+// commitBlockByParticipant returns the committed header, if any, and whether it was committed in the round in
+// which the job of this subround was done
+func (sr *subroundEndRound) commitBlockByParticipant(cnsDta *consensus.Message) (data.HeaderHandler, bool) {
 	sr.mutProcessingEndRound.Lock()
 	defer sr.mutProcessingEndRound.Unlock()
 
 	if sr.RoundCanceled {
-		return false
+		return nil, false
 	}
 	if !sr.IsConsensusDataSet() {
-		return false
+		return nil, false
 	}
 	if !sr.IsSubroundFinished(sr.Previous()) {
-		return false
+		return nil, false
 	}
 	if sr.IsSubroundFinished(sr.Current()) {
-		return false
+		return nil, false
 	}
 
 	haveHeader, header := sr.haveConsensusHeaderWithFullInfo(cnsDta)
 	if !haveHeader {
-		return false
+		return nil, false
 	}
 
 	defer func() {
@@ -312,11 +347,11 @@ func (sr *subroundEndRound) doEndRoundJobByParticipant(cnsDta *consensus.Message
 			"header round", header.GetRound(),
 			"extended called", sr.ExtendedCalled,
 		)
-		return false
+		return nil, false
 	}
 
 	if sr.isOutOfTime() {
-		return false
+		return nil, false
 	}
 
 	startTime := time.Now()
@@ -331,7 +366,7 @@ func (sr *subroundEndRound) doEndRoundJobByParticipant(cnsDta *consensus.Message
 	}
 	if err != nil {
 		log.Debug("doEndRoundJobByParticipant.CommitBlock", "error", err.Error())
-		return false
+		return nil, false
 	}
 
 	sr.SetStatus(sr.Current(), spos.SsFinished)
@@ -354,7 +389,14 @@ func (sr *subroundEndRound) doEndRoundJobByParticipant(cnsDta *consensus.Message
 
 	msg := fmt.Sprintf("Added %s block with nonce  %d  in blockchain", headerTypeMsg, header.GetNonce())
 	log.Debug(display.Headline(msg, sr.SyncTimer().FormattedCurrentTime(), "-"))
-	return true
+
+	return header, sr.jobRound == int64(header.GetRound())
+}
+
+func (sr *subroundEndRound) notifyBlockCommitted(header data.HeaderHandler) {
+	if sr.blockCommittedHandler != nil {
+		sr.blockCommittedHandler(header)
+	}
 }
 
 func (sr *subroundEndRound) haveConsensusHeaderWithFullInfo(cnsDta *consensus.Message) (bool, data.HeaderHandler) {
@@ -499,6 +499,26 @@ func TestSubroundEndRound_DoEndRoundJobAllOK(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundEndRound_DoEndRoundJobShouldTraceTheAggregationDuration(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	aggregationTraced := false
+	container.SetRoundTracer(&mock.RoundTracerStub{
+		SetAggregationDurationCalled: func(roundIndex int64, duration time.Duration) {
+			aggregationTraced = true
+		},
+	})
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.SetSelfPubKey("A")
+
+	sr.Header = &block.Header{}
+
+	r := sr.DoEndRoundJob()
+	assert.True(t, r)
+	assert.True(t, aggregationTraced)
+}
+
 func TestSubroundEndRound_CheckIfSignatureIsFilled(t *testing.T) {
 	t.Parallel()
 
@@ -942,3 +962,77 @@ func TestSubroundEndRound_IsBlockHeaderFinalInfoValidShouldReturnTrue(t *testing
 	isValid := sr.IsBlockHeaderFinalInfoValid(cnsDta)
 	assert.True(t, isValid)
 }
+
+func TestSubroundEndRound_DoEndRoundJobByLeaderShouldNotifyCommittedBlock(t *testing.T) {
+	t.Parallel()
+
+	sr := *initSubroundEndRound()
+	sr.SetSelfPubKey("A")
+	sr.Header = &block.Header{Nonce: 37}
+
+	var notifiedHeader data.HeaderHandler
+	sr.SetBlockCommittedHandler(func(header data.HeaderHandler) {
+		notifiedHeader = header
+	})
+
+	r := sr.DoEndRoundJob()
+	assert.True(t, r)
+	assert.Equal(t, sr.Header, notifiedHeader)
+}
+
+func TestSubroundEndRound_DoEndRoundJobByParticipantShouldNotifyOnlyAfterJobWasDone(t *testing.T) {
+	t.Parallel()
+
+	hdr := &block.Header{Nonce: 37}
+	container := mock.InitConsensusCore()
+	container.SetBlockchain(&mock.BlockChainMock{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return hdr
+		},
+	})
+	sr := *initSubroundEndRoundWithContainer(container)
+	sr.SetSelfPubKey("B")
+	sr.Header = hdr
+	sr.AddReceivedHeader(hdr)
+	sr.SetStatus(bls.SrSignature, spos.SsFinished)
+	sr.SetStatus(bls.SrEndRound, spos.SsNotFinished)
+
+	numNotifications := 0
+	sr.SetBlockCommittedHandler(func(header data.HeaderHandler) {
+		assert.Equal(t, hdr, header)
+		numNotifications++
+	})
+
+	res := sr.DoEndRoundJobByParticipant(&consensus.Message{})
+	assert.True(t, res)
+	assert.Equal(t, 0, numNotifications)
+
+	res = sr.DoEndRoundJob()
+	assert.False(t, res)
+	assert.Equal(t, 1, numNotifications)
+}
+
+func TestSubroundEndRound_DoEndRoundJobByParticipantAfterJobShouldNotifyCommittedBlock(t *testing.T) {
+	t.Parallel()
+
+	hdr := &block.Header{Nonce: 37}
+	sr := *initSubroundEndRound()
+	sr.SetSelfPubKey("B")
+	sr.Header = hdr
+	sr.AddReceivedHeader(hdr)
+	sr.SetStatus(bls.SrEndRound, spos.SsNotFinished)
+
+	numNotifications := 0
+	sr.SetBlockCommittedHandler(func(header data.HeaderHandler) {
+		numNotifications++
+	})
+
+	res := sr.DoEndRoundJob()
+	assert.False(t, res)
+	assert.Equal(t, 0, numNotifications)
+
+	sr.SetStatus(bls.SrSignature, spos.SsFinished)
+	res = sr.DoEndRoundJobByParticipant(&consensus.Message{})
+	assert.True(t, res)
+	assert.Equal(t, 1, numNotifications)
+}
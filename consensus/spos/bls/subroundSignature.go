@@ -81,7 +81,12 @@ func (sr *subroundSignature) doSignatureJob() bool {
 	isSelfLeader := sr.IsSelfLeaderInCurrentRound()
 
 	if !isSelfLeader {
-		//TODO: Analyze it is possible to send message only to leader with O(1) instead of O(n)
+		leader, errGetLeader := sr.GetLeader()
+		if errGetLeader != nil {
+			log.Debug("doSignatureJob.GetLeader", "error", errGetLeader.Error())
+			return false
+		}
+
 		cnsMsg := consensus.NewConsensusMessage(
 			sr.GetData(),
 			signatureShare,
@@ -98,9 +103,9 @@ func (sr *subroundSignature) doSignatureJob() bool {
 			sr.CurrentPid(),
 		)
 
-		err = sr.BroadcastMessenger().BroadcastConsensusMessage(cnsMsg)
+		err = sr.BroadcastMessenger().SendConsensusMessageToValidator(cnsMsg, []byte(leader))
 		if err != nil {
-			log.Debug("doSignatureJob.BroadcastConsensusMessage", "error", err.Error())
+			log.Debug("doSignatureJob.SendConsensusMessageToValidator", "error", err.Error())
 			return false
 		}
 
@@ -180,6 +185,8 @@ func (sr *subroundSignature) receivedSignature(cnsDta *consensus.Message) bool {
 		return false
 	}
 
+	sr.RoundTracer().AddSignatureReceived(cnsDta.RoundIndex, cnsDta.PubKey, sr.SyncTimer().CurrentTime())
+
 	sr.PeerHonestyHandler().ChangeScore(
 		node,
 		spos.GetConsensusTopicID(sr.ShardCoordinator()),
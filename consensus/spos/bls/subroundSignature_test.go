@@ -517,6 +517,40 @@ func TestSubroundSignature_DoSignatureConsensusCheckShouldReturnTrueWhenFallback
 	assert.True(t, sr.DoSignatureConsensusCheck())
 }
 
+func TestSubroundSignature_ReceivedSignatureShouldTraceTheSignature(t *testing.T) {
+	t.Parallel()
+
+	container := mock.InitConsensusCore()
+	var tracedPubKey []byte
+	container.SetRoundTracer(&mock.RoundTracerStub{
+		AddSignatureReceivedCalled: func(roundIndex int64, pubKey []byte, receivedTime time.Time) {
+			tracedPubKey = pubKey
+		},
+	})
+	sr := *initSubroundSignatureWithContainer(container)
+	sr.SetSelfPubKey(sr.ConsensusGroup()[0])
+
+	cnsMsg := consensus.NewConsensusMessage(
+		sr.Data,
+		[]byte("signature"),
+		nil,
+		nil,
+		[]byte(sr.ConsensusGroup()[1]),
+		[]byte("sig"),
+		int(bls.MtSignature),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+
+	r := sr.ReceivedSignature(cnsMsg)
+	assert.True(t, r)
+	assert.Equal(t, cnsMsg.PubKey, tracedPubKey)
+}
+
 func TestSubroundSignature_ReceivedSignatureReturnFalseWhenConsensusDataIsNotEqual(t *testing.T) {
 	t.Parallel()
 
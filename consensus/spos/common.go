@@ -9,3 +9,9 @@ import (
 func GetConsensusTopicID(shardCoordinator sharding.Coordinator) string {
 	return core.ConsensusTopic + shardCoordinator.CommunicationIdentifier(shardCoordinator.SelfId())
 }
+
+// GetValidatorDirectSendTopicID will construct and return the topic ID used by the validators of the self shard
+// when sending consensus messages directly to each other
+func GetValidatorDirectSendTopicID(shardCoordinator sharding.Coordinator) string {
+	return GetConsensusTopicID(shardCoordinator) + core.ValidatorDirectSendTopicSuffix
+}
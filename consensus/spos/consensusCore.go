@@ -34,6 +34,7 @@ type ConsensusCore struct {
 	peerHonestyHandler            consensus.PeerHonestyHandler
 	headerSigVerifier             consensus.HeaderSigVerifier
 	fallbackHeaderValidator       consensus.FallbackHeaderValidator
+	roundTracer                   consensus.RoundTracer
 }
 
 // ConsensusCoreArgs store all arguments that are needed to create a ConsensusCore object
@@ -57,6 +58,7 @@ type ConsensusCoreArgs struct {
 	PeerHonestyHandler            consensus.PeerHonestyHandler
 	HeaderSigVerifier             consensus.HeaderSigVerifier
 	FallbackHeaderValidator       consensus.FallbackHeaderValidator
+	RoundTracer                   consensus.RoundTracer
 }
 
 // NewConsensusCore creates a new ConsensusCore instance
@@ -83,6 +85,7 @@ func NewConsensusCore(
 		peerHonestyHandler:            args.PeerHonestyHandler,
 		headerSigVerifier:             args.HeaderSigVerifier,
 		fallbackHeaderValidator:       args.FallbackHeaderValidator,
+		roundTracer:                   args.RoundTracer,
 	}
 
 	err := ValidateConsensusCore(consensusCore)
@@ -188,6 +191,11 @@ func (cc *ConsensusCore) FallbackHeaderValidator() consensus.FallbackHeaderValid
 	return cc.fallbackHeaderValidator
 }
 
+// RoundTracer returns the round tracer which records the timings of the consensus rounds
+func (cc *ConsensusCore) RoundTracer() consensus.RoundTracer {
+	return cc.roundTracer
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cc *ConsensusCore) IsInterfaceNil() bool {
 	return cc == nil
@@ -31,6 +31,7 @@ func createDefaultConsensusCoreArgs() *spos.ConsensusCoreArgs {
 		PeerHonestyHandler:            consensusCoreMock.PeerHonestyHandler(),
 		HeaderSigVerifier:             consensusCoreMock.HeaderSigVerifier(),
 		FallbackHeaderValidator:       consensusCoreMock.FallbackHeaderValidator(),
+		RoundTracer:                   consensusCoreMock.RoundTracer(),
 	}
 	return args
 }
@@ -285,6 +286,20 @@ func TestConsensusCore_WithNilFallbackHeaderValidatorShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilFallbackHeaderValidator, err)
 }
 
+func TestConsensusCore_WithNilRoundTracerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.RoundTracer = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilRoundTracer, err)
+}
+
 func TestConsensusCore_CreateConsensusCoreShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
@@ -16,6 +17,7 @@ type consensusMessageValidator struct {
 	consensusState       *ConsensusState
 	consensusService     ConsensusService
 	peerSignatureHandler crypto.PeerSignatureHandler
+	equivocationDetector EquivocationDetector
 
 	signatureSize       int
 	publicKeySize       int
@@ -36,14 +38,20 @@ type ArgsConsensusMessageValidator struct {
 	PublicKeySize        int
 	HasherSize           int
 	ChainID              []byte
+	EquivocationDetector EquivocationDetector
 }
 
 // NewConsensusMessageValidator creates a new consensusMessageValidator object
 func NewConsensusMessageValidator(args *ArgsConsensusMessageValidator) (*consensusMessageValidator, error) {
+	if check.IfNil(args.EquivocationDetector) {
+		return nil, ErrNilEquivocationDetector
+	}
+
 	cmv := &consensusMessageValidator{
 		consensusState:       args.ConsensusState,
 		consensusService:     args.ConsensusService,
 		peerSignatureHandler: args.PeerSignatureHandler,
+		equivocationDetector: args.EquivocationDetector,
 		signatureSize:        args.SignatureSize,
 		publicKeySize:        args.PublicKeySize,
 		chainID:              args.ChainID,
@@ -134,6 +142,8 @@ func (cmv *consensusMessageValidator) checkConsensusMessageValidity(cnsMsg *cons
 	}
 
 	if cmv.isMessageTypeLimitReached(cnsMsg.PubKey, cnsMsg.RoundIndex, msgType) {
+		cmv.checkEquivocation(cnsMsg, originator)
+
 		log.Trace("received message type from consensus topic reached the limit",
 			"msg type", cmv.consensusService.GetStringValue(msgType),
 			"public key", cnsMsg.PubKey,
@@ -159,10 +169,30 @@ func (cmv *consensusMessageValidator) checkConsensusMessageValidity(cnsMsg *cons
 	}
 
 	cmv.addMessageTypeToPublicKey(cnsMsg.PubKey, cnsMsg.RoundIndex, msgType)
+	cmv.equivocationDetector.AddConsensusMessage(cnsMsg)
 
 	return nil
 }
 
+// checkEquivocation hands over to the equivocation detector a message signed by the leader which was received after
+// the limit for its type had been reached, as it could be signed for a different header in the same round. The peer
+// signature is verified only for these messages, so that a flood of repeated messages would not be costly.
+func (cmv *consensusMessageValidator) checkEquivocation(cnsMsg *consensus.Message, originator core.PeerID) {
+	if len(cnsMsg.LeaderSignature) == 0 {
+		return
+	}
+
+	err := cmv.peerSignatureHandler.VerifyPeerSignature(cnsMsg.PubKey, core.PeerID(cnsMsg.OriginatorPid), cnsMsg.Signature)
+	if err != nil {
+		return
+	}
+	if core.PeerID(cnsMsg.OriginatorPid) != originator {
+		return
+	}
+
+	cmv.equivocationDetector.AddConsensusMessage(cnsMsg)
+}
+
 func (cmv *consensusMessageValidator) isBlockHeaderHashSizeValid(cnsMsg *consensus.Message) bool {
 	msgType := consensus.MessageType(cnsMsg.MsgType)
 	isMessageWithBlockBody := cmv.consensusService.IsMessageWithBlockBody(msgType)
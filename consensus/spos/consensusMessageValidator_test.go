@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createDefaultConsensusMessageValidatorArgs() *spos.ArgsConsensusMessageValidator {
@@ -37,6 +38,7 @@ func createDefaultConsensusMessageValidatorArgs() *spos.ArgsConsensusMessageVali
 		PublicKeySize:        PublicKeySize,
 		HasherSize:           hasher.Size(),
 		ChainID:              chainID,
+		EquivocationDetector: &mock.EquivocationDetectorStub{},
 	}
 
 	return argsConsensusMessageValidator
@@ -658,3 +660,91 @@ func TestResetConsensusMessages_ShouldWork(t *testing.T) {
 	assert.Equal(t, uint32(0), cmv.GetNumOfMessageTypeForPublicKey([]byte("pk1"), 2, bls.MtBlockBody))
 	assert.Equal(t, uint32(0), cmv.GetNumOfMessageTypeForPublicKey([]byte("pk2"), 1, bls.MtBlockHeaderFinalInfo))
 }
+
+func TestNewConsensusMessageValidator_NilEquivocationDetectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	consensusMessageValidatorArgs := createDefaultConsensusMessageValidatorArgs()
+	consensusMessageValidatorArgs.EquivocationDetector = nil
+	cmv, err := spos.NewConsensusMessageValidator(consensusMessageValidatorArgs)
+
+	assert.Nil(t, cmv)
+	assert.Equal(t, spos.ErrNilEquivocationDetector, err)
+}
+
+func createFinalInfoMessage(args *spos.ArgsConsensusMessageValidator) *consensus.Message {
+	headerHash := make([]byte, args.HasherSize)
+	_, _ = rand.Read(headerHash)
+	bitmapSize := (len(args.ConsensusState.ConsensusGroup()) + 7) / 8
+	aggSig := make([]byte, SignatureSize)
+	_, _ = rand.Read(aggSig)
+	leaderSig := make([]byte, SignatureSize)
+	_, _ = rand.Read(leaderSig)
+	sig := make([]byte, SignatureSize)
+	_, _ = rand.Read(sig)
+
+	return &consensus.Message{
+		ChainID:            chainID,
+		MsgType:            int64(bls.MtBlockHeaderFinalInfo),
+		BlockHeaderHash:    headerHash,
+		PubKey:             []byte(args.ConsensusState.ConsensusGroup()[0]),
+		Signature:          sig,
+		RoundIndex:         10,
+		PubKeysBitmap:      make([]byte, bitmapSize),
+		AggregateSignature: aggSig,
+		LeaderSignature:    leaderSig,
+	}
+}
+
+func TestCheckConsensusMessageValidity_MessagesWithLeaderSignatureShouldBeAddedToEquivocationDetector(t *testing.T) {
+	t.Parallel()
+
+	addedMessages := make([]*consensus.Message, 0)
+	consensusMessageValidatorArgs := createDefaultConsensusMessageValidatorArgs()
+	consensusMessageValidatorArgs.ConsensusState.RoundIndex = 10
+	consensusMessageValidatorArgs.EquivocationDetector = &mock.EquivocationDetectorStub{
+		AddConsensusMessageCalled: func(cnsMsg *consensus.Message) {
+			addedMessages = append(addedMessages, cnsMsg)
+		},
+	}
+	cmv, _ := spos.NewConsensusMessageValidator(consensusMessageValidatorArgs)
+
+	firstMsg := createFinalInfoMessage(consensusMessageValidatorArgs)
+	err := cmv.CheckConsensusMessageValidity(firstMsg, "")
+	assert.Nil(t, err)
+
+	secondMsg := createFinalInfoMessage(consensusMessageValidatorArgs)
+	err = cmv.CheckConsensusMessageValidity(secondMsg, "")
+	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
+
+	require.Equal(t, 2, len(addedMessages))
+	assert.True(t, firstMsg == addedMessages[0])
+	assert.True(t, secondMsg == addedMessages[1])
+}
+
+func TestCheckConsensusMessageValidity_MessageOverLimitWithInvalidSignatureShouldNotBeAddedToEquivocationDetector(t *testing.T) {
+	t.Parallel()
+
+	numAddedMessages := 0
+	consensusMessageValidatorArgs := createDefaultConsensusMessageValidatorArgs()
+	consensusMessageValidatorArgs.ConsensusState.RoundIndex = 10
+	consensusMessageValidatorArgs.PeerSignatureHandler = &mock.PeerSignatureHandler{
+		Signer: &mock.SingleSignerMock{
+			VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				return errors.New("local error")
+			},
+		},
+	}
+	consensusMessageValidatorArgs.EquivocationDetector = &mock.EquivocationDetectorStub{
+		AddConsensusMessageCalled: func(cnsMsg *consensus.Message) {
+			numAddedMessages++
+		},
+	}
+	cmv, _ := spos.NewConsensusMessageValidator(consensusMessageValidatorArgs)
+
+	cnsMsg := createFinalInfoMessage(consensusMessageValidatorArgs)
+	cmv.AddMessageTypeToPublicKey(cnsMsg.PubKey, 10, bls.MtBlockHeaderFinalInfo)
+	err := cmv.CheckConsensusMessageValidity(cnsMsg, "")
+	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
+	assert.Equal(t, 0, numAddedMessages)
+}
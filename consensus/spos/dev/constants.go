@@ -0,0 +1,44 @@
+package dev
+
+import (
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+)
+
+var log = logger.GetOrCreate("consensus/spos/dev")
+
+const (
+	// SrStartRound defines ID of Subround "Start round"
+	SrStartRound = bls.SrStartRound
+	// SrBlock defines ID of Subround "block", in which the single validator produces and commits the block
+	SrBlock = bls.SrBlock
+)
+
+// consensusGroupSize is the only consensus group size supported by the dev consensus
+const consensusGroupSize = 1
+
+// processingThresholdPercent specifies the max allocated time for processing the block as a percentage of the total time of the round
+const processingThresholdPercent = 85
+
+// srStartStartTime specifies the start time, from the total time of the round, of Subround Start
+const srStartStartTime = 0.0
+
+// srStartEndTime specifies the end time, from the total time of the round, of Subround Start
+const srStartEndTime = 0.05
+
+// srBlockStartTime specifies the start time, from the total time of the round, of Subround Block
+const srBlockStartTime = 0.05
+
+// srBlockEndTime specifies the end time, from the total time of the round, of Subround Block
+const srBlockEndTime = 0.95
+
+func getSubroundName(subroundId int) string {
+	switch subroundId {
+	case SrStartRound:
+		return "(START_ROUND)"
+	case SrBlock:
+		return "(BLOCK)"
+	default:
+		return "Undefined subround"
+	}
+}
@@ -0,0 +1,181 @@
+package dev
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/core/indexer"
+	"github.com/ElrondNetwork/elrond-go/statusHandler"
+)
+
+// factory defines the data needed by this factory to create the subrounds of the dev consensus: a start round
+// subround followed by a block subround in which the single validator produces and commits a block every round
+type factory struct {
+	consensusCore  spos.ConsensusCoreHandler
+	consensusState *spos.ConsensusState
+	worker         spos.WorkerHandler
+
+	appStatusHandler core.AppStatusHandler
+	indexer          indexer.Indexer
+	chainID          []byte
+	currentPid       core.PeerID
+}
+
+// NewSubroundsFactory creates a new dev subrounds factory
+func NewSubroundsFactory(
+	consensusDataContainer spos.ConsensusCoreHandler,
+	consensusState *spos.ConsensusState,
+	worker spos.WorkerHandler,
+	chainID []byte,
+	currentPid core.PeerID,
+) (*factory, error) {
+	err := spos.ValidateConsensusCore(consensusDataContainer)
+	if err != nil {
+		return nil, err
+	}
+	if consensusState == nil {
+		return nil, spos.ErrNilConsensusState
+	}
+	if check.IfNil(worker) {
+		return nil, spos.ErrNilWorker
+	}
+	if len(chainID) == 0 {
+		return nil, spos.ErrInvalidChainID
+	}
+	if consensusState.ConsensusGroupSize() != consensusGroupSize {
+		return nil, spos.ErrInvalidConsensusGroupSize
+	}
+
+	fct := factory{
+		consensusCore:    consensusDataContainer,
+		consensusState:   consensusState,
+		worker:           worker,
+		appStatusHandler: statusHandler.NewNilStatusHandler(),
+		indexer:          indexer.NewNilIndexer(),
+		chainID:          chainID,
+		currentPid:       currentPid,
+	}
+
+	return &fct, nil
+}
+
+// SetAppStatusHandler method will update the value of the factory's appStatusHandler
+func (fct *factory) SetAppStatusHandler(ash core.AppStatusHandler) error {
+	if check.IfNil(ash) {
+		return spos.ErrNilAppStatusHandler
+	}
+	fct.appStatusHandler = ash
+
+	return fct.worker.SetAppStatusHandler(ash)
+}
+
+// SetIndexer method will update the value of the factory's indexer
+func (fct *factory) SetIndexer(indexer indexer.Indexer) {
+	fct.indexer = indexer
+}
+
+// GenerateSubrounds will generate the subrounds used in the dev consensus
+func (fct *factory) GenerateSubrounds() error {
+	fct.consensusState.SetThreshold(SrBlock, consensusGroupSize)
+	fct.consensusState.SetFallbackThreshold(SrBlock, consensusGroupSize)
+	fct.consensusCore.Chronology().RemoveAllSubrounds()
+	fct.worker.RemoveAllReceivedMessagesCalls()
+
+	err := fct.generateStartRoundSubround()
+	if err != nil {
+		return err
+	}
+
+	return fct.generateBlockSubround()
+}
+
+func (fct *factory) getTimeDuration() time.Duration {
+	return fct.consensusCore.Rounder().TimeDuration()
+}
+
+func (fct *factory) generateStartRoundSubround() error {
+	subround, err := spos.NewSubround(
+		-1,
+		SrStartRound,
+		SrBlock,
+		int64(float64(fct.getTimeDuration())*srStartStartTime),
+		int64(float64(fct.getTimeDuration())*srStartEndTime),
+		getSubroundName(SrStartRound),
+		fct.consensusState,
+		fct.worker.GetConsensusStateChangedChannel(),
+		fct.worker.ExecuteStoredMessages,
+		fct.consensusCore,
+		fct.chainID,
+		fct.currentPid,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = subround.SetAppStatusHandler(fct.appStatusHandler)
+	if err != nil {
+		return err
+	}
+
+	subroundStartRound, err := bls.NewSubroundStartRound(
+		subround,
+		fct.worker.Extend,
+		processingThresholdPercent,
+		fct.worker.ExecuteStoredMessages,
+		fct.worker.ResetConsensusMessages,
+	)
+	if err != nil {
+		return err
+	}
+
+	subroundStartRound.SetIndexer(fct.indexer)
+
+	fct.consensusCore.Chronology().AddSubround(subroundStartRound)
+
+	return nil
+}
+
+func (fct *factory) generateBlockSubround() error {
+	subround, err := spos.NewSubround(
+		SrStartRound,
+		SrBlock,
+		-1,
+		int64(float64(fct.getTimeDuration())*srBlockStartTime),
+		int64(float64(fct.getTimeDuration())*srBlockEndTime),
+		getSubroundName(SrBlock),
+		fct.consensusState,
+		fct.worker.GetConsensusStateChangedChannel(),
+		fct.worker.ExecuteStoredMessages,
+		fct.consensusCore,
+		fct.chainID,
+		fct.currentPid,
+	)
+	if err != nil {
+		return err
+	}
+
+	err = subround.SetAppStatusHandler(fct.appStatusHandler)
+	if err != nil {
+		return err
+	}
+
+	subroundBlock, err := NewSubroundBlock(
+		subround,
+		fct.worker.Extend,
+	)
+	if err != nil {
+		return err
+	}
+
+	fct.consensusCore.Chronology().AddSubround(subroundBlock)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fct *factory) IsInterfaceNil() bool {
+	return fct == nil
+}
@@ -0,0 +1,153 @@
+package dev_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/dev"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+var chainID = []byte("chain ID")
+
+const currentPid = core.PeerID("pid")
+
+const roundTimeDuration = 100 * time.Millisecond
+
+const selfPubKey = "A"
+
+func extend(_ int) {
+}
+
+func executeStoredMessages() {
+}
+
+func initConsensusState(consensusGroup []string) *spos.ConsensusState {
+	eligibleNodesPubKeys := make(map[string]struct{})
+	for _, key := range consensusGroup {
+		eligibleNodesPubKeys[key] = struct{}{}
+	}
+
+	rcns := spos.NewRoundConsensus(
+		eligibleNodesPubKeys,
+		len(consensusGroup),
+		selfPubKey,
+	)
+	rcns.SetConsensusGroup(consensusGroup)
+	rcns.ResetRoundState()
+
+	rthr := spos.NewRoundThreshold()
+	rthr.SetThreshold(dev.SrBlock, 1)
+	rthr.SetFallbackThreshold(dev.SrBlock, 1)
+
+	rstatus := spos.NewRoundStatus()
+	rstatus.ResetRoundStatus()
+
+	return spos.NewConsensusState(rcns, rthr, rstatus)
+}
+
+func initWorker() spos.WorkerHandler {
+	sposWorker := &mock.SposWorkerMock{}
+	sposWorker.GetConsensusStateChangedChannelsCalled = func() chan bool {
+		return make(chan bool)
+	}
+	sposWorker.RemoveAllReceivedMessagesCallsCalled = func() {}
+	sposWorker.AddReceivedMessageCallCalled =
+		func(messageType consensus.MessageType, receivedMessageCall func(cnsDta *consensus.Message) bool) {}
+
+	return sposWorker
+}
+
+func initContainer() *mock.ConsensusCoreMock {
+	container := mock.InitConsensusCore()
+	container.SetRounder(&mock.RounderMock{
+		TimeStampCalled: func() time.Time {
+			return time.Unix(0, 0)
+		},
+		TimeDurationCalled: func() time.Duration {
+			return roundTimeDuration
+		},
+	})
+
+	return container
+}
+
+func TestNewSubroundsFactory_NilContainerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fct, err := dev.NewSubroundsFactory(nil, initConsensusState([]string{selfPubKey}), initWorker(), chainID, currentPid)
+
+	assert.True(t, check.IfNil(fct))
+	assert.Equal(t, spos.ErrNilConsensusCore, err)
+}
+
+func TestNewSubroundsFactory_NilConsensusStateShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fct, err := dev.NewSubroundsFactory(initContainer(), nil, initWorker(), chainID, currentPid)
+
+	assert.True(t, check.IfNil(fct))
+	assert.Equal(t, spos.ErrNilConsensusState, err)
+}
+
+func TestNewSubroundsFactory_NilWorkerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fct, err := dev.NewSubroundsFactory(initContainer(), initConsensusState([]string{selfPubKey}), nil, chainID, currentPid)
+
+	assert.True(t, check.IfNil(fct))
+	assert.Equal(t, spos.ErrNilWorker, err)
+}
+
+func TestNewSubroundsFactory_EmptyChainIDShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fct, err := dev.NewSubroundsFactory(initContainer(), initConsensusState([]string{selfPubKey}), initWorker(), nil, currentPid)
+
+	assert.True(t, check.IfNil(fct))
+	assert.Equal(t, spos.ErrInvalidChainID, err)
+}
+
+func TestNewSubroundsFactory_MoreThanOneValidatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	consensusState := initConsensusState([]string{selfPubKey, "B"})
+	fct, err := dev.NewSubroundsFactory(initContainer(), consensusState, initWorker(), chainID, currentPid)
+
+	assert.True(t, check.IfNil(fct))
+	assert.Equal(t, spos.ErrInvalidConsensusGroupSize, err)
+}
+
+func TestFactory_SetAppStatusHandlerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	fct, _ := dev.NewSubroundsFactory(initContainer(), initConsensusState([]string{selfPubKey}), initWorker(), chainID, currentPid)
+	err := fct.SetAppStatusHandler(nil)
+
+	assert.Equal(t, spos.ErrNilAppStatusHandler, err)
+}
+
+func TestFactory_GenerateSubroundsShouldAddStartRoundAndBlockSubrounds(t *testing.T) {
+	t.Parallel()
+
+	subroundNames := make([]string, 0)
+	container := initContainer()
+	container.SetChronology(&mock.ChronologyHandlerMock{
+		AddSubroundCalled: func(handler consensus.SubroundHandler) {
+			subroundNames = append(subroundNames, handler.Name())
+		},
+	})
+
+	fct, err := dev.NewSubroundsFactory(container, initConsensusState([]string{selfPubKey}), initWorker(), chainID, currentPid)
+	assert.Nil(t, err)
+
+	err = fct.GenerateSubrounds()
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"(START_ROUND)", "(BLOCK)"}, subroundNames)
+}
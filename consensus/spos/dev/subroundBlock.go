@@ -0,0 +1,252 @@
+package dev
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/display"
+)
+
+// subroundBlock defines the data needed by the dev subround Block, in which the single validator of the
+// consensus group creates, seals, commits and broadcasts the block without exchanging any consensus message
+type subroundBlock struct {
+	*spos.Subround
+}
+
+// NewSubroundBlock creates a subroundBlock object
+func NewSubroundBlock(
+	baseSubround *spos.Subround,
+	extend func(subroundId int),
+) (*subroundBlock, error) {
+	err := checkNewSubroundBlockParams(baseSubround)
+	if err != nil {
+		return nil, err
+	}
+
+	srBlock := subroundBlock{
+		Subround: baseSubround,
+	}
+
+	srBlock.Job = srBlock.doBlockJob
+	srBlock.Check = srBlock.doBlockConsensusCheck
+	srBlock.Extend = extend
+
+	return &srBlock, nil
+}
+
+func checkNewSubroundBlockParams(
+	baseSubround *spos.Subround,
+) error {
+	if baseSubround == nil {
+		return spos.ErrNilSubround
+	}
+	if baseSubround.ConsensusState == nil {
+		return spos.ErrNilConsensusState
+	}
+
+	return spos.ValidateConsensusCore(baseSubround.ConsensusCoreHandler)
+}
+
+// doBlockJob method does the job of the subround Block
+func (sr *subroundBlock) doBlockJob() bool {
+	if !sr.IsSelfLeaderInCurrentRound() {
+		return false
+	}
+	if sr.Rounder().Index() <= sr.getRoundInLastCommittedBlock() {
+		return false
+	}
+	if sr.IsSelfJobDone(sr.Current()) {
+		return false
+	}
+	if sr.IsSubroundFinished(sr.Current()) {
+		return false
+	}
+
+	header, err := sr.createHeader()
+	if err != nil {
+		log.Debug("doBlockJob.createHeader", "error", err.Error())
+		return false
+	}
+
+	header, body, err := sr.createBlock(header)
+	if err != nil {
+		log.Debug("doBlockJob.createBlock", "error", err.Error())
+		return false
+	}
+
+	err = sr.sealHeader(header)
+	if err != nil {
+		log.Debug("doBlockJob.sealHeader", "error", err.Error())
+		return false
+	}
+
+	sr.Header = header
+	sr.Body = body
+	sr.RoundTracer().SetBlockReceived(sr.Rounder().Index(), sr.SyncTimer().CurrentTime())
+
+	err = sr.BroadcastMessenger().BroadcastHeader(header)
+	if err != nil {
+		log.Debug("doBlockJob.BroadcastHeader", "error", err.Error())
+	}
+
+	startTime := time.Now()
+	err = sr.BlockProcessor().CommitBlock(header, body)
+	elapsedTime := time.Since(startTime)
+	if elapsedTime >= core.CommitMaxTime {
+		log.Warn("doBlockJob.CommitBlock", "elapsed time", elapsedTime)
+	}
+	if err != nil {
+		log.Debug("doBlockJob.CommitBlock", "error", err)
+		return false
+	}
+
+	sr.SetStatus(sr.Current(), spos.SsFinished)
+
+	err = sr.broadcastBlockDataLeader(header, body)
+	if err != nil {
+		log.Debug("doBlockJob.broadcastBlockDataLeader", "error", err.Error())
+	}
+
+	msg := fmt.Sprintf("Added produced block with nonce  %d  in blockchain", header.GetNonce())
+	log.Debug(display.Headline(msg, sr.SyncTimer().FormattedCurrentTime(), "+"))
+
+	sr.AppStatusHandler().Increment(core.MetricCountAcceptedBlocks)
+	sr.AppStatusHandler().SetStringValue(core.MetricConsensusRoundState,
+		fmt.Sprintf("valid block produced in %f sec", time.Since(sr.Rounder().TimeStamp()).Seconds()))
+
+	return true
+}
+
+func (sr *subroundBlock) getRoundInLastCommittedBlock() int64 {
+	roundInLastCommittedBlock := int64(0)
+	currentHeader := sr.Blockchain().GetCurrentBlockHeader()
+	if !check.IfNil(currentHeader) {
+		roundInLastCommittedBlock = int64(currentHeader.GetRound())
+	}
+
+	return roundInLastCommittedBlock
+}
+
+func (sr *subroundBlock) createHeader() (data.HeaderHandler, error) {
+	var nonce uint64
+	var prevHash []byte
+	var prevRandSeed []byte
+
+	currentHeader := sr.Blockchain().GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		nonce = sr.Blockchain().GetGenesisHeader().GetNonce() + 1
+		prevHash = sr.Blockchain().GetGenesisHeaderHash()
+		prevRandSeed = sr.Blockchain().GetGenesisHeader().GetRandSeed()
+	} else {
+		nonce = currentHeader.GetNonce() + 1
+		prevHash = sr.Blockchain().GetCurrentBlockHeaderHash()
+		prevRandSeed = currentHeader.GetRandSeed()
+	}
+
+	hdr := sr.BlockProcessor().CreateNewHeader(uint64(sr.Rounder().Index()), nonce)
+	hdr.SetPrevHash(prevHash)
+
+	randSeed, err := sr.SingleSigner().Sign(sr.PrivateKey(), prevRandSeed)
+	if err != nil {
+		return nil, err
+	}
+
+	hdr.SetShardID(sr.ShardCoordinator().SelfId())
+	hdr.SetTimeStamp(uint64(sr.Rounder().TimeStamp().Unix()))
+	hdr.SetPrevRandSeed(prevRandSeed)
+	hdr.SetRandSeed(randSeed)
+	hdr.SetChainID(sr.ChainID())
+
+	return hdr, nil
+}
+
+func (sr *subroundBlock) createBlock(header data.HeaderHandler) (data.HeaderHandler, data.BodyHandler, error) {
+	startTime := sr.RoundTimeStamp
+	maxTime := time.Duration(sr.EndTime())
+	haveTimeInCurrentSubround := func() bool {
+		return sr.Rounder().RemainingTime(startTime, maxTime) > 0
+	}
+
+	return sr.BlockProcessor().CreateBlock(header, haveTimeInCurrentSubround)
+}
+
+// sealHeader adds the aggregated signature, which holds only the signature of the single validator, the bitmap
+// and the leader signature, so the header can be verified as any other header by the rest of the network
+func (sr *subroundBlock) sealHeader(header data.HeaderHandler) error {
+	marshalizedHeader, err := sr.Marshalizer().Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	headerHash := sr.Hasher().Compute(string(marshalizedHeader))
+	sr.Data = headerHash
+
+	selfIndex, err := sr.SelfConsensusGroupIndex()
+	if err != nil {
+		return err
+	}
+
+	signatureShare, err := sr.MultiSigner().CreateSignatureShare(headerHash, nil)
+	if err != nil {
+		return err
+	}
+
+	err = sr.MultiSigner().StoreSignatureShare(uint16(selfIndex), signatureShare)
+	if err != nil {
+		return err
+	}
+
+	err = sr.SetSelfJobDone(sr.Current(), true)
+	if err != nil {
+		return err
+	}
+
+	aggregationStartTime := time.Now()
+	bitmap := sr.GenerateBitmap(sr.Current())
+	signature, err := sr.MultiSigner().AggregateSigs(bitmap)
+	if err != nil {
+		return err
+	}
+	sr.RoundTracer().SetAggregationDuration(sr.Rounder().Index(), time.Since(aggregationStartTime))
+
+	header.SetPubKeysBitmap(bitmap)
+	header.SetSignature(signature)
+
+	headerClone := header.Clone()
+	headerClone.SetLeaderSignature(nil)
+	marshalizedHeader, err = sr.Marshalizer().Marshal(headerClone)
+	if err != nil {
+		return err
+	}
+
+	leaderSignature, err := sr.SingleSigner().Sign(sr.PrivateKey(), marshalizedHeader)
+	if err != nil {
+		return err
+	}
+
+	header.SetLeaderSignature(leaderSignature)
+
+	return nil
+}
+
+func (sr *subroundBlock) broadcastBlockDataLeader(header data.HeaderHandler, body data.BodyHandler) error {
+	miniBlocks, transactions, err := sr.BlockProcessor().MarshalizedDataToBroadcast(header, body)
+	if err != nil {
+		return err
+	}
+
+	return sr.BroadcastMessenger().BroadcastBlockDataLeader(header, miniBlocks, transactions)
+}
+
+// doBlockConsensusCheck method checks if the consensus in the subround Block is achieved
+func (sr *subroundBlock) doBlockConsensusCheck() bool {
+	if sr.RoundCanceled {
+		return false
+	}
+
+	return sr.IsSubroundFinished(sr.Current())
+}
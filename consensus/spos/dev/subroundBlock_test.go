@@ -0,0 +1,120 @@
+package dev_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/dev"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSubroundBlock(container *mock.ConsensusCoreMock, consensusState *spos.ConsensusState) *spos.Subround {
+	sr, _ := spos.NewSubround(
+		dev.SrStartRound,
+		dev.SrBlock,
+		-1,
+		int64(5*roundTimeDuration/100),
+		int64(95*roundTimeDuration/100),
+		"(BLOCK)",
+		consensusState,
+		make(chan bool, 1),
+		executeStoredMessages,
+		container,
+		chainID,
+		currentPid,
+	)
+
+	return sr
+}
+
+func initBlockChain() *mock.BlockChainMock {
+	return &mock.BlockChainMock{
+		GetGenesisHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{
+				Nonce:    0,
+				RandSeed: []byte("genesis rand seed"),
+			}
+		},
+		GetGenesisHeaderHashCalled: func() []byte {
+			return []byte("genesis header hash")
+		},
+	}
+}
+
+func TestNewSubroundBlock_NilSubroundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	srBlock, err := dev.NewSubroundBlock(nil, extend)
+
+	assert.Nil(t, srBlock)
+	assert.Equal(t, spos.ErrNilSubround, err)
+}
+
+func TestSubroundBlock_DoBlockJobNotLeaderShouldNotProduceBlock(t *testing.T) {
+	t.Parallel()
+
+	container := initContainer()
+	container.SetBlockchain(initBlockChain())
+	commitCalled := false
+	blockProcessor := mock.InitBlockProcessorMock()
+	blockProcessor.CommitBlockCalled = func(header data.HeaderHandler, body data.BodyHandler) error {
+		commitCalled = true
+		return nil
+	}
+	container.SetBlockProcessor(blockProcessor)
+
+	consensusState := initConsensusState([]string{"B"})
+	srBlock, _ := dev.NewSubroundBlock(createSubroundBlock(container, consensusState), extend)
+
+	assert.False(t, srBlock.Job())
+	assert.False(t, commitCalled)
+}
+
+func TestSubroundBlock_DoBlockJobShouldProduceSealAndCommitTheBlock(t *testing.T) {
+	t.Parallel()
+
+	container := initContainer()
+	container.SetBlockchain(initBlockChain())
+
+	var committedHeader data.HeaderHandler
+	blockProcessor := mock.InitBlockProcessorMock()
+	blockProcessor.CommitBlockCalled = func(header data.HeaderHandler, body data.BodyHandler) error {
+		committedHeader = header
+		return nil
+	}
+	container.SetBlockProcessor(blockProcessor)
+
+	broadcastHeaderCalled := false
+	broadcastBlockDataCalled := false
+	container.SetBroadcastMessenger(&mock.BroadcastMessengerMock{
+		BroadcastHeaderCalled: func(handler data.HeaderHandler) error {
+			broadcastHeaderCalled = true
+			return nil
+		},
+		BroadcastBlockDataLeaderCalled: func(h data.HeaderHandler, mbs map[uint32][]byte, txs map[string][][]byte) error {
+			broadcastBlockDataCalled = true
+			return nil
+		},
+	})
+
+	consensusState := initConsensusState([]string{selfPubKey})
+	srBlock, _ := dev.NewSubroundBlock(createSubroundBlock(container, consensusState), extend)
+	consensusState.RoundIndex = 1
+	container.SetRounder(&mock.RounderMock{RoundIndex: 1})
+
+	assert.True(t, srBlock.Job())
+	require.False(t, check.IfNil(committedHeader))
+	assert.Equal(t, uint64(1), committedHeader.GetNonce())
+	assert.Equal(t, []byte("genesis header hash"), committedHeader.GetPrevHash())
+	assert.Equal(t, []byte{1}, committedHeader.GetPubKeysBitmap())
+	assert.Equal(t, []byte("aggregatedSig"), committedHeader.GetSignature())
+	assert.True(t, broadcastHeaderCalled)
+	assert.True(t, broadcastBlockDataCalled)
+	assert.True(t, consensusState.IsSubroundFinished(dev.SrBlock))
+	assert.True(t, srBlock.Check())
+}
@@ -0,0 +1,37 @@
+package spos
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// directConsensusMessage exposes the consensus message unwrapped from a signed direct message. As the message was
+// not relayed, its originator is the peer that sent it
+type directConsensusMessage struct {
+	p2p.MessageP2P
+	data []byte
+	peer core.PeerID
+}
+
+func newDirectConsensusMessage(message p2p.MessageP2P, data []byte, fromConnectedPeer core.PeerID) *directConsensusMessage {
+	return &directConsensusMessage{
+		MessageP2P: message,
+		data:       data,
+		peer:       fromConnectedPeer,
+	}
+}
+
+// Data returns the wrapped consensus message bytes
+func (dcm *directConsensusMessage) Data() []byte {
+	return dcm.data
+}
+
+// Peer returns the peer that sent the message
+func (dcm *directConsensusMessage) Peer() core.PeerID {
+	return dcm.peer
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dcm *directConsensusMessage) IsInterfaceNil() bool {
+	return dcm == nil
+}
@@ -0,0 +1,358 @@
+package spos
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// numRoundsToKeepSignedData defines how many rounds, behind the highest received one, the signed headers and
+// messages are kept in order to be compared with the newly received ones
+const numRoundsToKeepSignedData = 10
+
+// maxNumEquivocationProofs defines the maximum number of equivocation proofs kept by the detector
+const maxNumEquivocationProofs = 1000
+
+// ArgsEquivocationDetector holds the equivocation detector arguments
+type ArgsEquivocationDetector struct {
+	Marshalizer      marshal.Marshalizer
+	NodesCoordinator sharding.NodesCoordinator
+	HeadersPool      HeadersPool
+	ProofVerifier    EquivocationProofVerifier
+	Messenger        consensus.P2PMessenger
+	AntifloodHandler consensus.P2PAntifloodHandler
+}
+
+type signedHeader struct {
+	round  uint64
+	hash   []byte
+	header data.HeaderHandler
+}
+
+type signedMessage struct {
+	round  uint64
+	cnsMsg *consensus.Message
+}
+
+type equivocationDetector struct {
+	marshalizer      marshal.Marshalizer
+	nodesCoordinator sharding.NodesCoordinator
+	headersPool      HeadersPool
+	proofVerifier    EquivocationProofVerifier
+	messenger        consensus.P2PMessenger
+	antifloodHandler consensus.P2PAntifloodHandler
+
+	mutData             sync.Mutex
+	highestRound        uint64
+	signedHeaders       map[string]*signedHeader
+	signedMessages      map[string]*signedMessage
+	conflictingMessages map[string][]*consensus.Message
+	proofs              map[string]*consensus.EquivocationProof
+	proofsKeys          []string
+}
+
+// NewEquivocationDetector creates a new equivocation detector, which keeps the headers signed by leaders and builds
+// an equivocation proof when the same leader signs two different headers for the same round and shard
+func NewEquivocationDetector(args ArgsEquivocationDetector) (*equivocationDetector, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.HeadersPool) {
+		return nil, ErrNilHeadersPool
+	}
+	if check.IfNil(args.ProofVerifier) {
+		return nil, ErrNilEquivocationProofVerifier
+	}
+	if check.IfNil(args.Messenger) {
+		return nil, ErrNilMessenger
+	}
+	if check.IfNil(args.AntifloodHandler) {
+		return nil, ErrNilAntifloodHandler
+	}
+
+	return &equivocationDetector{
+		marshalizer:         args.Marshalizer,
+		nodesCoordinator:    args.NodesCoordinator,
+		headersPool:         args.HeadersPool,
+		proofVerifier:       args.ProofVerifier,
+		messenger:           args.Messenger,
+		antifloodHandler:    args.AntifloodHandler,
+		signedHeaders:       make(map[string]*signedHeader),
+		signedMessages:      make(map[string]*signedMessage),
+		conflictingMessages: make(map[string][]*consensus.Message),
+		proofs:              make(map[string]*consensus.EquivocationProof),
+		proofsKeys:          make([]string, 0),
+	}, nil
+}
+
+// AddSignedHeader records the given header, if it was signed by its leader, and broadcasts an equivocation proof if
+// the same leader has already signed a different header for the same round and shard
+func (ed *equivocationDetector) AddSignedHeader(header data.HeaderHandler, headerHash []byte) {
+	if check.IfNil(header) || len(header.GetLeaderSignature()) == 0 {
+		return
+	}
+
+	leaderPubKey, err := ed.getLeaderPubKey(header)
+	if err != nil {
+		log.Trace("equivocationDetector.AddSignedHeader: leader can not be computed",
+			"round", header.GetRound(),
+			"shard", header.GetShardID(),
+			"error", err.Error())
+		return
+	}
+
+	key := fmt.Sprintf("%s_%d_%d", string(leaderPubKey), header.GetShardID(), header.GetRound())
+
+	ed.mutData.Lock()
+	ed.updateHighestRound(header.GetRound())
+	previous, ok := ed.signedHeaders[key]
+	if !ok {
+		ed.signedHeaders[key] = &signedHeader{
+			round:  header.GetRound(),
+			hash:   headerHash,
+			header: header,
+		}
+	}
+	_, isProofAlreadyCreated := ed.proofs[key]
+	ed.mutData.Unlock()
+
+	if !ok || bytes.Equal(previous.hash, headerHash) || isProofAlreadyCreated {
+		return
+	}
+
+	log.Warn("leader signed different headers in the same round",
+		"leader", core.GetTrimmedPk(hex.EncodeToString(leaderPubKey)),
+		"shard", header.GetShardID(),
+		"round", header.GetRound(),
+		"first header hash", previous.hash,
+		"second header hash", headerHash)
+
+	proof, err := ed.createProof(leaderPubKey, previous.header, header)
+	if err != nil {
+		log.Debug("equivocationDetector.AddSignedHeader: can not create equivocation proof", "error", err.Error())
+		return
+	}
+
+	if !ed.addProof(key, proof) {
+		return
+	}
+
+	ed.broadcastProof(proof)
+}
+
+// AddConsensusMessage records the given consensus message, if it holds a leader signature, and signals if the same
+// leader has already sent a message for a different header in the same round. In this case, the headers signed by
+// the leader are looked up in the headers pool in order to build an equivocation proof
+func (ed *equivocationDetector) AddConsensusMessage(cnsMsg *consensus.Message) {
+	if cnsMsg == nil || len(cnsMsg.LeaderSignature) == 0 || cnsMsg.RoundIndex < 0 {
+		return
+	}
+
+	round := uint64(cnsMsg.RoundIndex)
+	key := fmt.Sprintf("%s_%d", string(cnsMsg.PubKey), round)
+
+	ed.mutData.Lock()
+	ed.updateHighestRound(round)
+	previous, ok := ed.signedMessages[key]
+	if !ok {
+		ed.signedMessages[key] = &signedMessage{
+			round:  round,
+			cnsMsg: cnsMsg,
+		}
+		ed.mutData.Unlock()
+		return
+	}
+
+	isConflicting := !bytes.Equal(previous.cnsMsg.BlockHeaderHash, cnsMsg.BlockHeaderHash)
+	_, isAlreadyRecorded := ed.conflictingMessages[key]
+	if !isConflicting || isAlreadyRecorded {
+		ed.mutData.Unlock()
+		return
+	}
+
+	ed.conflictingMessages[key] = []*consensus.Message{previous.cnsMsg, cnsMsg}
+	ed.mutData.Unlock()
+
+	log.Warn("leader sent messages for different headers in the same round",
+		"leader", core.GetTrimmedPk(hex.EncodeToString(cnsMsg.PubKey)),
+		"round", round,
+		"first header hash", previous.cnsMsg.BlockHeaderHash,
+		"second header hash", cnsMsg.BlockHeaderHash)
+
+	for _, headerHash := range [][]byte{previous.cnsMsg.BlockHeaderHash, cnsMsg.BlockHeaderHash} {
+		header, err := ed.headersPool.GetHeaderByHash(headerHash)
+		if err != nil {
+			continue
+		}
+
+		ed.AddSignedHeader(header, headerHash)
+	}
+}
+
+// ProcessReceivedMessage verifies and stores the equivocation proofs received on the equivocation proof topic
+func (ed *equivocationDetector) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	if check.IfNil(message) {
+		return ErrNilMessage
+	}
+	if message.Data() == nil {
+		return ErrNilDataToProcess
+	}
+
+	err := ed.antifloodHandler.CanProcessMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+	err = ed.antifloodHandler.CanProcessMessagesOnTopic(fromConnectedPeer, core.EquivocationProofTopic, 1, uint64(len(message.Data())), message.SeqNo())
+	if err != nil {
+		return err
+	}
+
+	proof := &consensus.EquivocationProof{}
+	err = ed.marshalizer.Unmarshal(proof, message.Data())
+	if err != nil {
+		return err
+	}
+
+	err = ed.proofVerifier.Verify(proof)
+	if err != nil {
+		//this situation is so severe that we have to black list both the message originator and the connected peer
+		//that disseminated this message.
+
+		reason := "blacklisted due to invalid equivocation proof"
+		ed.antifloodHandler.BlacklistPeer(message.Peer(), reason, core.InvalidMessageBlacklistDuration)
+		ed.antifloodHandler.BlacklistPeer(fromConnectedPeer, reason, core.InvalidMessageBlacklistDuration)
+
+		return err
+	}
+
+	key := fmt.Sprintf("%s_%d_%d", string(proof.PubKey), proof.ShardID, proof.Round)
+	ed.addProof(key, proof)
+
+	return nil
+}
+
+// GetEquivocationProofs returns the verified equivocation proofs, in the order they were created or received
+func (ed *equivocationDetector) GetEquivocationProofs() []*consensus.EquivocationProof {
+	ed.mutData.Lock()
+	defer ed.mutData.Unlock()
+
+	proofs := make([]*consensus.EquivocationProof, 0, len(ed.proofsKeys))
+	for _, key := range ed.proofsKeys {
+		proofs = append(proofs, ed.proofs[key])
+	}
+
+	return proofs
+}
+
+func (ed *equivocationDetector) createProof(
+	leaderPubKey []byte,
+	firstHeader data.HeaderHandler,
+	secondHeader data.HeaderHandler,
+) (*consensus.EquivocationProof, error) {
+	firstHeaderBytes, err := ed.marshalizer.Marshal(firstHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	secondHeaderBytes, err := ed.marshalizer.Marshal(secondHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	proof := consensus.NewEquivocationProof(
+		leaderPubKey,
+		secondHeader.GetShardID(),
+		secondHeader.GetRound(),
+		firstHeaderBytes,
+		secondHeaderBytes,
+	)
+
+	err = ed.proofVerifier.Verify(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
+func (ed *equivocationDetector) addProof(key string, proof *consensus.EquivocationProof) bool {
+	ed.mutData.Lock()
+	defer ed.mutData.Unlock()
+
+	_, ok := ed.proofs[key]
+	if ok || len(ed.proofsKeys) >= maxNumEquivocationProofs {
+		return false
+	}
+
+	ed.proofs[key] = proof
+	ed.proofsKeys = append(ed.proofsKeys, key)
+
+	return true
+}
+
+func (ed *equivocationDetector) broadcastProof(proof *consensus.EquivocationProof) {
+	proofBytes, err := ed.marshalizer.Marshal(proof)
+	if err != nil {
+		log.Debug("equivocationDetector.broadcastProof: marshal", "error", err.Error())
+		return
+	}
+
+	ed.messenger.Broadcast(core.EquivocationProofTopic, proofBytes)
+}
+
+func (ed *equivocationDetector) getLeaderPubKey(header data.HeaderHandler) ([]byte, error) {
+	// the start of epoch block is validated by the nodes of the previous epoch
+	epoch := header.GetEpoch()
+	if header.IsStartOfEpochBlock() && epoch > 0 {
+		epoch = epoch - 1
+	}
+
+	consensusGroup, err := ed.nodesCoordinator.ComputeConsensusGroup(header.GetPrevRandSeed(), header.GetRound(), header.GetShardID(), epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return consensusGroup[0].PubKey(), nil
+}
+
+// updateHighestRound should be called under mutex protection
+func (ed *equivocationDetector) updateHighestRound(round uint64) {
+	if round <= ed.highestRound {
+		return
+	}
+
+	ed.highestRound = round
+	if ed.highestRound < numRoundsToKeepSignedData {
+		return
+	}
+
+	minRoundToKeep := ed.highestRound - numRoundsToKeepSignedData
+	for key, sh := range ed.signedHeaders {
+		if sh.round < minRoundToKeep {
+			delete(ed.signedHeaders, key)
+		}
+	}
+	for key, sm := range ed.signedMessages {
+		if sm.round < minRoundToKeep {
+			delete(ed.signedMessages, key)
+			delete(ed.conflictingMessages, key)
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ed *equivocationDetector) IsInterfaceNil() bool {
+	return ed == nil
+}
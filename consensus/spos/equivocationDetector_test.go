@@ -0,0 +1,369 @@
+package spos_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDefaultEquivocationDetectorArgs() spos.ArgsEquivocationDetector {
+	return spos.ArgsEquivocationDetector{
+		Marshalizer: &mock.MarshalizerMock{},
+		NodesCoordinator: &mock.NodesCoordinatorMock{
+			ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]sharding.Validator, error) {
+				// the leader depends only on the randomness, so that headers with different previous rand seeds
+				// can be proposed by different leaders
+				return []sharding.Validator{mock.NewValidator(append([]byte("leader_"), randomness...), 1, 0)}, nil
+			},
+		},
+		HeadersPool:      &mock.HeadersCacherStub{},
+		ProofVerifier:    &mock.EquivocationProofVerifierStub{},
+		Messenger:        &mock.MessengerStub{BroadcastCalled: func(topic string, buff []byte) {}},
+		AntifloodHandler: &mock.P2PAntifloodHandlerStub{},
+	}
+}
+
+func createSignedHeader(round uint64, nonce uint64) *block.Header {
+	return &block.Header{
+		Round:           round,
+		Nonce:           nonce,
+		PrevRandSeed:    []byte("rand seed"),
+		LeaderSignature: []byte("leader signature"),
+	}
+}
+
+func TestNewEquivocationDetector_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultEquivocationDetectorArgs()
+	args.Marshalizer = nil
+	ed, err := spos.NewEquivocationDetector(args)
+
+	assert.Nil(t, ed)
+	assert.Equal(t, spos.ErrNilMarshalizer, err)
+}
+
+func TestNewEquivocationDetector_NilNodesCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultEquivocationDetectorArgs()
+	args.NodesCoordinator = nil
+	ed, err := spos.NewEquivocationDetector(args)
+
+	assert.Nil(t, ed)
+	assert.Equal(t, spos.ErrNilNodesCoordinator, err)
+}
+
+func TestNewEquivocationDetector_NilHeadersPoolShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultEquivocationDetectorArgs()
+	args.HeadersPool = nil
+	ed, err := spos.NewEquivocationDetector(args)
+
+	assert.Nil(t, ed)
+	assert.Equal(t, spos.ErrNilHeadersPool, err)
+}
+
+func TestNewEquivocationDetector_NilProofVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultEquivocationDetectorArgs()
+	args.ProofVerifier = nil
+	ed, err := spos.NewEquivocationDetector(args)
+
+	assert.Nil(t, ed)
+	assert.Equal(t, spos.ErrNilEquivocationProofVerifier, err)
+}
+
+func TestNewEquivocationDetector_NilMessengerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultEquivocationDetectorArgs()
+	args.Messenger = nil
+	ed, err := spos.NewEquivocationDetector(args)
+
+	assert.Nil(t, ed)
+	assert.Equal(t, spos.ErrNilMessenger, err)
+}
+
+func TestNewEquivocationDetector_NilAntifloodHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultEquivocationDetectorArgs()
+	args.AntifloodHandler = nil
+	ed, err := spos.NewEquivocationDetector(args)
+
+	assert.Nil(t, ed)
+	assert.Equal(t, spos.ErrNilAntifloodHandler, err)
+}
+
+func TestNewEquivocationDetector_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	ed, err := spos.NewEquivocationDetector(createDefaultEquivocationDetectorArgs())
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(ed))
+}
+
+func TestEquivocationDetector_AddSignedHeaderWithoutLeaderSignatureShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	numBroadcasts := 0
+	args := createDefaultEquivocationDetectorArgs()
+	args.Messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			numBroadcasts++
+		},
+	}
+	ed, _ := spos.NewEquivocationDetector(args)
+
+	firstHeader := createSignedHeader(10, 5)
+	firstHeader.LeaderSignature = nil
+	secondHeader := createSignedHeader(10, 6)
+	secondHeader.LeaderSignature = nil
+	ed.AddSignedHeader(firstHeader, []byte("hash1"))
+	ed.AddSignedHeader(secondHeader, []byte("hash2"))
+
+	assert.Equal(t, 0, numBroadcasts)
+	assert.Equal(t, 0, len(ed.GetEquivocationProofs()))
+}
+
+func TestEquivocationDetector_AddSignedHeaderSameHeaderShouldNotCreateProof(t *testing.T) {
+	t.Parallel()
+
+	ed, _ := spos.NewEquivocationDetector(createDefaultEquivocationDetectorArgs())
+
+	header := createSignedHeader(10, 5)
+	ed.AddSignedHeader(header, []byte("hash"))
+	ed.AddSignedHeader(header, []byte("hash"))
+
+	assert.Equal(t, 0, len(ed.GetEquivocationProofs()))
+}
+
+func TestEquivocationDetector_AddSignedHeaderDifferentLeadersShouldNotCreateProof(t *testing.T) {
+	t.Parallel()
+
+	ed, _ := spos.NewEquivocationDetector(createDefaultEquivocationDetectorArgs())
+
+	firstHeader := createSignedHeader(10, 5)
+	secondHeader := createSignedHeader(10, 5)
+	secondHeader.PrevRandSeed = []byte("other rand seed")
+	ed.AddSignedHeader(firstHeader, []byte("hash1"))
+	ed.AddSignedHeader(secondHeader, []byte("hash2"))
+
+	assert.Equal(t, 0, len(ed.GetEquivocationProofs()))
+}
+
+func TestEquivocationDetector_AddSignedHeaderConflictingHeadersShouldCreateAndBroadcastProof(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	broadcastedProofs := make([]*consensus.EquivocationProof, 0)
+	args := createDefaultEquivocationDetectorArgs()
+	args.Messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			assert.Equal(t, core.EquivocationProofTopic, topic)
+
+			proof := &consensus.EquivocationProof{}
+			err := marshalizer.Unmarshal(proof, buff)
+			assert.Nil(t, err)
+			broadcastedProofs = append(broadcastedProofs, proof)
+		},
+	}
+	ed, _ := spos.NewEquivocationDetector(args)
+
+	firstHeader := createSignedHeader(10, 5)
+	secondHeader := createSignedHeader(10, 6)
+	thirdHeader := createSignedHeader(10, 7)
+	ed.AddSignedHeader(firstHeader, []byte("hash1"))
+	ed.AddSignedHeader(secondHeader, []byte("hash2"))
+	ed.AddSignedHeader(thirdHeader, []byte("hash3"))
+
+	require.Equal(t, 1, len(broadcastedProofs))
+	proofs := ed.GetEquivocationProofs()
+	require.Equal(t, 1, len(proofs))
+	assert.Equal(t, proofs[0], broadcastedProofs[0])
+
+	firstHeaderBytes, _ := marshalizer.Marshal(firstHeader)
+	secondHeaderBytes, _ := marshalizer.Marshal(secondHeader)
+	expectedProof := consensus.NewEquivocationProof([]byte("leader_rand seed"), 0, 10, firstHeaderBytes, secondHeaderBytes)
+	assert.Equal(t, expectedProof, proofs[0])
+}
+
+func TestEquivocationDetector_AddSignedHeaderInvalidProofShouldNotBroadcast(t *testing.T) {
+	t.Parallel()
+
+	numBroadcasts := 0
+	args := createDefaultEquivocationDetectorArgs()
+	args.ProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyCalled: func(proof *consensus.EquivocationProof) error {
+			return errors.New("invalid proof")
+		},
+	}
+	args.Messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			numBroadcasts++
+		},
+	}
+	ed, _ := spos.NewEquivocationDetector(args)
+
+	ed.AddSignedHeader(createSignedHeader(10, 5), []byte("hash1"))
+	ed.AddSignedHeader(createSignedHeader(10, 6), []byte("hash2"))
+
+	assert.Equal(t, 0, numBroadcasts)
+	assert.Equal(t, 0, len(ed.GetEquivocationProofs()))
+}
+
+func TestEquivocationDetector_AddSignedHeaderOldRoundsShouldBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	ed, _ := spos.NewEquivocationDetector(createDefaultEquivocationDetectorArgs())
+
+	ed.AddSignedHeader(createSignedHeader(10, 5), []byte("hash1"))
+	ed.AddSignedHeader(createSignedHeader(100, 6), []byte("hash2"))
+	ed.AddSignedHeader(createSignedHeader(10, 7), []byte("hash3"))
+
+	assert.Equal(t, 0, len(ed.GetEquivocationProofs()))
+}
+
+func TestEquivocationDetector_AddConsensusMessageConflictingMessagesShouldCreateProofFromPoolHeaders(t *testing.T) {
+	t.Parallel()
+
+	headers := map[string]data.HeaderHandler{
+		"hash1": createSignedHeader(10, 5),
+		"hash2": createSignedHeader(10, 6),
+	}
+	args := createDefaultEquivocationDetectorArgs()
+	args.HeadersPool = &mock.HeadersCacherStub{
+		GetHeaderByHashCalled: func(hash []byte) (data.HeaderHandler, error) {
+			header, ok := headers[string(hash)]
+			if !ok {
+				return nil, errors.New("missing header")
+			}
+			return header, nil
+		},
+	}
+	ed, _ := spos.NewEquivocationDetector(args)
+
+	ed.AddConsensusMessage(&consensus.Message{
+		PubKey:          []byte("leader_rand seed"),
+		RoundIndex:      10,
+		BlockHeaderHash: []byte("hash1"),
+		LeaderSignature: []byte("leader signature"),
+	})
+	assert.Equal(t, 0, len(ed.GetEquivocationProofs()))
+
+	ed.AddConsensusMessage(&consensus.Message{
+		PubKey:          []byte("leader_rand seed"),
+		RoundIndex:      10,
+		BlockHeaderHash: []byte("hash2"),
+		LeaderSignature: []byte("leader signature"),
+	})
+	proofs := ed.GetEquivocationProofs()
+	require.Equal(t, 1, len(proofs))
+	assert.Equal(t, []byte("leader_rand seed"), proofs[0].PubKey)
+}
+
+func TestEquivocationDetector_AddConsensusMessageWithoutLeaderSignatureShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	poolWasCalled := false
+	args := createDefaultEquivocationDetectorArgs()
+	args.HeadersPool = &mock.HeadersCacherStub{
+		GetHeaderByHashCalled: func(hash []byte) (data.HeaderHandler, error) {
+			poolWasCalled = true
+			return nil, errors.New("missing header")
+		},
+	}
+	ed, _ := spos.NewEquivocationDetector(args)
+
+	ed.AddConsensusMessage(&consensus.Message{PubKey: []byte("pk"), RoundIndex: 10, BlockHeaderHash: []byte("hash1")})
+	ed.AddConsensusMessage(&consensus.Message{PubKey: []byte("pk"), RoundIndex: 10, BlockHeaderHash: []byte("hash2")})
+
+	assert.False(t, poolWasCalled)
+}
+
+func TestEquivocationDetector_ProcessReceivedMessageNilMessageShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ed, _ := spos.NewEquivocationDetector(createDefaultEquivocationDetectorArgs())
+
+	err := ed.ProcessReceivedMessage(nil, fromConnectedPeerId)
+	assert.Equal(t, spos.ErrNilMessage, err)
+}
+
+func TestEquivocationDetector_ProcessReceivedMessageAntifloodErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("flood")
+	args := createDefaultEquivocationDetectorArgs()
+	args.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		CanProcessMessagesOnTopicCalled: func(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error {
+			return expectedErr
+		},
+	}
+	ed, _ := spos.NewEquivocationDetector(args)
+
+	err := ed.ProcessReceivedMessage(&mock.P2PMessageMock{DataField: []byte("data")}, fromConnectedPeerId)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestEquivocationDetector_ProcessReceivedMessageInvalidProofShouldBlacklist(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("invalid proof")
+	blacklistedPeers := make([]core.PeerID, 0)
+	args := createDefaultEquivocationDetectorArgs()
+	args.ProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyCalled: func(proof *consensus.EquivocationProof) error {
+			return expectedErr
+		},
+	}
+	args.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		BlacklistPeerCalled: func(peer core.PeerID, reason string, duration time.Duration) {
+			blacklistedPeers = append(blacklistedPeers, peer)
+		},
+	}
+	ed, _ := spos.NewEquivocationDetector(args)
+
+	proofBytes, _ := args.Marshalizer.Marshal(consensus.NewEquivocationProof([]byte("pk"), 0, 10, []byte("h1"), []byte("h2")))
+	msg := &mock.P2PMessageMock{DataField: proofBytes, PeerField: "originator"}
+	err := ed.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, []core.PeerID{"originator", fromConnectedPeerId}, blacklistedPeers)
+	assert.Equal(t, 0, len(ed.GetEquivocationProofs()))
+}
+
+func TestEquivocationDetector_ProcessReceivedMessageValidProofShouldStore(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultEquivocationDetectorArgs()
+	ed, _ := spos.NewEquivocationDetector(args)
+
+	proof := consensus.NewEquivocationProof([]byte("pk"), 0, 10, []byte("h1"), []byte("h2"))
+	proofBytes, _ := args.Marshalizer.Marshal(proof)
+	msg := &mock.P2PMessageMock{DataField: proofBytes}
+	err := ed.ProcessReceivedMessage(msg, fromConnectedPeerId)
+	assert.Nil(t, err)
+
+	err = ed.ProcessReceivedMessage(msg, fromConnectedPeerId)
+	assert.Nil(t, err)
+
+	proofs := ed.GetEquivocationProofs()
+	require.Equal(t, 1, len(proofs))
+	assert.Equal(t, proof, proofs[0])
+}
@@ -213,3 +213,43 @@ var ErrMessageTypeLimitReached = errors.New("consensus message type limit has be
 
 // ErrNilFallbackHeaderValidator signals that a nil fallback header validator has been provided
 var ErrNilFallbackHeaderValidator = errors.New("nil fallback header validator")
+
+// ErrNilRoundTracer signals that a nil round tracer has been provided
+var ErrNilRoundTracer = errors.New("nil round tracer")
+
+// ErrNilEquivocationDetector signals that a nil equivocation detector has been provided
+var ErrNilEquivocationDetector = errors.New("nil equivocation detector")
+
+// ErrNilEquivocationProofVerifier signals that a nil equivocation proof verifier has been provided
+var ErrNilEquivocationProofVerifier = errors.New("nil equivocation proof verifier")
+
+// ErrNilHeadersPool signals that a nil headers pool has been provided
+var ErrNilHeadersPool = errors.New("nil headers pool")
+
+// ErrInvalidConsensusGroupSize signals that the consensus group size is not supported by the consensus type
+var ErrInvalidConsensusGroupSize = errors.New("invalid consensus group size")
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilPeerIDProvider signals that a nil peer ID provider has been provided
+var ErrNilPeerIDProvider = errors.New("nil peer ID provider")
+
+// ErrNilValidatorDirectSender signals that a nil validator direct sender has been provided
+var ErrNilValidatorDirectSender = errors.New("nil validator direct sender")
+
+// ErrValidatorDirectSendDisabled signals that the direct send between validators is disabled
+var ErrValidatorDirectSendDisabled = errors.New("validator direct send is disabled")
+
+// ErrPeerIDNotFound signals that no peer ID is known for the provided public key
+var ErrPeerIDNotFound = errors.New("peer ID not found")
+
+// ErrPeerNotConnected signals that the destination peer is not connected
+var ErrPeerNotConnected = errors.New("peer is not connected")
+
+// ErrPublicKeyMismatch signals that the public key of the direct message signer differs from the one of the
+// wrapped consensus message
+var ErrPublicKeyMismatch = errors.New("public key mismatch")
+
+// ErrNodeIsNotInConsensusGroup is raised when a node is not in the consensus group of the current round
+var ErrNodeIsNotInConsensusGroup = errors.New("node is not in the consensus group of the current round")
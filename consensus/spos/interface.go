@@ -54,6 +54,8 @@ type ConsensusCoreHandler interface {
 	HeaderSigVerifier() consensus.HeaderSigVerifier
 	// FallbackHeaderValidator returns the fallback header validator handler which will be used in subrounds
 	FallbackHeaderValidator() consensus.FallbackHeaderValidator
+	// RoundTracer returns the round tracer which records the timings of the consensus rounds
+	RoundTracer() consensus.RoundTracer
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -147,3 +149,31 @@ type HeaderIntegrityVerifier interface {
 	Verify(header data.HeaderHandler) error
 	IsInterfaceNil() bool
 }
+
+// EquivocationDetector defines the behaviour of a component able to detect if the same leader signed two different
+// headers in the same round
+type EquivocationDetector interface {
+	AddSignedHeader(header data.HeaderHandler, headerHash []byte)
+	AddConsensusMessage(cnsMsg *consensus.Message)
+	IsInterfaceNil() bool
+}
+
+// EquivocationDetectorHandler defines the behaviour of an equivocation detector which also processes the equivocation
+// proofs received from the network
+type EquivocationDetectorHandler interface {
+	EquivocationDetector
+	ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error
+	GetEquivocationProofs() []*consensus.EquivocationProof
+}
+
+// EquivocationProofVerifier defines the behaviour of a component able to verify an equivocation proof
+type EquivocationProofVerifier interface {
+	Verify(proof *consensus.EquivocationProof) error
+	IsInterfaceNil() bool
+}
+
+// HeadersPool defines the behaviour of a component able to return a header from the pool by its hash
+type HeadersPool interface {
+	GetHeaderByHash(hash []byte) (data.HeaderHandler, error)
+	IsInterfaceNil() bool
+}
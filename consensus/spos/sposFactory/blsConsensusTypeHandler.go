@@ -0,0 +1,62 @@
+package sposFactory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+)
+
+// blsConsensusTypeHandler creates the components of the BLS consensus
+type blsConsensusTypeHandler struct {
+}
+
+// CreateConsensusService creates the BLS consensus service
+func (bcth *blsConsensusTypeHandler) CreateConsensusService() (spos.ConsensusService, error) {
+	return bls.NewConsensusService()
+}
+
+// CreateSubroundsFactory creates the BLS subrounds factory
+func (bcth *blsConsensusTypeHandler) CreateSubroundsFactory(args ArgsSubroundsFactory) (spos.SubroundsFactory, error) {
+	subRoundFactoryBls, err := bls.NewSubroundsFactory(
+		args.ConsensusDataContainer,
+		args.ConsensusState,
+		args.Worker,
+		args.ChainID,
+		args.CurrentPid,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = subRoundFactoryBls.SetAppStatusHandler(args.AppStatusHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	subRoundFactoryBls.SetIndexer(args.Indexer)
+
+	if args.PipeliningEnabled {
+		err = subRoundFactoryBls.EnableBlockPipelining(args.ForkDetector)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return subRoundFactoryBls, nil
+}
+
+// CreateBroadcastMessenger creates the broadcast messenger used by the BLS consensus
+func (bcth *blsConsensusTypeHandler) CreateBroadcastMessenger(args broadcast.CommonMessengerArgs) (consensus.BroadcastMessenger, error) {
+	return createBroadcastMessenger(args)
+}
+
+// SignatureScheme returns the BLS signature scheme
+func (bcth *blsConsensusTypeHandler) SignatureScheme() string {
+	return consensus.BlsConsensusType
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bcth *blsConsensusTypeHandler) IsInterfaceNil() bool {
+	return bcth == nil
+}
@@ -0,0 +1,69 @@
+package sposFactory
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+type consensusTypesRegistry struct {
+	mut      sync.RWMutex
+	handlers map[string]ConsensusTypeHandler
+}
+
+// NewConsensusTypesRegistry creates an empty consensus types registry
+func NewConsensusTypesRegistry() *consensusTypesRegistry {
+	return &consensusTypesRegistry{
+		handlers: make(map[string]ConsensusTypeHandler),
+	}
+}
+
+// NewDefaultConsensusTypesRegistry creates a consensus types registry holding the built-in consensus types
+func NewDefaultConsensusTypesRegistry() *consensusTypesRegistry {
+	registry := NewConsensusTypesRegistry()
+	registry.handlers[blsConsensusType] = &blsConsensusTypeHandler{}
+	registry.handlers[DevConsensusType] = &devConsensusTypeHandler{}
+
+	return registry
+}
+
+// Register adds a new consensus type in the registry
+func (ctr *consensusTypesRegistry) Register(consensusType string, handler ConsensusTypeHandler) error {
+	if len(consensusType) == 0 {
+		return ErrInvalidConsensusType
+	}
+	if check.IfNil(handler) {
+		return ErrNilConsensusTypeHandler
+	}
+
+	ctr.mut.Lock()
+	defer ctr.mut.Unlock()
+
+	_, exists := ctr.handlers[consensusType]
+	if exists {
+		return fmt.Errorf("%w: %s", ErrConsensusTypeAlreadyRegistered, consensusType)
+	}
+
+	ctr.handlers[consensusType] = handler
+
+	return nil
+}
+
+// Get returns the handler of the provided consensus type
+func (ctr *consensusTypesRegistry) Get(consensusType string) (ConsensusTypeHandler, error) {
+	ctr.mut.RLock()
+	defer ctr.mut.RUnlock()
+
+	handler, exists := ctr.handlers[consensusType]
+	if !exists {
+		return nil, ErrInvalidConsensusType
+	}
+
+	return handler, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ctr *consensusTypesRegistry) IsInterfaceNil() bool {
+	return ctr == nil
+}
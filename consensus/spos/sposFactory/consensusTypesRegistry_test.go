@@ -0,0 +1,79 @@
+package sposFactory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConsensusTypesRegistry_ShouldBeEmpty(t *testing.T) {
+	t.Parallel()
+
+	registry := NewConsensusTypesRegistry()
+
+	assert.False(t, check.IfNil(registry))
+	handler, err := registry.Get(blsConsensusType)
+	assert.True(t, check.IfNil(handler))
+	assert.Equal(t, ErrInvalidConsensusType, err)
+}
+
+func TestNewDefaultConsensusTypesRegistry_ShouldContainTheBuiltInTypes(t *testing.T) {
+	t.Parallel()
+
+	registry := NewDefaultConsensusTypesRegistry()
+
+	handler, err := registry.Get(blsConsensusType)
+	assert.Nil(t, err)
+	assert.IsType(t, &blsConsensusTypeHandler{}, handler)
+	assert.Equal(t, consensus.BlsConsensusType, handler.SignatureScheme())
+
+	handler, err = registry.Get(DevConsensusType)
+	assert.Nil(t, err)
+	assert.IsType(t, &devConsensusTypeHandler{}, handler)
+	assert.Equal(t, consensus.BlsConsensusType, handler.SignatureScheme())
+}
+
+func TestConsensusTypesRegistry_RegisterEmptyTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	registry := NewConsensusTypesRegistry()
+	err := registry.Register("", &blsConsensusTypeHandler{})
+
+	assert.Equal(t, ErrInvalidConsensusType, err)
+}
+
+func TestConsensusTypesRegistry_RegisterNilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	registry := NewConsensusTypesRegistry()
+	err := registry.Register("custom", nil)
+
+	assert.Equal(t, ErrNilConsensusTypeHandler, err)
+}
+
+func TestConsensusTypesRegistry_RegisterAlreadyRegisteredTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	registry := NewDefaultConsensusTypesRegistry()
+	err := registry.Register(blsConsensusType, &devConsensusTypeHandler{})
+
+	assert.True(t, errors.Is(err, ErrConsensusTypeAlreadyRegistered))
+	handler, _ := registry.Get(blsConsensusType)
+	assert.IsType(t, &blsConsensusTypeHandler{}, handler)
+}
+
+func TestConsensusTypesRegistry_RegisterShouldWork(t *testing.T) {
+	t.Parallel()
+
+	registry := NewDefaultConsensusTypesRegistry()
+	customHandler := &devConsensusTypeHandler{}
+	err := registry.Register("custom", customHandler)
+	assert.Nil(t, err)
+
+	handler, err := registry.Get("custom")
+	assert.Nil(t, err)
+	assert.True(t, handler == customHandler)
+}
@@ -1,4 +1,11 @@
 package sposFactory
 
-const blsConsensusType = "bls"
+import "github.com/ElrondNetwork/elrond-go/consensus"
+
+const blsConsensusType = consensus.BlsConsensusType
+
+// DevConsensusType is the consensus type in which a single validator produces a block every round, without any
+// signature exchange. It is meant to be used on local development chains
+const DevConsensusType = "dev"
+
 const maxDelayCacheSize = 20
@@ -0,0 +1,58 @@
+package sposFactory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/dev"
+)
+
+// devConsensusTypeHandler creates the components of the single validator dev consensus. The consensus messages are
+// the BLS ones and the produced headers carry BLS signatures, so they can be verified by the rest of the network
+type devConsensusTypeHandler struct {
+}
+
+// CreateConsensusService creates the dev consensus service
+func (dcth *devConsensusTypeHandler) CreateConsensusService() (spos.ConsensusService, error) {
+	return bls.NewConsensusService()
+}
+
+// CreateSubroundsFactory creates the dev subrounds factory. Block pipelining is not supported as the single validator
+// produces and commits its block in the same subround
+func (dcth *devConsensusTypeHandler) CreateSubroundsFactory(args ArgsSubroundsFactory) (spos.SubroundsFactory, error) {
+	subRoundFactoryDev, err := dev.NewSubroundsFactory(
+		args.ConsensusDataContainer,
+		args.ConsensusState,
+		args.Worker,
+		args.ChainID,
+		args.CurrentPid,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = subRoundFactoryDev.SetAppStatusHandler(args.AppStatusHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	subRoundFactoryDev.SetIndexer(args.Indexer)
+
+	return subRoundFactoryDev, nil
+}
+
+// CreateBroadcastMessenger creates the broadcast messenger used by the dev consensus
+func (dcth *devConsensusTypeHandler) CreateBroadcastMessenger(args broadcast.CommonMessengerArgs) (consensus.BroadcastMessenger, error) {
+	return createBroadcastMessenger(args)
+}
+
+// SignatureScheme returns the BLS signature scheme
+func (dcth *devConsensusTypeHandler) SignatureScheme() string {
+	return consensus.BlsConsensusType
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dcth *devConsensusTypeHandler) IsInterfaceNil() bool {
+	return dcth == nil
+}
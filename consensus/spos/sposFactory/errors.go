@@ -9,3 +9,12 @@ var ErrInvalidConsensusType = errors.New("invalid consensus type")
 
 // ErrInvalidShardId signals that an invalid shard id has been provided
 var ErrInvalidShardId = errors.New("invalid shard id")
+
+// ErrNilConsensusTypeHandler signals that a nil consensus type handler has been provided
+var ErrNilConsensusTypeHandler = errors.New("nil consensus type handler")
+
+// ErrNilConsensusTypesRegistry signals that a nil consensus types registry has been provided
+var ErrNilConsensusTypesRegistry = errors.New("nil consensus types registry")
+
+// ErrConsensusTypeAlreadyRegistered signals that the consensus type was already registered
+var ErrConsensusTypeAlreadyRegistered = errors.New("consensus type already registered")
@@ -0,0 +1,23 @@
+package sposFactory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+)
+
+// ConsensusTypeHandler defines the components a consensus type has to provide in order to be run by the node
+type ConsensusTypeHandler interface {
+	CreateConsensusService() (spos.ConsensusService, error)
+	CreateSubroundsFactory(args ArgsSubroundsFactory) (spos.SubroundsFactory, error)
+	CreateBroadcastMessenger(args broadcast.CommonMessengerArgs) (consensus.BroadcastMessenger, error)
+	SignatureScheme() string
+	IsInterfaceNil() bool
+}
+
+// ConsensusTypesRegistry defines a registry holding the consensus types a node is able to run
+type ConsensusTypesRegistry interface {
+	Register(consensusType string, handler ConsensusTypeHandler) error
+	Get(consensusType string) (ConsensusTypeHandler, error)
+	IsInterfaceNil() bool
+}
@@ -4,8 +4,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
-	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/indexer"
 	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -14,8 +14,22 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
+// ArgsSubroundsFactory holds the arguments needed by a consensus type to create its subrounds factory
+type ArgsSubroundsFactory struct {
+	ConsensusDataContainer spos.ConsensusCoreHandler
+	ConsensusState         *spos.ConsensusState
+	Worker                 spos.WorkerHandler
+	AppStatusHandler       core.AppStatusHandler
+	Indexer                indexer.Indexer
+	ChainID                []byte
+	CurrentPid             core.PeerID
+	ForkDetector           process.ForkDetector
+	PipeliningEnabled      bool
+}
+
 // GetSubroundsFactory returns a subrounds factory depending of the given parameter
 func GetSubroundsFactory(
+	registry ConsensusTypesRegistry,
 	consensusDataContainer spos.ConsensusCoreHandler,
 	consensusState *spos.ConsensusState,
 	worker spos.WorkerHandler,
@@ -24,54 +38,56 @@ func GetSubroundsFactory(
 	indexer indexer.Indexer,
 	chainID []byte,
 	currentPid core.PeerID,
+	forkDetector process.ForkDetector,
+	pipeliningEnabled bool,
 ) (spos.SubroundsFactory, error) {
-	switch consensusType {
-	case blsConsensusType:
-		subRoundFactoryBls, err := bls.NewSubroundsFactory(
-			consensusDataContainer,
-			consensusState,
-			worker,
-			chainID,
-			currentPid,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		err = subRoundFactoryBls.SetAppStatusHandler(appStatusHandler)
-		if err != nil {
-			return nil, err
-		}
-
-		subRoundFactoryBls.SetIndexer(indexer)
-
-		return subRoundFactoryBls, nil
-	default:
-		return nil, ErrInvalidConsensusType
+	handler, err := getConsensusTypeHandler(registry, consensusType)
+	if err != nil {
+		return nil, err
 	}
+
+	return handler.CreateSubroundsFactory(ArgsSubroundsFactory{
+		ConsensusDataContainer: consensusDataContainer,
+		ConsensusState:         consensusState,
+		Worker:                 worker,
+		AppStatusHandler:       appStatusHandler,
+		Indexer:                indexer,
+		ChainID:                chainID,
+		CurrentPid:             currentPid,
+		ForkDetector:           forkDetector,
+		PipeliningEnabled:      pipeliningEnabled,
+	})
 }
 
 // GetConsensusCoreFactory returns a consensus service depending of the given parameter
-func GetConsensusCoreFactory(consensusType string) (spos.ConsensusService, error) {
-	switch consensusType {
-	case blsConsensusType:
-		return bls.NewConsensusService()
-	default:
-		return nil, ErrInvalidConsensusType
+func GetConsensusCoreFactory(registry ConsensusTypesRegistry, consensusType string) (spos.ConsensusService, error) {
+	handler, err := getConsensusTypeHandler(registry, consensusType)
+	if err != nil {
+		return nil, err
 	}
+
+	return handler.CreateConsensusService()
 }
 
 // GetBroadcastMessenger returns a consensus service depending of the given parameter
 func GetBroadcastMessenger(
+	registry ConsensusTypesRegistry,
+	consensusType string,
 	marshalizer marshal.Marshalizer,
 	hasher hashing.Hasher,
 	messenger consensus.P2PMessenger,
 	shardCoordinator sharding.Coordinator,
 	privateKey crypto.PrivateKey,
 	peerSignatureHandler crypto.PeerSignatureHandler,
+	validatorDirectSender consensus.ValidatorDirectSender,
 	headersSubscriber consensus.HeadersPoolSubscriber,
 	interceptorsContainer process.InterceptorsContainer,
+	roundTracer consensus.RoundTracer,
 ) (consensus.BroadcastMessenger, error) {
+	handler, err := getConsensusTypeHandler(registry, consensusType)
+	if err != nil {
+		return nil, err
+	}
 
 	commonMessengerArgs := broadcast.CommonMessengerArgs{
 		Marshalizer:                marshalizer,
@@ -80,10 +96,30 @@ func GetBroadcastMessenger(
 		PrivateKey:                 privateKey,
 		ShardCoordinator:           shardCoordinator,
 		PeerSignatureHandler:       peerSignatureHandler,
+		ValidatorDirectSender:      validatorDirectSender,
 		HeadersSubscriber:          headersSubscriber,
 		MaxDelayCacheSize:          maxDelayCacheSize,
 		MaxValidatorDelayCacheSize: maxDelayCacheSize,
 		InterceptorsContainer:      interceptorsContainer,
+		RoundTracer:                roundTracer,
+	}
+
+	return handler.CreateBroadcastMessenger(commonMessengerArgs)
+}
+
+func getConsensusTypeHandler(registry ConsensusTypesRegistry, consensusType string) (ConsensusTypeHandler, error) {
+	if check.IfNil(registry) {
+		return nil, ErrNilConsensusTypesRegistry
+	}
+
+	return registry.Get(consensusType)
+}
+
+// createBroadcastMessenger creates the shard or the metachain broadcast messenger, depending on the self shard
+func createBroadcastMessenger(commonMessengerArgs broadcast.CommonMessengerArgs) (consensus.BroadcastMessenger, error) {
+	shardCoordinator := commonMessengerArgs.ShardCoordinator
+	if check.IfNil(shardCoordinator) {
+		return nil, spos.ErrNilShardCoordinator
 	}
 
 	if shardCoordinator.SelfId() < shardCoordinator.NumberOfShards() {
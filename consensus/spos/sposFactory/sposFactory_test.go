@@ -17,7 +17,7 @@ var currentPid = core.PeerID("pid")
 func TestGetConsensusCoreFactory_InvalidTypeShouldErr(t *testing.T) {
 	t.Parallel()
 
-	csf, err := sposFactory.GetConsensusCoreFactory("invalid")
+	csf, err := sposFactory.GetConsensusCoreFactory(sposFactory.NewDefaultConsensusTypesRegistry(), "invalid")
 
 	assert.Nil(t, csf)
 	assert.Equal(t, sposFactory.ErrInvalidConsensusType, err)
@@ -26,7 +26,7 @@ func TestGetConsensusCoreFactory_InvalidTypeShouldErr(t *testing.T) {
 func TestGetConsensusCoreFactory_BlsShouldWork(t *testing.T) {
 	t.Parallel()
 
-	csf, err := sposFactory.GetConsensusCoreFactory(consensus.BlsConsensusType)
+	csf, err := sposFactory.GetConsensusCoreFactory(sposFactory.NewDefaultConsensusTypesRegistry(), consensus.BlsConsensusType)
 
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(csf))
@@ -41,6 +41,7 @@ func TestGetSubroundsFactory_BlsNilConsensusCoreShouldErr(t *testing.T) {
 	chainID := []byte("chain-id")
 	indexer := &mock.IndexerMock{}
 	sf, err := sposFactory.GetSubroundsFactory(
+		sposFactory.NewDefaultConsensusTypesRegistry(),
 		nil,
 		&spos.ConsensusState{},
 		worker,
@@ -49,6 +50,8 @@ func TestGetSubroundsFactory_BlsNilConsensusCoreShouldErr(t *testing.T) {
 		indexer,
 		chainID,
 		currentPid,
+		&mock.ForkDetectorMock{},
+		false,
 	)
 
 	assert.Nil(t, sf)
@@ -64,6 +67,7 @@ func TestGetSubroundsFactory_BlsNilStatusHandlerShouldErr(t *testing.T) {
 	chainID := []byte("chain-id")
 	indexer := &mock.IndexerMock{}
 	sf, err := sposFactory.GetSubroundsFactory(
+		sposFactory.NewDefaultConsensusTypesRegistry(),
 		consensusCore,
 		&spos.ConsensusState{},
 		worker,
@@ -72,6 +76,8 @@ func TestGetSubroundsFactory_BlsNilStatusHandlerShouldErr(t *testing.T) {
 		indexer,
 		chainID,
 		currentPid,
+		&mock.ForkDetectorMock{},
+		false,
 	)
 
 	assert.Nil(t, sf)
@@ -88,6 +94,7 @@ func TestGetSubroundsFactory_BlsShouldWork(t *testing.T) {
 	chainID := []byte("chain-id")
 	indexer := &mock.IndexerMock{}
 	sf, err := sposFactory.GetSubroundsFactory(
+		sposFactory.NewDefaultConsensusTypesRegistry(),
 		consensusCore,
 		&spos.ConsensusState{},
 		worker,
@@ -96,16 +103,61 @@ func TestGetSubroundsFactory_BlsShouldWork(t *testing.T) {
 		indexer,
 		chainID,
 		currentPid,
+		&mock.ForkDetectorMock{},
+		false,
 	)
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(sf))
 }
 
+func TestGetSubroundsFactory_BlsPipeliningWithNilForkDetectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sf, err := sposFactory.GetSubroundsFactory(
+		sposFactory.NewDefaultConsensusTypesRegistry(),
+		mock.InitConsensusCore(),
+		&spos.ConsensusState{},
+		&mock.SposWorkerMock{},
+		consensus.BlsConsensusType,
+		&mock.AppStatusHandlerMock{},
+		&mock.IndexerMock{},
+		[]byte("chain-id"),
+		currentPid,
+		nil,
+		true,
+	)
+
+	assert.Nil(t, sf)
+	assert.Equal(t, spos.ErrNilForkDetector, err)
+}
+
+func TestGetSubroundsFactory_BlsWithPipeliningShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sf, err := sposFactory.GetSubroundsFactory(
+		sposFactory.NewDefaultConsensusTypesRegistry(),
+		mock.InitConsensusCore(),
+		&spos.ConsensusState{},
+		&mock.SposWorkerMock{},
+		consensus.BlsConsensusType,
+		&mock.AppStatusHandlerMock{},
+		&mock.IndexerMock{},
+		[]byte("chain-id"),
+		currentPid,
+		&mock.ForkDetectorMock{},
+		true,
+	)
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(sf))
+}
+
 func TestGetSubroundsFactory_InvalidConsensusTypeShouldErr(t *testing.T) {
 	t.Parallel()
 
 	consensusType := "invalid"
 	sf, err := sposFactory.GetSubroundsFactory(
+		sposFactory.NewDefaultConsensusTypesRegistry(),
 		nil,
 		nil,
 		nil,
@@ -114,6 +166,8 @@ func TestGetSubroundsFactory_InvalidConsensusTypeShouldErr(t *testing.T) {
 		nil,
 		nil,
 		currentPid,
+		&mock.ForkDetectorMock{},
+		false,
 	)
 
 	assert.Nil(t, sf)
@@ -135,14 +189,18 @@ func TestGetBroadcastMessenger_ShardShouldWork(t *testing.T) {
 	headersSubscriber := &mock.HeadersCacherStub{}
 	interceptosContainer := &mock.InterceptorsContainerStub{}
 	bm, err := sposFactory.GetBroadcastMessenger(
+		sposFactory.NewDefaultConsensusTypesRegistry(),
+		consensus.BlsConsensusType,
 		marshalizer,
 		hasher,
 		messenger,
 		shardCoord,
 		privateKey,
 		peerSigHandler,
+		&mock.ValidatorDirectSenderStub{},
 		headersSubscriber,
 		interceptosContainer,
+		&mock.RoundTracerStub{},
 	)
 
 	assert.Nil(t, err)
@@ -164,14 +222,18 @@ func TestGetBroadcastMessenger_MetachainShouldWork(t *testing.T) {
 	headersSubscriber := &mock.HeadersCacherStub{}
 	interceptosContainer := &mock.InterceptorsContainerStub{}
 	bm, err := sposFactory.GetBroadcastMessenger(
+		sposFactory.NewDefaultConsensusTypesRegistry(),
+		consensus.BlsConsensusType,
 		marshalizer,
 		hasher,
 		messenger,
 		shardCoord,
 		privateKey,
 		peerSigHandler,
+		&mock.ValidatorDirectSenderStub{},
 		headersSubscriber,
 		interceptosContainer,
+		&mock.RoundTracerStub{},
 	)
 
 	assert.Nil(t, err)
@@ -189,16 +251,62 @@ func TestGetBroadcastMessenger_InvalidShardIdShouldErr(t *testing.T) {
 	interceptosContainer := &mock.InterceptorsContainerStub{}
 
 	bm, err := sposFactory.GetBroadcastMessenger(
+		sposFactory.NewDefaultConsensusTypesRegistry(),
+		consensus.BlsConsensusType,
 		nil,
 		nil,
 		nil,
 		shardCoord,
 		nil,
 		nil,
+		nil,
 		headersSubscriber,
 		interceptosContainer,
+		&mock.RoundTracerStub{},
 	)
 
 	assert.Nil(t, bm)
 	assert.Equal(t, sposFactory.ErrInvalidShardId, err)
 }
+
+func TestGetConsensusCoreFactory_NilRegistryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	csf, err := sposFactory.GetConsensusCoreFactory(nil, consensus.BlsConsensusType)
+
+	assert.Nil(t, csf)
+	assert.Equal(t, sposFactory.ErrNilConsensusTypesRegistry, err)
+}
+
+func TestGetConsensusCoreFactory_DevShouldWork(t *testing.T) {
+	t.Parallel()
+
+	csf, err := sposFactory.GetConsensusCoreFactory(sposFactory.NewDefaultConsensusTypesRegistry(), sposFactory.DevConsensusType)
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(csf))
+}
+
+func TestGetSubroundsFactory_DevShouldWork(t *testing.T) {
+	t.Parallel()
+
+	selfPubKey := "A"
+	roundConsensus := spos.NewRoundConsensus(map[string]struct{}{selfPubKey: {}}, 1, selfPubKey)
+	consensusState := spos.NewConsensusState(roundConsensus, spos.NewRoundThreshold(), spos.NewRoundStatus())
+	sf, err := sposFactory.GetSubroundsFactory(
+		sposFactory.NewDefaultConsensusTypesRegistry(),
+		mock.InitConsensusCore(),
+		consensusState,
+		&mock.SposWorkerMock{},
+		sposFactory.DevConsensusType,
+		&mock.AppStatusHandlerMock{},
+		&mock.IndexerMock{},
+		[]byte("chain-id"),
+		currentPid,
+		&mock.ForkDetectorMock{},
+		false,
+	)
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(sf))
+}
@@ -0,0 +1,154 @@
+package spos
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var _ p2p.MessageProcessor = (*validatorDirectMessageProcessor)(nil)
+
+// ArgsValidatorDirectMessageProcessor holds the validator direct message processor arguments
+type ArgsValidatorDirectMessageProcessor struct {
+	Marshalizer      marshal.Marshalizer
+	SingleSigner     crypto.SingleSigner
+	KeyGen           crypto.KeyGenerator
+	NodesCoordinator sharding.NodesCoordinator
+	ShardCoordinator sharding.Coordinator
+	AntifloodHandler consensus.P2PAntifloodHandler
+	MessageProcessor p2p.MessageProcessor
+	ConsensusState   *ConsensusState
+}
+
+type validatorDirectMessageProcessor struct {
+	marshalizer      marshal.Marshalizer
+	singleSigner     crypto.SingleSigner
+	keyGen           crypto.KeyGenerator
+	nodesCoordinator sharding.NodesCoordinator
+	shardCoordinator sharding.Coordinator
+	antifloodHandler consensus.P2PAntifloodHandler
+	messageProcessor p2p.MessageProcessor
+	consensusState   *ConsensusState
+	topic            string
+}
+
+// NewValidatorDirectMessageProcessor creates the processor of the consensus messages sent directly by validators.
+// A message is passed to the wrapped message processor only if it was signed by a validator of the self shard which
+// is part of the consensus group of the current round
+func NewValidatorDirectMessageProcessor(args ArgsValidatorDirectMessageProcessor) (*validatorDirectMessageProcessor, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+	if check.IfNil(args.KeyGen) {
+		return nil, ErrNilKeyGenerator
+	}
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if check.IfNil(args.AntifloodHandler) {
+		return nil, ErrNilAntifloodHandler
+	}
+	if check.IfNil(args.MessageProcessor) {
+		return nil, ErrNilWorker
+	}
+	if args.ConsensusState == nil {
+		return nil, ErrNilConsensusState
+	}
+
+	return &validatorDirectMessageProcessor{
+		marshalizer:      args.Marshalizer,
+		singleSigner:     args.SingleSigner,
+		keyGen:           args.KeyGen,
+		nodesCoordinator: args.NodesCoordinator,
+		shardCoordinator: args.ShardCoordinator,
+		antifloodHandler: args.AntifloodHandler,
+		messageProcessor: args.MessageProcessor,
+		consensusState:   args.ConsensusState,
+		topic:            GetValidatorDirectSendTopicID(args.ShardCoordinator),
+	}, nil
+}
+
+// ProcessReceivedMessage verifies the signed envelope of a direct consensus message and, if valid, passes the
+// wrapped consensus message to the message processor
+func (vdmp *validatorDirectMessageProcessor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	if check.IfNil(message) {
+		return ErrNilMessage
+	}
+	if message.Data() == nil {
+		return ErrNilDataToProcess
+	}
+
+	err := vdmp.antifloodHandler.CanProcessMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+	//the message is sent directly, so the connected peer is the originator
+	err = vdmp.antifloodHandler.CanProcessMessagesOnTopic(fromConnectedPeer, vdmp.topic, 1, uint64(len(message.Data())), message.SeqNo())
+	if err != nil {
+		return err
+	}
+
+	envelope := &consensus.SignedDirectMessage{}
+	err = vdmp.marshalizer.Unmarshal(envelope, message.Data())
+	if err != nil {
+		return err
+	}
+
+	_, shardID, err := vdmp.nodesCoordinator.GetValidatorWithPublicKey(envelope.PubKey)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrNodeIsNotInEligibleList, err.Error())
+	}
+	if shardID != vdmp.shardCoordinator.SelfId() {
+		return fmt.Errorf("%w: validator is in shard %d", ErrNodeIsNotInEligibleList, shardID)
+	}
+	if !vdmp.consensusState.IsNodeInConsensusGroup(string(envelope.PubKey)) {
+		return ErrNodeIsNotInConsensusGroup
+	}
+
+	err = vdmp.verifySignature(envelope)
+	if err != nil {
+		//only the connected peer can be blamed as the envelope is sent directly, without being relayed
+		reason := "blacklisted due to invalid validator direct message signature"
+		vdmp.antifloodHandler.BlacklistPeer(fromConnectedPeer, reason, core.InvalidMessageBlacklistDuration)
+
+		return err
+	}
+
+	cnsMsg := &consensus.Message{}
+	err = vdmp.marshalizer.Unmarshal(cnsMsg, envelope.Payload)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(cnsMsg.PubKey, envelope.PubKey) {
+		return ErrPublicKeyMismatch
+	}
+
+	return vdmp.messageProcessor.ProcessReceivedMessage(newDirectConsensusMessage(message, envelope.Payload, fromConnectedPeer), fromConnectedPeer)
+}
+
+func (vdmp *validatorDirectMessageProcessor) verifySignature(envelope *consensus.SignedDirectMessage) error {
+	pubKey, err := vdmp.keyGen.PublicKeyFromByteArray(envelope.PubKey)
+	if err != nil {
+		return err
+	}
+
+	return vdmp.singleSigner.Verify(pubKey, envelope.Payload, envelope.Signature)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vdmp *validatorDirectMessageProcessor) IsInterfaceNil() bool {
+	return vdmp == nil
+}
@@ -0,0 +1,278 @@
+package spos_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var validatorPk = []byte("validator pk")
+
+func createDefaultValidatorDirectMessageProcessorArgs() spos.ArgsValidatorDirectMessageProcessor {
+	return spos.ArgsValidatorDirectMessageProcessor{
+		Marshalizer: &mock.MarshalizerMock{},
+		SingleSigner: &mock.SingleSignerMock{
+			VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				return nil
+			},
+		},
+		KeyGen: &mock.KeyGenMock{
+			PublicKeyFromByteArrayMock: func(b []byte) (crypto.PublicKey, error) {
+				return &mock.PublicKeyMock{}, nil
+			},
+		},
+		NodesCoordinator: &mock.NodesCoordinatorMock{
+			GetValidatorWithPublicKeyCalled: func(publicKey []byte) (sharding.Validator, uint32, error) {
+				return mock.NewValidator(publicKey, 1, 0), 0, nil
+			},
+		},
+		ShardCoordinator: &mock.ShardCoordinatorMock{},
+		AntifloodHandler: &mock.P2PAntifloodHandlerStub{},
+		MessageProcessor: &mock.SposWorkerMock{
+			ProcessReceivedMessageCalled: func(message p2p.MessageP2P) error {
+				return nil
+			},
+		},
+		ConsensusState: createConsensusStateWithGroup(string(validatorPk)),
+	}
+}
+
+func createConsensusStateWithGroup(consensusGroup ...string) *spos.ConsensusState {
+	cns := initConsensusState()
+	cns.SetConsensusGroup(consensusGroup)
+
+	return cns
+}
+
+func createDirectMessage(t *testing.T, cnsMsgPk []byte, envelopePk []byte) *mock.P2PMessageMock {
+	marshalizer := &mock.MarshalizerMock{}
+	cnsMsgBuff, err := marshalizer.Marshal(&consensus.Message{PubKey: cnsMsgPk})
+	require.Nil(t, err)
+
+	envelope := consensus.NewSignedDirectMessage(cnsMsgBuff, envelopePk, []byte("signature"))
+	envelopeBuff, err := marshalizer.Marshal(envelope)
+	require.Nil(t, err)
+
+	return &mock.P2PMessageMock{
+		DataField: envelopeBuff,
+		PeerField: "originator",
+	}
+}
+
+func TestNewValidatorDirectMessageProcessor_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultValidatorDirectMessageProcessorArgs()
+	args.Marshalizer = nil
+	vdmp, err := spos.NewValidatorDirectMessageProcessor(args)
+	assert.True(t, check.IfNil(vdmp))
+	assert.Equal(t, spos.ErrNilMarshalizer, err)
+
+	args = createDefaultValidatorDirectMessageProcessorArgs()
+	args.SingleSigner = nil
+	vdmp, err = spos.NewValidatorDirectMessageProcessor(args)
+	assert.True(t, check.IfNil(vdmp))
+	assert.Equal(t, spos.ErrNilSingleSigner, err)
+
+	args = createDefaultValidatorDirectMessageProcessorArgs()
+	args.KeyGen = nil
+	vdmp, err = spos.NewValidatorDirectMessageProcessor(args)
+	assert.True(t, check.IfNil(vdmp))
+	assert.Equal(t, spos.ErrNilKeyGenerator, err)
+
+	args = createDefaultValidatorDirectMessageProcessorArgs()
+	args.NodesCoordinator = nil
+	vdmp, err = spos.NewValidatorDirectMessageProcessor(args)
+	assert.True(t, check.IfNil(vdmp))
+	assert.Equal(t, spos.ErrNilNodesCoordinator, err)
+
+	args = createDefaultValidatorDirectMessageProcessorArgs()
+	args.ShardCoordinator = nil
+	vdmp, err = spos.NewValidatorDirectMessageProcessor(args)
+	assert.True(t, check.IfNil(vdmp))
+	assert.Equal(t, spos.ErrNilShardCoordinator, err)
+
+	args = createDefaultValidatorDirectMessageProcessorArgs()
+	args.AntifloodHandler = nil
+	vdmp, err = spos.NewValidatorDirectMessageProcessor(args)
+	assert.True(t, check.IfNil(vdmp))
+	assert.Equal(t, spos.ErrNilAntifloodHandler, err)
+
+	args = createDefaultValidatorDirectMessageProcessorArgs()
+	args.MessageProcessor = nil
+	vdmp, err = spos.NewValidatorDirectMessageProcessor(args)
+	assert.True(t, check.IfNil(vdmp))
+	assert.Equal(t, spos.ErrNilWorker, err)
+
+	args = createDefaultValidatorDirectMessageProcessorArgs()
+	args.ConsensusState = nil
+	vdmp, err = spos.NewValidatorDirectMessageProcessor(args)
+	assert.True(t, check.IfNil(vdmp))
+	assert.Equal(t, spos.ErrNilConsensusState, err)
+}
+
+func TestNewValidatorDirectMessageProcessor_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	vdmp, err := spos.NewValidatorDirectMessageProcessor(createDefaultValidatorDirectMessageProcessorArgs())
+	assert.False(t, check.IfNil(vdmp))
+	assert.Nil(t, err)
+}
+
+func TestValidatorDirectMessageProcessor_ProcessReceivedMessageAntifloodErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createDefaultValidatorDirectMessageProcessorArgs()
+	args.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			return expectedErr
+		},
+	}
+	vdmp, _ := spos.NewValidatorDirectMessageProcessor(args)
+
+	err := vdmp.ProcessReceivedMessage(createDirectMessage(t, validatorPk, validatorPk), "pid")
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestValidatorDirectMessageProcessor_ProcessReceivedMessageTopicAntifloodErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	fromConnectedPeer := core.PeerID("pid")
+	args := createDefaultValidatorDirectMessageProcessorArgs()
+	args.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		CanProcessMessagesOnTopicCalled: func(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error {
+			assert.Equal(t, fromConnectedPeer, peer)
+			assert.Equal(t, spos.GetValidatorDirectSendTopicID(args.ShardCoordinator), topic)
+			return expectedErr
+		},
+	}
+	vdmp, _ := spos.NewValidatorDirectMessageProcessor(args)
+
+	err := vdmp.ProcessReceivedMessage(createDirectMessage(t, validatorPk, validatorPk), fromConnectedPeer)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestValidatorDirectMessageProcessor_ProcessReceivedMessageNotInConsensusGroupShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultValidatorDirectMessageProcessorArgs()
+	args.ConsensusState = createConsensusStateWithGroup("other validator")
+	args.MessageProcessor = &mock.SposWorkerMock{
+		ProcessReceivedMessageCalled: func(message p2p.MessageP2P) error {
+			assert.Fail(t, "should have not processed the message")
+			return nil
+		},
+	}
+	vdmp, _ := spos.NewValidatorDirectMessageProcessor(args)
+
+	err := vdmp.ProcessReceivedMessage(createDirectMessage(t, validatorPk, validatorPk), "pid")
+	assert.Equal(t, spos.ErrNodeIsNotInConsensusGroup, err)
+}
+
+func TestValidatorDirectMessageProcessor_ProcessReceivedMessageNotAValidatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultValidatorDirectMessageProcessorArgs()
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		GetValidatorWithPublicKeyCalled: func(publicKey []byte) (sharding.Validator, uint32, error) {
+			return nil, 0, errors.New("not found")
+		},
+	}
+	vdmp, _ := spos.NewValidatorDirectMessageProcessor(args)
+
+	err := vdmp.ProcessReceivedMessage(createDirectMessage(t, validatorPk, validatorPk), "pid")
+	assert.True(t, errors.Is(err, spos.ErrNodeIsNotInEligibleList))
+}
+
+func TestValidatorDirectMessageProcessor_ProcessReceivedMessageValidatorFromAnotherShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultValidatorDirectMessageProcessorArgs()
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		GetValidatorWithPublicKeyCalled: func(publicKey []byte) (sharding.Validator, uint32, error) {
+			return mock.NewValidator(publicKey, 1, 0), 1, nil
+		},
+	}
+	vdmp, _ := spos.NewValidatorDirectMessageProcessor(args)
+
+	err := vdmp.ProcessReceivedMessage(createDirectMessage(t, validatorPk, validatorPk), "pid")
+	assert.True(t, errors.Is(err, spos.ErrNodeIsNotInEligibleList))
+}
+
+func TestValidatorDirectMessageProcessor_ProcessReceivedMessageInvalidSignatureShouldBlacklist(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("invalid signature")
+	fromConnectedPeer := core.PeerID("pid")
+	blacklisted := false
+	args := createDefaultValidatorDirectMessageProcessorArgs()
+	args.SingleSigner = &mock.SingleSignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			return expectedErr
+		},
+	}
+	args.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		BlacklistPeerCalled: func(peer core.PeerID, reason string, duration time.Duration) {
+			blacklisted = true
+			assert.Equal(t, fromConnectedPeer, peer)
+		},
+	}
+	args.MessageProcessor = &mock.SposWorkerMock{
+		ProcessReceivedMessageCalled: func(message p2p.MessageP2P) error {
+			assert.Fail(t, "should have not processed the message")
+			return nil
+		},
+	}
+	vdmp, _ := spos.NewValidatorDirectMessageProcessor(args)
+
+	err := vdmp.ProcessReceivedMessage(createDirectMessage(t, validatorPk, validatorPk), fromConnectedPeer)
+	assert.Equal(t, expectedErr, err)
+	assert.True(t, blacklisted)
+}
+
+func TestValidatorDirectMessageProcessor_ProcessReceivedMessagePublicKeyMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vdmp, _ := spos.NewValidatorDirectMessageProcessor(createDefaultValidatorDirectMessageProcessorArgs())
+
+	err := vdmp.ProcessReceivedMessage(createDirectMessage(t, []byte("other pk"), validatorPk), "pid")
+	assert.Equal(t, spos.ErrPublicKeyMismatch, err)
+}
+
+func TestValidatorDirectMessageProcessor_ProcessReceivedMessageShouldForwardTheConsensusMessage(t *testing.T) {
+	t.Parallel()
+
+	fromConnectedPeer := core.PeerID("pid")
+	message := createDirectMessage(t, validatorPk, validatorPk)
+	envelope := &consensus.SignedDirectMessage{}
+	_ = (&mock.MarshalizerMock{}).Unmarshal(envelope, message.DataField)
+
+	processCalled := false
+	args := createDefaultValidatorDirectMessageProcessorArgs()
+	args.MessageProcessor = &mock.SposWorkerMock{
+		ProcessReceivedMessageCalled: func(msg p2p.MessageP2P) error {
+			processCalled = true
+			assert.Equal(t, envelope.Payload, msg.Data())
+			assert.Equal(t, fromConnectedPeer, msg.Peer())
+			return nil
+		},
+	}
+	vdmp, _ := spos.NewValidatorDirectMessageProcessor(args)
+
+	err := vdmp.ProcessReceivedMessage(message, fromConnectedPeer)
+	assert.Nil(t, err)
+	assert.True(t, processCalled)
+}
@@ -65,8 +65,9 @@ type Worker struct {
 	receivedHeadersHandlers   []func(headerHandler data.HeaderHandler)
 	mutReceivedHeadersHandler sync.RWMutex
 
-	antifloodHandler consensus.P2PAntifloodHandler
-	poolAdder        PoolAdder
+	antifloodHandler     consensus.P2PAntifloodHandler
+	poolAdder            PoolAdder
+	equivocationDetector EquivocationDetector
 
 	cancelFunc                func()
 	consensusMessageValidator *consensusMessageValidator
@@ -93,6 +94,7 @@ type WorkerArgs struct {
 	NetworkShardingCollector consensus.NetworkShardingCollector
 	AntifloodHandler         consensus.P2PAntifloodHandler
 	PoolAdder                PoolAdder
+	EquivocationDetector     EquivocationDetector
 	SignatureSize            int
 	PublicKeySize            int
 }
@@ -112,6 +114,7 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		PublicKeySize:        args.PublicKeySize,
 		HasherSize:           args.Hasher.Size(),
 		ChainID:              args.ChainID,
+		EquivocationDetector: args.EquivocationDetector,
 	}
 
 	consensusMessageValidator, err := NewConsensusMessageValidator(argsConsensusMessageValidator)
@@ -139,6 +142,7 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		networkShardingCollector: args.NetworkShardingCollector,
 		antifloodHandler:         args.AntifloodHandler,
 		poolAdder:                args.PoolAdder,
+		equivocationDetector:     args.EquivocationDetector,
 	}
 
 	wrk.consensusMessageValidator = consensusMessageValidator
@@ -227,6 +231,9 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.PoolAdder) {
 		return ErrNilPoolAdder
 	}
+	if check.IfNil(args.EquivocationDetector) {
+		return ErrNilEquivocationDetector
+	}
 
 	return nil
 }
@@ -241,7 +248,9 @@ func (wrk *Worker) receivedSyncState(isNodeSynchronized bool) {
 }
 
 // ReceivedHeader process the received header, calling each received header handler registered in worker instance
-func (wrk *Worker) ReceivedHeader(headerHandler data.HeaderHandler, _ []byte) {
+func (wrk *Worker) ReceivedHeader(headerHandler data.HeaderHandler, headerHash []byte) {
+	wrk.equivocationDetector.AddSignedHeader(headerHandler, headerHash)
+
 	isHeaderForOtherShard := headerHandler.GetShardID() != wrk.shardCoordinator.SelfId()
 	isHeaderForOtherRound := int64(headerHandler.GetRound()) != wrk.rounder.Index()
 	headerCanNotBeProcessed := isHeaderForOtherShard || isHeaderForOtherRound
@@ -93,6 +93,7 @@ func createDefaultWorkerArgs() *spos.WorkerArgs {
 		NetworkShardingCollector: createMockNetworkShardingCollector(),
 		AntifloodHandler:         createMockP2PAntifloodHandler(),
 		PoolAdder:                poolAdder,
+		EquivocationDetector:     &mock.EquivocationDetectorStub{},
 		SignatureSize:            SignatureSize,
 		PublicKeySize:            PublicKeySize,
 	}
@@ -346,6 +347,17 @@ func TestWorker_NewWorkerPoolAdderNilShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilPoolAdder, err)
 }
 
+func TestWorker_NewWorkerEquivocationDetectorNilShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.EquivocationDetector = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilEquivocationDetector, err)
+}
+
 func TestWorker_NewWorkerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1546,3 +1558,25 @@ func TestWorker_ProcessReceivedMessageWrongHeaderShouldErr(t *testing.T) {
 	err := wrk.ProcessReceivedMessage(msg, "")
 	assert.True(t, errors.Is(err, spos.ErrInvalidHeader))
 }
+
+func TestWorker_ReceivedHeaderShouldAddSignedHeaderToEquivocationDetector(t *testing.T) {
+	t.Parallel()
+
+	var addedHeader data.HeaderHandler
+	var addedHash []byte
+	workerArgs := createDefaultWorkerArgs()
+	workerArgs.EquivocationDetector = &mock.EquivocationDetectorStub{
+		AddSignedHeaderCalled: func(header data.HeaderHandler, headerHash []byte) {
+			addedHeader = header
+			addedHash = headerHash
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	// the header is handed over to the equivocation detector even if it is for other shard and round
+	hdr := &block.Header{ShardID: 1, Round: 100}
+	wrk.ReceivedHeader(hdr, []byte("hash"))
+
+	assert.True(t, hdr == addedHeader)
+	assert.Equal(t, []byte("hash"), addedHash)
+}
@@ -72,6 +72,21 @@ const ConsensusTopic = "consensus"
 // HeartbeatTopic is the topic used for heartbeat signaling
 const HeartbeatTopic = "heartbeat"
 
+// PeerAuthenticationTopic is the topic used by the heartbeat v2 protocol for the signed messages that bind the
+// validators public keys to their peer IDs
+const PeerAuthenticationTopic = "peerAuthentication"
+
+// HeartbeatV2Topic is the topic used by the heartbeat v2 protocol for the compact liveness messages
+const HeartbeatV2Topic = "heartbeatV2"
+
+// EquivocationProofTopic is the topic used for broadcasting the proofs of leaders which signed different headers in
+// the same round
+const EquivocationProofTopic = "equivocationProof"
+
+// ValidatorDirectSendTopicSuffix is appended to the consensus topic of a shard in order to obtain the topic used by
+// the validators of that shard when sending signed consensus messages directly to each other
+const ValidatorDirectSendTopicSuffix = "_direct"
+
 // PathShardPlaceholder represents the placeholder for the shard ID in paths
 const PathShardPlaceholder = "[S]"
 
@@ -472,6 +487,67 @@ const BuiltInFunctionESDTPause = "ESDTPause"
 // BuiltInFunctionESDTUnPause is the key for the elrond standard digital token unpause built-in function
 const BuiltInFunctionESDTUnPause = "ESDTUnPause"
 
+// BuiltInFunctionESDTSetRole is the key for the elrond standard digital token set role built-in function
+const BuiltInFunctionESDTSetRole = "ESDTSetRole"
+
+// BuiltInFunctionESDTUnSetRole is the key for the elrond standard digital token unset role built-in function
+const BuiltInFunctionESDTUnSetRole = "ESDTUnSetRole"
+
+// BuiltInFunctionESDTLocalMint is the key for the elrond standard digital token local mint built-in function
+const BuiltInFunctionESDTLocalMint = "ESDTLocalMint"
+
+// BuiltInFunctionESDTLocalBurn is the key for the elrond standard digital token local burn built-in function
+const BuiltInFunctionESDTLocalBurn = "ESDTLocalBurn"
+
+// BuiltInFunctionESDTNFTCreate is the key for the elrond standard digital token NFT create built-in function
+const BuiltInFunctionESDTNFTCreate = "ESDTNFTCreate"
+
+// BuiltInFunctionESDTNFTAddQuantity is the key for the elrond standard digital token NFT add quantity built-in function
+const BuiltInFunctionESDTNFTAddQuantity = "ESDTNFTAddQuantity"
+
+// BuiltInFunctionESDTNFTBurn is the key for the elrond standard digital token NFT burn built-in function
+const BuiltInFunctionESDTNFTBurn = "ESDTNFTBurn"
+
+// BuiltInFunctionESDTNFTTransfer is the key for the elrond standard digital token NFT transfer built-in function
+const BuiltInFunctionESDTNFTTransfer = "ESDTNFTTransfer"
+
+// BuiltInFunctionMultiESDTTransfer is the key for the elrond standard digital token multi transfer built-in function
+const BuiltInFunctionMultiESDTTransfer = "MultiESDTTransfer"
+
+// ESDTRoleLocalMint is the constant string for the role of minting fungible tokens in the address's shard
+const ESDTRoleLocalMint = "ESDTRoleLocalMint"
+
+// ESDTRoleLocalBurn is the constant string for the role of burning fungible tokens in the address's shard
+const ESDTRoleLocalBurn = "ESDTRoleLocalBurn"
+
+// ESDTRoleNFTCreate is the constant string for the role of creating NFT/SFT tokens
+const ESDTRoleNFTCreate = "ESDTRoleNFTCreate"
+
+// ESDTRoleNFTAddQuantity is the constant string for the role of adding quantity to existing SFT tokens
+const ESDTRoleNFTAddQuantity = "ESDTRoleNFTAddQuantity"
+
+// ESDTRoleNFTBurn is the constant string for the role of burning NFT/SFT tokens
+const ESDTRoleNFTBurn = "ESDTRoleNFTBurn"
+
+// FungibleESDT is the token type of fungible elrond standard digital tokens
+const FungibleESDT = "FungibleESDT"
+
+// NonFungibleESDT is the token type of non-fungible elrond standard digital tokens
+const NonFungibleESDT = "NonFungibleESDT"
+
+// SemiFungibleESDT is the token type of semi-fungible elrond standard digital tokens
+const SemiFungibleESDT = "SemiFungibleESDT"
+
+// ESDTType defines the possible types of the elrond standard digital tokens saved in accounts
+type ESDTType uint32
+
+const (
+	// Fungible defines the type of fungible tokens, kept as a balance under the token key
+	Fungible ESDTType = iota
+	// NonFungible defines the type of non-fungible and semi-fungible tokens, kept under the token key and nonce
+	NonFungible
+)
+
 // RelayedTransaction is the key for the elrond meta/gassless/relayed transaction standard
 const RelayedTransaction = "relayedTx"
 
@@ -493,6 +569,15 @@ const SleepTimeBetweenCreateDBRetries = 5 * time.Second
 // ElrondProtectedKeyPrefix is the key prefix which is protected from writing in the trie - only for special builtin functions
 const ElrondProtectedKeyPrefix = "ELROND"
 
+// ESDTKeyIdentifier is the key identifier of elrond standard digital tokens in the protected part of the trie
+const ESDTKeyIdentifier = "esdt"
+
+// ESDTRoleIdentifier is the key identifier of elrond standard digital token roles in the protected part of the trie
+const ESDTRoleIdentifier = "role"
+
+// ESDTNFTLatestNonceIdentifier is the key identifier of the latest created NFT nonce in the protected part of the trie
+const ESDTNFTLatestNonceIdentifier = "nonce"
+
 // MaxSoftwareVersionLengthInBytes represents the maximum length for the software version to be saved in block header
 const MaxSoftwareVersionLengthInBytes = 10
 
@@ -52,6 +52,28 @@ func LoadTomlFile(dest interface{}, relativePath string) error {
 	return toml.NewDecoder(f).Decode(dest)
 }
 
+// SaveTomlFile encodes the provided object as toml and writes it in the provided file. The content is first
+// written in a temporary file which then replaces the destination, so a partially written file is never left behind
+func SaveTomlFile(src interface{}, relativePath string) error {
+	buff, err := toml.Marshal(src)
+	if err != nil {
+		return err
+	}
+
+	path, err := filepath.Abs(relativePath)
+	if err != nil {
+		return err
+	}
+
+	tmpPath := path + ".tmp"
+	err = ioutil.WriteFile(tmpPath, buff, FileModeUserReadWrite)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, path)
+}
+
 // LoadTomlFileToMap opens and decodes a toml file as a map[string]interface{}
 func LoadTomlFileToMap(relativePath string) (map[string]interface{}, error) {
 	f, err := OpenFile(relativePath)
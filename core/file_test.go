@@ -5,12 +5,14 @@ import (
 	"errors"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type TestStruct struct {
@@ -69,6 +71,36 @@ func TestLoadTomlFile_FileExitsShouldPass(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestSaveTomlFile_ShouldWriteAndReplaceTheFile(t *testing.T) {
+	t.Parallel()
+
+	dir, err := ioutil.TempDir("", "saveToml")
+	require.Nil(t, err)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	fileName := filepath.Join(dir, "testFile.toml")
+	cfg := config.TopicAntifloodConfig{
+		DefaultMaxMessagesPerSec: 10,
+		MaxMessages:              []config.TopicMaxMessagesConfig{{Topic: "topic", NumMessagesPerSec: 5}},
+	}
+	err = core.SaveTomlFile(cfg, fileName)
+	require.Nil(t, err)
+
+	cfg.DefaultMaxMessagesPerSec = 20
+	err = core.SaveTomlFile(cfg, fileName)
+	require.Nil(t, err)
+
+	loadedCfg := config.TopicAntifloodConfig{}
+	err = core.LoadTomlFile(&loadedCfg, fileName)
+	require.Nil(t, err)
+	assert.Equal(t, cfg, loadedCfg)
+
+	_, err = os.Stat(fileName + ".tmp")
+	assert.True(t, os.IsNotExist(err))
+}
+
 func TestLoadJSonFile_NoExistingFileShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -14,6 +14,13 @@ type AppStatusHandler interface {
 	Close()
 }
 
+// PrometheusMetricsProvider defines the behavior of a component able to export its own metrics in the prometheus
+// text format. The provided labels are added on each exported metric
+type PrometheusMetricsProvider interface {
+	PrometheusMetrics(labels string) string
+	IsInterfaceNil() bool
+}
+
 // ConnectedAddressesHandler interface will be used for passing the network component to AppStatusPolling
 type ConnectedAddressesHandler interface {
 	ConnectedAddresses() []string
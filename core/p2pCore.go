@@ -1,5 +1,7 @@
 package core
 
+import "time"
+
 // P2PPeerType defines the type of a p2p peer
 type P2PPeerType int
 
@@ -39,3 +41,44 @@ type QueryP2PPeerInfo struct {
 	PeerType      string   `json:"peertype"`
 	Addresses     []string `json:"addresses"`
 }
+
+// P2PConnectionInfo represents the details of the connection with a peer as seen by the p2p layer
+type P2PConnectionInfo struct {
+	Pid       PeerID
+	Address   string
+	Direction string
+	Latency   time.Duration
+}
+
+// QueryP2PTopologyPeer represents a DTO used in exporting a connected peer of the p2p topology
+type QueryP2PTopologyPeer struct {
+	Pid         string `json:"pid"`
+	Pk          string `json:"pk"`
+	ShardID     uint32 `json:"shardid"`
+	PeerType    string `json:"peertype"`
+	Address     string `json:"address"`
+	Direction   string `json:"direction"`
+	LatencyInMs int64  `json:"latencyms"`
+}
+
+// QueryP2PTopology represents a DTO used in exporting the connection graph of the node
+type QueryP2PTopology struct {
+	SelfPid              string                 `json:"selfpid"`
+	SelfPk               string                 `json:"selfpk"`
+	SelfShardID          uint32                 `json:"selfshardid"`
+	SelfPeerType         string                 `json:"selfpeertype"`
+	IntraShardValidators []QueryP2PTopologyPeer `json:"intrashardvalidators"`
+	IntraShardObservers  []QueryP2PTopologyPeer `json:"intrashardobservers"`
+	CrossShardValidators []QueryP2PTopologyPeer `json:"crossshardvalidators"`
+	CrossShardObservers  []QueryP2PTopologyPeer `json:"crossshardobservers"`
+	UnknownPeers         []QueryP2PTopologyPeer `json:"unknownpeers"`
+}
+
+// PeerReputationInfo represents a persisted blacklisting decision of a peer ID or a public key
+type PeerReputationInfo struct {
+	Type            string  `json:"type"`
+	Identifier      string  `json:"identifier"`
+	Score           float64 `json:"score"`
+	Reason          string  `json:"reason"`
+	ExpiryTimestamp int64   `json:"expiryTimestamp"`
+}
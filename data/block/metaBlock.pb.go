@@ -70,14 +70,16 @@ func (PeerAction) EnumDescriptor() ([]byte, []int) {
 }
 
 // PeerData holds information about actions taken by a peer:
-//  - a peer can register with an amount to become a validator
-//  - a peer can choose to deregister and get back the deposited value
+// - a peer can register with an amount to become a validator
+// - a peer can choose to deregister and get back the deposited value
+// - a peer can be slashed if the evidence of its misbehaviour is included in the block
 type PeerData struct {
 	Address     []byte        `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
 	PublicKey   []byte        `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
 	Action      PeerAction    `protobuf:"varint,3,opt,name=Action,proto3,enum=proto.PeerAction" json:"Action,omitempty"`
 	TimeStamp   uint64        `protobuf:"varint,4,opt,name=TimeStamp,proto3" json:"TimeStamp,omitempty"`
 	ValueChange *math_big.Int `protobuf:"bytes,5,opt,name=ValueChange,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"ValueChange,omitempty"`
+	Evidence    []byte        `protobuf:"bytes,6,opt,name=Evidence,proto3" json:"Evidence,omitempty"`
 }
 
 func (m *PeerData) Reset()      { *m = PeerData{} }
@@ -143,6 +145,13 @@ func (m *PeerData) GetValueChange() *math_big.Int {
 	return nil
 }
 
+func (m *PeerData) GetEvidence() []byte {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
 // ShardData holds the block information sent by the shards to the metachain
 type ShardData struct {
 	HeaderHash            []byte            `protobuf:"bytes,2,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
@@ -780,86 +789,86 @@ func init() {
 func init() { proto.RegisterFile("metaBlock.proto", fileDescriptor_87b91ab531130b2b) }
 
 var fileDescriptor_87b91ab531130b2b = []byte{
-	// 1257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
-	0x13, 0x17, 0x6d, 0xcb, 0xb6, 0x46, 0x96, 0x4d, 0x6f, 0x1c, 0x87, 0x9f, 0xf1, 0x81, 0x31, 0x84,
-	0x1e, 0xdc, 0x02, 0xb1, 0x5b, 0x37, 0x68, 0x0f, 0x3d, 0x14, 0xb6, 0x65, 0x23, 0x6a, 0x12, 0x43,
-	0xa0, 0x5c, 0x1f, 0x7a, 0x5b, 0x91, 0x1b, 0x6a, 0x61, 0x6a, 0x57, 0x25, 0x97, 0x76, 0x5d, 0xa0,
-	0x40, 0x1f, 0xa1, 0xbd, 0xf5, 0x01, 0x7a, 0x08, 0xda, 0x17, 0xc9, 0x31, 0xc7, 0x9c, 0x9a, 0x46,
-	0xb9, 0xf4, 0x98, 0x02, 0x7d, 0x80, 0x62, 0x77, 0x49, 0x91, 0xa2, 0xe8, 0x26, 0x07, 0xe5, 0x64,
-	0xcf, 0x6f, 0x76, 0x66, 0xb4, 0xf3, 0x6f, 0x7f, 0x84, 0xb5, 0x01, 0x11, 0xf8, 0x30, 0xe0, 0xee,
-	0xc5, 0xee, 0x30, 0xe4, 0x82, 0xa3, 0xaa, 0xfa, 0xb3, 0x75, 0xcf, 0xa7, 0xa2, 0x1f, 0xf7, 0x76,
-	0x5d, 0x3e, 0xd8, 0xf3, 0xb9, 0xcf, 0xf7, 0x14, 0xdc, 0x8b, 0x9f, 0x28, 0x49, 0x09, 0xea, 0x3f,
-	0x6d, 0xb5, 0x55, 0xef, 0x65, 0x2e, 0x9a, 0xff, 0x18, 0xb0, 0xdc, 0x21, 0x24, 0x6c, 0x61, 0x81,
-	0x91, 0x05, 0x4b, 0x07, 0x9e, 0x17, 0x92, 0x28, 0xb2, 0x8c, 0x6d, 0x63, 0x67, 0xc5, 0x49, 0x45,
-	0xf4, 0x7f, 0xa8, 0x75, 0xe2, 0x5e, 0x40, 0xdd, 0x87, 0xe4, 0xda, 0x9a, 0x53, 0xba, 0x0c, 0x40,
-	0x1f, 0xc2, 0xe2, 0x81, 0x2b, 0x28, 0x67, 0xd6, 0xfc, 0xb6, 0xb1, 0xb3, 0xba, 0xbf, 0xae, 0x9d,
-	0xef, 0x4a, 0xc7, 0x5a, 0xe1, 0x24, 0x07, 0xa4, 0xa3, 0x33, 0x3a, 0x20, 0x5d, 0x81, 0x07, 0x43,
-	0x6b, 0x61, 0xdb, 0xd8, 0x59, 0x70, 0x32, 0x00, 0xf9, 0x50, 0x3f, 0xc7, 0x41, 0x4c, 0x8e, 0xfa,
-	0x98, 0xf9, 0xc4, 0xaa, 0xca, 0x40, 0x87, 0xc7, 0xbf, 0xbd, 0xbc, 0x7b, 0x30, 0xc0, 0xa2, 0xbf,
-	0xd7, 0xa3, 0xfe, 0x6e, 0x9b, 0x89, 0x2f, 0x72, 0xf7, 0x3d, 0x0e, 0x42, 0xce, 0xbc, 0x53, 0x22,
-	0xae, 0x78, 0x78, 0xb1, 0x47, 0x94, 0x74, 0xcf, 0xe7, 0x7b, 0x1e, 0x16, 0x78, 0xf7, 0x90, 0xfa,
-	0x6d, 0x26, 0x8e, 0x70, 0x24, 0x48, 0xe8, 0xe4, 0x3d, 0x37, 0x7f, 0xaf, 0x42, 0xad, 0xdb, 0xc7,
-	0xa1, 0xa7, 0xee, 0x6d, 0x03, 0x3c, 0x20, 0xd8, 0x23, 0xe1, 0x03, 0x1c, 0xf5, 0x93, 0xeb, 0xe5,
-	0x10, 0xe4, 0xc0, 0x6d, 0x75, 0xf8, 0x31, 0x65, 0x54, 0xe5, 0x5f, 0xeb, 0x22, 0x6b, 0x7e, 0x7b,
-	0x7e, 0xa7, 0xbe, 0xbf, 0x99, 0x5c, 0xb7, 0xa0, 0x3e, 0x5c, 0x78, 0xf6, 0xc7, 0xdd, 0x8a, 0x53,
-	0x6e, 0x8a, 0x9a, 0xb0, 0xd2, 0x09, 0xc9, 0xa5, 0x83, 0x99, 0xd7, 0x25, 0xc4, 0x53, 0xb9, 0x58,
-	0x71, 0x26, 0x30, 0xf4, 0x01, 0x34, 0x3a, 0x71, 0xef, 0x21, 0xb9, 0x8e, 0x0e, 0xa9, 0x18, 0xe0,
-	0xa1, 0x4e, 0x88, 0x33, 0x09, 0xca, 0x94, 0x76, 0xa9, 0xcf, 0xb0, 0x88, 0x43, 0x62, 0x2d, 0xea,
-	0xda, 0x8c, 0x01, 0xb4, 0x01, 0x55, 0x87, 0xc7, 0xcc, 0xb3, 0x96, 0x55, 0xb2, 0xb5, 0x80, 0xb6,
-	0x60, 0x59, 0x46, 0x52, 0xf7, 0xad, 0x29, 0x93, 0xb1, 0x2c, 0x2d, 0x4e, 0x39, 0x73, 0x89, 0x05,
-	0xda, 0x42, 0x09, 0x88, 0xc3, 0xda, 0x81, 0xeb, 0xc6, 0x83, 0x38, 0xc0, 0x82, 0x78, 0x27, 0x84,
-	0x44, 0xd6, 0xca, 0x2c, 0xcb, 0x53, 0xf4, 0x8e, 0x2e, 0xa0, 0xd1, 0x22, 0x97, 0x24, 0xe0, 0x43,
-	0x12, 0xaa, 0x70, 0xab, 0xb3, 0x0c, 0x37, 0xe9, 0x1b, 0xed, 0xc3, 0xc6, 0x69, 0x3c, 0xe8, 0x10,
-	0xe6, 0x51, 0xe6, 0x8f, 0x6b, 0x15, 0x59, 0xf5, 0x6d, 0x63, 0xa7, 0xe1, 0x94, 0xea, 0xd0, 0x7d,
-	0xb8, 0xfd, 0x08, 0x47, 0xa2, 0xcd, 0xdc, 0x20, 0xf6, 0x88, 0xf7, 0x98, 0x08, 0xac, 0xf3, 0xd6,
-	0x50, 0x79, 0x2b, 0x57, 0xca, 0x19, 0x53, 0x0d, 0xd1, 0x6e, 0xa9, 0x19, 0x6b, 0x38, 0xa9, 0x28,
-	0x35, 0x67, 0xdf, 0x1d, 0xf1, 0x98, 0x09, 0x6b, 0x49, 0x6b, 0x12, 0xb1, 0xf9, 0xf7, 0x1c, 0xdc,
-	0x3a, 0x1e, 0x72, 0xb7, 0xdf, 0x15, 0x38, 0x14, 0x59, 0xdf, 0xde, 0xec, 0x6b, 0x03, 0xaa, 0xca,
-	0x40, 0x15, 0xb7, 0xe1, 0x68, 0x21, 0xeb, 0x85, 0xa5, 0x7c, 0x2f, 0x8c, 0xeb, 0xbd, 0x9c, 0xaf,
-	0xf7, 0xdb, 0x66, 0x62, 0x0b, 0x96, 0x1d, 0xce, 0x85, 0xd2, 0xce, 0xeb, 0x0e, 0x4a, 0x65, 0x99,
-	0x99, 0x13, 0x1a, 0x46, 0x22, 0xcd, 0x59, 0xba, 0xb6, 0x92, 0x26, 0x2f, 0x57, 0xa6, 0xf9, 0x3c,
-	0xa1, 0x8c, 0x46, 0x7d, 0x9d, 0x32, 0x6d, 0xa5, 0xbb, 0xbe, 0x5c, 0x89, 0xce, 0xe1, 0x4e, 0xb1,
-	0x34, 0xe9, 0x74, 0x2e, 0xbe, 0xc3, 0x74, 0xde, 0x64, 0xdc, 0x7c, 0xba, 0x08, 0xb5, 0x63, 0x97,
-	0x33, 0x3e, 0xa0, 0x6e, 0x24, 0x17, 0xd3, 0x19, 0x17, 0x38, 0xe8, 0xc6, 0xc3, 0x61, 0x70, 0xad,
-	0xb7, 0xe3, 0xcc, 0x16, 0x53, 0xce, 0x33, 0x8a, 0x60, 0x5d, 0x89, 0x67, 0xbc, 0x45, 0x23, 0x11,
-	0xd2, 0x5e, 0x2c, 0x88, 0xce, 0xfe, 0xac, 0xc2, 0x4d, 0xfb, 0x47, 0xdf, 0x82, 0xa9, 0xc0, 0x53,
-	0x72, 0x15, 0x5c, 0x3f, 0xa6, 0x4c, 0x10, 0x4f, 0xd7, 0x74, 0x56, 0x31, 0xa7, 0xdc, 0xcb, 0x75,
-	0xe2, 0x90, 0x2b, 0x1c, 0x7a, 0x51, 0x87, 0x84, 0xb9, 0xe6, 0x98, 0xd9, 0x3a, 0x29, 0x78, 0x47,
-	0x3f, 0x1b, 0xb0, 0x9d, 0x60, 0x27, 0x3c, 0xec, 0xc8, 0x96, 0x70, 0x79, 0xd0, 0x8d, 0x23, 0x81,
-	0x29, 0xc3, 0x3d, 0x1a, 0x50, 0x71, 0x3d, 0xdb, 0x07, 0xe7, 0xad, 0xe1, 0x90, 0x0b, 0xb5, 0x53,
-	0xee, 0x91, 0x4e, 0x48, 0xdd, 0x64, 0x73, 0xcf, 0x2a, 0x76, 0xe6, 0x17, 0x7d, 0x0c, 0xb7, 0xe4,
-	0x6a, 0xcf, 0xf6, 0x47, 0x7e, 0x05, 0x94, 0xa9, 0xd0, 0x2e, 0xa0, 0x49, 0x58, 0x0d, 0xf9, 0xb2,
-	0x9a, 0xc2, 0x12, 0x4d, 0xf3, 0x17, 0x03, 0x20, 0x83, 0xd0, 0x19, 0x6c, 0x24, 0xa3, 0x8a, 0x03,
-	0xfa, 0x3d, 0xf1, 0xd2, 0x71, 0x34, 0xd4, 0x38, 0x6e, 0x25, 0xe3, 0x58, 0xb2, 0xcf, 0x92, 0x91,
-	0x2c, 0xb5, 0x46, 0xf7, 0x73, 0xe3, 0xa8, 0x06, 0xa2, 0xbe, 0x6f, 0xa6, 0xae, 0x52, 0x3c, 0x71,
-	0x90, 0x1d, 0x6c, 0xbe, 0xac, 0x41, 0x2d, 0xdb, 0x15, 0xe3, 0x4d, 0x67, 0xe4, 0x37, 0xdd, 0x78,
-	0x57, 0xce, 0x95, 0xee, 0xca, 0xf9, 0xfc, 0xae, 0xfc, 0x6f, 0xfa, 0x72, 0x3f, 0x21, 0x15, 0x6d,
-	0xf6, 0x84, 0x5b, 0x55, 0x75, 0xdd, 0xf4, 0x37, 0x16, 0x2f, 0x99, 0x1d, 0x44, 0x9f, 0x68, 0x06,
-	0xa6, 0x8c, 0xf4, 0xca, 0x5a, 0xcb, 0xf1, 0xa7, 0x9c, 0xcd, 0xf8, 0xd8, 0xe4, 0x93, 0xbf, 0x54,
-	0x7c, 0xf2, 0x77, 0x60, 0xed, 0x91, 0xca, 0x5a, 0x76, 0x46, 0x17, 0xaf, 0x08, 0x4f, 0x13, 0x8c,
-	0x5a, 0x19, 0xc1, 0xc8, 0x93, 0x05, 0x28, 0x90, 0x85, 0x22, 0x8d, 0xa9, 0x97, 0xd0, 0x18, 0xf9,
-	0x54, 0xa4, 0xfa, 0x95, 0xe4, 0xa9, 0xc8, 0xeb, 0xd2, 0x67, 0xa4, 0x51, 0x78, 0x46, 0x3e, 0x83,
-	0xcd, 0x73, 0x1c, 0x50, 0x0f, 0x0b, 0x1e, 0x76, 0x05, 0x16, 0xd1, 0xf8, 0xa4, 0xa2, 0x02, 0xce,
-	0x0d, 0x5a, 0xf4, 0x00, 0xcc, 0xa9, 0xb7, 0xc0, 0x7c, 0x87, 0xb7, 0xc0, 0x2c, 0x23, 0x69, 0x0e,
-	0x71, 0x09, 0x1d, 0x8a, 0x48, 0xc5, 0x5d, 0xd7, 0xb7, 0xcb, 0x63, 0xe8, 0xf3, 0x7c, 0xf3, 0x5b,
-	0x48, 0x75, 0xe6, 0xfa, 0x54, 0x93, 0x27, 0x21, 0xf2, 0x73, 0x62, 0xc1, 0xd2, 0x51, 0x1f, 0x53,
-	0xd6, 0x6e, 0x59, 0xb7, 0x34, 0xdb, 0x4e, 0x44, 0x59, 0xc0, 0x2e, 0x7f, 0x22, 0xae, 0x70, 0x48,
-	0xce, 0x49, 0x18, 0x49, 0x62, 0xbd, 0xa1, 0x0b, 0x58, 0x80, 0xcb, 0x58, 0xd9, 0xed, 0xf7, 0xca,
-	0xca, 0x7e, 0x80, 0xcd, 0x02, 0xd4, 0x66, 0x7a, 0x7a, 0x36, 0x67, 0x19, 0xf7, 0x86, 0x20, 0xd3,
-	0xa4, 0xf0, 0xce, 0x7b, 0x24, 0x85, 0x03, 0x58, 0x6d, 0x91, 0xcb, 0xfc, 0x1d, 0xad, 0x59, 0x46,
-	0x2b, 0x38, 0xcf, 0xf3, 0xbf, 0xff, 0x4d, 0xf0, 0x3f, 0x35, 0x24, 0x24, 0x22, 0xe1, 0x25, 0xf1,
-	0xac, 0xad, 0x64, 0x48, 0x12, 0xf9, 0xa3, 0x5f, 0x0d, 0x80, 0xec, 0x3b, 0x0b, 0xad, 0x43, 0xa3,
-	0xcd, 0x2e, 0xe5, 0x5c, 0x68, 0xc0, 0xac, 0xa0, 0x0d, 0x30, 0xe5, 0x01, 0x87, 0xf8, 0xf2, 0xc5,
-	0xc7, 0x0a, 0x35, 0xe4, 0x41, 0x89, 0x7e, 0xcd, 0x22, 0x81, 0x2f, 0x28, 0xf3, 0xcd, 0x39, 0xb4,
-	0x09, 0x48, 0x6d, 0x1c, 0x12, 0xe6, 0x8f, 0xce, 0xa3, 0x55, 0x1d, 0xe1, 0x2b, 0x4c, 0x03, 0xe2,
-	0x99, 0x0b, 0xc8, 0x84, 0x15, 0x6d, 0x9a, 0x20, 0x55, 0xb4, 0x06, 0x75, 0x89, 0x74, 0x03, 0x2c,
-	0xc9, 0x99, 0xb9, 0x98, 0x02, 0x8e, 0x5c, 0x8c, 0x17, 0xc4, 0x5c, 0x3a, 0xfc, 0xf2, 0xf9, 0x2b,
-	0xbb, 0xf2, 0xe2, 0x95, 0x5d, 0x79, 0xf3, 0xca, 0x36, 0x7e, 0x1c, 0xd9, 0xc6, 0xd3, 0x91, 0x6d,
-	0x3c, 0x1b, 0xd9, 0xc6, 0xf3, 0x91, 0x6d, 0xbc, 0x18, 0xd9, 0xc6, 0x9f, 0x23, 0xdb, 0xf8, 0x6b,
-	0x64, 0x57, 0xde, 0x8c, 0x6c, 0xe3, 0xa7, 0xd7, 0x76, 0xe5, 0xf9, 0x6b, 0xbb, 0xf2, 0xe2, 0xb5,
-	0x5d, 0xf9, 0xa6, 0xaa, 0x3e, 0x57, 0x7b, 0x8b, 0x6a, 0xa2, 0x3e, 0xfd, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0xd0, 0xf8, 0x08, 0x32, 0x05, 0x0f, 0x00, 0x00,
+	// 1259 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x13, 0x17, 0x2d, 0xcb, 0xb6, 0x46, 0x96, 0x2d, 0x6f, 0x1c, 0x87, 0x7f, 0xe3, 0x0f, 0x46, 0x10,
+	0x7a, 0x70, 0x0b, 0xc4, 0x6e, 0xdd, 0xa0, 0x3d, 0xf4, 0x50, 0xf8, 0x13, 0x51, 0x93, 0x18, 0x02,
+	0xe5, 0xfa, 0xd0, 0xdb, 0x8a, 0x9c, 0x48, 0x0b, 0x53, 0xbb, 0x2a, 0xb9, 0xb4, 0xeb, 0x02, 0x05,
+	0xfa, 0x08, 0xe9, 0xad, 0x0f, 0xd0, 0x43, 0xd0, 0xbe, 0x48, 0x8e, 0x39, 0xe6, 0xd4, 0x34, 0xca,
+	0xa5, 0xc7, 0xf4, 0x0d, 0x8a, 0x5d, 0x92, 0x22, 0x4d, 0xd1, 0x4d, 0x0e, 0xca, 0xc9, 0x9e, 0xdf,
+	0xec, 0xce, 0x68, 0xe7, 0xf3, 0x47, 0x58, 0x1d, 0xa2, 0xa4, 0xfb, 0x9e, 0x70, 0xce, 0xb7, 0x47,
+	0xbe, 0x90, 0x82, 0x54, 0xf4, 0x9f, 0xcd, 0x7b, 0x7d, 0x26, 0x07, 0x61, 0x6f, 0xdb, 0x11, 0xc3,
+	0x9d, 0xbe, 0xe8, 0x8b, 0x1d, 0x0d, 0xf7, 0xc2, 0x27, 0x5a, 0xd2, 0x82, 0xfe, 0x2f, 0xba, 0xb5,
+	0x59, 0xeb, 0xa5, 0x26, 0x5a, 0x4f, 0xe7, 0x60, 0xa9, 0x83, 0xe8, 0x1f, 0x52, 0x49, 0x89, 0x09,
+	0x8b, 0x7b, 0xae, 0xeb, 0x63, 0x10, 0x98, 0x46, 0xd3, 0xd8, 0x5a, 0xb6, 0x13, 0x91, 0xfc, 0x1f,
+	0xaa, 0x9d, 0xb0, 0xe7, 0x31, 0xe7, 0x21, 0x5e, 0x99, 0x73, 0x5a, 0x97, 0x02, 0xe4, 0x63, 0x58,
+	0xd8, 0x73, 0x24, 0x13, 0xdc, 0x2c, 0x37, 0x8d, 0xad, 0x95, 0xdd, 0xb5, 0xc8, 0xf8, 0xb6, 0x32,
+	0x1c, 0x29, 0xec, 0xf8, 0x80, 0x32, 0x74, 0xca, 0x86, 0xd8, 0x95, 0x74, 0x38, 0x32, 0xe7, 0x9b,
+	0xc6, 0xd6, 0xbc, 0x9d, 0x02, 0xa4, 0x0f, 0xb5, 0x33, 0xea, 0x85, 0x78, 0x30, 0xa0, 0xbc, 0x8f,
+	0x66, 0x45, 0x39, 0xda, 0x3f, 0xfa, 0xfd, 0xd5, 0xdd, 0xbd, 0x21, 0x95, 0x83, 0x9d, 0x1e, 0xeb,
+	0x6f, 0xb7, 0xb9, 0xfc, 0x2a, 0xf3, 0xde, 0x23, 0xcf, 0x17, 0xdc, 0x3d, 0x41, 0x79, 0x29, 0xfc,
+	0xf3, 0x1d, 0xd4, 0xd2, 0xbd, 0xbe, 0xd8, 0x71, 0xa9, 0xa4, 0xdb, 0xfb, 0xac, 0xdf, 0xe6, 0xf2,
+	0x80, 0x06, 0x12, 0x7d, 0x3b, 0x6b, 0x99, 0x6c, 0xc2, 0xd2, 0xd1, 0x05, 0x73, 0x91, 0x3b, 0x68,
+	0x2e, 0xe8, 0xe7, 0x4c, 0xe4, 0xd6, 0x1f, 0x15, 0xa8, 0x76, 0x07, 0xd4, 0x77, 0x75, 0x4c, 0x2c,
+	0x80, 0x07, 0x48, 0x5d, 0xf4, 0x1f, 0xd0, 0x60, 0x10, 0x3f, 0x3d, 0x83, 0x10, 0x1b, 0x6e, 0xeb,
+	0xc3, 0x8f, 0x19, 0x67, 0x3a, 0x37, 0x91, 0x2e, 0x30, 0xcb, 0xcd, 0xf2, 0x56, 0x6d, 0x77, 0x23,
+	0x0e, 0x45, 0x4e, 0xbd, 0x3f, 0xff, 0xfc, 0xcf, 0xbb, 0x25, 0xbb, 0xf8, 0x2a, 0x69, 0xc1, 0x72,
+	0xc7, 0xc7, 0x0b, 0x9b, 0x72, 0xb7, 0x8b, 0xe8, 0xea, 0x38, 0x2d, 0xdb, 0xd7, 0x30, 0xf2, 0x11,
+	0xd4, 0x3b, 0x61, 0xef, 0x21, 0x5e, 0x05, 0xfb, 0x4c, 0x0e, 0xe9, 0x28, 0x0a, 0x96, 0x7d, 0x1d,
+	0x54, 0xe1, 0xee, 0xb2, 0x3e, 0xa7, 0x32, 0xf4, 0x93, 0x87, 0xa6, 0x00, 0x59, 0x87, 0x8a, 0x2d,
+	0x42, 0xee, 0x9a, 0x4b, 0x3a, 0x11, 0x91, 0xa0, 0x62, 0xa3, 0x3c, 0xe9, 0xf7, 0x56, 0xa3, 0xd8,
+	0x24, 0xb2, 0xba, 0x71, 0x22, 0x54, 0xd0, 0x20, 0xba, 0xa1, 0x05, 0x22, 0x60, 0x75, 0xcf, 0x71,
+	0xc2, 0x61, 0xe8, 0x51, 0x89, 0xee, 0x31, 0x62, 0x60, 0x2e, 0xcf, 0x32, 0x75, 0x79, 0xeb, 0xe4,
+	0x1c, 0xea, 0x87, 0x78, 0x81, 0x9e, 0x18, 0xa1, 0xaf, 0xdd, 0xad, 0xcc, 0xd2, 0xdd, 0x75, 0xdb,
+	0x64, 0x17, 0xd6, 0x4f, 0xc2, 0x61, 0x07, 0xb9, 0xcb, 0x78, 0x7f, 0x92, 0xab, 0xc0, 0xac, 0x35,
+	0x8d, 0xad, 0xba, 0x5d, 0xa8, 0x23, 0xf7, 0xe1, 0xf6, 0x23, 0x1a, 0xc8, 0x36, 0x77, 0xbc, 0xd0,
+	0x45, 0xf7, 0x31, 0x4a, 0x1a, 0xc5, 0xad, 0xae, 0xe3, 0x56, 0xac, 0x54, 0xfd, 0xa7, 0x0b, 0xa2,
+	0x7d, 0xa8, 0xfb, 0xaf, 0x6e, 0x27, 0xa2, 0xd2, 0x9c, 0xfe, 0x70, 0x20, 0x42, 0x2e, 0xcd, 0xc5,
+	0x48, 0x13, 0x8b, 0xad, 0x7f, 0xe6, 0xe0, 0xd6, 0xd1, 0x48, 0x38, 0x83, 0xae, 0xa4, 0xbe, 0x4c,
+	0xeb, 0xf6, 0x66, 0x5b, 0xeb, 0x50, 0xd1, 0x17, 0x74, 0x72, 0xeb, 0x76, 0x24, 0xa4, 0xb5, 0xb0,
+	0x98, 0xad, 0x85, 0x49, 0xbe, 0x97, 0xb2, 0xf9, 0x7e, 0x57, 0x4f, 0x6c, 0xc2, 0x92, 0x2d, 0x84,
+	0xd4, 0xda, 0x72, 0x54, 0x41, 0x89, 0xac, 0x22, 0x73, 0xcc, 0xfc, 0x40, 0x26, 0x31, 0x4b, 0x46,
+	0x5a, 0x5c, 0xe4, 0xc5, 0xca, 0x24, 0x9e, 0xc7, 0x8c, 0xb3, 0x60, 0x80, 0xee, 0x44, 0x11, 0x57,
+	0x7d, 0xb1, 0x92, 0x9c, 0xc1, 0x9d, 0x7c, 0x6a, 0x92, 0xee, 0x5c, 0x78, 0x8f, 0xee, 0xbc, 0xe9,
+	0x72, 0xeb, 0xd9, 0x02, 0x54, 0x8f, 0x1c, 0xc1, 0xc5, 0x90, 0x39, 0x81, 0x1a, 0x5a, 0xa7, 0x42,
+	0x52, 0xaf, 0x1b, 0x8e, 0x46, 0xde, 0x95, 0x69, 0xcc, 0xb2, 0x14, 0xb3, 0x96, 0x49, 0x00, 0x6b,
+	0x5a, 0x3c, 0x15, 0x87, 0x2c, 0x90, 0x3e, 0xeb, 0x85, 0x12, 0xcd, 0xb9, 0x59, 0xba, 0x9b, 0xb6,
+	0x4f, 0xbe, 0x87, 0x86, 0x06, 0x4f, 0xf0, 0xd2, 0xbb, 0x7a, 0xcc, 0xb8, 0x44, 0xd7, 0x2c, 0xcf,
+	0xd2, 0xe7, 0x94, 0x79, 0x35, 0x4e, 0x6c, 0xbc, 0xa4, 0xbe, 0x1b, 0x74, 0xd0, 0xcf, 0x14, 0xc7,
+	0xcc, 0xc6, 0x49, 0xce, 0x3a, 0xf9, 0xc5, 0x80, 0x66, 0x8c, 0x1d, 0x0b, 0xbf, 0xa3, 0x4a, 0xc2,
+	0x11, 0x5e, 0x37, 0x0c, 0x24, 0x65, 0x9c, 0xf6, 0x98, 0xc7, 0xe4, 0xd5, 0x6c, 0x97, 0xd1, 0x3b,
+	0xdd, 0x11, 0x07, 0xaa, 0x27, 0xc2, 0xc5, 0x8e, 0xcf, 0x92, 0x15, 0x35, 0x2b, 0xdf, 0xa9, 0x5d,
+	0xf2, 0x29, 0xdc, 0x52, 0xa3, 0x3d, 0x9d, 0x1f, 0xd9, 0x11, 0x50, 0xa4, 0x22, 0xdb, 0x40, 0xae,
+	0xc3, 0xba, 0xc9, 0x97, 0x74, 0x17, 0x16, 0x68, 0x5a, 0xbf, 0x1a, 0x00, 0x29, 0x44, 0x4e, 0x61,
+	0x3d, 0x6e, 0x55, 0xea, 0xb1, 0x1f, 0xd1, 0x4d, 0xda, 0xd1, 0xd0, 0xed, 0xb8, 0x19, 0xb7, 0x63,
+	0xc1, 0x3c, 0x8b, 0x5b, 0xb2, 0xf0, 0x36, 0xb9, 0x9f, 0x69, 0x47, 0xdd, 0x10, 0xb5, 0xdd, 0x46,
+	0x62, 0x2a, 0xc1, 0x63, 0x03, 0xe9, 0xc1, 0xd6, 0xab, 0x2a, 0x54, 0xd3, 0x59, 0x31, 0x99, 0x74,
+	0x46, 0x76, 0xd2, 0x4d, 0x66, 0xe5, 0x5c, 0xe1, 0xac, 0x2c, 0x67, 0x67, 0xe5, 0x7f, 0x53, 0x9b,
+	0xfb, 0x31, 0xa9, 0x68, 0xf3, 0x27, 0xc2, 0xac, 0x34, 0xcb, 0x99, 0xdf, 0x98, 0x7f, 0x64, 0x7a,
+	0x90, 0x7c, 0x16, 0xb1, 0x33, 0x7d, 0x29, 0x1a, 0x59, 0xab, 0x19, 0x6e, 0x95, 0xb9, 0x33, 0x39,
+	0x76, 0x7d, 0xe5, 0x2f, 0xe6, 0x57, 0xfe, 0x16, 0xac, 0x3e, 0xd2, 0x51, 0x4b, 0xcf, 0x44, 0xc9,
+	0xcb, 0xc3, 0xd3, 0x04, 0xa3, 0x5a, 0x44, 0x30, 0xb2, 0x64, 0x01, 0x72, 0x64, 0x21, 0x4f, 0x63,
+	0x6a, 0x05, 0x34, 0x46, 0xad, 0x8a, 0x44, 0xbf, 0x1c, 0xaf, 0x8a, 0xac, 0x2e, 0x59, 0x23, 0xf5,
+	0xdc, 0x1a, 0xf9, 0x02, 0x36, 0xce, 0xa8, 0xc7, 0x5c, 0x2a, 0x85, 0xdf, 0x95, 0x54, 0x06, 0x93,
+	0x93, 0x9a, 0x0a, 0xd8, 0x37, 0x68, 0xc9, 0x03, 0x68, 0x4c, 0xed, 0x82, 0xc6, 0x7b, 0xec, 0x82,
+	0x46, 0x11, 0x49, 0xb3, 0xd1, 0x41, 0x36, 0x92, 0x81, 0xf6, 0xbb, 0x16, 0xbd, 0x2e, 0x8b, 0x91,
+	0x2f, 0xb3, 0xc5, 0x6f, 0x12, 0x5d, 0x99, 0x6b, 0x53, 0x45, 0x1e, 0xbb, 0xc8, 0xf6, 0x89, 0x09,
+	0x8b, 0x07, 0x03, 0xca, 0x78, 0xfb, 0xd0, 0xbc, 0x15, 0x31, 0xf1, 0x58, 0x54, 0x09, 0xec, 0x8a,
+	0x27, 0xf2, 0x92, 0xfa, 0x78, 0x86, 0x7e, 0xa0, 0x48, 0xf7, 0x7a, 0x94, 0xc0, 0x1c, 0x5c, 0xc4,
+	0xca, 0x6e, 0x7f, 0x50, 0x56, 0xf6, 0x13, 0x6c, 0xe4, 0xa0, 0x36, 0x8f, 0xba, 0x67, 0x63, 0x96,
+	0x7e, 0x6f, 0x70, 0x32, 0x4d, 0x0a, 0xef, 0x7c, 0x40, 0x52, 0x38, 0x84, 0x95, 0x43, 0xbc, 0xc8,
+	0xbe, 0xd1, 0x9c, 0xa5, 0xb7, 0x9c, 0xf1, 0x2c, 0xff, 0xfb, 0xdf, 0x35, 0xfe, 0xa7, 0x9b, 0x04,
+	0x03, 0xf4, 0x2f, 0xd0, 0x35, 0x37, 0xe3, 0x26, 0x89, 0xe5, 0x4f, 0x7e, 0x33, 0x00, 0xd2, 0x6f,
+	0x30, 0xb2, 0x06, 0xf5, 0x36, 0xbf, 0x50, 0x7d, 0x11, 0x01, 0x8d, 0x12, 0x59, 0x87, 0x86, 0x3a,
+	0x60, 0x63, 0x5f, 0x6d, 0x7c, 0xaa, 0x51, 0x43, 0x1d, 0x54, 0xe8, 0xb7, 0x3c, 0x90, 0xf4, 0x9c,
+	0xf1, 0x7e, 0x63, 0x8e, 0x6c, 0x00, 0xd1, 0x13, 0x07, 0xfd, 0xec, 0xd1, 0x32, 0x59, 0x89, 0x3c,
+	0x7c, 0x43, 0x99, 0x87, 0x6e, 0x63, 0x9e, 0x34, 0x60, 0x39, 0xba, 0x1a, 0x23, 0x15, 0xb2, 0x0a,
+	0x35, 0x85, 0x74, 0x3d, 0xaa, 0xc8, 0x59, 0x63, 0x21, 0x01, 0x6c, 0x35, 0x18, 0xcf, 0xb1, 0xb1,
+	0xb8, 0xff, 0xf5, 0x8b, 0xd7, 0x56, 0xe9, 0xe5, 0x6b, 0xab, 0xf4, 0xf6, 0xb5, 0x65, 0xfc, 0x3c,
+	0xb6, 0x8c, 0x67, 0x63, 0xcb, 0x78, 0x3e, 0xb6, 0x8c, 0x17, 0x63, 0xcb, 0x78, 0x39, 0xb6, 0x8c,
+	0xbf, 0xc6, 0x96, 0xf1, 0xf7, 0xd8, 0x2a, 0xbd, 0x1d, 0x5b, 0xc6, 0xd3, 0x37, 0x56, 0xe9, 0xc5,
+	0x1b, 0xab, 0xf4, 0xf2, 0x8d, 0x55, 0xfa, 0xae, 0xa2, 0x3f, 0x65, 0x7b, 0x0b, 0xba, 0xa3, 0x3e,
+	0xff, 0x77, 0x00, 0x4e, 0x8c, 0x81, 0x34, 0x21, 0x0f, 0x00, 0x00,
 }
 
 func (x PeerAction) String() string {
@@ -906,6 +915,9 @@ func (this *PeerData) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !bytes.Equal(this.Evidence, that1.Evidence) {
+		return false
+	}
 	return true
 }
 func (this *ShardData) Equal(that interface{}) bool {
@@ -1257,13 +1269,14 @@ func (this *PeerData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 10)
 	s = append(s, "&block.PeerData{")
 	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
 	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
 	s = append(s, "Action: "+fmt.Sprintf("%#v", this.Action)+",\n")
 	s = append(s, "TimeStamp: "+fmt.Sprintf("%#v", this.TimeStamp)+",\n")
 	s = append(s, "ValueChange: "+fmt.Sprintf("%#v", this.ValueChange)+",\n")
+	s = append(s, "Evidence: "+fmt.Sprintf("%#v", this.Evidence)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1434,6 +1447,13 @@ func (m *PeerData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintMetaBlock(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x32
+	}
 	{
 		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
 		size := __caster.Size(m.ValueChange)
@@ -2115,6 +2135,10 @@ func (m *PeerData) Size() (n int) {
 		l = __caster.Size(m.ValueChange)
 		n += 1 + l + sovMetaBlock(uint64(l))
 	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovMetaBlock(uint64(l))
+	}
 	return n
 }
 
@@ -2415,6 +2439,7 @@ func (this *PeerData) String() string {
 		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
 		`TimeStamp:` + fmt.Sprintf("%v", this.TimeStamp) + `,`,
 		`ValueChange:` + fmt.Sprintf("%v", this.ValueChange) + `,`,
+		`Evidence:` + fmt.Sprintf("%v", this.Evidence) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2733,6 +2758,40 @@ func (m *PeerData) Unmarshal(dAtA []byte) error {
 				}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetaBlock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetaBlock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = append(m.Evidence[:0], dAtA[iNdEx:postIndex]...)
+			if m.Evidence == nil {
+				m.Evidence = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetaBlock(dAtA[iNdEx:])
@@ -192,6 +192,14 @@ type DBRemoveCacher interface {
 	IsInterfaceNil() bool
 }
 
+// ReferenceCounter keeps persistent reference counts of the trie nodes and removes the nodes that are no longer referenced
+type ReferenceCounter interface {
+	Put([]byte, ModifiedHashes) error
+	Prune([]byte) error
+	CancelPrune([]byte) error
+	IsInterfaceNil() bool
+}
+
 // TrieSyncer synchronizes the trie, asking on the network for the missing nodes
 type TrieSyncer interface {
 	StartSyncing(rootHash []byte, ctx context.Context) error
@@ -73,6 +73,12 @@ var ErrNilTrieStorage = errors.New("nil trie storage provided")
 // ErrNilEvictionWaitingList is raised when a nil eviction waiting list is provided
 var ErrNilEvictionWaitingList = errors.New("nil eviction waiting list provided")
 
+// ErrInvalidPruningStrategy is raised when the configured pruning strategy is not supported
+var ErrInvalidPruningStrategy = errors.New("invalid pruning strategy")
+
+// ErrNilReferenceCounter is raised when a nil reference counter is provided
+var ErrNilReferenceCounter = errors.New("nil reference counter provided")
+
 // ErrNilPathManager signals that a nil path manager has been provided
 var ErrNilPathManager = errors.New("nil path manager")
 
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/trie"
 	"github.com/ElrondNetwork/elrond-go/data/trie/evictionWaitingList"
+	"github.com/ElrondNetwork/elrond-go/data/trie/referenceCounter"
 	"github.com/ElrondNetwork/elrond-go/hashing"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -41,6 +42,9 @@ func NewTrieFactory(
 	if check.IfNil(args.PathManager) {
 		return nil, trie.ErrNilPathManager
 	}
+	if !isPruningStrategySupported(args.TrieStorageManagerConfig.PruningStrategy) {
+		return nil, trie.ErrInvalidPruningStrategy
+	}
 
 	return &trieCreator{
 		evictionWaitingListCfg:   args.EvictionWaitingListCfg,
@@ -87,6 +91,37 @@ func (tc *trieCreator) Create(
 		return trieStorage, newTrie, nil
 	}
 
+	snapshotDbCfg := config.DBConfig{
+		FilePath:          filepath.Join(trieStoragePath, tc.snapshotDbCfg.FilePath),
+		Type:              tc.snapshotDbCfg.Type,
+		BatchDelaySeconds: tc.snapshotDbCfg.BatchDelaySeconds,
+		MaxBatchSize:      tc.snapshotDbCfg.MaxBatchSize,
+		MaxOpenFiles:      tc.snapshotDbCfg.MaxOpenFiles,
+	}
+
+	var trieStorage data.StorageManager
+	if tc.trieStorageManagerConfig.PruningStrategy == ReferenceCountPruning {
+		trieStorage, err = tc.createStorageManagerWithReferenceCounting(accountsTrieStorage, trieStoragePath, snapshotDbCfg)
+	} else {
+		trieStorage, err = tc.createStorageManagerWithEvictionWaitingList(accountsTrieStorage, trieStoragePath, snapshotDbCfg)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newTrie, err := trie.NewTrie(trieStorage, tc.marshalizer, tc.hasher, maxTrieLevelInMem)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return trieStorage, newTrie, nil
+}
+
+func (tc *trieCreator) createStorageManagerWithEvictionWaitingList(
+	trieDb data.DBWriteCacher,
+	trieStoragePath string,
+	snapshotDbCfg config.DBConfig,
+) (data.StorageManager, error) {
 	arg := storageUnit.ArgDB{
 		DBType:            storageUnit.DBType(tc.evictionWaitingListCfg.DB.Type),
 		Path:              filepath.Join(trieStoragePath, tc.evictionWaitingListCfg.DB.FilePath),
@@ -96,40 +131,64 @@ func (tc *trieCreator) Create(
 	}
 	evictionDb, err := storageUnit.NewDB(arg)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	ewl, err := evictionWaitingList.NewEvictionWaitingList(tc.evictionWaitingListCfg.Size, evictionDb, tc.marshalizer)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	snapshotDbCfg := config.DBConfig{
-		FilePath:          filepath.Join(trieStoragePath, tc.snapshotDbCfg.FilePath),
-		Type:              tc.snapshotDbCfg.Type,
-		BatchDelaySeconds: tc.snapshotDbCfg.BatchDelaySeconds,
-		MaxBatchSize:      tc.snapshotDbCfg.MaxBatchSize,
-		MaxOpenFiles:      tc.snapshotDbCfg.MaxOpenFiles,
-	}
-
-	trieStorage, err := trie.NewTrieStorageManager(
-		accountsTrieStorage,
+	return trie.NewTrieStorageManager(
+		trieDb,
 		tc.marshalizer,
 		tc.hasher,
 		snapshotDbCfg,
 		ewl,
 		tc.trieStorageManagerConfig,
 	)
+}
+
+func (tc *trieCreator) createStorageManagerWithReferenceCounting(
+	trieDb data.DBWriteCacher,
+	trieStoragePath string,
+	snapshotDbCfg config.DBConfig,
+) (data.StorageManager, error) {
+	refCountDbCfg := tc.trieStorageManagerConfig.ReferenceCountDB
+	arg := storageUnit.ArgDB{
+		DBType:            storageUnit.DBType(refCountDbCfg.Type),
+		Path:              filepath.Join(trieStoragePath, refCountDbCfg.FilePath),
+		BatchDelaySeconds: refCountDbCfg.BatchDelaySeconds,
+		MaxBatchSize:      refCountDbCfg.MaxBatchSize,
+		MaxOpenFiles:      refCountDbCfg.MaxOpenFiles,
+	}
+	refCountDb, err := storageUnit.NewDB(arg)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	newTrie, err := trie.NewTrie(trieStorage, tc.marshalizer, tc.hasher, maxTrieLevelInMem)
+	refCounter, err := referenceCounter.NewReferenceCounter(trieDb, refCountDb, tc.marshalizer)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	return trieStorage, newTrie, nil
+	return trie.NewTrieStorageManagerWithReferenceCounting(
+		trieDb,
+		tc.marshalizer,
+		tc.hasher,
+		snapshotDbCfg,
+		refCounter,
+		tc.trieStorageManagerConfig,
+	)
+}
+
+func isPruningStrategySupported(strategy string) bool {
+	switch strategy {
+	case "", EvictionWaitingListPruning, ReferenceCountPruning:
+		return true
+	default:
+		return false
+	}
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -144,3 +144,31 @@ func TestTrieFactory_CreateWithPRunningShouldWork(t *testing.T) {
 	require.NotNil(t, tr)
 	require.Nil(t, err)
 }
+
+func TestNewTrieFactory_InvalidPruningStrategyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgs()
+	args.TrieStorageManagerConfig.PruningStrategy = "invalid"
+	tf, err := NewTrieFactory(args)
+
+	assert.Nil(t, tf)
+	assert.Equal(t, trie.ErrInvalidPruningStrategy, err)
+}
+
+func TestTrieFactory_CreateWithReferenceCountPruningShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := getArgs()
+	args.TrieStorageManagerConfig = config.TrieStorageManagerConfig{
+		PruningStrategy:  ReferenceCountPruning,
+		ReferenceCountDB: config.DBConfig{Type: string(storageUnit.MemoryDB)},
+	}
+	tf, _ := NewTrieFactory(args)
+	trieStorageCfg := createTrieStorageCfg()
+
+	maxTrieLevelInMemory := uint(5)
+	_, tr, err := tf.Create(trieStorageCfg, "0", true, maxTrieLevelInMemory)
+	require.NotNil(t, tr)
+	require.Nil(t, err)
+}
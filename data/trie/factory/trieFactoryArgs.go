@@ -13,6 +13,12 @@ const UserAccountTrie = "userAccount"
 // PeerAccountTrie represents the peer account identifier
 const PeerAccountTrie = "peerAccount"
 
+// EvictionWaitingListPruning is the pruning strategy that uses the eviction waiting list
+const EvictionWaitingListPruning = "EvictionWaitingList"
+
+// ReferenceCountPruning is the pruning strategy that uses persistent reference counts of the trie nodes
+const ReferenceCountPruning = "ReferenceCount"
+
 // TrieFactoryArgs holds arguments for creating a trie factory
 type TrieFactoryArgs struct {
 	EvictionWaitingListCfg   config.EvictionWaitingListConfig
@@ -0,0 +1,15 @@
+package referenceCounter
+
+import "errors"
+
+// ErrInvalidKey is raised when the given key is invalid
+var ErrInvalidKey = errors.New("invalid key")
+
+// ErrNilTrieDatabase is raised when a nil trie database is provided
+var ErrNilTrieDatabase = errors.New("nil trie database")
+
+// ErrInvalidCounter is raised when a stored reference counter can not be decoded
+var ErrInvalidCounter = errors.New("invalid reference counter")
+
+// ErrInvalidOperation is raised when a stored operation can not be applied
+var ErrInvalidOperation = errors.New("invalid reference counter operation")
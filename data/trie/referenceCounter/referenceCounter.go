@@ -0,0 +1,350 @@
+package referenceCounter
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var log = logger.GetOrCreate("trie")
+
+type operationType byte
+
+const (
+	storeEntry  operationType = 0
+	removeEntry operationType = 1
+)
+
+const counterPrefix = "c"
+const entryPrefix = "e"
+const counterLen = 8
+
+// pendingOperationKey is the key under which the operation that is currently applied is saved. If the node
+// crashes in the middle of an operation, the operation is applied again at startup.
+var pendingOperationKey = []byte("pendingOperation")
+
+// referenceCounter keeps, for each trie node, the number of committed roots that still need it. The counters
+// are kept in a separate database and they are changed only through operations that are first saved in the
+// same database, so that an operation interrupted by a crash can be completed at the next startup.
+//
+// A marked new root increments the counters of its new nodes. Pruning a root decrements the counters of the
+// nodes saved for it and removes from the trie database the nodes that are no longer referenced. Cancelling
+// the pruning only removes the saved entry, leaving the counters unchanged.
+type referenceCounter struct {
+	trieDb      data.DBWriteCacher
+	db          storage.Persister
+	marshalizer marshal.Marshalizer
+	opMutex     sync.Mutex
+}
+
+// NewReferenceCounter creates a new instance of referenceCounter
+func NewReferenceCounter(
+	trieDb data.DBWriteCacher,
+	db storage.Persister,
+	marshalizer marshal.Marshalizer,
+) (*referenceCounter, error) {
+	if check.IfNil(trieDb) {
+		return nil, ErrNilTrieDatabase
+	}
+	if check.IfNil(db) {
+		return nil, data.ErrNilDatabase
+	}
+	if check.IfNil(marshalizer) {
+		return nil, data.ErrNilMarshalizer
+	}
+
+	rc := &referenceCounter{
+		trieDb:      trieDb,
+		db:          db,
+		marshalizer: marshalizer,
+	}
+
+	err := rc.resumePendingOperation()
+	if err != nil {
+		return nil, err
+	}
+
+	return rc, nil
+}
+
+// Put saves the given hashes at the position given by the root hash. If the root is a new root, the reference
+// counters of the hashes are incremented.
+func (rc *referenceCounter) Put(rootHash []byte, hashes data.ModifiedHashes) error {
+	rc.opMutex.Lock()
+	defer rc.opMutex.Unlock()
+
+	identifier, err := getIdentifier(rootHash)
+	if err != nil {
+		return err
+	}
+
+	if identifier != data.NewRoot {
+		entryHashes := make([][]byte, 0, len(hashes))
+		for hash := range hashes {
+			entryHashes = append(entryHashes, []byte(hash))
+		}
+
+		return rc.updateEntry(storeEntry, rootHash, entryHashes)
+	}
+
+	existingHashes, err := rc.getEntry(rootHash)
+	if err != nil {
+		return err
+	}
+	alreadyCounted := make(map[string]struct{}, len(existingHashes))
+	for _, hash := range existingHashes {
+		alreadyCounted[string(hash)] = struct{}{}
+	}
+
+	counters := make(map[string]uint64, len(hashes)+len(existingHashes))
+	for hash := range alreadyCounted {
+		counters[hash], err = rc.getCounter(hash)
+		if err != nil {
+			return err
+		}
+	}
+	for hash := range hashes {
+		_, ok := alreadyCounted[hash]
+		if ok {
+			continue
+		}
+
+		var counter uint64
+		counter, err = rc.getCounter(hash)
+		if err != nil {
+			return err
+		}
+
+		counters[hash] = counter + 1
+	}
+
+	return rc.executeOperation(storeEntry, rootHash, counters)
+}
+
+// Prune decrements the counters of the hashes saved at the position given by the root hash. The nodes
+// that are no longer referenced are removed from the trie database.
+func (rc *referenceCounter) Prune(rootHash []byte) error {
+	rc.opMutex.Lock()
+	defer rc.opMutex.Unlock()
+
+	hashes, err := rc.getEntry(rootHash)
+	if err != nil {
+		return err
+	}
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	counters := make(map[string]uint64, len(hashes))
+	for _, hash := range hashes {
+		var counter uint64
+		counter, err = rc.getCounter(string(hash))
+		if err != nil {
+			return err
+		}
+
+		// nodes that were not counted (for example, the nodes of a synced trie) are considered to be referenced once
+		if counter > 0 {
+			counter--
+		}
+		counters[string(hash)] = counter
+	}
+
+	return rc.executeOperation(removeEntry, rootHash, counters)
+}
+
+// CancelPrune removes the entry saved at the position given by the root hash, without changing any counter
+func (rc *referenceCounter) CancelPrune(rootHash []byte) error {
+	rc.opMutex.Lock()
+	defer rc.opMutex.Unlock()
+
+	err := rc.db.Remove(append([]byte(entryPrefix), rootHash...))
+	if err != nil {
+		return err
+	}
+
+	log.Trace("reference counter cancel prune", "root", rootHash)
+
+	return nil
+}
+
+func (rc *referenceCounter) executeOperation(opType operationType, rootHash []byte, counters map[string]uint64) error {
+	b := &batch.Batch{
+		Data: make([][]byte, 0, len(counters)+2),
+	}
+	b.Data = append(b.Data, []byte{byte(opType)}, rootHash)
+	for hash, counter := range counters {
+		b.Data = append(b.Data, encodeCounter([]byte(hash), counter))
+	}
+
+	marshalizedOperation, err := rc.marshalizer.Marshal(b)
+	if err != nil {
+		return err
+	}
+
+	err = rc.db.Put(pendingOperationKey, marshalizedOperation)
+	if err != nil {
+		return err
+	}
+
+	return rc.applyOperation(b)
+}
+
+func (rc *referenceCounter) resumePendingOperation() error {
+	marshalizedOperation, err := rc.db.Get(pendingOperationKey)
+	if err != nil || len(marshalizedOperation) == 0 {
+		return nil
+	}
+
+	b := &batch.Batch{}
+	err = rc.marshalizer.Unmarshal(b, marshalizedOperation)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("reference counter: resuming interrupted operation", "num hashes", len(b.Data))
+
+	return rc.applyOperation(b)
+}
+
+// applyOperation is idempotent as the operation holds the final values of the counters
+func (rc *referenceCounter) applyOperation(b *batch.Batch) error {
+	if len(b.Data) < 2 || len(b.Data[0]) != 1 {
+		return ErrInvalidOperation
+	}
+
+	opType := operationType(b.Data[0][0])
+	rootHash := b.Data[1]
+	entryHashes := make([][]byte, 0, len(b.Data)-2)
+
+	for _, encodedCounter := range b.Data[2:] {
+		hash, counter, err := decodeCounter(encodedCounter)
+		if err != nil {
+			return err
+		}
+		entryHashes = append(entryHashes, hash)
+
+		err = rc.setCounter(hash, counter, opType == removeEntry)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := rc.updateEntry(opType, rootHash, entryHashes)
+	if err != nil {
+		return err
+	}
+
+	return rc.db.Remove(pendingOperationKey)
+}
+
+func (rc *referenceCounter) setCounter(hash []byte, counter uint64, shouldRemoveNode bool) error {
+	counterKey := append([]byte(counterPrefix), hash...)
+
+	if counter > 0 {
+		counterBytes := make([]byte, counterLen)
+		binary.BigEndian.PutUint64(counterBytes, counter)
+
+		return rc.db.Put(counterKey, counterBytes)
+	}
+
+	err := rc.db.Remove(counterKey)
+	if err != nil {
+		return err
+	}
+	if !shouldRemoveNode {
+		return nil
+	}
+
+	nodeHash, err := hex.DecodeString(string(hash))
+	if err != nil {
+		return err
+	}
+
+	log.Trace("remove hash from trie db", "hash", nodeHash)
+
+	return rc.trieDb.Remove(nodeHash)
+}
+
+func (rc *referenceCounter) updateEntry(opType operationType, rootHash []byte, hashes [][]byte) error {
+	entryKey := append([]byte(entryPrefix), rootHash...)
+
+	switch opType {
+	case storeEntry:
+		marshalizedHashes, err := rc.marshalizer.Marshal(&batch.Batch{Data: hashes})
+		if err != nil {
+			return err
+		}
+
+		return rc.db.Put(entryKey, marshalizedHashes)
+	case removeEntry:
+		return rc.db.Remove(entryKey)
+	default:
+		return ErrInvalidOperation
+	}
+}
+
+func (rc *referenceCounter) getEntry(rootHash []byte) ([][]byte, error) {
+	marshalizedHashes, err := rc.db.Get(append([]byte(entryPrefix), rootHash...))
+	if err != nil || len(marshalizedHashes) == 0 {
+		log.Trace("reference counter: no entry for root", "root", rootHash)
+		return nil, nil
+	}
+
+	b := &batch.Batch{}
+	err = rc.marshalizer.Unmarshal(b, marshalizedHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Data, nil
+}
+
+func (rc *referenceCounter) getCounter(hash string) (uint64, error) {
+	counterBytes, err := rc.db.Get(append([]byte(counterPrefix), hash...))
+	if err != nil || len(counterBytes) == 0 {
+		return 0, nil
+	}
+	if len(counterBytes) != counterLen {
+		return 0, ErrInvalidCounter
+	}
+
+	return binary.BigEndian.Uint64(counterBytes), nil
+}
+
+func encodeCounter(hash []byte, counter uint64) []byte {
+	encoded := make([]byte, counterLen+len(hash))
+	binary.BigEndian.PutUint64(encoded, counter)
+	copy(encoded[counterLen:], hash)
+
+	return encoded
+}
+
+func decodeCounter(encoded []byte) ([]byte, uint64, error) {
+	if len(encoded) < counterLen {
+		return nil, 0, ErrInvalidCounter
+	}
+
+	return encoded[counterLen:], binary.BigEndian.Uint64(encoded[:counterLen]), nil
+}
+
+func getIdentifier(rootHash []byte) (data.TriePruningIdentifier, error) {
+	lastBytePos := len(rootHash) - 1
+	if lastBytePos < 0 {
+		return 0, ErrInvalidKey
+	}
+
+	return data.TriePruningIdentifier(rootHash[lastBytePos]), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rc *referenceCounter) IsInterfaceNil() bool {
+	return rc == nil
+}
@@ -0,0 +1,218 @@
+package referenceCounter
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/batch"
+	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRoot(root string) []byte {
+	return append([]byte(root), byte(data.NewRoot))
+}
+
+func oldRoot(root string) []byte {
+	return append([]byte(root), byte(data.OldRoot))
+}
+
+func putNodes(db data.DBWriteCacher, hashes ...string) data.ModifiedHashes {
+	modifiedHashes := make(data.ModifiedHashes)
+	for _, hash := range hashes {
+		_ = db.Put([]byte(hash), []byte("node "+hash))
+		modifiedHashes[hex.EncodeToString([]byte(hash))] = struct{}{}
+	}
+
+	return modifiedHashes
+}
+
+func isNodePresent(db data.DBWriteCacher, hash string) bool {
+	val, err := db.Get([]byte(hash))
+	return err == nil && len(val) > 0
+}
+
+func TestNewReferenceCounter(t *testing.T) {
+	t.Parallel()
+
+	rc, err := NewReferenceCounter(memorydb.New(), memorydb.New(), &mock.MarshalizerMock{})
+	assert.Nil(t, err)
+	assert.False(t, rc.IsInterfaceNil())
+}
+
+func TestNewReferenceCounter_NilTrieDatabaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rc, err := NewReferenceCounter(nil, memorydb.New(), &mock.MarshalizerMock{})
+	assert.Nil(t, rc)
+	assert.Equal(t, ErrNilTrieDatabase, err)
+}
+
+func TestNewReferenceCounter_NilDatabaseShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rc, err := NewReferenceCounter(memorydb.New(), nil, &mock.MarshalizerMock{})
+	assert.Nil(t, rc)
+	assert.Equal(t, data.ErrNilDatabase, err)
+}
+
+func TestNewReferenceCounter_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rc, err := NewReferenceCounter(memorydb.New(), memorydb.New(), nil)
+	assert.Nil(t, rc)
+	assert.Equal(t, data.ErrNilMarshalizer, err)
+}
+
+func TestReferenceCounter_PutInvalidKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rc, _ := NewReferenceCounter(memorydb.New(), memorydb.New(), &mock.MarshalizerMock{})
+	err := rc.Put(nil, make(data.ModifiedHashes))
+	assert.Equal(t, ErrInvalidKey, err)
+}
+
+func TestReferenceCounter_PruneOldRootRemovesUnreferencedNodes(t *testing.T) {
+	t.Parallel()
+
+	trieDb := memorydb.New()
+	rc, _ := NewReferenceCounter(trieDb, memorydb.New(), &mock.MarshalizerMock{})
+
+	err := rc.Put(newRoot("root1"), putNodes(trieDb, "hash1", "hash2"))
+	require.Nil(t, err)
+	err = rc.Put(oldRoot("root1"), putNodes(trieDb, "hash1"))
+	require.Nil(t, err)
+	err = rc.Put(newRoot("root2"), putNodes(trieDb, "hash3"))
+	require.Nil(t, err)
+
+	err = rc.CancelPrune(newRoot("root1"))
+	require.Nil(t, err)
+	err = rc.Prune(oldRoot("root1"))
+	require.Nil(t, err)
+
+	assert.False(t, isNodePresent(trieDb, "hash1"))
+	assert.True(t, isNodePresent(trieDb, "hash2"))
+	assert.True(t, isNodePresent(trieDb, "hash3"))
+}
+
+func TestReferenceCounter_PruneNewRootRemovesOnlyItsNodes(t *testing.T) {
+	t.Parallel()
+
+	trieDb := memorydb.New()
+	rc, _ := NewReferenceCounter(trieDb, memorydb.New(), &mock.MarshalizerMock{})
+
+	_ = rc.Put(newRoot("root1"), putNodes(trieDb, "hash1"))
+	_ = rc.Put(oldRoot("root1"), putNodes(trieDb, "hash1"))
+	_ = rc.Put(newRoot("root2"), putNodes(trieDb, "hash2"))
+
+	err := rc.CancelPrune(oldRoot("root1"))
+	require.Nil(t, err)
+	err = rc.Prune(newRoot("root2"))
+	require.Nil(t, err)
+
+	assert.True(t, isNodePresent(trieDb, "hash1"))
+	assert.False(t, isNodePresent(trieDb, "hash2"))
+}
+
+func TestReferenceCounter_NodeCreatedOnTwoForksIsKeptWhileReferenced(t *testing.T) {
+	t.Parallel()
+
+	trieDb := memorydb.New()
+	rc, _ := NewReferenceCounter(trieDb, memorydb.New(), &mock.MarshalizerMock{})
+
+	_ = rc.Put(newRoot("fork1"), putNodes(trieDb, "shared", "hash1"))
+	_ = rc.Put(newRoot("fork2"), putNodes(trieDb, "shared", "hash2"))
+
+	_ = rc.Prune(newRoot("fork1"))
+	assert.True(t, isNodePresent(trieDb, "shared"))
+	assert.False(t, isNodePresent(trieDb, "hash1"))
+	assert.True(t, isNodePresent(trieDb, "hash2"))
+
+	_ = rc.Prune(newRoot("fork2"))
+	assert.False(t, isNodePresent(trieDb, "shared"))
+	assert.False(t, isNodePresent(trieDb, "hash2"))
+}
+
+func TestReferenceCounter_PutSameNewRootTwiceShouldNotCountTwice(t *testing.T) {
+	t.Parallel()
+
+	trieDb := memorydb.New()
+	rc, _ := NewReferenceCounter(trieDb, memorydb.New(), &mock.MarshalizerMock{})
+
+	_ = rc.Put(newRoot("root1"), putNodes(trieDb, "hash1"))
+	_ = rc.Put(newRoot("root1"), putNodes(trieDb, "hash1", "hash2"))
+
+	_ = rc.Prune(newRoot("root1"))
+	assert.False(t, isNodePresent(trieDb, "hash1"))
+	assert.False(t, isNodePresent(trieDb, "hash2"))
+}
+
+func TestReferenceCounter_PruneMissingEntryShouldNotRemoveAnything(t *testing.T) {
+	t.Parallel()
+
+	trieDb := memorydb.New()
+	rc, _ := NewReferenceCounter(trieDb, memorydb.New(), &mock.MarshalizerMock{})
+	_ = putNodes(trieDb, "hash1")
+
+	err := rc.Prune(oldRoot("root1"))
+	assert.Nil(t, err)
+	assert.True(t, isNodePresent(trieDb, "hash1"))
+}
+
+func TestReferenceCounter_CountersArePersisted(t *testing.T) {
+	t.Parallel()
+
+	trieDb := memorydb.New()
+	db := memorydb.New()
+	marshalizer := &mock.MarshalizerMock{}
+	rc, _ := NewReferenceCounter(trieDb, db, marshalizer)
+
+	_ = rc.Put(newRoot("root1"), putNodes(trieDb, "hash1"))
+	_ = rc.Put(newRoot("root2"), putNodes(trieDb, "hash1"))
+
+	rc, _ = NewReferenceCounter(trieDb, db, marshalizer)
+	_ = rc.Prune(newRoot("root1"))
+	assert.True(t, isNodePresent(trieDb, "hash1"))
+
+	_ = rc.Prune(newRoot("root2"))
+	assert.False(t, isNodePresent(trieDb, "hash1"))
+}
+
+func TestReferenceCounter_InterruptedOperationIsResumedAtStartup(t *testing.T) {
+	t.Parallel()
+
+	trieDb := memorydb.New()
+	db := memorydb.New()
+	marshalizer := &mock.MarshalizerMock{}
+	rc, _ := NewReferenceCounter(trieDb, db, marshalizer)
+	_ = rc.Put(newRoot("root1"), putNodes(trieDb, "hash1", "hash2"))
+
+	hash1 := []byte(hex.EncodeToString([]byte("hash1")))
+	hash2 := []byte(hex.EncodeToString([]byte("hash2")))
+	pendingOperation := &batch.Batch{
+		Data: [][]byte{
+			{byte(removeEntry)},
+			newRoot("root1"),
+			encodeCounter(hash1, 0),
+			encodeCounter(hash2, 0),
+		},
+	}
+	marshalizedOperation, _ := marshalizer.Marshal(pendingOperation)
+	_ = db.Put(pendingOperationKey, marshalizedOperation)
+	// simulate a crash after the first counter was updated
+	_ = db.Remove(append([]byte(counterPrefix), hash1...))
+	_ = trieDb.Remove([]byte("hash1"))
+
+	rc, err := NewReferenceCounter(trieDb, db, marshalizer)
+	require.Nil(t, err)
+
+	assert.False(t, isNodePresent(trieDb, "hash1"))
+	assert.False(t, isNodePresent(trieDb, "hash2"))
+	_, err = db.Get(pendingOperationKey)
+	assert.NotNil(t, err)
+	_, err = db.Get(append([]byte(entryPrefix), newRoot("root1")...))
+	assert.NotNil(t, err)
+}
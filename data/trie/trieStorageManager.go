@@ -39,6 +39,7 @@ type trieStorageManager struct {
 	maxSnapshots       uint8
 
 	dbEvictionWaitingList data.DBRemoveCacher
+	referenceCounter      data.ReferenceCounter
 	storageOperationMutex sync.RWMutex
 }
 
@@ -69,25 +70,63 @@ func NewTrieStorageManager(
 		return nil, ErrNilEvictionWaitingList
 	}
 
+	tsm := newTrieStorageManager(db, snapshotDbCfg, generalConfig)
+	tsm.dbEvictionWaitingList = ewl
+
+	go tsm.storageProcessLoop(marshalizer, hasher)
+	return tsm, nil
+}
+
+// NewTrieStorageManagerWithReferenceCounting creates a new instance of trieStorageManager that uses
+// the reference counts of the trie nodes to decide which nodes can be pruned
+func NewTrieStorageManagerWithReferenceCounting(
+	db data.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	snapshotDbCfg config.DBConfig,
+	referenceCounter data.ReferenceCounter,
+	generalConfig config.TrieStorageManagerConfig,
+) (*trieStorageManager, error) {
+	if check.IfNil(db) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+	if check.IfNil(referenceCounter) {
+		return nil, ErrNilReferenceCounter
+	}
+
+	tsm := newTrieStorageManager(db, snapshotDbCfg, generalConfig)
+	tsm.referenceCounter = referenceCounter
+
+	go tsm.storageProcessLoop(marshalizer, hasher)
+	return tsm, nil
+}
+
+func newTrieStorageManager(
+	db data.DBWriteCacher,
+	snapshotDbCfg config.DBConfig,
+	generalConfig config.TrieStorageManagerConfig,
+) *trieStorageManager {
 	snapshots, snapshotId, err := getSnapshotsAndSnapshotId(snapshotDbCfg)
 	if err != nil {
 		log.Debug("get snapshot", "error", err.Error())
 	}
 
-	tsm := &trieStorageManager{
-		db:                    db,
-		snapshots:             snapshots,
-		snapshotId:            snapshotId,
-		snapshotDbCfg:         snapshotDbCfg,
-		pruningBuffer:         newPruningBuffer(generalConfig.PruningBufferLen),
-		dbEvictionWaitingList: ewl,
-		snapshotReq:           make(chan *snapshotsQueueEntry, generalConfig.SnapshotsBufferLen),
-		snapshotInProgress:    0,
-		maxSnapshots:          generalConfig.MaxSnapshots,
+	return &trieStorageManager{
+		db:                 db,
+		snapshots:          snapshots,
+		snapshotId:         snapshotId,
+		snapshotDbCfg:      snapshotDbCfg,
+		pruningBuffer:      newPruningBuffer(generalConfig.PruningBufferLen),
+		snapshotReq:        make(chan *snapshotsQueueEntry, generalConfig.SnapshotsBufferLen),
+		snapshotInProgress: 0,
+		maxSnapshots:       generalConfig.MaxSnapshots,
 	}
-
-	go tsm.storageProcessLoop(marshalizer, hasher)
-	return tsm, nil
 }
 
 func (tsm *trieStorageManager) storageProcessLoop(msh marshal.Marshalizer, hsh hashing.Hasher) {
@@ -211,7 +250,8 @@ func (tsm *trieStorageManager) Prune(rootHash []byte, identifier data.TriePrunin
 	rootHash = append(rootHash, byte(identifier))
 
 	if tsm.snapshotInProgress > 0 {
-		if identifier == data.NewRoot {
+		// the reference counter would never release the new nodes of a cancelled new root, so the pruning is delayed instead
+		if identifier == data.NewRoot && check.IfNil(tsm.referenceCounter) {
 			tsm.cancelPrune(rootHash)
 			return
 		}
@@ -251,6 +291,14 @@ func (tsm *trieStorageManager) resolveBufferedHashes(oldHashes [][]byte) {
 func (tsm *trieStorageManager) prune(rootHash []byte) {
 	log.Trace("trie storage manager prune", "root", rootHash)
 
+	if !check.IfNil(tsm.referenceCounter) {
+		err := tsm.referenceCounter.Prune(rootHash)
+		if err != nil {
+			log.Error("trie storage manager reference counter prune", "error", err, "rootHash", hex.EncodeToString(rootHash))
+		}
+		return
+	}
+
 	err := tsm.removeFromDb(rootHash)
 	if err != nil {
 		log.Error("trie storage manager remove from db", "error", err, "rootHash", hex.EncodeToString(rootHash))
@@ -276,6 +324,15 @@ func (tsm *trieStorageManager) CancelPrune(rootHash []byte, identifier data.Trie
 
 func (tsm *trieStorageManager) cancelPrune(rootHash []byte) {
 	log.Trace("trie storage manager cancel prune", "root", rootHash)
+
+	if !check.IfNil(tsm.referenceCounter) {
+		err := tsm.referenceCounter.CancelPrune(rootHash)
+		if err != nil {
+			log.Error("trie storage manager reference counter cancel prune", "error", err, "rootHash", hex.EncodeToString(rootHash))
+		}
+		return
+	}
+
 	_, _ = tsm.dbEvictionWaitingList.Evict(rootHash)
 }
 
@@ -330,6 +387,10 @@ func (tsm *trieStorageManager) removeFromDb(rootHash []byte) error {
 func (tsm *trieStorageManager) MarkForEviction(root []byte, hashes data.ModifiedHashes) error {
 	log.Trace("trie storage manager: mark for eviction", "root", root)
 
+	if !check.IfNil(tsm.referenceCounter) {
+		return tsm.referenceCounter.Put(root, hashes)
+	}
+
 	return tsm.dbEvictionWaitingList.Put(root, hashes)
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/mock"
+	"github.com/ElrondNetwork/elrond-go/data/trie/referenceCounter"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
@@ -60,6 +61,24 @@ func TestNewTrieStorageManagerOkVals(t *testing.T) {
 	assert.NotNil(t, ts)
 }
 
+func TestNewTrieStorageManagerWithReferenceCountingNilReferenceCounter(t *testing.T) {
+	t.Parallel()
+
+	ts, err := NewTrieStorageManagerWithReferenceCounting(mock.NewMemDbMock(), &mock.MarshalizerMock{}, &mock.HasherMock{}, config.DBConfig{}, nil, config.TrieStorageManagerConfig{})
+	assert.Nil(t, ts)
+	assert.Equal(t, ErrNilReferenceCounter, err)
+}
+
+func TestNewTrieStorageManagerWithReferenceCountingOkVals(t *testing.T) {
+	t.Parallel()
+
+	db := mock.NewMemDbMock()
+	refCounter, _ := referenceCounter.NewReferenceCounter(db, memorydb.New(), &mock.MarshalizerMock{})
+	ts, err := NewTrieStorageManagerWithReferenceCounting(db, &mock.MarshalizerMock{}, &mock.HasherMock{}, config.DBConfig{}, refCounter, config.TrieStorageManagerConfig{})
+	assert.Nil(t, err)
+	assert.NotNil(t, ts)
+}
+
 func TestNewTrieStorageManagerWithExistingSnapshot(t *testing.T) {
 	t.Parallel()
 
@@ -225,6 +244,61 @@ func TestTrieDatabasePruning(t *testing.T) {
 	}
 }
 
+func TestTrieDatabasePruningWithReferenceCounting(t *testing.T) {
+	t.Parallel()
+
+	generalCfg := config.TrieStorageManagerConfig{
+		PruningBufferLen:   1000,
+		SnapshotsBufferLen: 10,
+		MaxSnapshots:       2,
+	}
+	db := mock.NewMemDbMock()
+	msh, hsh := getTestMarshAndHasher()
+	refCounter, _ := referenceCounter.NewReferenceCounter(db, memorydb.New(), msh)
+	trieStorage, _ := NewTrieStorageManagerWithReferenceCounting(db, msh, hsh, config.DBConfig{}, refCounter, generalCfg)
+	maxTrieLevelInMemory := uint(5)
+	tr, _ := NewTrie(trieStorage, msh, hsh, maxTrieLevelInMemory)
+
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Update([]byte("ddog"), []byte("cat"))
+	newHashes, _ := tr.GetDirtyHashes()
+	tr.SetNewHashes(newHashes)
+	_ = tr.Commit()
+	rootHash, _ := tr.Root()
+	oldHashes, _ := tr.GetAllHashes()
+
+	_ = tr.Update([]byte("dog"), []byte("doee"))
+	newHashes, _ = tr.GetDirtyHashes()
+	tr.SetNewHashes(newHashes)
+	_ = tr.Commit()
+	newRootHash, _ := tr.Root()
+	currentHashes, _ := tr.GetAllHashes()
+
+	tr.CancelPrune(rootHash, data.NewRoot)
+	tr.Prune(rootHash, data.OldRoot)
+
+	stillReferenced := make(map[string]struct{})
+	for _, hash := range currentHashes {
+		stillReferenced[string(hash)] = struct{}{}
+		encNode, err := tr.Database().Get(hash)
+		assert.Nil(t, err)
+		assert.NotNil(t, encNode)
+	}
+	for _, hash := range oldHashes {
+		if _, ok := stillReferenced[string(hash)]; ok {
+			continue
+		}
+
+		encNode, err := tr.Database().Get(hash)
+		assert.Nil(t, encNode)
+		assert.NotNil(t, err)
+	}
+
+	_, err := tr.Recreate(newRootHash)
+	assert.Nil(t, err)
+}
+
 func TestRecreateTrieFromSnapshotDb(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,50 @@
+package consensus
+
+import "time"
+
+type disabledRoundTracer struct {
+}
+
+// NewDisabledRoundTracer returns a disabled instance of the round tracer
+func NewDisabledRoundTracer() *disabledRoundTracer {
+	return &disabledRoundTracer{}
+}
+
+// StartRound does nothing
+func (drt *disabledRoundTracer) StartRound(_ int64, _ time.Time) {
+}
+
+// AddSubroundTrace does nothing
+func (drt *disabledRoundTracer) AddSubroundTrace(_ int64, _ string, _ time.Duration, _ bool) {
+}
+
+// SetBlockReceived does nothing
+func (drt *disabledRoundTracer) SetBlockReceived(_ int64, _ time.Time) {
+}
+
+// AddSignatureReceived does nothing
+func (drt *disabledRoundTracer) AddSignatureReceived(_ int64, _ []byte, _ time.Time) {
+}
+
+// SetAggregationDuration does nothing
+func (drt *disabledRoundTracer) SetAggregationDuration(_ int64, _ time.Duration) {
+}
+
+// AddBroadcastDelay does nothing
+func (drt *disabledRoundTracer) AddBroadcastDelay(_ int64, _ string, _ time.Duration) {
+}
+
+// Query returns an empty slice
+func (drt *disabledRoundTracer) Query(_ string) []string {
+	return make([]string, 0)
+}
+
+// PrometheusMetrics returns an empty string
+func (drt *disabledRoundTracer) PrometheusMetrics(_ string) string {
+	return ""
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (drt *disabledRoundTracer) IsInterfaceNil() bool {
+	return drt == nil
+}
@@ -0,0 +1,25 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledRoundTracer(t *testing.T) {
+	t.Parallel()
+
+	drt := NewDisabledRoundTracer()
+	assert.False(t, check.IfNil(drt))
+
+	drt.StartRound(1, time.Now())
+	drt.AddSubroundTrace(1, "", 0, true)
+	drt.SetBlockReceived(1, time.Now())
+	drt.AddSignatureReceived(1, nil, time.Now())
+	drt.SetAggregationDuration(1, 0)
+	drt.AddBroadcastDelay(1, "", 0)
+	assert.Equal(t, 0, len(drt.Query("*")))
+	assert.Equal(t, "", drt.PrometheusMetrics(""))
+}
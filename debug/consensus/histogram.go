@@ -0,0 +1,65 @@
+package consensus
+
+import (
+	"fmt"
+	"strings"
+)
+
+// histogram holds the observations of a single metric, grouped in cumulative buckets as prometheus expects them
+type histogram struct {
+	name    string
+	labels  string
+	buckets []float64
+	counts  []uint64
+	sum     float64
+	count   uint64
+}
+
+func newHistogram(name string, labels string, buckets []float64) *histogram {
+	return &histogram{
+		name:    name,
+		labels:  labels,
+		buckets: buckets,
+		counts:  make([]uint64, len(buckets)),
+	}
+}
+
+// observe should be called under mutex protection
+func (h *histogram) observe(value float64) {
+	for i, upperBound := range h.buckets {
+		if value <= upperBound {
+			h.counts[i]++
+		}
+	}
+
+	h.sum += value
+	h.count++
+}
+
+// prometheusString should be called under mutex protection
+func (h *histogram) prometheusString(labels string) string {
+	allLabels := joinLabels(labels, h.labels)
+
+	stringBuilder := strings.Builder{}
+	for i, upperBound := range h.buckets {
+		bucketLabels := joinLabels(allLabels, fmt.Sprintf("le=\"%v\"", upperBound))
+		stringBuilder.WriteString(fmt.Sprintf("%s_bucket{%s} %d\n", h.name, bucketLabels, h.counts[i]))
+	}
+	bucketLabels := joinLabels(allLabels, "le=\"+Inf\"")
+	stringBuilder.WriteString(fmt.Sprintf("%s_bucket{%s} %d\n", h.name, bucketLabels, h.count))
+	stringBuilder.WriteString(fmt.Sprintf("%s_sum{%s} %v\n", h.name, allLabels, h.sum))
+	stringBuilder.WriteString(fmt.Sprintf("%s_count{%s} %d\n", h.name, allLabels, h.count))
+
+	return stringBuilder.String()
+}
+
+func joinLabels(first string, second string) string {
+	if len(first) == 0 {
+		return second
+	}
+	if len(second) == 0 {
+		return first
+	}
+
+	return first + "," + second
+}
@@ -0,0 +1,311 @@
+package consensus
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/debug"
+)
+
+const minNumRoundsToKeep = 1
+const queryAllRounds = "*"
+
+const subroundDurationMetric = "erd_consensus_subround_duration_ms"
+const blockReceivedDelayMetric = "erd_consensus_block_received_delay_ms"
+const signatureLatencyMetric = "erd_consensus_signature_latency_ms"
+const aggregationDurationMetric = "erd_consensus_signatures_aggregation_duration_ms"
+const broadcastDelayMetric = "erd_consensus_broadcast_delay_ms"
+
+// histogramBuckets holds the upper bounds, in milliseconds, of the exported histograms buckets
+var histogramBuckets = []float64{10, 50, 100, 250, 500, 1000, 2000, 3000, 4000, 5000, 6000}
+
+type subroundTrace struct {
+	name      string
+	duration  time.Duration
+	isJobDone bool
+}
+
+type signatureTrace struct {
+	pubKey  []byte
+	latency time.Duration
+}
+
+type broadcastTrace struct {
+	dataType string
+	delay    time.Duration
+}
+
+type roundTrace struct {
+	roundIndex          int64
+	startTime           time.Time
+	subrounds           []*subroundTrace
+	isBlockReceived     bool
+	blockReceivedTime   time.Time
+	signatures          []*signatureTrace
+	isAggregated        bool
+	aggregationDuration time.Duration
+	broadcasts          []*broadcastTrace
+}
+
+func (rt *roundTrace) String() string {
+	subrounds := make([]string, 0, len(rt.subrounds))
+	for _, sr := range rt.subrounds {
+		status := "done"
+		if !sr.isJobDone {
+			status = "not done"
+		}
+		subrounds = append(subrounds, fmt.Sprintf("%s %v %s", sr.name, sr.duration, status))
+	}
+
+	blockReceived := "-"
+	if rt.isBlockReceived {
+		blockReceived = rt.blockReceivedTime.Sub(rt.startTime).String()
+	}
+
+	signatures := make([]string, 0, len(rt.signatures))
+	for _, sig := range rt.signatures {
+		signatures = append(signatures, fmt.Sprintf("%s %v", core.GetTrimmedPk(hex.EncodeToString(sig.pubKey)), sig.latency))
+	}
+
+	aggregation := "-"
+	if rt.isAggregated {
+		aggregation = rt.aggregationDuration.String()
+	}
+
+	broadcasts := make([]string, 0, len(rt.broadcasts))
+	for _, bt := range rt.broadcasts {
+		broadcasts = append(broadcasts, fmt.Sprintf("%s %v", bt.dataType, bt.delay))
+	}
+
+	return fmt.Sprintf("round: %d, start time: %s, subrounds: [%s], block received after: %s, "+
+		"signatures latencies: [%s], aggregation duration: %s, broadcast delays: [%s]",
+		rt.roundIndex,
+		rt.startTime.Format("2006-01-02 15:04:05.000"),
+		strings.Join(subrounds, ", "),
+		blockReceived,
+		strings.Join(signatures, ", "),
+		aggregation,
+		strings.Join(broadcasts, ", "),
+	)
+}
+
+type roundTracer struct {
+	mutTraces  sync.RWMutex
+	traces     []*roundTrace
+	nextIndex  int
+	histograms map[string]*histogram
+}
+
+// NewRoundTracer creates a new round tracer able to keep the timings of the last rounds in a bounded ring buffer
+func NewRoundTracer(config config.ConsensusDebugConfig) (*roundTracer, error) {
+	if config.NumRoundsToKeep < minNumRoundsToKeep {
+		return nil, fmt.Errorf("%w for NumRoundsToKeep, minimum is %d", debug.ErrInvalidValue, minNumRoundsToKeep)
+	}
+
+	return &roundTracer{
+		traces:     make([]*roundTrace, config.NumRoundsToKeep),
+		histograms: make(map[string]*histogram),
+	}, nil
+}
+
+// StartRound adds a new round trace, overwriting the oldest one if the buffer is full
+func (rt *roundTracer) StartRound(roundIndex int64, roundTimeStamp time.Time) {
+	rt.mutTraces.Lock()
+	defer rt.mutTraces.Unlock()
+
+	if rt.getTrace(roundIndex) != nil {
+		return
+	}
+
+	rt.traces[rt.nextIndex] = &roundTrace{
+		roundIndex: roundIndex,
+		startTime:  roundTimeStamp,
+		subrounds:  make([]*subroundTrace, 0),
+		signatures: make([]*signatureTrace, 0),
+		broadcasts: make([]*broadcastTrace, 0),
+	}
+	rt.nextIndex = (rt.nextIndex + 1) % len(rt.traces)
+}
+
+// AddSubroundTrace records the time spent by the chronology in the provided subround
+func (rt *roundTracer) AddSubroundTrace(roundIndex int64, subroundName string, duration time.Duration, isJobDone bool) {
+	rt.mutTraces.Lock()
+	defer rt.mutTraces.Unlock()
+
+	labels := fmt.Sprintf("subround=\"%s\"", subroundName)
+	rt.observe(subroundDurationMetric, labels, duration)
+
+	trace := rt.getTrace(roundIndex)
+	if trace == nil {
+		return
+	}
+
+	trace.subrounds = append(trace.subrounds, &subroundTrace{
+		name:      subroundName,
+		duration:  duration,
+		isJobDone: isJobDone,
+	})
+}
+
+// SetBlockReceived records the moment the block was received (or proposed, in case of the leader)
+func (rt *roundTracer) SetBlockReceived(roundIndex int64, receivedTime time.Time) {
+	rt.mutTraces.Lock()
+	defer rt.mutTraces.Unlock()
+
+	trace := rt.getTrace(roundIndex)
+	if trace == nil || trace.isBlockReceived {
+		return
+	}
+
+	trace.isBlockReceived = true
+	trace.blockReceivedTime = receivedTime
+	rt.observe(blockReceivedDelayMetric, "", receivedTime.Sub(trace.startTime))
+}
+
+// AddSignatureReceived records the latency of the signature share received from the provided validator. The latency
+// is computed from the moment the block was proposed or, if not known, from the start of the round
+func (rt *roundTracer) AddSignatureReceived(roundIndex int64, pubKey []byte, receivedTime time.Time) {
+	rt.mutTraces.Lock()
+	defer rt.mutTraces.Unlock()
+
+	trace := rt.getTrace(roundIndex)
+	if trace == nil {
+		return
+	}
+
+	referenceTime := trace.startTime
+	if trace.isBlockReceived {
+		referenceTime = trace.blockReceivedTime
+	}
+
+	latency := receivedTime.Sub(referenceTime)
+	trace.signatures = append(trace.signatures, &signatureTrace{
+		pubKey:  pubKey,
+		latency: latency,
+	})
+	rt.observe(signatureLatencyMetric, "", latency)
+}
+
+// SetAggregationDuration records the time needed to aggregate the signature shares
+func (rt *roundTracer) SetAggregationDuration(roundIndex int64, duration time.Duration) {
+	rt.mutTraces.Lock()
+	defer rt.mutTraces.Unlock()
+
+	rt.observe(aggregationDurationMetric, "", duration)
+
+	trace := rt.getTrace(roundIndex)
+	if trace == nil {
+		return
+	}
+
+	trace.isAggregated = true
+	trace.aggregationDuration = duration
+}
+
+// AddBroadcastDelay records the delay between the moment the provided data was prepared for the delayed broadcast and
+// the moment it was actually broadcast
+func (rt *roundTracer) AddBroadcastDelay(roundIndex int64, dataType string, delay time.Duration) {
+	rt.mutTraces.Lock()
+	defer rt.mutTraces.Unlock()
+
+	labels := fmt.Sprintf("data=\"%s\"", dataType)
+	rt.observe(broadcastDelayMetric, labels, delay)
+
+	trace := rt.getTrace(roundIndex)
+	if trace == nil {
+		return
+	}
+
+	trace.broadcasts = append(trace.broadcasts, &broadcastTrace{
+		dataType: dataType,
+		delay:    delay,
+	})
+}
+
+// Query returns the traces of the kept rounds, the newest first. The search string can be either "*", for all the
+// kept rounds, or a round index
+func (rt *roundTracer) Query(search string) []string {
+	rt.mutTraces.RLock()
+	defer rt.mutTraces.RUnlock()
+
+	acceptTrace := func(trace *roundTrace) bool {
+		return search == queryAllRounds || search == strconv.FormatInt(trace.roundIndex, 10)
+	}
+
+	traces := make([]string, 0)
+	for i := 1; i <= len(rt.traces); i++ {
+		index := (rt.nextIndex - i + len(rt.traces)) % len(rt.traces)
+		trace := rt.traces[index]
+		if trace == nil {
+			break
+		}
+		if !acceptTrace(trace) {
+			continue
+		}
+
+		traces = append(traces, trace.String())
+	}
+
+	return traces
+}
+
+// PrometheusMetrics returns the timing histograms in the prometheus text format
+func (rt *roundTracer) PrometheusMetrics(labels string) string {
+	rt.mutTraces.RLock()
+	defer rt.mutTraces.RUnlock()
+
+	keys := make([]string, 0, len(rt.histograms))
+	for key := range rt.histograms {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	stringBuilder := strings.Builder{}
+	lastName := ""
+	for _, key := range keys {
+		h := rt.histograms[key]
+		if h.name != lastName {
+			stringBuilder.WriteString(fmt.Sprintf("# TYPE %s histogram\n", h.name))
+			lastName = h.name
+		}
+
+		stringBuilder.WriteString(h.prometheusString(labels))
+	}
+
+	return stringBuilder.String()
+}
+
+// getTrace should be called under mutex protection
+func (rt *roundTracer) getTrace(roundIndex int64) *roundTrace {
+	for _, trace := range rt.traces {
+		if trace != nil && trace.roundIndex == roundIndex {
+			return trace
+		}
+	}
+
+	return nil
+}
+
+// observe should be called under mutex protection
+func (rt *roundTracer) observe(name string, labels string, value time.Duration) {
+	key := name + "{" + labels + "}"
+	h, ok := rt.histograms[key]
+	if !ok {
+		h = newHistogram(name, labels, histogramBuckets)
+		rt.histograms[key] = h
+	}
+
+	h.observe(float64(value) / float64(time.Millisecond))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rt *roundTracer) IsInterfaceNil() bool {
+	return rt == nil
+}
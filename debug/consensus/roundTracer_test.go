@@ -0,0 +1,182 @@
+package consensus
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createRoundTracer(numRoundsToKeep int) *roundTracer {
+	rt, _ := NewRoundTracer(config.ConsensusDebugConfig{
+		Enabled:         true,
+		NumRoundsToKeep: numRoundsToKeep,
+	})
+
+	return rt
+}
+
+func TestNewRoundTracer_InvalidNumRoundsToKeepShouldErr(t *testing.T) {
+	t.Parallel()
+
+	rt, err := NewRoundTracer(config.ConsensusDebugConfig{
+		Enabled:         true,
+		NumRoundsToKeep: 0,
+	})
+
+	assert.True(t, check.IfNil(rt))
+	assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+}
+
+func TestNewRoundTracer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rt, err := NewRoundTracer(config.ConsensusDebugConfig{
+		Enabled:         true,
+		NumRoundsToKeep: 10,
+	})
+
+	assert.False(t, check.IfNil(rt))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(rt.Query(queryAllRounds)))
+	assert.Equal(t, "", rt.PrometheusMetrics(""))
+}
+
+func TestRoundTracer_QueryShouldReturnTheRoundTimings(t *testing.T) {
+	t.Parallel()
+
+	rt := createRoundTracer(10)
+	startTime := time.Unix(1000, 0)
+	rt.StartRound(5, startTime)
+	rt.AddSubroundTrace(5, "(BLOCK)", 300*time.Millisecond, true)
+	rt.SetBlockReceived(5, startTime.Add(250*time.Millisecond))
+	rt.AddSignatureReceived(5, []byte("pk"), startTime.Add(400*time.Millisecond))
+	rt.SetAggregationDuration(5, 3*time.Millisecond)
+	rt.AddBroadcastDelay(5, "validator_header", 600*time.Millisecond)
+
+	traces := rt.Query("5")
+	require.Equal(t, 1, len(traces))
+	assert.True(t, strings.Contains(traces[0], "round: 5,"))
+	assert.True(t, strings.Contains(traces[0], "(BLOCK) 300ms done"))
+	assert.True(t, strings.Contains(traces[0], "block received after: 250ms"))
+	assert.True(t, strings.Contains(traces[0], "706b 150ms"))
+	assert.True(t, strings.Contains(traces[0], "aggregation duration: 3ms"))
+	assert.True(t, strings.Contains(traces[0], "validator_header 600ms"))
+
+	assert.Equal(t, 0, len(rt.Query("6")))
+}
+
+func TestRoundTracer_ShouldKeepOnlyTheLastRounds(t *testing.T) {
+	t.Parallel()
+
+	numRoundsToKeep := 3
+	rt := createRoundTracer(numRoundsToKeep)
+	for i := int64(0); i < 5; i++ {
+		rt.StartRound(i, time.Now())
+	}
+
+	traces := rt.Query(queryAllRounds)
+	require.Equal(t, numRoundsToKeep, len(traces))
+	assert.True(t, strings.HasPrefix(traces[0], "round: 4,"))
+	assert.True(t, strings.HasPrefix(traces[1], "round: 3,"))
+	assert.True(t, strings.HasPrefix(traces[2], "round: 2,"))
+	assert.Equal(t, 0, len(rt.Query("1")))
+}
+
+func TestRoundTracer_StartRoundTwiceShouldNotOverwrite(t *testing.T) {
+	t.Parallel()
+
+	rt := createRoundTracer(10)
+	rt.StartRound(1, time.Now())
+	rt.SetAggregationDuration(1, time.Millisecond)
+	rt.StartRound(1, time.Now())
+
+	traces := rt.Query(queryAllRounds)
+	require.Equal(t, 1, len(traces))
+	assert.True(t, strings.Contains(traces[0], "aggregation duration: 1ms"))
+}
+
+func TestRoundTracer_SignatureLatencyWithoutBlockShouldUseRoundStart(t *testing.T) {
+	t.Parallel()
+
+	rt := createRoundTracer(10)
+	startTime := time.Unix(1000, 0)
+	rt.StartRound(1, startTime)
+	rt.AddSignatureReceived(1, []byte("pk"), startTime.Add(time.Second))
+
+	traces := rt.Query("1")
+	require.Equal(t, 1, len(traces))
+	assert.True(t, strings.Contains(traces[0], "706b 1s"))
+}
+
+func TestRoundTracer_PrometheusMetricsShouldExportHistograms(t *testing.T) {
+	t.Parallel()
+
+	rt := createRoundTracer(10)
+	startTime := time.Unix(1000, 0)
+	rt.StartRound(1, startTime)
+	rt.SetBlockReceived(1, startTime.Add(200*time.Millisecond))
+	rt.AddSubroundTrace(1, "(BLOCK)", 1500*time.Millisecond, true)
+	rt.AddSubroundTrace(2, "(BLOCK)", 30*time.Millisecond, false)
+
+	metrics := rt.PrometheusMetrics("erd_shard_id=\"0\"")
+
+	assert.Equal(t, 1, strings.Count(metrics, "# TYPE "+subroundDurationMetric+" histogram"))
+	assert.True(t, strings.Contains(metrics, blockReceivedDelayMetric+"_bucket{erd_shard_id=\"0\",le=\"100\"} 0\n"))
+	assert.True(t, strings.Contains(metrics, blockReceivedDelayMetric+"_bucket{erd_shard_id=\"0\",le=\"250\"} 1\n"))
+	assert.True(t, strings.Contains(metrics, blockReceivedDelayMetric+"_bucket{erd_shard_id=\"0\",le=\"+Inf\"} 1\n"))
+	assert.True(t, strings.Contains(metrics, blockReceivedDelayMetric+"_sum{erd_shard_id=\"0\"} 200\n"))
+	assert.True(t, strings.Contains(metrics, blockReceivedDelayMetric+"_count{erd_shard_id=\"0\"} 1\n"))
+	assert.True(t, strings.Contains(metrics, subroundDurationMetric+"_bucket{erd_shard_id=\"0\",subround=\"(BLOCK)\",le=\"50\"} 1\n"))
+	assert.True(t, strings.Contains(metrics, subroundDurationMetric+"_bucket{erd_shard_id=\"0\",subround=\"(BLOCK)\",le=\"2000\"} 2\n"))
+	assert.True(t, strings.Contains(metrics, subroundDurationMetric+"_count{erd_shard_id=\"0\",subround=\"(BLOCK)\"} 2\n"))
+}
+
+func TestRoundTracer_ConcurrentOperationsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, "should not panic")
+		}
+	}()
+
+	rt := createRoundTracer(5)
+	numCalls := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			round := int64(idx / 7)
+			switch idx % 7 {
+			case 0:
+				rt.StartRound(round, time.Now())
+			case 1:
+				rt.AddSubroundTrace(round, "(BLOCK)", time.Millisecond, true)
+			case 2:
+				rt.SetBlockReceived(round, time.Now())
+			case 3:
+				rt.AddSignatureReceived(round, []byte("pk"), time.Now())
+			case 4:
+				rt.SetAggregationDuration(round, time.Millisecond)
+			case 5:
+				rt.AddBroadcastDelay(round, "data", time.Millisecond)
+			case 6:
+				_ = rt.Query(queryAllRounds)
+				_ = rt.PrometheusMetrics("")
+			}
+
+			wg.Done()
+		}(i)
+	}
+
+	wg.Wait()
+}
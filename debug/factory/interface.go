@@ -1,5 +1,7 @@
 package factory
 
+import "github.com/ElrondNetwork/elrond-go/consensus"
+
 // InterceptorResolverDebugHandler hold information about requested and received information
 type InterceptorResolverDebugHandler interface {
 	LogRequestedData(topic string, hashes [][]byte, numReqIntra int, numReqCross int)
@@ -10,3 +12,11 @@ type InterceptorResolverDebugHandler interface {
 	Query(topic string) []string
 	IsInterfaceNil() bool
 }
+
+// RoundTracerHandler records the timings of the consensus rounds and exposes them as queryable traces and as
+// prometheus histograms
+type RoundTracerHandler interface {
+	consensus.RoundTracer
+	Query(search string) []string
+	PrometheusMetrics(labels string) string
+}
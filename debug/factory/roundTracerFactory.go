@@ -0,0 +1,15 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	consensusDebug "github.com/ElrondNetwork/elrond-go/debug/consensus"
+)
+
+// NewRoundTracerFactory will instantiate a RoundTracerHandler based on the provided config
+func NewRoundTracerFactory(config config.ConsensusDebugConfig) (RoundTracerHandler, error) {
+	if !config.Enabled {
+		return consensusDebug.NewDisabledRoundTracer(), nil
+	}
+
+	return consensusDebug.NewRoundTracer(config)
+}
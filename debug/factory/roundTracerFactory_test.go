@@ -0,0 +1,40 @@
+package factory
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	consensusDebug "github.com/ElrondNetwork/elrond-go/debug/consensus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRoundTracerFactory_DisabledShouldWork(t *testing.T) {
+	t.Parallel()
+
+	rth, err := NewRoundTracerFactory(
+		config.ConsensusDebugConfig{
+			Enabled: false,
+		},
+	)
+
+	assert.Nil(t, err)
+	expected := consensusDebug.NewDisabledRoundTracer()
+	assert.IsType(t, expected, rth)
+}
+
+func TestNewRoundTracerFactory_RoundTracer(t *testing.T) {
+	t.Parallel()
+
+	rth, err := NewRoundTracerFactory(
+		config.ConsensusDebugConfig{
+			Enabled:         true,
+			NumRoundsToKeep: 10,
+		},
+	)
+
+	assert.Nil(t, err)
+	expected, _ := consensusDebug.NewRoundTracer(config.ConsensusDebugConfig{
+		NumRoundsToKeep: 1,
+	})
+	assert.IsType(t, expected, rth)
+}
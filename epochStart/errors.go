@@ -247,3 +247,12 @@ var ErrNilChanceComputer = errors.New("nil chance computer")
 
 // ErrInvalidMinNumberOfNodes signals that the minimum number of nodes is invalid
 var ErrInvalidMinNumberOfNodes = errors.New("minimum number of nodes invalid")
+
+// ErrInvalidSlashValue signals that an invalid slash value has been provided
+var ErrInvalidSlashValue = errors.New("invalid slash value")
+
+// ErrValidatorKeyChangesNotFinalized signals that the pending validator key changes could not be finalized
+var ErrValidatorKeyChangesNotFinalized = errors.New("validator key changes not finalized")
+
+// ErrCouldNotInitDelegationSystemSC signals that the delegation system smart contracts could not be deployed
+var ErrCouldNotInitDelegationSystemSC = errors.New("could not init delegation system smart contracts")
@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/vm"
 )
 
 var _ process.EpochStartRewardsCreator = (*rewardsCreator)(nil)
@@ -39,6 +40,7 @@ type ArgsNewRewardsCreator struct {
 	DataPool                      dataRetriever.PoolsHolder
 	ProtocolSustainabilityAddress string
 	NodesConfigProvider           epochStart.NodesConfigProvider
+	DelegationEnableEpoch         uint32
 }
 
 type rewardsCreator struct {
@@ -49,6 +51,7 @@ type rewardsCreator struct {
 	miniBlockStorage              storage.Storer
 	protocolSustainabilityAddress []byte
 	nodesConfigProvider           epochStart.NodesConfigProvider
+	delegationEnableEpoch         uint32
 
 	hasher                         hashing.Hasher
 	marshalizer                    marshal.Marshalizer
@@ -56,6 +59,7 @@ type rewardsCreator struct {
 	mapRewardsPerBlockPerValidator map[uint32]*big.Int
 	accumulatedRewards             *big.Int
 	protocolSustainability         *big.Int
+	delegationRewards              map[string]*big.Int
 }
 
 type rewardInfoData struct {
@@ -120,8 +124,10 @@ func NewEpochStartRewardsCreator(args ArgsNewRewardsCreator) (*rewardsCreator, e
 		dataPool:                      args.DataPool,
 		protocolSustainabilityAddress: address,
 		nodesConfigProvider:           args.NodesConfigProvider,
+		delegationEnableEpoch:         args.DelegationEnableEpoch,
 		accumulatedRewards:            big.NewInt(0),
 		protocolSustainability:        big.NewInt(0),
+		delegationRewards:             make(map[string]*big.Int),
 	}
 
 	return rc, nil
@@ -133,6 +139,7 @@ func (rc *rewardsCreator) clean() {
 	rc.currTxs.Clean()
 	rc.accumulatedRewards = big.NewInt(0)
 	rc.protocolSustainability = big.NewInt(0)
+	rc.delegationRewards = make(map[string]*big.Int)
 }
 
 // CreateRewardsMiniBlocks creates the rewards miniblocks according to economics data and validator info
@@ -225,6 +232,11 @@ func (rc *rewardsCreator) addValidatorRewardsToMiniBlocks(
 		rc.accumulatedRewards.Add(rc.accumulatedRewards, rwdTx.Value)
 		shardId := rc.shardCoordinator.ComputeId([]byte(rwdInfo.address))
 		if shardId == core.MetachainShardId {
+			if rc.isDelegationRewardAddress(metaBlock.Epoch, []byte(rwdInfo.address)) {
+				rc.delegationRewards[rwdInfo.address] = rwdTx.Value
+				continue
+			}
+
 			protocolSustainabilityRwdTx.Value.Add(protocolSustainabilityRwdTx.Value, rwdTx.Value)
 			continue
 		}
@@ -236,6 +248,12 @@ func (rc *rewardsCreator) addValidatorRewardsToMiniBlocks(
 	return nil
 }
 
+// the rewards of the delegation pools are credited by the end of epoch system SC processor, as they can not be
+// sent as reward transactions to the metachain
+func (rc *rewardsCreator) isDelegationRewardAddress(epoch uint32, address []byte) bool {
+	return epoch >= rc.delegationEnableEpoch && vm.IsDelegationSCAddress(address)
+}
+
 func (rc *rewardsCreator) createProtocolSustainabilityRewardTransaction(
 	metaBlock *block.MetaBlock,
 ) (*rewardTx.RewardTx, uint32, error) {
@@ -320,6 +338,11 @@ func (rc *rewardsCreator) GetProtocolSustainabilityRewards() *big.Int {
 	return rc.protocolSustainability
 }
 
+// GetDelegationRewards returns the rewards of the delegation pools computed when the rewards miniblocks were created
+func (rc *rewardsCreator) GetDelegationRewards() map[string]*big.Int {
+	return rc.delegationRewards
+}
+
 // VerifyRewardsMiniBlocks verifies if received rewards miniblocks are correct
 func (rc *rewardsCreator) VerifyRewardsMiniBlocks(metaBlock *block.MetaBlock, validatorsInfo map[uint32][]*state.ValidatorInfo) error {
 	if check.IfNil(metaBlock) {
@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewEpochStartRewardsCreator_NilShardCoordinator(t *testing.T) {
@@ -694,3 +695,50 @@ func getRewardsArguments() ArgsNewRewardsCreator {
 		NodesConfigProvider:           &mock.NodesCoordinatorStub{},
 	}
 }
+
+func TestRewardsCreator_DelegationPoolRewardsShouldNotBeAddedToProtocolSustainability(t *testing.T) {
+	t.Parallel()
+
+	poolAddress := vm.CreateDelegationSCAddress(1)
+	createRewards := func(delegationEnableEpoch uint32) (*rewardsCreator, *big.Int) {
+		args := getRewardsArguments()
+		args.ShardCoordinator, _ = sharding.NewMultiShardCoordinator(1, core.MetachainShardId)
+		args.DelegationEnableEpoch = delegationEnableEpoch
+		rwdc, _ := NewEpochStartRewardsCreator(args)
+		metaBlk := &block.MetaBlock{
+			Epoch:          2,
+			EpochStart:     getDefaultEpochStart(),
+			DevFeesInEpoch: big.NewInt(0),
+		}
+		metaBlk.EpochStart.Economics.TotalToDistribute = big.NewInt(10150)
+		valInfo := make(map[uint32][]*state.ValidatorInfo)
+		valInfo[0] = []*state.ValidatorInfo{
+			{
+				RewardAddress:              poolAddress,
+				ShardId:                    0,
+				AccumulatedFees:            big.NewInt(100),
+				NumSelectedInSuccessBlocks: 1,
+				LeaderSuccess:              1,
+			},
+		}
+		miniBlocks, err := rwdc.CreateRewardsMiniBlocks(metaBlk, valInfo)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(miniBlocks))
+		require.Equal(t, 1, len(miniBlocks[0].TxHashes))
+
+		protocolSustainabilityReward, err := rwdc.currTxs.GetTx(miniBlocks[0].TxHashes[0])
+		require.Nil(t, err)
+
+		return rwdc, protocolSustainabilityReward.GetValue()
+	}
+
+	poolReward := big.NewInt(10100)
+	rwdc, protocolSustainabilityValue := createRewards(3)
+	assert.Equal(t, 0, len(rwdc.GetDelegationRewards()))
+	assert.Equal(t, big.NewInt(0).Add(big.NewInt(50), poolReward), protocolSustainabilityValue)
+
+	rwdc, protocolSustainabilityValue = createRewards(2)
+	assert.Equal(t, map[string]*big.Int{string(poolAddress): poolReward}, rwdc.GetDelegationRewards())
+	assert.Equal(t, big.NewInt(50), protocolSustainabilityValue)
+	assert.Equal(t, big.NewInt(50), rwdc.GetProtocolSustainabilityRewards())
+}
@@ -2,9 +2,11 @@ package metachain
 
 import (
 	"bytes"
+	"math"
 	"math/big"
 	"sort"
 
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -13,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
@@ -24,6 +27,7 @@ type ArgsNewEpochStartSystemSCProcessing struct {
 	PeerAccountsDB       state.AccountsAdapter
 	Marshalizer          marshal.Marshalizer
 	StartRating          uint32
+	JailRating           uint32
 	ValidatorInfoCreator epochStart.ValidatorInfoCreator
 	ChanceComputer       sharding.ChanceComputer
 
@@ -32,6 +36,8 @@ type ArgsNewEpochStartSystemSCProcessing struct {
 
 	SwitchJailWaitingEnableEpoch           uint32
 	SwitchHysteresisForMinNodesEnableEpoch uint32
+	ValidatorKeyRotationEnableEpoch        uint32
+	DelegationEnableEpoch                  uint32
 
 	GenesisNodesConfig sharding.GenesisNodesSetupHandler
 	EpochNotifier      process.EpochNotifier
@@ -44,14 +50,19 @@ type systemSCProcessor struct {
 	peerAccountsDB          state.AccountsAdapter
 	chanceComputer          sharding.ChanceComputer
 	startRating             uint32
+	jailRating              uint32
 	validatorInfoCreator    epochStart.ValidatorInfoCreator
 	genesisNodesConfig      sharding.GenesisNodesSetupHandler
 	endOfEpochCallerAddress []byte
 	stakingSCAddress        []byte
 	switchEnableEpoch       uint32
 	hystNodesEnableEpoch    uint32
+	keyRotationEnableEpoch  uint32
+	delegationEnableEpoch   uint32
 	flagSwitchEnabled       atomic.Flag
 	flagHystNodesEnabled    atomic.Flag
+	flagKeyRotationEnabled  atomic.Flag
+	flagDelegationEnabled   atomic.Flag
 
 	mapNumSwitchedPerShard   map[uint32]uint32
 	mapNumSwitchablePerShard map[uint32]uint32
@@ -116,6 +127,7 @@ func NewSystemSCProcessor(args ArgsNewEpochStartSystemSCProcessing) (*systemSCPr
 		peerAccountsDB:           args.PeerAccountsDB,
 		marshalizer:              args.Marshalizer,
 		startRating:              args.StartRating,
+		jailRating:               args.JailRating,
 		validatorInfoCreator:     args.ValidatorInfoCreator,
 		genesisNodesConfig:       args.GenesisNodesConfig,
 		endOfEpochCallerAddress:  args.EndOfEpochCallerAddress,
@@ -125,6 +137,8 @@ func NewSystemSCProcessor(args ArgsNewEpochStartSystemSCProcessing) (*systemSCPr
 		mapNumSwitchablePerShard: make(map[uint32]uint32),
 		switchEnableEpoch:        args.SwitchJailWaitingEnableEpoch,
 		hystNodesEnableEpoch:     args.SwitchHysteresisForMinNodesEnableEpoch,
+		keyRotationEnableEpoch:   args.ValidatorKeyRotationEnableEpoch,
+		delegationEnableEpoch:    args.DelegationEnableEpoch,
 	}
 
 	args.EpochNotifier.RegisterNotifyHandler(s)
@@ -133,6 +147,13 @@ func NewSystemSCProcessor(args ArgsNewEpochStartSystemSCProcessing) (*systemSCPr
 
 // ProcessSystemSmartContract does all the processing at end of epoch in case of system smart contract
 func (s *systemSCProcessor) ProcessSystemSmartContract(validatorInfos map[uint32][]*state.ValidatorInfo) error {
+	if s.flagDelegationEnabled.IsSet() {
+		err := s.initDelegationSystemSC()
+		if err != nil {
+			return err
+		}
+	}
+
 	if s.flagHystNodesEnabled.IsSet() {
 		err := s.updateSystemSCConfig()
 		if err != nil {
@@ -140,6 +161,18 @@ func (s *systemSCProcessor) ProcessSystemSmartContract(validatorInfos map[uint32
 		}
 	}
 
+	if s.flagKeyRotationEnabled.IsSet() {
+		err := s.cleanReplacedValidatorKeys(validatorInfos)
+		if err != nil {
+			return err
+		}
+
+		err = s.finalizeValidatorKeyChanges(validatorInfos)
+		if err != nil {
+			return err
+		}
+	}
+
 	if s.flagSwitchEnabled.IsSet() {
 		err := s.computeNumWaitingPerShard(validatorInfos)
 		if err != nil {
@@ -155,6 +188,70 @@ func (s *systemSCProcessor) ProcessSystemSmartContract(validatorInfos map[uint32
 	return nil
 }
 
+// ProcessDelegationRewards credits the rewards computed at the end of epoch for the delegation pools
+func (s *systemSCProcessor) ProcessDelegationRewards(rewards map[string]*big.Int) error {
+	addresses := make([]string, 0, len(rewards))
+	for address := range rewards {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	for _, address := range addresses {
+		err := s.updateDelegationRewards([]byte(address), rewards[address])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *systemSCProcessor) updateDelegationRewards(address []byte, value *big.Int) error {
+	if value.Cmp(zero) <= 0 {
+		return nil
+	}
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  s.endOfEpochCallerAddress,
+			Arguments:   [][]byte{},
+			CallValue:   big.NewInt(0).Set(value),
+			GasProvided: math.MaxUint64,
+		},
+		RecipientAddr: address,
+		Function:      "updateRewards",
+	}
+	vmOutput, err := s.systemVM.RunSmartContractCall(vmInput)
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		// the rewards are still credited to the pool, so the total supply stays consistent
+		log.Warn("could not update the delegation rewards",
+			"address", address,
+			"value", value,
+			"return code", vmOutput.ReturnCode,
+			"return message", vmOutput.ReturnMessage,
+		)
+	} else {
+		err = s.processSCOutputAccounts(vmOutput)
+		if err != nil {
+			return err
+		}
+	}
+
+	account, err := s.getExistingAccount(address)
+	if err != nil {
+		return err
+	}
+	err = account.AddToBalance(value)
+	if err != nil {
+		return err
+	}
+
+	return s.userAccountsDB.SaveAccount(account)
+}
+
 // updates the configuration of the system SC if the flags permit
 func (s *systemSCProcessor) updateSystemSCConfig() error {
 	minNumberOfNodesWithHysteresis := s.genesisNodesConfig.MinNumberOfNodesWithHysteresis()
@@ -163,6 +260,192 @@ func (s *systemSCProcessor) updateSystemSCConfig() error {
 	return err
 }
 
+// deploys the delegation manager and the delegation template contracts, which are not part of the genesis state
+func (s *systemSCProcessor) initDelegationSystemSC() error {
+	_, err := s.userAccountsDB.GetExistingAccount(vm.DelegationManagerSCAddress)
+	if err == nil {
+		return nil
+	}
+
+	err = s.deploySystemSC(vm.DelegationManagerSCAddress)
+	if err != nil {
+		return err
+	}
+
+	return s.deploySystemSC(vm.FirstDelegationSCAddress)
+}
+
+func (s *systemSCProcessor) deploySystemSC(address []byte) error {
+	vmInput := &vmcommon.ContractCreateInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: address,
+			Arguments:  [][]byte{},
+			CallValue:  big.NewInt(0),
+		},
+		ContractCodeMetadata: (&vmcommon.CodeMetadata{}).ToBytes(),
+	}
+
+	vmOutput, err := s.systemVM.RunSmartContractCreate(vmInput)
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return epochStart.ErrCouldNotInitDelegationSystemSC
+	}
+
+	account, err := s.userAccountsDB.LoadAccount(address)
+	if err != nil {
+		return err
+	}
+	err = s.userAccountsDB.SaveAccount(account)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("system smart contract deployed", "address", address)
+
+	return s.processSCOutputAccounts(vmOutput)
+}
+
+// moves the staking data of the validators which requested a key change during the epoch and migrates their
+// peer accounts and validator info to the new keys, so the nodes coordinator will use the new keys in the next epoch
+func (s *systemSCProcessor) finalizeValidatorKeyChanges(validatorInfos map[uint32][]*state.ValidatorInfo) error {
+	vmOutput, err := s.executeKeyRotationFunction("finalizeValidatorKeyChanges")
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i+1 < len(vmOutput.ReturnData); i += 2 {
+		oldKey := vmOutput.ReturnData[i]
+		newKey := vmOutput.ReturnData[i+1]
+		log.Debug("validator key changed", "old key", oldKey, "new key", newKey)
+
+		err = s.changePeerAccountKey(oldKey, newKey)
+		if err != nil {
+			return err
+		}
+
+		changeValidatorKeyInMap(validatorInfos, oldKey, newKey)
+	}
+
+	return nil
+}
+
+func (s *systemSCProcessor) changePeerAccountKey(oldKey []byte, newKey []byte) error {
+	oldAccount, err := s.getPeerAccount(oldKey)
+	if err != nil {
+		return err
+	}
+	if len(oldAccount.GetBLSPublicKey()) == 0 {
+		log.Debug("no peer account for the old validator key", "key", oldKey)
+		return nil
+	}
+
+	newAccount, err := s.getPeerAccount(newKey)
+	if err != nil {
+		return err
+	}
+
+	err = newAccount.SetBLSPublicKey(newKey)
+	if err != nil {
+		return err
+	}
+	if len(oldAccount.GetRewardAddress()) > 0 {
+		err = newAccount.SetRewardAddress(oldAccount.GetRewardAddress())
+		if err != nil {
+			return err
+		}
+	}
+
+	newAccount.SetListAndIndex(oldAccount.GetShardId(), oldAccount.GetList(), oldAccount.GetIndexInList())
+	newAccount.SetRating(oldAccount.GetRating())
+	newAccount.SetTempRating(oldAccount.GetTempRating())
+	newAccount.SetUnStakedEpoch(oldAccount.GetUnStakedEpoch())
+	newAccount.SetConsecutiveProposerMisses(oldAccount.GetConsecutiveProposerMisses())
+	newAccount.AddToAccumulatedFees(oldAccount.GetAccumulatedFees())
+
+	err = s.peerAccountsDB.SaveAccount(newAccount)
+	if err != nil {
+		return err
+	}
+
+	// the old account is still needed while the blocks signed with the old key are processed,
+	// it will be removed at the next epoch start
+	oldAccount.SetListAndIndex(oldAccount.GetShardId(), string(core.LeavingList), oldAccount.GetIndexInList())
+
+	return s.peerAccountsDB.SaveAccount(oldAccount)
+}
+
+// removes the peer accounts and validator info of the keys which were replaced at the previous epoch start
+func (s *systemSCProcessor) cleanReplacedValidatorKeys(validatorInfos map[uint32][]*state.ValidatorInfo) error {
+	vmOutput, err := s.executeKeyRotationFunction("cleanReplacedValidatorKeys")
+	if err != nil {
+		return err
+	}
+
+	for _, oldKey := range vmOutput.ReturnData {
+		log.Debug("replaced validator key removed", "key", oldKey)
+
+		err = s.peerAccountsDB.RemoveAccount(oldKey)
+		if err != nil {
+			return err
+		}
+
+		for shardID := range validatorInfos {
+			deleteNewValidatorIfExistsFromMap(validatorInfos, oldKey, shardID)
+		}
+	}
+
+	return nil
+}
+
+func (s *systemSCProcessor) executeKeyRotationFunction(function string) (*vmcommon.VMOutput, error) {
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: s.endOfEpochCallerAddress,
+			Arguments:  make([][]byte, 0),
+			CallValue:  big.NewInt(0),
+		},
+		RecipientAddr: s.stakingSCAddress,
+		Function:      function,
+	}
+
+	vmOutput, err := s.systemVM.RunSmartContractCall(vmInput)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		log.Debug(function, "returnMessage", vmOutput.ReturnMessage)
+		return nil, epochStart.ErrValidatorKeyChangesNotFinalized
+	}
+
+	err = s.processSCOutputAccounts(vmOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput, nil
+}
+
+func changeValidatorKeyInMap(
+	validatorInfos map[uint32][]*state.ValidatorInfo,
+	oldKey []byte,
+	newKey []byte,
+) {
+	for shardID, validatorInfoList := range validatorInfos {
+		for index, validatorInfo := range validatorInfoList {
+			if !bytes.Equal(validatorInfo.PublicKey, oldKey) {
+				continue
+			}
+
+			newValidatorInfo := *validatorInfo
+			newValidatorInfo.PublicKey = newKey
+			validatorInfos[shardID][index] = &newValidatorInfo
+			return
+		}
+	}
+}
+
 func (s *systemSCProcessor) computeNumWaitingPerShard(validatorInfos map[uint32][]*state.ValidatorInfo) error {
 	for shardID, validatorInfoList := range validatorInfos {
 		totalInWaiting := uint32(0)
@@ -460,6 +743,111 @@ func (s *systemSCProcessor) setMinNumberOfNodes(minNumNodes uint32) error {
 	return nil
 }
 
+// ProcessEquivocationProofs calls the slash function of the staking system smart contract for each leader which was
+// proven to sign different headers in the same round. The proofs should be verified before being provided. Each
+// leader is slashed at most once per call and each proof is processed at most once, so the proofs which were
+// actually applied are returned.
+func (s *systemSCProcessor) ProcessEquivocationProofs(
+	proofs []*consensus.EquivocationProof,
+	slashValue *big.Int,
+) ([]*consensus.EquivocationProof, error) {
+	if slashValue == nil || slashValue.Sign() <= 0 {
+		return nil, epochStart.ErrInvalidSlashValue
+	}
+
+	appliedProofs := make([]*consensus.EquivocationProof, 0, len(proofs))
+	slashedKeys := make(map[string]struct{})
+	for _, proof := range proofs {
+		if proof == nil {
+			continue
+		}
+		if _, ok := slashedKeys[string(proof.PubKey)]; ok {
+			continue
+		}
+
+		isSlashed, err := s.slashValidator(proof.PubKey, proof.ID(), slashValue)
+		if err != nil {
+			return nil, err
+		}
+		if !isSlashed {
+			continue
+		}
+
+		slashedKeys[string(proof.PubKey)] = struct{}{}
+		appliedProofs = append(appliedProofs, proof)
+	}
+
+	return appliedProofs, nil
+}
+
+// IsEquivocationProofApplied returns true if the staking system smart contract already processed the provided evidence
+func (s *systemSCProcessor) IsEquivocationProofApplied(evidenceID []byte) bool {
+	stakingSCAccount, err := s.getExistingAccount(s.stakingSCAddress)
+	if err != nil {
+		return false
+	}
+
+	evidenceKey := append([]byte(systemSmartContracts.SlashedEvidencePrefix), evidenceID...)
+	value, err := stakingSCAccount.DataTrieTracker().RetrieveValue(evidenceKey)
+
+	return err == nil && len(value) > 0
+}
+
+func (s *systemSCProcessor) slashValidator(blsPubKey []byte, evidenceID []byte, slashValue *big.Int) (bool, error) {
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: s.endOfEpochCallerAddress,
+			Arguments:  [][]byte{blsPubKey, slashValue.Bytes(), evidenceID},
+			CallValue:  big.NewInt(0),
+		},
+		RecipientAddr: s.stakingSCAddress,
+		Function:      "slash",
+	}
+
+	vmOutput, err := s.systemVM.RunSmartContractCall(vmInput)
+	if err != nil {
+		return false, err
+	}
+
+	log.Debug("slash called for",
+		"key", blsPubKey,
+		"slash value", slashValue,
+		"returnMessage", vmOutput.ReturnMessage)
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		// the key might not be staked anymore or the evidence was already processed, so the other validators
+		// should be slashed anyway
+		return false, nil
+	}
+
+	err = s.processSCOutputAccounts(vmOutput)
+	if err != nil {
+		return false, err
+	}
+
+	return true, s.jailSlashedValidator(blsPubKey)
+}
+
+// the slashed validator is moved to the leaving list, the same way the jailed validators are handled when the staking
+// data is changed by a transaction
+func (s *systemSCProcessor) jailSlashedValidator(blsPubKey []byte) error {
+	account, err := s.getPeerAccount(blsPubKey)
+	if err != nil {
+		return err
+	}
+	if len(account.GetBLSPublicKey()) == 0 {
+		log.Debug("no peer account for the slashed validator", "key", blsPubKey)
+		return nil
+	}
+	if account.GetList() == string(core.InactiveList) {
+		return nil
+	}
+
+	account.SetListAndIndex(account.GetShardId(), string(core.LeavingList), account.GetIndexInList())
+	account.SetTempRating(s.jailRating)
+
+	return s.peerAccountsDB.SaveAccount(account)
+}
+
 // IsInterfaceNil returns true if underlying object is nil
 func (s *systemSCProcessor) IsInterfaceNil() bool {
 	return s == nil
@@ -471,7 +859,11 @@ func (s *systemSCProcessor) EpochConfirmed(epoch uint32) {
 
 	// only toggle on exact epoch. In future epochs the config should have already been synchronized from peers
 	s.flagHystNodesEnabled.Toggle(epoch == s.hystNodesEnableEpoch)
+	s.flagKeyRotationEnabled.Toggle(epoch >= s.keyRotationEnableEpoch)
+	s.flagDelegationEnabled.Toggle(epoch >= s.delegationEnableEpoch)
 	log.Debug("systemSCProcessor: switch jail with waiting", "enabled", s.flagSwitchEnabled.IsSet())
 	log.Debug("systemProcessor: consider also (minimum) hysteresis nodes for minimum number of nodes",
 		"enabled", s.flagHystNodesEnabled.IsSet())
+	log.Debug("systemSCProcessor: validator key rotation", "enabled", s.flagKeyRotationEnabled.IsSet())
+	log.Debug("systemSCProcessor: delegation system smart contracts", "enabled", s.flagDelegationEnabled.IsSet())
 }
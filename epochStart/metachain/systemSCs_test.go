@@ -2,17 +2,20 @@ package metachain
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"strconv"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/state/factory"
 	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
 	"github.com/ElrondNetwork/elrond-go/genesis/process/disabled"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -28,6 +31,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts/defaults"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -103,6 +107,357 @@ func TestSystemSCProcessor_JailedNodesShouldNotBeSwappedAllAtOnce(t *testing.T)
 	}
 }
 
+func TestSystemSCProcessor_ProcessEquivocationProofsInvalidSlashValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createFullArgumentsForSystemSCProcessing()
+	s, _ := NewSystemSCProcessor(args)
+
+	proofs := []*consensus.EquivocationProof{{PubKey: []byte("stakedPubKey0")}}
+	appliedProofs, err := s.ProcessEquivocationProofs(proofs, nil)
+	assert.Equal(t, epochStart.ErrInvalidSlashValue, err)
+	assert.Nil(t, appliedProofs)
+
+	appliedProofs, err = s.ProcessEquivocationProofs(proofs, big.NewInt(0))
+	assert.Equal(t, epochStart.ErrInvalidSlashValue, err)
+	assert.Nil(t, appliedProofs)
+}
+
+func TestSystemSCProcessor_ProcessEquivocationProofsShouldSlashEachLeaderOnce(t *testing.T) {
+	t.Parallel()
+
+	args := createFullArgumentsForSystemSCProcessing()
+	s, _ := NewSystemSCProcessor(args)
+
+	stakedKey := []byte("stakedPubKey0")
+	prepareStakingContractWithData(args.UserAccountsDB, stakedKey, []byte("waitingPubKey"), args.Marshalizer)
+
+	slashValue := big.NewInt(10)
+	proofs := []*consensus.EquivocationProof{
+		{PubKey: stakedKey, Round: 10},
+		{PubKey: stakedKey, Round: 11},
+		{PubKey: []byte("notStakedPubKey"), Round: 11},
+	}
+	appliedProofs, err := s.ProcessEquivocationProofs(proofs, slashValue)
+	require.Nil(t, err)
+	assert.Equal(t, proofs[:1], appliedProofs)
+
+	stakingSCAcc := createStakingScAcc(args.UserAccountsDB)
+	marshaledData, err := stakingSCAcc.DataTrieTracker().RetrieveValue(stakedKey)
+	require.Nil(t, err)
+	stakedData := &systemSmartContracts.StakedDataV2{}
+	err = args.Marshalizer.Unmarshal(stakedData, marshaledData)
+	require.Nil(t, err)
+
+	assert.Equal(t, slashValue, stakedData.SlashValue)
+	assert.True(t, stakedData.Jailed)
+
+	assert.True(t, s.IsEquivocationProofApplied(proofs[0].ID()))
+	assert.False(t, s.IsEquivocationProofApplied(proofs[1].ID()))
+	assert.False(t, s.IsEquivocationProofApplied(proofs[2].ID()))
+}
+
+func TestSystemSCProcessor_ProcessEquivocationProofsShouldNotApplySameProofTwice(t *testing.T) {
+	t.Parallel()
+
+	args := createFullArgumentsForSystemSCProcessing()
+	s, _ := NewSystemSCProcessor(args)
+
+	stakedKey := []byte("stakedPubKey0")
+	prepareStakingContractWithData(args.UserAccountsDB, stakedKey, []byte("waitingPubKey"), args.Marshalizer)
+
+	peerAcc, _ := s.getPeerAccount(stakedKey)
+	_ = peerAcc.SetBLSPublicKey(stakedKey)
+	peerAcc.SetListAndIndex(0, string(core.EligibleList), 2)
+	peerAcc.SetTempRating(50)
+	_ = args.PeerAccountsDB.SaveAccount(peerAcc)
+
+	slashValue := big.NewInt(10)
+	proofs := []*consensus.EquivocationProof{{PubKey: stakedKey, Round: 10}}
+	appliedProofs, err := s.ProcessEquivocationProofs(proofs, slashValue)
+	require.Nil(t, err)
+	assert.Equal(t, proofs, appliedProofs)
+
+	peerAcc, _ = s.getPeerAccount(stakedKey)
+	assert.Equal(t, string(core.LeavingList), peerAcc.GetList())
+	assert.Equal(t, uint32(1), peerAcc.GetTempRating())
+
+	appliedProofs, err = s.ProcessEquivocationProofs(proofs, slashValue)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(appliedProofs))
+
+	stakingSCAcc := createStakingScAcc(args.UserAccountsDB)
+	marshaledData, _ := stakingSCAcc.DataTrieTracker().RetrieveValue(stakedKey)
+	stakedData := &systemSmartContracts.StakedDataV2{}
+	_ = args.Marshalizer.Unmarshal(stakedData, marshaledData)
+	assert.Equal(t, slashValue, stakedData.SlashValue)
+}
+
+func TestSystemSCProcessor_ProcessSystemSmartContractShouldChangeValidatorKeys(t *testing.T) {
+	t.Parallel()
+
+	args := createFullArgumentsForSystemSCProcessing()
+	s, _ := NewSystemSCProcessor(args)
+
+	oldKey := []byte("oldPubKey0")
+	newKey := []byte("newPubKey0")
+	prepareStakingContractWithPendingKeyChange(args.UserAccountsDB, oldKey, newKey, args.Marshalizer)
+
+	peerAcc, _ := s.getPeerAccount(oldKey)
+	_ = peerAcc.SetBLSPublicKey(oldKey)
+	_ = peerAcc.SetRewardAddress([]byte("rewardAddress"))
+	peerAcc.SetListAndIndex(1, string(core.EligibleList), 3)
+	peerAcc.SetRating(70)
+	peerAcc.SetTempRating(60)
+	_ = args.PeerAccountsDB.SaveAccount(peerAcc)
+
+	validatorInfos := make(map[uint32][]*state.ValidatorInfo)
+	validatorInfos[1] = append(validatorInfos[1], &state.ValidatorInfo{
+		PublicKey:       oldKey,
+		ShardId:         1,
+		List:            string(core.EligibleList),
+		Index:           3,
+		TempRating:      60,
+		RewardAddress:   []byte("rewardAddress"),
+		AccumulatedFees: big.NewInt(0),
+	})
+	err := s.ProcessSystemSmartContract(validatorInfos)
+	require.Nil(t, err)
+
+	require.Equal(t, 1, len(validatorInfos[1]))
+	assert.Equal(t, newKey, validatorInfos[1][0].PublicKey)
+	assert.Equal(t, string(core.EligibleList), validatorInfos[1][0].List)
+	assert.Equal(t, uint32(3), validatorInfos[1][0].Index)
+
+	oldPeerAcc, err := s.getPeerAccount(oldKey)
+	require.Nil(t, err)
+	assert.Equal(t, oldKey, oldPeerAcc.GetBLSPublicKey())
+	assert.Equal(t, string(core.LeavingList), oldPeerAcc.GetList())
+	newPeerAcc, err := s.getPeerAccount(newKey)
+	require.Nil(t, err)
+	assert.Equal(t, newKey, newPeerAcc.GetBLSPublicKey())
+	assert.Equal(t, []byte("rewardAddress"), newPeerAcc.GetRewardAddress())
+	assert.Equal(t, uint32(1), newPeerAcc.GetShardId())
+	assert.Equal(t, string(core.EligibleList), newPeerAcc.GetList())
+	assert.Equal(t, uint32(3), newPeerAcc.GetIndexInList())
+	assert.Equal(t, uint32(70), newPeerAcc.GetRating())
+	assert.Equal(t, uint32(60), newPeerAcc.GetTempRating())
+
+	stakingSCAcc := createStakingScAcc(args.UserAccountsDB)
+	marshaledData, _ := stakingSCAcc.DataTrieTracker().RetrieveValue(oldKey)
+	assert.Equal(t, 0, len(marshaledData))
+	marshaledData, _ = stakingSCAcc.DataTrieTracker().RetrieveValue(newKey)
+	assert.True(t, len(marshaledData) > 0)
+	marshaledData, _ = stakingSCAcc.DataTrieTracker().RetrieveValue([]byte("pendingKeyChanges"))
+	assert.Equal(t, 0, len(marshaledData))
+	assert.Equal(t, [][]byte{newKey}, getAuctionRegisteredKeys(t, args.UserAccountsDB, args.Marshalizer))
+
+	validatorInfos[1] = append(validatorInfos[1], &state.ValidatorInfo{
+		PublicKey:       oldKey,
+		ShardId:         1,
+		List:            string(core.LeavingList),
+		Index:           3,
+		AccumulatedFees: big.NewInt(0),
+	})
+	err = s.ProcessSystemSmartContract(validatorInfos)
+	require.Nil(t, err)
+
+	require.Equal(t, 1, len(validatorInfos[1]))
+	assert.Equal(t, newKey, validatorInfos[1][0].PublicKey)
+	_, err = args.PeerAccountsDB.GetExistingAccount(oldKey)
+	assert.NotNil(t, err)
+	_, err = args.PeerAccountsDB.GetExistingAccount(newKey)
+	assert.Nil(t, err)
+}
+
+func TestSystemSCProcessor_FinalizeValidatorKeyChangesShouldMoveThePeerAccount(t *testing.T) {
+	t.Parallel()
+
+	args := createFullArgumentsForSystemSCProcessing()
+	s, _ := NewSystemSCProcessor(args)
+
+	oldKey := []byte("oldPubKey0")
+	newKey := []byte("newPubKey0")
+	prepareStakingContractWithPendingKeyChange(args.UserAccountsDB, oldKey, newKey, args.Marshalizer)
+
+	peerAcc, _ := s.getPeerAccount(oldKey)
+	_ = peerAcc.SetBLSPublicKey(oldKey)
+	_ = peerAcc.SetRewardAddress([]byte("rewardAddress"))
+	peerAcc.SetListAndIndex(2, string(core.WaitingList), 5)
+	peerAcc.SetRating(80)
+	peerAcc.SetTempRating(75)
+	peerAcc.SetUnStakedEpoch(4)
+	peerAcc.SetConsecutiveProposerMisses(2)
+	peerAcc.AddToAccumulatedFees(big.NewInt(37))
+	_ = args.PeerAccountsDB.SaveAccount(peerAcc)
+	_, _ = args.PeerAccountsDB.Commit()
+
+	validatorInfos := make(map[uint32][]*state.ValidatorInfo)
+	validatorInfos[2] = append(validatorInfos[2], &state.ValidatorInfo{
+		PublicKey:       oldKey,
+		ShardId:         2,
+		List:            string(core.WaitingList),
+		Index:           5,
+		AccumulatedFees: big.NewInt(37),
+	})
+	err := s.finalizeValidatorKeyChanges(validatorInfos)
+	require.Nil(t, err)
+
+	_, err = args.PeerAccountsDB.Commit()
+	require.Nil(t, err)
+
+	require.Equal(t, 1, len(validatorInfos[2]))
+	assert.Equal(t, newKey, validatorInfos[2][0].PublicKey)
+
+	acc, err := args.PeerAccountsDB.GetExistingAccount(newKey)
+	require.Nil(t, err)
+	newPeerAcc := acc.(state.PeerAccountHandler)
+	assert.Equal(t, newKey, newPeerAcc.GetBLSPublicKey())
+	assert.Equal(t, []byte("rewardAddress"), newPeerAcc.GetRewardAddress())
+	assert.Equal(t, uint32(2), newPeerAcc.GetShardId())
+	assert.Equal(t, string(core.WaitingList), newPeerAcc.GetList())
+	assert.Equal(t, uint32(5), newPeerAcc.GetIndexInList())
+	assert.Equal(t, uint32(80), newPeerAcc.GetRating())
+	assert.Equal(t, uint32(75), newPeerAcc.GetTempRating())
+	assert.Equal(t, uint32(4), newPeerAcc.GetUnStakedEpoch())
+	assert.Equal(t, uint32(2), newPeerAcc.GetConsecutiveProposerMisses())
+	assert.Equal(t, big.NewInt(37), newPeerAcc.GetAccumulatedFees())
+
+	acc, err = args.PeerAccountsDB.GetExistingAccount(oldKey)
+	require.Nil(t, err)
+	oldPeerAcc := acc.(state.PeerAccountHandler)
+	assert.Equal(t, string(core.LeavingList), oldPeerAcc.GetList())
+	assert.Equal(t, uint32(2), oldPeerAcc.GetShardId())
+}
+
+func TestSystemSCProcessor_ChangePeerAccountKeyWithoutOldPeerAccountShouldNotCreateAccounts(t *testing.T) {
+	t.Parallel()
+
+	args := createFullArgumentsForSystemSCProcessing()
+	s, _ := NewSystemSCProcessor(args)
+
+	err := s.changePeerAccountKey([]byte("oldPubKey0"), []byte("newPubKey0"))
+	require.Nil(t, err)
+
+	_, err = args.PeerAccountsDB.GetExistingAccount([]byte("newPubKey0"))
+	assert.NotNil(t, err)
+}
+
+func TestSystemSCProcessor_ProcessSystemSmartContractKeyRotationNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	args := createFullArgumentsForSystemSCProcessing()
+	args.ValidatorKeyRotationEnableEpoch = 10
+	s, _ := NewSystemSCProcessor(args)
+
+	oldKey := []byte("oldPubKey0")
+	newKey := []byte("newPubKey0")
+	prepareStakingContractWithPendingKeyChange(args.UserAccountsDB, oldKey, newKey, args.Marshalizer)
+
+	validatorInfos := make(map[uint32][]*state.ValidatorInfo)
+	validatorInfos[0] = append(validatorInfos[0], &state.ValidatorInfo{
+		PublicKey:       oldKey,
+		List:            string(core.EligibleList),
+		AccumulatedFees: big.NewInt(0),
+	})
+	err := s.ProcessSystemSmartContract(validatorInfos)
+	require.Nil(t, err)
+
+	assert.Equal(t, oldKey, validatorInfos[0][0].PublicKey)
+	stakingSCAcc := createStakingScAcc(args.UserAccountsDB)
+	marshaledData, _ := stakingSCAcc.DataTrieTracker().RetrieveValue(oldKey)
+	assert.True(t, len(marshaledData) > 0)
+	assert.Equal(t, [][]byte{oldKey}, getAuctionRegisteredKeys(t, args.UserAccountsDB, args.Marshalizer))
+}
+
+func TestSystemSCProcessor_ProcessSystemSmartContractShouldDeployDelegationSystemSCs(t *testing.T) {
+	t.Parallel()
+
+	args := createFullArgumentsForSystemSCProcessing()
+	s, _ := NewSystemSCProcessor(args)
+	prepareStakingContractWithData(args.UserAccountsDB, []byte("stakedPubKey0"), []byte("waitingPubKey"), args.Marshalizer)
+
+	_, err := args.UserAccountsDB.GetExistingAccount(vm.DelegationManagerSCAddress)
+	require.NotNil(t, err)
+
+	err = s.ProcessSystemSmartContract(make(map[uint32][]*state.ValidatorInfo))
+	require.Nil(t, err)
+
+	acc, err := args.UserAccountsDB.GetExistingAccount(vm.DelegationManagerSCAddress)
+	require.Nil(t, err)
+	managementData, _ := acc.(state.UserAccountHandler).DataTrieTracker().RetrieveValue([]byte("delegationManagement"))
+	assert.True(t, len(managementData) > 0)
+	_, err = args.UserAccountsDB.GetExistingAccount(vm.FirstDelegationSCAddress)
+	assert.Nil(t, err)
+
+	err = s.ProcessSystemSmartContract(make(map[uint32][]*state.ValidatorInfo))
+	assert.Nil(t, err)
+}
+
+func TestSystemSCProcessor_ProcessSystemSmartContractDelegationNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	args := createFullArgumentsForSystemSCProcessing()
+	args.DelegationEnableEpoch = 10
+	s, _ := NewSystemSCProcessor(args)
+	prepareStakingContractWithData(args.UserAccountsDB, []byte("stakedPubKey0"), []byte("waitingPubKey"), args.Marshalizer)
+
+	err := s.ProcessSystemSmartContract(make(map[uint32][]*state.ValidatorInfo))
+	require.Nil(t, err)
+
+	_, err = args.UserAccountsDB.GetExistingAccount(vm.DelegationManagerSCAddress)
+	assert.NotNil(t, err)
+	_, err = args.UserAccountsDB.GetExistingAccount(vm.FirstDelegationSCAddress)
+	assert.NotNil(t, err)
+}
+
+func TestSystemSCProcessor_ProcessDelegationRewardsShouldUpdateThePoolRewards(t *testing.T) {
+	t.Parallel()
+
+	args := createFullArgumentsForSystemSCProcessing()
+	s, _ := NewSystemSCProcessor(args)
+	prepareStakingContractWithData(args.UserAccountsDB, []byte("stakedPubKey0"), []byte("waitingPubKey"), args.Marshalizer)
+
+	err := s.ProcessSystemSmartContract(make(map[uint32][]*state.ValidatorInfo))
+	require.Nil(t, err)
+
+	owner := []byte("delegation pool owner")
+	maxServiceFee := big.NewInt(10000)
+	vmOutput, err := args.SystemVM.RunSmartContractCall(&vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  owner,
+			Arguments:   [][]byte{big.NewInt(0).Bytes(), maxServiceFee.Bytes()},
+			CallValue:   big.NewInt(1000),
+			GasProvided: math.MaxUint64,
+		},
+		RecipientAddr: vm.DelegationManagerSCAddress,
+		Function:      "createNewDelegationContract",
+	})
+	require.Nil(t, err)
+	require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+	poolAddress := vm.CreateDelegationSCAddress(1)
+	poolAcc, _ := args.UserAccountsDB.LoadAccount(poolAddress)
+	_ = args.UserAccountsDB.SaveAccount(poolAcc)
+	err = s.processSCOutputAccounts(vmOutput)
+	require.Nil(t, err)
+
+	rewards := map[string]*big.Int{
+		string(poolAddress): big.NewInt(500),
+	}
+	err = s.ProcessDelegationRewards(rewards)
+	require.Nil(t, err)
+
+	acc, _ := args.UserAccountsDB.GetExistingAccount(poolAddress)
+	poolUserAcc := acc.(state.UserAccountHandler)
+	assert.Equal(t, big.NewInt(500), poolUserAcc.GetBalance())
+
+	marshaledOwnerData, _ := poolUserAcc.DataTrieTracker().RetrieveValue(owner)
+	ownerData := &systemSmartContracts.DelegatorData{}
+	_ = args.Marshalizer.Unmarshal(ownerData, marshaledOwnerData)
+	assert.Equal(t, big.NewInt(500), ownerData.UnClaimedRewards)
+}
+
 func createStakingScAcc(accountsDB state.AccountsAdapter) state.UserAccountHandler {
 	acc, _ := accountsDB.LoadAccount(vm.StakingSCAddress)
 	stakingSCAcc := acc.(state.UserAccountHandler)
@@ -246,6 +601,59 @@ func prepareStakingContractWithData(
 	_ = accountsDB.SaveAccount(stakingSCAcc)
 }
 
+func prepareStakingContractWithPendingKeyChange(
+	accountsDB state.AccountsAdapter,
+	oldKey []byte,
+	newKey []byte,
+	marshalizer marshal.Marshalizer,
+) {
+	stakingSCAcc := createStakingScAcc(accountsDB)
+
+	stakedData := &systemSmartContracts.StakedDataV2{
+		Staked:        true,
+		RewardAddress: []byte("rewardAddress"),
+		StakeValue:    big.NewInt(100),
+	}
+	marshaledData, _ := marshalizer.Marshal(stakedData)
+	stakingSCAcc.DataTrieTracker().SaveKeyValue(oldKey, marshaledData)
+
+	pendingChanges := &systemSmartContracts.PendingValidatorKeyChanges{
+		Changes: []*systemSmartContracts.ValidatorKeyChange{{OldKey: oldKey, NewKey: newKey}},
+	}
+	marshaledData, _ = marshalizer.Marshal(pendingChanges)
+	stakingSCAcc.DataTrieTracker().SaveKeyValue([]byte("pendingKeyChanges"), marshaledData)
+
+	_ = accountsDB.SaveAccount(stakingSCAcc)
+
+	acc, _ := accountsDB.LoadAccount(vm.AuctionSCAddress)
+	auctionSCAcc := acc.(state.UserAccountHandler)
+
+	auctionData := &systemSmartContracts.AuctionData{
+		RewardAddress:   []byte("rewardAddress"),
+		TotalStakeValue: big.NewInt(100),
+		LockedStake:     big.NewInt(100),
+		MaxStakePerNode: big.NewInt(100),
+		BlsPubKeys:      [][]byte{oldKey},
+	}
+	marshaledData, _ = marshalizer.Marshal(auctionData)
+	auctionSCAcc.DataTrieTracker().SaveKeyValue([]byte("ownerAddress"), marshaledData)
+	auctionSCAcc.DataTrieTracker().SaveKeyValue(append([]byte("pendingKeyChangeOwner_"), oldKey...), []byte("ownerAddress"))
+
+	_ = accountsDB.SaveAccount(auctionSCAcc)
+}
+
+func getAuctionRegisteredKeys(t *testing.T, accountsDB state.AccountsAdapter, marshalizer marshal.Marshalizer) [][]byte {
+	acc, _ := accountsDB.LoadAccount(vm.AuctionSCAddress)
+	auctionSCAcc := acc.(state.UserAccountHandler)
+
+	marshaledData, _ := auctionSCAcc.DataTrieTracker().RetrieveValue([]byte("ownerAddress"))
+	auctionData := &systemSmartContracts.AuctionData{}
+	err := marshalizer.Unmarshal(auctionData, marshaledData)
+	require.Nil(t, err)
+
+	return auctionData.BlsPubKeys
+}
+
 func createAccountsDB(
 	hasher hashing.Hasher,
 	marshalizer marshal.Marshalizer,
@@ -317,6 +725,13 @@ func createFullArgumentsForSystemSCProcessing() ArgsNewEpochStartSystemSCProcess
 				MinPassThreshold: 50,
 				MinVetoThreshold: 50,
 			},
+			DelegationManagerSystemSCConfig: config.DelegationManagerSystemSCConfig{
+				MinCreationDeposit: "100",
+			},
+			DelegationSystemSCConfig: config.DelegationSystemSCConfig{
+				MinDelegationAmount: "1",
+				MaxServiceFee:       10000,
+			},
 			StakingSystemSCConfig: config.StakingSystemSCConfig{
 				GenesisNodePrice:                     "1000",
 				UnJailValue:                          "10",
@@ -346,6 +761,7 @@ func createFullArgumentsForSystemSCProcessing() ArgsNewEpochStartSystemSCProcess
 		PeerAccountsDB:          peerAccountsDB,
 		Marshalizer:             marshalizer,
 		StartRating:             5,
+		JailRating:              1,
 		ValidatorInfoCreator:    vCreator,
 		EndOfEpochCallerAddress: vm.EndOfEpochAddress,
 		StakingSCAddress:        vm.StakingSCAddress,
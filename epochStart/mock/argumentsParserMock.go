@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/ElrondNetwork/elrond-vm-common/parsers"
 )
@@ -11,6 +12,7 @@ type ArgumentParserMock struct {
 	ParseDeployDataCalled             func(data string) (*parsers.DeployArgs, error)
 	CreateDataFromStorageUpdateCalled func(storageUpdates []*vmcommon.StorageUpdate) string
 	GetStorageUpdatesCalled           func(data string) ([]*vmcommon.StorageUpdate, error)
+	ParseMultiESDTTransferDataCalled  func(arguments [][]byte) (*process.ParsedMultiESDTTransfer, error)
 }
 
 // ParseData -
@@ -29,6 +31,14 @@ func (ap *ArgumentParserMock) ParseDeployData(data string) (*parsers.DeployArgs,
 	return ap.ParseDeployDataCalled(data)
 }
 
+// ParseMultiESDTTransferData -
+func (ap *ArgumentParserMock) ParseMultiESDTTransferData(arguments [][]byte) (*process.ParsedMultiESDTTransfer, error) {
+	if ap.ParseMultiESDTTransferDataCalled == nil {
+		return &process.ParsedMultiESDTTransfer{}, nil
+	}
+	return ap.ParseMultiESDTTransferDataCalled(arguments)
+}
+
 // CreateDataFromStorageUpdate -
 func (ap *ArgumentParserMock) CreateDataFromStorageUpdate(storageUpdates []*vmcommon.StorageUpdate) string {
 	if ap.CreateDataFromStorageUpdateCalled == nil {
@@ -2,8 +2,10 @@ package facade
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -11,6 +13,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -28,6 +33,18 @@ type NodeHandler interface {
 	// GetValueForKey returns the value of a key from a given account
 	GetValueForKey(address string, key string) (string, error)
 
+	// GetESDTNFTToken returns the non-fungible token with the given nonce held by an account
+	GetESDTNFTToken(address string, tokenName string, nonce uint64) (*builtInFunctions.ESDigitalToken, error)
+
+	// GetAllESDTTokens returns all the esdt tokens held by an account
+	GetAllESDTTokens(address string) (map[string]*builtInFunctions.ESDigitalToken, error)
+
+	// GetAllIssuedESDTs returns all the esdt tokens issued through the esdt system smart contract
+	GetAllIssuedESDTs() (map[string]*systemSmartContracts.ESDTData, error)
+
+	// GetIssuedESDT returns the esdt token with the given name as stored by the esdt system smart contract
+	GetIssuedESDT(tokenName string) (*systemSmartContracts.ESDTData, error)
+
 	//CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value string, receiverHex string, senderHex string, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32) (*transaction.Transaction, []byte, error)
@@ -49,6 +66,12 @@ type NodeHandler interface {
 	// GetHeartbeats returns the heartbeat status for each public key defined in genesis.json
 	GetHeartbeats() []data.PubKeyHeartbeat
 
+	// GetValidatorUptime returns the availability report of a validator between the provided epochs
+	GetValidatorUptime(pubKey string, fromEpoch uint32, toEpoch uint32) (*data.UptimeReport, error)
+
+	// GetSlashingHistory returns the slashing records applied by the metachain
+	GetSlashingHistory() ([]*slashing.SlashingRecordApiResponse, error)
+
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 
@@ -62,6 +85,12 @@ type NodeHandler interface {
 
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetP2PTopology() (*core.QueryP2PTopology, error)
+	GetPeersBlacklist() ([]core.PeerReputationInfo, error)
+	BanPeer(recordType string, identifier string, duration time.Duration, reason string) error
+	UnbanPeer(recordType string, identifier string) error
+	GetAntifloodLimits() config.AntifloodLimitsOverrideConfig
+	SetAntifloodLimits(limits config.AntifloodLimitsOverrideConfig, source string) error
 
 	GetBlockByHash(hash string, withTxs bool) (*block.APIBlock, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*block.APIBlock, error)
@@ -78,6 +107,7 @@ type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
 	StatusMetrics() external.StatusMetricsHandler
+	GetActiveBuiltInFunctions() []process.BuiltInFunctionInfo
 	IsInterfaceNil() bool
 }
 
@@ -12,6 +12,7 @@ type ApiResolverStub struct {
 	ExecuteSCQueryHandler             func(query *process.SCQuery) (*vmcommon.VMOutput, error)
 	StatusMetricsHandler              func() external.StatusMetricsHandler
 	ComputeTransactionGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
+	GetActiveBuiltInFunctionsHandler  func() []process.BuiltInFunctionInfo
 }
 
 // ExecuteSCQuery -
@@ -29,6 +30,15 @@ func (ars *ApiResolverStub) ComputeTransactionGasLimit(tx *transaction.Transacti
 	return ars.ComputeTransactionGasLimitHandler(tx)
 }
 
+// GetActiveBuiltInFunctions -
+func (ars *ApiResolverStub) GetActiveBuiltInFunctions() []process.BuiltInFunctionInfo {
+	if ars.GetActiveBuiltInFunctionsHandler != nil {
+		return ars.GetActiveBuiltInFunctionsHandler()
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ars *ApiResolverStub) IsInterfaceNil() bool {
 	return ars == nil
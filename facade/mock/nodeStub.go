@@ -3,13 +3,18 @@ package mock
 import (
 	"encoding/hex"
 	"math/big"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go/api/block"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 )
 
 // NodeStub -
@@ -30,12 +35,24 @@ type NodeStub struct {
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	GetHeartbeatsHandler                           func() []data.PubKeyHeartbeat
+	GetValidatorUptimeCalled                       func(pubKey string, fromEpoch uint32, toEpoch uint32) (*data.UptimeReport, error)
+	GetSlashingHistoryCalled                       func() ([]*slashing.SlashingRecordApiResponse, error)
 	ValidatorStatisticsApiCalled                   func() (map[string]*state.ValidatorApiResponse, error)
 	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTriggerCalled                            func() bool
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                           func(address string, key string) (string, error)
+	GetESDTNFTTokenCalled                          func(address string, tokenName string, nonce uint64) (*builtInFunctions.ESDigitalToken, error)
+	GetAllESDTTokensCalled                         func(address string) (map[string]*builtInFunctions.ESDigitalToken, error)
+	GetAllIssuedESDTsCalled                        func() (map[string]*systemSmartContracts.ESDTData, error)
+	GetIssuedESDTCalled                            func(tokenName string) (*systemSmartContracts.ESDTData, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetP2PTopologyCalled                           func() (*core.QueryP2PTopology, error)
+	GetPeersBlacklistCalled                        func() ([]core.PeerReputationInfo, error)
+	BanPeerCalled                                  func(recordType string, identifier string, duration time.Duration, reason string) error
+	UnbanPeerCalled                                func(recordType string, identifier string) error
+	GetAntifloodLimitsCalled                       func() config.AntifloodLimitsOverrideConfig
+	SetAntifloodLimitsCalled                       func(limits config.AntifloodLimitsOverrideConfig, source string) error
 	GetBlockByHashCalled                           func(hash string, withTxs bool) (*block.APIBlock, error)
 	GetBlockByNonceCalled                          func(nonce uint64, withTxs bool) (*block.APIBlock, error)
 	GetUsernameCalled                              func(address string) (string, error)
@@ -59,6 +76,42 @@ func (ns *NodeStub) GetValueForKey(address string, key string) (string, error) {
 	return "", nil
 }
 
+// GetESDTNFTToken -
+func (ns *NodeStub) GetESDTNFTToken(address string, tokenName string, nonce uint64) (*builtInFunctions.ESDigitalToken, error) {
+	if ns.GetESDTNFTTokenCalled != nil {
+		return ns.GetESDTNFTTokenCalled(address, tokenName, nonce)
+	}
+
+	return &builtInFunctions.ESDigitalToken{}, nil
+}
+
+// GetAllESDTTokens -
+func (ns *NodeStub) GetAllESDTTokens(address string) (map[string]*builtInFunctions.ESDigitalToken, error) {
+	if ns.GetAllESDTTokensCalled != nil {
+		return ns.GetAllESDTTokensCalled(address)
+	}
+
+	return make(map[string]*builtInFunctions.ESDigitalToken), nil
+}
+
+// GetAllIssuedESDTs -
+func (ns *NodeStub) GetAllIssuedESDTs() (map[string]*systemSmartContracts.ESDTData, error) {
+	if ns.GetAllIssuedESDTsCalled != nil {
+		return ns.GetAllIssuedESDTsCalled()
+	}
+
+	return make(map[string]*systemSmartContracts.ESDTData), nil
+}
+
+// GetIssuedESDT -
+func (ns *NodeStub) GetIssuedESDT(tokenName string) (*systemSmartContracts.ESDTData, error) {
+	if ns.GetIssuedESDTCalled != nil {
+		return ns.GetIssuedESDTCalled(tokenName)
+	}
+
+	return &systemSmartContracts.ESDTData{}, nil
+}
+
 // EncodeAddressPubkey -
 func (ns *NodeStub) EncodeAddressPubkey(pk []byte) (string, error) {
 	return hex.EncodeToString(pk), nil
@@ -126,6 +179,24 @@ func (ns *NodeStub) GetHeartbeats() []data.PubKeyHeartbeat {
 	return ns.GetHeartbeatsHandler()
 }
 
+// GetValidatorUptime -
+func (ns *NodeStub) GetValidatorUptime(pubKey string, fromEpoch uint32, toEpoch uint32) (*data.UptimeReport, error) {
+	if ns.GetValidatorUptimeCalled != nil {
+		return ns.GetValidatorUptimeCalled(pubKey, fromEpoch, toEpoch)
+	}
+
+	return nil, nil
+}
+
+// GetSlashingHistory -
+func (ns *NodeStub) GetSlashingHistory() ([]*slashing.SlashingRecordApiResponse, error) {
+	if ns.GetSlashingHistoryCalled != nil {
+		return ns.GetSlashingHistoryCalled()
+	}
+
+	return nil, nil
+}
+
 // ValidatorStatisticsApi -
 func (ns *NodeStub) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error) {
 	return ns.ValidatorStatisticsApiCalled()
@@ -159,6 +230,60 @@ func (ns *NodeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return make([]core.QueryP2PPeerInfo, 0), nil
 }
 
+// GetP2PTopology -
+func (ns *NodeStub) GetP2PTopology() (*core.QueryP2PTopology, error) {
+	if ns.GetP2PTopologyCalled != nil {
+		return ns.GetP2PTopologyCalled()
+	}
+
+	return &core.QueryP2PTopology{}, nil
+}
+
+// GetPeersBlacklist -
+func (ns *NodeStub) GetPeersBlacklist() ([]core.PeerReputationInfo, error) {
+	if ns.GetPeersBlacklistCalled != nil {
+		return ns.GetPeersBlacklistCalled()
+	}
+
+	return make([]core.PeerReputationInfo, 0), nil
+}
+
+// BanPeer -
+func (ns *NodeStub) BanPeer(recordType string, identifier string, duration time.Duration, reason string) error {
+	if ns.BanPeerCalled != nil {
+		return ns.BanPeerCalled(recordType, identifier, duration, reason)
+	}
+
+	return nil
+}
+
+// UnbanPeer -
+func (ns *NodeStub) UnbanPeer(recordType string, identifier string) error {
+	if ns.UnbanPeerCalled != nil {
+		return ns.UnbanPeerCalled(recordType, identifier)
+	}
+
+	return nil
+}
+
+// GetAntifloodLimits -
+func (ns *NodeStub) GetAntifloodLimits() config.AntifloodLimitsOverrideConfig {
+	if ns.GetAntifloodLimitsCalled != nil {
+		return ns.GetAntifloodLimitsCalled()
+	}
+
+	return config.AntifloodLimitsOverrideConfig{}
+}
+
+// SetAntifloodLimits -
+func (ns *NodeStub) SetAntifloodLimits(limits config.AntifloodLimitsOverrideConfig, source string) error {
+	if ns.SetAntifloodLimitsCalled != nil {
+		return ns.SetAntifloodLimitsCalled(limits, source)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ns *NodeStub) IsInterfaceNil() bool {
 	return ns == nil
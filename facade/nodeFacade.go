@@ -2,6 +2,7 @@ package facade
 
 import (
 	"context"
+	"crypto/subtle"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -30,6 +31,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -269,6 +273,26 @@ func (nf *nodeFacade) GetValueForKey(address string, key string) (string, error)
 	return nf.node.GetValueForKey(address, key)
 }
 
+// GetESDTNFTToken returns the non-fungible token with the given nonce held by an account
+func (nf *nodeFacade) GetESDTNFTToken(address string, tokenName string, nonce uint64) (*builtInFunctions.ESDigitalToken, error) {
+	return nf.node.GetESDTNFTToken(address, tokenName, nonce)
+}
+
+// GetAllESDTTokens returns all the esdt tokens held by an account
+func (nf *nodeFacade) GetAllESDTTokens(address string) (map[string]*builtInFunctions.ESDigitalToken, error) {
+	return nf.node.GetAllESDTTokens(address)
+}
+
+// GetAllIssuedESDTs returns all the esdt tokens issued through the esdt system smart contract
+func (nf *nodeFacade) GetAllIssuedESDTs() (map[string]*systemSmartContracts.ESDTData, error) {
+	return nf.node.GetAllIssuedESDTs()
+}
+
+// GetIssuedESDT returns the esdt token with the given name as stored by the esdt system smart contract
+func (nf *nodeFacade) GetIssuedESDT(tokenName string) (*systemSmartContracts.ESDTData, error) {
+	return nf.node.GetIssuedESDT(tokenName)
+}
+
 // CreateTransaction creates a transaction from all needed fields
 func (nf *nodeFacade) CreateTransaction(
 	nonce uint64,
@@ -337,11 +361,26 @@ func (nf *nodeFacade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 	return hbStatus, nil
 }
 
+// GetValidatorUptime returns the availability report of the provided validator public key between the provided epochs
+func (nf *nodeFacade) GetValidatorUptime(pubKey string, fromEpoch uint32, toEpoch uint32) (*data.UptimeReport, error) {
+	return nf.node.GetValidatorUptime(pubKey, fromEpoch, toEpoch)
+}
+
+// GetSlashingHistory returns the slashing records applied by the metachain
+func (nf *nodeFacade) GetSlashingHistory() ([]*slashing.SlashingRecordApiResponse, error) {
+	return nf.node.GetSlashingHistory()
+}
+
 // StatusMetrics will return the node's status metrics
 func (nf *nodeFacade) StatusMetrics() external.StatusMetricsHandler {
 	return nf.apiResolver.StatusMetrics()
 }
 
+// GetActiveBuiltInFunctions returns the built-in functions active in the current epoch together with their gas costs
+func (nf *nodeFacade) GetActiveBuiltInFunctions() []process.BuiltInFunctionInfo {
+	return nf.apiResolver.GetActiveBuiltInFunctions()
+}
+
 // ExecuteSCQuery retrieves data from existing SC trie
 func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
 	vmOutput, err := nf.apiResolver.ExecuteSCQuery(query)
@@ -387,6 +426,47 @@ func (nf *nodeFacade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return nf.node.GetPeerInfo(pid)
 }
 
+// GetP2PTopology returns the connection graph of the node
+func (nf *nodeFacade) GetP2PTopology() (*core.QueryP2PTopology, error) {
+	return nf.node.GetP2PTopology()
+}
+
+// GetPeersBlacklist returns the persisted blacklisting decisions of the peer IDs and public keys
+func (nf *nodeFacade) GetPeersBlacklist() ([]core.PeerReputationInfo, error) {
+	return nf.node.GetPeersBlacklist()
+}
+
+// BanPeer will blacklist the provided peer ID or public key for the provided duration
+func (nf *nodeFacade) BanPeer(recordType string, identifier string, duration time.Duration, reason string) error {
+	return nf.node.BanPeer(recordType, identifier, duration, reason)
+}
+
+// UnbanPeer will remove the provided peer ID or public key from the blacklist
+func (nf *nodeFacade) UnbanPeer(recordType string, identifier string) error {
+	return nf.node.UnbanPeer(recordType, identifier)
+}
+
+// IsAntifloodAdminTokenValid returns true if the provided token matches the configured antiflood admin token.
+// An empty configured token means that the antiflood admin routes are disabled
+func (nf *nodeFacade) IsAntifloodAdminTokenValid(token string) bool {
+	adminToken := nf.config.AntifloodAdminToken
+	if len(adminToken) == 0 {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(token), []byte(adminToken)) == 1
+}
+
+// GetAntifloodLimits returns the antiflood limits currently used by the node
+func (nf *nodeFacade) GetAntifloodLimits() config.AntifloodLimitsOverrideConfig {
+	return nf.node.GetAntifloodLimits()
+}
+
+// SetAntifloodLimits will replace the antiflood limits of the node
+func (nf *nodeFacade) SetAntifloodLimits(limits config.AntifloodLimitsOverrideConfig, source string) error {
+	return nf.node.SetAntifloodLimits(limits, source)
+}
+
 // GetThrottlerForEndpoint returns the throttler for a given endpoint if found
 func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool) {
 	throttlerForEndpoint, ok := nf.endpointsThrottlers[endpoint]
@@ -606,6 +606,84 @@ func TestNodeFacade_GetPeerInfo(t *testing.T) {
 	assert.Equal(t, []core.QueryP2PPeerInfo{pinfo}, val)
 }
 
+func TestNodeFacade_PeersBlacklist(t *testing.T) {
+	t.Parallel()
+
+	info := core.PeerReputationInfo{
+		Type:       "pid",
+		Identifier: "pid",
+	}
+	banCalled := false
+	unbanCalled := false
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetPeersBlacklistCalled: func() ([]core.PeerReputationInfo, error) {
+			return []core.PeerReputationInfo{info}, nil
+		},
+		BanPeerCalled: func(recordType string, identifier string, duration time.Duration, reason string) error {
+			banCalled = true
+			return nil
+		},
+		UnbanPeerCalled: func(recordType string, identifier string) error {
+			unbanCalled = true
+			return nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	val, err := nf.GetPeersBlacklist()
+	assert.Nil(t, err)
+	assert.Equal(t, []core.PeerReputationInfo{info}, val)
+
+	err = nf.BanPeer("pid", "pid", time.Minute, "")
+	assert.Nil(t, err)
+	assert.True(t, banCalled)
+
+	err = nf.UnbanPeer("pid", "pid")
+	assert.Nil(t, err)
+	assert.True(t, unbanCalled)
+}
+
+func TestNodeFacade_IsAntifloodAdminTokenValid(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	nf, _ := NewNodeFacade(arg)
+	assert.False(t, nf.IsAntifloodAdminTokenValid(""))
+
+	arg.FacadeConfig.AntifloodAdminToken = "token"
+	nf, _ = NewNodeFacade(arg)
+	assert.False(t, nf.IsAntifloodAdminTokenValid(""))
+	assert.False(t, nf.IsAntifloodAdminTokenValid("invalid"))
+	assert.True(t, nf.IsAntifloodAdminTokenValid("token"))
+}
+
+func TestNodeFacade_AntifloodLimits(t *testing.T) {
+	t.Parallel()
+
+	limits := config.AntifloodLimitsOverrideConfig{
+		Topic: config.TopicAntifloodConfig{
+			DefaultMaxMessagesPerSec: 10,
+		},
+	}
+	expectedErr := errors.New("expected error")
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetAntifloodLimitsCalled: func() config.AntifloodLimitsOverrideConfig {
+			return limits
+		},
+		SetAntifloodLimitsCalled: func(providedLimits config.AntifloodLimitsOverrideConfig, source string) error {
+			assert.Equal(t, limits, providedLimits)
+			assert.Equal(t, "source", source)
+			return expectedErr
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	assert.Equal(t, limits, nf.GetAntifloodLimits())
+	assert.Equal(t, expectedErr, nf.SetAntifloodLimits(limits, "source"))
+}
+
 func TestNodeFacade_GetThrottlerForEndpointNoConfigShouldReturnNilAndFalse(t *testing.T) {
 	t.Parallel()
 
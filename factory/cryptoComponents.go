@@ -33,6 +33,7 @@ const disabledSigChecking = "disabled"
 // CryptoComponentsFactoryArgs holds the arguments needed for creating crypto components
 type CryptoComponentsFactoryArgs struct {
 	Config                               config.Config
+	SignatureScheme                      string
 	NodesConfig                          NodesSetupHandler
 	ShardCoordinator                     sharding.Coordinator
 	KeyGen                               crypto.KeyGenerator
@@ -42,7 +43,7 @@ type CryptoComponentsFactoryArgs struct {
 }
 
 type cryptoComponentsFactory struct {
-	consensusType                        string
+	signatureScheme                      string
 	config                               config.Config
 	nodesConfig                          NodesSetupHandler
 	shardCoordinator                     sharding.Coordinator
@@ -67,7 +68,7 @@ func NewCryptoComponentsFactory(args CryptoComponentsFactoryArgs) (*cryptoCompon
 	}
 
 	ccf := &cryptoComponentsFactory{
-		consensusType:                        args.Config.Consensus.Type,
+		signatureScheme:                      args.SignatureScheme,
 		config:                               args.Config,
 		nodesConfig:                          args.NodesConfig,
 		shardCoordinator:                     args.ShardCoordinator,
@@ -76,7 +77,7 @@ func NewCryptoComponentsFactory(args CryptoComponentsFactoryArgs) (*cryptoCompon
 		activateBLSPubKeyMessageVerification: args.ActivateBLSPubKeyMessageVerification,
 	}
 	if args.UseDisabledSigVerifier {
-		ccf.consensusType = disabledSigChecking
+		ccf.signatureScheme = disabledSigChecking
 		ccf.keyGen = signing.NewKeyGenerator(disabledCrypto.NewDisabledSuite())
 		log.Warn("using disabled key generator")
 	}
@@ -147,7 +148,7 @@ func (ccf *cryptoComponentsFactory) Create() (*CryptoComponents, error) {
 }
 
 func (ccf *cryptoComponentsFactory) createSingleSigner() (crypto.SingleSigner, error) {
-	switch ccf.consensusType {
+	switch ccf.signatureScheme {
 	case consensus.BlsConsensusType:
 		return &mclSig.BlsSingleSigner{}, nil
 	case disabledSigChecking:
@@ -159,7 +160,7 @@ func (ccf *cryptoComponentsFactory) createSingleSigner() (crypto.SingleSigner, e
 }
 
 func (ccf *cryptoComponentsFactory) getMultisigHasherFromConfig() (hashing.Hasher, error) {
-	if ccf.consensusType == consensus.BlsConsensusType && ccf.config.MultisigHasher.Type != "blake2b" {
+	if ccf.signatureScheme == consensus.BlsConsensusType && ccf.config.MultisigHasher.Type != "blake2b" {
 		return nil, ErrMultiSigHasherMissmatch
 	}
 
@@ -167,7 +168,7 @@ func (ccf *cryptoComponentsFactory) getMultisigHasherFromConfig() (hashing.Hashe
 	case "sha256":
 		return sha256.Sha256{}, nil
 	case "blake2b":
-		if ccf.consensusType == consensus.BlsConsensusType {
+		if ccf.signatureScheme == consensus.BlsConsensusType {
 			return &blake2b.Blake2b{HashSize: multisig.BlsHashSize}, nil
 		}
 		return &blake2b.Blake2b{}, nil
@@ -187,7 +188,7 @@ func (ccf *cryptoComponentsFactory) createMultiSigner(
 	// we care about the order of the initial public keys that signed, but we never use the entire set of initial
 	// public keys in their initial order.
 
-	switch ccf.consensusType {
+	switch ccf.signatureScheme {
 	case consensus.BlsConsensusType:
 		blsSigner := &mclMultiSig.BlsMultiSigner{Hasher: hasher}
 		return multisig.NewBLSMultisig(blsSigner, pubKeys, ccf.privKey, ccf.keyGen, uint16(0))
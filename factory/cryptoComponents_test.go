@@ -112,8 +112,8 @@ func getCryptoArgs() factory.CryptoComponentsFactoryArgs {
 				Capacity: 1000,
 				Type:     "LRU",
 			},
-			Consensus: config.TypeConfig{Type: "bls"},
 		},
+		SignatureScheme:  "bls",
 		NodesConfig:      &mock.NodesSetupStub{},
 		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(2),
 		KeyGen:           &mock.KeyGenMock{},
@@ -78,4 +78,7 @@ type NetworkComponents struct {
 	OutputAntifloodHandler P2PAntifloodHandler
 	PeerBlackListHandler   process.PeerBlackListCacher
 	PkTimeCache            process.TimeCacher
+	PeerReputationStore    PeerReputationStoreHandler
+	AntifloodLimitsHandler process.AntifloodLimitsHandler
+	AdaptiveQuotaHandler   process.AdaptiveQuotaHandler
 }
@@ -82,3 +82,9 @@ var ErrNilStatusHandler = errors.New("nil status handler provided")
 
 // ErrWrongTypeAssertion signals that a wrong type assertion occurred
 var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrNilPeerReputationStore signals that a nil peer reputation store has been provided
+var ErrNilPeerReputationStore = errors.New("nil peer reputation store")
+
+// ErrNilLoadProvider signals that a nil load provider has been provided
+var ErrNilLoadProvider = errors.New("nil load provider")
@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
 )
 
 // EpochStartNotifier defines which actions should be done for handling new epoch's events
@@ -41,3 +42,15 @@ type P2PAntifloodHandler interface {
 	IsOriginatorEligibleForTopic(pid core.PeerID, topic string) error
 	IsInterfaceNil() bool
 }
+
+// PeerReputationStoreHandler defines the behavior of the store that persists the peers blacklisting decisions
+type PeerReputationStoreHandler interface {
+	PeerIDsCacher() process.PeerBlackListCacher
+	PublicKeysCacher() process.TimeCacher
+	Ban(recordType string, identifier []byte, span time.Duration, reason string) error
+	Unban(recordType string, identifier []byte) error
+	Records() []blackList.ReputationRecord
+	SaveScore(pk string, score float64)
+	Close() error
+	IsInterfaceNil() bool
+}
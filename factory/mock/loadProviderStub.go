@@ -0,0 +1,20 @@
+package mock
+
+// LoadProviderStub -
+type LoadProviderStub struct {
+	LoadPercentCalled func() uint64
+}
+
+// LoadPercent -
+func (lps *LoadProviderStub) LoadPercent() uint64 {
+	if lps.LoadPercentCalled != nil {
+		return lps.LoadPercentCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (lps *LoadProviderStub) IsInterfaceNil() bool {
+	return lps == nil
+}
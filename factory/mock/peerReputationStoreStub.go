@@ -0,0 +1,85 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
+)
+
+// PeerReputationStoreStub -
+type PeerReputationStoreStub struct {
+	PeerIDsCacherCalled    func() process.PeerBlackListCacher
+	PublicKeysCacherCalled func() process.TimeCacher
+	BanCalled              func(recordType string, identifier []byte, span time.Duration, reason string) error
+	UnbanCalled            func(recordType string, identifier []byte) error
+	RecordsCalled          func() []blackList.ReputationRecord
+	SaveScoreCalled        func(pk string, score float64)
+	CloseCalled            func() error
+}
+
+// PeerIDsCacher -
+func (prss *PeerReputationStoreStub) PeerIDsCacher() process.PeerBlackListCacher {
+	if prss.PeerIDsCacherCalled != nil {
+		return prss.PeerIDsCacherCalled()
+	}
+
+	return nil
+}
+
+// PublicKeysCacher -
+func (prss *PeerReputationStoreStub) PublicKeysCacher() process.TimeCacher {
+	if prss.PublicKeysCacherCalled != nil {
+		return prss.PublicKeysCacherCalled()
+	}
+
+	return nil
+}
+
+// Ban -
+func (prss *PeerReputationStoreStub) Ban(recordType string, identifier []byte, span time.Duration, reason string) error {
+	if prss.BanCalled != nil {
+		return prss.BanCalled(recordType, identifier, span, reason)
+	}
+
+	return nil
+}
+
+// Unban -
+func (prss *PeerReputationStoreStub) Unban(recordType string, identifier []byte) error {
+	if prss.UnbanCalled != nil {
+		return prss.UnbanCalled(recordType, identifier)
+	}
+
+	return nil
+}
+
+// Records -
+func (prss *PeerReputationStoreStub) Records() []blackList.ReputationRecord {
+	if prss.RecordsCalled != nil {
+		return prss.RecordsCalled()
+	}
+
+	return nil
+}
+
+// SaveScore -
+func (prss *PeerReputationStoreStub) SaveScore(pk string, score float64) {
+	if prss.SaveScoreCalled != nil {
+		prss.SaveScoreCalled(pk, score)
+	}
+}
+
+// Close -
+func (prss *PeerReputationStoreStub) Close() error {
+	if prss.CloseCalled != nil {
+		return prss.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (prss *PeerReputationStoreStub) IsInterfaceNil() bool {
+	return prss == nil
+}
@@ -15,12 +15,14 @@ import (
 )
 
 type networkComponentsFactory struct {
-	p2pConfig     config.P2PConfig
-	mainConfig    config.Config
-	statusHandler core.AppStatusHandler
-	listenAddress string
-	marshalizer   marshal.Marshalizer
-	syncer        p2p.SyncTimer
+	p2pConfig       config.P2PConfig
+	mainConfig      config.Config
+	statusHandler   core.AppStatusHandler
+	listenAddress   string
+	marshalizer     marshal.Marshalizer
+	syncer          p2p.SyncTimer
+	reputationStore PeerReputationStoreHandler
+	loadProvider    process.LoadProvider
 }
 
 // NewNetworkComponentsFactory returns a new instance of a network components factory
@@ -30,6 +32,8 @@ func NewNetworkComponentsFactory(
 	statusHandler core.AppStatusHandler,
 	marshalizer marshal.Marshalizer,
 	syncer p2p.SyncTimer,
+	reputationStore PeerReputationStoreHandler,
+	loadProvider process.LoadProvider,
 ) (*networkComponentsFactory, error) {
 	if check.IfNil(statusHandler) {
 		return nil, ErrNilStatusHandler
@@ -37,14 +41,22 @@ func NewNetworkComponentsFactory(
 	if check.IfNil(marshalizer) {
 		return nil, fmt.Errorf("%w in NewNetworkComponentsFactory", ErrNilMarshalizer)
 	}
+	if check.IfNil(reputationStore) {
+		return nil, fmt.Errorf("%w in NewNetworkComponentsFactory", ErrNilPeerReputationStore)
+	}
+	if check.IfNil(loadProvider) {
+		return nil, fmt.Errorf("%w in NewNetworkComponentsFactory", ErrNilLoadProvider)
+	}
 
 	return &networkComponentsFactory{
-		p2pConfig:     p2pConfig,
-		marshalizer:   marshalizer,
-		mainConfig:    mainConfig,
-		statusHandler: statusHandler,
-		listenAddress: libp2p.ListenAddrWithIp4AndTcp,
-		syncer:        syncer,
+		p2pConfig:       p2pConfig,
+		marshalizer:     marshalizer,
+		mainConfig:      mainConfig,
+		statusHandler:   statusHandler,
+		listenAddress:   libp2p.ListenAddrWithIp4AndTcp,
+		syncer:          syncer,
+		reputationStore: reputationStore,
+		loadProvider:    loadProvider,
 	}, nil
 }
 
@@ -62,15 +74,19 @@ func (ncf *networkComponentsFactory) Create() (*NetworkComponents, error) {
 		return nil, err
 	}
 
-	inAntifloodHandler, peerIdBlackList, pkTimeCache, errNewAntiflood := antifloodFactory.NewP2PAntiFloodAndBlackList(
+	antiFloodComponents, errNewAntiflood := antifloodFactory.NewP2PAntiFloodAndBlackList(
 		ncf.mainConfig,
 		ncf.statusHandler,
 		netMessenger.ID(),
+		ncf.reputationStore,
+		ncf.loadProvider,
 	)
 	if errNewAntiflood != nil {
 		return nil, errNewAntiflood
 	}
 
+	inAntifloodHandler := antiFloodComponents.AntiFloodHandler
+
 	if ncf.mainConfig.Debug.Antiflood.Enabled {
 		var debugger process.AntifloodDebugger
 		debugger, err = antiflood.NewAntifloodDebugger(ncf.mainConfig.Debug.Antiflood)
@@ -103,7 +119,10 @@ func (ncf *networkComponentsFactory) Create() (*NetworkComponents, error) {
 		NetMessenger:           netMessenger,
 		InputAntifloodHandler:  inputAntifloodHandler,
 		OutputAntifloodHandler: outputAntifloodHandler,
-		PeerBlackListHandler:   peerIdBlackList,
-		PkTimeCache:            pkTimeCache,
+		PeerBlackListHandler:   antiFloodComponents.BlacklistHandler,
+		PkTimeCache:            antiFloodComponents.PubKeysCacher,
+		PeerReputationStore:    ncf.reputationStore,
+		AntifloodLimitsHandler: antiFloodComponents.LimitsHandler,
+		AdaptiveQuotaHandler:   antiFloodComponents.AdaptiveQuotaHandler,
 	}, nil
 }
@@ -19,6 +19,8 @@ func TestNewNetworkComponentsFactory_NilStatusHandlerShouldErr(t *testing.T) {
 		nil,
 		&mock.MarshalizerMock{},
 		&libp2p.LocalSyncTimer{},
+		&mock.PeerReputationStoreStub{},
+		&mock.LoadProviderStub{},
 	)
 	require.Nil(t, ncf)
 	require.Equal(t, ErrNilStatusHandler, err)
@@ -33,11 +35,45 @@ func TestNewNetworkComponentsFactory_NilMarshalizerShouldErr(t *testing.T) {
 		&mock.AppStatusHandlerMock{},
 		nil,
 		&libp2p.LocalSyncTimer{},
+		&mock.PeerReputationStoreStub{},
+		&mock.LoadProviderStub{},
 	)
 	require.Nil(t, ncf)
 	require.True(t, errors.Is(err, ErrNilMarshalizer))
 }
 
+func TestNewNetworkComponentsFactory_NilPeerReputationStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ncf, err := NewNetworkComponentsFactory(
+		config.P2PConfig{},
+		config.Config{},
+		&mock.AppStatusHandlerMock{},
+		&mock.MarshalizerMock{},
+		&libp2p.LocalSyncTimer{},
+		nil,
+		&mock.LoadProviderStub{},
+	)
+	require.Nil(t, ncf)
+	require.True(t, errors.Is(err, ErrNilPeerReputationStore))
+}
+
+func TestNewNetworkComponentsFactory_NilLoadProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ncf, err := NewNetworkComponentsFactory(
+		config.P2PConfig{},
+		config.Config{},
+		&mock.AppStatusHandlerMock{},
+		&mock.MarshalizerMock{},
+		&libp2p.LocalSyncTimer{},
+		&mock.PeerReputationStoreStub{},
+		nil,
+	)
+	require.Nil(t, ncf)
+	require.True(t, errors.Is(err, ErrNilLoadProvider))
+}
+
 func TestNewNetworkComponentsFactory_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -47,6 +83,8 @@ func TestNewNetworkComponentsFactory_OkValsShouldWork(t *testing.T) {
 		&mock.AppStatusHandlerMock{},
 		&mock.MarshalizerMock{},
 		&libp2p.LocalSyncTimer{},
+		&mock.PeerReputationStoreStub{},
+		&mock.LoadProviderStub{},
 	)
 	require.NoError(t, err)
 	require.NotNil(t, ncf)
@@ -59,6 +97,8 @@ func TestNetworkComponentsFactory_Create_ShouldErrDueToBadConfig(t *testing.T) {
 		&mock.AppStatusHandlerMock{},
 		&mock.MarshalizerMock{},
 		&libp2p.LocalSyncTimer{},
+		&mock.PeerReputationStoreStub{},
+		&mock.LoadProviderStub{},
 	)
 
 	nc, err := ncf.Create()
@@ -103,6 +143,8 @@ func TestNetworkComponentsFactory_Create_ShouldWork(t *testing.T) {
 		&mock.AppStatusHandlerMock{},
 		&mock.MarshalizerMock{},
 		&libp2p.LocalSyncTimer{},
+		&mock.PeerReputationStoreStub{},
+		&mock.LoadProviderStub{},
 	)
 
 	ncf.SetListenAddress(libp2p.ListenLocalhostAddrWithIp4AndTcp)
@@ -70,6 +70,13 @@ func createMockArgument(
 				MinPassThreshold: 50,
 				MinVetoThreshold: 50,
 			},
+			DelegationManagerSystemSCConfig: config.DelegationManagerSystemSCConfig{
+				MinCreationDeposit: "100",
+			},
+			DelegationSystemSCConfig: config.DelegationSystemSCConfig{
+				MinDelegationAmount: "1",
+				MaxServiceFee:       10000,
+			},
 			StakingSystemSCConfig: config.StakingSystemSCConfig{
 				GenesisNodePrice:                     nodePrice.Text(10),
 				UnJailValue:                          "10",
@@ -310,7 +310,6 @@ func createProcessorsForMetaGenesisBlock(arg ArgsGenesisBlockCreator, generalCon
 		BadTxForwarder:                 badTxForwarder,
 		EpochNotifier:                  epochNotifier,
 		DeployEnableEpoch:              generalConfig.SCDeployEnableEpoch,
-		BuiltinEnableEpoch:             generalConfig.BuiltInFunctionsEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: generalConfig.PenalizedTooMuchGasEnableEpoch,
 	}
 	scProcessor, err := smartContract.NewSmartContractProcessor(argsNewSCProcessor)
@@ -433,6 +432,10 @@ func deploySystemSmartContracts(
 	})
 
 	for _, address := range systemSCAddresses {
+		if isDeployedAtActivationEpoch(address) {
+			continue
+		}
+
 		tx.SndAddr = address
 		_, err := txProcessor.ProcessTransaction(tx)
 		if err != nil {
@@ -443,6 +446,12 @@ func deploySystemSmartContracts(
 	return nil
 }
 
+// isDeployedAtActivationEpoch returns true for the system smart contracts which are not part of the genesis state,
+// as they are deployed by the end of epoch system SC processor when their activation epoch is reached
+func isDeployedAtActivationEpoch(address []byte) bool {
+	return bytes.Equal(address, vm.DelegationManagerSCAddress) || bytes.Equal(address, vm.FirstDelegationSCAddress)
+}
+
 // setStakedData sets the initial staked values to the staking smart contract
 // it will register both categories of nodes: direct staked and delegated stake. This is done because it is the only
 // way possible due to the fact that the delegation contract can not call a sandbox-ed processor suite and accounts state
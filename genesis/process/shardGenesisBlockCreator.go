@@ -227,12 +227,20 @@ func setBalanceToTrie(arg ArgsGenesisBlockCreator, accnt genesis.InitialAccountH
 }
 
 func createProcessorsForShard(arg ArgsGenesisBlockCreator, generalConfig config.GeneralSettingsConfig) (*genesisProcessors, error) {
+	epochNotifier := forking.NewGenericEpochNotifier()
+	epochNotifier.CheckEpoch(arg.StartEpochNum)
+
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:               arg.GasMap,
-		MapDNSAddresses:      make(map[string]struct{}),
-		EnableUserNameChange: false,
-		Marshalizer:          arg.Marshalizer,
-		Accounts:             arg.Accounts,
+		GasMap:                      arg.GasMap,
+		MapDNSAddresses:             make(map[string]struct{}),
+		EnableUserNameChange:        false,
+		Marshalizer:                 arg.Marshalizer,
+		Accounts:                    arg.Accounts,
+		ShardCoordinator:            arg.ShardCoordinator,
+		ArgumentParser:              smartContract.NewArgumentParser(),
+		EpochNotifier:               epochNotifier,
+		BuiltInFunctionsEnableEpoch: generalConfig.BuiltInFunctionsEnableEpoch,
+		ActivationEpochs:            generalConfig.BuiltInFunctionsActivationEpochs,
 	}
 	builtInFuncs, err := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 	if err != nil {
@@ -318,9 +326,6 @@ func createProcessorsForShard(arg ArgsGenesisBlockCreator, generalConfig config.
 		return nil, err
 	}
 
-	epochNotifier := forking.NewGenericEpochNotifier()
-	epochNotifier.CheckEpoch(arg.StartEpochNum)
-
 	genesisFeeHandler := &disabled.FeeHandler{}
 	argsNewScProcessor := smartContract.ArgsNewSmartContractProcessor{
 		VmContainer:                    vmContainer,
@@ -341,7 +346,6 @@ func createProcessorsForShard(arg ArgsGenesisBlockCreator, generalConfig config.
 		TxLogsProcessor:                arg.TxLogsProcessor,
 		BadTxForwarder:                 badTxInterim,
 		EpochNotifier:                  epochNotifier,
-		BuiltinEnableEpoch:             generalConfig.BuiltInFunctionsEnableEpoch,
 		DeployEnableEpoch:              generalConfig.SCDeployEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: generalConfig.PenalizedTooMuchGasEnableEpoch,
 	}
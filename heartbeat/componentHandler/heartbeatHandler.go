@@ -24,6 +24,10 @@ import (
 
 var log = logger.GetOrCreate("heartbeat/componenthandler")
 
+// minTimeBetweenTriggeredPeerAuthentications bounds the number of peer authentications sent as a reaction to the
+// peers joining the network
+const minTimeBetweenTriggeredPeerAuthentications = time.Second * 30
+
 // ArgHeartbeat represents the heartbeat creation argument
 type ArgHeartbeat struct {
 	HeartbeatConfig          config.HeartbeatConfig
@@ -37,6 +41,8 @@ type ArgHeartbeat struct {
 	ValidatorStatistics      heartbeat.ValidatorStatisticsProcessor
 	PeerSignatureHandler     crypto.PeerSignatureHandler
 	PrivKey                  crypto.PrivateKey
+	SingleSigner             crypto.SingleSigner
+	KeyGen                   crypto.KeyGenerator
 	HardforkTrigger          heartbeat.HardforkTrigger
 	AntifloodHandler         heartbeat.P2PAntifloodHandler
 	ValidatorPubkeyConverter core.PubkeyConverter
@@ -52,19 +58,24 @@ type ArgHeartbeat struct {
 }
 
 // HeartbeatHandler is the struct used to manage heartbeat subsystem consisting of a heartbeat sender and monitor
-// wired on a dedicated p2p topic
+// wired on a dedicated p2p topic. The heartbeat v2 messages are received on their own topics and are recorded by
+// the same monitor so both protocol versions are accepted during the migration
 type HeartbeatHandler struct {
-	monitor          *process.Monitor
-	sender           *process.Sender
-	arg              ArgHeartbeat
-	peerTypeProvider *peer.PeerTypeProvider
-	cancelFunc       func()
+	monitor                    *process.Monitor
+	sender                     *process.Sender
+	peerAuthenticationCache    *process.PeerAuthenticationCache
+	peerAuthenticationTrigger  *process.PeerAuthenticationTrigger
+	lastPeerAuthenticationTime time.Time
+	arg                        ArgHeartbeat
+	peerTypeProvider           *peer.PeerTypeProvider
+	cancelFunc                 func()
 }
 
 // NewHeartbeatHandler will create a heartbeat handler containing both a monitor and a sender
 func NewHeartbeatHandler(arg ArgHeartbeat) (*HeartbeatHandler, error) {
 	hbh := &HeartbeatHandler{
-		arg: arg,
+		arg:                       arg,
+		peerAuthenticationTrigger: process.NewPeerAuthenticationTrigger(),
 	}
 
 	err := hbh.create()
@@ -90,16 +101,13 @@ func (hbh *HeartbeatHandler) create() error {
 		return heartbeat.ErrNilMessenger
 	}
 
-	if arg.Messenger.HasTopicValidator(core.HeartbeatTopic) {
-		return heartbeat.ErrValidatorAlreadySet
-	}
-
-	if !arg.Messenger.HasTopic(core.HeartbeatTopic) {
-		err = arg.Messenger.CreateTopic(core.HeartbeatTopic, true)
+	for _, topic := range []string{core.HeartbeatTopic, core.PeerAuthenticationTopic, core.HeartbeatV2Topic} {
+		err = createTopic(arg.Messenger, topic)
 		if err != nil {
 			return err
 		}
 	}
+
 	argPeerTypeProvider := peer.ArgPeerTypeProvider{
 		NodesCoordinator:        arg.NodesCoordinator,
 		StartEpoch:              arg.EpochStartTrigger.MetaEpoch(),
@@ -111,19 +119,23 @@ func (hbh *HeartbeatHandler) create() error {
 	}
 	hbh.peerTypeProvider = peerTypeProvider
 	argSender := process.ArgHeartbeatSender{
-		PeerMessenger:        arg.Messenger,
-		PeerSignatureHandler: arg.PeerSignatureHandler,
-		PrivKey:              arg.PrivKey,
-		Marshalizer:          arg.Marshalizer,
-		Topic:                core.HeartbeatTopic,
-		ShardCoordinator:     arg.ShardCoordinator,
-		PeerTypeProvider:     peerTypeProvider,
-		StatusHandler:        arg.AppStatusHandler,
-		VersionNumber:        arg.VersionNumber,
-		NodeDisplayName:      arg.PrefsConfig.NodeDisplayName,
-		KeyBaseIdentity:      arg.PrefsConfig.Identity,
-		HardforkTrigger:      arg.HardforkTrigger,
-		CurrentBlockProvider: arg.CurrentBlockProvider,
+		PeerMessenger:            arg.Messenger,
+		PeerSignatureHandler:     arg.PeerSignatureHandler,
+		PrivKey:                  arg.PrivKey,
+		Marshalizer:              arg.Marshalizer,
+		Topic:                    core.HeartbeatTopic,
+		ShardCoordinator:         arg.ShardCoordinator,
+		PeerTypeProvider:         peerTypeProvider,
+		StatusHandler:            arg.AppStatusHandler,
+		VersionNumber:            arg.VersionNumber,
+		NodeDisplayName:          arg.PrefsConfig.NodeDisplayName,
+		KeyBaseIdentity:          arg.PrefsConfig.Identity,
+		HardforkTrigger:          arg.HardforkTrigger,
+		CurrentBlockProvider:     arg.CurrentBlockProvider,
+		SingleSigner:             arg.SingleSigner,
+		PeerAuthenticationTopic:  core.PeerAuthenticationTopic,
+		HeartbeatV2Topic:         core.HeartbeatV2Topic,
+		PeerAuthenticationExpiry: time.Second * time.Duration(arg.HeartbeatConfig.PeerAuthenticationExpiryInSec),
 	}
 
 	hbh.sender, err = process.NewSender(argSender)
@@ -175,6 +187,8 @@ func (hbh *HeartbeatHandler) create() error {
 		ValidatorPubkeyConverter:           arg.ValidatorPubkeyConverter,
 		HeartbeatRefreshIntervalInSec:      arg.HeartbeatConfig.HeartbeatRefreshIntervalInSec,
 		HideInactiveValidatorIntervalInSec: arg.HeartbeatConfig.HideInactiveValidatorIntervalInSec,
+		EpochStartEventNotifier:            arg.EpochStartRegistration,
+		StartEpoch:                         arg.EpochStartTrigger.MetaEpoch(),
 	}
 	hbh.monitor, err = process.NewMonitor(argMonitor)
 	if err != nil {
@@ -193,11 +207,73 @@ func (hbh *HeartbeatHandler) create() error {
 		return err
 	}
 
+	err = hbh.createHeartbeatV2Processors(netInputMarshalizer, timer)
+	if err != nil {
+		return err
+	}
+
 	go hbh.startSendingHeartbeats(ctx)
 
 	return nil
 }
 
+func createTopic(messenger heartbeat.P2PMessenger, topic string) error {
+	if messenger.HasTopicValidator(topic) {
+		return heartbeat.ErrValidatorAlreadySet
+	}
+	if messenger.HasTopic(topic) {
+		return nil
+	}
+
+	return messenger.CreateTopic(topic, true)
+}
+
+func (hbh *HeartbeatHandler) createHeartbeatV2Processors(marshalizer marshal.Marshalizer, timer heartbeat.Timer) error {
+	arg := hbh.arg
+
+	var err error
+	hbh.peerAuthenticationCache, err = process.NewPeerAuthenticationCache(timer)
+	if err != nil {
+		return err
+	}
+
+	argPeerAuthenticationProcessor := process.ArgPeerAuthenticationProcessor{
+		Marshalizer:              marshalizer,
+		SingleSigner:             arg.SingleSigner,
+		KeyGen:                   arg.KeyGen,
+		AntifloodHandler:         arg.AntifloodHandler,
+		NetworkShardingCollector: arg.PeerShardMapper,
+		PeerAuthenticationCache:  hbh.peerAuthenticationCache,
+		NewPeerNotifier:          hbh.peerAuthenticationTrigger,
+		Timer:                    timer,
+		MaxExpiryDuration:        time.Second * time.Duration(arg.HeartbeatConfig.PeerAuthenticationExpiryInSec),
+	}
+	peerAuthenticationProcessor, err := process.NewPeerAuthenticationProcessor(argPeerAuthenticationProcessor)
+	if err != nil {
+		return err
+	}
+
+	argHeartbeatV2Processor := process.ArgHeartbeatV2Processor{
+		Marshalizer:             marshalizer,
+		AntifloodHandler:        arg.AntifloodHandler,
+		PeerAuthenticationCache: hbh.peerAuthenticationCache,
+		HeartbeatReceiver:       hbh.monitor,
+	}
+	heartbeatV2Processor, err := process.NewHeartbeatV2Processor(argHeartbeatV2Processor)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("heartbeat v2 processors have been instantiated")
+
+	err = arg.Messenger.RegisterMessageProcessor(core.PeerAuthenticationTopic, peerAuthenticationProcessor)
+	if err != nil {
+		return err
+	}
+
+	return arg.Messenger.RegisterMessageProcessor(core.HeartbeatV2Topic, heartbeatV2Processor)
+}
+
 func (hbh *HeartbeatHandler) getLatestValidators() (map[uint32][]*state.ValidatorInfo, map[string]*state.ValidatorApiResponse, error) {
 	latestHash, err := hbh.arg.ValidatorStatistics.RootHash()
 	if err != nil {
@@ -220,27 +296,87 @@ func (hbh *HeartbeatHandler) startSendingHeartbeats(ctx context.Context) {
 
 	diffSeconds := cfg.MaxTimeToWaitBetweenBroadcastsInSec - cfg.MinTimeToWaitBetweenBroadcastsInSec
 	diffNanos := int64(diffSeconds) * time.Second.Nanoseconds()
+	timeBetweenPeerAuthentications := time.Second * time.Duration(cfg.PeerAuthenticationTimeBetweenSendsInSec)
 
 	for {
 		randomNanos := r.Int63n(diffNanos)
 		timeToWait := time.Second*time.Duration(cfg.MinTimeToWaitBetweenBroadcastsInSec) + time.Duration(randomNanos)
 
-		select {
-		case <-ctx.Done():
+		shouldContinue := hbh.waitForNextBroadcast(ctx, timeToWait)
+		if !shouldContinue {
 			log.Debug("heartbeat's go routine is stopping...")
 			return
-		case <-time.After(timeToWait):
+		}
+
+		hbh.sendHeartbeatV1()
+
+		if cfg.SendHeartbeatV2 {
+			hbh.sendPeerAuthenticationIfNeeded(timeBetweenPeerAuthentications)
+
+			err := hbh.sender.SendHeartbeatV2()
+			if err != nil {
+				log.Debug("SendHeartbeatV2", "error", err.Error())
+			}
+		}
+
+		hbh.monitor.Cleanup()
+		hbh.peerAuthenticationCache.Sweep()
+	}
+}
+
+// waitForNextBroadcast waits until the next heartbeat broadcast is due, sending in the meantime the self peer
+// authentication whenever a new peer joined the network. Returns false if the sending go routine should stop
+func (hbh *HeartbeatHandler) waitForNextBroadcast(ctx context.Context, timeToWait time.Duration) bool {
+	timer := time.NewTimer(timeToWait)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timer.C:
+			return true
 		case <-hbh.arg.HardforkTrigger.NotifyTriggerReceived(): //this will force an immediate broadcast of the trigger
 			//message on the network
 			log.Debug("hardfork message prepared for heartbeat sending")
+			return true
+		case <-hbh.peerAuthenticationTrigger.NotifyTriggerReceived():
+			if hbh.arg.HeartbeatConfig.SendHeartbeatV2 {
+				hbh.sendPeerAuthenticationIfNeeded(minTimeBetweenTriggeredPeerAuthentications)
+			}
 		}
+	}
+}
 
-		err := hbh.sender.SendHeartbeat()
-		if err != nil {
-			log.Debug("SendHeartbeat", "error", err.Error())
-		}
+func (hbh *HeartbeatHandler) sendPeerAuthenticationIfNeeded(minTimeSinceLastSend time.Duration) {
+	if time.Since(hbh.lastPeerAuthenticationTime) < minTimeSinceLastSend {
+		return
+	}
+	//a peer authentication broadcast while not connected is lost, so it will be retried on the next broadcast
+	if !hbh.arg.Messenger.IsConnectedToTheNetwork() {
+		return
+	}
 
-		hbh.monitor.Cleanup()
+	err := hbh.sender.SendPeerAuthentication()
+	if err != nil {
+		log.Debug("SendPeerAuthentication", "error", err.Error())
+		return
+	}
+
+	hbh.lastPeerAuthenticationTime = time.Now()
+}
+
+func (hbh *HeartbeatHandler) sendHeartbeatV1() {
+	//the hardfork trigger is only spread through the heartbeat v1 topic so the v1 message will still be sent
+	//after the migration whenever the hardfork was triggered
+	_, isHardforkTriggered := hbh.arg.HardforkTrigger.RecordedTriggerMessage()
+	if hbh.arg.HeartbeatConfig.StopSendingHeartbeatV1 && !isHardforkTriggered {
+		return
+	}
+
+	err := hbh.sender.SendHeartbeat()
+	if err != nil {
+		log.Debug("SendHeartbeat", "error", err.Error())
 	}
 }
 
@@ -260,6 +396,15 @@ func (hbh *HeartbeatHandler) checkConfigParams(config config.HeartbeatConfig) er
 	if config.DurationToConsiderUnresponsiveInSec <= config.MaxTimeToWaitBetweenBroadcastsInSec {
 		return fmt.Errorf("%w for DurationToConsiderUnresponsiveInSec", heartbeat.ErrWrongValues)
 	}
+	if config.PeerAuthenticationTimeBetweenSendsInSec < 1 {
+		return heartbeat.ErrInvalidPeerAuthenticationTimeBetweenSendsInSec
+	}
+	if config.PeerAuthenticationExpiryInSec <= config.PeerAuthenticationTimeBetweenSendsInSec {
+		return fmt.Errorf("%w for PeerAuthenticationExpiryInSec", heartbeat.ErrWrongValues)
+	}
+	if config.StopSendingHeartbeatV1 && !config.SendHeartbeatV2 {
+		return fmt.Errorf("%w, at least one heartbeat version should be sent", heartbeat.ErrWrongValues)
+	}
 
 	return nil
 }
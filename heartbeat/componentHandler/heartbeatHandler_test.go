@@ -2,13 +2,18 @@ package componentHandler
 
 import (
 	"errors"
+	atomicCore "sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -16,11 +21,13 @@ import (
 func createMockArgument() ArgHeartbeat {
 	arg := ArgHeartbeat{
 		HeartbeatConfig: config.HeartbeatConfig{
-			MinTimeToWaitBetweenBroadcastsInSec: 2,
-			MaxTimeToWaitBetweenBroadcastsInSec: 3,
-			DurationToConsiderUnresponsiveInSec: 10,
-			HeartbeatRefreshIntervalInSec:       1,
-			HideInactiveValidatorIntervalInSec:  20,
+			MinTimeToWaitBetweenBroadcastsInSec:     2,
+			MaxTimeToWaitBetweenBroadcastsInSec:     3,
+			DurationToConsiderUnresponsiveInSec:     10,
+			HeartbeatRefreshIntervalInSec:           1,
+			HideInactiveValidatorIntervalInSec:      20,
+			PeerAuthenticationTimeBetweenSendsInSec: 5,
+			PeerAuthenticationExpiryInSec:           15,
 		},
 		PrefsConfig: config.PreferencesConfig{
 			DestinationShardAsObserver: "0",
@@ -36,6 +43,8 @@ func createMockArgument() ArgHeartbeat {
 		ValidatorStatistics:      &mock.ValidatorStatisticsStub{},
 		PeerSignatureHandler:     &mock.PeerSignatureHandler{},
 		PrivKey:                  &mock.PrivateKeyStub{},
+		SingleSigner:             &mock.SinglesignMock{},
+		KeyGen:                   &mock.KeyGenMock{},
 		HardforkTrigger:          &mock.HardforkTriggerStub{},
 		AntifloodHandler:         &mock.P2PAntifloodHandlerStub{},
 		ValidatorPubkeyConverter: mock.NewPubkeyConverterMock(32),
@@ -112,6 +121,40 @@ func TestNewHeartbeatHandler_InvalidDurationToConsiderUnresponsiveInSec(t *testi
 	assert.True(t, errors.Is(err, heartbeat.ErrWrongValues))
 }
 
+func TestNewHeartbeatHandler_InvalidPeerAuthenticationTimeBetweenSendsInSec(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.HeartbeatConfig.PeerAuthenticationTimeBetweenSendsInSec = 0
+	hbh, err := NewHeartbeatHandler(arg)
+
+	assert.True(t, check.IfNil(hbh))
+	assert.Equal(t, heartbeat.ErrInvalidPeerAuthenticationTimeBetweenSendsInSec, err)
+}
+
+func TestNewHeartbeatHandler_InvalidPeerAuthenticationExpiryInSec(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.HeartbeatConfig.PeerAuthenticationExpiryInSec = arg.HeartbeatConfig.PeerAuthenticationTimeBetweenSendsInSec
+	hbh, err := NewHeartbeatHandler(arg)
+
+	assert.True(t, check.IfNil(hbh))
+	assert.True(t, errors.Is(err, heartbeat.ErrWrongValues))
+}
+
+func TestNewHeartbeatHandler_NoHeartbeatVersionSentShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgument()
+	arg.HeartbeatConfig.StopSendingHeartbeatV1 = true
+	arg.HeartbeatConfig.SendHeartbeatV2 = false
+	hbh, err := NewHeartbeatHandler(arg)
+
+	assert.True(t, check.IfNil(hbh))
+	assert.True(t, errors.Is(err, heartbeat.ErrWrongValues))
+}
+
 func TestNewHeartbeatHandler_NilMessenger(t *testing.T) {
 	t.Parallel()
 
@@ -138,4 +181,72 @@ func TestNewHeartbeatHandler_ShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
-//TODO(next PR) add more tests
+func TestNewHeartbeatHandler_ShouldRegisterBothHeartbeatVersions(t *testing.T) {
+	t.Parallel()
+
+	registeredTopics := make(map[string]struct{})
+	arg := createMockArgument()
+	arg.Messenger = &mock.MessengerStub{
+		RegisterMessageProcessorCalled: func(topic string, handler p2p.MessageProcessor) error {
+			registeredTopics[topic] = struct{}{}
+			return nil
+		},
+	}
+	hbh, err := NewHeartbeatHandler(arg)
+	require.Nil(t, err)
+
+	expectedTopics := map[string]struct{}{
+		core.HeartbeatTopic:          {},
+		core.PeerAuthenticationTopic: {},
+		core.HeartbeatV2Topic:        {},
+	}
+	assert.Equal(t, expectedTopics, registeredTopics)
+
+	_ = hbh.Close()
+}
+
+func TestHeartbeatHandler_NewPeerAuthenticationShouldTriggerPeerAuthenticationSend(t *testing.T) {
+	t.Parallel()
+
+	numPeerAuthenticationsSent := uint32(0)
+	isConnected := atomic.Flag{}
+	arg := createMockArgument()
+	arg.HeartbeatConfig.SendHeartbeatV2 = true
+	arg.Messenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			if topic == core.PeerAuthenticationTopic {
+				atomicCore.AddUint32(&numPeerAuthenticationsSent, 1)
+			}
+		},
+		IsConnectedToTheNetworkCalled: func() bool {
+			return isConnected.IsSet()
+		},
+	}
+	arg.PrivKey = &mock.PrivateKeyStub{
+		GeneratePublicHandler: func() crypto.PublicKey {
+			return &mock.PublicKeyMock{
+				ToByteArrayHandler: func() ([]byte, error) {
+					return []byte("pk"), nil
+				},
+			}
+		},
+	}
+	hbh, err := NewHeartbeatHandler(arg)
+	require.Nil(t, err)
+	defer func() {
+		_ = hbh.Close()
+	}()
+
+	hbh.peerAuthenticationTrigger.NotifyNewPeerAuthentication("pid1")
+	time.Sleep(time.Millisecond * 200)
+	assert.Equal(t, uint32(0), atomicCore.LoadUint32(&numPeerAuthenticationsSent), "should not send while not connected")
+
+	isConnected.Set()
+	hbh.peerAuthenticationTrigger.NotifyNewPeerAuthentication("pid2")
+	time.Sleep(time.Millisecond * 200)
+	assert.Equal(t, uint32(1), atomicCore.LoadUint32(&numPeerAuthenticationsSent))
+
+	hbh.peerAuthenticationTrigger.NotifyNewPeerAuthentication("pid3")
+	time.Sleep(time.Millisecond * 200)
+	assert.Equal(t, uint32(1), atomicCore.LoadUint32(&numPeerAuthenticationsSent), "should not send again so soon")
+}
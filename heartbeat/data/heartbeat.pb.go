@@ -348,51 +348,186 @@ func (m *DbTimeStamp) GetTimestamp() int64 {
 	return 0
 }
 
+// VersionChange records the moment a public key started to report another node version
+type VersionChange struct {
+	Timestamp int64  `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Version   string `protobuf:"bytes,2,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *VersionChange) Reset()      { *m = VersionChange{} }
+func (*VersionChange) ProtoMessage() {}
+func (*VersionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c667767fb9826a9, []int{3}
+}
+func (m *VersionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VersionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VersionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionChange.Merge(m, src)
+}
+func (m *VersionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionChange proto.InternalMessageInfo
+
+func (m *VersionChange) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *VersionChange) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+// EpochUptimeDTO is the struct used for storing the availability of a public key during one epoch
+type EpochUptimeDTO struct {
+	Epoch             uint32           `protobuf:"varint,1,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	TotalUpTime       int64            `protobuf:"varint,2,opt,name=TotalUpTime,proto3" json:"TotalUpTime,omitempty"`
+	TotalDownTime     int64            `protobuf:"varint,3,opt,name=TotalDownTime,proto3" json:"TotalDownTime,omitempty"`
+	MaxDowntimeStreak int64            `protobuf:"varint,4,opt,name=MaxDowntimeStreak,proto3" json:"MaxDowntimeStreak,omitempty"`
+	VersionChanges    []*VersionChange `protobuf:"bytes,5,rep,name=VersionChanges,proto3" json:"VersionChanges,omitempty"`
+}
+
+func (m *EpochUptimeDTO) Reset()      { *m = EpochUptimeDTO{} }
+func (*EpochUptimeDTO) ProtoMessage() {}
+func (*EpochUptimeDTO) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c667767fb9826a9, []int{4}
+}
+func (m *EpochUptimeDTO) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochUptimeDTO) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochUptimeDTO.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochUptimeDTO) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochUptimeDTO.Merge(m, src)
+}
+func (m *EpochUptimeDTO) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochUptimeDTO) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochUptimeDTO.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochUptimeDTO proto.InternalMessageInfo
+
+func (m *EpochUptimeDTO) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochUptimeDTO) GetTotalUpTime() int64 {
+	if m != nil {
+		return m.TotalUpTime
+	}
+	return 0
+}
+
+func (m *EpochUptimeDTO) GetTotalDownTime() int64 {
+	if m != nil {
+		return m.TotalDownTime
+	}
+	return 0
+}
+
+func (m *EpochUptimeDTO) GetMaxDowntimeStreak() int64 {
+	if m != nil {
+		return m.MaxDowntimeStreak
+	}
+	return 0
+}
+
+func (m *EpochUptimeDTO) GetVersionChanges() []*VersionChange {
+	if m != nil {
+		return m.VersionChanges
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Heartbeat)(nil), "proto.Heartbeat")
 	proto.RegisterType((*HeartbeatDTO)(nil), "proto.HeartbeatDTO")
 	proto.RegisterType((*DbTimeStamp)(nil), "proto.DbTimeStamp")
+	proto.RegisterType((*VersionChange)(nil), "proto.VersionChange")
+	proto.RegisterType((*EpochUptimeDTO)(nil), "proto.EpochUptimeDTO")
 }
 
 func init() { proto.RegisterFile("heartbeat.proto", fileDescriptor_3c667767fb9826a9) }
 
 var fileDescriptor_3c667767fb9826a9 = []byte{
-	// 553 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xbd, 0x6e, 0x13, 0x4f,
-	0x14, 0xc5, 0x3d, 0x59, 0x3b, 0xb1, 0xc7, 0xce, 0x3f, 0xf9, 0x8f, 0x10, 0x1a, 0x01, 0x1a, 0xad,
-	0x2c, 0x0a, 0x4b, 0x48, 0x69, 0xe8, 0xa8, 0xf8, 0x58, 0x09, 0x2c, 0x11, 0x63, 0x6d, 0x9c, 0x14,
-	0x74, 0x63, 0xef, 0x15, 0x19, 0xe1, 0x9d, 0x59, 0xed, 0xcc, 0x86, 0xb8, 0xa3, 0x42, 0xa2, 0xe3,
-	0x31, 0x78, 0x14, 0xca, 0x94, 0x29, 0xc9, 0xa6, 0xa1, 0xcc, 0x23, 0xa0, 0xb9, 0x8e, 0xbd, 0xb6,
-	0xf9, 0x10, 0xd5, 0xf8, 0x9c, 0x7b, 0xb4, 0xde, 0x39, 0xbf, 0xab, 0xa5, 0x7b, 0xa7, 0x20, 0x73,
-	0x37, 0x06, 0xe9, 0x0e, 0xb2, 0xdc, 0x38, 0xc3, 0x1a, 0x78, 0x74, 0x3f, 0x6f, 0xd1, 0xd6, 0xab,
-	0xc5, 0x88, 0x71, 0xba, 0x33, 0x94, 0xb3, 0xa9, 0x91, 0x09, 0x27, 0x21, 0xe9, 0x75, 0xe2, 0x85,
-	0x64, 0x77, 0xe9, 0xf6, 0xb0, 0x18, 0xbf, 0x87, 0x19, 0xdf, 0xc2, 0xc1, 0xad, 0x62, 0x0f, 0x68,
-	0xeb, 0x48, 0xbd, 0xd3, 0xd2, 0x15, 0x39, 0xf0, 0x00, 0x47, 0x95, 0xe1, 0x9f, 0x77, 0x74, 0x2a,
-	0xf3, 0xa4, 0x1f, 0xf1, 0x7a, 0x48, 0x7a, 0xbb, 0xf1, 0x42, 0xb2, 0x87, 0x74, 0xf7, 0x04, 0x72,
-	0xab, 0x8c, 0x1e, 0x14, 0xe9, 0x18, 0x72, 0xde, 0x08, 0x49, 0xaf, 0x15, 0xaf, 0x9b, 0xac, 0x47,
-	0xf7, 0x06, 0x26, 0x81, 0x48, 0xd9, 0x6c, 0x2a, 0x67, 0x03, 0x99, 0x02, 0xdf, 0xc6, 0xdc, 0xa6,
-	0xcd, 0xee, 0xd1, 0x66, 0x3f, 0x01, 0xed, 0x94, 0x9b, 0xf1, 0x1d, 0x8c, 0x2c, 0x35, 0xdb, 0xa7,
-	0xc1, 0x50, 0x25, 0xbc, 0x89, 0x6f, 0xe7, 0x7f, 0xb2, 0x3b, 0xb4, 0x31, 0x30, 0x7a, 0x02, 0xbc,
-	0x15, 0x92, 0x5e, 0x3d, 0x9e, 0x8b, 0xee, 0xa7, 0x06, 0xed, 0x2c, 0xbb, 0x88, 0x46, 0x6f, 0xd8,
-	0x53, 0x7a, 0xff, 0x50, 0x9e, 0x47, 0x45, 0x2e, 0x9d, 0x32, 0x7a, 0x08, 0x90, 0x1f, 0xeb, 0x1c,
-	0x6c, 0x66, 0xb4, 0x55, 0x67, 0x80, 0x15, 0x05, 0xf1, 0xdf, 0x22, 0xfe, 0x02, 0x87, 0xf2, 0xbc,
-	0xaf, 0xe5, 0xc4, 0xa9, 0x33, 0x18, 0xa9, 0x14, 0xb0, 0xbf, 0x20, 0xde, 0xb4, 0x59, 0x48, 0xdb,
-	0x23, 0xe3, 0xe4, 0xf4, 0x38, 0xc3, 0x54, 0x80, 0xa9, 0x55, 0xcb, 0x57, 0x86, 0x32, 0x32, 0x1f,
-	0x34, 0x66, 0xea, 0x98, 0x59, 0x37, 0x3d, 0x10, 0x7f, 0x1e, 0x39, 0x99, 0x66, 0x58, 0x6a, 0x10,
-	0x57, 0x06, 0xd6, 0x64, 0x9f, 0xe1, 0xbf, 0x62, 0x93, 0xcd, 0x78, 0xa9, 0xfd, 0xbb, 0xc6, 0x30,
-	0x01, 0x75, 0x06, 0xc9, 0x02, 0xda, 0x0e, 0x42, 0xdb, 0xb4, 0x7d, 0xf2, 0x85, 0x49, 0xb3, 0xc2,
-	0x55, 0xc9, 0xe6, 0x3c, 0xb9, 0x61, 0xff, 0x8a, 0xb9, 0xf5, 0x8f, 0x98, 0xe9, 0x1f, 0x31, 0xfb,
-	0x8e, 0x47, 0xb3, 0x0c, 0x78, 0x7b, 0x8e, 0x79, 0xa1, 0xd7, 0x56, 0xa0, 0xb3, 0xb1, 0x02, 0x21,
-	0x6d, 0xf7, 0xed, 0x89, 0x9c, 0xaa, 0x44, 0x3a, 0x93, 0xf3, 0x5d, 0xbc, 0xfa, 0xaa, 0xc5, 0x0e,
-	0x28, 0x7b, 0x2d, 0xad, 0x3b, 0xce, 0x9c, 0x4a, 0xc1, 0xb7, 0xe9, 0x4f, 0xfe, 0x1f, 0x16, 0xf8,
-	0x9b, 0x89, 0x7f, 0xe2, 0x4b, 0xd0, 0x60, 0x95, 0x45, 0x16, 0x7b, 0x73, 0x5e, 0x2b, 0x56, 0xb5,
-	0x64, 0xfb, 0x2b, 0x4b, 0xc6, 0xba, 0xb4, 0x33, 0x28, 0xd2, 0xbe, 0xb6, 0x4e, 0xea, 0x09, 0x58,
-	0xfe, 0x3f, 0x0e, 0xd7, 0xbc, 0xee, 0x23, 0xda, 0x8e, 0xc6, 0x15, 0xb4, 0x5b, 0xa4, 0x16, 0x91,
-	0x92, 0x0a, 0x29, 0x1a, 0xcf, 0x9f, 0x5c, 0x5c, 0x89, 0xda, 0xe5, 0x95, 0xa8, 0xdd, 0x5c, 0x09,
-	0xf2, 0xb1, 0x14, 0xe4, 0x6b, 0x29, 0xc8, 0xb7, 0x52, 0x90, 0x8b, 0x52, 0x90, 0xef, 0xa5, 0x20,
-	0x3f, 0x4a, 0x51, 0xbb, 0x29, 0x05, 0xf9, 0x72, 0x2d, 0x6a, 0x17, 0xd7, 0xa2, 0x76, 0x79, 0x2d,
-	0x6a, 0x6f, 0xeb, 0x89, 0x74, 0x72, 0xbc, 0x8d, 0x1f, 0x81, 0xc7, 0x3f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0xce, 0xb6, 0x0b, 0x17, 0x1e, 0x04, 0x00, 0x00,
+	// 637 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0x8d, 0xeb, 0xa4, 0x4d, 0x2e, 0x49, 0x7f, 0x9c, 0x2a, 0x74, 0x02, 0x64, 0x59, 0x11, 0x43,
+	0x24, 0x50, 0x07, 0xd8, 0x10, 0x03, 0xd0, 0xa0, 0x12, 0x89, 0xa6, 0x91, 0x93, 0x76, 0x60, 0xbb,
+	0xc4, 0x9f, 0x1a, 0xab, 0xf1, 0x9d, 0xe5, 0xbb, 0x94, 0x66, 0x63, 0x42, 0x62, 0xe3, 0xcf, 0xe0,
+	0x4f, 0x61, 0xec, 0x58, 0x31, 0x51, 0x67, 0x61, 0xec, 0x9f, 0x80, 0xee, 0x8b, 0xf3, 0xcb, 0x2d,
+	0x55, 0xa7, 0xcb, 0x7b, 0xdf, 0xd3, 0xd9, 0xdf, 0x7b, 0xcf, 0x21, 0x5b, 0x03, 0xe0, 0xb1, 0xee,
+	0x01, 0xd7, 0x7b, 0x51, 0x2c, 0xb5, 0xa4, 0x05, 0x3c, 0x6a, 0xdf, 0xd7, 0x48, 0xe9, 0xe3, 0x6c,
+	0x44, 0x19, 0xd9, 0x68, 0xf3, 0xf1, 0x50, 0x72, 0x9f, 0x59, 0xae, 0x55, 0xaf, 0x78, 0x33, 0x48,
+	0x1f, 0x91, 0xf5, 0xf6, 0xa8, 0x77, 0x06, 0x63, 0xb6, 0x86, 0x83, 0x14, 0xd1, 0xa7, 0xa4, 0xd4,
+	0x09, 0x4e, 0x05, 0xd7, 0xa3, 0x18, 0x98, 0x8d, 0xa3, 0x05, 0x61, 0xee, 0xeb, 0x0c, 0x78, 0xec,
+	0x37, 0x1b, 0x2c, 0xef, 0x5a, 0xf5, 0xaa, 0x37, 0x83, 0xf4, 0x19, 0xa9, 0x9e, 0x40, 0xac, 0x02,
+	0x29, 0x5a, 0xa3, 0xb0, 0x07, 0x31, 0x2b, 0xb8, 0x56, 0xbd, 0xe4, 0xad, 0x92, 0xb4, 0x4e, 0xb6,
+	0x5a, 0xd2, 0x87, 0x46, 0xa0, 0xa2, 0x21, 0x1f, 0xb7, 0x78, 0x08, 0x6c, 0x1d, 0x75, 0x59, 0x9a,
+	0x3e, 0x26, 0xc5, 0xa6, 0x0f, 0x42, 0x07, 0x7a, 0xcc, 0x36, 0x50, 0x32, 0xc7, 0x74, 0x9b, 0xd8,
+	0xed, 0xc0, 0x67, 0x45, 0x7c, 0x3b, 0xf3, 0x93, 0xee, 0x92, 0x42, 0x4b, 0x8a, 0x3e, 0xb0, 0x92,
+	0x6b, 0xd5, 0xf3, 0xde, 0x14, 0xd4, 0xbe, 0x15, 0x48, 0x65, 0xee, 0x45, 0xa3, 0x7b, 0x44, 0xdf,
+	0x92, 0x27, 0x87, 0xfc, 0xa2, 0x31, 0x8a, 0xb9, 0x0e, 0xa4, 0x68, 0x03, 0xc4, 0xc7, 0x22, 0x06,
+	0x15, 0x49, 0xa1, 0x82, 0x73, 0x40, 0x8b, 0x6c, 0xef, 0x3e, 0x89, 0x59, 0xe0, 0x90, 0x5f, 0x34,
+	0x05, 0xef, 0xeb, 0xe0, 0x1c, 0xba, 0x41, 0x08, 0xe8, 0x9f, 0xed, 0x65, 0x69, 0xea, 0x92, 0x72,
+	0x57, 0x6a, 0x3e, 0x3c, 0x8e, 0x50, 0x65, 0xa3, 0x6a, 0x99, 0x32, 0x96, 0x21, 0x6c, 0xc8, 0x2f,
+	0x02, 0x35, 0x79, 0xd4, 0xac, 0x92, 0x26, 0x10, 0x73, 0x76, 0x34, 0x0f, 0x23, 0x34, 0xd5, 0xf6,
+	0x16, 0x04, 0xda, 0xa4, 0xde, 0xe1, 0x53, 0xd1, 0xc9, 0xa2, 0x37, 0xc7, 0xe6, 0x5d, 0x3d, 0xe8,
+	0x43, 0x70, 0x0e, 0xfe, 0x2c, 0xb4, 0x0d, 0x0c, 0x2d, 0x4b, 0x1b, 0xe5, 0xbe, 0x0c, 0xa3, 0x91,
+	0x5e, 0x28, 0x8b, 0x53, 0x65, 0x86, 0xbe, 0x1d, 0x73, 0xe9, 0x81, 0x31, 0x93, 0xff, 0xc6, 0x6c,
+	0x3c, 0xee, 0x8e, 0x23, 0x60, 0xe5, 0x69, 0xcc, 0x33, 0xbc, 0x52, 0x81, 0x4a, 0xa6, 0x02, 0x2e,
+	0x29, 0x37, 0xd5, 0x09, 0x1f, 0x06, 0x3e, 0xd7, 0x32, 0x66, 0x55, 0x5c, 0x7d, 0x99, 0xa2, 0x7b,
+	0x84, 0x7e, 0xe2, 0x4a, 0x1f, 0x47, 0x3a, 0x08, 0xc1, 0xb8, 0x69, 0x4e, 0xb6, 0x89, 0x06, 0xde,
+	0x31, 0x31, 0x37, 0x1e, 0x80, 0x00, 0x15, 0x28, 0xcc, 0x62, 0x6b, 0x9a, 0xd7, 0x12, 0xb5, 0x28,
+	0xd9, 0xf6, 0x52, 0xc9, 0x68, 0x8d, 0x54, 0x5a, 0xa3, 0xb0, 0x29, 0x94, 0xe6, 0xa2, 0x0f, 0x8a,
+	0xed, 0xe0, 0x70, 0x85, 0xab, 0x3d, 0x27, 0xe5, 0x46, 0x6f, 0x11, 0x5a, 0x1a, 0xa9, 0x32, 0x20,
+	0x2d, 0xdd, 0x82, 0xa8, 0x1d, 0xcc, 0x2d, 0xde, 0x1f, 0x70, 0x71, 0x0a, 0xf7, 0xcb, 0xcd, 0x27,
+	0x99, 0xca, 0xb1, 0x89, 0x25, 0x6f, 0x06, 0x6b, 0xbf, 0x2d, 0xb2, 0xf9, 0x21, 0x92, 0xfd, 0x41,
+	0xba, 0x69, 0xf7, 0xc8, 0xac, 0x80, 0x0c, 0x5e, 0x53, 0xf5, 0xa6, 0x20, 0x5b, 0xd5, 0xb5, 0x07,
+	0x54, 0xd5, 0xbe, 0xab, 0xaa, 0x2f, 0xc8, 0x8e, 0xf9, 0x76, 0x52, 0x47, 0x3b, 0x3a, 0x06, 0x7e,
+	0x96, 0x96, 0xfa, 0xf6, 0x80, 0xbe, 0x21, 0x9b, 0x2b, 0x7b, 0x2a, 0x56, 0x70, 0xed, 0x7a, 0xf9,
+	0xe5, 0xee, 0xf4, 0x0f, 0x6d, 0x6f, 0x65, 0xe8, 0x65, 0xb4, 0xef, 0x5f, 0x5f, 0x5e, 0x3b, 0xb9,
+	0xab, 0x6b, 0x27, 0x77, 0x73, 0xed, 0x58, 0x5f, 0x13, 0xc7, 0xfa, 0x99, 0x38, 0xd6, 0xaf, 0xc4,
+	0xb1, 0x2e, 0x13, 0xc7, 0xfa, 0x93, 0x38, 0xd6, 0xdf, 0xc4, 0xc9, 0xdd, 0x24, 0x8e, 0xf5, 0x63,
+	0xe2, 0xe4, 0x2e, 0x27, 0x4e, 0xee, 0x6a, 0xe2, 0xe4, 0x3e, 0xe7, 0x7d, 0xae, 0x79, 0x6f, 0x1d,
+	0x1f, 0xf0, 0xea, 0xdf, 0x00, 0x47, 0x14, 0xcf, 0xb5, 0x44, 0x05, 0x00, 0x00,
 }
 
 func (this *Heartbeat) Equal(that interface{}) bool {
@@ -539,6 +674,74 @@ func (this *DbTimeStamp) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *VersionChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VersionChange)
+	if !ok {
+		that2, ok := that.(VersionChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *EpochUptimeDTO) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EpochUptimeDTO)
+	if !ok {
+		that2, ok := that.(EpochUptimeDTO)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.TotalUpTime != that1.TotalUpTime {
+		return false
+	}
+	if this.TotalDownTime != that1.TotalDownTime {
+		return false
+	}
+	if this.MaxDowntimeStreak != that1.MaxDowntimeStreak {
+		return false
+	}
+	if len(this.VersionChanges) != len(that1.VersionChanges) {
+		return false
+	}
+	for i := range this.VersionChanges {
+		if !this.VersionChanges[i].Equal(that1.VersionChanges[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *Heartbeat) GoString() string {
 	if this == nil {
 		return "nil"
@@ -593,6 +796,33 @@ func (this *DbTimeStamp) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *VersionChange) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&data.VersionChange{")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EpochUptimeDTO) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&data.EpochUptimeDTO{")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "TotalUpTime: "+fmt.Sprintf("%#v", this.TotalUpTime)+",\n")
+	s = append(s, "TotalDownTime: "+fmt.Sprintf("%#v", this.TotalDownTime)+",\n")
+	s = append(s, "MaxDowntimeStreak: "+fmt.Sprintf("%#v", this.MaxDowntimeStreak)+",\n")
+	if this.VersionChanges != nil {
+		s = append(s, "VersionChanges: "+fmt.Sprintf("%#v", this.VersionChanges)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringHeartbeat(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -841,6 +1071,98 @@ func (m *DbTimeStamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VersionChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintHeartbeat(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochUptimeDTO) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochUptimeDTO) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochUptimeDTO) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VersionChanges) > 0 {
+		for iNdEx := len(m.VersionChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VersionChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHeartbeat(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxDowntimeStreak != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.MaxDowntimeStreak))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalDownTime != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.TotalDownTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalUpTime != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.TotalUpTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintHeartbeat(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHeartbeat(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHeartbeat(v)
 	base := offset
@@ -971,6 +1293,49 @@ func (m *DbTimeStamp) Size() (n int) {
 	return n
 }
 
+func (m *VersionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovHeartbeat(uint64(m.Timestamp))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovHeartbeat(uint64(l))
+	}
+	return n
+}
+
+func (m *EpochUptimeDTO) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovHeartbeat(uint64(m.Epoch))
+	}
+	if m.TotalUpTime != 0 {
+		n += 1 + sovHeartbeat(uint64(m.TotalUpTime))
+	}
+	if m.TotalDownTime != 0 {
+		n += 1 + sovHeartbeat(uint64(m.TotalDownTime))
+	}
+	if m.MaxDowntimeStreak != 0 {
+		n += 1 + sovHeartbeat(uint64(m.MaxDowntimeStreak))
+	}
+	if len(m.VersionChanges) > 0 {
+		for _, e := range m.VersionChanges {
+			l = e.Size()
+			n += 1 + l + sovHeartbeat(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovHeartbeat(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1021,12 +1386,42 @@ func (this *HeartbeatDTO) String() string {
 	}, "")
 	return s
 }
-func (this *DbTimeStamp) String() string {
+func (this *DbTimeStamp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DbTimeStamp{`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VersionChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VersionChange{`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EpochUptimeDTO) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&DbTimeStamp{`,
-		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+	repeatedStringForVersionChanges := "[]*VersionChange{"
+	for _, f := range this.VersionChanges {
+		repeatedStringForVersionChanges += strings.Replace(f.String(), "VersionChange", "VersionChange", 1) + ","
+	}
+	repeatedStringForVersionChanges += "}"
+	s := strings.Join([]string{`&EpochUptimeDTO{`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`TotalUpTime:` + fmt.Sprintf("%v", this.TotalUpTime) + `,`,
+		`TotalDownTime:` + fmt.Sprintf("%v", this.TotalDownTime) + `,`,
+		`MaxDowntimeStreak:` + fmt.Sprintf("%v", this.MaxDowntimeStreak) + `,`,
+		`VersionChanges:` + repeatedStringForVersionChanges + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1864,6 +2259,273 @@ func (m *DbTimeStamp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VersionChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeartbeat
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VersionChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VersionChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeartbeat(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochUptimeDTO) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeartbeat
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochUptimeDTO: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochUptimeDTO: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUpTime", wireType)
+			}
+			m.TotalUpTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalUpTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDownTime", wireType)
+			}
+			m.TotalDownTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalDownTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDowntimeStreak", wireType)
+			}
+			m.MaxDowntimeStreak = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDowntimeStreak |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionChanges = append(m.VersionChanges, &VersionChange{})
+			if err := m.VersionChanges[len(m.VersionChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeartbeat(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHeartbeat
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipHeartbeat(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
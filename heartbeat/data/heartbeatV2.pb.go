@@ -0,0 +1,1041 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: heartbeatV2.proto
+
+package data
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PeerAuthentication is the rarely sent message that binds a validator public key to a peer ID until
+// the expiry timestamp. The signature is computed with the validator key over the marshaled message
+// with an empty Signature field
+type PeerAuthentication struct {
+	Pubkey          []byte `protobuf:"bytes,1,opt,name=Pubkey,proto3" json:"Pubkey,omitempty"`
+	Signature       []byte `protobuf:"bytes,2,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	Pid             []byte `protobuf:"bytes,3,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	ShardID         uint32 `protobuf:"varint,4,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	VersionNumber   string `protobuf:"bytes,5,opt,name=VersionNumber,proto3" json:"VersionNumber,omitempty"`
+	NodeDisplayName string `protobuf:"bytes,6,opt,name=NodeDisplayName,proto3" json:"NodeDisplayName,omitempty"`
+	Identity        string `protobuf:"bytes,7,opt,name=Identity,proto3" json:"Identity,omitempty"`
+	Timestamp       int64  `protobuf:"varint,8,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	ExpiryTimestamp int64  `protobuf:"varint,9,opt,name=ExpiryTimestamp,proto3" json:"ExpiryTimestamp,omitempty"`
+}
+
+func (m *PeerAuthentication) Reset()      { *m = PeerAuthentication{} }
+func (*PeerAuthentication) ProtoMessage() {}
+func (*PeerAuthentication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a015ba718fb71d33, []int{0}
+}
+func (m *PeerAuthentication) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerAuthentication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerAuthentication.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerAuthentication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerAuthentication.Merge(m, src)
+}
+func (m *PeerAuthentication) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerAuthentication) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerAuthentication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerAuthentication proto.InternalMessageInfo
+
+func (m *PeerAuthentication) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *PeerAuthentication) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *PeerAuthentication) GetPid() []byte {
+	if m != nil {
+		return m.Pid
+	}
+	return nil
+}
+
+func (m *PeerAuthentication) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *PeerAuthentication) GetVersionNumber() string {
+	if m != nil {
+		return m.VersionNumber
+	}
+	return ""
+}
+
+func (m *PeerAuthentication) GetNodeDisplayName() string {
+	if m != nil {
+		return m.NodeDisplayName
+	}
+	return ""
+}
+
+func (m *PeerAuthentication) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *PeerAuthentication) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *PeerAuthentication) GetExpiryTimestamp() int64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
+// HeartbeatV2 represents the compact liveness message that is periodically sent between peers. The sender
+// is identified through the peer authentication message previously received from the same peer ID
+type HeartbeatV2 struct {
+	Nonce     uint64 `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+}
+
+func (m *HeartbeatV2) Reset()      { *m = HeartbeatV2{} }
+func (*HeartbeatV2) ProtoMessage() {}
+func (*HeartbeatV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a015ba718fb71d33, []int{1}
+}
+func (m *HeartbeatV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeartbeatV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeartbeatV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeartbeatV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeartbeatV2.Merge(m, src)
+}
+func (m *HeartbeatV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeartbeatV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeartbeatV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeartbeatV2 proto.InternalMessageInfo
+
+func (m *HeartbeatV2) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *HeartbeatV2) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PeerAuthentication)(nil), "proto.PeerAuthentication")
+	proto.RegisterType((*HeartbeatV2)(nil), "proto.HeartbeatV2")
+}
+
+func init() { proto.RegisterFile("heartbeatV2.proto", fileDescriptor_a015ba718fb71d33) }
+
+var fileDescriptor_a015ba718fb71d33 = []byte{
+	// 329 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xbd, 0x4e, 0xeb, 0x30,
+	0x18, 0x86, 0xe3, 0xfe, 0xd7, 0xe7, 0x54, 0xe7, 0x1c, 0xeb, 0x08, 0x59, 0x08, 0x7d, 0x8a, 0x2a,
+	0x86, 0x4c, 0x0c, 0xb0, 0xb1, 0x15, 0x15, 0x89, 0x2e, 0x51, 0x95, 0xa2, 0x0e, 0x6c, 0x4e, 0xf3,
+	0x89, 0x5a, 0x90, 0x1f, 0xb9, 0x8e, 0x44, 0x36, 0x2e, 0x81, 0xcb, 0x40, 0x5c, 0x09, 0x63, 0xc7,
+	0x8e, 0xd4, 0x5d, 0x18, 0x7b, 0x09, 0x28, 0xe6, 0x27, 0x6a, 0x27, 0xfb, 0x7d, 0xfc, 0x48, 0xaf,
+	0xfd, 0x99, 0xfe, 0x9b, 0xa3, 0x50, 0x3a, 0x44, 0xa1, 0xa7, 0xa7, 0x27, 0x99, 0x4a, 0x75, 0xca,
+	0x9a, 0x76, 0xe9, 0xbf, 0xd4, 0x28, 0x1b, 0x23, 0xaa, 0x41, 0xae, 0xe7, 0x98, 0x68, 0x39, 0x13,
+	0x5a, 0xa6, 0x09, 0x3b, 0xa0, 0xad, 0x71, 0x1e, 0xde, 0x61, 0xc1, 0x89, 0x4b, 0xbc, 0xdf, 0xc1,
+	0x57, 0x62, 0x47, 0xb4, 0x3b, 0x91, 0xb7, 0x89, 0xd0, 0xb9, 0x42, 0x5e, 0xb3, 0x47, 0x15, 0x60,
+	0x7f, 0x69, 0x7d, 0x2c, 0x23, 0x5e, 0xb7, 0xbc, 0xdc, 0x32, 0x4e, 0xdb, 0x93, 0xb9, 0x50, 0xd1,
+	0x68, 0xc8, 0x1b, 0x2e, 0xf1, 0x7a, 0xc1, 0x77, 0x64, 0xc7, 0xb4, 0x37, 0x45, 0xb5, 0x90, 0x69,
+	0xe2, 0xe7, 0x71, 0x88, 0x8a, 0x37, 0x5d, 0xe2, 0x75, 0x83, 0x5d, 0xc8, 0x3c, 0xfa, 0xc7, 0x4f,
+	0x23, 0x1c, 0xca, 0x45, 0x76, 0x2f, 0x0a, 0x5f, 0xc4, 0xc8, 0x5b, 0xd6, 0xdb, 0xc7, 0xec, 0x90,
+	0x76, 0x46, 0x51, 0xf9, 0x02, 0x5d, 0xf0, 0xb6, 0x55, 0x7e, 0x72, 0x79, 0xeb, 0x6b, 0x19, 0xe3,
+	0x42, 0x8b, 0x38, 0xe3, 0x1d, 0x97, 0x78, 0xf5, 0xa0, 0x02, 0x65, 0xc7, 0xe5, 0x43, 0x26, 0x55,
+	0x51, 0x39, 0x5d, 0xeb, 0xec, 0xe3, 0xfe, 0x80, 0xfe, 0xba, 0xaa, 0x06, 0xc9, 0xfe, 0xd3, 0xa6,
+	0x9f, 0x26, 0x33, 0xb4, 0x33, 0x6a, 0x04, 0x9f, 0x61, 0xb7, 0xac, 0xb6, 0x57, 0x76, 0x71, 0xbe,
+	0x5c, 0x83, 0xb3, 0x5a, 0x83, 0xb3, 0x5d, 0x03, 0x79, 0x34, 0x40, 0x9e, 0x0d, 0x90, 0x57, 0x03,
+	0x64, 0x69, 0x80, 0xbc, 0x19, 0x20, 0xef, 0x06, 0x9c, 0xad, 0x01, 0xf2, 0xb4, 0x01, 0x67, 0xb9,
+	0x01, 0x67, 0xb5, 0x01, 0xe7, 0xa6, 0x11, 0x09, 0x2d, 0xc2, 0x96, 0xfd, 0xb2, 0xb3, 0x8f, 0x01,
+	0x00, 0x8b, 0x4c, 0x97, 0xe7, 0xce, 0x01, 0x00, 0x00,
+}
+
+func (this *PeerAuthentication) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PeerAuthentication)
+	if !ok {
+		that2, ok := that.(PeerAuthentication)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Pubkey, that1.Pubkey) {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	if !bytes.Equal(this.Pid, that1.Pid) {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if this.VersionNumber != that1.VersionNumber {
+		return false
+	}
+	if this.NodeDisplayName != that1.NodeDisplayName {
+		return false
+	}
+	if this.Identity != that1.Identity {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.ExpiryTimestamp != that1.ExpiryTimestamp {
+		return false
+	}
+	return true
+}
+func (this *HeartbeatV2) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HeartbeatV2)
+	if !ok {
+		that2, ok := that.(HeartbeatV2)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	return true
+}
+func (this *PeerAuthentication) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&data.PeerAuthentication{")
+	s = append(s, "Pubkey: "+fmt.Sprintf("%#v", this.Pubkey)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "Pid: "+fmt.Sprintf("%#v", this.Pid)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "VersionNumber: "+fmt.Sprintf("%#v", this.VersionNumber)+",\n")
+	s = append(s, "NodeDisplayName: "+fmt.Sprintf("%#v", this.NodeDisplayName)+",\n")
+	s = append(s, "Identity: "+fmt.Sprintf("%#v", this.Identity)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "ExpiryTimestamp: "+fmt.Sprintf("%#v", this.ExpiryTimestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HeartbeatV2) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&data.HeartbeatV2{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringHeartbeatV2(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *PeerAuthentication) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerAuthentication) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerAuthentication) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.NodeDisplayName) > 0 {
+		i -= len(m.NodeDisplayName)
+		copy(dAtA[i:], m.NodeDisplayName)
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(len(m.NodeDisplayName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.VersionNumber) > 0 {
+		i -= len(m.VersionNumber)
+		copy(dAtA[i:], m.VersionNumber)
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(len(m.VersionNumber)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Pid) > 0 {
+		i -= len(m.Pid)
+		copy(dAtA[i:], m.Pid)
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(len(m.Pid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeartbeatV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeartbeatV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeartbeatV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintHeartbeatV2(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHeartbeatV2(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHeartbeatV2(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PeerAuthentication) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovHeartbeatV2(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovHeartbeatV2(uint64(l))
+	}
+	l = len(m.Pid)
+	if l > 0 {
+		n += 1 + l + sovHeartbeatV2(uint64(l))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovHeartbeatV2(uint64(m.ShardID))
+	}
+	l = len(m.VersionNumber)
+	if l > 0 {
+		n += 1 + l + sovHeartbeatV2(uint64(l))
+	}
+	l = len(m.NodeDisplayName)
+	if l > 0 {
+		n += 1 + l + sovHeartbeatV2(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovHeartbeatV2(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovHeartbeatV2(uint64(m.Timestamp))
+	}
+	if m.ExpiryTimestamp != 0 {
+		n += 1 + sovHeartbeatV2(uint64(m.ExpiryTimestamp))
+	}
+	return n
+}
+
+func (m *HeartbeatV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovHeartbeatV2(uint64(m.Nonce))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovHeartbeatV2(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func sovHeartbeatV2(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHeartbeatV2(x uint64) (n int) {
+	return sovHeartbeatV2(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *PeerAuthentication) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PeerAuthentication{`,
+		`Pubkey:` + fmt.Sprintf("%v", this.Pubkey) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`VersionNumber:` + fmt.Sprintf("%v", this.VersionNumber) + `,`,
+		`NodeDisplayName:` + fmt.Sprintf("%v", this.NodeDisplayName) + `,`,
+		`Identity:` + fmt.Sprintf("%v", this.Identity) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`ExpiryTimestamp:` + fmt.Sprintf("%v", this.ExpiryTimestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HeartbeatV2) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HeartbeatV2{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringHeartbeatV2(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *PeerAuthentication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeartbeatV2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerAuthentication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerAuthentication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = append(m.Pubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pubkey == nil {
+				m.Pubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pid = append(m.Pid[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pid == nil {
+				m.Pid = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VersionNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeDisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeDisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeartbeatV2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeartbeatV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHeartbeatV2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeartbeatV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeartbeatV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHeartbeatV2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHeartbeatV2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHeartbeatV2(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHeartbeatV2
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHeartbeatV2
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHeartbeatV2
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHeartbeatV2
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHeartbeatV2
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHeartbeatV2        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHeartbeatV2          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHeartbeatV2 = fmt.Errorf("proto: unexpected end of group")
+)
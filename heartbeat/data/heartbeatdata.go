@@ -1,4 +1,5 @@
 //go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. heartbeat.proto
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. heartbeatV2.proto
 package data
 
 import (
@@ -25,6 +26,34 @@ type PubKeyHeartbeat struct {
 	NumInstances    uint64    `json:"numInstances"`
 }
 
+// EpochUptime holds the availability of a public key during one epoch
+type EpochUptime struct {
+	Epoch             uint32  `json:"epoch"`
+	UpTime            int64   `json:"upTimeSec"`
+	DownTime          int64   `json:"downTimeSec"`
+	UptimePercentage  float64 `json:"uptimePercentage"`
+	MaxDowntimeStreak int64   `json:"maxDowntimeStreakSec"`
+	NumVersionChanges int     `json:"numVersionChanges"`
+}
+
+// VersionChangeInfo holds the moment a public key started to report another node version
+type VersionChangeInfo struct {
+	Epoch     uint32 `json:"epoch"`
+	Timestamp int64  `json:"timestamp"`
+	Version   string `json:"version"`
+}
+
+// UptimeReport holds the availability of a public key during a range of epochs
+type UptimeReport struct {
+	PublicKey         string              `json:"publicKey"`
+	FromEpoch         uint32              `json:"fromEpoch"`
+	ToEpoch           uint32              `json:"toEpoch"`
+	UptimePercentage  float64             `json:"uptimePercentage"`
+	MaxDowntimeStreak int64               `json:"maxDowntimeStreakSec"`
+	Epochs            []EpochUptime       `json:"epochs"`
+	VersionChanges    []VersionChangeInfo `json:"versionChanges"`
+}
+
 // Duration is a wrapper of the original Duration struct
 // that has JSON marshal and unmarshal capabilities
 // golang issue: https://github.com/golang/go/issues/10275
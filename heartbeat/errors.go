@@ -101,3 +101,43 @@ var ErrNilPeerSignatureHandler = errors.New("trying to set nil peerSignatureHand
 
 // ErrNilCurrentBlockProvider signals that a nil current block provider
 var ErrNilCurrentBlockProvider = errors.New("nil current block provider")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilPeerAuthenticationCache signals that a nil peer authentication cache has been provided
+var ErrNilPeerAuthenticationCache = errors.New("nil peer authentication cache")
+
+// ErrNilNewPeerAuthenticationNotifier signals that a nil new peer authentication notifier has been provided
+var ErrNilNewPeerAuthenticationNotifier = errors.New("nil new peer authentication notifier")
+
+// ErrNilHeartbeatReceiver signals that a nil heartbeat receiver has been provided
+var ErrNilHeartbeatReceiver = errors.New("nil heartbeat receiver")
+
+// ErrInvalidMaxExpiryDuration signals that an invalid maximum expiry duration has been provided
+var ErrInvalidMaxExpiryDuration = errors.New("invalid max expiry duration")
+
+// ErrInvalidPeerAuthenticationTimeBetweenSendsInSec is raised when a value less than 1 has been provided
+var ErrInvalidPeerAuthenticationTimeBetweenSendsInSec = errors.New("value PeerAuthenticationTimeBetweenSendsInSec is less than 1")
+
+// ErrPeerAuthenticationExpired signals that an expired peer authentication message has been received
+var ErrPeerAuthenticationExpired = errors.New("peer authentication expired")
+
+// ErrInvalidPeerAuthenticationTimestamps signals that a peer authentication message has inconsistent timestamps
+var ErrInvalidPeerAuthenticationTimestamps = errors.New("invalid peer authentication timestamps")
+
+// ErrPeerAuthenticationNotFound signals that no valid peer authentication is known for the originator of a
+// heartbeat v2 message
+var ErrPeerAuthenticationNotFound = errors.New("peer authentication not found")
+
+// ErrNilEpochStartEventNotifier signals that a nil epoch start event notifier has been provided
+var ErrNilEpochStartEventNotifier = errors.New("nil epoch start event notifier")
+
+// ErrInvalidEpochRange signals that an invalid range of epochs has been provided
+var ErrInvalidEpochRange = errors.New("invalid epoch range")
+
+// ErrUptimeNotFound signals that no uptime information was found for the provided public key
+var ErrUptimeNotFound = errors.New("uptime information not found")
@@ -34,6 +34,28 @@ type MessageHandler interface {
 	IsInterfaceNil() bool
 }
 
+// PeerAuthenticationCacher defines the behavior of a component able to hold the valid peer authentication messages
+// indexed by the peer ID that originated them
+type PeerAuthenticationCacher interface {
+	Put(peerAuthentication *heartbeatData.PeerAuthentication)
+	Get(pid core.PeerID) (*heartbeatData.PeerAuthentication, bool)
+	Sweep()
+	IsInterfaceNil() bool
+}
+
+// NewPeerAuthenticationNotifier defines the behavior of a component notified each time a valid peer authentication
+// is received from a peer ID that had no valid peer authentication before
+type NewPeerAuthenticationNotifier interface {
+	NotifyNewPeerAuthentication(pid core.PeerID)
+	IsInterfaceNil() bool
+}
+
+// HeartbeatReceiver defines the behavior of a component able to record already validated heartbeat messages
+type HeartbeatReceiver interface {
+	AddHeartbeat(hb *heartbeatData.Heartbeat)
+	IsInterfaceNil() bool
+}
+
 // EligibleListProvider defines what an eligible list provider should do
 type EligibleListProvider interface {
 	GetAllEligibleValidatorsPublicKeys(epoch uint32) (map[uint32][][]byte, error)
@@ -55,6 +77,8 @@ type HeartbeatStorageHandler interface {
 	SavePubkeyData(pubkey []byte, heartbeat *heartbeatData.HeartbeatDTO) error
 	LoadKeys() ([][]byte, error)
 	SaveKeys(peersSlice [][]byte) error
+	SaveEpochUptime(pubkey []byte, epochUptime *heartbeatData.EpochUptimeDTO) error
+	LoadEpochUptime(pubkey []byte, epoch uint32) (*heartbeatData.EpochUptimeDTO, error)
 	IsInterfaceNil() bool
 }
 
@@ -0,0 +1,22 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+)
+
+// HeartbeatReceiverStub -
+type HeartbeatReceiverStub struct {
+	AddHeartbeatCalled func(hb *data.Heartbeat)
+}
+
+// AddHeartbeat -
+func (hrs *HeartbeatReceiverStub) AddHeartbeat(hb *data.Heartbeat) {
+	if hrs.AddHeartbeatCalled != nil {
+		hrs.AddHeartbeatCalled(hb)
+	}
+}
+
+// IsInterfaceNil -
+func (hrs *HeartbeatReceiverStub) IsInterfaceNil() bool {
+	return hrs == nil
+}
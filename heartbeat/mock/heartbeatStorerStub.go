@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"errors"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
@@ -14,6 +15,8 @@ type HeartbeatStorerStub struct {
 	SavePubkeyDataCalled    func(pubkey []byte, heartbeat *data.HeartbeatDTO) error
 	LoadKeysCalled          func() ([][]byte, error)
 	SaveKeysCalled          func(peersSlice [][]byte) error
+	SaveEpochUptimeCalled   func(pubkey []byte, epochUptime *data.EpochUptimeDTO) error
+	LoadEpochUptimeCalled   func(pubkey []byte, epoch uint32) (*data.EpochUptimeDTO, error)
 }
 
 // LoadGenesisTime -
@@ -46,6 +49,24 @@ func (hss *HeartbeatStorerStub) SaveKeys(peersSlice [][]byte) error {
 	return hss.SaveKeysCalled(peersSlice)
 }
 
+// SaveEpochUptime -
+func (hss *HeartbeatStorerStub) SaveEpochUptime(pubkey []byte, epochUptime *data.EpochUptimeDTO) error {
+	if hss.SaveEpochUptimeCalled != nil {
+		return hss.SaveEpochUptimeCalled(pubkey, epochUptime)
+	}
+
+	return nil
+}
+
+// LoadEpochUptime -
+func (hss *HeartbeatStorerStub) LoadEpochUptime(pubkey []byte, epoch uint32) (*data.EpochUptimeDTO, error) {
+	if hss.LoadEpochUptimeCalled != nil {
+		return hss.LoadEpochUptimeCalled(pubkey, epoch)
+	}
+
+	return nil, errors.New("epoch uptime not found")
+}
+
 // IsInterfaceNil -
 func (hss *HeartbeatStorerStub) IsInterfaceNil() bool {
 	return false
@@ -0,0 +1,22 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+)
+
+// NewPeerAuthenticationNotifierStub -
+type NewPeerAuthenticationNotifierStub struct {
+	NotifyNewPeerAuthenticationCalled func(pid core.PeerID)
+}
+
+// NotifyNewPeerAuthentication -
+func (stub *NewPeerAuthenticationNotifierStub) NotifyNewPeerAuthentication(pid core.PeerID) {
+	if stub.NotifyNewPeerAuthenticationCalled != nil {
+		stub.NotifyNewPeerAuthenticationCalled(pid)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *NewPeerAuthenticationNotifierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 )
 
 // heartbeatMessageInfo retain the message info received from another node (identified by a public key)
@@ -29,6 +30,11 @@ type heartbeatMessageInfo struct {
 	isActive                    bool
 	nonce                       uint64
 	numInstances                uint64
+	epochUpTime                 time.Duration
+	epochDownTime               time.Duration
+	downtimeStreak              time.Duration
+	epochMaxDowntimeStreak      time.Duration
+	epochVersionChanges         []*data.VersionChange
 }
 
 // newHeartbeatMessageInfo returns a new instance of a heartbeatMessageInfo
@@ -113,11 +119,27 @@ func (hbmi *heartbeatMessageInfo) updateUpAndDownTime(crtTime time.Time) {
 
 	hbmi.totalUpTime += uptime
 	hbmi.totalDownTime += downTime
+	hbmi.updateEpochUptime(uptime, downTime)
 
 	hbmi.isActive = uptime == lastDuration
 	hbmi.lastUptimeDowntime = crtTime
 }
 
+// Will update the time a node was up and down during the current epoch. Inside the computed interval the up time
+// always precedes the down time so any up time ends the current downtime streak
+func (hbmi *heartbeatMessageInfo) updateEpochUptime(uptime time.Duration, downtime time.Duration) {
+	hbmi.epochUpTime += uptime
+	hbmi.epochDownTime += downtime
+
+	if uptime > 0 {
+		hbmi.downtimeStreak = 0
+	}
+	hbmi.downtimeStreak += downtime
+	if hbmi.downtimeStreak > hbmi.epochMaxDowntimeStreak {
+		hbmi.epochMaxDowntimeStreak = hbmi.downtimeStreak
+	}
+}
+
 func (hbmi *heartbeatMessageInfo) computeUptimeDowntime(
 	crtTime time.Time,
 	lastDuration time.Duration,
@@ -161,6 +183,13 @@ func (hbmi *heartbeatMessageInfo) HeartbeatReceived(
 	defer hbmi.updateMutex.Unlock()
 	crtTime := hbmi.getTimeHandler()
 
+	if len(version) > 0 && version != hbmi.versionNumber {
+		hbmi.epochVersionChanges = append(hbmi.epochVersionChanges, &data.VersionChange{
+			Timestamp: crtTime.Unix(),
+			Version:   version,
+		})
+	}
+
 	hbmi.computedShardID = computedShardID
 	hbmi.receivedShardID = receivedShardID
 	hbmi.versionNumber = version
@@ -207,6 +236,54 @@ func maxDuration(first, second time.Duration) time.Duration {
 	return second
 }
 
+// EpochUptime returns the availability accumulated during the current epoch
+func (hbmi *heartbeatMessageInfo) EpochUptime(epoch uint32) *data.EpochUptimeDTO {
+	hbmi.updateMutex.Lock()
+	defer hbmi.updateMutex.Unlock()
+
+	return hbmi.createEpochUptime(epoch)
+}
+
+// CloseEpoch returns the availability accumulated during the ending epoch and resets it for the next one.
+// The current downtime streak is kept as it continues in the next epoch
+func (hbmi *heartbeatMessageInfo) CloseEpoch(epoch uint32) *data.EpochUptimeDTO {
+	hbmi.updateMutex.Lock()
+	defer hbmi.updateMutex.Unlock()
+
+	epochUptime := hbmi.createEpochUptime(epoch)
+
+	hbmi.epochUpTime = 0
+	hbmi.epochDownTime = 0
+	hbmi.epochMaxDowntimeStreak = hbmi.downtimeStreak
+	hbmi.epochVersionChanges = nil
+
+	return epochUptime
+}
+
+func (hbmi *heartbeatMessageInfo) createEpochUptime(epoch uint32) *data.EpochUptimeDTO {
+	versionChanges := make([]*data.VersionChange, len(hbmi.epochVersionChanges))
+	copy(versionChanges, hbmi.epochVersionChanges)
+
+	return &data.EpochUptimeDTO{
+		Epoch:             epoch,
+		TotalUpTime:       hbmi.epochUpTime.Nanoseconds(),
+		TotalDownTime:     hbmi.epochDownTime.Nanoseconds(),
+		MaxDowntimeStreak: hbmi.epochMaxDowntimeStreak.Nanoseconds(),
+		VersionChanges:    versionChanges,
+	}
+}
+
+// SetEpochUptime restores the availability accumulated during the current epoch from a stored sample
+func (hbmi *heartbeatMessageInfo) SetEpochUptime(epochUptime *data.EpochUptimeDTO) {
+	hbmi.updateMutex.Lock()
+	defer hbmi.updateMutex.Unlock()
+
+	hbmi.epochUpTime = time.Duration(epochUptime.TotalUpTime)
+	hbmi.epochDownTime = time.Duration(epochUptime.TotalDownTime)
+	hbmi.epochMaxDowntimeStreak = time.Duration(epochUptime.MaxDowntimeStreak)
+	hbmi.epochVersionChanges = epochUptime.VersionChanges
+}
+
 // GetIsActive will return true if the peer is set as active
 func (hbmi *heartbeatMessageInfo) GetIsActive() bool {
 	hbmi.updateMutex.Lock()
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/stretchr/testify/assert"
@@ -298,3 +299,70 @@ func TestHeartbeatMessageInfo_Update(t *testing.T) {
 	assert.Equal(t, computedShardId, hbmi.GetComputedShardId())
 	assert.Equal(t, peerType, hbmi.GetPeerType())
 }
+
+//------- EpochUptime
+
+func TestHeartbeatMessageInfo_EpochUptimeShouldAccumulateAndCloseEpoch(t *testing.T) {
+	t.Parallel()
+
+	mockTimer := mock.NewTimerMock()
+	hbmi, _ := process.NewHeartbeatMessageInfo(
+		10*time.Second,
+		dummyPeerType,
+		mockTimer.Now(),
+		mockTimer,
+	)
+
+	mockTimer.IncrementSeconds(1)
+	hbmi.HeartbeatReceived(0, 0, "v1", dummyNodeDisplayName, dummyIdentity, dummyPeerType, 0, 1)
+	mockTimer.IncrementSeconds(30)
+	hbmi.ComputeActive(mockTimer.Now())
+	mockTimer.IncrementSeconds(10)
+	hbmi.HeartbeatReceived(0, 0, "v2", dummyNodeDisplayName, dummyIdentity, dummyPeerType, 1, 1)
+	hbmi.HeartbeatReceived(0, 0, "v2", dummyNodeDisplayName, dummyIdentity, dummyPeerType, 2, 1)
+	mockTimer.IncrementSeconds(5)
+	hbmi.ComputeActive(mockTimer.Now())
+
+	expectedEpochUptime := &data.EpochUptimeDTO{
+		Epoch:             3,
+		TotalUpTime:       int64(15 * time.Second),
+		TotalDownTime:     int64(31 * time.Second),
+		MaxDowntimeStreak: int64(30 * time.Second),
+		VersionChanges: []*data.VersionChange{
+			{Timestamp: 1, Version: "v1"},
+			{Timestamp: 41, Version: "v2"},
+		},
+	}
+	assert.Equal(t, expectedEpochUptime, hbmi.EpochUptime(3))
+	assert.Equal(t, expectedEpochUptime, hbmi.CloseEpoch(3))
+
+	expectedEpochUptime = &data.EpochUptimeDTO{
+		Epoch:          4,
+		VersionChanges: make([]*data.VersionChange, 0),
+	}
+	assert.Equal(t, expectedEpochUptime, hbmi.EpochUptime(4))
+	assert.Equal(t, 46*time.Second, hbmi.GetTotalUpTime()+hbmi.GetTotalDownTime())
+}
+
+func TestHeartbeatMessageInfo_SetEpochUptimeShouldRestore(t *testing.T) {
+	t.Parallel()
+
+	mockTimer := mock.NewTimerMock()
+	hbmi, _ := process.NewHeartbeatMessageInfo(
+		10*time.Second,
+		dummyPeerType,
+		mockTimer.Now(),
+		mockTimer,
+	)
+
+	epochUptime := &data.EpochUptimeDTO{
+		Epoch:             2,
+		TotalUpTime:       int64(15 * time.Second),
+		TotalDownTime:     int64(30 * time.Second),
+		MaxDowntimeStreak: int64(20 * time.Second),
+		VersionChanges:    []*data.VersionChange{{Timestamp: 4, Version: "v1"}},
+	}
+	hbmi.SetEpochUptime(epochUptime)
+
+	assert.Equal(t, epochUptime, hbmi.EpochUptime(2))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 )
 
 const maxSizeInBytes = 128
@@ -69,3 +70,55 @@ func trimLengths(heartbeat *data.Heartbeat) {
 		heartbeat.VersionNumber = heartbeat.VersionNumber[:maxSizeInBytes]
 	}
 }
+
+func verifyPeerAuthenticationLengths(peerAuthentication *data.PeerAuthentication) error {
+	err := VerifyHeartbeatProperyLen("Pubkey", peerAuthentication.Pubkey)
+	if err != nil {
+		return err
+	}
+
+	err = VerifyHeartbeatProperyLen("Signature", peerAuthentication.Signature)
+	if err != nil {
+		return err
+	}
+
+	err = VerifyHeartbeatProperyLen("NodeDisplayName", []byte(peerAuthentication.NodeDisplayName))
+	if err != nil {
+		return err
+	}
+
+	err = VerifyHeartbeatProperyLen("Identity", []byte(peerAuthentication.Identity))
+	if err != nil {
+		return err
+	}
+
+	err = VerifyHeartbeatProperyLen("VersionNumber", []byte(peerAuthentication.VersionNumber))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func trimPeerAuthenticationLengths(peerAuthentication *data.PeerAuthentication) {
+	if len(peerAuthentication.NodeDisplayName) > maxSizeInBytes {
+		peerAuthentication.NodeDisplayName = peerAuthentication.NodeDisplayName[:maxSizeInBytes]
+	}
+
+	if len(peerAuthentication.Identity) > maxSizeInBytes {
+		peerAuthentication.Identity = peerAuthentication.Identity[:maxSizeInBytes]
+	}
+
+	if len(peerAuthentication.VersionNumber) > maxSizeInBytes {
+		peerAuthentication.VersionNumber = peerAuthentication.VersionNumber[:maxSizeInBytes]
+	}
+}
+
+// computePeerAuthenticationSignedData returns the bytes covered by the peer authentication signature: the marshaled
+// message with an empty signature field
+func computePeerAuthenticationSignedData(marshalizer marshal.Marshalizer, peerAuthentication *data.PeerAuthentication) ([]byte, error) {
+	unsignedPeerAuthentication := *peerAuthentication
+	unsignedPeerAuthentication.Signature = nil
+
+	return marshalizer.Marshal(&unsignedPeerAuthentication)
+}
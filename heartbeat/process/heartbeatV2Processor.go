@@ -0,0 +1,104 @@
+package process
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// ArgHeartbeatV2Processor represents the arguments for the heartbeat v2 processor
+type ArgHeartbeatV2Processor struct {
+	Marshalizer             marshal.Marshalizer
+	AntifloodHandler        heartbeat.P2PAntifloodHandler
+	PeerAuthenticationCache heartbeat.PeerAuthenticationCacher
+	HeartbeatReceiver       heartbeat.HeartbeatReceiver
+}
+
+// HeartbeatV2Processor processes the compact heartbeat v2 liveness messages. The data that is not carried by
+// these messages is taken from the peer authentication previously received from the same peer ID
+type HeartbeatV2Processor struct {
+	marshalizer             marshal.Marshalizer
+	antifloodHandler        heartbeat.P2PAntifloodHandler
+	peerAuthenticationCache heartbeat.PeerAuthenticationCacher
+	heartbeatReceiver       heartbeat.HeartbeatReceiver
+}
+
+// NewHeartbeatV2Processor creates a new heartbeat v2 processor
+func NewHeartbeatV2Processor(arg ArgHeartbeatV2Processor) (*HeartbeatV2Processor, error) {
+	if check.IfNil(arg.Marshalizer) {
+		return nil, heartbeat.ErrNilMarshalizer
+	}
+	if check.IfNil(arg.AntifloodHandler) {
+		return nil, heartbeat.ErrNilAntifloodHandler
+	}
+	if check.IfNil(arg.PeerAuthenticationCache) {
+		return nil, heartbeat.ErrNilPeerAuthenticationCache
+	}
+	if check.IfNil(arg.HeartbeatReceiver) {
+		return nil, heartbeat.ErrNilHeartbeatReceiver
+	}
+
+	return &HeartbeatV2Processor{
+		marshalizer:             arg.Marshalizer,
+		antifloodHandler:        arg.AntifloodHandler,
+		peerAuthenticationCache: arg.PeerAuthenticationCache,
+		heartbeatReceiver:       arg.HeartbeatReceiver,
+	}, nil
+}
+
+// ProcessReceivedMessage satisfies the p2p.MessageProcessor interface so it can be called
+// by the p2p subsystem each time a new heartbeat v2 message arrives
+func (hp *HeartbeatV2Processor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	if check.IfNil(message) {
+		return heartbeat.ErrNilMessage
+	}
+	if message.Data() == nil {
+		return heartbeat.ErrNilDataToProcess
+	}
+
+	err := hp.antifloodHandler.CanProcessMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+	err = hp.antifloodHandler.CanProcessMessagesOnTopic(fromConnectedPeer, core.HeartbeatV2Topic, 1, uint64(len(message.Data())), message.SeqNo())
+	if err != nil {
+		return err
+	}
+
+	hbV2 := &data.HeartbeatV2{}
+	err = hp.marshalizer.Unmarshal(hbV2, message.Data())
+	if err != nil {
+		reason := "blacklisted due to invalid heartbeat v2 message"
+		hp.antifloodHandler.BlacklistPeer(message.Peer(), reason, core.InvalidMessageBlacklistDuration)
+		hp.antifloodHandler.BlacklistPeer(fromConnectedPeer, reason, core.InvalidMessageBlacklistDuration)
+
+		return err
+	}
+
+	//the peer authentication might not have reached this node yet, so the message is only dropped
+	peerAuthentication, found := hp.peerAuthenticationCache.Get(message.Peer())
+	if !found {
+		return heartbeat.ErrPeerAuthenticationNotFound
+	}
+
+	hb := &data.Heartbeat{
+		Pubkey:          peerAuthentication.Pubkey,
+		ShardID:         peerAuthentication.ShardID,
+		VersionNumber:   peerAuthentication.VersionNumber,
+		NodeDisplayName: peerAuthentication.NodeDisplayName,
+		Identity:        peerAuthentication.Identity,
+		Pid:             peerAuthentication.Pid,
+		Nonce:           hbV2.Nonce,
+	}
+	hp.heartbeatReceiver.AddHeartbeat(hb)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hp *HeartbeatV2Processor) IsInterfaceNil() bool {
+	return hp == nil
+}
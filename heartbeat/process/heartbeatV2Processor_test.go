@@ -0,0 +1,112 @@
+package process_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgHeartbeatV2Processor() process.ArgHeartbeatV2Processor {
+	timer := mock.NewTimerMock()
+	timer.SetSeconds(testCurrentTimeInSec)
+	cache, _ := process.NewPeerAuthenticationCache(timer)
+
+	return process.ArgHeartbeatV2Processor{
+		Marshalizer:             &mock.MarshalizerMock{},
+		AntifloodHandler:        &mock.P2PAntifloodHandlerStub{},
+		PeerAuthenticationCache: cache,
+		HeartbeatReceiver:       &mock.HeartbeatReceiverStub{},
+	}
+}
+
+func createHeartbeatV2Message(pid core.PeerID, nonce uint64) *mock.P2PMessageStub {
+	buff, _ := (&mock.MarshalizerMock{}).Marshal(&data.HeartbeatV2{Nonce: nonce})
+
+	return &mock.P2PMessageStub{
+		DataField: buff,
+		PeerField: pid,
+	}
+}
+
+func TestNewHeartbeatV2Processor_NilPeerAuthenticationCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Processor()
+	arg.PeerAuthenticationCache = nil
+	hp, err := process.NewHeartbeatV2Processor(arg)
+
+	assert.True(t, check.IfNil(hp))
+	assert.Equal(t, heartbeat.ErrNilPeerAuthenticationCache, err)
+}
+
+func TestNewHeartbeatV2Processor_NilHeartbeatReceiverShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatV2Processor()
+	arg.HeartbeatReceiver = nil
+	hp, err := process.NewHeartbeatV2Processor(arg)
+
+	assert.True(t, check.IfNil(hp))
+	assert.Equal(t, heartbeat.ErrNilHeartbeatReceiver, err)
+}
+
+func TestHeartbeatV2Processor_ProcessReceivedMessageUnknownPeerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	addCalled := false
+	arg := createMockArgHeartbeatV2Processor()
+	arg.HeartbeatReceiver = &mock.HeartbeatReceiverStub{
+		AddHeartbeatCalled: func(hb *data.Heartbeat) {
+			addCalled = true
+		},
+	}
+	hp, _ := process.NewHeartbeatV2Processor(arg)
+
+	err := hp.ProcessReceivedMessage(createHeartbeatV2Message("pid", 5), "from")
+
+	assert.Equal(t, heartbeat.ErrPeerAuthenticationNotFound, err)
+	assert.False(t, addCalled)
+}
+
+func TestHeartbeatV2Processor_ProcessReceivedMessageShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var addedHb *data.Heartbeat
+	arg := createMockArgHeartbeatV2Processor()
+	arg.HeartbeatReceiver = &mock.HeartbeatReceiverStub{
+		AddHeartbeatCalled: func(hb *data.Heartbeat) {
+			addedHb = hb
+		},
+	}
+	arg.PeerAuthenticationCache.Put(&data.PeerAuthentication{
+		Pubkey:          []byte("pk"),
+		Pid:             []byte("pid"),
+		ShardID:         2,
+		VersionNumber:   "v1.0.0",
+		NodeDisplayName: "node",
+		Identity:        "identity",
+		ExpiryTimestamp: testCurrentTimeInSec + 10,
+	})
+	hp, _ := process.NewHeartbeatV2Processor(arg)
+
+	err := hp.ProcessReceivedMessage(createHeartbeatV2Message("pid", 5), "from")
+	require.Nil(t, err)
+
+	expectedHb := &data.Heartbeat{
+		Pubkey:          []byte("pk"),
+		Pid:             []byte("pid"),
+		ShardID:         2,
+		VersionNumber:   "v1.0.0",
+		NodeDisplayName: "node",
+		Identity:        "identity",
+		Nonce:           5,
+	}
+	assert.Equal(t, expectedHb, addedHb)
+}
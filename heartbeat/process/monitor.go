@@ -11,17 +11,23 @@ import (
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	nodeData "github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
 )
 
 var log = logger.GetOrCreate("heartbeat/process")
 
+// maxEpochsInUptimeReport is the maximum number of epochs an uptime report can cover
+const maxEpochsInUptimeReport = 100
+
 // ArgHeartbeatMonitor represents the arguments for the heartbeat monitor
 type ArgHeartbeatMonitor struct {
 	Marshalizer                        marshal.Marshalizer
@@ -37,6 +43,8 @@ type ArgHeartbeatMonitor struct {
 	ValidatorPubkeyConverter           core.PubkeyConverter
 	HeartbeatRefreshIntervalInSec      uint32
 	HideInactiveValidatorIntervalInSec uint32
+	EpochStartEventNotifier            sharding.EpochStartEventNotifier
+	StartEpoch                         uint32
 }
 
 // Monitor represents the heartbeat component that processes received heartbeat messages
@@ -45,6 +53,7 @@ type Monitor struct {
 	marshalizer                        marshal.Marshalizer
 	peerTypeProvider                   heartbeat.PeerTypeProviderHandler
 	mutHeartbeatMessages               sync.RWMutex
+	mutEpochUptimeStorer               sync.Mutex
 	mutAppStatusHandler                sync.Mutex
 	heartbeatMessages                  map[string]*heartbeatMessageInfo
 	doubleSignerPeers                  map[string]process.TimeCacher
@@ -61,6 +70,7 @@ type Monitor struct {
 	validatorPubkeyConverter           core.PubkeyConverter
 	heartbeatRefreshIntervalInSec      uint32
 	hideInactiveValidatorIntervalInSec uint32
+	currentEpoch                       uint32
 }
 
 // NewMonitor returns a new monitor instance
@@ -98,6 +108,9 @@ func NewMonitor(arg ArgHeartbeatMonitor) (*Monitor, error) {
 	if arg.HideInactiveValidatorIntervalInSec == 0 {
 		return nil, heartbeat.ErrZeroHideInactiveValidatorIntervalInSec
 	}
+	if check.IfNil(arg.EpochStartEventNotifier) {
+		return nil, heartbeat.ErrNilEpochStartEventNotifier
+	}
 
 	mon := &Monitor{
 		marshalizer:                        arg.Marshalizer,
@@ -115,6 +128,7 @@ func NewMonitor(arg ArgHeartbeatMonitor) (*Monitor, error) {
 		heartbeatRefreshIntervalInSec:      arg.HeartbeatRefreshIntervalInSec,
 		hideInactiveValidatorIntervalInSec: arg.HideInactiveValidatorIntervalInSec,
 		doubleSignerPeers:                  make(map[string]process.TimeCacher),
+		currentEpoch:                       arg.StartEpoch,
 	}
 
 	err := mon.storer.UpdateGenesisTime(arg.GenesisTime)
@@ -134,6 +148,8 @@ func NewMonitor(arg ArgHeartbeatMonitor) (*Monitor, error) {
 
 	mon.startValidatorProcessing()
 
+	arg.EpochStartEventNotifier.RegisterHandler(mon.epochStartEventHandler())
+
 	return mon, nil
 }
 
@@ -180,15 +196,45 @@ func (m *Monitor) initializeHeartBeatForPK(
 
 // SaveMultipleHeartbeatMessageInfos stores all heartbeatMessageInfos to the storer
 func (m *Monitor) SaveMultipleHeartbeatMessageInfos(pubKeysToSave map[string]*heartbeatMessageInfo) {
+	heartbeatDTOs := make(map[string]data.HeartbeatDTO, len(pubKeysToSave))
+	epochUptimes := make(map[string]*data.EpochUptimeDTO, len(pubKeysToSave))
 	m.mutHeartbeatMessages.RLock()
-	defer m.mutHeartbeatMessages.RUnlock()
+	for key, hbmi := range pubKeysToSave {
+		heartbeatDTOs[key] = m.convertToExportedStruct(hbmi)
+		epochUptimes[key] = hbmi.EpochUptime(m.currentEpoch)
+	}
+	m.mutHeartbeatMessages.RUnlock()
 
-	for key, hmbi := range pubKeysToSave {
-		hbDTO := m.convertToExportedStruct(hmbi)
-		err := m.storer.SavePubkeyData([]byte(key), &hbDTO)
-		if err != nil {
-			log.Debug("cannot save heartbeat to db", "error", err.Error())
-		}
+	for key, hbDTO := range heartbeatDTOs {
+		m.saveHeartbeatMessageInfo([]byte(key), hbDTO, epochUptimes[key])
+	}
+}
+
+func (m *Monitor) saveHeartbeatMessageInfo(pubKey []byte, hbDTO data.HeartbeatDTO, epochUptime *data.EpochUptimeDTO) {
+	err := m.storer.SavePubkeyData(pubKey, &hbDTO)
+	if err != nil {
+		log.Debug("cannot save heartbeat to db", "error", err.Error())
+	}
+
+	m.saveCurrentEpochUptime(pubKey, epochUptime)
+}
+
+// saveCurrentEpochUptime saves a sample taken during the current epoch. The sample is dropped if its epoch was closed
+// in the meantime, otherwise it would overwrite the sample saved when the epoch was closed
+func (m *Monitor) saveCurrentEpochUptime(pubKey []byte, epochUptime *data.EpochUptimeDTO) {
+	m.mutEpochUptimeStorer.Lock()
+	defer m.mutEpochUptimeStorer.Unlock()
+
+	m.mutHeartbeatMessages.RLock()
+	isEpochClosed := epochUptime.Epoch < m.currentEpoch
+	m.mutHeartbeatMessages.RUnlock()
+	if isEpochClosed {
+		return
+	}
+
+	err := m.storer.SaveEpochUptime(pubKey, epochUptime)
+	if err != nil {
+		log.Debug("cannot save epoch uptime to db", "error", err.Error())
 	}
 }
 
@@ -233,6 +279,11 @@ func (m *Monitor) loadHeartbeatsFromStorer(pubKey string) (*heartbeatMessageInfo
 	receivedHbmi.lastUptimeDowntime = crtTime
 	receivedHbmi.genesisTime = m.genesisTime
 
+	epochUptime, err := m.storer.LoadEpochUptime([]byte(pubKey), m.currentEpoch)
+	if err == nil {
+		receivedHbmi.SetEpochUptime(epochUptime)
+	}
+
 	return receivedHbmi, nil
 }
 
@@ -306,6 +357,18 @@ func (m *Monitor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPe
 	return nil
 }
 
+// AddHeartbeat records a heartbeat that was already validated by another component, as is the case of the
+// heartbeat v2 messages, so that both protocol versions are reflected in the same heartbeat statuses
+func (m *Monitor) AddHeartbeat(hb *data.Heartbeat) {
+	if hb == nil {
+		return
+	}
+
+	go m.addHeartbeatMessageToMap(hb)
+
+	go m.computeAllHeartbeatMessages()
+}
+
 func (m *Monitor) addHeartbeatMessageToMap(hb *data.Heartbeat) {
 	pubKeyStr := string(hb.Pubkey)
 	m.mutHeartbeatMessages.Lock()
@@ -323,8 +386,6 @@ func (m *Monitor) addHeartbeatMessageToMap(hb *data.Heartbeat) {
 		m.heartbeatMessages[pubKeyStr] = hbmi
 	}
 	numInstances := m.getNumInstancesOfPublicKey(pubKeyStr)
-	m.mutHeartbeatMessages.Unlock()
-
 	peerType, computedShardID := m.computePeerTypeAndShardID(hb.Pubkey)
 
 	hbmi.HeartbeatReceived(
@@ -337,12 +398,12 @@ func (m *Monitor) addHeartbeatMessageToMap(hb *data.Heartbeat) {
 		hb.Nonce,
 		numInstances,
 	)
+	//the epoch uptime is computed while holding the lock so the sample belongs to the epoch it is saved for
 	hbDTO := m.convertToExportedStruct(hbmi)
+	epochUptime := hbmi.EpochUptime(m.currentEpoch)
+	m.mutHeartbeatMessages.Unlock()
 
-	err := m.storer.SavePubkeyData(hb.Pubkey, &hbDTO)
-	if err != nil {
-		log.Debug("cannot save heartbeat to db", "error", err.Error())
-	}
+	m.saveHeartbeatMessageInfo(hb.Pubkey, hbDTO, epochUptime)
 	m.addPeerToFullPeersSlice(hb.Pubkey)
 }
 
@@ -479,6 +540,150 @@ func (m *Monitor) GetHeartbeats() []data.PubKeyHeartbeat {
 	return status
 }
 
+// GetUptimeReport returns the availability of the provided public key during the provided range of epochs. The
+// range is capped to the current epoch and to the last maxEpochsInUptimeReport epochs of the range
+func (m *Monitor) GetUptimeReport(pubKey []byte, fromEpoch uint32, toEpoch uint32) (*data.UptimeReport, error) {
+	m.mutHeartbeatMessages.Lock()
+	currentEpoch := m.currentEpoch
+	var currentEpochUptime *data.EpochUptimeDTO
+	hbmi, ok := m.heartbeatMessages[string(pubKey)]
+	if ok && hbmi != nil {
+		hbmi.ComputeActive(m.timer.Now())
+		currentEpochUptime = hbmi.EpochUptime(currentEpoch)
+	}
+	m.mutHeartbeatMessages.Unlock()
+
+	if toEpoch > currentEpoch {
+		toEpoch = currentEpoch
+	}
+	if fromEpoch > toEpoch {
+		return nil, fmt.Errorf("%w, from epoch %d, to epoch %d, current epoch %d",
+			heartbeat.ErrInvalidEpochRange, fromEpoch, toEpoch, currentEpoch)
+	}
+	if toEpoch-fromEpoch >= maxEpochsInUptimeReport {
+		fromEpoch = toEpoch - maxEpochsInUptimeReport + 1
+	}
+
+	report := &data.UptimeReport{
+		PublicKey:      m.validatorPubkeyConverter.Encode(pubKey),
+		FromEpoch:      fromEpoch,
+		ToEpoch:        toEpoch,
+		Epochs:         make([]data.EpochUptime, 0),
+		VersionChanges: make([]data.VersionChangeInfo, 0),
+	}
+
+	totalUpTime := time.Duration(0)
+	totalDownTime := time.Duration(0)
+	for epoch := fromEpoch; epoch <= toEpoch; epoch++ {
+		epochUptime := m.getEpochUptime(pubKey, currentEpochUptime, epoch, currentEpoch)
+		if epochUptime == nil {
+			continue
+		}
+
+		upTime := time.Duration(epochUptime.TotalUpTime)
+		downTime := time.Duration(epochUptime.TotalDownTime)
+		maxDowntimeStreak := time.Duration(epochUptime.MaxDowntimeStreak)
+		report.Epochs = append(report.Epochs, data.EpochUptime{
+			Epoch:             epoch,
+			UpTime:            int64(upTime.Seconds()),
+			DownTime:          int64(downTime.Seconds()),
+			UptimePercentage:  computeUptimePercentage(upTime, downTime),
+			MaxDowntimeStreak: int64(maxDowntimeStreak.Seconds()),
+		})
+		for _, versionChange := range epochUptime.VersionChanges {
+			report.VersionChanges = append(report.VersionChanges, data.VersionChangeInfo{
+				Epoch:     epoch,
+				Timestamp: versionChange.Timestamp,
+				Version:   versionChange.Version,
+			})
+		}
+
+		totalUpTime += upTime
+		totalDownTime += downTime
+		if report.MaxDowntimeStreak < int64(maxDowntimeStreak.Seconds()) {
+			report.MaxDowntimeStreak = int64(maxDowntimeStreak.Seconds())
+		}
+	}
+
+	if currentEpochUptime == nil && len(report.Epochs) == 0 {
+		return nil, heartbeat.ErrUptimeNotFound
+	}
+
+	report.UptimePercentage = computeUptimePercentage(totalUpTime, totalDownTime)
+
+	return report, nil
+}
+
+func (m *Monitor) getEpochUptime(
+	pubKey []byte,
+	currentEpochUptime *data.EpochUptimeDTO,
+	epoch uint32,
+	currentEpoch uint32,
+) *data.EpochUptimeDTO {
+	if epoch == currentEpoch && currentEpochUptime != nil {
+		return currentEpochUptime
+	}
+
+	epochUptime, err := m.storer.LoadEpochUptime(pubKey, epoch)
+	if err != nil {
+		return nil
+	}
+
+	return epochUptime
+}
+
+func computeUptimePercentage(upTime time.Duration, downTime time.Duration) float64 {
+	totalTime := upTime + downTime
+	if totalTime == 0 {
+		return 0
+	}
+
+	return float64(upTime) * 100 / float64(totalTime)
+}
+
+func (m *Monitor) epochStartEventHandler() sharding.EpochStartActionHandler {
+	return notifier.NewHandlerForEpochStart(
+		func(hdr nodeData.HeaderHandler) {
+			m.changeEpoch(hdr.GetEpoch())
+		},
+		func(_ nodeData.HeaderHandler) {},
+		core.IndexerOrder,
+	)
+}
+
+// changeEpoch closes the availability samples of the ending epoch and saves them in the storer
+func (m *Monitor) changeEpoch(newEpoch uint32) {
+	m.mutHeartbeatMessages.Lock()
+	if newEpoch <= m.currentEpoch {
+		m.mutHeartbeatMessages.Unlock()
+		return
+	}
+
+	crtTime := m.timer.Now()
+	oldEpoch := m.currentEpoch
+	epochUptimes := make(map[string]*data.EpochUptimeDTO, len(m.heartbeatMessages))
+	for pubKey, hbmi := range m.heartbeatMessages {
+		hbmi.ComputeActive(crtTime)
+		epochUptimes[pubKey] = hbmi.CloseEpoch(oldEpoch)
+	}
+	m.currentEpoch = newEpoch
+	m.mutHeartbeatMessages.Unlock()
+
+	log.Debug("heartbeat monitor: epoch uptime samples closed", "epoch", oldEpoch, "num public keys", len(epochUptimes))
+
+	go func() {
+		m.mutEpochUptimeStorer.Lock()
+		defer m.mutEpochUptimeStorer.Unlock()
+
+		for pubKey, epochUptime := range epochUptimes {
+			err := m.storer.SaveEpochUptime([]byte(pubKey), epochUptime)
+			if err != nil {
+				log.Debug("cannot save epoch uptime to db", "error", err.Error())
+			}
+		}
+	}()
+}
+
 func (m *Monitor) shouldSkipValidator(v *heartbeatMessageInfo) bool {
 	isInactiveObserver := !v.GetIsActive() &&
 		(v.peerType != string(core.EligibleList) &&
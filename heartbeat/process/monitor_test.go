@@ -4,11 +4,14 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
@@ -16,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/storage"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var fromConnectedPeerId = core.PeerID("from connected peer Id")
@@ -74,6 +78,7 @@ func createMockArgHeartbeatMonitor() process.ArgHeartbeatMonitor {
 		ValidatorPubkeyConverter:           mock.NewPubkeyConverterMock(96),
 		HeartbeatRefreshIntervalInSec:      1,
 		HideInactiveValidatorIntervalInSec: 600,
+		EpochStartEventNotifier:            &mock.EpochStartNotifierStub{},
 	}
 }
 
@@ -200,6 +205,17 @@ func TestNewMonitor_ZeroHideInactiveVlidatorIntervalInHoursShouldErr(t *testing.
 	assert.True(t, errors.Is(err, heartbeat.ErrZeroHideInactiveValidatorIntervalInSec))
 }
 
+func TestNewMonitor_NilEpochStartEventNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatMonitor()
+	arg.EpochStartEventNotifier = nil
+	mon, err := process.NewMonitor(arg)
+
+	assert.Nil(t, mon)
+	assert.Equal(t, heartbeat.ErrNilEpochStartEventNotifier, err)
+}
+
 func TestNewMonitor_OkValsShouldCreatePubkeyMap(t *testing.T) {
 	t.Parallel()
 
@@ -275,6 +291,33 @@ func TestMonitor_ProcessReceivedMessageShouldWork(t *testing.T) {
 	assert.Equal(t, hex.EncodeToString([]byte(pubKey)), hbStatus[0].PublicKey)
 }
 
+func TestMonitor_AddHeartbeatShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pubKey := "pk1"
+
+	arg := createMockArgHeartbeatMonitor()
+	arg.MaxDurationPeerUnresponsive = time.Second * 1000
+	arg.PubKeysMap = map[uint32][]string{0: {}}
+	mon, _ := process.NewMonitor(arg)
+
+	mon.AddHeartbeat(&data.Heartbeat{
+		Pubkey:          []byte(pubKey),
+		NodeDisplayName: "node",
+		Nonce:           37,
+	})
+
+	//a delay is mandatory for the go routine to finish its job
+	time.Sleep(time.Second)
+
+	hbStatus := mon.GetHeartbeats()
+	require.Equal(t, 1, len(hbStatus))
+	assert.Equal(t, hex.EncodeToString([]byte(pubKey)), hbStatus[0].PublicKey)
+	assert.Equal(t, "node", hbStatus[0].NodeDisplayName)
+	assert.Equal(t, uint64(37), hbStatus[0].Nonce)
+	assert.True(t, hbStatus[0].IsActive)
+}
+
 func TestMonitor_ProcessReceivedMessageProcessTriggerErrorShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -530,6 +573,7 @@ func TestMonitor_RemoveInactiveValidatorsIfIntervalExceeded(t *testing.T) {
 		ValidatorPubkeyConverter:           mock.NewPubkeyConverterMock(32),
 		HeartbeatRefreshIntervalInSec:      1,
 		HideInactiveValidatorIntervalInSec: 600,
+		EpochStartEventNotifier:            &mock.EpochStartNotifierStub{},
 	}
 	mon, _ := process.NewMonitor(arg)
 	mon.SendHeartbeatMessage(&data.Heartbeat{Pubkey: []byte(pkValidator)})
@@ -678,3 +722,277 @@ func TestMonitor_CleanupShouldWork(t *testing.T) {
 	assert.Equal(t, 0, mon.GetNumHearbeatMessages())
 	assert.Equal(t, 0, mon.GetNumDoubleSignerPeers())
 }
+
+//------- GetUptimeReport
+
+func createMockArgHeartbeatMonitorForUptime(timer *mock.TimerMock, storer heartbeat.HeartbeatStorageHandler) process.ArgHeartbeatMonitor {
+	arg := createMockArgHeartbeatMonitor()
+	arg.MaxDurationPeerUnresponsive = time.Second * 10
+	arg.PubKeysMap = map[uint32][]string{0: {}}
+	arg.GenesisTime = timer.Now()
+	arg.Timer = timer
+	arg.Storer = storer
+	arg.StartEpoch = 2
+
+	return arg
+}
+
+func TestMonitor_GetUptimeReportInvalidRangeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	timer := mock.NewTimerMock()
+	mon, _ := process.NewMonitor(createMockArgHeartbeatMonitorForUptime(timer, createMockStorer()))
+
+	report, err := mon.GetUptimeReport([]byte("pk1"), 3, 5)
+
+	assert.Nil(t, report)
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidEpochRange))
+}
+
+func TestMonitor_GetUptimeReportUnknownPublicKeyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	timer := mock.NewTimerMock()
+	mon, _ := process.NewMonitor(createMockArgHeartbeatMonitorForUptime(timer, createMockStorer()))
+
+	report, err := mon.GetUptimeReport([]byte("pk1"), 0, 2)
+
+	assert.Nil(t, report)
+	assert.Equal(t, heartbeat.ErrUptimeNotFound, err)
+}
+
+func TestMonitor_GetUptimeReportShouldMergeStoredAndCurrentEpochs(t *testing.T) {
+	t.Parallel()
+
+	pubKey := "pk1"
+	storer := createMockStorer().(*mock.HeartbeatStorerStub)
+	storer.LoadEpochUptimeCalled = func(pubkey []byte, epoch uint32) (*data.EpochUptimeDTO, error) {
+		if string(pubkey) != pubKey || epoch != 1 {
+			return nil, errors.New("not found")
+		}
+
+		return &data.EpochUptimeDTO{
+			Epoch:             1,
+			TotalUpTime:       int64(time.Second * 30),
+			TotalDownTime:     int64(time.Second * 10),
+			MaxDowntimeStreak: int64(time.Second * 10),
+			VersionChanges:    []*data.VersionChange{{Timestamp: 5, Version: "v0"}},
+		}, nil
+	}
+	timer := mock.NewTimerMock()
+	mon, _ := process.NewMonitor(createMockArgHeartbeatMonitorForUptime(timer, storer))
+
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{
+		Pubkey:        []byte(pubKey),
+		VersionNumber: "v1",
+	})
+	timer.IncrementSeconds(10)
+
+	report, err := mon.GetUptimeReport([]byte(pubKey), 0, 10)
+	require.Nil(t, err)
+
+	assert.Equal(t, hex.EncodeToString([]byte(pubKey)), report.PublicKey)
+	assert.Equal(t, uint32(0), report.FromEpoch)
+	assert.Equal(t, uint32(2), report.ToEpoch)
+	require.Equal(t, 2, len(report.Epochs))
+	assert.Equal(t, data.EpochUptime{Epoch: 1, UpTime: 30, DownTime: 10, UptimePercentage: 75, MaxDowntimeStreak: 10}, report.Epochs[0])
+	assert.Equal(t, data.EpochUptime{Epoch: 2, UpTime: 10, DownTime: 0, UptimePercentage: 100, MaxDowntimeStreak: 0}, report.Epochs[1])
+	assert.Equal(t, float64(80), report.UptimePercentage)
+	assert.Equal(t, int64(10), report.MaxDowntimeStreak)
+	expectedVersionChanges := []data.VersionChangeInfo{
+		{Epoch: 1, Timestamp: 5, Version: "v0"},
+		{Epoch: 2, Timestamp: 0, Version: "v1"},
+	}
+	assert.Equal(t, expectedVersionChanges, report.VersionChanges)
+}
+
+func TestMonitor_EpochChangeShouldSaveEpochUptime(t *testing.T) {
+	t.Parallel()
+
+	pubKey := "pk1"
+	mutSaved := sync.Mutex{}
+	savedUptimes := make([]*data.EpochUptimeDTO, 0)
+	storer := createMockStorer().(*mock.HeartbeatStorerStub)
+	storer.SaveEpochUptimeCalled = func(pubkey []byte, epochUptime *data.EpochUptimeDTO) error {
+		mutSaved.Lock()
+		savedUptimes = append(savedUptimes, epochUptime)
+		mutSaved.Unlock()
+
+		return nil
+	}
+	timer := mock.NewTimerMock()
+	epochStartNotifier := &mock.EpochStartNotifierStub{}
+	arg := createMockArgHeartbeatMonitorForUptime(timer, storer)
+	arg.EpochStartEventNotifier = epochStartNotifier
+	mon, _ := process.NewMonitor(arg)
+
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{
+		Pubkey:        []byte(pubKey),
+		VersionNumber: "v1",
+	})
+	timer.IncrementSeconds(10)
+	epochStartNotifier.NotifyAll(&block.MetaBlock{Epoch: 3})
+
+	//a delay is mandatory for the go routine to finish its job
+	time.Sleep(time.Millisecond * 100)
+
+	mutSaved.Lock()
+	lastSaved := savedUptimes[len(savedUptimes)-1]
+	mutSaved.Unlock()
+	assert.Equal(t, uint32(2), lastSaved.Epoch)
+	assert.Equal(t, int64(time.Second*10), lastSaved.TotalUpTime)
+	assert.Equal(t, 1, len(lastSaved.VersionChanges))
+
+	report, err := mon.GetUptimeReport([]byte(pubKey), 3, 3)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(report.Epochs))
+	assert.Equal(t, uint32(3), report.Epochs[0].Epoch)
+	assert.Equal(t, int64(0), report.Epochs[0].UpTime)
+	assert.Equal(t, 0, len(report.VersionChanges))
+}
+
+func TestMonitor_EpochChangeWhileAddingHeartbeatShouldNotOverwriteEpochUptime(t *testing.T) {
+	t.Parallel()
+
+	pubKey := "pk1"
+	mutSaved := sync.Mutex{}
+	savedUptimes := make(map[uint32]*data.EpochUptimeDTO)
+	storer := createMockStorer().(*mock.HeartbeatStorerStub)
+	storer.SaveEpochUptimeCalled = func(pubkey []byte, epochUptime *data.EpochUptimeDTO) error {
+		mutSaved.Lock()
+		savedUptimes[epochUptime.Epoch] = epochUptime
+		mutSaved.Unlock()
+
+		return nil
+	}
+	timer := mock.NewTimerMock()
+	epochStartNotifier := &mock.EpochStartNotifierStub{}
+	shouldChangeEpoch := atomic.Flag{}
+	arg := createMockArgHeartbeatMonitorForUptime(timer, storer)
+	arg.EpochStartEventNotifier = epochStartNotifier
+	arg.PeerTypeProvider = &mock.PeerTypeProviderStub{
+		ComputeForPubKeyCalled: func(pubKey []byte) (core.PeerType, uint32, error) {
+			if shouldChangeEpoch.IsSet() {
+				shouldChangeEpoch.Unset()
+				//the epoch changes while the heartbeat is being added
+				go epochStartNotifier.NotifyAll(&block.MetaBlock{Epoch: 3})
+				time.Sleep(time.Millisecond * 50)
+			}
+
+			return core.EligibleList, 0, nil
+		},
+	}
+	mon, _ := process.NewMonitor(arg)
+
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{
+		Pubkey:        []byte(pubKey),
+		VersionNumber: "v1",
+	})
+	timer.IncrementSeconds(5)
+	shouldChangeEpoch.Set()
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{
+		Pubkey:        []byte(pubKey),
+		VersionNumber: "v1",
+	})
+
+	//a delay is mandatory for the go routine to finish its job
+	time.Sleep(time.Millisecond * 100)
+
+	mutSaved.Lock()
+	savedUptime := savedUptimes[2]
+	mutSaved.Unlock()
+	require.NotNil(t, savedUptime)
+	assert.Equal(t, int64(time.Second*5), savedUptime.TotalUpTime)
+}
+
+func TestMonitor_EpochChangeBeforeSavingHeartbeatShouldDropTheStaleEpochUptime(t *testing.T) {
+	t.Parallel()
+
+	pubKey := "pk1"
+	mutSaved := sync.Mutex{}
+	savedUptimes := make(map[uint32]*data.EpochUptimeDTO)
+	timer := mock.NewTimerMock()
+	epochStartNotifier := &mock.EpochStartNotifierStub{}
+	shouldChangeEpoch := atomic.Flag{}
+	storer := createMockStorer().(*mock.HeartbeatStorerStub)
+	storer.SaveEpochUptimeCalled = func(pubkey []byte, epochUptime *data.EpochUptimeDTO) error {
+		mutSaved.Lock()
+		savedUptimes[epochUptime.Epoch] = epochUptime
+		mutSaved.Unlock()
+
+		return nil
+	}
+	storer.SavePubkeyDataCalled = func(pubkey []byte, heartbeat *data.HeartbeatDTO) error {
+		if shouldChangeEpoch.IsSet() {
+			shouldChangeEpoch.Unset()
+			//the epoch changes after the sample was taken but before it is saved
+			timer.IncrementSeconds(5)
+			epochStartNotifier.NotifyAll(&block.MetaBlock{Epoch: 3})
+		}
+
+		return nil
+	}
+	arg := createMockArgHeartbeatMonitorForUptime(timer, storer)
+	arg.EpochStartEventNotifier = epochStartNotifier
+	mon, _ := process.NewMonitor(arg)
+
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{
+		Pubkey:        []byte(pubKey),
+		VersionNumber: "v1",
+	})
+	timer.IncrementSeconds(10)
+	shouldChangeEpoch.Set()
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{
+		Pubkey:        []byte(pubKey),
+		VersionNumber: "v1",
+	})
+
+	//a delay is mandatory for the go routine to finish its job
+	time.Sleep(time.Millisecond * 100)
+
+	mutSaved.Lock()
+	savedUptime := savedUptimes[2]
+	mutSaved.Unlock()
+	require.NotNil(t, savedUptime)
+	assert.Equal(t, int64(time.Second*15), savedUptime.TotalUpTime)
+}
+
+func TestMonitor_GetUptimeReportConcurrentWithEpochChangeShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pubKey := []byte("pk1")
+	storer := createMockStorer().(*mock.HeartbeatStorerStub)
+	storer.SaveEpochUptimeCalled = func(pubkey []byte, epochUptime *data.EpochUptimeDTO) error {
+		return nil
+	}
+	storer.LoadEpochUptimeCalled = func(pubkey []byte, epoch uint32) (*data.EpochUptimeDTO, error) {
+		return nil, errors.New("not found")
+	}
+	timer := mock.NewTimerMock()
+	epochStartNotifier := &mock.EpochStartNotifierStub{}
+	arg := createMockArgHeartbeatMonitorForUptime(timer, storer)
+	arg.EpochStartEventNotifier = epochStartNotifier
+	mon, _ := process.NewMonitor(arg)
+	mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: pubKey})
+
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(3 * numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(epoch uint32) {
+			epochStartNotifier.NotifyAll(&block.MetaBlock{Epoch: epoch})
+			wg.Done()
+		}(uint32(i + 3))
+		go func() {
+			mon.AddHeartbeatMessageToMap(&data.Heartbeat{Pubkey: pubKey})
+			wg.Done()
+		}()
+		go func() {
+			report, err := mon.GetUptimeReport(pubKey, 0, 1000)
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(report.Epochs))
+			wg.Done()
+		}()
+	}
+	wg.Wait()
+}
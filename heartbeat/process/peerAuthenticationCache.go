@@ -0,0 +1,82 @@
+package process
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+)
+
+// PeerAuthenticationCache holds the last valid peer authentication message received from each peer ID
+type PeerAuthenticationCache struct {
+	mut                 sync.RWMutex
+	peerAuthentications map[core.PeerID]*data.PeerAuthentication
+	timer               heartbeat.Timer
+}
+
+// NewPeerAuthenticationCache creates a new peer authentication cache
+func NewPeerAuthenticationCache(timer heartbeat.Timer) (*PeerAuthenticationCache, error) {
+	if check.IfNil(timer) {
+		return nil, heartbeat.ErrNilTimer
+	}
+
+	return &PeerAuthenticationCache{
+		peerAuthentications: make(map[core.PeerID]*data.PeerAuthentication),
+		timer:               timer,
+	}, nil
+}
+
+// Put stores the provided peer authentication, replacing the one previously received from the same peer ID
+func (pac *PeerAuthenticationCache) Put(peerAuthentication *data.PeerAuthentication) {
+	if peerAuthentication == nil {
+		return
+	}
+
+	pac.mut.Lock()
+	pac.peerAuthentications[core.PeerID(peerAuthentication.Pid)] = peerAuthentication
+	pac.mut.Unlock()
+}
+
+// Get returns the peer authentication of the provided peer ID if it exists and did not expire
+func (pac *PeerAuthenticationCache) Get(pid core.PeerID) (*data.PeerAuthentication, bool) {
+	pac.mut.RLock()
+	peerAuthentication, found := pac.peerAuthentications[pid]
+	pac.mut.RUnlock()
+
+	if !found || pac.isExpired(peerAuthentication) {
+		return nil, false
+	}
+
+	return peerAuthentication, true
+}
+
+// Sweep removes all expired peer authentications
+func (pac *PeerAuthenticationCache) Sweep() {
+	pac.mut.Lock()
+	defer pac.mut.Unlock()
+
+	for pid, peerAuthentication := range pac.peerAuthentications {
+		if pac.isExpired(peerAuthentication) {
+			delete(pac.peerAuthentications, pid)
+		}
+	}
+}
+
+func (pac *PeerAuthenticationCache) isExpired(peerAuthentication *data.PeerAuthentication) bool {
+	return peerAuthentication.ExpiryTimestamp <= pac.timer.Now().Unix()
+}
+
+// Len returns the number of stored peer authentications
+func (pac *PeerAuthenticationCache) Len() int {
+	pac.mut.RLock()
+	defer pac.mut.RUnlock()
+
+	return len(pac.peerAuthentications)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pac *PeerAuthenticationCache) IsInterfaceNil() bool {
+	return pac == nil
+}
@@ -0,0 +1,84 @@
+package process_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPeerAuthenticationCache_NilTimerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pac, err := process.NewPeerAuthenticationCache(nil)
+
+	assert.True(t, check.IfNil(pac))
+	assert.Equal(t, heartbeat.ErrNilTimer, err)
+}
+
+func TestPeerAuthenticationCache_PutGetShouldWork(t *testing.T) {
+	t.Parallel()
+
+	timer := mock.NewTimerMock()
+	timer.SetSeconds(100)
+	pac, _ := process.NewPeerAuthenticationCache(timer)
+
+	pa := &data.PeerAuthentication{
+		Pid:             []byte("pid"),
+		Pubkey:          []byte("pk"),
+		ExpiryTimestamp: 200,
+	}
+	pac.Put(pa)
+
+	recovered, found := pac.Get("pid")
+	assert.True(t, found)
+	assert.Equal(t, pa, recovered)
+
+	_, found = pac.Get("other pid")
+	assert.False(t, found)
+}
+
+func TestPeerAuthenticationCache_GetExpiredShouldNotReturn(t *testing.T) {
+	t.Parallel()
+
+	timer := mock.NewTimerMock()
+	timer.SetSeconds(100)
+	pac, _ := process.NewPeerAuthenticationCache(timer)
+	pac.Put(&data.PeerAuthentication{
+		Pid:             []byte("pid"),
+		ExpiryTimestamp: 200,
+	})
+
+	timer.SetSeconds(200)
+	_, found := pac.Get(core.PeerID("pid"))
+
+	assert.False(t, found)
+}
+
+func TestPeerAuthenticationCache_SweepShouldRemoveExpired(t *testing.T) {
+	t.Parallel()
+
+	timer := mock.NewTimerMock()
+	timer.SetSeconds(100)
+	pac, _ := process.NewPeerAuthenticationCache(timer)
+	pac.Put(&data.PeerAuthentication{
+		Pid:             []byte("pid1"),
+		ExpiryTimestamp: 150,
+	})
+	pac.Put(&data.PeerAuthentication{
+		Pid:             []byte("pid2"),
+		ExpiryTimestamp: 250,
+	})
+
+	timer.SetSeconds(200)
+	pac.Sweep()
+
+	assert.Equal(t, 1, pac.Len())
+	_, found := pac.Get(core.PeerID("pid2"))
+	assert.True(t, found)
+}
@@ -0,0 +1,204 @@
+package process
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// maxTimestampDriftInSec is the maximum accepted difference between the timestamp of a received peer authentication
+// and the local time, used to tolerate small clock differences between nodes
+const maxTimestampDriftInSec = 60
+
+// ArgPeerAuthenticationProcessor represents the arguments for the peer authentication processor
+type ArgPeerAuthenticationProcessor struct {
+	Marshalizer              marshal.Marshalizer
+	SingleSigner             crypto.SingleSigner
+	KeyGen                   crypto.KeyGenerator
+	AntifloodHandler         heartbeat.P2PAntifloodHandler
+	NetworkShardingCollector heartbeat.NetworkShardingCollector
+	PeerAuthenticationCache  heartbeat.PeerAuthenticationCacher
+	NewPeerNotifier          heartbeat.NewPeerAuthenticationNotifier
+	Timer                    heartbeat.Timer
+	MaxExpiryDuration        time.Duration
+}
+
+// PeerAuthenticationProcessor processes the heartbeat v2 peer authentication messages: it verifies the validator
+// signature and the expiry of each message and records the public key - peer ID binding
+type PeerAuthenticationProcessor struct {
+	marshalizer              marshal.Marshalizer
+	singleSigner             crypto.SingleSigner
+	keyGen                   crypto.KeyGenerator
+	antifloodHandler         heartbeat.P2PAntifloodHandler
+	networkShardingCollector heartbeat.NetworkShardingCollector
+	peerAuthenticationCache  heartbeat.PeerAuthenticationCacher
+	newPeerNotifier          heartbeat.NewPeerAuthenticationNotifier
+	timer                    heartbeat.Timer
+	maxExpiryInSec           int64
+}
+
+// NewPeerAuthenticationProcessor creates a new peer authentication processor
+func NewPeerAuthenticationProcessor(arg ArgPeerAuthenticationProcessor) (*PeerAuthenticationProcessor, error) {
+	if check.IfNil(arg.Marshalizer) {
+		return nil, heartbeat.ErrNilMarshalizer
+	}
+	if check.IfNil(arg.SingleSigner) {
+		return nil, heartbeat.ErrNilSingleSigner
+	}
+	if check.IfNil(arg.KeyGen) {
+		return nil, heartbeat.ErrNilKeyGenerator
+	}
+	if check.IfNil(arg.AntifloodHandler) {
+		return nil, heartbeat.ErrNilAntifloodHandler
+	}
+	if check.IfNil(arg.NetworkShardingCollector) {
+		return nil, heartbeat.ErrNilNetworkShardingCollector
+	}
+	if check.IfNil(arg.PeerAuthenticationCache) {
+		return nil, heartbeat.ErrNilPeerAuthenticationCache
+	}
+	if check.IfNil(arg.NewPeerNotifier) {
+		return nil, heartbeat.ErrNilNewPeerAuthenticationNotifier
+	}
+	if check.IfNil(arg.Timer) {
+		return nil, heartbeat.ErrNilTimer
+	}
+	if arg.MaxExpiryDuration < time.Second {
+		return nil, heartbeat.ErrInvalidMaxExpiryDuration
+	}
+
+	return &PeerAuthenticationProcessor{
+		marshalizer:              arg.Marshalizer,
+		singleSigner:             arg.SingleSigner,
+		keyGen:                   arg.KeyGen,
+		antifloodHandler:         arg.AntifloodHandler,
+		networkShardingCollector: arg.NetworkShardingCollector,
+		peerAuthenticationCache:  arg.PeerAuthenticationCache,
+		newPeerNotifier:          arg.NewPeerNotifier,
+		timer:                    arg.Timer,
+		maxExpiryInSec:           int64(arg.MaxExpiryDuration / time.Second),
+	}, nil
+}
+
+// ProcessReceivedMessage satisfies the p2p.MessageProcessor interface so it can be called
+// by the p2p subsystem each time a new peer authentication message arrives
+func (pap *PeerAuthenticationProcessor) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+	if check.IfNil(message) {
+		return heartbeat.ErrNilMessage
+	}
+	if message.Data() == nil {
+		return heartbeat.ErrNilDataToProcess
+	}
+
+	err := pap.antifloodHandler.CanProcessMessage(message, fromConnectedPeer)
+	if err != nil {
+		return err
+	}
+	err = pap.antifloodHandler.CanProcessMessagesOnTopic(fromConnectedPeer, core.PeerAuthenticationTopic, 1, uint64(len(message.Data())), message.SeqNo())
+	if err != nil {
+		return err
+	}
+
+	peerAuthentication, err := pap.createPeerAuthentication(message)
+	if err != nil {
+		//the message is either malformed, not correctly signed or not sent by the peer it authenticates so
+		//we have to black list both the message originator and the connected peer that disseminated this message.
+		reason := "blacklisted due to invalid peer authentication message"
+		pap.antifloodHandler.BlacklistPeer(message.Peer(), reason, core.InvalidMessageBlacklistDuration)
+		pap.antifloodHandler.BlacklistPeer(fromConnectedPeer, reason, core.InvalidMessageBlacklistDuration)
+
+		return err
+	}
+
+	err = pap.checkTimestamps(peerAuthentication)
+	if err != nil {
+		//a late or slightly skewed message is not a reason to black list anyone
+		return err
+	}
+
+	_, isKnownPeer := pap.peerAuthenticationCache.Get(message.Peer())
+	pap.peerAuthenticationCache.Put(peerAuthentication)
+	pap.networkShardingCollector.UpdatePeerIdPublicKey(message.Peer(), peerAuthentication.Pubkey)
+	pap.networkShardingCollector.UpdatePeerIdShardId(message.Peer(), peerAuthentication.ShardID)
+	if !isKnownPeer {
+		//the peer has just joined (or re-joined) the network and, as the peer authentications are kept only in memory,
+		//it does not know ours either
+		pap.newPeerNotifier.NotifyNewPeerAuthentication(message.Peer())
+	}
+
+	return nil
+}
+
+func (pap *PeerAuthenticationProcessor) createPeerAuthentication(message p2p.MessageP2P) (*data.PeerAuthentication, error) {
+	peerAuthentication := &data.PeerAuthentication{}
+	err := pap.marshalizer.Unmarshal(peerAuthentication, message.Data())
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifyPeerAuthenticationLengths(peerAuthentication)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(peerAuthentication.Pid, message.Peer().Bytes()) {
+		return nil, fmt.Errorf("%w peer authentication pid %s, message pid %s",
+			heartbeat.ErrHeartbeatPidMismatch,
+			p2p.PeerIdToShortString(core.PeerID(peerAuthentication.Pid)),
+			p2p.PeerIdToShortString(message.Peer()),
+		)
+	}
+
+	err = pap.verifySignature(peerAuthentication)
+	if err != nil {
+		return nil, err
+	}
+
+	return peerAuthentication, nil
+}
+
+func (pap *PeerAuthenticationProcessor) verifySignature(peerAuthentication *data.PeerAuthentication) error {
+	pubKey, err := pap.keyGen.PublicKeyFromByteArray(peerAuthentication.Pubkey)
+	if err != nil {
+		return err
+	}
+
+	signedData, err := computePeerAuthenticationSignedData(pap.marshalizer, peerAuthentication)
+	if err != nil {
+		return err
+	}
+
+	return pap.singleSigner.Verify(pubKey, signedData, peerAuthentication.Signature)
+}
+
+func (pap *PeerAuthenticationProcessor) checkTimestamps(peerAuthentication *data.PeerAuthentication) error {
+	validityInSec := peerAuthentication.ExpiryTimestamp - peerAuthentication.Timestamp
+	if validityInSec <= 0 || validityInSec > pap.maxExpiryInSec {
+		return fmt.Errorf("%w, validity of %d seconds, maximum %d seconds",
+			heartbeat.ErrInvalidPeerAuthenticationTimestamps, validityInSec, pap.maxExpiryInSec)
+	}
+
+	now := pap.timer.Now().Unix()
+	if peerAuthentication.Timestamp > now+maxTimestampDriftInSec {
+		return fmt.Errorf("%w, timestamp %d is in the future, current time %d",
+			heartbeat.ErrInvalidPeerAuthenticationTimestamps, peerAuthentication.Timestamp, now)
+	}
+	if peerAuthentication.ExpiryTimestamp <= now {
+		return heartbeat.ErrPeerAuthenticationExpired
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pap *PeerAuthenticationProcessor) IsInterfaceNil() bool {
+	return pap == nil
+}
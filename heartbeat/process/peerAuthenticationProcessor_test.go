@@ -0,0 +1,246 @@
+package process_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCurrentTimeInSec = 1000
+
+func createMockArgPeerAuthenticationProcessor() process.ArgPeerAuthenticationProcessor {
+	timer := mock.NewTimerMock()
+	timer.SetSeconds(testCurrentTimeInSec)
+	cache, _ := process.NewPeerAuthenticationCache(timer)
+
+	return process.ArgPeerAuthenticationProcessor{
+		Marshalizer:  &mock.MarshalizerMock{},
+		SingleSigner: &mock.SinglesignMock{},
+		KeyGen: &mock.KeyGenMock{
+			PublicKeyFromByteArrayMock: func(b []byte) (crypto.PublicKey, error) {
+				return &mock.PublicKeyMock{}, nil
+			},
+		},
+		AntifloodHandler: &mock.P2PAntifloodHandlerStub{},
+		NetworkShardingCollector: &mock.NetworkShardingCollectorStub{
+			UpdatePeerIdPublicKeyCalled: func(pid core.PeerID, pk []byte) {},
+			UpdatePeerIdShardIdCalled:   func(pid core.PeerID, shardId uint32) {},
+		},
+		PeerAuthenticationCache: cache,
+		NewPeerNotifier:         &mock.NewPeerAuthenticationNotifierStub{},
+		Timer:                   timer,
+		MaxExpiryDuration:       time.Minute,
+	}
+}
+
+func createSignedPeerAuthenticationMessage(pid core.PeerID, timestamp int64, expiryTimestamp int64) *mock.P2PMessageStub {
+	marshalizer := &mock.MarshalizerMock{}
+	pa := &data.PeerAuthentication{
+		Pubkey:          []byte("pk"),
+		Pid:             pid.Bytes(),
+		ShardID:         1,
+		VersionNumber:   "v1.0.0",
+		NodeDisplayName: "node",
+		Timestamp:       timestamp,
+		ExpiryTimestamp: expiryTimestamp,
+	}
+	pa.Signature, _ = (&mock.SinglesignMock{}).Sign(nil, nil)
+	buff, _ := marshalizer.Marshal(pa)
+
+	return &mock.P2PMessageStub{
+		DataField: buff,
+		PeerField: pid,
+	}
+}
+
+func TestNewPeerAuthenticationProcessor_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationProcessor()
+	arg.SingleSigner = nil
+	pap, err := process.NewPeerAuthenticationProcessor(arg)
+
+	assert.True(t, check.IfNil(pap))
+	assert.Equal(t, heartbeat.ErrNilSingleSigner, err)
+}
+
+func TestNewPeerAuthenticationProcessor_NilKeyGenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationProcessor()
+	arg.KeyGen = nil
+	pap, err := process.NewPeerAuthenticationProcessor(arg)
+
+	assert.True(t, check.IfNil(pap))
+	assert.Equal(t, heartbeat.ErrNilKeyGenerator, err)
+}
+
+func TestNewPeerAuthenticationProcessor_NilPeerAuthenticationCacheShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationProcessor()
+	arg.PeerAuthenticationCache = nil
+	pap, err := process.NewPeerAuthenticationProcessor(arg)
+
+	assert.True(t, check.IfNil(pap))
+	assert.Equal(t, heartbeat.ErrNilPeerAuthenticationCache, err)
+}
+
+func TestNewPeerAuthenticationProcessor_NilNewPeerNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationProcessor()
+	arg.NewPeerNotifier = nil
+	pap, err := process.NewPeerAuthenticationProcessor(arg)
+
+	assert.True(t, check.IfNil(pap))
+	assert.Equal(t, heartbeat.ErrNilNewPeerAuthenticationNotifier, err)
+}
+
+func TestNewPeerAuthenticationProcessor_InvalidMaxExpiryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerAuthenticationProcessor()
+	arg.MaxExpiryDuration = 0
+	pap, err := process.NewPeerAuthenticationProcessor(arg)
+
+	assert.True(t, check.IfNil(pap))
+	assert.Equal(t, heartbeat.ErrInvalidMaxExpiryDuration, err)
+}
+
+func TestNewPeerAuthenticationProcessor_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pap, err := process.NewPeerAuthenticationProcessor(createMockArgPeerAuthenticationProcessor())
+
+	assert.False(t, check.IfNil(pap))
+	assert.Nil(t, err)
+}
+
+func TestPeerAuthenticationProcessor_ProcessReceivedMessageShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pid := core.PeerID("pid")
+	updatedPk := make([]byte, 0)
+	updatedShard := uint32(0)
+	arg := createMockArgPeerAuthenticationProcessor()
+	arg.NetworkShardingCollector = &mock.NetworkShardingCollectorStub{
+		UpdatePeerIdPublicKeyCalled: func(p core.PeerID, pk []byte) {
+			updatedPk = pk
+		},
+		UpdatePeerIdShardIdCalled: func(p core.PeerID, shardId uint32) {
+			updatedShard = shardId
+		},
+	}
+	pap, _ := process.NewPeerAuthenticationProcessor(arg)
+
+	msg := createSignedPeerAuthenticationMessage(pid, testCurrentTimeInSec, testCurrentTimeInSec+30)
+	err := pap.ProcessReceivedMessage(msg, "from")
+	require.Nil(t, err)
+
+	pa, found := arg.PeerAuthenticationCache.Get(pid)
+	require.True(t, found)
+	assert.Equal(t, []byte("pk"), pa.Pubkey)
+	assert.Equal(t, []byte("pk"), updatedPk)
+	assert.Equal(t, uint32(1), updatedShard)
+}
+
+func TestPeerAuthenticationProcessor_ProcessReceivedMessageShouldNotifyOnlyNewPeers(t *testing.T) {
+	t.Parallel()
+
+	notifiedPids := make([]core.PeerID, 0)
+	arg := createMockArgPeerAuthenticationProcessor()
+	arg.NewPeerNotifier = &mock.NewPeerAuthenticationNotifierStub{
+		NotifyNewPeerAuthenticationCalled: func(pid core.PeerID) {
+			notifiedPids = append(notifiedPids, pid)
+		},
+	}
+	pap, _ := process.NewPeerAuthenticationProcessor(arg)
+
+	err := pap.ProcessReceivedMessage(createSignedPeerAuthenticationMessage("pid1", testCurrentTimeInSec, testCurrentTimeInSec+30), "from")
+	require.Nil(t, err)
+	err = pap.ProcessReceivedMessage(createSignedPeerAuthenticationMessage("pid1", testCurrentTimeInSec, testCurrentTimeInSec+40), "from")
+	require.Nil(t, err)
+	err = pap.ProcessReceivedMessage(createSignedPeerAuthenticationMessage("pid2", testCurrentTimeInSec, testCurrentTimeInSec+30), "from")
+	require.Nil(t, err)
+
+	assert.Equal(t, []core.PeerID{"pid1", "pid2"}, notifiedPids)
+}
+
+func TestPeerAuthenticationProcessor_ProcessReceivedMessageInvalidSignatureShouldBlacklist(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	blacklisted := make(map[core.PeerID]struct{})
+	arg := createMockArgPeerAuthenticationProcessor()
+	arg.SingleSigner = &mock.SinglesignStub{
+		VerifyCalled: func(_ crypto.PublicKey, _ []byte, _ []byte) error {
+			return expectedErr
+		},
+	}
+	arg.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		BlacklistPeerCalled: func(peer core.PeerID, _ string, _ time.Duration) {
+			blacklisted[peer] = struct{}{}
+		},
+	}
+	pap, _ := process.NewPeerAuthenticationProcessor(arg)
+
+	msg := createSignedPeerAuthenticationMessage("pid", testCurrentTimeInSec, testCurrentTimeInSec+30)
+	err := pap.ProcessReceivedMessage(msg, "from")
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 2, len(blacklisted))
+	_, found := arg.PeerAuthenticationCache.Get("pid")
+	assert.False(t, found)
+}
+
+func TestPeerAuthenticationProcessor_ProcessReceivedMessagePidMismatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pap, _ := process.NewPeerAuthenticationProcessor(createMockArgPeerAuthenticationProcessor())
+
+	msg := createSignedPeerAuthenticationMessage("pid", testCurrentTimeInSec, testCurrentTimeInSec+30)
+	msg.PeerField = "other pid"
+	err := pap.ProcessReceivedMessage(msg, "from")
+
+	assert.True(t, errors.Is(err, heartbeat.ErrHeartbeatPidMismatch))
+}
+
+func TestPeerAuthenticationProcessor_ProcessReceivedMessageTimestampsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	blacklistCalled := false
+	arg := createMockArgPeerAuthenticationProcessor()
+	arg.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		BlacklistPeerCalled: func(_ core.PeerID, _ string, _ time.Duration) {
+			blacklistCalled = true
+		},
+	}
+	pap, _ := process.NewPeerAuthenticationProcessor(arg)
+
+	msg := createSignedPeerAuthenticationMessage("pid", testCurrentTimeInSec-60, testCurrentTimeInSec-10)
+	err := pap.ProcessReceivedMessage(msg, "from")
+	assert.Equal(t, heartbeat.ErrPeerAuthenticationExpired, err)
+
+	msg = createSignedPeerAuthenticationMessage("pid", testCurrentTimeInSec, testCurrentTimeInSec+3600)
+	err = pap.ProcessReceivedMessage(msg, "from")
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidPeerAuthenticationTimestamps))
+
+	msg = createSignedPeerAuthenticationMessage("pid", testCurrentTimeInSec+3600, testCurrentTimeInSec+3630)
+	err = pap.ProcessReceivedMessage(msg, "from")
+	assert.True(t, errors.Is(err, heartbeat.ErrInvalidPeerAuthenticationTimestamps))
+
+	assert.False(t, blacklistCalled)
+	_, found := arg.PeerAuthenticationCache.Get("pid")
+	assert.False(t, found)
+}
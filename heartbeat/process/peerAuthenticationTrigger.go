@@ -0,0 +1,42 @@
+package process
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/heartbeat"
+)
+
+var _ heartbeat.NewPeerAuthenticationNotifier = (*PeerAuthenticationTrigger)(nil)
+
+// PeerAuthenticationTrigger signals the heartbeat sender that a new peer authentication was received so the
+// self peer authentication should be sent earlier than its regular interval. Multiple notifications received
+// before the sender consumes the signal are merged into a single one.
+type PeerAuthenticationTrigger struct {
+	chTrigger chan struct{}
+}
+
+// NewPeerAuthenticationTrigger creates a new peer authentication trigger
+func NewPeerAuthenticationTrigger() *PeerAuthenticationTrigger {
+	return &PeerAuthenticationTrigger{
+		chTrigger: make(chan struct{}, 1),
+	}
+}
+
+// NotifyNewPeerAuthentication records that a peer authentication was received from a new peer ID
+func (pat *PeerAuthenticationTrigger) NotifyNewPeerAuthentication(pid core.PeerID) {
+	log.Trace("new peer authentication received", "pid", pid.Pretty())
+
+	select {
+	case pat.chTrigger <- struct{}{}:
+	default:
+	}
+}
+
+// NotifyTriggerReceived returns the channel on which the sender is signaled to send the self peer authentication
+func (pat *PeerAuthenticationTrigger) NotifyTriggerReceived() <-chan struct{} {
+	return pat.chTrigger
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pat *PeerAuthenticationTrigger) IsInterfaceNil() bool {
+	return pat == nil
+}
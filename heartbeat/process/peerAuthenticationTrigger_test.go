@@ -0,0 +1,34 @@
+package process_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPeerAuthenticationTrigger(t *testing.T) {
+	t.Parallel()
+
+	pat := process.NewPeerAuthenticationTrigger()
+
+	assert.False(t, check.IfNil(pat))
+	assert.Equal(t, 0, len(pat.NotifyTriggerReceived()))
+}
+
+func TestPeerAuthenticationTrigger_NotificationsShouldBeMerged(t *testing.T) {
+	t.Parallel()
+
+	pat := process.NewPeerAuthenticationTrigger()
+	pat.NotifyNewPeerAuthentication("pid1")
+	pat.NotifyNewPeerAuthentication("pid2")
+	pat.NotifyNewPeerAuthentication("pid3")
+
+	assert.Equal(t, 1, len(pat.NotifyTriggerReceived()))
+	<-pat.NotifyTriggerReceived()
+	assert.Equal(t, 0, len(pat.NotifyTriggerReceived()))
+
+	pat.NotifyNewPeerAuthentication("pid4")
+	assert.Equal(t, 1, len(pat.NotifyTriggerReceived()))
+}
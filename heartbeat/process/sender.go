@@ -17,36 +17,44 @@ const delayAfterHardforkMessageBroadcast = time.Second * 5
 
 // ArgHeartbeatSender represents the arguments for the heartbeat sender
 type ArgHeartbeatSender struct {
-	PeerMessenger        heartbeat.P2PMessenger
-	PeerSignatureHandler crypto.PeerSignatureHandler
-	PrivKey              crypto.PrivateKey
-	Marshalizer          marshal.Marshalizer
-	Topic                string
-	ShardCoordinator     sharding.Coordinator
-	PeerTypeProvider     heartbeat.PeerTypeProviderHandler
-	StatusHandler        core.AppStatusHandler
-	VersionNumber        string
-	NodeDisplayName      string
-	KeyBaseIdentity      string
-	HardforkTrigger      heartbeat.HardforkTrigger
-	CurrentBlockProvider heartbeat.CurrentBlockProvider
+	PeerMessenger            heartbeat.P2PMessenger
+	PeerSignatureHandler     crypto.PeerSignatureHandler
+	PrivKey                  crypto.PrivateKey
+	Marshalizer              marshal.Marshalizer
+	Topic                    string
+	ShardCoordinator         sharding.Coordinator
+	PeerTypeProvider         heartbeat.PeerTypeProviderHandler
+	StatusHandler            core.AppStatusHandler
+	VersionNumber            string
+	NodeDisplayName          string
+	KeyBaseIdentity          string
+	HardforkTrigger          heartbeat.HardforkTrigger
+	CurrentBlockProvider     heartbeat.CurrentBlockProvider
+	SingleSigner             crypto.SingleSigner
+	PeerAuthenticationTopic  string
+	HeartbeatV2Topic         string
+	PeerAuthenticationExpiry time.Duration
 }
 
 // Sender periodically sends heartbeat messages on a pubsub topic
 type Sender struct {
-	peerMessenger        heartbeat.P2PMessenger
-	peerSignatureHandler crypto.PeerSignatureHandler
-	privKey              crypto.PrivateKey
-	marshalizer          marshal.Marshalizer
-	shardCoordinator     sharding.Coordinator
-	peerTypeProvider     heartbeat.PeerTypeProviderHandler
-	statusHandler        core.AppStatusHandler
-	topic                string
-	versionNumber        string
-	nodeDisplayName      string
-	keyBaseIdentity      string
-	hardforkTrigger      heartbeat.HardforkTrigger
-	currentBlockProvider heartbeat.CurrentBlockProvider
+	peerMessenger            heartbeat.P2PMessenger
+	peerSignatureHandler     crypto.PeerSignatureHandler
+	privKey                  crypto.PrivateKey
+	marshalizer              marshal.Marshalizer
+	shardCoordinator         sharding.Coordinator
+	peerTypeProvider         heartbeat.PeerTypeProviderHandler
+	statusHandler            core.AppStatusHandler
+	topic                    string
+	versionNumber            string
+	nodeDisplayName          string
+	keyBaseIdentity          string
+	hardforkTrigger          heartbeat.HardforkTrigger
+	currentBlockProvider     heartbeat.CurrentBlockProvider
+	singleSigner             crypto.SingleSigner
+	peerAuthenticationTopic  string
+	heartbeatV2Topic         string
+	peerAuthenticationExpiry time.Duration
 }
 
 // NewSender will create a new sender instance
@@ -78,25 +86,35 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 	if check.IfNil(arg.CurrentBlockProvider) {
 		return nil, heartbeat.ErrNilCurrentBlockProvider
 	}
+	if check.IfNil(arg.SingleSigner) {
+		return nil, heartbeat.ErrNilSingleSigner
+	}
+	if arg.PeerAuthenticationExpiry < time.Second {
+		return nil, heartbeat.ErrInvalidMaxExpiryDuration
+	}
 	err := VerifyHeartbeatProperyLen("application version string", []byte(arg.VersionNumber))
 	if err != nil {
 		return nil, err
 	}
 
 	sender := &Sender{
-		peerMessenger:        arg.PeerMessenger,
-		peerSignatureHandler: arg.PeerSignatureHandler,
-		privKey:              arg.PrivKey,
-		marshalizer:          arg.Marshalizer,
-		topic:                arg.Topic,
-		shardCoordinator:     arg.ShardCoordinator,
-		peerTypeProvider:     arg.PeerTypeProvider,
-		statusHandler:        arg.StatusHandler,
-		versionNumber:        arg.VersionNumber,
-		nodeDisplayName:      arg.NodeDisplayName,
-		keyBaseIdentity:      arg.KeyBaseIdentity,
-		hardforkTrigger:      arg.HardforkTrigger,
-		currentBlockProvider: arg.CurrentBlockProvider,
+		peerMessenger:            arg.PeerMessenger,
+		peerSignatureHandler:     arg.PeerSignatureHandler,
+		privKey:                  arg.PrivKey,
+		marshalizer:              arg.Marshalizer,
+		topic:                    arg.Topic,
+		shardCoordinator:         arg.ShardCoordinator,
+		peerTypeProvider:         arg.PeerTypeProvider,
+		statusHandler:            arg.StatusHandler,
+		versionNumber:            arg.VersionNumber,
+		nodeDisplayName:          arg.NodeDisplayName,
+		keyBaseIdentity:          arg.KeyBaseIdentity,
+		hardforkTrigger:          arg.HardforkTrigger,
+		currentBlockProvider:     arg.CurrentBlockProvider,
+		singleSigner:             arg.SingleSigner,
+		peerAuthenticationTopic:  arg.PeerAuthenticationTopic,
+		heartbeatV2Topic:         arg.HeartbeatV2Topic,
+		peerAuthenticationExpiry: arg.PeerAuthenticationExpiry,
 	}
 
 	return sender, nil
@@ -104,12 +122,7 @@ func NewSender(arg ArgHeartbeatSender) (*Sender, error) {
 
 // SendHeartbeat broadcasts a new heartbeat message
 func (s *Sender) SendHeartbeat() error {
-	nonce := uint64(0)
-	crtBlock := s.currentBlockProvider.GetCurrentBlockHeader()
-	if !check.IfNil(crtBlock) {
-		nonce = crtBlock.GetNonce()
-	}
-
+	nonce := s.currentNonce()
 	hb := &heartbeatData.Heartbeat{
 		Payload:         []byte(fmt.Sprintf("%v", time.Now())),
 		ShardID:         s.shardCoordinator.SelfId(),
@@ -141,7 +154,7 @@ func (s *Sender) SendHeartbeat() error {
 		return err
 	}
 
-	s.updateMetrics(hb)
+	s.updateMetrics(hb.Pubkey)
 
 	err = verifyLengths(hb)
 	if err != nil {
@@ -164,8 +177,83 @@ func (s *Sender) SendHeartbeat() error {
 	return nil
 }
 
-func (s *Sender) updateMetrics(hb *heartbeatData.Heartbeat) {
-	result := s.computePeerList(hb.Pubkey)
+// SendPeerAuthentication broadcasts a new heartbeat v2 peer authentication message that binds the node's public key
+// to its peer ID until the configured expiry
+func (s *Sender) SendPeerAuthentication() error {
+	pubKey, err := s.privKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	peerAuthentication := &heartbeatData.PeerAuthentication{
+		Pubkey:          pubKey,
+		Pid:             s.peerMessenger.ID().Bytes(),
+		ShardID:         s.shardCoordinator.SelfId(),
+		VersionNumber:   s.versionNumber,
+		NodeDisplayName: s.nodeDisplayName,
+		Identity:        s.keyBaseIdentity,
+		Timestamp:       now.Unix(),
+		ExpiryTimestamp: now.Add(s.peerAuthenticationExpiry).Unix(),
+	}
+
+	s.updateMetrics(pubKey)
+
+	err = verifyPeerAuthenticationLengths(peerAuthentication)
+	if err != nil {
+		log.Warn("verify peer authentication length", "error", err.Error())
+		trimPeerAuthenticationLengths(peerAuthentication)
+	}
+
+	signedData, err := computePeerAuthenticationSignedData(s.marshalizer, peerAuthentication)
+	if err != nil {
+		return err
+	}
+
+	peerAuthentication.Signature, err = s.singleSigner.Sign(s.privKey, signedData)
+	if err != nil {
+		return err
+	}
+
+	buffToSend, err := s.marshalizer.Marshal(peerAuthentication)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("broadcasting peer authentication message", "expiry", peerAuthentication.ExpiryTimestamp)
+	s.peerMessenger.Broadcast(s.peerAuthenticationTopic, buffToSend)
+
+	return nil
+}
+
+// SendHeartbeatV2 broadcasts a new compact heartbeat v2 liveness message
+func (s *Sender) SendHeartbeatV2() error {
+	hbV2 := &heartbeatData.HeartbeatV2{
+		Nonce:     s.currentNonce(),
+		Timestamp: time.Now().Unix(),
+	}
+
+	buffToSend, err := s.marshalizer.Marshal(hbV2)
+	if err != nil {
+		return err
+	}
+
+	s.peerMessenger.Broadcast(s.heartbeatV2Topic, buffToSend)
+
+	return nil
+}
+
+func (s *Sender) currentNonce() uint64 {
+	crtBlock := s.currentBlockProvider.GetCurrentBlockHeader()
+	if check.IfNil(crtBlock) {
+		return 0
+	}
+
+	return crtBlock.GetNonce()
+}
+
+func (s *Sender) updateMetrics(pubKey []byte) {
+	result := s.computePeerList(pubKey)
 
 	nodeType := ""
 	if result == string(core.ObserverList) {
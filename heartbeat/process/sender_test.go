@@ -5,13 +5,18 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	nodeData "github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/heartbeat"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/mock"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 //------- NewSender
@@ -28,14 +33,18 @@ func createMockArgHeartbeatSender() process.ArgHeartbeatSender {
 				return nil, nil
 			},
 		},
-		Topic:                "",
-		ShardCoordinator:     &mock.ShardCoordinatorMock{},
-		PeerTypeProvider:     &mock.PeerTypeProviderStub{},
-		StatusHandler:        &mock.AppStatusHandlerStub{},
-		VersionNumber:        "v0.1",
-		NodeDisplayName:      "undefined",
-		HardforkTrigger:      &mock.HardforkTriggerStub{},
-		CurrentBlockProvider: &mock.CurrentBlockProviderStub{},
+		Topic:                    "",
+		ShardCoordinator:         &mock.ShardCoordinatorMock{},
+		PeerTypeProvider:         &mock.PeerTypeProviderStub{},
+		StatusHandler:            &mock.AppStatusHandlerStub{},
+		VersionNumber:            "v0.1",
+		NodeDisplayName:          "undefined",
+		HardforkTrigger:          &mock.HardforkTriggerStub{},
+		CurrentBlockProvider:     &mock.CurrentBlockProviderStub{},
+		SingleSigner:             &mock.SinglesignMock{},
+		PeerAuthenticationTopic:  "peerAuthentication",
+		HeartbeatV2Topic:         "heartbeatV2",
+		PeerAuthenticationExpiry: time.Minute,
 	}
 }
 
@@ -149,6 +158,28 @@ func TestNewSender_NilCurrentBlockProviderShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, heartbeat.ErrNilCurrentBlockProvider))
 }
 
+func TestNewSender_NilSingleSignerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.SingleSigner = nil
+	sender, err := process.NewSender(arg)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrNilSingleSigner, err)
+}
+
+func TestNewSender_InvalidPeerAuthenticationExpiryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeartbeatSender()
+	arg.PeerAuthenticationExpiry = time.Millisecond
+	sender, err := process.NewSender(arg)
+
+	assert.Nil(t, sender)
+	assert.Equal(t, heartbeat.ErrInvalidMaxExpiryDuration, err)
+}
+
 func TestNewSender_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -452,3 +483,123 @@ func TestSender_SendHeartbeatAfterTriggerWithRecorededPayloadShouldWork(t *testi
 	assert.True(t, genPubKeyClled)
 	assert.True(t, marshalCalled)
 }
+
+//------- SendPeerAuthentication
+
+func TestSender_SendPeerAuthenticationShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pid := core.PeerID("pid")
+	pubKeyBytes := []byte("pub key")
+	marshalizer := &mock.MarshalizerMock{}
+	var broadcastBuff []byte
+	arg := createMockArgHeartbeatSender()
+	arg.Marshalizer = marshalizer
+	arg.PeerMessenger = &mock.MessengerStub{
+		IDCalled: func() core.PeerID {
+			return pid
+		},
+		BroadcastCalled: func(topic string, buff []byte) {
+			if topic == arg.PeerAuthenticationTopic {
+				broadcastBuff = buff
+			}
+		},
+	}
+	arg.PrivKey = &mock.PrivateKeyStub{
+		GeneratePublicHandler: func() crypto.PublicKey {
+			return &mock.PublicKeyMock{
+				ToByteArrayHandler: func() ([]byte, error) {
+					return pubKeyBytes, nil
+				},
+			}
+		},
+	}
+	var signedData []byte
+	arg.SingleSigner = &mock.SinglesignStub{
+		SignCalled: func(_ crypto.PrivateKey, msg []byte) ([]byte, error) {
+			signedData = msg
+			return []byte("signature"), nil
+		},
+	}
+	sender, _ := process.NewSender(arg)
+
+	err := sender.SendPeerAuthentication()
+	require.Nil(t, err)
+
+	peerAuthentication := &data.PeerAuthentication{}
+	err = marshalizer.Unmarshal(peerAuthentication, broadcastBuff)
+	require.Nil(t, err)
+	assert.Equal(t, pubKeyBytes, peerAuthentication.Pubkey)
+	assert.Equal(t, pid.Bytes(), peerAuthentication.Pid)
+	assert.Equal(t, []byte("signature"), peerAuthentication.Signature)
+	assert.Equal(t, int64(time.Minute/time.Second), peerAuthentication.ExpiryTimestamp-peerAuthentication.Timestamp)
+
+	peerAuthentication.Signature = nil
+	expectedSignedData, _ := marshalizer.Marshal(peerAuthentication)
+	assert.Equal(t, expectedSignedData, signedData)
+}
+
+func TestSender_SendPeerAuthenticationSignErrShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	broadcastCalled := false
+	arg := createMockArgHeartbeatSender()
+	arg.PeerMessenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastCalled = true
+		},
+	}
+	arg.PrivKey = &mock.PrivateKeyStub{
+		GeneratePublicHandler: func() crypto.PublicKey {
+			return &mock.PublicKeyMock{
+				ToByteArrayHandler: func() ([]byte, error) {
+					return []byte("pub key"), nil
+				},
+			}
+		},
+	}
+	arg.SingleSigner = &mock.SinglesignStub{
+		SignCalled: func(_ crypto.PrivateKey, _ []byte) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	sender, _ := process.NewSender(arg)
+
+	err := sender.SendPeerAuthentication()
+
+	assert.Equal(t, expectedErr, err)
+	assert.False(t, broadcastCalled)
+}
+
+//------- SendHeartbeatV2
+
+func TestSender_SendHeartbeatV2ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	var broadcastBuff []byte
+	arg := createMockArgHeartbeatSender()
+	arg.Marshalizer = marshalizer
+	arg.PeerMessenger = &mock.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			if topic == arg.HeartbeatV2Topic {
+				broadcastBuff = buff
+			}
+		},
+	}
+	arg.CurrentBlockProvider = &mock.CurrentBlockProviderStub{
+		GetCurrentBlockHeaderCalled: func() nodeData.HeaderHandler {
+			return &block.Header{Nonce: 37}
+		},
+	}
+	sender, _ := process.NewSender(arg)
+
+	err := sender.SendHeartbeatV2()
+	require.Nil(t, err)
+
+	hbV2 := &data.HeartbeatV2{}
+	err = marshalizer.Unmarshal(hbV2, broadcastBuff)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(37), hbV2.Nonce)
+}
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
@@ -16,6 +17,7 @@ var log = logger.GetOrCreate("heartbeat/storage")
 
 const peersKeysDbEntry = "keys"
 const genesisTimeDbEntry = "genesisTime"
+const epochUptimeDbEntryPrefix = "epochUptime_"
 
 // HeartbeatDbStorer is the struct which will handle storage operations for heartbeat
 type HeartbeatDbStorer struct {
@@ -159,6 +161,36 @@ func (hs *HeartbeatDbStorer) SavePubkeyData(
 	return nil
 }
 
+// SaveEpochUptime will add or update the availability of a public key during the epoch set in the provided DTO
+func (hs *HeartbeatDbStorer) SaveEpochUptime(pubkey []byte, epochUptime *data.EpochUptimeDTO) error {
+	marshalizedEpochUptime, err := hs.marshalizer.Marshal(epochUptime)
+	if err != nil {
+		return err
+	}
+
+	return hs.storer.Put(epochUptimeKey(pubkey, epochUptime.Epoch), marshalizedEpochUptime)
+}
+
+// LoadEpochUptime will return the availability of a public key during the provided epoch
+func (hs *HeartbeatDbStorer) LoadEpochUptime(pubkey []byte, epoch uint32) (*data.EpochUptimeDTO, error) {
+	epochUptimeBytes, err := hs.storer.Get(epochUptimeKey(pubkey, epoch))
+	if err != nil {
+		return nil, err
+	}
+
+	epochUptime := &data.EpochUptimeDTO{}
+	err = hs.marshalizer.Unmarshal(epochUptime, epochUptimeBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return epochUptime, nil
+}
+
+func epochUptimeKey(pubkey []byte, epoch uint32) []byte {
+	return append([]byte(fmt.Sprintf("%s%d_", epochUptimeDbEntryPrefix, epoch)), pubkey...)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hs *HeartbeatDbStorer) IsInterfaceNil() bool {
 	return hs == nil
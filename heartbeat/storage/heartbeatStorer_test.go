@@ -279,3 +279,42 @@ func TestHeartbeatDbStorer_LoadHeartBeatDTOShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, hb.NodeDisplayName, hbmiDto.NodeDisplayName)
 }
+
+func TestHeartbeatDbStorer_LoadEpochUptimeNotFoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hs, _ := storage.NewHeartbeatDbStorer(
+		mock.NewStorerMock(),
+		&mock.MarshalizerMock{},
+	)
+
+	epochUptime, err := hs.LoadEpochUptime([]byte("key1"), 3)
+	assert.Nil(t, epochUptime)
+	assert.NotNil(t, err)
+}
+
+func TestHeartbeatDbStorer_SaveAndLoadEpochUptimeShouldWork(t *testing.T) {
+	t.Parallel()
+
+	hs, _ := storage.NewHeartbeatDbStorer(
+		mock.NewStorerMock(),
+		&mock.MarshalizerMock{},
+	)
+
+	epochUptime := &data.EpochUptimeDTO{
+		Epoch:             3,
+		TotalUpTime:       int64(time.Minute),
+		TotalDownTime:     int64(time.Second),
+		MaxDowntimeStreak: int64(time.Second),
+		VersionChanges:    []*data.VersionChange{{Timestamp: 10, Version: "v1"}},
+	}
+	err := hs.SaveEpochUptime([]byte("key1"), epochUptime)
+	assert.Nil(t, err)
+
+	recovered, err := hs.LoadEpochUptime([]byte("key1"), 3)
+	assert.Nil(t, err)
+	assert.Equal(t, epochUptime, recovered)
+
+	_, err = hs.LoadEpochUptime([]byte("key1"), 4)
+	assert.NotNil(t, err)
+}
@@ -34,6 +34,8 @@ func initNodesAndTest(
 	numInvalid uint32,
 	roundTime uint64,
 	consensusType string,
+	pipeliningEnabled bool,
+	validatorDirectSendEnabled bool,
 ) ([]*testNode, p2p.Messenger, *sync.Map) {
 
 	fmt.Println("Step 1. Setup nodes...")
@@ -49,6 +51,8 @@ func initNodesAndTest(
 		roundTime,
 		getConnectableAddress(advertiser),
 		consensusType,
+		pipeliningEnabled,
+		validatorDirectSendEnabled,
 	)
 
 	for _, nodesList := range nodes {
@@ -144,14 +148,14 @@ func checkBlockProposedEveryRound(numCommBlock uint64, nonceForRoundMap map[uint
 	}
 }
 
-func runFullConsensusTest(t *testing.T, consensusType string) {
+func runFullConsensusTest(t *testing.T, consensusType string, pipeliningEnabled bool, validatorDirectSendEnabled bool) {
 	numNodes := uint32(4)
 	consensusSize := uint32(4)
 	numInvalid := uint32(0)
 	roundTime := uint64(5000)
 	numCommBlock := uint64(8)
 
-	nodes, advertiser, _ := initNodesAndTest(numNodes, consensusSize, numInvalid, roundTime, consensusType)
+	nodes, advertiser, _ := initNodesAndTest(numNodes, consensusSize, numInvalid, roundTime, consensusType, pipeliningEnabled, validatorDirectSendEnabled)
 
 	mutex := &sync.Mutex{}
 	defer func() {
@@ -191,7 +195,23 @@ func TestConsensusBLSFullTest(t *testing.T) {
 		t.Skip("this is not a short test")
 	}
 
-	runFullConsensusTest(t, blsConsensusType)
+	runFullConsensusTest(t, blsConsensusType, false, false)
+}
+
+func TestConsensusBLSPipelinedFullTest(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	runFullConsensusTest(t, blsConsensusType, true, false)
+}
+
+func TestConsensusBLSValidatorDirectSendFullTest(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	runFullConsensusTest(t, blsConsensusType, false, true)
 }
 
 func runConsensusWithNotEnoughValidators(t *testing.T, consensusType string) {
@@ -199,7 +219,7 @@ func runConsensusWithNotEnoughValidators(t *testing.T, consensusType string) {
 	consensusSize := uint32(4)
 	numInvalid := uint32(2)
 	roundTime := uint64(4000)
-	nodes, advertiser, _ := initNodesAndTest(numNodes, consensusSize, numInvalid, roundTime, consensusType)
+	nodes, advertiser, _ := initNodesAndTest(numNodes, consensusSize, numInvalid, roundTime, consensusType, false, false)
 
 	mutex := &sync.Mutex{}
 	defer func() {
@@ -234,3 +254,87 @@ func TestConsensusBLSNotEnoughValidators(t *testing.T) {
 
 	runConsensusWithNotEnoughValidators(t, blsConsensusType)
 }
+
+func TestConsensusBLSPipelinedLeaderFailure(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	numNodes := uint32(4)
+	consensusSize := uint32(4)
+	numInvalid := uint32(1)
+	roundTime := uint64(4000)
+	numCommBlock := uint64(10)
+	nodes, advertiser, _ := initNodesAndTest(numNodes, consensusSize, numInvalid, roundTime, blsConsensusType, true, false)
+
+	defer func() {
+		_ = advertiser.Close()
+		for _, n := range nodes {
+			_ = n.mesenger.Close()
+		}
+	}()
+
+	mutex := &sync.Mutex{}
+	committedRounds := make([]map[uint64]uint64, len(nodes))
+	numPipelinedBlocks := 0
+	for i, n := range nodes {
+		idx := i
+		nCopy := n
+		committedRounds[idx] = make(map[uint64]uint64)
+
+		createBlock := n.blkProcessor.CreateBlockCalled
+		n.blkProcessor.CreateBlockCalled = func(header data.HeaderHandler, haveTime func() bool) (data.HeaderHandler, data.BodyHandler, error) {
+			currentRound := uint64(time.Now().UnixNano() / int64(time.Millisecond) / int64(roundTime))
+			if header.GetRound() > currentRound {
+				mutex.Lock()
+				numPipelinedBlocks++
+				mutex.Unlock()
+			}
+
+			return createBlock(header, haveTime)
+		}
+		n.blkProcessor.CommitBlockCalled = func(header data.HeaderHandler, body data.BodyHandler) error {
+			nCopy.blkProcessor.NrCommitBlockCalled++
+			_ = nCopy.blkc.SetCurrentBlockHeader(header)
+
+			mutex.Lock()
+			committedRounds[idx][header.GetNonce()] = header.GetRound()
+			mutex.Unlock()
+
+			return nil
+		}
+	}
+
+	fmt.Println("Start consensus...")
+	time.Sleep(time.Second)
+
+	for _, n := range nodes {
+		err := n.node.StartConsensus()
+		assert.Nil(t, err)
+	}
+
+	waitTime := time.Duration(roundTime) * time.Duration(numCommBlock*3) * time.Millisecond
+	fmt.Printf("Run for %v...\n", waitTime)
+	time.Sleep(waitTime)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	// the blocks committed by the honest nodes for the same nonce must be the same, even if some of them were
+	// built in advance by a leader which could not know if the previous block would become final
+	for i := int(numInvalid); i < len(nodes); i++ {
+		assert.True(t, uint64(len(committedRounds[i])) >= numCommBlock,
+			fmt.Sprintf("node %d committed only %d blocks", i, len(committedRounds[i])))
+
+		for nonce, round := range committedRounds[i] {
+			for j := int(numInvalid); j < len(nodes); j++ {
+				otherRound, ok := committedRounds[j][nonce]
+				if !ok {
+					continue
+				}
+				assert.Equal(t, round, otherRound, fmt.Sprintf("nodes %d and %d committed different blocks with nonce %d", i, j, nonce))
+			}
+		}
+	}
+	assert.True(t, numPipelinedBlocks > 0)
+}
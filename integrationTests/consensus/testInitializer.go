@@ -245,6 +245,8 @@ func createConsensusOnlyNode(
 	pubKeys []crypto.PublicKey,
 	testKeyGen crypto.KeyGenerator,
 	consensusType string,
+	pipeliningEnabled bool,
+	validatorDirectSendEnabled bool,
 	epochStartRegistrationHandler epochStart.RegistrationHandler,
 ) (
 	*node.Node,
@@ -399,6 +401,8 @@ func createConsensusOnlyNode(
 		node.WithDataStore(createTestStore()),
 		node.WithResolversFinder(resolverFinder),
 		node.WithConsensusType(consensusType),
+		node.WithConsensusPipelining(pipeliningEnabled),
+		node.WithValidatorDirectSend(validatorDirectSendEnabled),
 		node.WithBlockBlackListHandler(&mock.TimeCacheStub{}),
 		node.WithPeerDenialEvaluator(&mock.PeerDenialEvaluatorStub{}),
 		node.WithEpochStartTrigger(epochStartTrigger),
@@ -436,6 +440,8 @@ func createNodes(
 	roundTime uint64,
 	serviceID string,
 	consensusType string,
+	pipeliningEnabled bool,
+	validatorDirectSendEnabled bool,
 ) map[uint32][]*testNode {
 
 	nodes := make(map[uint32][]*testNode)
@@ -492,6 +498,8 @@ func createNodes(
 			pubKeys,
 			cp.keyGen,
 			consensusType,
+			pipeliningEnabled,
+			validatorDirectSendEnabled,
 			epochStartRegistrationHandler,
 		)
 
@@ -37,5 +37,6 @@ type NetworkShardingUpdater interface {
 	UpdatePeerIdPublicKey(pid core.PeerID, pk []byte)
 	UpdatePublicKeyShardId(pk []byte, shardId uint32)
 	UpdatePeerIdShardId(pid core.PeerID, shardId uint32)
+	GetLastKnownPeerID(pk []byte) (*core.PeerID, bool)
 	IsInterfaceNil() bool
 }
@@ -74,6 +74,9 @@ func createWorkableConfig() config.Config {
 			Topic: config.TopicAntifloodConfig{
 				DefaultMaxMessagesPerSec: 10000,
 			},
+			RuntimeLimits: config.AntifloodRuntimeLimitsConfig{
+				OverrideFilePath: "missing-antiflood-override.toml",
+			},
 		},
 	}
 }
@@ -111,43 +114,55 @@ func TestAntifloodingForLargerPeriodOfTime(t *testing.T) {
 	}
 }
 
+func createReputationStore() process.PeerReputationStore {
+	store, err := blackList.NewPeerReputationStore(blackList.ArgPeerReputationStore{
+		Storer:      integrationTests.CreateMemUnit(),
+		Marshalizer: integrationTests.TestMarshalizer,
+	})
+	log.LogIfError(err)
+
+	return store
+}
+
 func createProcessors(peers []p2p.Messenger, topic string, idxBadPeers []int, idxGoodPeers []int) []*messageProcessor {
 	processors := make([]*messageProcessor, 0, len(peers))
 	for i := 0; i < len(peers); i++ {
-		var antiflood process.P2PAntifloodHandler
-		var blackListHandler process.PeerBlackListCacher
-		var pkTimeCache process.TimeCacher
+		var components *factory.AntiFloodComponents
 		var err error
 
 		if intInSlice(i, idxBadPeers) {
-			antiflood, blackListHandler, pkTimeCache, err = factory.NewP2PAntiFloodAndBlackList(
+			components, err = factory.NewP2PAntiFloodAndBlackList(
 				createDisabledConfig(),
 				&mock.AppStatusHandlerStub{},
 				peers[i].ID(),
+				createReputationStore(),
+				&mock.LoadProviderStub{},
 			)
 			log.LogIfError(err)
 		}
 
 		if intInSlice(i, idxGoodPeers) {
 			statusHandler := &mock.AppStatusHandlerStub{}
-			antiflood, blackListHandler, pkTimeCache, err = factory.NewP2PAntiFloodAndBlackList(
+			components, err = factory.NewP2PAntiFloodAndBlackList(
 				createWorkableConfig(),
 				statusHandler,
 				peers[i].ID(),
+				createReputationStore(),
+				&mock.LoadProviderStub{},
 			)
 			log.LogIfError(err)
 		}
 
 		pde, _ := blackList.NewPeerDenialEvaluator(
-			blackListHandler,
-			pkTimeCache,
+			components.BlacklistHandler,
+			components.PubKeysCacher,
 			&mock.PeerShardMapperStub{},
 		)
 
 		err = peers[i].SetPeerDenialEvaluator(pde)
 		log.LogIfError(err)
 
-		proc := NewMessageProcessor(antiflood, peers[i])
+		proc := NewMessageProcessor(components.AntiFloodHandler, peers[i])
 		processors = append(processors, proc)
 
 		err = proc.messenger.CreateTopic(topic, true)
@@ -0,0 +1,154 @@
+package storage
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/trie"
+	"github.com/ElrondNetwork/elrond-go/data/trie/referenceCounter"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrieReferenceCountPruningKeepsDiskSizeBounded(t *testing.T) {
+	t.Skip("this is a long test")
+
+	numBlocks := 10000
+	numKeys := 10000
+	numUpdatesPerBlock := 100
+	forkEveryNumBlocks := 50
+	measureEveryNumBlocks := 500
+	warmUpNumBlocks := 2000
+	maxAllowedGrowthFactor := 2.0
+
+	trieDir, _ := ioutil.TempDir("", "trie_ref_count_pruning")
+	defer func() {
+		_ = os.RemoveAll(trieDir)
+	}()
+
+	trieDb, err := leveldb.NewSerialDB(filepath.Join(trieDir, "trie"), 2, 1000, 10)
+	require.Nil(t, err)
+	refCountDb, err := leveldb.NewSerialDB(filepath.Join(trieDir, "refCount"), 2, 1000, 10)
+	require.Nil(t, err)
+	defer func() {
+		_ = trieDb.Close()
+		_ = refCountDb.Close()
+	}()
+
+	refCounter, err := referenceCounter.NewReferenceCounter(trieDb, refCountDb, integrationTests.TestMarshalizer)
+	require.Nil(t, err)
+
+	generalCfg := config.TrieStorageManagerConfig{
+		PruningBufferLen:   1000,
+		SnapshotsBufferLen: 10,
+		MaxSnapshots:       2,
+	}
+	trieStorage, err := trie.NewTrieStorageManagerWithReferenceCounting(
+		trieDb,
+		integrationTests.TestMarshalizer,
+		integrationTests.TestHasher,
+		config.DBConfig{},
+		refCounter,
+		generalCfg,
+	)
+	require.Nil(t, err)
+
+	maxTrieLevelInMemory := uint(5)
+	var tr data.Trie
+	tr, _ = trie.NewTrie(trieStorage, integrationTests.TestMarshalizer, integrationTests.TestHasher, maxTrieLevelInMemory)
+
+	keys := make([][]byte, numKeys)
+	for i := range keys {
+		keys[i] = []byte(fmt.Sprintf("key%d", i))
+		_ = tr.Update(keys[i], randomValue())
+	}
+	commitWithNewHashes(tr)
+	prevRootHash, _ := tr.Root()
+
+	maxSizeDuringWarmUp := int64(0)
+	for block := 1; block <= numBlocks; block++ {
+		if block%forkEveryNumBlocks == 0 {
+			updateRandomKeys(tr, keys, numUpdatesPerBlock)
+			commitWithNewHashes(tr)
+			forkRootHash, _ := tr.Root()
+
+			tr.CancelPrune(prevRootHash, data.OldRoot)
+			tr.Prune(forkRootHash, data.NewRoot)
+
+			tr, err = tr.Recreate(prevRootHash)
+			require.Nil(t, err)
+		}
+
+		updateRandomKeys(tr, keys, numUpdatesPerBlock)
+		commitWithNewHashes(tr)
+		rootHash, _ := tr.Root()
+
+		tr.CancelPrune(prevRootHash, data.NewRoot)
+		tr.Prune(prevRootHash, data.OldRoot)
+		prevRootHash = rootHash
+
+		if block%measureEveryNumBlocks != 0 {
+			continue
+		}
+
+		size := getDirSize(trieDir)
+		log.Info("trie with reference count pruning", "block", block, "size on disk", size)
+
+		if block <= warmUpNumBlocks {
+			if size > maxSizeDuringWarmUp {
+				maxSizeDuringWarmUp = size
+			}
+			continue
+		}
+
+		assert.True(t, float64(size) < float64(maxSizeDuringWarmUp)*maxAllowedGrowthFactor,
+			fmt.Sprintf("size on disk grew from %d to %d", maxSizeDuringWarmUp, size))
+	}
+
+	for _, key := range keys {
+		_, err = tr.Get(key)
+		assert.Nil(t, err)
+	}
+}
+
+func commitWithNewHashes(tr data.Trie) {
+	newHashes, _ := tr.GetDirtyHashes()
+	tr.SetNewHashes(newHashes)
+	_ = tr.Commit()
+}
+
+func updateRandomKeys(tr data.Trie, keys [][]byte, numUpdates int) {
+	for i := 0; i < numUpdates; i++ {
+		idx := make([]byte, 2)
+		_, _ = rand.Read(idx)
+		key := keys[(int(idx[0])<<8|int(idx[1]))%len(keys)]
+		_ = tr.Update(key, randomValue())
+	}
+}
+
+func randomValue() []byte {
+	value := make([]byte, 32)
+	_, _ = rand.Read(value)
+
+	return value
+}
+
+func getDirSize(dir string) int64 {
+	size := int64(0)
+	_ = filepath.Walk(dir, func(_ string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+
+	return size
+}
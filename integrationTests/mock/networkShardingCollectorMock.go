@@ -52,6 +52,21 @@ func (nscm *networkShardingCollectorMock) GetPeerInfo(_ core.PeerID) core.P2PPee
 	return core.P2PPeerInfo{}
 }
 
+// GetLastKnownPeerID -
+func (nscm *networkShardingCollectorMock) GetLastKnownPeerID(pk []byte) (*core.PeerID, bool) {
+	nscm.mutPeerIdPkMap.RLock()
+	defer nscm.mutPeerIdPkMap.RUnlock()
+
+	for pid, pkValue := range nscm.peerIdPkMap {
+		if string(pkValue) == string(pk) {
+			pidCopy := pid
+			return &pidCopy, true
+		}
+	}
+
+	return nil, false
+}
+
 // IsInterfaceNil -
 func (nscm *networkShardingCollectorMock) IsInterfaceNil() bool {
 	return nscm == nil
@@ -0,0 +1,42 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/block"
+)
+
+// SlashingHandlerStub -
+type SlashingHandlerStub struct {
+	CreateSlashingPeerDataCalled  func() ([]block.PeerData, error)
+	ProcessSlashingPeerDataCalled func(peerData []block.PeerData) error
+	SaveSlashingHistoryCalled     func(header *block.MetaBlock, headerHash []byte, highestFinalBlockNonce uint64)
+}
+
+// CreateSlashingPeerData -
+func (shs *SlashingHandlerStub) CreateSlashingPeerData() ([]block.PeerData, error) {
+	if shs.CreateSlashingPeerDataCalled != nil {
+		return shs.CreateSlashingPeerDataCalled()
+	}
+
+	return nil, nil
+}
+
+// ProcessSlashingPeerData -
+func (shs *SlashingHandlerStub) ProcessSlashingPeerData(peerData []block.PeerData) error {
+	if shs.ProcessSlashingPeerDataCalled != nil {
+		return shs.ProcessSlashingPeerDataCalled(peerData)
+	}
+
+	return nil
+}
+
+// SaveSlashingHistory -
+func (shs *SlashingHandlerStub) SaveSlashingHistory(header *block.MetaBlock, headerHash []byte, highestFinalBlockNonce uint64) {
+	if shs.SaveSlashingHistoryCalled != nil {
+		shs.SaveSlashingHistoryCalled(header, headerHash, highestFinalBlockNonce)
+	}
+}
+
+// IsInterfaceNil -
+func (shs *SlashingHandlerStub) IsInterfaceNil() bool {
+	return shs == nil
+}
@@ -448,6 +448,13 @@ func hardForkImport(
 					MinPassThreshold: 50,
 					MinVetoThreshold: 50,
 				},
+				DelegationManagerSystemSCConfig: config.DelegationManagerSystemSCConfig{
+					MinCreationDeposit: "100",
+				},
+				DelegationSystemSCConfig: config.DelegationSystemSCConfig{
+					MinDelegationAmount: "1",
+					MaxServiceFee:       10000,
+				},
 				StakingSystemSCConfig: config.StakingSystemSCConfig{
 					GenesisNodePrice:                     "1000",
 					UnJailValue:                          "10",
@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/crypto/signing"
 	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
 	mclsig "github.com/ElrondNetwork/elrond-go/crypto/signing/mcl/singlesig"
+	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
@@ -25,6 +26,10 @@ import (
 )
 
 var stepDelay = time.Second
+
+const topicPeerAuthentication = "peerAuthentication"
+const topicHeartbeatV2 = "heartbeatV2"
+
 var log = logger.GetOrCreate("integrationtests/node")
 
 // TestHeartbeatMonitorWillUpdateAnInactivePeer test what happen if a peer out of 2 stops being responsive on heartbeat status
@@ -121,6 +126,70 @@ func TestHeartbeatMonitorWillNotUpdateTooLongHeartbeatMessages(t *testing.T) {
 	assert.True(t, isMessageCorrectLen(pkHeartBeats, secondPK, expectedLen))
 }
 
+// TestHeartbeatV2MonitorWillUpdateAnInactivePeer tests that the monitor records the heartbeat v2 messages only from
+// the peers that were authenticated and that the peers which stop sending them become inactive
+func TestHeartbeatV2MonitorWillUpdateAnInactivePeer(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	advertiser := integrationTests.CreateMessengerWithKadDht("")
+	_ = advertiser.Bootstrap()
+	advertiserAddr := integrationTests.GetConnectableAddress(advertiser)
+	maxUnresposiveTime := time.Second * 10
+
+	monitor := createMonitor(maxUnresposiveTime)
+	nodes, senders, pks := prepareNodes(advertiserAddr, monitor, 3, "nodeName")
+
+	defer func() {
+		_ = advertiser.Close()
+		for _, n := range nodes {
+			_ = n.Close()
+		}
+	}()
+
+	fmt.Println("Delaying for node bootstrap and topic announcement...")
+	time.Sleep(integrationTests.P2pBootstrapDelay)
+
+	fmt.Println("Sending heartbeat v2 messages without peer authentication...")
+	for _, sender := range senders {
+		log.LogIfError(sender.SendHeartbeatV2())
+	}
+
+	time.Sleep(stepDelay)
+
+	fmt.Println("Checking no public key was recorded...")
+	pkHeartBeats := monitor.GetHeartbeats()
+	for _, pk := range pks {
+		assert.False(t, isMessageReceived(pkHeartBeats, pk))
+	}
+
+	fmt.Println("Sending peer authentication and heartbeat v2 messages from both public keys...")
+	for _, sender := range senders {
+		log.LogIfError(sender.SendPeerAuthentication())
+	}
+	time.Sleep(stepDelay)
+	for _, sender := range senders {
+		log.LogIfError(sender.SendHeartbeatV2())
+	}
+
+	time.Sleep(stepDelay)
+
+	fmt.Println("Checking both public keys are active...")
+	checkReceivedMessages(t, monitor, pks, []int{0, 1})
+
+	fmt.Println("Waiting for max unresponsive time...")
+	time.Sleep(maxUnresposiveTime)
+
+	fmt.Println("Only first pk will send another heartbeat v2 message...")
+	_ = senders[0].SendHeartbeatV2()
+
+	time.Sleep(stepDelay)
+
+	fmt.Println("Checking only first pk is active...")
+	checkReceivedMessages(t, monitor, pks, []int{0})
+}
+
 func prepareNodes(
 	advertiserAddr string,
 	monitor *process.Monitor,
@@ -134,9 +203,13 @@ func prepareNodes(
 	senders := make([]*process.Sender, 0)
 	pks := make([]crypto.PublicKey, 0)
 
+	peerAuthenticationProcessor, heartbeatV2Processor := createHeartbeatV2Processors(monitor)
+
 	for i := 0; i < interactingNodes; i++ {
 		nodes[i] = integrationTests.CreateMessengerWithKadDht(advertiserAddr)
 		_ = nodes[i].CreateTopic(topicHeartbeat, true)
+		_ = nodes[i].CreateTopic(topicPeerAuthentication, true)
+		_ = nodes[i].CreateTopic(topicHeartbeatV2, true)
 
 		isSender := integrationTests.IsIntInSlice(i, senderIdxs)
 		if isSender {
@@ -145,6 +218,8 @@ func prepareNodes(
 			pks = append(pks, pk)
 		} else {
 			_ = nodes[i].RegisterMessageProcessor(topicHeartbeat, monitor)
+			_ = nodes[i].RegisterMessageProcessor(topicPeerAuthentication, peerAuthenticationProcessor)
+			_ = nodes[i].RegisterMessageProcessor(topicHeartbeatV2, heartbeatV2Processor)
 		}
 
 		_ = nodes[i].Bootstrap()
@@ -213,24 +288,64 @@ func createSenderWithName(messenger p2p.Messenger, topic string, nodeName string
 	version := "v01"
 
 	argSender := process.ArgHeartbeatSender{
-		PeerMessenger:        messenger,
-		PeerSignatureHandler: &mock2.PeerSignatureHandler{Signer: signer},
-		PrivKey:              sk,
-		Marshalizer:          integrationTests.TestMarshalizer,
-		Topic:                topic,
-		ShardCoordinator:     &sharding.OneShardCoordinator{},
-		PeerTypeProvider:     &mock.PeerTypeProviderStub{},
-		StatusHandler:        &mock.AppStatusHandlerStub{},
-		VersionNumber:        version,
-		NodeDisplayName:      nodeName,
-		HardforkTrigger:      &mock.HardforkTriggerStub{},
-		CurrentBlockProvider: &mock.BlockChainMock{},
+		PeerMessenger:            messenger,
+		PeerSignatureHandler:     &mock2.PeerSignatureHandler{Signer: signer},
+		PrivKey:                  sk,
+		Marshalizer:              integrationTests.TestMarshalizer,
+		Topic:                    topic,
+		ShardCoordinator:         &sharding.OneShardCoordinator{},
+		PeerTypeProvider:         &mock.PeerTypeProviderStub{},
+		StatusHandler:            &mock.AppStatusHandlerStub{},
+		VersionNumber:            version,
+		NodeDisplayName:          nodeName,
+		HardforkTrigger:          &mock.HardforkTriggerStub{},
+		CurrentBlockProvider:     &mock.BlockChainMock{},
+		SingleSigner:             signer,
+		PeerAuthenticationTopic:  topicPeerAuthentication,
+		HeartbeatV2Topic:         topicHeartbeatV2,
+		PeerAuthenticationExpiry: time.Minute,
 	}
 
 	sender, _ := process.NewSender(argSender)
 	return sender, pk
 }
 
+func createHeartbeatV2Processors(monitor *process.Monitor) (p2p.MessageProcessor, p2p.MessageProcessor) {
+	timer := &process.RealTimer{}
+	cache, _ := process.NewPeerAuthenticationCache(timer)
+	antifloodHandler := &mock.P2PAntifloodHandlerStub{
+		CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
+			return nil
+		},
+	}
+
+	argPeerAuthenticationProcessor := process.ArgPeerAuthenticationProcessor{
+		Marshalizer:      integrationTests.TestMarshalizer,
+		SingleSigner:     &mclsig.BlsSingleSigner{},
+		KeyGen:           signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		AntifloodHandler: antifloodHandler,
+		NetworkShardingCollector: &mock.NetworkShardingCollectorStub{
+			UpdatePeerIdPublicKeyCalled: func(pid core.PeerID, pk []byte) {},
+			UpdatePeerIdShardIdCalled:   func(pid core.PeerID, shardId uint32) {},
+		},
+		PeerAuthenticationCache: cache,
+		NewPeerNotifier:         process.NewPeerAuthenticationTrigger(),
+		Timer:                   timer,
+		MaxExpiryDuration:       time.Minute,
+	}
+	peerAuthenticationProcessor, _ := process.NewPeerAuthenticationProcessor(argPeerAuthenticationProcessor)
+
+	argHeartbeatV2Processor := process.ArgHeartbeatV2Processor{
+		Marshalizer:             integrationTests.TestMarshalizer,
+		AntifloodHandler:        antifloodHandler,
+		PeerAuthenticationCache: cache,
+		HeartbeatReceiver:       monitor,
+	}
+	heartbeatV2Processor, _ := process.NewHeartbeatV2Processor(argHeartbeatV2Processor)
+
+	return peerAuthenticationProcessor, heartbeatV2Processor
+}
+
 func createMonitor(maxDurationPeerUnresponsive time.Duration) *process.Monitor {
 	suite := mcl.NewSuiteBLS12()
 	singlesigner := &mclsig.BlsSingleSigner{}
@@ -279,6 +394,7 @@ func createMonitor(maxDurationPeerUnresponsive time.Duration) *process.Monitor {
 		ValidatorPubkeyConverter:           integrationTests.TestValidatorPubkeyConverter,
 		HeartbeatRefreshIntervalInSec:      1,
 		HideInactiveValidatorIntervalInSec: 600,
+		EpochStartEventNotifier:            notifier.NewEpochStartSubscriptionHandler(),
 	}
 
 	monitor, _ := process.NewMonitor(argMonitor)
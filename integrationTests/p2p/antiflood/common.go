@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/floodPreventers"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
@@ -58,6 +59,7 @@ func CreateTopicsAndMockInterceptors(
 			PercentReserved:           0,
 			IncreaseThreshold:         0,
 			IncreaseFactor:            0,
+			ReservedPercentAdapter:    &disabled.AdaptiveQuotaHandler{},
 		}
 		interceptors[idx].FloodPreventer, err = floodPreventers.NewQuotaFloodPreventer(arg)
 		if err != nil {
@@ -0,0 +1,86 @@
+package mdns
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/integrationTests/p2p/peerDiscovery"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTopic = "test topic"
+const durationTopicAnnounceTime = 2 * time.Second
+
+func createMessengerWithMdns(t *testing.T, serviceTag string) p2p.Messenger {
+	arg := libp2p.ArgsNetworkMessenger{
+		Marshalizer:   integrationTests.TestMarshalizer,
+		ListenAddress: libp2p.ListenAddrWithIp4AndTcp,
+		P2pConfig: config.P2PConfig{
+			Node: config.NodeConfig{
+				Port: "0",
+			},
+			Discovery: config.PeerDiscoveryConfig{
+				Type: p2p.MdnsDiscovery,
+				Mdns: config.MdnsDiscoveryConfig{
+					IntervalInSec: 1,
+					ServiceTag:    serviceTag,
+				},
+			},
+			Sharding: config.ShardingConfig{
+				Type: p2p.NilListSharder,
+			},
+		},
+		SyncTimer: &libp2p.LocalSyncTimer{},
+	}
+
+	messenger, err := libp2p.NewNetworkMessenger(arg)
+	require.Nil(t, err)
+
+	return messenger
+}
+
+func TestMdnsPeerDiscoveryAndMessageSending(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	numOfPeers := 5
+	serviceTag := fmt.Sprintf("_erd-test-%d._udp", time.Now().UnixNano())
+	peers := make([]p2p.Messenger, numOfPeers)
+	for i := 0; i < numOfPeers; i++ {
+		peers[i] = createMessengerWithMdns(t, serviceTag)
+		err := peers[i].Bootstrap()
+		require.Nil(t, err)
+	}
+	defer func() {
+		for _, peer := range peers {
+			_ = peer.Close()
+		}
+	}()
+
+	integrationTests.WaitForBootstrapAndShowConnected(peers, integrationTests.P2pBootstrapDelay)
+	for _, peer := range peers {
+		assert.Equal(t, numOfPeers-1, len(peer.ConnectedPeers()))
+	}
+
+	for _, peer := range peers {
+		err := peer.CreateTopic(testTopic, false)
+		require.Nil(t, err)
+	}
+	time.Sleep(durationTopicAnnounceTime)
+
+	numOfTests := 5
+	for i := 0; i < numOfTests; i++ {
+		if peerDiscovery.RunTest(peers, i, testTopic) {
+			return
+		}
+	}
+
+	assert.Fail(t, "test failed. Discovery/message passing are not validated")
+}
@@ -0,0 +1,113 @@
+package staticPeers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const durationWaitForConnection = 3 * time.Second
+
+func createP2PConfig(port string, seed string, staticPeers []string) config.P2PConfig {
+	return config.P2PConfig{
+		Node: config.NodeConfig{
+			Port: port,
+			Seed: seed,
+		},
+		Discovery: config.PeerDiscoveryConfig{
+			Type: p2p.StaticPeersDiscovery,
+			StaticPeers: config.StaticPeersDiscoveryConfig{
+				PeerList:                 staticPeers,
+				MinReconnectBackoffInSec: 1,
+				MaxReconnectBackoffInSec: 2,
+			},
+		},
+		Sharding: config.ShardingConfig{
+			Type: p2p.NilListSharder,
+		},
+	}
+}
+
+func createMessenger(t *testing.T, p2pConfig config.P2PConfig) p2p.Messenger {
+	arg := libp2p.ArgsNetworkMessenger{
+		Marshalizer:   integrationTests.TestMarshalizer,
+		ListenAddress: libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		P2pConfig:     p2pConfig,
+		SyncTimer:     &libp2p.LocalSyncTimer{},
+	}
+
+	messenger, err := libp2p.NewNetworkMessenger(arg)
+	require.Nil(t, err)
+
+	err = messenger.Bootstrap()
+	require.Nil(t, err)
+
+	return messenger
+}
+
+func getPort(address string) string {
+	// address has the form /ip4/127.0.0.1/tcp/<port>/p2p/<pid>
+	return strings.Split(address, "/")[4]
+}
+
+func TestStaticPeersDiscovery_ShouldConnectToAllStaticPeers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	staticPeer1 := createMessenger(t, createP2PConfig("0", "", nil))
+	staticPeer2 := createMessenger(t, createP2PConfig("0", "", nil))
+	staticPeers := []string{
+		integrationTests.GetConnectableAddress(staticPeer1),
+		integrationTests.GetConnectableAddress(staticPeer2),
+	}
+	peer := createMessenger(t, createP2PConfig("0", "", staticPeers))
+	defer func() {
+		_ = staticPeer1.Close()
+		_ = staticPeer2.Close()
+		_ = peer.Close()
+	}()
+
+	time.Sleep(durationWaitForConnection)
+
+	assert.True(t, peer.IsConnected(staticPeer1.ID()))
+	assert.True(t, peer.IsConnected(staticPeer2.ID()))
+	assert.False(t, staticPeer1.IsConnected(staticPeer2.ID()))
+}
+
+func TestStaticPeersDiscovery_ShouldReconnectAfterTheStaticPeerRestarts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	staticPeerSeed := "static peer seed"
+	staticPeer := createMessenger(t, createP2PConfig("0", staticPeerSeed, nil))
+	staticPeerAddress := integrationTests.GetConnectableAddress(staticPeer)
+	staticPeerID := staticPeer.ID()
+	err := staticPeer.Close()
+	require.Nil(t, err)
+
+	peer := createMessenger(t, createP2PConfig("0", "", []string{staticPeerAddress}))
+	defer func() {
+		_ = peer.Close()
+	}()
+
+	time.Sleep(durationWaitForConnection)
+	assert.False(t, peer.IsConnected(staticPeerID))
+
+	restartedStaticPeer := createMessenger(t, createP2PConfig(getPort(staticPeerAddress), staticPeerSeed, nil))
+	defer func() {
+		_ = restartedStaticPeer.Close()
+	}()
+	require.Equal(t, staticPeerID, restartedStaticPeer.ID())
+
+	time.Sleep(durationWaitForConnection)
+	assert.True(t, peer.IsConnected(staticPeerID))
+}
@@ -0,0 +1,160 @@
+package transports
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/integrationTests"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const webSocketMarker = "/ws/"
+const testTopic = "test"
+const durationWaitForConnection = time.Second
+const durationWaitForMessages = time.Second * 2
+
+type messageCounter struct {
+	numMessages uint32
+}
+
+// ProcessReceivedMessage -
+func (mc *messageCounter) ProcessReceivedMessage(_ p2p.MessageP2P, _ core.PeerID) error {
+	atomic.AddUint32(&mc.numMessages, 1)
+	return nil
+}
+
+// IsInterfaceNil -
+func (mc *messageCounter) IsInterfaceNil() bool {
+	return mc == nil
+}
+
+func createP2PConfig(webSocketEnabled bool) config.P2PConfig {
+	return config.P2PConfig{
+		Node: config.NodeConfig{
+			Port: "0",
+			Transports: config.TransportsConfig{
+				WebSocket: config.TransportConfig{
+					Enabled: webSocketEnabled,
+					Port:    "0",
+				},
+			},
+		},
+		KadDhtPeerDiscovery: config.KadDhtPeerDiscoveryConfig{
+			Enabled: false,
+		},
+		Sharding: config.ShardingConfig{
+			Type: p2p.NilListSharder,
+		},
+	}
+}
+
+func createMessenger(t *testing.T, webSocketEnabled bool) p2p.Messenger {
+	arg := libp2p.ArgsNetworkMessenger{
+		Marshalizer:   integrationTests.TestMarshalizer,
+		ListenAddress: libp2p.ListenLocalhostAddrWithIp4AndTcp,
+		P2pConfig:     createP2PConfig(webSocketEnabled),
+		SyncTimer:     &libp2p.LocalSyncTimer{},
+	}
+
+	messenger, err := libp2p.NewNetworkMessenger(arg)
+	require.Nil(t, err)
+
+	return messenger
+}
+
+func getAddress(messenger p2p.Messenger, webSocket bool) string {
+	for _, address := range messenger.Addresses() {
+		if strings.Contains(address, webSocketMarker) == webSocket {
+			return address
+		}
+	}
+
+	return ""
+}
+
+func countWebSocketAddresses(addresses []string) int {
+	counter := 0
+	for _, address := range addresses {
+		if strings.Contains(address, webSocketMarker) {
+			counter++
+		}
+	}
+
+	return counter
+}
+
+func TestWebSocketTransport_PeersShouldConnectAndExchangeMessages(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	server := createMessenger(t, true)
+	client := createMessenger(t, false)
+	defer func() {
+		_ = server.Close()
+		_ = client.Close()
+	}()
+
+	webSocketAddress := getAddress(server, true)
+	require.NotEmpty(t, webSocketAddress)
+
+	err := client.ConnectToPeer(webSocketAddress)
+	require.Nil(t, err)
+	time.Sleep(durationWaitForConnection)
+
+	assert.Equal(t, 1, countWebSocketAddresses(server.ConnectedAddresses()))
+	assert.Equal(t, 1, countWebSocketAddresses(client.ConnectedAddresses()))
+
+	serverCounter := &messageCounter{}
+	clientCounter := &messageCounter{}
+	for _, mes := range []p2p.Messenger{server, client} {
+		err = mes.CreateTopic(testTopic, true)
+		require.Nil(t, err)
+	}
+	err = server.RegisterMessageProcessor(testTopic, serverCounter)
+	require.Nil(t, err)
+	err = client.RegisterMessageProcessor(testTopic, clientCounter)
+	require.Nil(t, err)
+	time.Sleep(durationWaitForConnection)
+
+	server.Broadcast(testTopic, []byte("from server"))
+	client.Broadcast(testTopic, []byte("from client"))
+	time.Sleep(durationWaitForMessages)
+
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&serverCounter.numMessages))
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&clientCounter.numMessages))
+}
+
+func TestWebSocketTransport_ConnectedAddressesShouldReportEachTransport(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	server := createMessenger(t, true)
+	webSocketClient := createMessenger(t, false)
+	tcpClient := createMessenger(t, false)
+	defer func() {
+		_ = server.Close()
+		_ = webSocketClient.Close()
+		_ = tcpClient.Close()
+	}()
+
+	err := webSocketClient.ConnectToPeer(getAddress(server, true))
+	require.Nil(t, err)
+	err = tcpClient.ConnectToPeer(getAddress(server, false))
+	require.Nil(t, err)
+	time.Sleep(durationWaitForConnection)
+
+	connectedAddresses := server.ConnectedAddresses()
+	assert.Equal(t, 2, len(connectedAddresses))
+	assert.Equal(t, 1, countWebSocketAddresses(connectedAddresses))
+	assert.Equal(t, 0, countWebSocketAddresses(tcpClient.ConnectedAddresses()))
+	assert.Equal(t, 1, countWebSocketAddresses(webSocketClient.ConnectedAddresses()))
+}
@@ -567,6 +567,13 @@ func CreateFullGenesisBlocks(
 				MinPassThreshold: 50,
 				MinVetoThreshold: 50,
 			},
+			DelegationManagerSystemSCConfig: config.DelegationManagerSystemSCConfig{
+				MinCreationDeposit: "100",
+			},
+			DelegationSystemSCConfig: config.DelegationSystemSCConfig{
+				MinDelegationAmount: "1",
+				MaxServiceFee:       10000,
+			},
 			StakingSystemSCConfig: config.StakingSystemSCConfig{
 				GenesisNodePrice:                     "1000",
 				UnJailValue:                          "10",
@@ -575,6 +582,7 @@ func CreateFullGenesisBlocks(
 				UnBondPeriod:                         1,
 				AuctionEnableEpoch:                   10000000,
 				StakeEnableEpoch:                     0,
+				ValidatorKeyRotationEnableEpoch:      0,
 				NumRoundsWithoutBleed:                1,
 				MaximumPercentageToBleed:             1,
 				BleedPercentagePerRound:              1,
@@ -656,6 +664,13 @@ func CreateGenesisMetaBlock(
 				MinPassThreshold: 50,
 				MinVetoThreshold: 50,
 			},
+			DelegationManagerSystemSCConfig: config.DelegationManagerSystemSCConfig{
+				MinCreationDeposit: "100",
+			},
+			DelegationSystemSCConfig: config.DelegationSystemSCConfig{
+				MinDelegationAmount: "1",
+				MaxServiceFee:       10000,
+			},
 			StakingSystemSCConfig: config.StakingSystemSCConfig{
 				GenesisNodePrice:                     "1000",
 				UnJailValue:                          "10",
@@ -664,6 +679,7 @@ func CreateGenesisMetaBlock(
 				UnBondPeriod:                         1,
 				AuctionEnableEpoch:                   10000000,
 				StakeEnableEpoch:                     0,
+				ValidatorKeyRotationEnableEpoch:      0,
 				NumRoundsWithoutBleed:                1,
 				MaximumPercentageToBleed:             1,
 				BleedPercentagePerRound:              1,
@@ -180,11 +180,13 @@ func (tP2pNode *TestP2PNode) initNode() {
 	log.LogIfError(err)
 
 	hbConfig := config.HeartbeatConfig{
-		MinTimeToWaitBetweenBroadcastsInSec: 4,
-		MaxTimeToWaitBetweenBroadcastsInSec: 6,
-		DurationToConsiderUnresponsiveInSec: 60,
-		HeartbeatRefreshIntervalInSec:       5,
-		HideInactiveValidatorIntervalInSec:  600,
+		MinTimeToWaitBetweenBroadcastsInSec:     4,
+		MaxTimeToWaitBetweenBroadcastsInSec:     6,
+		DurationToConsiderUnresponsiveInSec:     60,
+		HeartbeatRefreshIntervalInSec:           5,
+		HideInactiveValidatorIntervalInSec:      600,
+		PeerAuthenticationTimeBetweenSendsInSec: 60,
+		PeerAuthenticationExpiryInSec:           300,
 	}
 	err = tP2pNode.Node.StartHeartbeat(hbConfig, "test", config.PreferencesConfig{})
 	log.LogIfError(err)
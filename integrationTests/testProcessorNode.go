@@ -11,6 +11,7 @@ import (
 	arwenConfig "github.com/ElrondNetwork/arwen-wasm-vm/config"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/accumulator"
@@ -37,6 +38,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/containers"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/factory/resolverscontainer"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
+	consensusDebug "github.com/ElrondNetwork/elrond-go/debug/consensus"
 	"github.com/ElrondNetwork/elrond-go/epochStart/metachain"
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
 	"github.com/ElrondNetwork/elrond-go/epochStart/shardchain"
@@ -236,7 +238,7 @@ type TestProcessorNode struct {
 	RequestedItemsHandler    dataRetriever.RequestedItemsHandler
 	WhiteListHandler         process.WhiteListHandler
 	WhiteListerVerifiedTxs   process.WhiteListHandler
-	NetworkShardingCollector consensus.NetworkShardingCollector
+	NetworkShardingCollector NetworkShardingUpdater
 
 	EpochStartTrigger  TestEpochStartTrigger
 	EpochStartNotifier notifier.EpochStartNotifier
@@ -472,14 +474,18 @@ func NewTestProcessorNodeWithFullGenesis(
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain)
 	tpn.initBlockProcessor(stateCheckpointModulus)
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
+		sposFactory.NewDefaultConsensusTypesRegistry(),
+		consensus.BlsConsensusType,
 		TestMarshalizer,
 		TestHasher,
 		tpn.Messenger,
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		broadcast.NewDisabledValidatorDirectSender(),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
+		consensusDebug.NewDisabledRoundTracer(),
 	)
 	tpn.setGenesisBlock()
 	tpn.initNode()
@@ -614,14 +620,18 @@ func (tpn *TestProcessorNode) initTestNode() {
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain)
 	tpn.initBlockProcessor(stateCheckpointModulus)
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
+		sposFactory.NewDefaultConsensusTypesRegistry(),
+		consensus.BlsConsensusType,
 		TestMarshalizer,
 		TestHasher,
 		tpn.Messenger,
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		broadcast.NewDisabledValidatorDirectSender(),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
+		consensusDebug.NewDisabledRoundTracer(),
 	)
 	tpn.setGenesisBlock()
 	tpn.initNode()
@@ -637,14 +647,18 @@ func (tpn *TestProcessorNode) InitializeProcessors() {
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain)
 	tpn.initBlockProcessor(stateCheckpointModulus)
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
+		sposFactory.NewDefaultConsensusTypesRegistry(),
+		consensus.BlsConsensusType,
 		TestMarshalizer,
 		TestHasher,
 		tpn.Messenger,
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		broadcast.NewDisabledValidatorDirectSender(),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
+		consensusDebug.NewDisabledRoundTracer(),
 	)
 	tpn.setGenesisBlock()
 	tpn.initNode()
@@ -1031,10 +1045,14 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 	gasSchedule := arwenConfig.MakeGasMapForTests()
 	defaults.FillGasMapInternal(gasSchedule, 1)
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:          gasSchedule,
-		MapDNSAddresses: mapDNSAddresses,
-		Marshalizer:     TestMarshalizer,
-		Accounts:        tpn.AccntState,
+		GasMap:                      gasSchedule,
+		MapDNSAddresses:             mapDNSAddresses,
+		Marshalizer:                 TestMarshalizer,
+		Accounts:                    tpn.AccntState,
+		ShardCoordinator:            tpn.ShardCoordinator,
+		ArgumentParser:              smartContract.NewArgumentParser(),
+		EpochNotifier:               tpn.EpochNotifier,
+		BuiltInFunctionsEnableEpoch: tpn.BuiltinEnableEpoch,
 	}
 	builtInFuncs, _ := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 
@@ -1110,7 +1128,6 @@ func (tpn *TestProcessorNode) initInnerProcessors() {
 		BadTxForwarder:                 badBlocksHandler,
 		EpochNotifier:                  tpn.EpochNotifier,
 		DeployEnableEpoch:              tpn.DeployEnableEpoch,
-		BuiltinEnableEpoch:             tpn.BuiltinEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: tpn.PenalizedTooMuchGasEnableEpoch,
 	}
 	tpn.ScProcessor, _ = smartContract.NewSmartContractProcessor(argsNewScProcessor)
@@ -1229,6 +1246,13 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 				MinPassThreshold: 50,
 				MinVetoThreshold: 50,
 			},
+			DelegationManagerSystemSCConfig: config.DelegationManagerSystemSCConfig{
+				MinCreationDeposit: "100",
+			},
+			DelegationSystemSCConfig: config.DelegationSystemSCConfig{
+				MinDelegationAmount: "1",
+				MaxServiceFee:       10000,
+			},
 			StakingSystemSCConfig: config.StakingSystemSCConfig{
 				GenesisNodePrice:                     "1000",
 				UnJailValue:                          "10",
@@ -1237,6 +1261,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 				UnBondPeriod:                         1,
 				AuctionEnableEpoch:                   1000000,
 				StakeEnableEpoch:                     0,
+				ValidatorKeyRotationEnableEpoch:      0,
 				NumRoundsWithoutBleed:                1,
 				MaximumPercentageToBleed:             1,
 				BleedPercentagePerRound:              1,
@@ -1285,7 +1310,6 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		TxLogsProcessor:                &mock.TxLogsProcessorStub{},
 		BadTxForwarder:                 badBlocksHandler,
 		EpochNotifier:                  tpn.EpochNotifier,
-		BuiltinEnableEpoch:             tpn.BuiltinEnableEpoch,
 		DeployEnableEpoch:              tpn.DeployEnableEpoch,
 		PenalizedTooMuchGasEnableEpoch: tpn.PenalizedTooMuchGasEnableEpoch,
 	}
@@ -1479,6 +1503,7 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 			PeerAccountsDB:          tpn.PeerState,
 			Marshalizer:             TestMarshalizer,
 			StartRating:             tpn.RatingsData.StartRating(),
+			JailRating:              tpn.RatingsData.MinRating(),
 			ValidatorInfoCreator:    tpn.ValidatorStatisticsProcessor,
 			EndOfEpochCallerAddress: vm.EndOfEpochAddress,
 			StakingSCAddress:        vm.StakingSCAddress,
@@ -1498,6 +1523,7 @@ func (tpn *TestProcessorNode) initBlockProcessor(stateCheckpointModulus uint) {
 			EpochValidatorInfoCreator:    epochStartValidatorInfo,
 			ValidatorStatisticsProcessor: tpn.ValidatorStatisticsProcessor,
 			EpochSystemSCProcessor:       epochStartSystemSCProcessor,
+			SlashingHandler:              &mock.SlashingHandlerStub{},
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
@@ -2085,11 +2111,13 @@ func (tpn *TestProcessorNode) createHeartbeatWithHardforkTrigger(heartbeatPk str
 	)
 
 	hbConfig := config.HeartbeatConfig{
-		MinTimeToWaitBetweenBroadcastsInSec: 4,
-		MaxTimeToWaitBetweenBroadcastsInSec: 6,
-		DurationToConsiderUnresponsiveInSec: 60,
-		HeartbeatRefreshIntervalInSec:       5,
-		HideInactiveValidatorIntervalInSec:  600,
+		MinTimeToWaitBetweenBroadcastsInSec:     4,
+		MaxTimeToWaitBetweenBroadcastsInSec:     6,
+		DurationToConsiderUnresponsiveInSec:     60,
+		HeartbeatRefreshIntervalInSec:           5,
+		HideInactiveValidatorIntervalInSec:      600,
+		PeerAuthenticationTimeBetweenSendsInSec: 60,
+		PeerAuthenticationExpiryInSec:           300,
 	}
 	err = tpn.Node.StartHeartbeat(hbConfig, "test", config.PreferencesConfig{})
 	log.LogIfError(err)
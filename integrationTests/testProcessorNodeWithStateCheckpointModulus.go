@@ -1,8 +1,11 @@
 package integrationTests
 
 import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core/forking"
+	consensusDebug "github.com/ElrondNetwork/elrond-go/debug/consensus"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -114,14 +117,18 @@ func NewTestProcessorNodeWithStateCheckpointModulus(
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(tpn.VMContainer, tpn.EconomicsData, tpn.BlockchainHook, tpn.BlockChain)
 	tpn.initBlockProcessor(stateCheckpointModulus)
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
+		sposFactory.NewDefaultConsensusTypesRegistry(),
+		consensus.BlsConsensusType,
 		TestMarshalizer,
 		TestHasher,
 		tpn.Messenger,
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		broadcast.NewDisabledValidatorDirectSender(),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
+		consensusDebug.NewDisabledRoundTracer(),
 	)
 	tpn.setGenesisBlock()
 	tpn.initNode()
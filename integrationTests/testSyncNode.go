@@ -3,6 +3,8 @@ package integrationTests
 import (
 	"fmt"
 
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/forking"
@@ -10,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/provider"
+	consensusDebug "github.com/ElrondNetwork/elrond-go/debug/consensus"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/process/block"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
@@ -120,14 +123,18 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 	tpn.initInnerProcessors()
 	tpn.initBlockProcessorWithSync()
 	tpn.BroadcastMessenger, _ = sposFactory.GetBroadcastMessenger(
+		sposFactory.NewDefaultConsensusTypesRegistry(),
+		consensus.BlsConsensusType,
 		TestMarshalizer,
 		TestHasher,
 		tpn.Messenger,
 		tpn.ShardCoordinator,
 		tpn.OwnAccount.SkTxSign,
 		tpn.OwnAccount.PeerSigHandler,
+		broadcast.NewDisabledValidatorDirectSender(),
 		tpn.DataPool.Headers(),
 		tpn.InterceptorsContainer,
+		consensusDebug.NewDisabledRoundTracer(),
 	)
 	tpn.initBootstrapper()
 	tpn.setGenesisBlock()
@@ -205,6 +212,7 @@ func (tpn *TestProcessorNode) initBlockProcessorWithSync() {
 			EpochValidatorInfoCreator:    &mock.EpochValidatorInfoCreatorStub{},
 			ValidatorStatisticsProcessor: &mock.ValidatorStatisticsProcessorStub{},
 			EpochSystemSCProcessor:       &mock.EpochStartSystemSCStub{},
+			SlashingHandler:              &mock.SlashingHandlerStub{},
 		}
 
 		tpn.BlockProcessor, err = block.NewMetaProcessor(arguments)
@@ -1,6 +1,7 @@
 package systemVM
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -10,11 +11,14 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing"
+	"github.com/ElrondNetwork/elrond-go/crypto/signing/mcl"
 	"github.com/ElrondNetwork/elrond-go/data/block"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/multiShard/endOfEpoch"
 	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -342,6 +346,140 @@ func TestStakeWithRewardsAddressAndValidatorStatistics(t *testing.T) {
 	}
 }
 
+func TestChangeValidatorKeysOnMultiShardEnvironment(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	numOfShards := 2
+	nodesPerShard := 2
+	numMetachainNodes := 2
+	shardConsensusGroupSize := 1
+	metaConsensusGroupSize := 1
+
+	advertiser := integrationTests.CreateMessengerWithKadDht("")
+	_ = advertiser.Bootstrap()
+
+	nodesMap := integrationTests.CreateNodesWithNodesCoordinatorAndTxKeys(
+		nodesPerShard,
+		numMetachainNodes,
+		numOfShards,
+		shardConsensusGroupSize,
+		metaConsensusGroupSize,
+		integrationTests.GetConnectableAddress(advertiser),
+	)
+
+	nodes := make([]*integrationTests.TestProcessorNode, 0)
+	for _, nds := range nodesMap {
+		nodes = append(nodes, nds...)
+	}
+	integrationTests.DisplayAndStartNodes(nodes)
+
+	roundsPerEpoch := uint64(5)
+	for _, node := range nodes {
+		node.EpochStartTrigger.SetRoundsPerEpoch(roundsPerEpoch)
+	}
+
+	defer func() {
+		_ = advertiser.Close()
+		for _, n := range nodes {
+			_ = n.Messenger.Close()
+		}
+	}()
+
+	initialVal := big.NewInt(10000000000)
+	integrationTests.MintAllNodes(nodes, initialVal)
+
+	round := uint64(0)
+	nonce := uint64(0)
+	round = integrationTests.IncrementAndPrintRound(round)
+	nonce++
+
+	///////////------- send the change validator key tx from the owner of a shard validator
+	rotatedNode := nodesMap[0][1]
+	oldKeys := rotatedNode.NodeKeys
+	keyGen := signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	newSk, newPk := keyGen.GeneratePair()
+	newKeys := &integrationTests.TestKeyPair{Sk: newSk, Pk: newPk}
+	oldPubKey, _ := oldKeys.Pk.ToByteArray()
+	newPubKey, _ := newKeys.Pk.ToByteArray()
+
+	txData := "changeValidatorKeys" + "@" + hex.EncodeToString(big.NewInt(1).Bytes()) +
+		"@" + hex.EncodeToString(oldPubKey) + "@" + hex.EncodeToString(newPubKey) + "@" + hex.EncodeToString([]byte("signed"))
+	integrationTests.CreateAndSendTransaction(rotatedNode, nodes, big.NewInt(0), vm.AuctionSCAddress, txData, integrationTests.AdditionalGasLimit)
+
+	nbBlocksToProduce := roundsPerEpoch * 3
+	var consensusNodes map[uint32][]*integrationTests.TestProcessorNode
+
+	for i := uint64(0); i < nbBlocksToProduce; i++ {
+		for _, nodesSlice := range nodesMap {
+			integrationTests.UpdateRound(nodesSlice, round)
+			integrationTests.AddSelfNotarizedHeaderByMetachain(nodesSlice)
+		}
+
+		// the node has to sign with the new key as soon as the nodes coordinator uses it
+		if isKeyInNodesConfig(nodesMap[0][0], newPubKey) {
+			rotatedNode.NodeKeys = newKeys
+		}
+
+		_, _, consensusNodes = integrationTests.AllShardsProposeBlock(round, nonce, nodesMap)
+		indexesProposers := endOfEpoch.GetBlockProposersIndexes(consensusNodes, nodesMap)
+		integrationTests.SyncAllShardsWithRoundBlock(t, nodesMap, indexesProposers, round)
+		round++
+		nonce++
+
+		time.Sleep(time.Second)
+	}
+
+	for _, node := range nodes {
+		assert.True(t, isKeyInNodesConfig(node, newPubKey))
+		assert.False(t, isKeyInNodesConfig(node, oldPubKey))
+	}
+
+	for _, node := range nodesMap[core.MetachainShardId] {
+		stakingSCAcc := getAccountFromAddrBytes(node.AccntState, vm.StakingSCAddress)
+		marshaledData, _ := stakingSCAcc.DataTrieTracker().RetrieveValue(oldPubKey)
+		assert.Equal(t, 0, len(marshaledData))
+		marshaledData, _ = stakingSCAcc.DataTrieTracker().RetrieveValue(newPubKey)
+		assert.True(t, len(marshaledData) > 0)
+
+		auctionSCAcc := getAccountFromAddrBytes(node.AccntState, vm.AuctionSCAddress)
+		marshaledData, _ = auctionSCAcc.DataTrieTracker().RetrieveValue(rotatedNode.OwnAccount.Address)
+		auctionData := &systemSmartContracts.AuctionData{}
+		err := integrationTests.TestMarshalizer.Unmarshal(auctionData, marshaledData)
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{newPubKey}, auctionData.BlsPubKeys)
+
+		_, err = node.PeerState.GetExistingAccount(oldPubKey)
+		assert.NotNil(t, err)
+		peerAcc, err := node.PeerState.GetExistingAccount(newPubKey)
+		require.Nil(t, err)
+		assert.Equal(t, uint32(0), peerAcc.(state.PeerAccountHandler).GetShardId())
+	}
+}
+
+func isKeyInNodesConfig(node *integrationTests.TestProcessorNode, blsKey []byte) bool {
+	epoch := node.BlockChain.GetGenesisHeader().GetEpoch()
+	currentHeader := node.BlockChain.GetCurrentBlockHeader()
+	if currentHeader != nil && !currentHeader.IsInterfaceNil() {
+		epoch = currentHeader.GetEpoch()
+	}
+
+	eligible, _ := node.NodesCoordinator.GetAllEligibleValidatorsPublicKeys(epoch)
+	waiting, _ := node.NodesCoordinator.GetAllWaitingValidatorsPublicKeys(epoch)
+	for _, keysMap := range []map[uint32][][]byte{eligible, waiting} {
+		for _, keys := range keysMap {
+			for _, key := range keys {
+				if bytes.Equal(key, blsKey) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
 func getNodeIndex(nodeList []*integrationTests.TestProcessorNode, node *integrationTests.TestProcessorNode) (int, error) {
 	for i := range nodeList {
 		if node == nodeList[i] {
@@ -266,10 +266,13 @@ func CreateVMAndBlockchainHook(
 	}
 
 	argsBuiltIn := builtInFunctions.ArgsCreateBuiltInFunctionContainer{
-		GasMap:          actualGasSchedule,
-		MapDNSAddresses: make(map[string]struct{}),
-		Marshalizer:     testMarshalizer,
-		Accounts:        accnts,
+		GasMap:           actualGasSchedule,
+		MapDNSAddresses:  make(map[string]struct{}),
+		Marshalizer:      testMarshalizer,
+		Accounts:         accnts,
+		ShardCoordinator: oneShardCoordinator,
+		ArgumentParser:   smartContract.NewArgumentParser(),
+		EpochNotifier:    forking.NewGenericEpochNotifier(),
 	}
 	builtInFuncs, _ := builtInFunctions.CreateBuiltInFunctionContainer(argsBuiltIn)
 
@@ -109,6 +109,12 @@ var ErrSystemBusyGeneratingTransactions = errors.New("system busy while generati
 // ErrNilStatusHandler is returned when the status handler is nil
 var ErrNilStatusHandler = errors.New("nil AppStatusHandler")
 
+// ErrNilConsensusTypesRegistry signals that a nil consensus types registry has been provided
+var ErrNilConsensusTypesRegistry = errors.New("nil consensus types registry")
+
+// ErrNilRoundTracer signals that a nil round tracer has been provided
+var ErrNilRoundTracer = errors.New("nil round tracer")
+
 // ErrNoTxToProcess signals that no transaction were sent for processing
 var ErrNoTxToProcess = errors.New("no transaction to process")
 
@@ -201,3 +207,27 @@ var ErrCannotRetrieveTransaction = errors.New("transaction cannot be retrieved")
 
 // ErrDifferentSenderShardId signals that a different shard ID was detected between the sender shard ID and the current node shard ID
 var ErrDifferentSenderShardId = errors.New("different shard ID between the transaction sender shard ID and current node shard ID")
+
+// ErrNilPeerReputationStore signals that a nil peer reputation store has been provided
+var ErrNilPeerReputationStore = errors.New("nil peer reputation store")
+
+// ErrNilAntifloodLimitsHandler signals that a nil antiflood limits handler has been provided
+var ErrNilAntifloodLimitsHandler = errors.New("nil antiflood limits handler")
+
+// ErrHeartbeatNotActive signals that the heartbeat subsystem is not active
+var ErrHeartbeatNotActive = errors.New("heartbeat subsystem not active")
+
+// ErrNFTTokenNotFound signals that the requested non-fungible token was not found in the account
+var ErrNFTTokenNotFound = errors.New("non-fungible token not found")
+
+// ErrESDTTokenNotFound signals that the requested esdt token was not found
+var ErrESDTTokenNotFound = errors.New("esdt token not found")
+
+// ErrMetachainOnlyOperation signals that the requested operation can only be executed on a metachain node
+var ErrMetachainOnlyOperation = errors.New("operation is available only on metachain nodes")
+
+// ErrNilEquivocationDetector signals that a nil equivocation detector has been provided
+var ErrNilEquivocationDetector = errors.New("nil equivocation detector")
+
+// ErrNilSlashingHistory signals that a nil slashing history has been provided
+var ErrNilSlashingHistory = errors.New("nil slashing history")
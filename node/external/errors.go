@@ -10,3 +10,6 @@ var ErrNilStatusMetrics = errors.New("nil status metrics handler")
 
 // ErrNilTransactionCostHandler signals that a nil transaction cost handler was provided
 var ErrNilTransactionCostHandler = errors.New("nil transaction cost handler")
+
+// ErrNilBuiltInFunctionsHandler signals that a nil built-in functions handler was provided
+var ErrNilBuiltInFunctionsHandler = errors.New("nil built-in functions handler")
@@ -1,6 +1,7 @@
 package external
 
 import (
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -21,6 +22,7 @@ type StatusMetricsHandler interface {
 	EconomicsMetrics() map[string]interface{}
 	ConfigMetrics() map[string]interface{}
 	NetworkMetrics() map[string]interface{}
+	AddPrometheusMetricsProvider(provider core.PrometheusMetricsProvider) error
 	IsInterfaceNil() bool
 }
 
@@ -29,3 +31,9 @@ type TransactionCostHandler interface {
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (uint64, error)
 	IsInterfaceNil() bool
 }
+
+// BuiltInFunctionsHandler defines the actions which should be handled by a built-in functions provider
+type BuiltInFunctionsHandler interface {
+	GetActiveBuiltInFunctions() []process.BuiltInFunctionInfo
+	IsInterfaceNil() bool
+}
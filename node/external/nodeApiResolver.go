@@ -12,6 +12,7 @@ type NodeApiResolver struct {
 	scQueryService       SCQueryService
 	statusMetricsHandler StatusMetricsHandler
 	txCostHandler        TransactionCostHandler
+	builtInFunctions     BuiltInFunctionsHandler
 }
 
 // NewNodeApiResolver creates a new NodeApiResolver instance
@@ -19,6 +20,7 @@ func NewNodeApiResolver(
 	scQueryService SCQueryService,
 	statusMetricsHandler StatusMetricsHandler,
 	txCostHandler TransactionCostHandler,
+	builtInFunctions BuiltInFunctionsHandler,
 ) (*NodeApiResolver, error) {
 	if check.IfNil(scQueryService) {
 		return nil, ErrNilSCQueryService
@@ -29,11 +31,15 @@ func NewNodeApiResolver(
 	if check.IfNil(txCostHandler) {
 		return nil, ErrNilTransactionCostHandler
 	}
+	if check.IfNil(builtInFunctions) {
+		return nil, ErrNilBuiltInFunctionsHandler
+	}
 
 	return &NodeApiResolver{
 		scQueryService:       scQueryService,
 		statusMetricsHandler: statusMetricsHandler,
 		txCostHandler:        txCostHandler,
+		builtInFunctions:     builtInFunctions,
 	}, nil
 }
 
@@ -52,6 +58,11 @@ func (nar *NodeApiResolver) ComputeTransactionGasLimit(tx *transaction.Transacti
 	return nar.txCostHandler.ComputeTransactionGasLimit(tx)
 }
 
+// GetActiveBuiltInFunctions returns the built-in functions active in the current epoch together with their gas costs
+func (nar *NodeApiResolver) GetActiveBuiltInFunctions() []process.BuiltInFunctionInfo {
+	return nar.builtInFunctions.GetActiveBuiltInFunctions()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nar *NodeApiResolver) IsInterfaceNil() bool {
 	return nar == nil
@@ -14,7 +14,7 @@ import (
 func TestNewNodeApiResolver_NilSCQueryServiceShouldErr(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{})
+	nar, err := external.NewNodeApiResolver(nil, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, &mock.BuiltInFunctionsHandlerStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilSCQueryService, err)
@@ -23,7 +23,7 @@ func TestNewNodeApiResolver_NilSCQueryServiceShouldErr(t *testing.T) {
 func TestNewNodeApiResolver_NilStatusMetricsShouldErr(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, nil, &mock.TransactionCostEstimatorMock{})
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, nil, &mock.TransactionCostEstimatorMock{}, &mock.BuiltInFunctionsHandlerStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilStatusMetrics, err)
@@ -32,16 +32,25 @@ func TestNewNodeApiResolver_NilStatusMetricsShouldErr(t *testing.T) {
 func TestNewNodeApiResolver_NilTransactionCostEstsimator(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, nil)
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, nil, &mock.BuiltInFunctionsHandlerStub{})
 
 	assert.Nil(t, nar)
 	assert.Equal(t, external.ErrNilTransactionCostHandler, err)
 }
 
+func TestNewNodeApiResolver_NilBuiltInFunctionsHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, nil)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilBuiltInFunctionsHandler, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
-	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{})
+	nar, err := external.NewNodeApiResolver(&mock.SCQueryServiceStub{}, &mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, &mock.BuiltInFunctionsHandlerStub{})
 
 	assert.Nil(t, err)
 	assert.False(t, check.IfNil(nar))
@@ -57,7 +66,7 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 			return &vmcommon.VMOutput{}, nil
 		},
 	},
-		&mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{})
+		&mock.StatusMetricsStub{}, &mock.TransactionCostEstimatorMock{}, &mock.BuiltInFunctionsHandlerStub{})
 
 	_, _ = nar.ExecuteSCQuery(&process.SCQuery{
 		ScAddress: []byte{0},
@@ -80,6 +89,7 @@ func TestNodeApiResolver_StatusMetricsMapWithoutP2PShouldBeCalled(t *testing.T)
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.BuiltInFunctionsHandlerStub{},
 	)
 	_ = nar.StatusMetrics().StatusMetricsMapWithoutP2P()
 
@@ -99,6 +109,7 @@ func TestNodeApiResolver_StatusP2pMetricsMapShouldBeCalled(t *testing.T) {
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.BuiltInFunctionsHandlerStub{},
 	)
 	_ = nar.StatusMetrics().StatusP2pMetricsMap()
 
@@ -118,6 +129,7 @@ func TestNodeApiResolver_StatusMetricsMapWhitoutP2PShouldBeCalled(t *testing.T)
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.BuiltInFunctionsHandlerStub{},
 	)
 	_ = nar.StatusMetrics().StatusMetricsMapWithoutP2P()
 
@@ -137,6 +149,7 @@ func TestNodeApiResolver_StatusP2PMetricsMapShouldBeCalled(t *testing.T) {
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.BuiltInFunctionsHandlerStub{},
 	)
 	_ = nar.StatusMetrics().StatusP2pMetricsMap()
 
@@ -156,8 +169,27 @@ func TestNodeApiResolver_NetworkMetricsMapShouldBeCalled(t *testing.T) {
 			},
 		},
 		&mock.TransactionCostEstimatorMock{},
+		&mock.BuiltInFunctionsHandlerStub{},
 	)
 	_ = nar.StatusMetrics().NetworkMetrics()
 
 	assert.True(t, wasCalled)
 }
+
+func TestNodeApiResolver_GetActiveBuiltInFunctionsShouldBeCalled(t *testing.T) {
+	t.Parallel()
+
+	expectedFunctions := []process.BuiltInFunctionInfo{{Name: "ESDTTransfer", GasCost: 10}}
+	nar, _ := external.NewNodeApiResolver(
+		&mock.SCQueryServiceStub{},
+		&mock.StatusMetricsStub{},
+		&mock.TransactionCostEstimatorMock{},
+		&mock.BuiltInFunctionsHandlerStub{
+			GetActiveBuiltInFunctionsCalled: func() []process.BuiltInFunctionInfo {
+				return expectedFunctions
+			},
+		},
+	)
+
+	assert.Equal(t, expectedFunctions, nar.GetActiveBuiltInFunctions())
+}
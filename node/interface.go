@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/process"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
 	"github.com/ElrondNetwork/elrond-go/update"
 )
 
@@ -23,8 +24,11 @@ type P2PMessenger interface {
 	RegisterMessageProcessor(topic string, handler p2p.MessageProcessor) error
 	PeerAddresses(pid core.PeerID) []string
 	IsConnectedToTheNetwork() bool
+	SendToConnectedPeer(topic string, buff []byte, peerID core.PeerID) error
+	IsConnected(peerID core.PeerID) bool
 	ID() core.PeerID
 	Peers() []core.PeerID
+	GetConnectionsInfo() []core.P2PConnectionInfo
 	IsInterfaceNil() bool
 }
 
@@ -35,6 +39,7 @@ type NetworkShardingCollector interface {
 	UpdatePublicKeyShardId(pk []byte, shardId uint32)
 	UpdatePeerIdShardId(pid core.PeerID, shardId uint32)
 	GetPeerInfo(pid core.PeerID) core.P2PPeerInfo
+	GetLastKnownPeerID(pk []byte) (*core.PeerID, bool)
 	IsInterfaceNil() bool
 }
 
@@ -83,3 +88,11 @@ type HeartbeatHandler interface {
 	Sender() *process.Sender
 	IsInterfaceNil() bool
 }
+
+// PeerReputationStore defines the operations available on the persisted peers blacklist
+type PeerReputationStore interface {
+	Ban(recordType string, identifier []byte, span time.Duration, reason string) error
+	Unban(recordType string, identifier []byte) error
+	Records() []blackList.ReputationRecord
+	IsInterfaceNil() bool
+}
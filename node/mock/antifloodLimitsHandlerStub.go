@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/config"
+
+// AntifloodLimitsHandlerStub -
+type AntifloodLimitsHandlerStub struct {
+	LimitsCalled    func() config.AntifloodLimitsOverrideConfig
+	SetLimitsCalled func(limits config.AntifloodLimitsOverrideConfig, source string) error
+}
+
+// Limits -
+func (stub *AntifloodLimitsHandlerStub) Limits() config.AntifloodLimitsOverrideConfig {
+	if stub.LimitsCalled != nil {
+		return stub.LimitsCalled()
+	}
+
+	return config.AntifloodLimitsOverrideConfig{}
+}
+
+// SetLimits -
+func (stub *AntifloodLimitsHandlerStub) SetLimits(limits config.AntifloodLimitsOverrideConfig, source string) error {
+	if stub.SetLimitsCalled != nil {
+		return stub.SetLimitsCalled(limits, source)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *AntifloodLimitsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
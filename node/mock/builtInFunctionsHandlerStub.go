@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// BuiltInFunctionsHandlerStub -
+type BuiltInFunctionsHandlerStub struct {
+	GetActiveBuiltInFunctionsCalled func() []process.BuiltInFunctionInfo
+}
+
+// GetActiveBuiltInFunctions -
+func (bfhs *BuiltInFunctionsHandlerStub) GetActiveBuiltInFunctions() []process.BuiltInFunctionInfo {
+	if bfhs.GetActiveBuiltInFunctionsCalled != nil {
+		return bfhs.GetActiveBuiltInFunctionsCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (bfhs *BuiltInFunctionsHandlerStub) IsInterfaceNil() bool {
+	return bfhs == nil
+}
@@ -17,9 +17,12 @@ type MessengerStub struct {
 	RegisterMessageProcessorCalled   func(topic string, handler p2p.MessageProcessor) error
 	BootstrapCalled                  func() error
 	PeerAddressesCalled              func(pid core.PeerID) []string
+	GetConnectionsInfoCalled         func() []core.P2PConnectionInfo
 	BroadcastOnChannelBlockingCalled func(channel string, topic string, buff []byte) error
 	IsConnectedToTheNetworkCalled    func() bool
 	PeersCalled                      func() []core.PeerID
+	SendToConnectedPeerCalled        func(topic string, buff []byte, peerID core.PeerID) error
+	IsConnectedCalled                func(peerID core.PeerID) bool
 }
 
 // ID -
@@ -89,6 +92,24 @@ func (ms *MessengerStub) IsConnectedToTheNetwork() bool {
 	return ms.IsConnectedToTheNetworkCalled()
 }
 
+// SendToConnectedPeer -
+func (ms *MessengerStub) SendToConnectedPeer(topic string, buff []byte, peerID core.PeerID) error {
+	if ms.SendToConnectedPeerCalled != nil {
+		return ms.SendToConnectedPeerCalled(topic, buff, peerID)
+	}
+
+	return nil
+}
+
+// IsConnected -
+func (ms *MessengerStub) IsConnected(peerID core.PeerID) bool {
+	if ms.IsConnectedCalled != nil {
+		return ms.IsConnectedCalled(peerID)
+	}
+
+	return false
+}
+
 // Peers -
 func (ms *MessengerStub) Peers() []core.PeerID {
 	if ms.PeersCalled != nil {
@@ -98,6 +119,15 @@ func (ms *MessengerStub) Peers() []core.PeerID {
 	return make([]core.PeerID, 0)
 }
 
+// GetConnectionsInfo -
+func (ms *MessengerStub) GetConnectionsInfo() []core.P2PConnectionInfo {
+	if ms.GetConnectionsInfoCalled != nil {
+		return ms.GetConnectionsInfoCalled()
+	}
+
+	return make([]core.P2PConnectionInfo, 0)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ms *MessengerStub) IsInterfaceNil() bool {
 	return ms == nil
@@ -10,6 +10,7 @@ type NetworkShardingCollectorStub struct {
 	UpdatePublicKeyShardIdCalled func(pk []byte, shardId uint32)
 	UpdatePeerIdShardIdCalled    func(pid core.PeerID, shardId uint32)
 	GetPeerInfoCalled            func(pid core.PeerID) core.P2PPeerInfo
+	GetLastKnownPeerIDCalled     func(pk []byte) (*core.PeerID, bool)
 }
 
 // UpdatePeerIdPublicKey -
@@ -32,6 +33,15 @@ func (nscs *NetworkShardingCollectorStub) GetPeerInfo(pid core.PeerID) core.P2PP
 	return nscs.GetPeerInfoCalled(pid)
 }
 
+// GetLastKnownPeerID -
+func (nscs *NetworkShardingCollectorStub) GetLastKnownPeerID(pk []byte) (*core.PeerID, bool) {
+	if nscs.GetLastKnownPeerIDCalled != nil {
+		return nscs.GetLastKnownPeerIDCalled(pk)
+	}
+
+	return nil, false
+}
+
 // IsInterfaceNil -
 func (nscs *NetworkShardingCollectorStub) IsInterfaceNil() bool {
 	return nscs == nil
@@ -1,5 +1,7 @@
 package mock
 
+import "github.com/ElrondNetwork/elrond-go/core"
+
 // StatusMetricsStub -
 type StatusMetricsStub struct {
 	StatusMetricsMapWithoutP2PCalled              func() map[string]interface{}
@@ -8,6 +10,7 @@ type StatusMetricsStub struct {
 	NetworkMetricsCalled                          func() map[string]interface{}
 	EconomicsMetricsCalled                        func() map[string]interface{}
 	StatusMetricsWithoutP2PPrometheusStringCalled func() string
+	AddPrometheusMetricsProviderCalled            func(provider core.PrometheusMetricsProvider) error
 }
 
 // StatusMetricsWithoutP2PPrometheusString -
@@ -19,6 +22,15 @@ func (sms *StatusMetricsStub) StatusMetricsWithoutP2PPrometheusString() string {
 	return "metric 10"
 }
 
+// AddPrometheusMetricsProvider -
+func (sms *StatusMetricsStub) AddPrometheusMetricsProvider(provider core.PrometheusMetricsProvider) error {
+	if sms.AddPrometheusMetricsProviderCalled != nil {
+		return sms.AddPrometheusMetricsProviderCalled(provider)
+	}
+
+	return nil
+}
+
 // ConfigMetrics -
 func (sms *StatusMetricsStub) ConfigMetrics() map[string]interface{} {
 	return sms.ConfigMetricsCalled()
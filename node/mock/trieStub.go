@@ -21,6 +21,7 @@ type TrieStub struct {
 	GetAllHashesCalled          func() ([][]byte, error)
 	DatabaseCalled              func() data.DBWriteCacher
 	GetAllLeavesOnChannelCalled func() chan core.KeyValueHolder
+	GetAllLeavesCalled          func() (map[string][]byte, error)
 }
 
 // EnterSnapshotMode -
@@ -46,6 +47,10 @@ func (ts *TrieStub) SetCheckpoint(_ []byte) {
 
 // GetAllLeaves -
 func (ts *TrieStub) GetAllLeaves() (map[string][]byte, error) {
+	if ts.GetAllLeavesCalled != nil {
+		return ts.GetAllLeavesCalled()
+	}
+
 	return make(map[string][]byte), nil
 }
 
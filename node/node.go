@@ -15,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/broadcast"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
@@ -32,6 +33,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/provider"
 	"github.com/ElrondNetwork/elrond-go/debug"
+	consensusDebug "github.com/ElrondNetwork/elrond-go/debug/consensus"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/hashing"
@@ -44,13 +46,20 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
+	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/process/sync/storageBootstrap"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
 	procTx "github.com/ElrondNetwork/elrond-go/process/transaction"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/update"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	"github.com/mr-tron/base58/base58"
 )
 
 // SendTransactionsPipe is the pipe used for sending new transactions
@@ -92,6 +101,9 @@ type Node struct {
 	resolversFinder               dataRetriever.ResolversFinder
 	peerDenialEvaluator           p2p.PeerDenialEvaluator
 	appStatusHandler              core.AppStatusHandler
+	roundTracer                   consensus.RoundTracer
+	peerReputationStore           PeerReputationStore
+	antifloodLimitsHandler        process.AntifloodLimitsHandler
 	validatorStatistics           process.ValidatorStatisticsProcessor
 	hardforkTrigger               HardforkTrigger
 	validatorsProvider            process.ValidatorsProvider
@@ -117,8 +129,11 @@ type Node struct {
 
 	networkShardingCollector NetworkShardingCollector
 
-	consensusTopic string
-	consensusType  string
+	consensusTopic             string
+	consensusType              string
+	consensusTypesRegistry     sposFactory.ConsensusTypesRegistry
+	consensusPipeliningEnabled bool
+	validatorDirectSendEnabled bool
 
 	currentSendingGoRoutines int32
 	bootstrapRoundIndex      uint64
@@ -157,6 +172,9 @@ type Node struct {
 
 	watchdog          core.WatchdogTimer
 	historyRepository dblookupext.HistoryRepository
+
+	equivocationDetector spos.EquivocationDetectorHandler
+	slashingHistory      slashing.SlashingHistoryHandler
 }
 
 // ApplyOptions can set up different configurable options of a Node instance
@@ -176,6 +194,8 @@ func NewNode(opts ...Option) (*Node, error) {
 		ctx:                      context.Background(),
 		currentSendingGoRoutines: 0,
 		appStatusHandler:         statusHandler.NewNilStatusHandler(),
+		roundTracer:              consensusDebug.NewDisabledRoundTracer(),
+		consensusTypesRegistry:   sposFactory.NewDefaultConsensusTypesRegistry(),
 		queryHandlers:            make(map[string]debug.QueryHandler),
 	}
 	for _, opt := range opts {
@@ -258,26 +278,40 @@ func (n *Node) StartConsensus() error {
 		return err
 	}
 
-	consensusService, err := sposFactory.GetConsensusCoreFactory(n.consensusType)
+	consensusService, err := sposFactory.GetConsensusCoreFactory(n.consensusTypesRegistry, n.consensusType)
+	if err != nil {
+		return err
+	}
+
+	validatorDirectSender, err := n.createValidatorDirectSender()
 	if err != nil {
 		return err
 	}
 
 	broadcastMessenger, err := sposFactory.GetBroadcastMessenger(
+		n.consensusTypesRegistry,
+		n.consensusType,
 		n.internalMarshalizer,
 		n.hasher,
 		n.messenger,
 		n.shardCoordinator,
 		n.privKey,
 		n.peerSigHandler,
+		validatorDirectSender,
 		n.dataPool.Headers(),
 		n.interceptorsContainer,
+		n.roundTracer,
 	)
 
 	if err != nil {
 		return err
 	}
 
+	equivocationDetector, err := n.createEquivocationDetector()
+	if err != nil {
+		return err
+	}
+
 	netInputMarshalizer := n.internalMarshalizer
 	if n.sizeCheckDelta > 0 {
 		netInputMarshalizer = marshal.NewSizeCheckUnmarshalizer(n.internalMarshalizer, n.sizeCheckDelta)
@@ -303,6 +337,7 @@ func (n *Node) StartConsensus() error {
 		NetworkShardingCollector: n.networkShardingCollector,
 		AntifloodHandler:         n.inputAntifloodHandler,
 		PoolAdder:                n.dataPool.MiniBlocks(),
+		EquivocationDetector:     equivocationDetector,
 		SignatureSize:            n.signatureSize,
 		PublicKeySize:            n.publicKeySize,
 	}
@@ -323,6 +358,16 @@ func (n *Node) StartConsensus() error {
 		return err
 	}
 
+	err = n.createEquivocationProofTopic(equivocationDetector)
+	if err != nil {
+		return err
+	}
+
+	err = n.createValidatorDirectSendTopic(worker, consensusState)
+	if err != nil {
+		return err
+	}
+
 	consensusArgs := &spos.ConsensusCoreArgs{
 		BlockChain:                    n.blkc,
 		BlockProcessor:                n.blockProcessor,
@@ -343,6 +388,7 @@ func (n *Node) StartConsensus() error {
 		PeerHonestyHandler:            n.peerHonestyHandler,
 		HeaderSigVerifier:             n.headerSigVerifier,
 		FallbackHeaderValidator:       n.fallbackHeaderValidator,
+		RoundTracer:                   n.roundTracer,
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -353,6 +399,7 @@ func (n *Node) StartConsensus() error {
 	}
 
 	fct, err := sposFactory.GetSubroundsFactory(
+		n.consensusTypesRegistry,
 		consensusDataContainer,
 		consensusState,
 		worker,
@@ -361,6 +408,8 @@ func (n *Node) StartConsensus() error {
 		n.indexer,
 		n.chainID,
 		n.messenger.ID(),
+		n.forkDetector,
+		n.consensusPipeliningEnabled,
 	)
 	if err != nil {
 		return err
@@ -443,6 +492,174 @@ func (n *Node) GetValueForKey(address string, key string) (string, error) {
 	return hex.EncodeToString(valueBytes), nil
 }
 
+// GetESDTNFTToken returns the non-fungible or semi-fungible token with the given nonce held by an account
+func (n *Node) GetESDTNFTToken(address string, tokenName string, nonce uint64) (*builtInFunctions.ESDigitalToken, error) {
+	account, err := n.getAccountHandler(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+
+	tokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + tokenName)
+	tokenKey = builtInFunctions.ComputeESDTNFTTokenKey(tokenKey, nonce)
+	valueBytes, err := userAccount.DataTrieTracker().RetrieveValue(tokenKey)
+	if err != nil {
+		return nil, fmt.Errorf("fetching value error: %w", err)
+	}
+	if len(valueBytes) == 0 {
+		return nil, ErrNFTTokenNotFound
+	}
+
+	esdtToken := &builtInFunctions.ESDigitalToken{}
+	err = n.internalMarshalizer.Unmarshal(esdtToken, valueBytes)
+	if err != nil {
+		return nil, err
+	}
+	if esdtToken.TokenMetaData == nil {
+		return nil, ErrNFTTokenNotFound
+	}
+
+	return esdtToken, nil
+}
+
+// GetAllESDTTokens returns all the esdt tokens held by an account, mapped by their key in the account's data trie
+// without the esdt prefix. For non-fungible tokens the key also contains the nonce, as 8 bytes big endian
+func (n *Node) GetAllESDTTokens(address string) (map[string]*builtInFunctions.ESDigitalToken, error) {
+	account, err := n.getAccountHandler(address)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+
+	allESDTs := make(map[string]*builtInFunctions.ESDigitalToken)
+	if check.IfNil(userAccount.DataTrie()) {
+		return allESDTs, nil
+	}
+
+	leaves, err := userAccount.DataTrie().GetAllLeaves()
+	if err != nil {
+		return nil, err
+	}
+
+	esdtPrefix := core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier
+	for leafKey := range leaves {
+		if !strings.HasPrefix(leafKey, esdtPrefix) {
+			continue
+		}
+
+		valueBytes, errRetrieve := userAccount.DataTrieTracker().RetrieveValue([]byte(leafKey))
+		if errRetrieve != nil || len(valueBytes) == 0 {
+			continue
+		}
+
+		esdtToken := &builtInFunctions.ESDigitalToken{}
+		err = n.internalMarshalizer.Unmarshal(esdtToken, valueBytes)
+		if err != nil {
+			log.Debug("cannot unmarshal esdt token", "key", []byte(leafKey), "error", err)
+			continue
+		}
+
+		allESDTs[strings.TrimPrefix(leafKey, esdtPrefix)] = esdtToken
+	}
+
+	return allESDTs, nil
+}
+
+// GetAllIssuedESDTs returns all the esdt tokens issued through the esdt system smart contract, mapped by token name
+func (n *Node) GetAllIssuedESDTs() (map[string]*systemSmartContracts.ESDTData, error) {
+	esdtSCAccount, err := n.getESDTSystemSCAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	issuedESDTs := make(map[string]*systemSmartContracts.ESDTData)
+	if check.IfNil(esdtSCAccount.DataTrie()) {
+		return issuedESDTs, nil
+	}
+
+	leaves, err := esdtSCAccount.DataTrie().GetAllLeaves()
+	if err != nil {
+		return nil, err
+	}
+
+	for leafKey := range leaves {
+		if leafKey == systemSmartContracts.ESDTConfigKey {
+			continue
+		}
+
+		esdtData, errGet := n.getIssuedESDTFromAccount(esdtSCAccount, []byte(leafKey))
+		if errGet != nil {
+			log.Debug("cannot get issued esdt", "token", []byte(leafKey), "error", errGet)
+			continue
+		}
+
+		issuedESDTs[leafKey] = esdtData
+	}
+
+	return issuedESDTs, nil
+}
+
+// GetIssuedESDT returns the esdt token with the given name as stored by the esdt system smart contract
+func (n *Node) GetIssuedESDT(tokenName string) (*systemSmartContracts.ESDTData, error) {
+	if len(tokenName) == 0 || tokenName == systemSmartContracts.ESDTConfigKey {
+		return nil, ErrESDTTokenNotFound
+	}
+
+	esdtSCAccount, err := n.getESDTSystemSCAccount()
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getIssuedESDTFromAccount(esdtSCAccount, []byte(tokenName))
+}
+
+func (n *Node) getESDTSystemSCAccount() (state.UserAccountHandler, error) {
+	if check.IfNil(n.accounts) || check.IfNil(n.shardCoordinator) {
+		return nil, errors.New("initialize AccountsAdapter and ShardCoordinator first")
+	}
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, ErrMetachainOnlyOperation
+	}
+
+	account, err := n.accounts.GetExistingAccount(vm.ESDTSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := n.castAccountToUserAccount(account)
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+
+	return userAccount, nil
+}
+
+func (n *Node) getIssuedESDTFromAccount(esdtSCAccount state.UserAccountHandler, key []byte) (*systemSmartContracts.ESDTData, error) {
+	valueBytes, err := esdtSCAccount.DataTrieTracker().RetrieveValue(key)
+	if err != nil {
+		return nil, fmt.Errorf("fetching value error: %w", err)
+	}
+	if len(valueBytes) == 0 {
+		return nil, ErrESDTTokenNotFound
+	}
+
+	esdtData := &systemSmartContracts.ESDTData{}
+	err = n.internalMarshalizer.Unmarshal(esdtData, valueBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return esdtData, nil
+}
+
 func (n *Node) getAccountHandler(address string) (state.AccountHandler, error) {
 	if check.IfNil(n.addressPubkeyConverter) || check.IfNil(n.accounts) {
 		return nil, errors.New("initialize AccountsAdapter and PubkeyConverter first")
@@ -485,6 +702,11 @@ func (n *Node) createChronologyHandler(
 		return nil, err
 	}
 
+	err = chr.SetRoundTracer(n.roundTracer)
+	if err != nil {
+		return nil, err
+	}
+
 	return chr, nil
 }
 
@@ -703,6 +925,109 @@ func (n *Node) createConsensusTopic(messageProcessor p2p.MessageProcessor) error
 	return n.messenger.RegisterMessageProcessor(n.consensusTopic, messageProcessor)
 }
 
+func (n *Node) createEquivocationDetector() (spos.EquivocationDetectorHandler, error) {
+	if !check.IfNil(n.equivocationDetector) {
+		return n.equivocationDetector, nil
+	}
+
+	argsProofVerifier := headerCheck.ArgsEquivocationProofVerifier{
+		Marshalizer:       n.internalMarshalizer,
+		Hasher:            n.hasher,
+		NodesCoordinator:  n.nodesCoordinator,
+		SingleSigVerifier: n.singleSigner,
+		KeyGen:            n.keyGen,
+	}
+	proofVerifier, err := headerCheck.NewEquivocationProofVerifier(argsProofVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	argsEquivocationDetector := spos.ArgsEquivocationDetector{
+		Marshalizer:      n.internalMarshalizer,
+		NodesCoordinator: n.nodesCoordinator,
+		HeadersPool:      n.dataPool.Headers(),
+		ProofVerifier:    proofVerifier,
+		Messenger:        n.messenger,
+		AntifloodHandler: n.inputAntifloodHandler,
+	}
+
+	equivocationDetector, err := spos.NewEquivocationDetector(argsEquivocationDetector)
+	if err != nil {
+		return nil, err
+	}
+
+	return equivocationDetector, nil
+}
+
+func (n *Node) createEquivocationProofTopic(messageProcessor p2p.MessageProcessor) error {
+	if !n.messenger.HasTopic(core.EquivocationProofTopic) {
+		err := n.messenger.CreateTopic(core.EquivocationProofTopic, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if n.messenger.HasTopicValidator(core.EquivocationProofTopic) {
+		return ErrValidatorAlreadySet
+	}
+
+	return n.messenger.RegisterMessageProcessor(core.EquivocationProofTopic, messageProcessor)
+}
+
+func (n *Node) createValidatorDirectSender() (consensus.ValidatorDirectSender, error) {
+	if !n.validatorDirectSendEnabled {
+		return broadcast.NewDisabledValidatorDirectSender(), nil
+	}
+
+	argValidatorDirectSender := broadcast.ArgValidatorDirectSender{
+		Messenger:        n.messenger,
+		PeerIDProvider:   n.networkShardingCollector,
+		Marshalizer:      n.internalMarshalizer,
+		SingleSigner:     n.singleSigner,
+		PrivateKey:       n.privKey,
+		ShardCoordinator: n.shardCoordinator,
+	}
+
+	return broadcast.NewValidatorDirectSender(argValidatorDirectSender)
+}
+
+// createValidatorDirectSendTopic registers the processor of the consensus messages sent directly by the validators
+// of the self shard. The topic is used only for direct sending, so it has no broadcast channel
+func (n *Node) createValidatorDirectSendTopic(worker p2p.MessageProcessor, consensusState *spos.ConsensusState) error {
+	if !n.validatorDirectSendEnabled {
+		return nil
+	}
+
+	argsProcessor := spos.ArgsValidatorDirectMessageProcessor{
+		Marshalizer:      n.internalMarshalizer,
+		SingleSigner:     n.singleSigner,
+		KeyGen:           n.keyGen,
+		NodesCoordinator: n.nodesCoordinator,
+		ShardCoordinator: n.shardCoordinator,
+		AntifloodHandler: n.inputAntifloodHandler,
+		MessageProcessor: worker,
+		ConsensusState:   consensusState,
+	}
+	directMessageProcessor, err := spos.NewValidatorDirectMessageProcessor(argsProcessor)
+	if err != nil {
+		return err
+	}
+
+	topic := spos.GetValidatorDirectSendTopicID(n.shardCoordinator)
+	if !n.messenger.HasTopic(topic) {
+		err = n.messenger.CreateTopic(topic, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if n.messenger.HasTopicValidator(topic) {
+		return ErrValidatorAlreadySet
+	}
+
+	return n.messenger.RegisterMessageProcessor(topic, directMessageProcessor)
+}
+
 // SendBulkTransactions sends the provided transactions as a bulk, optimizing transfer between nodes
 func (n *Node) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	if len(txs) == 0 {
@@ -1054,6 +1379,8 @@ func (n *Node) StartHeartbeat(hbConfig config.HeartbeatConfig, versionNumber str
 		ValidatorStatistics:      n.validatorStatistics,
 		PeerSignatureHandler:     n.peerSigHandler,
 		PrivKey:                  n.privKey,
+		SingleSigner:             n.singleSigner,
+		KeyGen:                   n.keyGen,
 		HardforkTrigger:          n.hardforkTrigger,
 		AntifloodHandler:         n.inputAntifloodHandler,
 		ValidatorPubkeyConverter: n.validatorPubkeyConverter,
@@ -1087,6 +1414,24 @@ func (n *Node) GetHeartbeats() []heartbeatData.PubKeyHeartbeat {
 	return mon.GetHeartbeats()
 }
 
+// GetValidatorUptime returns the availability report of the provided validator public key between the provided epochs
+func (n *Node) GetValidatorUptime(pubKey string, fromEpoch uint32, toEpoch uint32) (*heartbeatData.UptimeReport, error) {
+	if check.IfNil(n.heartbeatHandler) {
+		return nil, ErrHeartbeatNotActive
+	}
+	mon := n.heartbeatHandler.Monitor()
+	if check.IfNil(mon) {
+		return nil, ErrHeartbeatNotActive
+	}
+
+	pubKeyBytes, err := n.validatorPubkeyConverter.Decode(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return mon.GetUptimeReport(pubKeyBytes, fromEpoch, toEpoch)
+}
+
 // ValidatorStatisticsApi will return the statistics for all the validators from the initial nodes pub keys
 func (n *Node) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error) {
 	return n.validatorsProvider.GetLatestValidators(), nil
@@ -1205,15 +1550,192 @@ func (n *Node) createPidInfo(p core.PeerID) core.QueryP2PPeerInfo {
 
 	peerInfo := n.networkShardingCollector.GetPeerInfo(p)
 	result.PeerType = peerInfo.PeerType.String()
-	if len(peerInfo.PkBytes) == 0 {
-		result.Pk = ""
-	} else {
-		result.Pk = n.validatorPubkeyConverter.Encode(peerInfo.PkBytes)
-	}
+	result.Pk = n.encodeValidatorPubkey(peerInfo.PkBytes)
 
 	return result
 }
 
+// GetP2PTopology returns the connection graph of the node, with the connected peers grouped by their shard and type
+func (n *Node) GetP2PTopology() (*core.QueryP2PTopology, error) {
+	selfPid := n.messenger.ID()
+	selfInfo := n.networkShardingCollector.GetPeerInfo(selfPid)
+	topology := &core.QueryP2PTopology{
+		SelfPid:              selfPid.Pretty(),
+		SelfPk:               n.encodeValidatorPubkey(selfInfo.PkBytes),
+		SelfShardID:          selfInfo.ShardID,
+		SelfPeerType:         selfInfo.PeerType.String(),
+		IntraShardValidators: make([]core.QueryP2PTopologyPeer, 0),
+		IntraShardObservers:  make([]core.QueryP2PTopologyPeer, 0),
+		CrossShardValidators: make([]core.QueryP2PTopologyPeer, 0),
+		CrossShardObservers:  make([]core.QueryP2PTopologyPeer, 0),
+		UnknownPeers:         make([]core.QueryP2PTopologyPeer, 0),
+	}
+
+	connections := n.messenger.GetConnectionsInfo()
+	sort.Slice(connections, func(i, j int) bool {
+		return connections[i].Pid.Pretty() < connections[j].Pid.Pretty()
+	})
+
+	for _, conn := range connections {
+		peerInfo := n.networkShardingCollector.GetPeerInfo(conn.Pid)
+		topologyPeer := core.QueryP2PTopologyPeer{
+			Pid:         conn.Pid.Pretty(),
+			Pk:          n.encodeValidatorPubkey(peerInfo.PkBytes),
+			ShardID:     peerInfo.ShardID,
+			PeerType:    peerInfo.PeerType.String(),
+			Address:     conn.Address,
+			Direction:   conn.Direction,
+			LatencyInMs: conn.Latency.Milliseconds(),
+		}
+
+		isIntraShard := peerInfo.ShardID == selfInfo.ShardID
+		switch {
+		case peerInfo.PeerType == core.ValidatorPeer && isIntraShard:
+			topology.IntraShardValidators = append(topology.IntraShardValidators, topologyPeer)
+		case peerInfo.PeerType == core.ValidatorPeer:
+			topology.CrossShardValidators = append(topology.CrossShardValidators, topologyPeer)
+		case peerInfo.PeerType == core.ObserverPeer && isIntraShard:
+			topology.IntraShardObservers = append(topology.IntraShardObservers, topologyPeer)
+		case peerInfo.PeerType == core.ObserverPeer:
+			topology.CrossShardObservers = append(topology.CrossShardObservers, topologyPeer)
+		default:
+			topology.UnknownPeers = append(topology.UnknownPeers, topologyPeer)
+		}
+	}
+
+	return topology, nil
+}
+
+func (n *Node) encodeValidatorPubkey(pkBytes []byte) string {
+	if len(pkBytes) == 0 {
+		return ""
+	}
+
+	return n.validatorPubkeyConverter.Encode(pkBytes)
+}
+
+// GetPeersBlacklist returns the persisted blacklisting decisions of the peer IDs and public keys
+func (n *Node) GetPeersBlacklist() ([]core.PeerReputationInfo, error) {
+	if check.IfNil(n.peerReputationStore) {
+		return nil, ErrNilPeerReputationStore
+	}
+
+	records := n.peerReputationStore.Records()
+	infos := make([]core.PeerReputationInfo, 0, len(records))
+	for _, record := range records {
+		infos = append(infos, core.PeerReputationInfo{
+			Type:            record.Type,
+			Identifier:      n.encodeReputationIdentifier(record.Type, record.Identifier),
+			Score:           record.Score,
+			Reason:          record.Reason,
+			ExpiryTimestamp: time.Unix(0, record.ExpiryTimestamp).Unix(),
+		})
+	}
+
+	return infos, nil
+}
+
+// BanPeer will blacklist the provided peer ID or public key for the provided duration
+func (n *Node) BanPeer(recordType string, identifier string, duration time.Duration, reason string) error {
+	if check.IfNil(n.peerReputationStore) {
+		return ErrNilPeerReputationStore
+	}
+
+	identifierBytes, err := n.decodeReputationIdentifier(recordType, identifier)
+	if err != nil {
+		return err
+	}
+
+	return n.peerReputationStore.Ban(recordType, identifierBytes, duration, reason)
+}
+
+// UnbanPeer will remove the provided peer ID or public key from the blacklist
+func (n *Node) UnbanPeer(recordType string, identifier string) error {
+	if check.IfNil(n.peerReputationStore) {
+		return ErrNilPeerReputationStore
+	}
+
+	identifierBytes, err := n.decodeReputationIdentifier(recordType, identifier)
+	if err != nil {
+		return err
+	}
+
+	return n.peerReputationStore.Unban(recordType, identifierBytes)
+}
+
+// GetSlashingHistory returns the slashing records applied by the metachain
+func (n *Node) GetSlashingHistory() ([]*slashing.SlashingRecordApiResponse, error) {
+	if check.IfNil(n.shardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if n.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, ErrMetachainOnlyOperation
+	}
+	if check.IfNil(n.slashingHistory) {
+		return nil, ErrNilSlashingHistory
+	}
+
+	records := n.slashingHistory.GetRecords()
+	response := make([]*slashing.SlashingRecordApiResponse, 0, len(records))
+	for _, record := range records {
+		slashValue := "0"
+		if record.SlashValue != nil {
+			slashValue = record.SlashValue.String()
+		}
+
+		response = append(response, &slashing.SlashingRecordApiResponse{
+			EvidenceID:     hex.EncodeToString(record.EvidenceID),
+			PublicKey:      n.encodeValidatorPubkey(record.PublicKey),
+			Reason:         record.Reason,
+			ShardID:        record.ShardID,
+			Round:          record.Round,
+			SlashValue:     slashValue,
+			MetaEpoch:      record.MetaEpoch,
+			MetaNonce:      record.MetaNonce,
+			MetaHeaderHash: hex.EncodeToString(record.MetaHeaderHash),
+		})
+	}
+
+	return response, nil
+}
+
+// GetAntifloodLimits returns the antiflood limits currently in use
+func (n *Node) GetAntifloodLimits() config.AntifloodLimitsOverrideConfig {
+	if check.IfNil(n.antifloodLimitsHandler) {
+		return config.AntifloodLimitsOverrideConfig{}
+	}
+
+	return n.antifloodLimitsHandler.Limits()
+}
+
+// SetAntifloodLimits replaces the antiflood limits. The source of the change is recorded in the audit log
+func (n *Node) SetAntifloodLimits(limits config.AntifloodLimitsOverrideConfig, source string) error {
+	if check.IfNil(n.antifloodLimitsHandler) {
+		return ErrNilAntifloodLimitsHandler
+	}
+
+	return n.antifloodLimitsHandler.SetLimits(limits, source)
+}
+
+func (n *Node) encodeReputationIdentifier(recordType string, identifier []byte) string {
+	if recordType == blackList.PublicKeyRecordType {
+		return n.validatorPubkeyConverter.Encode(identifier)
+	}
+
+	return core.PeerID(identifier).Pretty()
+}
+
+func (n *Node) decodeReputationIdentifier(recordType string, identifier string) ([]byte, error) {
+	switch recordType {
+	case blackList.PeerIDRecordType:
+		return base58.Decode(identifier)
+	case blackList.PublicKeyRecordType:
+		return n.validatorPubkeyConverter.Decode(identifier)
+	default:
+		return nil, fmt.Errorf("%w: %s", process.ErrInvalidReputationRecordType, recordType)
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (n *Node) IsInterfaceNil() bool {
 	return n == nil
@@ -10,3 +10,6 @@ var ErrNilInterceptorContainer = errors.New("nil interceptor container")
 
 // ErrNilResolverContainer signals that a nil resolver container has been provided
 var ErrNilResolverContainer = errors.New("nil resolver container")
+
+// ErrNilPrometheusMetricsRegistry signals that a nil prometheus metrics registry has been provided
+var ErrNilPrometheusMetricsRegistry = errors.New("nil prometheus metrics registry")
@@ -1,9 +1,19 @@
 package nodeDebugFactory
 
-import "github.com/ElrondNetwork/elrond-go/debug"
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/debug"
+)
 
 // NodeWrapper is the interface that defines the behavior of a Node that can work with debug handlers
 type NodeWrapper interface {
 	AddQueryHandler(name string, handler debug.QueryHandler) error
 	IsInterfaceNil() bool
 }
+
+// PrometheusMetricsRegistry is the interface that defines the behavior of a component able to export the metrics of
+// the registered prometheus metrics providers
+type PrometheusMetricsRegistry interface {
+	AddPrometheusMetricsProvider(provider core.PrometheusMetricsProvider) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,43 @@
+package nodeDebugFactory
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug/factory"
+)
+
+// ConsensusRoundTracer is the constant string for the consensus round tracer
+const ConsensusRoundTracer = "consensus round tracer"
+
+// CreateRoundTracer creates a consensus round tracer, registers it as a query handler on the node and as a prometheus
+// metrics provider on the registry
+func CreateRoundTracer(
+	node NodeWrapper,
+	registry PrometheusMetricsRegistry,
+	config config.ConsensusDebugConfig,
+) (consensus.RoundTracer, error) {
+	if check.IfNil(node) {
+		return nil, ErrNilNodeWrapper
+	}
+	if check.IfNil(registry) {
+		return nil, ErrNilPrometheusMetricsRegistry
+	}
+
+	roundTracer, err := factory.NewRoundTracerFactory(config)
+	if err != nil {
+		return nil, err
+	}
+
+	err = registry.AddPrometheusMetricsProvider(roundTracer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = node.AddQueryHandler(ConsensusRoundTracer, roundTracer)
+	if err != nil {
+		return nil, err
+	}
+
+	return roundTracer, nil
+}
@@ -0,0 +1,104 @@
+package nodeDebugFactory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/debug"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateRoundTracer_NilNodeWrapperShouldErr(t *testing.T) {
+	t.Parallel()
+
+	roundTracer, err := CreateRoundTracer(
+		nil,
+		&mock.StatusMetricsStub{},
+		config.ConsensusDebugConfig{},
+	)
+
+	assert.True(t, check.IfNil(roundTracer))
+	assert.Equal(t, ErrNilNodeWrapper, err)
+}
+
+func TestCreateRoundTracer_NilRegistryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	roundTracer, err := CreateRoundTracer(
+		&mock.NodeWrapperStub{},
+		nil,
+		config.ConsensusDebugConfig{},
+	)
+
+	assert.True(t, check.IfNil(roundTracer))
+	assert.Equal(t, ErrNilPrometheusMetricsRegistry, err)
+}
+
+func TestCreateRoundTracer_InvalidConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	roundTracer, err := CreateRoundTracer(
+		&mock.NodeWrapperStub{},
+		&mock.StatusMetricsStub{},
+		config.ConsensusDebugConfig{
+			Enabled:         true,
+			NumRoundsToKeep: 0,
+		},
+	)
+
+	assert.True(t, check.IfNil(roundTracer))
+	assert.True(t, errors.Is(err, debug.ErrInvalidValue))
+}
+
+func TestCreateRoundTracer_AddQueryHandlerErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	roundTracer, err := CreateRoundTracer(
+		&mock.NodeWrapperStub{
+			AddQueryHandlerCalled: func(name string, handler debug.QueryHandler) error {
+				return expectedErr
+			},
+		},
+		&mock.StatusMetricsStub{},
+		config.ConsensusDebugConfig{},
+	)
+
+	assert.True(t, check.IfNil(roundTracer))
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestCreateRoundTracer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	addQueryHandlerCalled := false
+	addProviderCalled := false
+	roundTracer, err := CreateRoundTracer(
+		&mock.NodeWrapperStub{
+			AddQueryHandlerCalled: func(name string, handler debug.QueryHandler) error {
+				addQueryHandlerCalled = true
+				assert.Equal(t, ConsensusRoundTracer, name)
+				return nil
+			},
+		},
+		&mock.StatusMetricsStub{
+			AddPrometheusMetricsProviderCalled: func(provider core.PrometheusMetricsProvider) error {
+				addProviderCalled = true
+				return nil
+			},
+		},
+		config.ConsensusDebugConfig{
+			Enabled:         true,
+			NumRoundsToKeep: 10,
+		},
+	)
+
+	assert.False(t, check.IfNil(roundTracer))
+	assert.Nil(t, err)
+	assert.True(t, addQueryHandlerCalled)
+	assert.True(t, addProviderCalled)
+}
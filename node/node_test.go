@@ -14,6 +14,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
@@ -33,9 +34,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -214,6 +221,204 @@ func TestGetUsername(t *testing.T) {
 	assert.Equal(t, string(expectedUsername), username)
 }
 
+func TestNode_GetESDTNFTToken(t *testing.T) {
+	tokenName := "token"
+	nonce := uint64(3)
+	esdtToken := &builtInFunctions.ESDigitalToken{
+		Value: big.NewInt(5),
+		TokenMetaData: &builtInFunctions.MetaData{
+			Nonce: nonce,
+			Name:  []byte("name"),
+		},
+	}
+	marshalizer := getMarshalizer()
+	marshaledToken, _ := marshalizer.Marshal(esdtToken)
+	tokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + tokenName)
+	tokenKey = builtInFunctions.ComputeESDTNFTTokenKey(tokenKey, nonce)
+
+	accDB := &mock.AccountsStub{}
+	accDB.GetExistingAccountCalled = func(address []byte) (handler state.AccountHandler, e error) {
+		acc, _ := state.NewUserAccount(address)
+		acc.DataTrieTracker().SaveKeyValue(tokenKey, marshaledToken)
+
+		return acc, nil
+	}
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(marshalizer, testSizeCheckDelta),
+		node.WithVmMarshalizer(marshalizer),
+		node.WithHasher(getHasher()),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accDB),
+	)
+
+	recovToken, err := n.GetESDTNFTToken(createDummyHexAddress(64), tokenName, nonce)
+	assert.Nil(t, err)
+	assert.Equal(t, esdtToken.Value, recovToken.Value)
+	assert.Equal(t, esdtToken.TokenMetaData.Name, recovToken.TokenMetaData.Name)
+
+	recovToken, err = n.GetESDTNFTToken(createDummyHexAddress(64), tokenName, nonce+1)
+	assert.Nil(t, recovToken)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetAllESDTTokens(t *testing.T) {
+	fungibleToken := &builtInFunctions.ESDigitalToken{Value: big.NewInt(10)}
+	nftToken := &builtInFunctions.ESDigitalToken{
+		Value:         big.NewInt(1),
+		TokenMetaData: &builtInFunctions.MetaData{Nonce: 2},
+	}
+	marshalizer := getMarshalizer()
+	marshaledFungible, _ := marshalizer.Marshal(fungibleToken)
+	marshaledNFT, _ := marshalizer.Marshal(nftToken)
+	esdtPrefix := core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier
+	fungibleKey := esdtPrefix + "fungible"
+	nftKey := esdtPrefix + "nft" + string(big.NewInt(2).Bytes())
+
+	accDB := &mock.AccountsStub{}
+	accDB.GetExistingAccountCalled = func(address []byte) (handler state.AccountHandler, e error) {
+		acc, _ := state.NewUserAccount(address)
+		acc.SetDataTrie(&mock.TrieStub{
+			GetAllLeavesCalled: func() (map[string][]byte, error) {
+				return map[string][]byte{
+					fungibleKey:    nil,
+					nftKey:         nil,
+					"otherSCValue": nil,
+				}, nil
+			},
+		})
+		acc.DataTrieTracker().SaveKeyValue([]byte(fungibleKey), marshaledFungible)
+		acc.DataTrieTracker().SaveKeyValue([]byte(nftKey), marshaledNFT)
+
+		return acc, nil
+	}
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(marshalizer, testSizeCheckDelta),
+		node.WithVmMarshalizer(marshalizer),
+		node.WithHasher(getHasher()),
+		node.WithAddressPubkeyConverter(createMockPubkeyConverter()),
+		node.WithAccountsAdapter(accDB),
+	)
+
+	allESDTs, err := n.GetAllESDTTokens(createDummyHexAddress(64))
+	require.Nil(t, err)
+	require.Equal(t, 2, len(allESDTs))
+	assert.Equal(t, fungibleToken.Value, allESDTs["fungible"].Value)
+	assert.Equal(t, uint64(2), allESDTs["nft"+string(big.NewInt(2).Bytes())].TokenMetaData.Nonce)
+}
+
+func TestNode_GetIssuedESDTOnShardShouldErr(t *testing.T) {
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(getMarshalizer(), testSizeCheckDelta),
+		node.WithAccountsAdapter(&mock.AccountsStub{}),
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+	)
+
+	esdtData, err := n.GetIssuedESDT("token")
+	assert.Nil(t, esdtData)
+	assert.Equal(t, node.ErrMetachainOnlyOperation, err)
+
+	allESDTs, err := n.GetAllIssuedESDTs()
+	assert.Nil(t, allESDTs)
+	assert.Equal(t, node.ErrMetachainOnlyOperation, err)
+}
+
+func TestNode_GetAllIssuedESDTsShouldSkipConfig(t *testing.T) {
+	marshalizer := getMarshalizer()
+	token := &systemSmartContracts.ESDTData{
+		OwnerAddress: []byte("owner"),
+		TokenName:    []byte("TOKEN"),
+		MintedValue:  big.NewInt(100),
+		BurntValue:   big.NewInt(0),
+	}
+	marshaledToken, _ := marshalizer.Marshal(token)
+	marshaledConfig, _ := marshalizer.Marshal(&systemSmartContracts.ESDTConfig{BaseIssuingCost: big.NewInt(5)})
+
+	accDB := &mock.AccountsStub{}
+	accDB.GetExistingAccountCalled = func(address []byte) (handler state.AccountHandler, e error) {
+		assert.Equal(t, vm.ESDTSCAddress, address)
+		acc, _ := state.NewUserAccount(address)
+		acc.SetDataTrie(&mock.TrieStub{
+			GetAllLeavesCalled: func() (map[string][]byte, error) {
+				return map[string][]byte{
+					"TOKEN":                            nil,
+					systemSmartContracts.ESDTConfigKey: nil,
+				}, nil
+			},
+		})
+		acc.DataTrieTracker().SaveKeyValue([]byte("TOKEN"), marshaledToken)
+		acc.DataTrieTracker().SaveKeyValue([]byte(systemSmartContracts.ESDTConfigKey), marshaledConfig)
+
+		return acc, nil
+	}
+	n, _ := node.NewNode(
+		node.WithInternalMarshalizer(marshalizer, testSizeCheckDelta),
+		node.WithAccountsAdapter(accDB),
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}),
+	)
+
+	allESDTs, err := n.GetAllIssuedESDTs()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(allESDTs))
+	assert.Equal(t, token.MintedValue, allESDTs["TOKEN"].MintedValue)
+
+	esdtData, err := n.GetIssuedESDT("TOKEN")
+	require.Nil(t, err)
+	assert.Equal(t, token.OwnerAddress, esdtData.OwnerAddress)
+
+	esdtData, err = n.GetIssuedESDT(systemSmartContracts.ESDTConfigKey)
+	assert.Nil(t, esdtData)
+	assert.Equal(t, node.ErrESDTTokenNotFound, err)
+}
+
+func TestNode_GetSlashingHistoryOnShardShouldErr(t *testing.T) {
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(mock.NewOneShardCoordinatorMock()),
+	)
+
+	records, err := n.GetSlashingHistory()
+	assert.Nil(t, records)
+	assert.Equal(t, node.ErrMetachainOnlyOperation, err)
+}
+
+func TestNode_GetSlashingHistoryShouldWork(t *testing.T) {
+	slashingHistory, _ := slashing.NewSlashingHistory(slashing.ArgsSlashingHistory{
+		Storer:      genericmocks.NewStorerMock("slashing", 0),
+		Marshalizer: getMarshalizer(),
+	})
+	_ = slashingHistory.Add(&slashing.SlashingRecord{
+		EvidenceID:     []byte("evidence"),
+		PublicKey:      []byte("pubKey"),
+		Reason:         slashing.EquivocationReason,
+		ShardID:        1,
+		Round:          37,
+		SlashValue:     big.NewInt(1000),
+		MetaEpoch:      2,
+		MetaNonce:      120,
+		MetaHeaderHash: []byte("meta hash"),
+	})
+
+	n, _ := node.NewNode(
+		node.WithShardCoordinator(&mock.ShardCoordinatorMock{SelfShardId: core.MetachainShardId}),
+		node.WithValidatorPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+		node.WithSlashingHistory(slashingHistory),
+	)
+
+	records, err := n.GetSlashingHistory()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, &slashing.SlashingRecordApiResponse{
+		EvidenceID:     hex.EncodeToString([]byte("evidence")),
+		PublicKey:      hex.EncodeToString([]byte("pubKey")),
+		Reason:         slashing.EquivocationReason,
+		ShardID:        1,
+		Round:          37,
+		SlashValue:     "1000",
+		MetaEpoch:      2,
+		MetaNonce:      120,
+		MetaHeaderHash: hex.EncodeToString([]byte("meta hash")),
+	}, records[0])
+}
+
 //------- GenerateTransaction
 
 func TestGenerateTransaction_NoAddrConverterShouldError(t *testing.T) {
@@ -2158,3 +2363,184 @@ func TestNode_ShouldWork(t *testing.T) {
 
 	assert.Equal(t, expected, vals)
 }
+
+func TestNode_GetP2PTopologyShouldGroupPeers(t *testing.T) {
+	t.Parallel()
+
+	selfPid := core.PeerID("self")
+	peersInfo := map[core.PeerID]core.P2PPeerInfo{
+		selfPid:      {PeerType: core.ValidatorPeer, ShardID: 0, PkBytes: []byte("pkSelf")},
+		"valIntra":   {PeerType: core.ValidatorPeer, ShardID: 0, PkBytes: []byte("pkValIntra")},
+		"valCross":   {PeerType: core.ValidatorPeer, ShardID: 1, PkBytes: []byte("pkValCross")},
+		"obsIntra":   {PeerType: core.ObserverPeer, ShardID: 0},
+		"obsCross":   {PeerType: core.ObserverPeer, ShardID: core.MetachainShardId},
+		"unknownPid": {PeerType: core.UnknownPeer},
+	}
+	connections := []core.P2PConnectionInfo{
+		{Pid: "valIntra", Address: "addr1", Direction: p2p.ConnectionDirectionOutbound, Latency: time.Millisecond * 30},
+		{Pid: "valCross", Address: "addr2", Direction: p2p.ConnectionDirectionInbound},
+		{Pid: "obsIntra", Address: "addr3", Direction: p2p.ConnectionDirectionInbound},
+		{Pid: "obsCross", Address: "addr4", Direction: p2p.ConnectionDirectionOutbound},
+		{Pid: "unknownPid", Address: "addr5", Direction: p2p.ConnectionDirectionUnknown},
+	}
+	n, _ := node.NewNode(
+		node.WithMessenger(&mock.MessengerStub{
+			IDCalled: func() core.PeerID {
+				return selfPid
+			},
+			GetConnectionsInfoCalled: func() []core.P2PConnectionInfo {
+				return connections
+			},
+		}),
+		node.WithNetworkShardingCollector(&mock.NetworkShardingCollectorStub{
+			GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+				return peersInfo[pid]
+			},
+		}),
+		node.WithValidatorPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+	)
+
+	topology, err := n.GetP2PTopology()
+	require.Nil(t, err)
+
+	assert.Equal(t, selfPid.Pretty(), topology.SelfPid)
+	assert.Equal(t, hex.EncodeToString([]byte("pkSelf")), topology.SelfPk)
+	assert.Equal(t, core.ValidatorPeer.String(), topology.SelfPeerType)
+	expectedIntraValidators := []core.QueryP2PTopologyPeer{
+		{
+			Pid:         core.PeerID("valIntra").Pretty(),
+			Pk:          hex.EncodeToString([]byte("pkValIntra")),
+			ShardID:     0,
+			PeerType:    core.ValidatorPeer.String(),
+			Address:     "addr1",
+			Direction:   p2p.ConnectionDirectionOutbound,
+			LatencyInMs: 30,
+		},
+	}
+	assert.Equal(t, expectedIntraValidators, topology.IntraShardValidators)
+	require.Equal(t, 1, len(topology.CrossShardValidators))
+	assert.Equal(t, uint32(1), topology.CrossShardValidators[0].ShardID)
+	require.Equal(t, 1, len(topology.IntraShardObservers))
+	assert.Equal(t, "", topology.IntraShardObservers[0].Pk)
+	require.Equal(t, 1, len(topology.CrossShardObservers))
+	assert.Equal(t, core.MetachainShardId, topology.CrossShardObservers[0].ShardID)
+	require.Equal(t, 1, len(topology.UnknownPeers))
+	assert.Equal(t, p2p.ConnectionDirectionUnknown, topology.UnknownPeers[0].Direction)
+}
+
+func createPeerReputationStore() node.PeerReputationStore {
+	store, _ := blackList.NewPeerReputationStore(blackList.ArgPeerReputationStore{
+		Storer:      genericmocks.NewStorerMock("", 0),
+		Marshalizer: &mock.MarshalizerMock{},
+	})
+
+	return store
+}
+
+func TestNode_PeersBlacklistWithoutStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	vals, err := n.GetPeersBlacklist()
+	assert.Nil(t, vals)
+	assert.Equal(t, node.ErrNilPeerReputationStore, err)
+
+	err = n.BanPeer(blackList.PeerIDRecordType, "pid", time.Minute, "")
+	assert.Equal(t, node.ErrNilPeerReputationStore, err)
+
+	err = n.UnbanPeer(blackList.PeerIDRecordType, "pid")
+	assert.Equal(t, node.ErrNilPeerReputationStore, err)
+}
+
+func TestNode_BanPeerInvalidTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(
+		node.WithPeerReputationStore(createPeerReputationStore()),
+	)
+
+	err := n.BanPeer("invalid", "pid", time.Minute, "")
+	assert.True(t, errors.Is(err, process.ErrInvalidReputationRecordType))
+}
+
+func TestNode_BanAndUnbanPeersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pid := core.PeerID("pid")
+	pk := []byte("pk")
+	n, _ := node.NewNode(
+		node.WithPeerReputationStore(createPeerReputationStore()),
+		node.WithValidatorPubkeyConverter(mock.NewPubkeyConverterMock(32)),
+	)
+
+	err := n.BanPeer(blackList.PeerIDRecordType, pid.Pretty(), time.Minute, "manual")
+	require.Nil(t, err)
+	err = n.BanPeer(blackList.PublicKeyRecordType, hex.EncodeToString(pk), time.Minute, "manual")
+	require.Nil(t, err)
+
+	vals, err := n.GetPeersBlacklist()
+	require.Nil(t, err)
+	require.Equal(t, 2, len(vals))
+	assert.Equal(t, blackList.PeerIDRecordType, vals[0].Type)
+	assert.Equal(t, pid.Pretty(), vals[0].Identifier)
+	assert.Equal(t, "manual", vals[0].Reason)
+	assert.Equal(t, blackList.PublicKeyRecordType, vals[1].Type)
+	assert.Equal(t, hex.EncodeToString(pk), vals[1].Identifier)
+
+	err = n.UnbanPeer(blackList.PeerIDRecordType, pid.Pretty())
+	require.Nil(t, err)
+
+	vals, _ = n.GetPeersBlacklist()
+	require.Equal(t, 1, len(vals))
+	assert.Equal(t, blackList.PublicKeyRecordType, vals[0].Type)
+}
+
+func TestNode_AntifloodLimitsWithoutHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	assert.Equal(t, config.AntifloodLimitsOverrideConfig{}, n.GetAntifloodLimits())
+	err := n.SetAntifloodLimits(config.AntifloodLimitsOverrideConfig{}, "source")
+	assert.Equal(t, node.ErrNilAntifloodLimitsHandler, err)
+}
+
+func TestNode_GetValidatorUptimeWithoutHeartbeatShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode()
+
+	report, err := n.GetValidatorUptime("pk", 0, 1)
+	assert.Nil(t, report)
+	assert.Equal(t, node.ErrHeartbeatNotActive, err)
+}
+
+func TestNode_AntifloodLimitsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	limits := config.AntifloodLimitsOverrideConfig{
+		Topic: config.TopicAntifloodConfig{
+			DefaultMaxMessagesPerSec: 10,
+		},
+	}
+	setCalled := false
+	n, _ := node.NewNode(
+		node.WithAntifloodLimitsHandler(&mock.AntifloodLimitsHandlerStub{
+			LimitsCalled: func() config.AntifloodLimitsOverrideConfig {
+				return limits
+			},
+			SetLimitsCalled: func(providedLimits config.AntifloodLimitsOverrideConfig, source string) error {
+				setCalled = true
+				assert.Equal(t, limits, providedLimits)
+				assert.Equal(t, "source", source)
+				return nil
+			},
+		}),
+	)
+
+	assert.Equal(t, limits, n.GetAntifloodLimits())
+	err := n.SetAntifloodLimits(limits, "source")
+	assert.Nil(t, err)
+	assert.True(t, setCalled)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/core/dblookupext"
@@ -22,6 +23,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/ntp"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
@@ -380,6 +382,22 @@ func WithConsensusType(consensusType string) Option {
 	}
 }
 
+// WithConsensusPipelining sets up the consensus pipelining option for the Node
+func WithConsensusPipelining(pipeliningEnabled bool) Option {
+	return func(n *Node) error {
+		n.consensusPipeliningEnabled = pipeliningEnabled
+		return nil
+	}
+}
+
+// WithValidatorDirectSend sets up the option of sending the consensus signatures directly to the leader
+func WithValidatorDirectSend(validatorDirectSendEnabled bool) Option {
+	return func(n *Node) error {
+		n.validatorDirectSendEnabled = validatorDirectSendEnabled
+		return nil
+	}
+}
+
 // WithBootstrapRoundIndex sets up a bootstrapRoundIndex option for the Node
 func WithBootstrapRoundIndex(bootstrapRoundIndex uint64) Option {
 	return func(n *Node) error {
@@ -422,6 +440,50 @@ func WithAppStatusHandler(aph core.AppStatusHandler) Option {
 	}
 }
 
+// WithConsensusTypesRegistry sets up the registry holding the consensus types the Node is able to run
+func WithConsensusTypesRegistry(registry sposFactory.ConsensusTypesRegistry) Option {
+	return func(n *Node) error {
+		if check.IfNil(registry) {
+			return ErrNilConsensusTypesRegistry
+		}
+		n.consensusTypesRegistry = registry
+		return nil
+	}
+}
+
+// WithRoundTracer sets up a round tracer for the Node
+func WithRoundTracer(roundTracer consensus.RoundTracer) Option {
+	return func(n *Node) error {
+		if check.IfNil(roundTracer) {
+			return ErrNilRoundTracer
+		}
+		n.roundTracer = roundTracer
+		return nil
+	}
+}
+
+// WithPeerReputationStore sets up the persisted peers blacklist store for the Node
+func WithPeerReputationStore(peerReputationStore PeerReputationStore) Option {
+	return func(n *Node) error {
+		if check.IfNil(peerReputationStore) {
+			return ErrNilPeerReputationStore
+		}
+		n.peerReputationStore = peerReputationStore
+		return nil
+	}
+}
+
+// WithAntifloodLimitsHandler sets up the component able to change the antiflood limits at runtime for the Node
+func WithAntifloodLimitsHandler(antifloodLimitsHandler process.AntifloodLimitsHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(antifloodLimitsHandler) {
+			return ErrNilAntifloodLimitsHandler
+		}
+		n.antifloodLimitsHandler = antifloodLimitsHandler
+		return nil
+	}
+}
+
 // WithIndexer sets up a indexer for the Node
 func WithIndexer(indexer indexer.Indexer) Option {
 	return func(n *Node) error {
@@ -734,3 +796,25 @@ func WithHistoryRepository(historyRepo dblookupext.HistoryRepository) Option {
 		return nil
 	}
 }
+
+// WithEquivocationDetector sets up the equivocation detector used by the consensus of the Node
+func WithEquivocationDetector(equivocationDetector spos.EquivocationDetectorHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(equivocationDetector) {
+			return ErrNilEquivocationDetector
+		}
+		n.equivocationDetector = equivocationDetector
+		return nil
+	}
+}
+
+// WithSlashingHistory sets up the slashing history of a metachain Node
+func WithSlashingHistory(slashingHistory slashing.SlashingHistoryHandler) Option {
+	return func(n *Node) error {
+		if check.IfNil(slashingHistory) {
+			return ErrNilSlashingHistory
+		}
+		n.slashingHistory = slashingHistory
+		return nil
+	}
+}
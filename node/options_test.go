@@ -5,11 +5,16 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
 	"github.com/ElrondNetwork/elrond-go/data/blockchain"
 	"github.com/ElrondNetwork/elrond-go/data/endProcess"
+	consensusDebug "github.com/ElrondNetwork/elrond-go/debug/consensus"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process/slashing"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -819,6 +824,30 @@ func TestWithConsensusBls_ShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithConsensusPipelining_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithConsensusPipelining(true)
+	err := opt(node)
+
+	assert.True(t, node.consensusPipeliningEnabled)
+	assert.Nil(t, err)
+}
+
+func TestWithValidatorDirectSend_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithValidatorDirectSend(true)
+	err := opt(node)
+
+	assert.True(t, node.validatorDirectSendEnabled)
+	assert.Nil(t, err)
+}
+
 func TestWithAppStatusHandler_NilAshShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -842,6 +871,105 @@ func TestWithAppStatusHandler_OkAshShouldPass(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWithConsensusTypesRegistry_NilRegistryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithConsensusTypesRegistry(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilConsensusTypesRegistry, err)
+}
+
+func TestWithConsensusTypesRegistry_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	registry := sposFactory.NewConsensusTypesRegistry()
+	opt := WithConsensusTypesRegistry(registry)
+	err := opt(node)
+
+	assert.True(t, node.consensusTypesRegistry == registry)
+	assert.Nil(t, err)
+}
+
+func TestWithRoundTracer_NilRoundTracerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithRoundTracer(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilRoundTracer, err)
+}
+
+func TestWithRoundTracer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	roundTracer := consensusDebug.NewDisabledRoundTracer()
+	opt := WithRoundTracer(roundTracer)
+	err := opt(node)
+
+	assert.True(t, node.roundTracer == roundTracer)
+	assert.Nil(t, err)
+}
+
+func TestWithPeerReputationStore_NilStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithPeerReputationStore(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilPeerReputationStore, err)
+}
+
+func TestWithPeerReputationStore_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	store, _ := blackList.NewPeerReputationStore(blackList.ArgPeerReputationStore{
+		Storer:      genericmocks.NewStorerMock("", 0),
+		Marshalizer: &mock.MarshalizerMock{},
+	})
+	opt := WithPeerReputationStore(store)
+	err := opt(node)
+
+	assert.True(t, node.peerReputationStore == store)
+	assert.Nil(t, err)
+}
+
+func TestWithAntifloodLimitsHandler_NilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithAntifloodLimitsHandler(nil)
+	err := opt(node)
+
+	assert.Equal(t, ErrNilAntifloodLimitsHandler, err)
+}
+
+func TestWithAntifloodLimitsHandler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	handler := &mock.AntifloodLimitsHandlerStub{}
+	opt := WithAntifloodLimitsHandler(handler)
+	err := opt(node)
+
+	assert.True(t, node.antifloodLimitsHandler == handler)
+	assert.Nil(t, err)
+}
+
 func TestWithIndexer_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1425,3 +1553,43 @@ func TestWithPeerSignatureHandler_OkPeerSignatureHandlerShouldWork(t *testing.T)
 	assert.Equal(t, peerSigHandler, node.peerSigHandler)
 	assert.Nil(t, err)
 }
+
+func TestWithEquivocationDetector_NilEquivocationDetectorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithEquivocationDetector(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.equivocationDetector)
+	assert.Equal(t, ErrNilEquivocationDetector, err)
+}
+
+func TestWithSlashingHistory_NilSlashingHistoryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	opt := WithSlashingHistory(nil)
+	err := opt(node)
+
+	assert.Nil(t, node.slashingHistory)
+	assert.Equal(t, ErrNilSlashingHistory, err)
+}
+
+func TestWithSlashingHistory_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	node, _ := NewNode()
+
+	slashingHistory, _ := slashing.NewSlashingHistory(slashing.ArgsSlashingHistory{
+		Storer:      genericmocks.NewStorerMock("slashing", 0),
+		Marshalizer: &mock.MarshalizerMock{},
+	})
+	opt := WithSlashingHistory(slashingHistory)
+	err := opt(node)
+
+	assert.Equal(t, slashingHistory, node.slashingHistory)
+	assert.Nil(t, err)
+}
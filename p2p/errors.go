@@ -94,6 +94,9 @@ var ErrEndPortIsSmallerThanStartPort = errors.New("ending port value is smaller
 // ErrNoFreePortInRange signals that no free port was found from provided range
 var ErrNoFreePortInRange = errors.New("no free port in range")
 
+// ErrPortAlreadyUsedByAnotherTransport signals that the same port was chosen for two transports
+var ErrPortAlreadyUsedByAnotherTransport = errors.New("port already used by another transport")
+
 // ErrNilSharder signals that the provided sharder is nil
 var ErrNilSharder = errors.New("nil sharder")
 
@@ -1,7 +1,11 @@
 package discovery
 
 import (
+	"context"
 	"time"
+
+	"github.com/libp2p/go-libp2p-core/host"
+	libp2pDiscovery "github.com/libp2p/go-libp2p/p2p/discovery"
 )
 
 const KadDhtName = kadDhtName
@@ -23,3 +27,17 @@ func (ckdd *ContinuousKadDhtDiscoverer) StopDHT() error {
 
 	return err
 }
+
+//------- MdnsDiscoverer
+
+const MdnsName = mdnsName
+
+func (md *MdnsDiscoverer) SetCreateServiceFunc(
+	handler func(ctx context.Context, peerhost host.Host, interval time.Duration, serviceTag string) (libp2pDiscovery.Service, error),
+) {
+	md.createServiceFunc = handler
+}
+
+//------- StaticPeersDiscoverer
+
+const StaticPeersName = staticPeersName
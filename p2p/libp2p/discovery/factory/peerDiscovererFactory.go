@@ -18,11 +18,21 @@ func NewPeerDiscoverer(
 	sharder p2p.CommonSharder,
 	p2pConfig config.P2PConfig,
 ) (p2p.PeerDiscoverer, error) {
-	if p2pConfig.KadDhtPeerDiscovery.Enabled {
-		return createKadDhtPeerDiscoverer(context, host, sharder, p2pConfig)
-	}
+	switch p2pConfig.Discovery.Type {
+	case "", p2p.KadDhtDiscovery:
+		if p2pConfig.KadDhtPeerDiscovery.Enabled {
+			return createKadDhtPeerDiscoverer(context, host, sharder, p2pConfig)
+		}
 
-	return discovery.NewNilDiscoverer(), nil
+		return discovery.NewNilDiscoverer(), nil
+	case p2p.MdnsDiscovery:
+		return createMdnsPeerDiscoverer(context, host, p2pConfig)
+	case p2p.StaticPeersDiscovery:
+		return createStaticPeersDiscoverer(context, host, p2pConfig)
+	default:
+		return nil, fmt.Errorf("%w unable to select peer discoverer: unknown discovery type '%s'",
+			p2p.ErrInvalidValue, p2pConfig.Discovery.Type)
+	}
 }
 
 func createKadDhtPeerDiscoverer(
@@ -50,3 +60,34 @@ func createKadDhtPeerDiscoverer(
 			"selected sharder: unknown sharder '%s'", p2p.ErrInvalidValue, p2pConfig.Sharding.Type)
 	}
 }
+
+func createMdnsPeerDiscoverer(
+	context context.Context,
+	host discovery.ConnectableHost,
+	p2pConfig config.P2PConfig,
+) (p2p.PeerDiscoverer, error) {
+	arg := discovery.ArgMdns{
+		Context:    context,
+		Host:       host,
+		Interval:   time.Second * time.Duration(p2pConfig.Discovery.Mdns.IntervalInSec),
+		ServiceTag: p2pConfig.Discovery.Mdns.ServiceTag,
+	}
+
+	return discovery.NewMdnsDiscoverer(arg)
+}
+
+func createStaticPeersDiscoverer(
+	context context.Context,
+	host discovery.ConnectableHost,
+	p2pConfig config.P2PConfig,
+) (p2p.PeerDiscoverer, error) {
+	arg := discovery.ArgStaticPeers{
+		Context:             context,
+		Host:                host,
+		PeersList:           p2pConfig.Discovery.StaticPeers.PeerList,
+		MinReconnectBackoff: time.Second * time.Duration(p2pConfig.Discovery.StaticPeers.MinReconnectBackoffInSec),
+		MaxReconnectBackoff: time.Second * time.Duration(p2pConfig.Discovery.StaticPeers.MaxReconnectBackoffInSec),
+	}
+
+	return discovery.NewStaticPeersDiscoverer(arg)
+}
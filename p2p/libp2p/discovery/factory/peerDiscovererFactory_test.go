@@ -85,3 +85,77 @@ func TestNewPeerDiscoverer_UnknownShouldErr(t *testing.T) {
 	assert.True(t, check.IfNil(pDiscoverer))
 	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
 }
+
+func TestNewPeerDiscoverer_MdnsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	p2pConfig := config.P2PConfig{
+		Discovery: config.PeerDiscoveryConfig{
+			Type: p2p.MdnsDiscovery,
+			Mdns: config.MdnsDiscoveryConfig{
+				IntervalInSec: 5,
+				ServiceTag:    "_erd-discovery._udp",
+			},
+		},
+	}
+
+	pDiscoverer, err := factory.NewPeerDiscoverer(
+		context.Background(),
+		&mock.ConnectableHostStub{},
+		&mock.SharderStub{},
+		p2pConfig,
+	)
+	_, ok := pDiscoverer.(*discovery.MdnsDiscoverer)
+
+	assert.True(t, ok)
+	assert.Nil(t, err)
+}
+
+func TestNewPeerDiscoverer_StaticPeersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	p2pConfig := config.P2PConfig{
+		Discovery: config.PeerDiscoveryConfig{
+			Type: p2p.StaticPeersDiscovery,
+			StaticPeers: config.StaticPeersDiscoveryConfig{
+				PeerList:                 []string{"/ip4/127.0.0.1/tcp/9999/p2p/16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk"},
+				MinReconnectBackoffInSec: 1,
+				MaxReconnectBackoffInSec: 60,
+			},
+		},
+	}
+
+	pDiscoverer, err := factory.NewPeerDiscoverer(
+		context.Background(),
+		&mock.ConnectableHostStub{},
+		&mock.SharderStub{},
+		p2pConfig,
+	)
+	_, ok := pDiscoverer.(*discovery.StaticPeersDiscoverer)
+
+	assert.True(t, ok)
+	assert.Nil(t, err)
+}
+
+func TestNewPeerDiscoverer_UnknownDiscoveryTypeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	p2pConfig := config.P2PConfig{
+		KadDhtPeerDiscovery: config.KadDhtPeerDiscoveryConfig{
+			Enabled: true,
+		},
+		Discovery: config.PeerDiscoveryConfig{
+			Type: "unknown",
+		},
+	}
+
+	pDiscoverer, err := factory.NewPeerDiscoverer(
+		context.Background(),
+		&mock.ConnectableHostStub{},
+		&mock.SharderStub{},
+		p2pConfig,
+	)
+
+	assert.True(t, check.IfNil(pDiscoverer))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
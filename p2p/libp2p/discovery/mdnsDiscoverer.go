@@ -0,0 +1,140 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	libp2pDiscovery "github.com/libp2p/go-libp2p/p2p/discovery"
+)
+
+var _ p2p.PeerDiscoverer = (*MdnsDiscoverer)(nil)
+var _ p2p.Reconnecter = (*MdnsDiscoverer)(nil)
+
+const mdnsName = "mdns discovery"
+const connectTimeout = time.Second * 10
+
+// ArgMdns represents the mDNS discoverer config argument DTO
+type ArgMdns struct {
+	Context    context.Context
+	Host       ConnectableHost
+	Interval   time.Duration
+	ServiceTag string
+}
+
+// MdnsDiscoverer is the peer discovery type that finds and connects to the peers running in the same local
+// network, by using the multicast DNS protocol. It is aimed for private local networks as it does not need a seeder.
+type MdnsDiscoverer struct {
+	host              ConnectableHost
+	context           context.Context
+	interval          time.Duration
+	serviceTag        string
+	mutService        sync.Mutex
+	service           libp2pDiscovery.Service
+	createServiceFunc func(ctx context.Context, peerhost host.Host, interval time.Duration, serviceTag string) (libp2pDiscovery.Service, error)
+}
+
+// NewMdnsDiscoverer creates a new mDNS discovery type implementation
+func NewMdnsDiscoverer(arg ArgMdns) (*MdnsDiscoverer, error) {
+	if check.IfNilReflect(arg.Context) {
+		return nil, p2p.ErrNilContext
+	}
+	if check.IfNilReflect(arg.Host) {
+		return nil, p2p.ErrNilHost
+	}
+	if arg.Interval < time.Second {
+		return nil, fmt.Errorf("%w, Interval should have been at least 1 second", p2p.ErrInvalidValue)
+	}
+	if len(arg.ServiceTag) == 0 {
+		return nil, fmt.Errorf("%w, empty ServiceTag", p2p.ErrInvalidValue)
+	}
+
+	return &MdnsDiscoverer{
+		host:              arg.Host,
+		context:           arg.Context,
+		interval:          arg.Interval,
+		serviceTag:        arg.ServiceTag,
+		createServiceFunc: libp2pDiscovery.NewMdnsService,
+	}, nil
+}
+
+// Bootstrap will start the mDNS service that advertises this host and periodically queries the local network
+func (md *MdnsDiscoverer) Bootstrap() error {
+	md.mutService.Lock()
+	defer md.mutService.Unlock()
+
+	if md.service != nil {
+		return p2p.ErrPeerDiscoveryProcessAlreadyStarted
+	}
+
+	service, err := md.createServiceFunc(md.context, md.host, md.interval, md.serviceTag)
+	if err != nil {
+		return err
+	}
+
+	service.RegisterNotifee(md)
+	md.service = service
+
+	go func() {
+		<-md.context.Done()
+		log.Debug("closing the mdns discovery process")
+		errClose := service.Close()
+		log.LogIfError(errClose)
+	}()
+
+	return nil
+}
+
+// HandlePeerFound is called by the mDNS service each time a peer is found in the local network
+func (md *MdnsDiscoverer) HandlePeerFound(pi peer.AddrInfo) {
+	if pi.ID == md.host.ID() {
+		return
+	}
+	if md.host.Network().Connectedness(pi.ID) == network.Connected {
+		return
+	}
+
+	go md.connect(pi)
+}
+
+func (md *MdnsDiscoverer) connect(pi peer.AddrInfo) {
+	ctx, cancel := context.WithTimeout(md.context, connectTimeout)
+	defer cancel()
+
+	err := md.host.Connect(ctx, pi)
+	if err != nil {
+		log.Trace("mdns discovery: can not connect to peer",
+			"pid", core.PeerID(pi.ID).Pretty(),
+			"error", err.Error(),
+		)
+		return
+	}
+
+	log.Debug("mdns discovery: connected to peer", "pid", core.PeerID(pi.ID).Pretty())
+}
+
+// Name returns the name of the mDNS peer discovery implementation
+func (md *MdnsDiscoverer) Name() string {
+	return mdnsName
+}
+
+// ReconnectToNetwork returns a channel that is signaled right away, as the mDNS service periodically
+// queries the local network and reconnects to the peers found by itself
+func (md *MdnsDiscoverer) ReconnectToNetwork() <-chan struct{} {
+	ch := make(chan struct{}, 1)
+	ch <- struct{}{}
+
+	return ch
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (md *MdnsDiscoverer) IsInterfaceNil() bool {
+	return md == nil
+}
@@ -0,0 +1,158 @@
+package discovery_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	libp2pDiscovery "github.com/libp2p/go-libp2p/p2p/discovery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestArgMdns() discovery.ArgMdns {
+	return discovery.ArgMdns{
+		Context:    context.Background(),
+		Host:       &mock.ConnectableHostStub{},
+		Interval:   time.Second,
+		ServiceTag: "_erd-discovery._udp",
+	}
+}
+
+func TestNewMdnsDiscoverer_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createTestArgMdns()
+	arg.Context = nil
+	md, err := discovery.NewMdnsDiscoverer(arg)
+	assert.True(t, check.IfNil(md))
+	assert.Equal(t, p2p.ErrNilContext, err)
+
+	arg = createTestArgMdns()
+	arg.Host = nil
+	md, err = discovery.NewMdnsDiscoverer(arg)
+	assert.True(t, check.IfNil(md))
+	assert.Equal(t, p2p.ErrNilHost, err)
+
+	arg = createTestArgMdns()
+	arg.Interval = time.Millisecond
+	md, err = discovery.NewMdnsDiscoverer(arg)
+	assert.True(t, check.IfNil(md))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+
+	arg = createTestArgMdns()
+	arg.ServiceTag = ""
+	md, err = discovery.NewMdnsDiscoverer(arg)
+	assert.True(t, check.IfNil(md))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestNewMdnsDiscoverer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	md, err := discovery.NewMdnsDiscoverer(createTestArgMdns())
+	assert.False(t, check.IfNil(md))
+	assert.Nil(t, err)
+	assert.Equal(t, discovery.MdnsName, md.Name())
+	assert.Equal(t, 1, len(md.ReconnectToNetwork()))
+}
+
+func TestMdnsDiscoverer_BootstrapShouldRegisterAndCloseOnContextDone(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	arg := createTestArgMdns()
+	arg.Context = ctx
+	md, _ := discovery.NewMdnsDiscoverer(arg)
+
+	var registeredNotifee libp2pDiscovery.Notifee
+	closed := int32(0)
+	md.SetCreateServiceFunc(func(_ context.Context, _ host.Host, _ time.Duration, _ string) (libp2pDiscovery.Service, error) {
+		return &mock.MdnsServiceStub{
+			RegisterNotifeeCalled: func(notifee libp2pDiscovery.Notifee) {
+				registeredNotifee = notifee
+			},
+			CloseCalled: func() error {
+				atomic.StoreInt32(&closed, 1)
+				return nil
+			},
+		}, nil
+	})
+
+	err := md.Bootstrap()
+	require.Nil(t, err)
+	assert.Equal(t, md, registeredNotifee)
+
+	err = md.Bootstrap()
+	assert.Equal(t, p2p.ErrPeerDiscoveryProcessAlreadyStarted, err)
+
+	cancel()
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&closed))
+}
+
+func TestMdnsDiscoverer_BootstrapServiceErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	md, _ := discovery.NewMdnsDiscoverer(createTestArgMdns())
+	md.SetCreateServiceFunc(func(_ context.Context, _ host.Host, _ time.Duration, _ string) (libp2pDiscovery.Service, error) {
+		return nil, expectedErr
+	})
+
+	err := md.Bootstrap()
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestMdnsDiscoverer_HandlePeerFoundShouldConnectOnlyToNewPeers(t *testing.T) {
+	t.Parallel()
+
+	selfPid := peer.ID("self")
+	connectedPid := peer.ID("connected")
+	newPid := peer.ID("new")
+	chConnected := make(chan peer.ID, 3)
+	arg := createTestArgMdns()
+	arg.Host = &mock.ConnectableHostStub{
+		IDCalled: func() peer.ID {
+			return selfPid
+		},
+		NetworkCalled: func() network.Network {
+			return &mock.NetworkStub{
+				ConnectednessCalled: func(pid peer.ID) network.Connectedness {
+					if pid == connectedPid {
+						return network.Connected
+					}
+
+					return network.NotConnected
+				},
+			}
+		},
+		ConnectCalled: func(_ context.Context, pi peer.AddrInfo) error {
+			chConnected <- pi.ID
+			return nil
+		},
+	}
+	md, _ := discovery.NewMdnsDiscoverer(arg)
+
+	md.HandlePeerFound(peer.AddrInfo{ID: selfPid})
+	md.HandlePeerFound(peer.AddrInfo{ID: connectedPid})
+	md.HandlePeerFound(peer.AddrInfo{ID: newPid})
+
+	select {
+	case pid := <-chConnected:
+		assert.Equal(t, newPid, pid)
+	case <-time.After(time.Second):
+		assert.Fail(t, "timeout while waiting for the connection")
+	}
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, 0, len(chConnected))
+}
@@ -0,0 +1,192 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+var _ p2p.PeerDiscoverer = (*StaticPeersDiscoverer)(nil)
+var _ p2p.Reconnecter = (*StaticPeersDiscoverer)(nil)
+
+const staticPeersName = "static peers discovery"
+
+// ArgStaticPeers represents the static peers discoverer config argument DTO
+type ArgStaticPeers struct {
+	Context             context.Context
+	Host                ConnectableHost
+	PeersList           []string
+	MinReconnectBackoff time.Duration
+	MaxReconnectBackoff time.Duration
+}
+
+// StaticPeersDiscoverer is the peer discovery type that keeps this host connected to a fixed list of peers.
+// Each failed connection attempt to a peer doubles the time until the next attempt, up to the maximum backoff
+type StaticPeersDiscoverer struct {
+	host                ConnectableHost
+	context             context.Context
+	peers               []peer.AddrInfo
+	minReconnectBackoff time.Duration
+	maxReconnectBackoff time.Duration
+	mutStarted          sync.Mutex
+	started             bool
+}
+
+// NewStaticPeersDiscoverer creates a new static peers discovery type implementation
+func NewStaticPeersDiscoverer(arg ArgStaticPeers) (*StaticPeersDiscoverer, error) {
+	if check.IfNilReflect(arg.Context) {
+		return nil, p2p.ErrNilContext
+	}
+	if check.IfNilReflect(arg.Host) {
+		return nil, p2p.ErrNilHost
+	}
+	if arg.MinReconnectBackoff <= 0 {
+		return nil, fmt.Errorf("%w, MinReconnectBackoff should have been greater than 0", p2p.ErrInvalidValue)
+	}
+	if arg.MaxReconnectBackoff < arg.MinReconnectBackoff {
+		return nil, fmt.Errorf("%w, MaxReconnectBackoff should have been at least MinReconnectBackoff", p2p.ErrInvalidValue)
+	}
+	peers, err := parsePeersList(arg.PeersList)
+	if err != nil {
+		return nil, err
+	}
+	if len(peers) == 0 {
+		log.Warn("nil or empty peers list provided to the static peers discoverer. " +
+			"No connection will be done")
+	}
+
+	return &StaticPeersDiscoverer{
+		host:                arg.Host,
+		context:             arg.Context,
+		peers:               peers,
+		minReconnectBackoff: arg.MinReconnectBackoff,
+		maxReconnectBackoff: arg.MaxReconnectBackoff,
+	}, nil
+}
+
+func parsePeersList(peersList []string) ([]peer.AddrInfo, error) {
+	addresses := make([]multiaddr.Multiaddr, 0, len(peersList))
+	for _, address := range peersList {
+		multiAddress, err := multiaddr.NewMultiaddr(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w for static peer address %s: %s", p2p.ErrInvalidValue, address, err.Error())
+		}
+
+		addresses = append(addresses, multiAddress)
+	}
+
+	peers, err := peer.AddrInfosFromP2pAddrs(addresses...)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the static peers list: %s", p2p.ErrInvalidValue, err.Error())
+	}
+
+	return peers, nil
+}
+
+// Bootstrap will start a go routine for each static peer that will keep the connection to that peer alive
+func (spd *StaticPeersDiscoverer) Bootstrap() error {
+	spd.mutStarted.Lock()
+	defer spd.mutStarted.Unlock()
+
+	if spd.started {
+		return p2p.ErrPeerDiscoveryProcessAlreadyStarted
+	}
+
+	for _, pi := range spd.peers {
+		go spd.keepConnected(pi)
+	}
+	spd.started = true
+
+	return nil
+}
+
+func (spd *StaticPeersDiscoverer) keepConnected(pi peer.AddrInfo) {
+	backoff := spd.minReconnectBackoff
+	for {
+		timeToWait := spd.minReconnectBackoff
+		if !spd.isConnected(pi) {
+			err := spd.connect(pi)
+			if err != nil {
+				log.Debug("static peers discovery: can not connect to peer",
+					"pid", core.PeerID(pi.ID).Pretty(),
+					"next attempt in", backoff,
+					"error", err.Error(),
+				)
+				timeToWait = backoff
+				backoff = spd.nextBackoff(backoff)
+			} else {
+				backoff = spd.minReconnectBackoff
+			}
+		}
+
+		select {
+		case <-time.After(timeToWait):
+		case <-spd.context.Done():
+			log.Debug("closing the static peers discovery process", "pid", core.PeerID(pi.ID).Pretty())
+			return
+		}
+	}
+}
+
+func (spd *StaticPeersDiscoverer) nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > spd.maxReconnectBackoff {
+		return spd.maxReconnectBackoff
+	}
+
+	return backoff
+}
+
+func (spd *StaticPeersDiscoverer) isConnected(pi peer.AddrInfo) bool {
+	return spd.host.Network().Connectedness(pi.ID) == network.Connected
+}
+
+func (spd *StaticPeersDiscoverer) connect(pi peer.AddrInfo) error {
+	ctx, cancel := context.WithTimeout(spd.context, connectTimeout)
+	defer cancel()
+
+	return spd.host.Connect(ctx, pi)
+}
+
+// Name returns the name of the static peers discovery implementation
+func (spd *StaticPeersDiscoverer) Name() string {
+	return staticPeersName
+}
+
+// ReconnectToNetwork will try once to connect to all the static peers that are not connected
+func (spd *StaticPeersDiscoverer) ReconnectToNetwork() <-chan struct{} {
+	ch := make(chan struct{}, 1)
+
+	go func() {
+		for _, pi := range spd.peers {
+			if spd.isConnected(pi) {
+				continue
+			}
+
+			err := spd.connect(pi)
+			if err != nil {
+				log.Trace("static peers discovery: reconnect to network",
+					"pid", core.PeerID(pi.ID).Pretty(),
+					"error", err.Error(),
+				)
+			}
+		}
+
+		ch <- struct{}{}
+	}()
+
+	return ch
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (spd *StaticPeersDiscoverer) IsInterfaceNil() bool {
+	return spd == nil
+}
@@ -0,0 +1,174 @@
+package discovery_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/discovery"
+	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const staticPeerAddress = "/ip4/127.0.0.1/tcp/9999/p2p/16Uiu2HAkw5SNNtSvH1zJiQ6Gc3WoGNSxiyNueRKe6fuAuh57G3Bk"
+
+func createTestArgStaticPeers() discovery.ArgStaticPeers {
+	return discovery.ArgStaticPeers{
+		Context:             context.Background(),
+		Host:                &mock.ConnectableHostStub{},
+		PeersList:           []string{staticPeerAddress},
+		MinReconnectBackoff: time.Second,
+		MaxReconnectBackoff: time.Minute,
+	}
+}
+
+func TestNewStaticPeersDiscoverer_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createTestArgStaticPeers()
+	arg.Context = nil
+	spd, err := discovery.NewStaticPeersDiscoverer(arg)
+	assert.True(t, check.IfNil(spd))
+	assert.Equal(t, p2p.ErrNilContext, err)
+
+	arg = createTestArgStaticPeers()
+	arg.Host = nil
+	spd, err = discovery.NewStaticPeersDiscoverer(arg)
+	assert.True(t, check.IfNil(spd))
+	assert.Equal(t, p2p.ErrNilHost, err)
+
+	arg = createTestArgStaticPeers()
+	arg.MinReconnectBackoff = 0
+	spd, err = discovery.NewStaticPeersDiscoverer(arg)
+	assert.True(t, check.IfNil(spd))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+
+	arg = createTestArgStaticPeers()
+	arg.MaxReconnectBackoff = arg.MinReconnectBackoff - 1
+	spd, err = discovery.NewStaticPeersDiscoverer(arg)
+	assert.True(t, check.IfNil(spd))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+
+	arg = createTestArgStaticPeers()
+	arg.PeersList = []string{"invalid address"}
+	spd, err = discovery.NewStaticPeersDiscoverer(arg)
+	assert.True(t, check.IfNil(spd))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+
+	arg = createTestArgStaticPeers()
+	arg.PeersList = []string{"/ip4/127.0.0.1/tcp/9999"}
+	spd, err = discovery.NewStaticPeersDiscoverer(arg)
+	assert.True(t, check.IfNil(spd))
+	assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+}
+
+func TestNewStaticPeersDiscoverer_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	spd, err := discovery.NewStaticPeersDiscoverer(createTestArgStaticPeers())
+	assert.False(t, check.IfNil(spd))
+	assert.Nil(t, err)
+	assert.Equal(t, discovery.StaticPeersName, spd.Name())
+}
+
+func TestStaticPeersDiscoverer_BootstrapCalledTwiceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	arg := createTestArgStaticPeers()
+	arg.Context = ctx
+	spd, _ := discovery.NewStaticPeersDiscoverer(arg)
+
+	err := spd.Bootstrap()
+	assert.Nil(t, err)
+
+	err = spd.Bootstrap()
+	assert.Equal(t, p2p.ErrPeerDiscoveryProcessAlreadyStarted, err)
+}
+
+func TestStaticPeersDiscoverer_BootstrapShouldRetryWithIncreasingBackoff(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mutAttempts := sync.Mutex{}
+	attempts := make([]time.Time, 0)
+	arg := createTestArgStaticPeers()
+	arg.Context = ctx
+	arg.MinReconnectBackoff = time.Millisecond * 100
+	arg.MaxReconnectBackoff = time.Millisecond * 400
+	arg.Host = &mock.ConnectableHostStub{
+		ConnectCalled: func(_ context.Context, _ peer.AddrInfo) error {
+			mutAttempts.Lock()
+			attempts = append(attempts, time.Now())
+			mutAttempts.Unlock()
+
+			return errors.New("unreachable")
+		},
+	}
+	spd, _ := discovery.NewStaticPeersDiscoverer(arg)
+
+	err := spd.Bootstrap()
+	require.Nil(t, err)
+	time.Sleep(time.Millisecond * 1650)
+	cancel()
+
+	mutAttempts.Lock()
+	defer mutAttempts.Unlock()
+
+	// attempts at about 0, 100, 300, 700, 1100, 1500 ms
+	require.True(t, len(attempts) >= 5)
+	assert.True(t, len(attempts) <= 7)
+	assert.True(t, attempts[2].Sub(attempts[1]) > attempts[1].Sub(attempts[0]))
+	assert.True(t, attempts[4].Sub(attempts[3]) >= arg.MaxReconnectBackoff)
+}
+
+func TestStaticPeersDiscoverer_ReconnectToNetworkShouldConnectOnlyToDisconnectedPeers(t *testing.T) {
+	t.Parallel()
+
+	connectedAddress := "/ip4/127.0.0.1/tcp/10000/p2p/16Uiu2HAm6yvbp1oZ6zjnWsn9FdRqBSaQkbhELyaThuq48ybdorrr"
+	connectedMultiaddr, err := multiaddr.NewMultiaddr(connectedAddress)
+	require.Nil(t, err)
+	connectedPid, err := peer.AddrInfoFromP2pAddr(connectedMultiaddr)
+	require.Nil(t, err)
+	numConnectCalls := 0
+	arg := createTestArgStaticPeers()
+	arg.PeersList = []string{staticPeerAddress, connectedAddress}
+	arg.Host = &mock.ConnectableHostStub{
+		NetworkCalled: func() network.Network {
+			return &mock.NetworkStub{
+				ConnectednessCalled: func(pid peer.ID) network.Connectedness {
+					if pid == connectedPid.ID {
+						return network.Connected
+					}
+
+					return network.NotConnected
+				},
+			}
+		},
+		ConnectCalled: func(_ context.Context, pi peer.AddrInfo) error {
+			numConnectCalls++
+			assert.NotEqual(t, connectedPid.ID, pi.ID)
+			return nil
+		},
+	}
+	spd, _ := discovery.NewStaticPeersDiscoverer(arg)
+
+	select {
+	case <-spd.ReconnectToNetwork():
+	case <-time.After(time.Second):
+		assert.Fail(t, "timeout while waiting for the reconnection")
+	}
+	assert.Equal(t, 1, numConnectCalls)
+}
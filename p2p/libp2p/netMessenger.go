@@ -43,6 +43,9 @@ const ListenAddrWithIp4AndTcp = "/ip4/0.0.0.0/tcp/"
 // ListenLocalhostAddrWithIp4AndTcp defines the local host listening ip v.4 address and TCP
 const ListenLocalhostAddrWithIp4AndTcp = "/ip4/127.0.0.1/tcp/"
 
+// webSocketAddressSuffix is appended to a TCP listen address in order to use the WebSocket transport
+const webSocketAddressSuffix = "/ws"
+
 // DirectSendID represents the protocol ID for sending and receiving direct P2P messages
 const DirectSendID = protocol.ID("/erd/directsend/1.0.0")
 
@@ -123,14 +126,13 @@ func NewNetworkMessenger(args ArgsNetworkMessenger) (*networkMessenger, error) {
 		return nil, err
 	}
 
-	port, err := getPort(args.P2pConfig.Node.Port, checkFreePort)
+	addresses, err := createListenAddresses(args.ListenAddress, args.P2pConfig.Node)
 	if err != nil {
 		return nil, err
 	}
 
-	address := fmt.Sprintf(args.ListenAddress+"%d", port)
 	opts := []libp2p.Option{
-		libp2p.ListenAddrStrings(address),
+		libp2p.ListenAddrStrings(addresses...),
 		libp2p.Identity(p2pPrivKey),
 		libp2p.DefaultMuxers,
 		libp2p.DefaultSecurity,
@@ -596,7 +598,8 @@ func (netMes *networkMessenger) ConnectedPeers() []core.PeerID {
 	return peerList
 }
 
-// ConnectedAddresses returns all connected peer's addresses
+// ConnectedAddresses returns all connected peer's addresses. The remote multiaddress of each connection is used,
+// so the transport can be identified from it: /ip4/<ip>/tcp/<port> for TCP and /ip4/<ip>/tcp/<port>/ws for WebSocket
 func (netMes *networkMessenger) ConnectedAddresses() []string {
 	h := netMes.p2pHost
 	conns := make([]string, 0)
@@ -1114,6 +1117,41 @@ func (netMes *networkMessenger) GetConnectedPeersInfo() *p2p.ConnectedPeersInfo
 	return connPeerInfo
 }
 
+// GetConnectionsInfo returns the details of the connections towards the connected peers
+func (netMes *networkMessenger) GetConnectionsInfo() []core.P2PConnectionInfo {
+	h := netMes.p2pHost
+	peers := h.Network().Peers()
+	connections := make([]core.P2PConnectionInfo, 0, len(peers))
+	for _, p := range peers {
+		connInfo := core.P2PConnectionInfo{
+			Pid:       core.PeerID(p),
+			Direction: p2p.ConnectionDirectionUnknown,
+			Latency:   h.Peerstore().LatencyEWMA(p),
+		}
+
+		conns := h.Network().ConnsToPeer(p)
+		if len(conns) > 0 {
+			connInfo.Address = conns[0].RemoteMultiaddr().String()
+			connInfo.Direction = convertDirection(conns[0].Stat().Direction)
+		}
+
+		connections = append(connections, connInfo)
+	}
+
+	return connections
+}
+
+func convertDirection(direction network.Direction) string {
+	switch direction {
+	case network.DirInbound:
+		return p2p.ConnectionDirectionInbound
+	case network.DirOutbound:
+		return p2p.ConnectionDirectionOutbound
+	default:
+		return p2p.ConnectionDirectionUnknown
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (netMes *networkMessenger) IsInterfaceNil() bool {
 	return netMes == nil
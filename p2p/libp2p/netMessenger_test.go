@@ -26,6 +26,7 @@ import (
 	"github.com/libp2p/go-libp2p-pubsub/pb"
 	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var timeoutWaitResponses = time.Second * 2
@@ -246,6 +247,26 @@ func TestLibp2pMessenger_IsConnectedShouldWork(t *testing.T) {
 	_ = mes2.Close()
 }
 
+func TestLibp2pMessenger_GetConnectionsInfoShouldWork(t *testing.T) {
+	_, mes1, mes2 := createMockNetworkOf2()
+
+	_ = mes1.ConnectToPeer(mes2.Addresses()[0])
+
+	connections1 := mes1.GetConnectionsInfo()
+	require.Equal(t, 1, len(connections1))
+	assert.Equal(t, mes2.ID(), connections1[0].Pid)
+	assert.Equal(t, p2p.ConnectionDirectionOutbound, connections1[0].Direction)
+	assert.NotEmpty(t, connections1[0].Address)
+
+	connections2 := mes2.GetConnectionsInfo()
+	require.Equal(t, 1, len(connections2))
+	assert.Equal(t, mes1.ID(), connections2[0].Pid)
+	assert.Equal(t, p2p.ConnectionDirectionInbound, connections2[0].Direction)
+
+	_ = mes1.Close()
+	_ = mes2.Close()
+}
+
 func TestLibp2pMessenger_CreateTopicOkValsShouldWork(t *testing.T) {
 	mes := createMockMessenger()
 
@@ -6,10 +6,62 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core/random"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
+// createListenAddresses returns the listen addresses of all the enabled transports. The TCP transport is always
+// enabled, the other transports are opened on their own port as the WebSocket transport uses TCP as well
+func createListenAddresses(listenAddress string, nodeConfig config.NodeConfig) ([]string, error) {
+	usedPorts := make(map[int]struct{})
+	checkPortNotUsed := func(port int) error {
+		_, isUsed := usedPorts[port]
+		if isUsed {
+			return fmt.Errorf("%w, port %d", p2p.ErrPortAlreadyUsedByAnotherTransport, port)
+		}
+
+		return checkFreePort(port)
+	}
+	markPortAsUsed := func(port int) error {
+		if port == 0 {
+			return nil
+		}
+		_, isUsed := usedPorts[port]
+		if isUsed {
+			return fmt.Errorf("%w, port %d", p2p.ErrPortAlreadyUsedByAnotherTransport, port)
+		}
+
+		usedPorts[port] = struct{}{}
+		return nil
+	}
+
+	tcpPort, err := getPort(nodeConfig.Port, checkPortNotUsed)
+	if err != nil {
+		return nil, err
+	}
+	err = markPortAsUsed(tcpPort)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := []string{fmt.Sprintf(listenAddress+"%d", tcpPort)}
+	if !nodeConfig.Transports.WebSocket.Enabled {
+		return addresses, nil
+	}
+
+	wsPort, err := getPort(nodeConfig.Transports.WebSocket.Port, checkPortNotUsed)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the WebSocket transport", err)
+	}
+	err = markPortAsUsed(wsPort)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the WebSocket transport", err)
+	}
+
+	return append(addresses, fmt.Sprintf(listenAddress+"%d"+webSocketAddressSuffix, wsPort)), nil
+}
+
 func getPort(port string, handler func(int) error) (int, error) {
 	val, err := strconv.Atoi(port)
 	if err == nil {
@@ -6,8 +6,10 @@ import (
 	"net"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetPort_InvalidStringShouldErr(t *testing.T) {
@@ -141,3 +143,87 @@ func TestCheckFreePort_OccupiedPortShouldErr(t *testing.T) {
 
 	_ = l.Close()
 }
+
+func TestCreateListenAddresses_OnlyTcpShouldWork(t *testing.T) {
+	t.Parallel()
+
+	addresses, err := createListenAddresses(ListenLocalhostAddrWithIp4AndTcp, config.NodeConfig{Port: "0"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{ListenLocalhostAddrWithIp4AndTcp + "0"}, addresses)
+}
+
+func TestCreateListenAddresses_WithWebSocketShouldWork(t *testing.T) {
+	t.Parallel()
+
+	nodeConfig := config.NodeConfig{
+		Port: "0",
+		Transports: config.TransportsConfig{
+			WebSocket: config.TransportConfig{
+				Enabled: true,
+				Port:    "0",
+			},
+		},
+	}
+	addresses, err := createListenAddresses(ListenLocalhostAddrWithIp4AndTcp, nodeConfig)
+	assert.Nil(t, err)
+	expectedAddresses := []string{
+		ListenLocalhostAddrWithIp4AndTcp + "0",
+		ListenLocalhostAddrWithIp4AndTcp + "0" + webSocketAddressSuffix,
+	}
+	assert.Equal(t, expectedAddresses, addresses)
+}
+
+func TestCreateListenAddresses_InvalidWebSocketPortShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nodeConfig := config.NodeConfig{
+		Port: "0",
+		Transports: config.TransportsConfig{
+			WebSocket: config.TransportConfig{
+				Enabled: true,
+				Port:    "NaN",
+			},
+		},
+	}
+	addresses, err := createListenAddresses(ListenLocalhostAddrWithIp4AndTcp, nodeConfig)
+	assert.Nil(t, addresses)
+	assert.True(t, errors.Is(err, p2p.ErrInvalidPortsRangeString))
+}
+
+func TestCreateListenAddresses_SamePortForTwoTransportsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nodeConfig := config.NodeConfig{
+		Port: "5000",
+		Transports: config.TransportsConfig{
+			WebSocket: config.TransportConfig{
+				Enabled: true,
+				Port:    "5000",
+			},
+		},
+	}
+	addresses, err := createListenAddresses(ListenLocalhostAddrWithIp4AndTcp, nodeConfig)
+	assert.Nil(t, addresses)
+	assert.True(t, errors.Is(err, p2p.ErrPortAlreadyUsedByAnotherTransport))
+}
+
+func TestCreateListenAddresses_OverlappingRangesShouldChooseDifferentPorts(t *testing.T) {
+	t.Parallel()
+
+	nodeConfig := config.NodeConfig{
+		Port: "40000-40001",
+		Transports: config.TransportsConfig{
+			WebSocket: config.TransportConfig{
+				Enabled: true,
+				Port:    "40000-40001",
+			},
+		},
+	}
+	addresses, err := createListenAddresses(ListenLocalhostAddrWithIp4AndTcp, nodeConfig)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(addresses))
+
+	tcpPort := addresses[0][len(ListenLocalhostAddrWithIp4AndTcp):]
+	wsPort := addresses[1][len(ListenLocalhostAddrWithIp4AndTcp) : len(addresses[1])-len(webSocketAddressSuffix)]
+	assert.NotEqual(t, tcpPort, wsPort)
+}
@@ -403,6 +403,26 @@ func (messenger *Messenger) GetConnectedPeersInfo() *p2p.ConnectedPeersInfo {
 	return nil
 }
 
+// GetConnectionsInfo returns the connections towards the peers from the same network. The in-memory connections
+// have no direction nor latency
+func (messenger *Messenger) GetConnectionsInfo() []core.P2PConnectionInfo {
+	peers := messenger.Peers()
+	connections := make([]core.P2PConnectionInfo, 0, len(peers))
+	for _, pid := range peers {
+		if pid == messenger.ID() {
+			continue
+		}
+
+		connections = append(connections, core.P2PConnectionInfo{
+			Pid:       pid,
+			Address:   fmt.Sprintf("/memp2p/%s", string(pid)),
+			Direction: p2p.ConnectionDirectionUnknown,
+		})
+	}
+
+	return connections
+}
+
 // Close disconnects this Messenger from the network it was connected to.
 func (messenger *Messenger) Close() error {
 	messenger.network.UnregisterPeer(messenger.ID())
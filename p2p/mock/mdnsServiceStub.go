@@ -0,0 +1,35 @@
+package mock
+
+import (
+	libp2pDiscovery "github.com/libp2p/go-libp2p/p2p/discovery"
+)
+
+// MdnsServiceStub -
+type MdnsServiceStub struct {
+	CloseCalled             func() error
+	RegisterNotifeeCalled   func(notifee libp2pDiscovery.Notifee)
+	UnregisterNotifeeCalled func(notifee libp2pDiscovery.Notifee)
+}
+
+// Close -
+func (mss *MdnsServiceStub) Close() error {
+	if mss.CloseCalled != nil {
+		return mss.CloseCalled()
+	}
+
+	return nil
+}
+
+// RegisterNotifee -
+func (mss *MdnsServiceStub) RegisterNotifee(notifee libp2pDiscovery.Notifee) {
+	if mss.RegisterNotifeeCalled != nil {
+		mss.RegisterNotifeeCalled(notifee)
+	}
+}
+
+// UnregisterNotifee -
+func (mss *MdnsServiceStub) UnregisterNotifee(notifee libp2pDiscovery.Notifee) {
+	if mss.UnregisterNotifeeCalled != nil {
+		mss.UnregisterNotifeeCalled(notifee)
+	}
+}
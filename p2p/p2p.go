@@ -19,6 +19,15 @@ const (
 	NilListSharder = "NilListSharder"
 )
 
+const (
+	// KadDhtDiscovery is the peer discovery variant that uses the kad-dht protocol
+	KadDhtDiscovery = "kad-dht"
+	// MdnsDiscovery is the peer discovery variant that finds the peers from the local network through mDNS
+	MdnsDiscovery = "mdns"
+	// StaticPeersDiscovery is the peer discovery variant that keeps the connections to a fixed list of peers
+	StaticPeersDiscovery = "static-peers"
+)
+
 // MessageProcessor is the interface used to describe what a receive message processor should do
 // All implementations that will be called from Messenger implementation will need to satisfy this interface
 // If the function returns a non nil value, the received message will not be propagated to its connected peers
@@ -75,7 +84,8 @@ type Messenger interface {
 	ConnectedPeers() []core.PeerID
 
 	// ConnectedAddresses returns the list of addresses of the peers to which the
-	// Messenger is currently connected.
+	// Messenger is currently connected. Each address contains the transport used
+	// by the connection (for example, WebSocket connections end in /ws).
 	ConnectedAddresses() []string
 
 	// PeerAddresses returns the known addresses for the provided peer ID
@@ -141,6 +151,7 @@ type Messenger interface {
 	SetPeerShardResolver(peerShardResolver PeerShardResolver) error
 	SetPeerDenialEvaluator(handler PeerDenialEvaluator) error
 	GetConnectedPeersInfo() *ConnectedPeersInfo
+	GetConnectionsInfo() []core.P2PConnectionInfo
 	UnjoinAllTopics() error
 
 	// IsInterfaceNil returns true if there is no value under the interface
@@ -214,6 +225,15 @@ type PeerShardResolver interface {
 	IsInterfaceNil() bool
 }
 
+// ConnectionDirectionInbound means that the connection was initiated by the remote peer
+const ConnectionDirectionInbound = "inbound"
+
+// ConnectionDirectionOutbound means that the connection was initiated by the current node
+const ConnectionDirectionOutbound = "outbound"
+
+// ConnectionDirectionUnknown means that the direction of the connection could not be determined
+const ConnectionDirectionUnknown = "unknown"
+
 // ConnectedPeersInfo represents the DTO structure used to output the metrics for connected peers
 type ConnectedPeersInfo struct {
 	SelfShardID             uint32
@@ -34,6 +34,7 @@ type metaProcessor struct {
 	epochSystemSCProcessor       process.EpochStartSystemSCProcessor
 	pendingMiniBlocksHandler     process.PendingMiniBlocksHandler
 	validatorStatisticsProcessor process.ValidatorStatisticsProcessor
+	slashingHandler              process.SlashingHandler
 	shardsHeadersNonce           *sync.Map
 	shardBlockFinality           uint32
 	chRcvAllHdrs                 chan bool
@@ -76,6 +77,9 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 	if check.IfNil(arguments.EpochSystemSCProcessor) {
 		return nil, process.ErrNilEpochStartSystemSCProcessor
 	}
+	if check.IfNil(arguments.SlashingHandler) {
+		return nil, process.ErrNilSlashingHandler
+	}
 
 	genesisHdr := arguments.BlockChain.GetGenesisHeader()
 	base := &baseProcessor{
@@ -120,6 +124,7 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		validatorStatisticsProcessor: arguments.ValidatorStatisticsProcessor,
 		validatorInfoCreator:         arguments.EpochValidatorInfoCreator,
 		epochSystemSCProcessor:       arguments.EpochSystemSCProcessor,
+		slashingHandler:              arguments.SlashingHandler,
 	}
 
 	mp.txCounter = NewTransactionCounter()
@@ -314,6 +319,11 @@ func (mp *metaProcessor) ProcessBlock(
 		return err
 	}
 
+	err = mp.slashingHandler.ProcessSlashingPeerData(header.PeerInfo)
+	if err != nil {
+		return err
+	}
+
 	err = mp.verifyFees(header)
 	if err != nil {
 		return err
@@ -341,6 +351,10 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 		return err
 	}
 
+	if len(header.PeerInfo) > 0 {
+		return fmt.Errorf("%w: start of epoch block can not contain slashing evidence", process.ErrInvalidSlashingEvidence)
+	}
+
 	currentRootHash, err := mp.validatorStatisticsProcessor.RootHash()
 	if err != nil {
 		return err
@@ -366,6 +380,11 @@ func (mp *metaProcessor) processEpochStartMetaBlock(
 		return err
 	}
 
+	err = mp.epochSystemSCProcessor.ProcessDelegationRewards(mp.epochRewardsCreator.GetDelegationRewards())
+	if err != nil {
+		return err
+	}
+
 	err = mp.validatorInfoCreator.VerifyValidatorInfoMiniBlocks(body.MiniBlocks, allValidatorsInfo)
 	if err != nil {
 		return err
@@ -747,6 +766,11 @@ func (mp *metaProcessor) createEpochStartBody(metaBlock *block.MetaBlock) (data.
 		return nil, err
 	}
 
+	err = mp.epochSystemSCProcessor.ProcessDelegationRewards(mp.epochRewardsCreator.GetDelegationRewards())
+	if err != nil {
+		return nil, err
+	}
+
 	validatorMiniBlocks, err := mp.validatorInfoCreator.CreateValidatorInfoMiniBlocks(allValidatorsInfo)
 	if err != nil {
 		return nil, err
@@ -785,6 +809,11 @@ func (mp *metaProcessor) createBlockBody(metaBlock *block.MetaBlock, haveTime fu
 		return nil, err
 	}
 
+	metaBlock.PeerInfo, err = mp.slashingHandler.CreateSlashingPeerData()
+	if err != nil {
+		return nil, err
+	}
+
 	return miniBlocks, nil
 }
 
@@ -1106,6 +1135,7 @@ func (mp *metaProcessor) CommitBlock(
 	mp.recordBlockInHistory(headerHash, headerHandler, bodyHandler)
 
 	highestFinalBlockNonce := mp.forkDetector.GetHighestFinalBlockNonce()
+	mp.slashingHandler.SaveSlashingHistory(header, headerHash, highestFinalBlockNonce)
 	saveMetricsForCommitMetachainBlock(mp.appStatusHandler, header, headerHash, mp.nodesCoordinator, highestFinalBlockNonce)
 
 	headersPool := mp.dataPool.Headers()
@@ -902,8 +902,96 @@ var ErrESDTIsFrozenForAccount = errors.New("account is frozen for this esdt toke
 // ErrNilPayableHandler signals that nil payableHandler was provided
 var ErrNilPayableHandler = errors.New("nil payableHandler was provided")
 
+// ErrActionNotAllowed signals that the address does not have the role needed for the esdt action
+var ErrActionNotAllowed = errors.New("action is not allowed")
+
+// ErrInvalidRoyalties signals that an invalid royalties value was provided
+var ErrInvalidRoyalties = errors.New("invalid royalties value")
+
+// ErrNFTTokenDoesNotExist signals that the NFT token with the given nonce does not exist
+var ErrNFTTokenDoesNotExist = errors.New("NFT token does not exist")
+
+// ErrOnlyFungibleTokensAccepted signals that the operation works only with fungible tokens
+var ErrOnlyFungibleTokensAccepted = errors.New("only fungible tokens are accepted")
+
+// ErrBuiltInFunctionNotCalledOnOwnAddress signals that the built-in function was not called on the caller's own address
+var ErrBuiltInFunctionNotCalledOnOwnAddress = errors.New("built-in function must be called on the caller's own address")
+
+// ErrInvalidNumOfESDTTransfers signals that an invalid number of ESDT transfers was provided
+var ErrInvalidNumOfESDTTransfers = errors.New("invalid number of ESDT transfers")
+
+// ErrDuplicateESDTTransfer signals that the same token appears more than once in a multi ESDT transfer
+var ErrDuplicateESDTTransfer = errors.New("duplicate token in multi ESDT transfer")
+
+// ErrNilMultiESDTTransferParser signals that a nil multi ESDT transfer parser has been provided
+var ErrNilMultiESDTTransferParser = errors.New("nil multi ESDT transfer parser")
+
 // ErrFailedExecutionAfterBuiltInFunc signals that tx execution after built in func call failed
 var ErrFailedExecutionAfterBuiltInFunc = errors.New("failed execution after built in func call failed")
 
 // ErrNilFallbackHeaderValidator signals that a nil fallback header validator has been provided
 var ErrNilFallbackHeaderValidator = errors.New("nil fallback header validator")
+
+// ErrNilPeerReputationStore signals that a nil peer reputation store has been provided
+var ErrNilPeerReputationStore = errors.New("nil peer reputation store")
+
+// ErrInvalidReputationRecordType signals that an unknown reputation record type has been provided
+var ErrInvalidReputationRecordType = errors.New("invalid reputation record type")
+
+// ErrReputationRecordNotFound signals that the requested reputation record does not exist
+var ErrReputationRecordNotFound = errors.New("reputation record not found")
+
+// ErrNilPeerScoresSaver signals that a nil peer scores saver has been provided
+var ErrNilPeerScoresSaver = errors.New("nil peer scores saver")
+
+// ErrNilFloodPreventerLimitsSetter signals that a nil flood preventer limits setter has been provided
+var ErrNilFloodPreventerLimitsSetter = errors.New("nil flood preventer limits setter")
+
+// ErrAntifloodDisabled signals that the operation can not be done as the antiflood components are disabled
+var ErrAntifloodDisabled = errors.New("antiflood is disabled")
+
+// ErrNilLoadProvider signals that a nil load provider has been provided
+var ErrNilLoadProvider = errors.New("nil load provider")
+
+// ErrNilReservedPercentAdapter signals that a nil reserved percent adapter has been provided
+var ErrNilReservedPercentAdapter = errors.New("nil reserved percent adapter")
+
+// ErrNilSlashingHandler signals that a nil slashing handler has been provided
+var ErrNilSlashingHandler = errors.New("nil slashing handler")
+
+// ErrNilEquivocationProofsProvider signals that a nil equivocation proofs provider has been provided
+var ErrNilEquivocationProofsProvider = errors.New("nil equivocation proofs provider")
+
+// ErrNilEquivocationProofVerifier signals that a nil equivocation proof verifier has been provided
+var ErrNilEquivocationProofVerifier = errors.New("nil equivocation proof verifier")
+
+// ErrNilEquivocationProofsSlasher signals that a nil equivocation proofs slasher has been provided
+var ErrNilEquivocationProofsSlasher = errors.New("nil equivocation proofs slasher")
+
+// ErrNilSlashingHistory signals that a nil slashing history has been provided
+var ErrNilSlashingHistory = errors.New("nil slashing history")
+
+// ErrInvalidSlashValue signals that an invalid slash value has been provided
+var ErrInvalidSlashValue = errors.New("invalid slash value")
+
+// ErrSlashingNotEnabled signals that a block holds slashing evidence while the slashing is not enabled
+var ErrSlashingNotEnabled = errors.New("slashing is not enabled")
+
+// ErrInvalidSlashingEvidence signals that a block holds invalid slashing evidence
+var ErrInvalidSlashingEvidence = errors.New("invalid slashing evidence")
+
+// ErrNilSlashingRecord signals that a nil slashing record has been provided
+var ErrNilSlashingRecord = errors.New("nil slashing record")
+
+// ErrEmptyEvidenceID signals that an empty evidence identifier has been provided
+var ErrEmptyEvidenceID = errors.New("empty evidence identifier")
+
+// ErrBuiltInFunctionNotActive signals that the called built-in function is not active in the current epoch or on
+// the current shard
+var ErrBuiltInFunctionNotActive = errors.New("built in function is not active")
+
+// ErrUnknownBuiltInFunction signals that an activation epoch was provided for an unknown built in function
+var ErrUnknownBuiltInFunction = errors.New("unknown built in function")
+
+// ErrInvalidShardApplicability signals that an invalid shard applicability has been provided
+var ErrInvalidShardApplicability = errors.New("invalid shard applicability")
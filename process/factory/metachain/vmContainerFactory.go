@@ -128,6 +128,8 @@ func (vmf *vmContainerFactory) createSystemVM() (vmcommon.VMExecutionHandler, er
 	if err != nil {
 		return nil, err
 	}
+	systemEI.SetNestedOutputMergeEnableEpoch(vmf.systemSCConfig.DelegationManagerSystemSCConfig.EnabledEpoch)
+	vmf.epochNotifier.RegisterNotifyHandler(systemEI)
 
 	argsNewSystemScFactory := systemVMFactory.ArgsNewSystemSCFactory{
 		SystemEI:            systemEI,
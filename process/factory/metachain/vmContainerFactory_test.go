@@ -57,6 +57,13 @@ func TestNewVMContainerFactory_OkValues(t *testing.T) {
 				MinPassThreshold: 50,
 				MinVetoThreshold: 50,
 			},
+			DelegationManagerSystemSCConfig: config.DelegationManagerSystemSCConfig{
+				MinCreationDeposit: "100",
+			},
+			DelegationSystemSCConfig: config.DelegationSystemSCConfig{
+				MinDelegationAmount: "1",
+				MaxServiceFee:       10000,
+			},
 			StakingSystemSCConfig: config.StakingSystemSCConfig{
 				GenesisNodePrice:                     "1000",
 				UnJailValue:                          "10",
@@ -137,6 +144,13 @@ func TestVmContainerFactory_Create(t *testing.T) {
 				MinPassThreshold: 50,
 				MinVetoThreshold: 50,
 			},
+			DelegationManagerSystemSCConfig: config.DelegationManagerSystemSCConfig{
+				MinCreationDeposit: "100",
+			},
+			DelegationSystemSCConfig: config.DelegationSystemSCConfig{
+				MinDelegationAmount: "1",
+				MaxServiceFee:       10000,
+			},
 			StakingSystemSCConfig: config.StakingSystemSCConfig{
 				GenesisNodePrice:                     "1000",
 				UnJailValue:                          "100",
@@ -219,6 +233,8 @@ func FillGasMapMetaChainSystemSCsCosts(value uint64) map[string]uint64 {
 	gasMap["DelegateVote"] = value
 	gasMap["RevokeVote"] = value
 	gasMap["CloseProposal"] = value
+	gasMap["DelegationOps"] = value
+	gasMap["DelegationMgrOps"] = value
 
 	return gasMap
 }
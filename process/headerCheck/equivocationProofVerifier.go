@@ -0,0 +1,156 @@
+package headerCheck
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+// ArgsEquivocationProofVerifier is used to store all components that are needed to create a new
+// EquivocationProofVerifier
+type ArgsEquivocationProofVerifier struct {
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+	NodesCoordinator  sharding.NodesCoordinator
+	SingleSigVerifier crypto.SingleSigner
+	KeyGen            crypto.KeyGenerator
+}
+
+// EquivocationProofVerifier is the component used to check if an equivocation proof is valid: both headers were
+// proposed in the same round and shard, they are different and they were both signed by the leader of that round
+type EquivocationProofVerifier struct {
+	marshalizer       marshal.Marshalizer
+	hasher            hashing.Hasher
+	nodesCoordinator  sharding.NodesCoordinator
+	singleSigVerifier crypto.SingleSigner
+	keyGen            crypto.KeyGenerator
+}
+
+// NewEquivocationProofVerifier will create a new instance of EquivocationProofVerifier
+func NewEquivocationProofVerifier(arguments ArgsEquivocationProofVerifier) (*EquivocationProofVerifier, error) {
+	if check.IfNil(arguments.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(arguments.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(arguments.NodesCoordinator) {
+		return nil, process.ErrNilNodesCoordinator
+	}
+	if check.IfNil(arguments.SingleSigVerifier) {
+		return nil, process.ErrNilSingleSigner
+	}
+	if check.IfNil(arguments.KeyGen) {
+		return nil, process.ErrNilKeyGen
+	}
+
+	return &EquivocationProofVerifier{
+		marshalizer:       arguments.Marshalizer,
+		hasher:            arguments.Hasher,
+		nodesCoordinator:  arguments.NodesCoordinator,
+		singleSigVerifier: arguments.SingleSigVerifier,
+		keyGen:            arguments.KeyGen,
+	}, nil
+}
+
+// Verify will check if the provided equivocation proof is valid
+func (epv *EquivocationProofVerifier) Verify(proof *consensus.EquivocationProof) error {
+	if proof == nil {
+		return ErrNilEquivocationProof
+	}
+
+	leaderPubKey, err := epv.keyGen.PublicKeyFromByteArray(proof.PubKey)
+	if err != nil {
+		return err
+	}
+
+	firstHeader, err := epv.verifyHeader(proof, proof.FirstHeader, leaderPubKey)
+	if err != nil {
+		return fmt.Errorf("%w for the first header", err)
+	}
+
+	secondHeader, err := epv.verifyHeader(proof, proof.SecondHeader, leaderPubKey)
+	if err != nil {
+		return fmt.Errorf("%w for the second header", err)
+	}
+
+	// the hashes are computed on the unmarshalled headers, so the same header can not be presented twice with
+	// different encodings
+	firstHash, err := core.CalculateHash(epv.marshalizer, epv.hasher, firstHeader)
+	if err != nil {
+		return err
+	}
+	secondHash, err := core.CalculateHash(epv.marshalizer, epv.hasher, secondHeader)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(firstHash, secondHash) {
+		return ErrEquivocationProofWithSameHeaders
+	}
+
+	return nil
+}
+
+func (epv *EquivocationProofVerifier) verifyHeader(
+	proof *consensus.EquivocationProof,
+	headerBytes []byte,
+	leaderPubKey crypto.PublicKey,
+) (data.HeaderHandler, error) {
+	header, err := epv.unmarshalHeader(proof.ShardID, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if header.GetShardID() != proof.ShardID || header.GetRound() != proof.Round {
+		return nil, ErrEquivocationProofHeaderMismatch
+	}
+
+	headerLeaderPubKey, err := getLeaderPubKey(epv.nodesCoordinator, header)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(headerLeaderPubKey, proof.PubKey) {
+		return nil, ErrEquivocationProofWrongLeader
+	}
+
+	headerCopy := header.Clone()
+	headerCopy.SetLeaderSignature(nil)
+	headerCopyBytes, err := epv.marshalizer.Marshal(headerCopy)
+	if err != nil {
+		return nil, err
+	}
+
+	err = epv.singleSigVerifier.Verify(leaderPubKey, headerCopyBytes, header.GetLeaderSignature())
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+func (epv *EquivocationProofVerifier) unmarshalHeader(shardID uint32, headerBytes []byte) (data.HeaderHandler, error) {
+	if shardID == core.MetachainShardId {
+		metaBlock := &block.MetaBlock{}
+		err := epv.marshalizer.Unmarshal(metaBlock, headerBytes)
+		return metaBlock, err
+	}
+
+	header := &block.Header{}
+	err := epv.marshalizer.Unmarshal(header, headerBytes)
+	return header, err
+}
+
+// IsInterfaceNil will check if interface is nil
+func (epv *EquivocationProofVerifier) IsInterfaceNil() bool {
+	return epv == nil
+}
@@ -0,0 +1,229 @@
+package headerCheck
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data"
+	dataBlock "github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var leaderPkBytes = []byte("leader public key")
+
+func createEquivocationProofVerifierArgs() ArgsEquivocationProofVerifier {
+	return ArgsEquivocationProofVerifier{
+		Marshalizer: &mock.MarshalizerMock{},
+		Hasher:      &mock.HasherMock{},
+		NodesCoordinator: &mock.NodesCoordinatorMock{
+			ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]sharding.Validator, error) {
+				v, _ := sharding.NewValidator(leaderPkBytes, 1, defaultChancesSelection)
+				return []sharding.Validator{v}, nil
+			},
+		},
+		SingleSigVerifier: &mock.SignerMock{
+			VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				return nil
+			},
+		},
+		KeyGen: &mock.SingleSignKeyGenMock{
+			PublicKeyFromByteArrayCalled: func(b []byte) (crypto.PublicKey, error) {
+				return &mock.SingleSignPublicKey{}, nil
+			},
+		},
+	}
+}
+
+func createEquivocationProof(t *testing.T, firstHeader data.HeaderHandler, secondHeader data.HeaderHandler) *consensus.EquivocationProof {
+	marshalizer := &mock.MarshalizerMock{}
+	firstHeaderBytes, err := marshalizer.Marshal(firstHeader)
+	require.Nil(t, err)
+	secondHeaderBytes, err := marshalizer.Marshal(secondHeader)
+	require.Nil(t, err)
+
+	return consensus.NewEquivocationProof(leaderPkBytes, firstHeader.GetShardID(), firstHeader.GetRound(), firstHeaderBytes, secondHeaderBytes)
+}
+
+func TestNewEquivocationProofVerifier_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createEquivocationProofVerifierArgs()
+	args.Marshalizer = nil
+	epv, err := NewEquivocationProofVerifier(args)
+
+	assert.Nil(t, epv)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewEquivocationProofVerifier_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createEquivocationProofVerifierArgs()
+	args.Hasher = nil
+	epv, err := NewEquivocationProofVerifier(args)
+
+	assert.Nil(t, epv)
+	assert.Equal(t, process.ErrNilHasher, err)
+}
+
+func TestNewEquivocationProofVerifier_NilNodesCoordinatorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createEquivocationProofVerifierArgs()
+	args.NodesCoordinator = nil
+	epv, err := NewEquivocationProofVerifier(args)
+
+	assert.Nil(t, epv)
+	assert.Equal(t, process.ErrNilNodesCoordinator, err)
+}
+
+func TestNewEquivocationProofVerifier_NilSingleSigVerifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createEquivocationProofVerifierArgs()
+	args.SingleSigVerifier = nil
+	epv, err := NewEquivocationProofVerifier(args)
+
+	assert.Nil(t, epv)
+	assert.Equal(t, process.ErrNilSingleSigner, err)
+}
+
+func TestNewEquivocationProofVerifier_NilKeyGenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createEquivocationProofVerifierArgs()
+	args.KeyGen = nil
+	epv, err := NewEquivocationProofVerifier(args)
+
+	assert.Nil(t, epv)
+	assert.Equal(t, process.ErrNilKeyGen, err)
+}
+
+func TestNewEquivocationProofVerifier_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	epv, err := NewEquivocationProofVerifier(createEquivocationProofVerifierArgs())
+
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(epv))
+}
+
+func TestEquivocationProofVerifier_VerifyNilProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epv, _ := NewEquivocationProofVerifier(createEquivocationProofVerifierArgs())
+
+	err := epv.Verify(nil)
+	assert.Equal(t, ErrNilEquivocationProof, err)
+}
+
+func TestEquivocationProofVerifier_VerifySameHeadersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epv, _ := NewEquivocationProofVerifier(createEquivocationProofVerifierArgs())
+	header := &dataBlock.Header{Round: 5, Nonce: 4, LeaderSignature: []byte("signature")}
+	proof := createEquivocationProof(t, header, header)
+
+	err := epv.Verify(proof)
+	assert.Equal(t, ErrEquivocationProofWithSameHeaders, err)
+}
+
+func TestEquivocationProofVerifier_VerifyHeaderFromOtherRoundShouldErr(t *testing.T) {
+	t.Parallel()
+
+	epv, _ := NewEquivocationProofVerifier(createEquivocationProofVerifierArgs())
+	firstHeader := &dataBlock.Header{Round: 5, Nonce: 4}
+	secondHeader := &dataBlock.Header{Round: 6, Nonce: 4}
+	proof := createEquivocationProof(t, firstHeader, secondHeader)
+
+	err := epv.Verify(proof)
+	assert.True(t, errors.Is(err, ErrEquivocationProofHeaderMismatch))
+}
+
+func TestEquivocationProofVerifier_VerifyHeaderFromOtherLeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createEquivocationProofVerifierArgs()
+	args.NodesCoordinator = &mock.NodesCoordinatorMock{
+		ComputeValidatorsGroupCalled: func(randomness []byte, round uint64, shardId uint32, epoch uint32) ([]sharding.Validator, error) {
+			v, _ := sharding.NewValidator([]byte("other leader"), 1, defaultChancesSelection)
+			return []sharding.Validator{v}, nil
+		},
+	}
+	epv, _ := NewEquivocationProofVerifier(args)
+	firstHeader := &dataBlock.Header{Round: 5, Nonce: 4}
+	secondHeader := &dataBlock.Header{Round: 5, Nonce: 5}
+	proof := createEquivocationProof(t, firstHeader, secondHeader)
+
+	err := epv.Verify(proof)
+	assert.True(t, errors.Is(err, ErrEquivocationProofWrongLeader))
+}
+
+func TestEquivocationProofVerifier_VerifyInvalidLeaderSignatureShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createEquivocationProofVerifierArgs()
+	args.SingleSigVerifier = &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			if string(sig) == "invalid signature" {
+				return expectedErr
+			}
+			return nil
+		},
+	}
+	epv, _ := NewEquivocationProofVerifier(args)
+	firstHeader := &dataBlock.Header{Round: 5, Nonce: 4, LeaderSignature: []byte("signature")}
+	secondHeader := &dataBlock.Header{Round: 5, Nonce: 5, LeaderSignature: []byte("invalid signature")}
+	proof := createEquivocationProof(t, firstHeader, secondHeader)
+
+	err := epv.Verify(proof)
+	assert.True(t, errors.Is(err, expectedErr))
+}
+
+func TestEquivocationProofVerifier_VerifyShouldWork(t *testing.T) {
+	t.Parallel()
+
+	verifiedHeaders := make([][]byte, 0)
+	args := createEquivocationProofVerifierArgs()
+	args.SingleSigVerifier = &mock.SignerMock{
+		VerifyStub: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+			verifiedHeaders = append(verifiedHeaders, msg)
+			return nil
+		},
+	}
+	epv, _ := NewEquivocationProofVerifier(args)
+	firstHeader := &dataBlock.Header{Round: 5, Nonce: 4, ShardID: 1, LeaderSignature: []byte("signature")}
+	secondHeader := &dataBlock.Header{Round: 5, Nonce: 5, ShardID: 1, LeaderSignature: []byte("signature")}
+	proof := createEquivocationProof(t, firstHeader, secondHeader)
+
+	err := epv.Verify(proof)
+	assert.Nil(t, err)
+	require.Equal(t, 2, len(verifiedHeaders))
+
+	marshalizer := &mock.MarshalizerMock{}
+	firstHeader.LeaderSignature = nil
+	firstHeaderBytes, _ := marshalizer.Marshal(firstHeader)
+	assert.Equal(t, firstHeaderBytes, verifiedHeaders[0])
+}
+
+func TestEquivocationProofVerifier_VerifyMetaBlocksShouldWork(t *testing.T) {
+	t.Parallel()
+
+	epv, _ := NewEquivocationProofVerifier(createEquivocationProofVerifierArgs())
+	firstHeader := &dataBlock.MetaBlock{Round: 5, Nonce: 4, LeaderSignature: []byte("signature")}
+	secondHeader := &dataBlock.MetaBlock{Round: 5, Nonce: 5, LeaderSignature: []byte("signature")}
+	proof := createEquivocationProof(t, firstHeader, secondHeader)
+	require.Equal(t, core.MetachainShardId, proof.ShardID)
+
+	err := epv.Verify(proof)
+	assert.Nil(t, err)
+}
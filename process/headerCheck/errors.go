@@ -32,3 +32,17 @@ var ErrSoftwareVersionMismatch = errors.New("software versions mismatch")
 
 // ErrNilCacher signals that a nil cacher has been provided
 var ErrNilCacher = errors.New("nil cacher")
+
+// ErrNilEquivocationProof signals that a nil equivocation proof has been provided
+var ErrNilEquivocationProof = errors.New("nil equivocation proof")
+
+// ErrEquivocationProofWithSameHeaders signals that the equivocation proof holds the same header twice
+var ErrEquivocationProofWithSameHeaders = errors.New("equivocation proof holds the same header twice")
+
+// ErrEquivocationProofHeaderMismatch signals that a header from the equivocation proof was not proposed in the
+// round and shard the proof refers to
+var ErrEquivocationProofHeaderMismatch = errors.New("equivocation proof header does not match the proof round and shard")
+
+// ErrEquivocationProofWrongLeader signals that the public key from the equivocation proof does not belong to the
+// leader which should have proposed the headers
+var ErrEquivocationProofWrongLeader = errors.New("equivocation proof public key does not belong to the headers leader")
@@ -261,6 +261,15 @@ func (hsv *HeaderSigVerifier) verifyLeaderSignature(leaderPubKey crypto.PublicKe
 }
 
 func (hsv *HeaderSigVerifier) getLeader(header data.HeaderHandler) (crypto.PublicKey, error) {
+	leaderPubKey, err := getLeaderPubKey(hsv.nodesCoordinator, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return hsv.keyGen.PublicKeyFromByteArray(leaderPubKey)
+}
+
+func getLeaderPubKey(nodesCoordinator sharding.NodesCoordinator, header data.HeaderHandler) ([]byte, error) {
 	prevRandSeed := header.GetPrevRandSeed()
 
 	// TODO: remove if start of epoch block needs to be validated by the new epoch nodes
@@ -269,13 +278,12 @@ func (hsv *HeaderSigVerifier) getLeader(header data.HeaderHandler) (crypto.Publi
 		epoch = epoch - 1
 	}
 
-	headerConsensusGroup, err := hsv.nodesCoordinator.ComputeConsensusGroup(prevRandSeed, header.GetRound(), header.GetShardID(), epoch)
+	headerConsensusGroup, err := nodesCoordinator.ComputeConsensusGroup(prevRandSeed, header.GetRound(), header.GetShardID(), epoch)
 	if err != nil {
 		return nil, err
 	}
 
-	leaderPubKeyValidator := headerConsensusGroup[0]
-	return hsv.keyGen.PublicKeyFromByteArray(leaderPubKeyValidator.PubKey())
+	return headerConsensusGroup[0].PubKey(), nil
 }
 
 func (hsv *HeaderSigVerifier) copyHeaderWithoutSig(header data.HeaderHandler) data.HeaderHandler {
@@ -4,6 +4,8 @@ import (
 	"math/big"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data"
 	"github.com/ElrondNetwork/elrond-go/data/block"
@@ -503,10 +505,17 @@ type StorageArgumentsParser interface {
 	IsInterfaceNil() bool
 }
 
+// MultiESDTTransferParser defines the functionality to parse the arguments of a multi ESDT transfer
+type MultiESDTTransferParser interface {
+	ParseMultiESDTTransferData(arguments [][]byte) (*ParsedMultiESDTTransfer, error)
+	IsInterfaceNil() bool
+}
+
 // ArgumentsParser defines the functionality to parse transaction data into arguments and code for smart contracts
 type ArgumentsParser interface {
 	ParseCallData(data string) (string, [][]byte, error)
 	ParseDeployData(data string) (*parsers.DeployArgs, error)
+	ParseMultiESDTTransferData(arguments [][]byte) (*ParsedMultiESDTTransfer, error)
 
 	CreateDataFromStorageUpdate(storageUpdates []*vmcommon.StorageUpdate) string
 	GetStorageUpdates(data string) ([]*vmcommon.StorageUpdate, error)
@@ -592,6 +601,19 @@ type PeerBlackListCacher interface {
 	IsInterfaceNil() bool
 }
 
+// PeerReputationStore provides the persistent peer IDs and public keys blacklist caches
+type PeerReputationStore interface {
+	PeerIDsCacher() PeerBlackListCacher
+	PublicKeysCacher() TimeCacher
+	IsInterfaceNil() bool
+}
+
+// PeerScoresSaver is able to save the score of a blacklisted public key
+type PeerScoresSaver interface {
+	SaveScore(pk string, score float64)
+	IsInterfaceNil() bool
+}
+
 // PeerShardMapper can return the public key of a provided peer ID
 type PeerShardMapper interface {
 	GetPeerInfo(pid core.PeerID) core.P2PPeerInfo
@@ -614,6 +636,19 @@ type SCQuery struct {
 	Arguments  [][]byte
 }
 
+// ESDTTransferData holds the token identifier and the value of one transfer from a multi ESDT transfer
+type ESDTTransferData struct {
+	TokenIdentifier []byte
+	Value           *big.Int
+}
+
+// ParsedMultiESDTTransfer holds the transfers and the optional smart contract call of a multi ESDT transfer
+type ParsedMultiESDTTransfer struct {
+	Transfers    []*ESDTTransferData
+	CallFunction string
+	CallArgs     [][]byte
+}
+
 // GasHandler is able to perform some gas calculation
 type GasHandler interface {
 	Init()
@@ -718,6 +753,39 @@ type TopicFloodPreventer interface {
 	IsInterfaceNil() bool
 }
 
+// FloodPreventerLimitsSetter defines the behavior of a flood preventer whose limits can be changed at runtime
+type FloodPreventerLimitsSetter interface {
+	SetLimits(limits config.FloodPreventerLimitsConfig) error
+	IsInterfaceNil() bool
+}
+
+// TopicFloodPreventerLimitsSetter defines the behavior of a topic flood preventer whose limits can be changed at runtime
+type TopicFloodPreventerLimitsSetter interface {
+	SetLimits(limits config.TopicAntifloodConfig) error
+	IsInterfaceNil() bool
+}
+
+// AntifloodLimitsHandler defines the behavior of a component able to read and change the antiflood limits at runtime
+type AntifloodLimitsHandler interface {
+	Limits() config.AntifloodLimitsOverrideConfig
+	SetLimits(limits config.AntifloodLimitsOverrideConfig, source string) error
+	IsInterfaceNil() bool
+}
+
+// LoadProvider defines a component able to tell the current load of the machine, as a percent
+type LoadProvider interface {
+	LoadPercent() uint64
+	IsInterfaceNil() bool
+}
+
+// AdaptiveQuotaHandler defines the behavior of a component able to adapt the flood preventers reserved percent
+// to the current load of the node
+type AdaptiveQuotaHandler interface {
+	AdaptReservedPercent(pid core.PeerID, reservedPercent float32) float32
+	SetPeerValidatorMapper(validatorMapper PeerValidatorMapper) error
+	IsInterfaceNil() bool
+}
+
 // P2PAntifloodHandler defines the behavior of a component able to signal that the system is too busy (or flooded) processing
 // p2p messages
 type P2PAntifloodHandler interface {
@@ -754,6 +822,7 @@ type EpochStartRewardsCreator interface {
 	CreateRewardsMiniBlocks(metaBlock *block.MetaBlock, validatorsInfo map[uint32][]*state.ValidatorInfo) (block.MiniBlockSlice, error)
 	VerifyRewardsMiniBlocks(metaBlock *block.MetaBlock, validatorsInfo map[uint32][]*state.ValidatorInfo) error
 	GetProtocolSustainabilityRewards() *big.Int
+	GetDelegationRewards() map[string]*big.Int
 	CreateMarshalizedData(body *block.Body) map[string][][]byte
 	GetRewardsTxs(body *block.Body) map[string]data.TransactionHandler
 	SaveTxBlockToStorage(metaBlock *block.MetaBlock, body *block.Body)
@@ -775,6 +844,7 @@ type EpochStartValidatorInfoCreator interface {
 // EpochStartSystemSCProcessor defines the functionality for the metachain to process system smart contract and end of epoch
 type EpochStartSystemSCProcessor interface {
 	ProcessSystemSmartContract(validatorInfos map[uint32][]*state.ValidatorInfo) error
+	ProcessDelegationRewards(rewards map[string]*big.Int) error
 	IsInterfaceNil() bool
 }
 
@@ -810,6 +880,20 @@ type BuiltInFunctionContainer interface {
 	IsInterfaceNil() bool
 }
 
+// BuiltInFunctionInfo holds the details of an active built-in function
+type BuiltInFunctionInfo struct {
+	Name            string `json:"name"`
+	GasCost         uint64 `json:"gasCost"`
+	ActivationEpoch uint32 `json:"activationEpoch"`
+}
+
+// BuiltInFunctionsRegistry defines a built-in functions container which activates the registered functions by epoch
+type BuiltInFunctionsRegistry interface {
+	BuiltInFunctionContainer
+	GetRegistered(key string) (BuiltinFunction, error)
+	GetActiveBuiltInFunctions() []BuiltInFunctionInfo
+}
+
 // RoundTimeDurationHandler defines the methods to get the time duration of a round
 type RoundTimeDurationHandler interface {
 	TimeDuration() time.Duration
@@ -946,3 +1030,32 @@ type FallbackHeaderValidator interface {
 	ShouldApplyFallbackValidation(headerHandler data.HeaderHandler) bool
 	IsInterfaceNil() bool
 }
+
+// SlashingHandler defines the behaviour of a component able to include the verified misbehaviour evidence in the
+// metachain blocks, to slash the validators proven to misbehave and to keep the history of the applied slashing
+type SlashingHandler interface {
+	CreateSlashingPeerData() ([]block.PeerData, error)
+	ProcessSlashingPeerData(peerData []block.PeerData) error
+	SaveSlashingHistory(header *block.MetaBlock, headerHash []byte, highestFinalBlockNonce uint64)
+	IsInterfaceNil() bool
+}
+
+// EquivocationProofsProvider defines the behaviour of a component able to provide the verified equivocation proofs
+type EquivocationProofsProvider interface {
+	GetEquivocationProofs() []*consensus.EquivocationProof
+	IsInterfaceNil() bool
+}
+
+// EquivocationProofVerifier defines the behaviour of a component able to verify an equivocation proof
+type EquivocationProofVerifier interface {
+	Verify(proof *consensus.EquivocationProof) error
+	IsInterfaceNil() bool
+}
+
+// EquivocationProofsSlasher defines the behaviour of a component able to slash the leaders proven to sign different
+// headers in the same round
+type EquivocationProofsSlasher interface {
+	ProcessEquivocationProofs(proofs []*consensus.EquivocationProof, slashValue *big.Int) ([]*consensus.EquivocationProof, error)
+	IsEquivocationProofApplied(evidenceID []byte) bool
+	IsInterfaceNil() bool
+}
@@ -18,6 +18,7 @@ type EpochRewardsCreatorStub struct {
 	RemoveBlockDataFromPoolsCalled func(metaBlock *block.MetaBlock, body *block.Body)
 	GetRewardsTxsCalled            func(body *block.Body) map[string]data.TransactionHandler
 	GetProtocolSustainCalled       func() *big.Int
+	GetDelegationRewardsCalled     func() map[string]*big.Int
 }
 
 // GetProtocolSustainabilityRewards -
@@ -28,6 +29,14 @@ func (e *EpochRewardsCreatorStub) GetProtocolSustainabilityRewards() *big.Int {
 	return big.NewInt(0)
 }
 
+// GetDelegationRewards -
+func (e *EpochRewardsCreatorStub) GetDelegationRewards() map[string]*big.Int {
+	if e.GetDelegationRewardsCalled != nil {
+		return e.GetDelegationRewardsCalled()
+	}
+	return make(map[string]*big.Int)
+}
+
 // CreateRewardsMiniBlocks -
 func (e *EpochRewardsCreatorStub) CreateRewardsMiniBlocks(metaBlock *block.MetaBlock, validatorsInfo map[uint32][]*state.ValidatorInfo) (block.MiniBlockSlice, error) {
 	if e.CreateRewardsMiniBlocksCalled != nil {
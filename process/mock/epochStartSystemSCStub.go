@@ -1,10 +1,15 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-go/data/state"
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/data/state"
+)
 
 // EpochStartSystemSCStub -
 type EpochStartSystemSCStub struct {
 	ProcessSystemSmartContractCalled func(validatorInfos map[uint32][]*state.ValidatorInfo) error
+	ProcessDelegationRewardsCalled   func(rewards map[string]*big.Int) error
 }
 
 // ProcessSystemSmartContract -
@@ -15,6 +20,14 @@ func (e *EpochStartSystemSCStub) ProcessSystemSmartContract(validatorInfos map[u
 	return nil
 }
 
+// ProcessDelegationRewards -
+func (e *EpochStartSystemSCStub) ProcessDelegationRewards(rewards map[string]*big.Int) error {
+	if e.ProcessDelegationRewardsCalled != nil {
+		return e.ProcessDelegationRewardsCalled(rewards)
+	}
+	return nil
+}
+
 // IsInterfaceNil -
 func (e *EpochStartSystemSCStub) IsInterfaceNil() bool {
 	return e == nil
@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// EquivocationProofVerifierStub -
+type EquivocationProofVerifierStub struct {
+	VerifyCalled func(proof *consensus.EquivocationProof) error
+}
+
+// Verify -
+func (epvs *EquivocationProofVerifierStub) Verify(proof *consensus.EquivocationProof) error {
+	if epvs.VerifyCalled != nil {
+		return epvs.VerifyCalled(proof)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (epvs *EquivocationProofVerifierStub) IsInterfaceNil() bool {
+	return epvs == nil
+}
@@ -0,0 +1,24 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// EquivocationProofsProviderStub -
+type EquivocationProofsProviderStub struct {
+	GetEquivocationProofsCalled func() []*consensus.EquivocationProof
+}
+
+// GetEquivocationProofs -
+func (epps *EquivocationProofsProviderStub) GetEquivocationProofs() []*consensus.EquivocationProof {
+	if epps.GetEquivocationProofsCalled != nil {
+		return epps.GetEquivocationProofsCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (epps *EquivocationProofsProviderStub) IsInterfaceNil() bool {
+	return epps == nil
+}
@@ -0,0 +1,39 @@
+package mock
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+// EquivocationProofsSlasherStub -
+type EquivocationProofsSlasherStub struct {
+	ProcessEquivocationProofsCalled  func(proofs []*consensus.EquivocationProof, slashValue *big.Int) ([]*consensus.EquivocationProof, error)
+	IsEquivocationProofAppliedCalled func(evidenceID []byte) bool
+}
+
+// ProcessEquivocationProofs -
+func (epss *EquivocationProofsSlasherStub) ProcessEquivocationProofs(
+	proofs []*consensus.EquivocationProof,
+	slashValue *big.Int,
+) ([]*consensus.EquivocationProof, error) {
+	if epss.ProcessEquivocationProofsCalled != nil {
+		return epss.ProcessEquivocationProofsCalled(proofs, slashValue)
+	}
+
+	return proofs, nil
+}
+
+// IsEquivocationProofApplied -
+func (epss *EquivocationProofsSlasherStub) IsEquivocationProofApplied(evidenceID []byte) bool {
+	if epss.IsEquivocationProofAppliedCalled != nil {
+		return epss.IsEquivocationProofAppliedCalled(evidenceID)
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (epss *EquivocationProofsSlasherStub) IsInterfaceNil() bool {
+	return epss == nil
+}
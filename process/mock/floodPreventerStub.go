@@ -1,12 +1,16 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-go/core"
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+)
 
 // FloodPreventerStub -
 type FloodPreventerStub struct {
 	IncreaseLoadCalled       func(pid core.PeerID, size uint64) error
 	ApplyConsensusSizeCalled func(size int)
 	ResetCalled              func()
+	SetLimitsCalled          func(limits config.FloodPreventerLimitsConfig) error
 }
 
 // IncreaseLoad -
@@ -26,6 +30,15 @@ func (fps *FloodPreventerStub) Reset() {
 	fps.ResetCalled()
 }
 
+// SetLimits -
+func (fps *FloodPreventerStub) SetLimits(limits config.FloodPreventerLimitsConfig) error {
+	if fps.SetLimitsCalled != nil {
+		return fps.SetLimitsCalled(limits)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (fps *FloodPreventerStub) IsInterfaceNil() bool {
 	return fps == nil
@@ -0,0 +1,18 @@
+package mock
+
+// PeerScoresSaverStub -
+type PeerScoresSaverStub struct {
+	SaveScoreCalled func(pk string, score float64)
+}
+
+// SaveScore -
+func (pss *PeerScoresSaverStub) SaveScore(pk string, score float64) {
+	if pss.SaveScoreCalled != nil {
+		pss.SaveScoreCalled(pk, score)
+	}
+}
+
+// IsInterfaceNil -
+func (pss *PeerScoresSaverStub) IsInterfaceNil() bool {
+	return pss == nil
+}
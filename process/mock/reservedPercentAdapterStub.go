@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/core"
+
+// ReservedPercentAdapterStub -
+type ReservedPercentAdapterStub struct {
+	AdaptReservedPercentCalled func(pid core.PeerID, reservedPercent float32) float32
+}
+
+// AdaptReservedPercent -
+func (rpas *ReservedPercentAdapterStub) AdaptReservedPercent(pid core.PeerID, reservedPercent float32) float32 {
+	if rpas.AdaptReservedPercentCalled != nil {
+		return rpas.AdaptReservedPercentCalled(pid, reservedPercent)
+	}
+
+	return reservedPercent
+}
+
+// IsInterfaceNil -
+func (rpas *ReservedPercentAdapterStub) IsInterfaceNil() bool {
+	return rpas == nil
+}
@@ -1,12 +1,16 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-go/core"
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+)
 
 // TopicAntiFloodStub -
 type TopicAntiFloodStub struct {
 	IncreaseLoadCalled           func(pid core.PeerID, topic string, numMessages uint32) error
 	ResetForTopicCalled          func(topic string)
 	SetMaxMessagesForTopicCalled func(topic string, num uint32)
+	SetLimitsCalled              func(limits config.TopicAntifloodConfig) error
 }
 
 // IncreaseLoad -
@@ -36,6 +40,15 @@ func (t *TopicAntiFloodStub) SetMaxMessagesForTopic(topic string, num uint32) {
 	}
 }
 
+// SetLimits -
+func (t *TopicAntiFloodStub) SetLimits(limits config.TopicAntifloodConfig) error {
+	if t.SetLimitsCalled != nil {
+		return t.SetLimitsCalled(limits)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (t *TopicAntiFloodStub) IsInterfaceNil() bool {
 	return t == nil
@@ -35,6 +35,7 @@ type p2pPeerHonesty struct {
 	cache                  storage.Cacher
 	mut                    sync.RWMutex
 	blackListedPkCache     process.TimeCacher
+	scoresSaver            process.PeerScoresSaver
 	cancelFunc             func()
 }
 
@@ -223,10 +224,14 @@ func (pph *p2pPeerHonesty) createDefaultPeerScore(pk string) *peerScore {
 
 func (pph *p2pPeerHonesty) checkBlacklistNoLock(ps *peerScore) {
 	shouldBlacklist := false
+	lowestScore := float64(0)
 	for _, score := range ps.scoresByTopic {
 		if score < pph.badPeerThreshold {
 			shouldBlacklist = true
 		}
+		if score < lowestScore {
+			lowestScore = score
+		}
 	}
 
 	if !shouldBlacklist {
@@ -247,9 +252,27 @@ func (pph *p2pPeerHonesty) checkBlacklistNoLock(ps *peerScore) {
 		log.Warn("p2pPeerHonesty.checkBlacklist",
 			"pk", core.GetTrimmedPk(hex.EncodeToString([]byte(ps.pk))),
 			"error", err)
+		return
+	}
+
+	if !check.IfNil(pph.scoresSaver) {
+		pph.scoresSaver.SaveScore(ps.pk, lowestScore)
 	}
 }
 
+// SetPeerScoresSaver sets the component that will persist the scores of the blacklisted public keys
+func (pph *p2pPeerHonesty) SetPeerScoresSaver(scoresSaver process.PeerScoresSaver) error {
+	if check.IfNil(scoresSaver) {
+		return process.ErrNilPeerScoresSaver
+	}
+
+	pph.mut.Lock()
+	pph.scoresSaver = scoresSaver
+	pph.mut.Unlock()
+
+	return nil
+}
+
 // Close closes the running go routines related to this instance
 func (pph *p2pPeerHonesty) Close() error {
 	pph.cancelFunc()
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // createMockPeerHonestyConfig creates a peer honesty config with reasonable values
@@ -385,6 +386,55 @@ func TestP2pPeerHonesty_CheckBlacklistUpsertErrorsShouldWork(t *testing.T) {
 	assert.True(t, upsertCalled)
 }
 
+func TestP2pPeerHonesty_SetPeerScoresSaverNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pph, _ := NewP2pPeerHonesty(
+		createMockPeerHonestyConfig(),
+		&mock.TimeCacheStub{},
+		testscommon.NewCacherMock(),
+	)
+
+	err := pph.SetPeerScoresSaver(nil)
+	assert.Equal(t, process.ErrNilPeerScoresSaver, err)
+}
+
+func TestP2pPeerHonesty_CheckBlacklistShouldSaveTheLowestScore(t *testing.T) {
+	t.Parallel()
+
+	cfg := createMockPeerHonestyConfig()
+	cfg.UnitValue = 4
+	pph, _ := NewP2pPeerHonesty(
+		cfg,
+		&mock.TimeCacheStub{
+			HasCalled: func(key string) bool {
+				return false
+			},
+			UpsertCalled: func(key string, span time.Duration) error {
+				return nil
+			},
+		},
+		testscommon.NewCacherMock(),
+	)
+
+	savedPk := ""
+	savedScore := float64(0)
+	err := pph.SetPeerScoresSaver(&mock.PeerScoresSaverStub{
+		SaveScoreCalled: func(pk string, score float64) {
+			savedPk = pk
+			savedScore = score
+		},
+	})
+	require.Nil(t, err)
+
+	pk := "pk"
+	units := int(cfg.MinScore) - 1
+	pph.ChangeScore(pk, "topic", units)
+
+	assert.Equal(t, pk, savedPk)
+	assert.Equal(t, cfg.MinScore, savedScore)
+}
+
 func TestP2pPeerHonesty_ApplyDecay(t *testing.T) {
 	t.Parallel()
 
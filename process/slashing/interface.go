@@ -0,0 +1,10 @@
+package slashing
+
+// SlashingHistoryHandler defines the behaviour of a component able to keep the slashing records
+type SlashingHistoryHandler interface {
+	Add(record *SlashingRecord) error
+	Has(evidenceID []byte) bool
+	GetRecords() []*SlashingRecord
+	Close() error
+	IsInterfaceNil() bool
+}
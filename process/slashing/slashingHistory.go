@@ -0,0 +1,127 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. slashingRecord.proto
+package slashing
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgsSlashingHistory represents the argument for the slashing history constructor
+type ArgsSlashingHistory struct {
+	Storer      storage.Storer
+	Marshalizer marshal.Marshalizer
+}
+
+type slashingHistory struct {
+	storer      storage.Storer
+	marshalizer marshal.Marshalizer
+	mutRecords  sync.RWMutex
+	records     map[string]*SlashingRecord
+}
+
+// NewSlashingHistory creates a slashing history which keeps the slashing records in the provided storer. The
+// existing records are reloaded on construction.
+func NewSlashingHistory(args ArgsSlashingHistory) (*slashingHistory, error) {
+	if check.IfNil(args.Storer) {
+		return nil, fmt.Errorf("%w in NewSlashingHistory", process.ErrNilStorage)
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, fmt.Errorf("%w in NewSlashingHistory", process.ErrNilMarshalizer)
+	}
+
+	sh := &slashingHistory{
+		storer:      args.Storer,
+		marshalizer: args.Marshalizer,
+		records:     make(map[string]*SlashingRecord),
+	}
+	sh.loadFromStorage()
+
+	return sh, nil
+}
+
+func (sh *slashingHistory) loadFromStorage() {
+	sh.storer.RangeKeys(func(key []byte, val []byte) bool {
+		record := &SlashingRecord{}
+		err := sh.marshalizer.Unmarshal(record, val)
+		if err != nil {
+			log.Debug("slashingHistory.loadFromStorage: unmarshal", "error", err)
+			return true
+		}
+
+		sh.records[string(key)] = record
+		return true
+	})
+
+	log.Debug("slashingHistory: loaded slashing records", "num records", len(sh.records))
+}
+
+// Add saves the provided slashing record. A record with the same evidence identifier is replaced.
+func (sh *slashingHistory) Add(record *SlashingRecord) error {
+	if record == nil {
+		return process.ErrNilSlashingRecord
+	}
+	if len(record.EvidenceID) == 0 {
+		return process.ErrEmptyEvidenceID
+	}
+
+	buff, err := sh.marshalizer.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	sh.mutRecords.Lock()
+	defer sh.mutRecords.Unlock()
+
+	err = sh.storer.Put(record.EvidenceID, buff)
+	if err != nil {
+		return err
+	}
+	sh.records[string(record.EvidenceID)] = record
+
+	return nil
+}
+
+// Has returns true if a slashing record exists for the provided evidence identifier
+func (sh *slashingHistory) Has(evidenceID []byte) bool {
+	sh.mutRecords.RLock()
+	_, found := sh.records[string(evidenceID)]
+	sh.mutRecords.RUnlock()
+
+	return found
+}
+
+// GetRecords returns all the slashing records, in the order of the metachain blocks which applied them
+func (sh *slashingHistory) GetRecords() []*SlashingRecord {
+	sh.mutRecords.RLock()
+	records := make([]*SlashingRecord, 0, len(sh.records))
+	for _, record := range sh.records {
+		records = append(records, record)
+	}
+	sh.mutRecords.RUnlock()
+
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].MetaNonce == records[j].MetaNonce {
+			return bytes.Compare(records[i].EvidenceID, records[j].EvidenceID) < 0
+		}
+		return records[i].MetaNonce < records[j].MetaNonce
+	})
+
+	return records
+}
+
+// Close closes the underlying storer
+func (sh *slashingHistory) Close() error {
+	return sh.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sh *slashingHistory) IsInterfaceNil() bool {
+	return sh == nil
+}
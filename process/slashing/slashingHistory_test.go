@@ -0,0 +1,106 @@
+package slashing
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSlashingHistory() ArgsSlashingHistory {
+	return ArgsSlashingHistory{
+		Storer:      genericmocks.NewStorerMock("slashing", 0),
+		Marshalizer: &mock.MarshalizerMock{},
+	}
+}
+
+func createSlashingRecord(evidenceID string, metaNonce uint64) *SlashingRecord {
+	return &SlashingRecord{
+		EvidenceID: []byte(evidenceID),
+		PublicKey:  []byte("pubKey"),
+		Reason:     EquivocationReason,
+		SlashValue: big.NewInt(100),
+		MetaNonce:  metaNonce,
+	}
+}
+
+func TestNewSlashingHistory_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingHistory()
+	args.Storer = nil
+
+	sh, err := NewSlashingHistory(args)
+	assert.True(t, check.IfNil(sh))
+	assert.True(t, errors.Is(err, process.ErrNilStorage))
+}
+
+func TestNewSlashingHistory_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingHistory()
+	args.Marshalizer = nil
+
+	sh, err := NewSlashingHistory(args)
+	assert.True(t, check.IfNil(sh))
+	assert.True(t, errors.Is(err, process.ErrNilMarshalizer))
+}
+
+func TestSlashingHistory_AddInvalidRecordShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sh, _ := NewSlashingHistory(createMockArgsSlashingHistory())
+
+	err := sh.Add(nil)
+	assert.Equal(t, process.ErrNilSlashingRecord, err)
+
+	err = sh.Add(&SlashingRecord{})
+	assert.Equal(t, process.ErrEmptyEvidenceID, err)
+}
+
+func TestSlashingHistory_AddShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sh, err := NewSlashingHistory(createMockArgsSlashingHistory())
+	require.Nil(t, err)
+	assert.False(t, sh.Has([]byte("evidence")))
+
+	err = sh.Add(createSlashingRecord("evidence", 1))
+	assert.Nil(t, err)
+	assert.True(t, sh.Has([]byte("evidence")))
+}
+
+func TestSlashingHistory_GetRecordsShouldBeSortedByMetaNonce(t *testing.T) {
+	t.Parallel()
+
+	sh, _ := NewSlashingHistory(createMockArgsSlashingHistory())
+	_ = sh.Add(createSlashingRecord("evidence3", 3))
+	_ = sh.Add(createSlashingRecord("evidence1b", 1))
+	_ = sh.Add(createSlashingRecord("evidence1a", 1))
+
+	records := sh.GetRecords()
+	require.Equal(t, 3, len(records))
+	assert.Equal(t, []byte("evidence1a"), records[0].EvidenceID)
+	assert.Equal(t, []byte("evidence1b"), records[1].EvidenceID)
+	assert.Equal(t, []byte("evidence3"), records[2].EvidenceID)
+}
+
+func TestSlashingHistory_ShouldLoadRecordsFromStorage(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingHistory()
+	sh, _ := NewSlashingHistory(args)
+	record := createSlashingRecord("evidence", 7)
+	_ = sh.Add(record)
+
+	reloaded, err := NewSlashingHistory(args)
+	require.Nil(t, err)
+	assert.True(t, reloaded.Has([]byte("evidence")))
+	assert.Equal(t, []*SlashingRecord{record}, reloaded.GetRecords())
+}
@@ -0,0 +1,356 @@
+package slashing
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.SlashingHandler = (*slashingProcessor)(nil)
+
+var log = logger.GetOrCreate("process/slashing")
+
+// MaxNumProofsPerBlock defines the maximum number of equivocation proofs which can be included in a metachain block
+const MaxNumProofsPerBlock = 10
+
+// EquivocationReason is the slashing reason used for the leaders which signed different headers in the same round
+const EquivocationReason = "equivocation"
+
+// SlashEventIdentifier is the identifier of the log entry saved for each final slashing
+const SlashEventIdentifier = "slash"
+
+// ArgsSlashingProcessor holds the slashing processor arguments
+type ArgsSlashingProcessor struct {
+	Marshalizer         marshal.Marshalizer
+	ProofsProvider      process.EquivocationProofsProvider
+	ProofVerifier       process.EquivocationProofVerifier
+	Slasher             process.EquivocationProofsSlasher
+	History             SlashingHistoryHandler
+	TxLogsProcessor     process.TransactionLogProcessor
+	EpochNotifier       process.EpochNotifier
+	SlashValue          *big.Int
+	SlashingEnableEpoch uint32
+}
+
+type slashingProcessor struct {
+	marshalizer         marshal.Marshalizer
+	proofsProvider      process.EquivocationProofsProvider
+	proofVerifier       process.EquivocationProofVerifier
+	slasher             process.EquivocationProofsSlasher
+	history             SlashingHistoryHandler
+	txLogsProcessor     process.TransactionLogProcessor
+	slashValue          *big.Int
+	slashingEnableEpoch uint32
+	flagSlashing        atomic.Flag
+
+	mutPendingRecords sync.Mutex
+	pendingRecords    map[uint64][]*SlashingRecord
+}
+
+// NewSlashingProcessor creates the component which includes the verified equivocation proofs in the metachain
+// blocks and slashes the leaders which signed different headers in the same round. Validators signing
+// conflicting blocks as consensus members, other than the leader, are not covered.
+func NewSlashingProcessor(args ArgsSlashingProcessor) (*slashingProcessor, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.ProofsProvider) {
+		return nil, process.ErrNilEquivocationProofsProvider
+	}
+	if check.IfNil(args.ProofVerifier) {
+		return nil, process.ErrNilEquivocationProofVerifier
+	}
+	if check.IfNil(args.Slasher) {
+		return nil, process.ErrNilEquivocationProofsSlasher
+	}
+	if check.IfNil(args.History) {
+		return nil, process.ErrNilSlashingHistory
+	}
+	if check.IfNil(args.TxLogsProcessor) {
+		return nil, process.ErrNilTxLogsProcessor
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+	if args.SlashValue == nil || args.SlashValue.Sign() <= 0 {
+		return nil, process.ErrInvalidSlashValue
+	}
+
+	sp := &slashingProcessor{
+		marshalizer:         args.Marshalizer,
+		proofsProvider:      args.ProofsProvider,
+		proofVerifier:       args.ProofVerifier,
+		slasher:             args.Slasher,
+		history:             args.History,
+		txLogsProcessor:     args.TxLogsProcessor,
+		slashValue:          big.NewInt(0).Set(args.SlashValue),
+		slashingEnableEpoch: args.SlashingEnableEpoch,
+		pendingRecords:      make(map[uint64][]*SlashingRecord),
+	}
+	args.EpochNotifier.RegisterNotifyHandler(sp)
+
+	return sp, nil
+}
+
+// CreateSlashingPeerData slashes the leaders proven to misbehave by the equivocation proofs which were not yet
+// applied and returns the peer data which should be included in the metachain block being created
+func (sp *slashingProcessor) CreateSlashingPeerData() ([]block.PeerData, error) {
+	if !sp.flagSlashing.IsSet() {
+		return nil, nil
+	}
+
+	candidates := sp.getCandidateProofs()
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	appliedProofs, err := sp.slasher.ProcessEquivocationProofs(candidates, sp.slashValue)
+	if err != nil {
+		return nil, err
+	}
+
+	peerData := make([]block.PeerData, 0, len(appliedProofs))
+	for _, proof := range appliedProofs {
+		evidence, errMarshal := sp.marshalizer.Marshal(proof)
+		if errMarshal != nil {
+			return nil, errMarshal
+		}
+
+		peerData = append(peerData, block.PeerData{
+			PublicKey:   proof.PubKey,
+			Action:      block.PeerSlashed,
+			ValueChange: big.NewInt(0).Set(sp.slashValue),
+			Evidence:    evidence,
+		})
+	}
+
+	return peerData, nil
+}
+
+func (sp *slashingProcessor) getCandidateProofs() []*consensus.EquivocationProof {
+	proofs := sp.proofsProvider.GetEquivocationProofs()
+	candidates := make([]*consensus.EquivocationProof, 0, MaxNumProofsPerBlock)
+	for _, proof := range proofs {
+		if len(candidates) >= MaxNumProofsPerBlock {
+			break
+		}
+		// the staking SC keeps the processed evidence, so it is the only source used to skip the applied proofs
+		if proof == nil || sp.slasher.IsEquivocationProofApplied(proof.ID()) {
+			continue
+		}
+
+		// the proof is verified again as it might have been built for an epoch the metachain can not validate anymore
+		err := sp.proofVerifier.Verify(proof)
+		if err != nil {
+			log.Debug("slashingProcessor.getCandidateProofs: equivocation proof not verified",
+				"public key", proof.PubKey,
+				"shard", proof.ShardID,
+				"round", proof.Round,
+				"error", err.Error())
+			continue
+		}
+
+		candidates = append(candidates, proof)
+	}
+
+	return candidates
+}
+
+// ProcessSlashingPeerData verifies the slashing evidence included in a metachain block and slashes the leaders
+// proven to misbehave. All the evidence should be applied, otherwise the block is not valid.
+func (sp *slashingProcessor) ProcessSlashingPeerData(peerData []block.PeerData) error {
+	if len(peerData) == 0 {
+		return nil
+	}
+	if !sp.flagSlashing.IsSet() {
+		return process.ErrSlashingNotEnabled
+	}
+	if len(peerData) > MaxNumProofsPerBlock {
+		return fmt.Errorf("%w: too many proofs, maximum %d, got %d",
+			process.ErrInvalidSlashingEvidence, MaxNumProofsPerBlock, len(peerData))
+	}
+
+	proofs := make([]*consensus.EquivocationProof, 0, len(peerData))
+	for i := range peerData {
+		proof, err := sp.getVerifiedProof(&peerData[i])
+		if err != nil {
+			return err
+		}
+
+		proofs = append(proofs, proof)
+	}
+
+	appliedProofs, err := sp.slasher.ProcessEquivocationProofs(proofs, sp.slashValue)
+	if err != nil {
+		return err
+	}
+	if len(appliedProofs) != len(proofs) {
+		return fmt.Errorf("%w: only %d out of %d proofs were applied",
+			process.ErrInvalidSlashingEvidence, len(appliedProofs), len(proofs))
+	}
+
+	return nil
+}
+
+func (sp *slashingProcessor) getVerifiedProof(peerData *block.PeerData) (*consensus.EquivocationProof, error) {
+	if peerData.Action != block.PeerSlashed {
+		return nil, fmt.Errorf("%w: wrong peer action %s", process.ErrInvalidSlashingEvidence, peerData.Action.String())
+	}
+	if peerData.ValueChange == nil || peerData.ValueChange.Cmp(sp.slashValue) != 0 {
+		return nil, fmt.Errorf("%w: wrong slash value", process.ErrInvalidSlashingEvidence)
+	}
+
+	proof := &consensus.EquivocationProof{}
+	err := sp.marshalizer.Unmarshal(proof, peerData.Evidence)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", process.ErrInvalidSlashingEvidence, err.Error())
+	}
+	if !bytes.Equal(proof.PubKey, peerData.PublicKey) {
+		return nil, fmt.Errorf("%w: public key mismatch", process.ErrInvalidSlashingEvidence)
+	}
+
+	err = sp.proofVerifier.Verify(proof)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", process.ErrInvalidSlashingEvidence, err.Error())
+	}
+
+	return proof, nil
+}
+
+// SaveSlashingHistory keeps the slashing applied by the provided committed metachain block until the block becomes
+// final. The records of the blocks with the same or higher nonce were rolled back, so they are dropped. The records
+// of the final blocks are saved in the slashing history and a slash log entry is saved for each of them.
+func (sp *slashingProcessor) SaveSlashingHistory(header *block.MetaBlock, headerHash []byte, highestFinalBlockNonce uint64) {
+	if header == nil {
+		return
+	}
+
+	sp.mutPendingRecords.Lock()
+	defer sp.mutPendingRecords.Unlock()
+
+	for nonce := range sp.pendingRecords {
+		if nonce >= header.Nonce {
+			delete(sp.pendingRecords, nonce)
+		}
+	}
+
+	records := sp.createSlashingRecords(header, headerHash)
+	if len(records) > 0 {
+		sp.pendingRecords[header.Nonce] = records
+	}
+
+	sp.saveFinalRecords(highestFinalBlockNonce)
+}
+
+func (sp *slashingProcessor) createSlashingRecords(header *block.MetaBlock, headerHash []byte) []*SlashingRecord {
+	records := make([]*SlashingRecord, 0)
+	for i := range header.PeerInfo {
+		peerData := &header.PeerInfo[i]
+		if peerData.Action != block.PeerSlashed {
+			continue
+		}
+
+		proof := &consensus.EquivocationProof{}
+		err := sp.marshalizer.Unmarshal(proof, peerData.Evidence)
+		if err != nil {
+			log.Debug("slashingProcessor.createSlashingRecords: unmarshal", "error", err.Error())
+			continue
+		}
+
+		records = append(records, &SlashingRecord{
+			EvidenceID:     proof.ID(),
+			PublicKey:      proof.PubKey,
+			Reason:         EquivocationReason,
+			ShardID:        proof.ShardID,
+			Round:          proof.Round,
+			SlashValue:     big.NewInt(0).Set(peerData.ValueChange),
+			MetaEpoch:      header.Epoch,
+			MetaNonce:      header.Nonce,
+			MetaHeaderHash: headerHash,
+		})
+	}
+
+	return records
+}
+
+func (sp *slashingProcessor) saveFinalRecords(highestFinalBlockNonce uint64) {
+	finalNonces := make([]uint64, 0)
+	for nonce := range sp.pendingRecords {
+		if nonce <= highestFinalBlockNonce {
+			finalNonces = append(finalNonces, nonce)
+		}
+	}
+	sort.Slice(finalNonces, func(i, j int) bool {
+		return finalNonces[i] < finalNonces[j]
+	})
+
+	for _, nonce := range finalNonces {
+		for _, record := range sp.pendingRecords[nonce] {
+			sp.saveFinalRecord(record)
+		}
+		delete(sp.pendingRecords, nonce)
+	}
+}
+
+func (sp *slashingProcessor) saveFinalRecord(record *SlashingRecord) {
+	err := sp.history.Add(record)
+	if err != nil {
+		log.Warn("slashingProcessor.saveFinalRecord: can not save slashing record", "error", err.Error())
+	}
+
+	err = sp.saveSlashLog(record)
+	if err != nil {
+		log.Warn("slashingProcessor.saveFinalRecord: can not save slash log", "error", err.Error())
+	}
+
+	log.Info("validator slashed",
+		"public key", record.PublicKey,
+		"reason", record.Reason,
+		"shard", record.ShardID,
+		"round", record.Round,
+		"slash value", record.SlashValue,
+		"meta nonce", record.MetaNonce,
+		"meta hash", record.MetaHeaderHash)
+}
+
+// the slashing is executed by the protocol on the staking SC, so the log is saved for the evidence identifier
+func (sp *slashingProcessor) saveSlashLog(record *SlashingRecord) error {
+	slashTx := &smartContractResult.SmartContractResult{
+		Value:   big.NewInt(0),
+		RcvAddr: vm.StakingSCAddress,
+		SndAddr: vm.EndOfEpochAddress,
+		Data:    []byte(SlashEventIdentifier),
+	}
+	logEntry := &vmcommon.LogEntry{
+		Identifier: []byte(SlashEventIdentifier),
+		Address:    vm.StakingSCAddress,
+		Topics:     [][]byte{record.PublicKey, record.SlashValue.Bytes(), []byte(record.Reason)},
+		Data:       record.MetaHeaderHash,
+	}
+
+	return sp.txLogsProcessor.SaveLog(record.EvidenceID, slashTx, []*vmcommon.LogEntry{logEntry})
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (sp *slashingProcessor) EpochConfirmed(epoch uint32) {
+	sp.flagSlashing.Toggle(epoch >= sp.slashingEnableEpoch)
+	log.Debug("slashingProcessor: slashing", "enabled", sp.flagSlashing.IsSet())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *slashingProcessor) IsInterfaceNil() bool {
+	return sp == nil
+}
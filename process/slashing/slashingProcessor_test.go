@@ -0,0 +1,448 @@
+package slashing
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data"
+	"github.com/ElrondNetwork/elrond-go/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSlashingProcessor() ArgsSlashingProcessor {
+	history, _ := NewSlashingHistory(createMockArgsSlashingHistory())
+
+	return ArgsSlashingProcessor{
+		Marshalizer:         &mock.MarshalizerMock{},
+		ProofsProvider:      &mock.EquivocationProofsProviderStub{},
+		ProofVerifier:       &mock.EquivocationProofVerifierStub{},
+		Slasher:             &mock.EquivocationProofsSlasherStub{},
+		History:             history,
+		TxLogsProcessor:     &mock.TxLogsProcessorStub{},
+		EpochNotifier:       &mock.EpochNotifierStub{},
+		SlashValue:          big.NewInt(1000),
+		SlashingEnableEpoch: 0,
+	}
+}
+
+func createEquivocationProof(pubKey string, round uint64) *consensus.EquivocationProof {
+	return &consensus.EquivocationProof{
+		PubKey:       []byte(pubKey),
+		ShardID:      1,
+		Round:        round,
+		FirstHeader:  []byte("first header"),
+		SecondHeader: []byte("second header"),
+	}
+}
+
+func TestNewSlashingProcessor_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingProcessor()
+	args.Marshalizer = nil
+	sp, err := NewSlashingProcessor(args)
+	assert.True(t, check.IfNil(sp))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	args = createMockArgsSlashingProcessor()
+	args.ProofsProvider = nil
+	sp, err = NewSlashingProcessor(args)
+	assert.True(t, check.IfNil(sp))
+	assert.Equal(t, process.ErrNilEquivocationProofsProvider, err)
+
+	args = createMockArgsSlashingProcessor()
+	args.ProofVerifier = nil
+	sp, err = NewSlashingProcessor(args)
+	assert.True(t, check.IfNil(sp))
+	assert.Equal(t, process.ErrNilEquivocationProofVerifier, err)
+
+	args = createMockArgsSlashingProcessor()
+	args.Slasher = nil
+	sp, err = NewSlashingProcessor(args)
+	assert.True(t, check.IfNil(sp))
+	assert.Equal(t, process.ErrNilEquivocationProofsSlasher, err)
+
+	args = createMockArgsSlashingProcessor()
+	args.History = nil
+	sp, err = NewSlashingProcessor(args)
+	assert.True(t, check.IfNil(sp))
+	assert.Equal(t, process.ErrNilSlashingHistory, err)
+
+	args = createMockArgsSlashingProcessor()
+	args.TxLogsProcessor = nil
+	sp, err = NewSlashingProcessor(args)
+	assert.True(t, check.IfNil(sp))
+	assert.Equal(t, process.ErrNilTxLogsProcessor, err)
+
+	args = createMockArgsSlashingProcessor()
+	args.EpochNotifier = nil
+	sp, err = NewSlashingProcessor(args)
+	assert.True(t, check.IfNil(sp))
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+}
+
+func TestNewSlashingProcessor_InvalidSlashValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingProcessor()
+	args.SlashValue = nil
+	sp, err := NewSlashingProcessor(args)
+	assert.True(t, check.IfNil(sp))
+	assert.Equal(t, process.ErrInvalidSlashValue, err)
+
+	args.SlashValue = big.NewInt(0)
+	sp, err = NewSlashingProcessor(args)
+	assert.True(t, check.IfNil(sp))
+	assert.Equal(t, process.ErrInvalidSlashValue, err)
+}
+
+func TestNewSlashingProcessor_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sp, err := NewSlashingProcessor(createMockArgsSlashingProcessor())
+	assert.False(t, check.IfNil(sp))
+	assert.Nil(t, err)
+	assert.True(t, sp.flagSlashing.IsSet())
+}
+
+func TestSlashingProcessor_CreateSlashingPeerDataFlagNotSetShouldNotCreate(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingProcessor()
+	args.SlashingEnableEpoch = 1
+	args.ProofsProvider = &mock.EquivocationProofsProviderStub{
+		GetEquivocationProofsCalled: func() []*consensus.EquivocationProof {
+			assert.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+	sp, _ := NewSlashingProcessor(args)
+
+	peerData, err := sp.CreateSlashingPeerData()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(peerData))
+}
+
+func TestSlashingProcessor_CreateSlashingPeerDataShouldSkipKnownAndUnverifiedProofs(t *testing.T) {
+	t.Parallel()
+
+	known := createEquivocationProof("known", 1)
+	unverified := createEquivocationProof("unverified", 2)
+	valid := createEquivocationProof("valid", 3)
+
+	args := createMockArgsSlashingProcessor()
+	// a local slashing record should not prevent including the proof, only the staking SC evidence should
+	_ = args.History.Add(&SlashingRecord{EvidenceID: valid.ID()})
+	args.ProofsProvider = &mock.EquivocationProofsProviderStub{
+		GetEquivocationProofsCalled: func() []*consensus.EquivocationProof {
+			return []*consensus.EquivocationProof{known, nil, unverified, valid}
+		},
+	}
+	args.ProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyCalled: func(proof *consensus.EquivocationProof) error {
+			if proof == unverified {
+				return errors.New("invalid signature")
+			}
+			return nil
+		},
+	}
+	var slashedProofs []*consensus.EquivocationProof
+	args.Slasher = &mock.EquivocationProofsSlasherStub{
+		ProcessEquivocationProofsCalled: func(proofs []*consensus.EquivocationProof, slashValue *big.Int) ([]*consensus.EquivocationProof, error) {
+			slashedProofs = proofs
+			assert.Equal(t, args.SlashValue, slashValue)
+			return proofs, nil
+		},
+		IsEquivocationProofAppliedCalled: func(evidenceID []byte) bool {
+			return bytes.Equal(evidenceID, known.ID())
+		},
+	}
+	sp, _ := NewSlashingProcessor(args)
+
+	peerData, err := sp.CreateSlashingPeerData()
+	require.Nil(t, err)
+	assert.Equal(t, []*consensus.EquivocationProof{valid}, slashedProofs)
+	require.Equal(t, 1, len(peerData))
+	assert.Equal(t, valid.PubKey, peerData[0].PublicKey)
+	assert.Equal(t, block.PeerSlashed, peerData[0].Action)
+	assert.Equal(t, args.SlashValue, peerData[0].ValueChange)
+
+	proof := &consensus.EquivocationProof{}
+	err = args.Marshalizer.Unmarshal(proof, peerData[0].Evidence)
+	assert.Nil(t, err)
+	assert.Equal(t, valid, proof)
+}
+
+func TestSlashingProcessor_CreateSlashingPeerDataShouldLimitTheNumberOfProofs(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingProcessor()
+	args.ProofsProvider = &mock.EquivocationProofsProviderStub{
+		GetEquivocationProofsCalled: func() []*consensus.EquivocationProof {
+			proofs := make([]*consensus.EquivocationProof, 0)
+			for i := 0; i < MaxNumProofsPerBlock+5; i++ {
+				proofs = append(proofs, createEquivocationProof("pubKey", uint64(i)))
+			}
+			return proofs
+		},
+	}
+	sp, _ := NewSlashingProcessor(args)
+
+	peerData, err := sp.CreateSlashingPeerData()
+	assert.Nil(t, err)
+	assert.Equal(t, MaxNumProofsPerBlock, len(peerData))
+}
+
+func TestSlashingProcessor_CreateSlashingPeerDataShouldIncludeOnlyAppliedProofs(t *testing.T) {
+	t.Parallel()
+
+	first := createEquivocationProof("first", 1)
+	second := createEquivocationProof("second", 1)
+
+	args := createMockArgsSlashingProcessor()
+	args.ProofsProvider = &mock.EquivocationProofsProviderStub{
+		GetEquivocationProofsCalled: func() []*consensus.EquivocationProof {
+			return []*consensus.EquivocationProof{first, second}
+		},
+	}
+	args.Slasher = &mock.EquivocationProofsSlasherStub{
+		ProcessEquivocationProofsCalled: func(proofs []*consensus.EquivocationProof, slashValue *big.Int) ([]*consensus.EquivocationProof, error) {
+			return []*consensus.EquivocationProof{second}, nil
+		},
+	}
+	sp, _ := NewSlashingProcessor(args)
+
+	peerData, err := sp.CreateSlashingPeerData()
+	assert.Nil(t, err)
+	require.Equal(t, 1, len(peerData))
+	assert.Equal(t, second.PubKey, peerData[0].PublicKey)
+}
+
+func TestSlashingProcessor_ProcessSlashingPeerDataEmptyShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingProcessor()
+	args.SlashingEnableEpoch = 1
+	sp, _ := NewSlashingProcessor(args)
+
+	err := sp.ProcessSlashingPeerData(nil)
+	assert.Nil(t, err)
+}
+
+func TestSlashingProcessor_ProcessSlashingPeerDataFlagNotSetShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingProcessor()
+	args.SlashingEnableEpoch = 1
+	sp, _ := NewSlashingProcessor(args)
+
+	err := sp.ProcessSlashingPeerData(make([]block.PeerData, 1))
+	assert.Equal(t, process.ErrSlashingNotEnabled, err)
+}
+
+func TestSlashingProcessor_ProcessSlashingPeerDataInvalidEvidenceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingProcessor()
+	sp, _ := NewSlashingProcessor(args)
+
+	proof := createEquivocationProof("pubKey", 1)
+	evidence, _ := args.Marshalizer.Marshal(proof)
+	createPeerData := func() []block.PeerData {
+		return []block.PeerData{{
+			PublicKey:   proof.PubKey,
+			Action:      block.PeerSlashed,
+			ValueChange: big.NewInt(0).Set(args.SlashValue),
+			Evidence:    evidence,
+		}}
+	}
+
+	err := sp.ProcessSlashingPeerData(make([]block.PeerData, MaxNumProofsPerBlock+1))
+	assert.True(t, errors.Is(err, process.ErrInvalidSlashingEvidence))
+
+	peerData := createPeerData()
+	peerData[0].Action = block.PeerJailed
+	err = sp.ProcessSlashingPeerData(peerData)
+	assert.True(t, errors.Is(err, process.ErrInvalidSlashingEvidence))
+
+	peerData = createPeerData()
+	peerData[0].ValueChange = big.NewInt(1)
+	err = sp.ProcessSlashingPeerData(peerData)
+	assert.True(t, errors.Is(err, process.ErrInvalidSlashingEvidence))
+
+	peerData = createPeerData()
+	peerData[0].Evidence = []byte("invalid evidence")
+	err = sp.ProcessSlashingPeerData(peerData)
+	assert.True(t, errors.Is(err, process.ErrInvalidSlashingEvidence))
+
+	peerData = createPeerData()
+	peerData[0].PublicKey = []byte("another pubKey")
+	err = sp.ProcessSlashingPeerData(peerData)
+	assert.True(t, errors.Is(err, process.ErrInvalidSlashingEvidence))
+}
+
+func TestSlashingProcessor_ProcessSlashingPeerDataUnverifiedProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingProcessor()
+	args.ProofVerifier = &mock.EquivocationProofVerifierStub{
+		VerifyCalled: func(proof *consensus.EquivocationProof) error {
+			return errors.New("invalid signature")
+		},
+	}
+	args.Slasher = &mock.EquivocationProofsSlasherStub{
+		ProcessEquivocationProofsCalled: func(proofs []*consensus.EquivocationProof, slashValue *big.Int) ([]*consensus.EquivocationProof, error) {
+			assert.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+	sp, _ := NewSlashingProcessor(args)
+
+	proof := createEquivocationProof("pubKey", 1)
+	evidence, _ := args.Marshalizer.Marshal(proof)
+	peerData := []block.PeerData{{
+		PublicKey:   proof.PubKey,
+		Action:      block.PeerSlashed,
+		ValueChange: big.NewInt(0).Set(args.SlashValue),
+		Evidence:    evidence,
+	}}
+
+	err := sp.ProcessSlashingPeerData(peerData)
+	assert.True(t, errors.Is(err, process.ErrInvalidSlashingEvidence))
+}
+
+func TestSlashingProcessor_ProcessSlashingPeerDataNotAllProofsAppliedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingProcessor()
+	args.Slasher = &mock.EquivocationProofsSlasherStub{
+		ProcessEquivocationProofsCalled: func(proofs []*consensus.EquivocationProof, slashValue *big.Int) ([]*consensus.EquivocationProof, error) {
+			return nil, nil
+		},
+	}
+	sp, _ := NewSlashingProcessor(args)
+
+	proof := createEquivocationProof("pubKey", 1)
+	evidence, _ := args.Marshalizer.Marshal(proof)
+	peerData := []block.PeerData{{
+		PublicKey:   proof.PubKey,
+		Action:      block.PeerSlashed,
+		ValueChange: big.NewInt(0).Set(args.SlashValue),
+		Evidence:    evidence,
+	}}
+
+	err := sp.ProcessSlashingPeerData(peerData)
+	assert.True(t, errors.Is(err, process.ErrInvalidSlashingEvidence))
+}
+
+func TestSlashingProcessor_ProcessSlashingPeerDataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	proof := createEquivocationProof("pubKey", 1)
+	args := createMockArgsSlashingProcessor()
+	args.Slasher = &mock.EquivocationProofsSlasherStub{
+		ProcessEquivocationProofsCalled: func(proofs []*consensus.EquivocationProof, slashValue *big.Int) ([]*consensus.EquivocationProof, error) {
+			assert.Equal(t, []*consensus.EquivocationProof{proof}, proofs)
+			return proofs, nil
+		},
+	}
+	sp, _ := NewSlashingProcessor(args)
+
+	evidence, _ := args.Marshalizer.Marshal(proof)
+	peerData := []block.PeerData{{
+		PublicKey:   proof.PubKey,
+		Action:      block.PeerSlashed,
+		ValueChange: big.NewInt(0).Set(args.SlashValue),
+		Evidence:    evidence,
+	}}
+
+	err := sp.ProcessSlashingPeerData(peerData)
+	assert.Nil(t, err)
+}
+
+func createMetaBlockWithSlashing(t *testing.T, args ArgsSlashingProcessor, nonce uint64, pubKey string) (*block.MetaBlock, *consensus.EquivocationProof) {
+	proof := createEquivocationProof(pubKey, nonce)
+	evidence, err := args.Marshalizer.Marshal(proof)
+	require.Nil(t, err)
+
+	header := &block.MetaBlock{
+		Nonce: nonce,
+		Epoch: 2,
+		PeerInfo: []block.PeerData{
+			{
+				PublicKey:   proof.PubKey,
+				Action:      block.PeerSlashed,
+				ValueChange: big.NewInt(0).Set(args.SlashValue),
+				Evidence:    evidence,
+			},
+		},
+	}
+
+	return header, proof
+}
+
+func TestSlashingProcessor_SaveSlashingHistoryShouldAddRecordsOfFinalBlocks(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingProcessor()
+	savedLogs := make(map[string][]*vmcommon.LogEntry)
+	args.TxLogsProcessor = &mock.TxLogsProcessorStub{
+		SaveLogCalled: func(txHash []byte, tx data.TransactionHandler, vmLogs []*vmcommon.LogEntry) error {
+			savedLogs[string(txHash)] = vmLogs
+			return nil
+		},
+	}
+	sp, _ := NewSlashingProcessor(args)
+
+	header, proof := createMetaBlockWithSlashing(t, args, 5, "pubKey")
+	sp.SaveSlashingHistory(header, []byte("meta hash"), 4)
+	assert.Equal(t, 0, len(args.History.GetRecords()))
+	assert.Equal(t, 0, len(savedLogs))
+
+	sp.SaveSlashingHistory(&block.MetaBlock{Nonce: 6}, []byte("next meta hash"), 5)
+
+	records := args.History.GetRecords()
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, &SlashingRecord{
+		EvidenceID:     proof.ID(),
+		PublicKey:      proof.PubKey,
+		Reason:         EquivocationReason,
+		ShardID:        proof.ShardID,
+		Round:          proof.Round,
+		SlashValue:     args.SlashValue,
+		MetaEpoch:      2,
+		MetaNonce:      5,
+		MetaHeaderHash: []byte("meta hash"),
+	}, records[0])
+
+	require.Equal(t, 1, len(savedLogs[string(proof.ID())]))
+	logEntry := savedLogs[string(proof.ID())][0]
+	assert.Equal(t, []byte(SlashEventIdentifier), logEntry.Identifier)
+	assert.Equal(t, [][]byte{proof.PubKey, args.SlashValue.Bytes(), []byte(EquivocationReason)}, logEntry.Topics)
+}
+
+func TestSlashingProcessor_SaveSlashingHistoryShouldDropTheRecordsOfRolledBackBlocks(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingProcessor()
+	sp, _ := NewSlashingProcessor(args)
+
+	header, _ := createMetaBlockWithSlashing(t, args, 5, "pubKey")
+	sp.SaveSlashingHistory(header, []byte("meta hash"), 4)
+	header, _ = createMetaBlockWithSlashing(t, args, 6, "another pubKey")
+	sp.SaveSlashingHistory(header, []byte("next meta hash"), 4)
+
+	// blocks 5 and 6 were rolled back and replaced by a block without slashing
+	sp.SaveSlashingHistory(&block.MetaBlock{Nonce: 5}, []byte("fork meta hash"), 4)
+	sp.SaveSlashingHistory(&block.MetaBlock{Nonce: 6}, []byte("fork next meta hash"), 6)
+
+	assert.Equal(t, 0, len(args.History.GetRecords()))
+}
@@ -0,0 +1,797 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: slashingRecord.proto
+
+package slashing
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_ElrondNetwork_elrond_go_data "github.com/ElrondNetwork/elrond-go/data"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SlashingRecord holds a slashing applied by a metachain block, together with the misbehaviour which caused it
+type SlashingRecord struct {
+	EvidenceID     []byte        `protobuf:"bytes,1,opt,name=EvidenceID,proto3" json:"EvidenceID,omitempty"`
+	PublicKey      []byte        `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	Reason         string        `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	ShardID        uint32        `protobuf:"varint,4,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	Round          uint64        `protobuf:"varint,5,opt,name=Round,proto3" json:"Round,omitempty"`
+	SlashValue     *math_big.Int `protobuf:"bytes,6,opt,name=SlashValue,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"SlashValue,omitempty"`
+	MetaEpoch      uint32        `protobuf:"varint,7,opt,name=MetaEpoch,proto3" json:"MetaEpoch,omitempty"`
+	MetaNonce      uint64        `protobuf:"varint,8,opt,name=MetaNonce,proto3" json:"MetaNonce,omitempty"`
+	MetaHeaderHash []byte        `protobuf:"bytes,9,opt,name=MetaHeaderHash,proto3" json:"MetaHeaderHash,omitempty"`
+}
+
+func (m *SlashingRecord) Reset()      { *m = SlashingRecord{} }
+func (*SlashingRecord) ProtoMessage() {}
+func (*SlashingRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a464977c7d41f83e, []int{0}
+}
+func (m *SlashingRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashingRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SlashingRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashingRecord.Merge(m, src)
+}
+func (m *SlashingRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashingRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashingRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashingRecord proto.InternalMessageInfo
+
+func (m *SlashingRecord) GetEvidenceID() []byte {
+	if m != nil {
+		return m.EvidenceID
+	}
+	return nil
+}
+
+func (m *SlashingRecord) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *SlashingRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SlashingRecord) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *SlashingRecord) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *SlashingRecord) GetSlashValue() *math_big.Int {
+	if m != nil {
+		return m.SlashValue
+	}
+	return nil
+}
+
+func (m *SlashingRecord) GetMetaEpoch() uint32 {
+	if m != nil {
+		return m.MetaEpoch
+	}
+	return 0
+}
+
+func (m *SlashingRecord) GetMetaNonce() uint64 {
+	if m != nil {
+		return m.MetaNonce
+	}
+	return 0
+}
+
+func (m *SlashingRecord) GetMetaHeaderHash() []byte {
+	if m != nil {
+		return m.MetaHeaderHash
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SlashingRecord)(nil), "proto.SlashingRecord")
+}
+
+func init() { proto.RegisterFile("slashingRecord.proto", fileDescriptor_a464977c7d41f83e) }
+
+var fileDescriptor_a464977c7d41f83e = []byte{
+	// 370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x3f, 0x6e, 0xdb, 0x30,
+	0x14, 0x87, 0x45, 0xd7, 0x7f, 0x89, 0xd6, 0x03, 0x61, 0x14, 0x44, 0x51, 0xb0, 0x42, 0x87, 0x42,
+	0x8b, 0xa5, 0xa1, 0x63, 0xa7, 0xaa, 0x16, 0x60, 0xa1, 0xa8, 0x51, 0xd0, 0x40, 0x87, 0x6e, 0x94,
+	0xc4, 0x48, 0x42, 0x64, 0xd2, 0x90, 0xa8, 0x04, 0xd9, 0x72, 0x84, 0x1c, 0x23, 0xc8, 0x49, 0x32,
+	0x7a, 0xf4, 0x96, 0x88, 0x5e, 0x32, 0xfa, 0x08, 0x81, 0xe9, 0x18, 0x76, 0x32, 0x49, 0xdf, 0xf7,
+	0xc0, 0xc7, 0xdf, 0xe3, 0x83, 0xa3, 0xaa, 0x60, 0x55, 0x96, 0x8b, 0x94, 0xf2, 0x58, 0x96, 0x89,
+	0xbb, 0x2c, 0xa5, 0x92, 0xa8, 0x63, 0x3e, 0x9f, 0xc6, 0x69, 0xae, 0xb2, 0x3a, 0x72, 0x63, 0xb9,
+	0xf0, 0x52, 0x99, 0x4a, 0xcf, 0xe8, 0xa8, 0x3e, 0x33, 0x64, 0xc0, 0xfc, 0xed, 0x4f, 0x7d, 0x6d,
+	0x5a, 0x70, 0x38, 0x7f, 0xd5, 0x0e, 0x11, 0x08, 0x83, 0x8b, 0x3c, 0xe1, 0x22, 0xe6, 0xe1, 0x04,
+	0x03, 0x1b, 0x38, 0xef, 0xe9, 0x89, 0x41, 0x9f, 0xe1, 0xe0, 0x6f, 0x1d, 0x15, 0x79, 0xfc, 0x9b,
+	0x5f, 0xe1, 0x96, 0x29, 0x1f, 0x05, 0xfa, 0x08, 0xbb, 0x94, 0xb3, 0x4a, 0x0a, 0xfc, 0xce, 0x06,
+	0xce, 0x80, 0xbe, 0x10, 0xc2, 0xb0, 0x37, 0xcf, 0x58, 0x99, 0x84, 0x13, 0xdc, 0xb6, 0x81, 0xf3,
+	0x81, 0x1e, 0x10, 0x8d, 0x60, 0x87, 0xca, 0x5a, 0x24, 0xb8, 0x63, 0x03, 0xa7, 0x4d, 0xf7, 0x80,
+	0x38, 0x84, 0x26, 0xd7, 0x3f, 0x56, 0xd4, 0x1c, 0x77, 0x77, 0xd7, 0xf8, 0xc1, 0xdd, 0xc3, 0x97,
+	0x9f, 0x0b, 0xa6, 0x32, 0x2f, 0xca, 0x53, 0x37, 0x14, 0xea, 0xc7, 0xc9, 0xb0, 0x41, 0x51, 0x4a,
+	0x91, 0xcc, 0xb8, 0xba, 0x94, 0xe5, 0xb9, 0xc7, 0x0d, 0x8d, 0x53, 0xe9, 0x25, 0x4c, 0x31, 0xd7,
+	0xcf, 0xd3, 0x50, 0xa8, 0x5f, 0xac, 0x52, 0xbc, 0xa4, 0x27, 0x8d, 0x77, 0xc3, 0xfc, 0xe1, 0x8a,
+	0x05, 0x4b, 0x19, 0x67, 0xb8, 0x67, 0x82, 0x1d, 0xc5, 0xa1, 0x3a, 0x93, 0x22, 0xe6, 0xb8, 0x6f,
+	0xe2, 0x1d, 0x05, 0xfa, 0x06, 0x87, 0x3b, 0x98, 0x72, 0x96, 0xf0, 0x72, 0xca, 0xaa, 0x0c, 0x0f,
+	0xcc, 0x6b, 0xbc, 0xb1, 0xbe, 0xbf, 0x6a, 0x88, 0xb5, 0x6e, 0x88, 0xb5, 0x6d, 0x08, 0xb8, 0xd6,
+	0x04, 0xdc, 0x6a, 0x02, 0xee, 0x35, 0x01, 0x2b, 0x4d, 0xc0, 0x5a, 0x13, 0xf0, 0xa8, 0x09, 0x78,
+	0xd2, 0xc4, 0xda, 0x6a, 0x02, 0x6e, 0x36, 0xc4, 0x5a, 0x6d, 0x88, 0xb5, 0xde, 0x10, 0xeb, 0x7f,
+	0xff, 0xb0, 0xe9, 0xa8, 0x6b, 0xd6, 0xf5, 0xfd, 0x79, 0x00, 0x9f, 0xa9, 0x32, 0x26, 0xfc, 0x01,
+	0x00, 0x00,
+}
+
+func (this *SlashingRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SlashingRecord)
+	if !ok {
+		that2, ok := that.(SlashingRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.EvidenceID, that1.EvidenceID) {
+		return false
+	}
+	if !bytes.Equal(this.PublicKey, that1.PublicKey) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.SlashValue, that1.SlashValue) {
+			return false
+		}
+	}
+	if this.MetaEpoch != that1.MetaEpoch {
+		return false
+	}
+	if this.MetaNonce != that1.MetaNonce {
+		return false
+	}
+	if !bytes.Equal(this.MetaHeaderHash, that1.MetaHeaderHash) {
+		return false
+	}
+	return true
+}
+func (this *SlashingRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&slashing.SlashingRecord{")
+	s = append(s, "EvidenceID: "+fmt.Sprintf("%#v", this.EvidenceID)+",\n")
+	s = append(s, "PublicKey: "+fmt.Sprintf("%#v", this.PublicKey)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "SlashValue: "+fmt.Sprintf("%#v", this.SlashValue)+",\n")
+	s = append(s, "MetaEpoch: "+fmt.Sprintf("%#v", this.MetaEpoch)+",\n")
+	s = append(s, "MetaNonce: "+fmt.Sprintf("%#v", this.MetaNonce)+",\n")
+	s = append(s, "MetaHeaderHash: "+fmt.Sprintf("%#v", this.MetaHeaderHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringSlashingRecord(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *SlashingRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashingRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashingRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MetaHeaderHash) > 0 {
+		i -= len(m.MetaHeaderHash)
+		copy(dAtA[i:], m.MetaHeaderHash)
+		i = encodeVarintSlashingRecord(dAtA, i, uint64(len(m.MetaHeaderHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MetaNonce != 0 {
+		i = encodeVarintSlashingRecord(dAtA, i, uint64(m.MetaNonce))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MetaEpoch != 0 {
+		i = encodeVarintSlashingRecord(dAtA, i, uint64(m.MetaEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.SlashValue)
+		i -= size
+		if _, err := __caster.MarshalTo(m.SlashValue, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashingRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Round != 0 {
+		i = encodeVarintSlashingRecord(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintSlashingRecord(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintSlashingRecord(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintSlashingRecord(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvidenceID) > 0 {
+		i -= len(m.EvidenceID)
+		copy(dAtA[i:], m.EvidenceID)
+		i = encodeVarintSlashingRecord(dAtA, i, uint64(len(m.EvidenceID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashingRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashingRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SlashingRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvidenceID)
+	if l > 0 {
+		n += 1 + l + sovSlashingRecord(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovSlashingRecord(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovSlashingRecord(uint64(l))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovSlashingRecord(uint64(m.ShardID))
+	}
+	if m.Round != 0 {
+		n += 1 + sovSlashingRecord(uint64(m.Round))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.SlashValue)
+		n += 1 + l + sovSlashingRecord(uint64(l))
+	}
+	if m.MetaEpoch != 0 {
+		n += 1 + sovSlashingRecord(uint64(m.MetaEpoch))
+	}
+	if m.MetaNonce != 0 {
+		n += 1 + sovSlashingRecord(uint64(m.MetaNonce))
+	}
+	l = len(m.MetaHeaderHash)
+	if l > 0 {
+		n += 1 + l + sovSlashingRecord(uint64(l))
+	}
+	return n
+}
+
+func sovSlashingRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashingRecord(x uint64) (n int) {
+	return sovSlashingRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *SlashingRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SlashingRecord{`,
+		`EvidenceID:` + fmt.Sprintf("%v", this.EvidenceID) + `,`,
+		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`SlashValue:` + fmt.Sprintf("%v", this.SlashValue) + `,`,
+		`MetaEpoch:` + fmt.Sprintf("%v", this.MetaEpoch) + `,`,
+		`MetaNonce:` + fmt.Sprintf("%v", this.MetaNonce) + `,`,
+		`MetaHeaderHash:` + fmt.Sprintf("%v", this.MetaHeaderHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringSlashingRecord(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *SlashingRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashingRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashingRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashingRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashingRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceID = append(m.EvidenceID[:0], dAtA[iNdEx:postIndex]...)
+			if m.EvidenceID == nil {
+				m.EvidenceID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashingRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashingRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashingRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.SlashValue = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaEpoch", wireType)
+			}
+			m.MetaEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetaEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaNonce", wireType)
+			}
+			m.MetaNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetaNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaHeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashingRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaHeaderHash = append(m.MetaHeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MetaHeaderHash == nil {
+				m.MetaHeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashingRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashingRecord
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashingRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlashingRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlashingRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashingRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashingRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlashingRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlashingRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlashingRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlashingRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlashingRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlashingRecord = fmt.Errorf("proto: unexpected end of group")
+)
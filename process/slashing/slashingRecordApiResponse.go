@@ -0,0 +1,14 @@
+package slashing
+
+// SlashingRecordApiResponse represents the data of a slashing record returned in API calls
+type SlashingRecordApiResponse struct {
+	EvidenceID     string `json:"evidenceId"`
+	PublicKey      string `json:"publicKey"`
+	Reason         string `json:"reason"`
+	ShardID        uint32 `json:"shardId"`
+	Round          uint64 `json:"round"`
+	SlashValue     string `json:"slashValue"`
+	MetaEpoch      uint32 `json:"metaEpoch"`
+	MetaNonce      uint64 `json:"metaNonce"`
+	MetaHeaderHash string `json:"metaHeaderHash"`
+}
@@ -1,6 +1,8 @@
 package smartContract
 
 import (
+	"math/big"
+
 	"github.com/ElrondNetwork/elrond-go/process"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/ElrondNetwork/elrond-vm-common/parsers"
@@ -37,6 +39,56 @@ func (a *argumentParser) ParseDeployData(data string) (*parsers.DeployArgs, erro
 	return a.deployParser.ParseData(data)
 }
 
+// ParseMultiESDTTransferData returns the transfers and the optional smart contract call from the arguments
+// of a multi ESDT transfer: numOfTransfers@token1@value1@token2@value2...[@function@arguments...]
+func (a *argumentParser) ParseMultiESDTTransferData(arguments [][]byte) (*process.ParsedMultiESDTTransfer, error) {
+	if len(arguments) < 1 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	numOfTransfers := big.NewInt(0).SetBytes(arguments[0])
+	if !numOfTransfers.IsUint64() || numOfTransfers.Uint64() == 0 {
+		return nil, process.ErrInvalidNumOfESDTTransfers
+	}
+	if numOfTransfers.Uint64() > uint64(len(arguments)-1)/2 {
+		return nil, process.ErrInvalidArguments
+	}
+	minNumOfArguments := 2*numOfTransfers.Uint64() + 1
+
+	parsedTransfer := &process.ParsedMultiESDTTransfer{
+		Transfers: make([]*process.ESDTTransferData, 0, numOfTransfers.Uint64()),
+	}
+	seenTokens := make(map[string]struct{})
+	for i := uint64(1); i < minNumOfArguments; i += 2 {
+		tokenIdentifier := arguments[i]
+		if len(tokenIdentifier) == 0 {
+			return nil, process.ErrInvalidArguments
+		}
+		_, alreadySeen := seenTokens[string(tokenIdentifier)]
+		if alreadySeen {
+			return nil, process.ErrDuplicateESDTTransfer
+		}
+		seenTokens[string(tokenIdentifier)] = struct{}{}
+
+		value := big.NewInt(0).SetBytes(arguments[i+1])
+		if value.Cmp(zero) <= 0 {
+			return nil, process.ErrNegativeValue
+		}
+
+		parsedTransfer.Transfers = append(parsedTransfer.Transfers, &process.ESDTTransferData{
+			TokenIdentifier: tokenIdentifier,
+			Value:           value,
+		})
+	}
+
+	if uint64(len(arguments)) > minNumOfArguments {
+		parsedTransfer.CallFunction = string(arguments[minNumOfArguments])
+		parsedTransfer.CallArgs = arguments[minNumOfArguments+1:]
+	}
+
+	return parsedTransfer, nil
+}
+
 // CreateDataFromStorageUpdate creates contract call data from storage update
 func (a *argumentParser) CreateDataFromStorageUpdate(storageUpdates []*vmcommon.StorageUpdate) string {
 	return a.storageParser.CreateDataFromStorageUpdate(storageUpdates)
@@ -0,0 +1,81 @@
+package smartContract
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArgumentParser_ParseMultiESDTTransferDataErrors(t *testing.T) {
+	t.Parallel()
+
+	argParser := NewArgumentParser()
+
+	parsed, err := argParser.ParseMultiESDTTransferData(nil)
+	assert.Nil(t, parsed)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	parsed, err = argParser.ParseMultiESDTTransferData([][]byte{{0}})
+	assert.Nil(t, parsed)
+	assert.Equal(t, process.ErrInvalidNumOfESDTTransfers, err)
+
+	parsed, err = argParser.ParseMultiESDTTransferData([][]byte{{2}, []byte("tkn1"), {10}})
+	assert.Nil(t, parsed)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	parsed, err = argParser.ParseMultiESDTTransferData([][]byte{{1}, []byte("tkn1"), {0}})
+	assert.Nil(t, parsed)
+	assert.Equal(t, process.ErrNegativeValue, err)
+
+	parsed, err = argParser.ParseMultiESDTTransferData([][]byte{{2}, []byte("tkn1"), {10}, []byte("tkn1"), {20}})
+	assert.Nil(t, parsed)
+	assert.Equal(t, process.ErrDuplicateESDTTransfer, err)
+}
+
+func TestArgumentParser_ParseMultiESDTTransferDataHugeNumOfTransfersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	argParser := NewArgumentParser()
+
+	overflowingNumOfTransfers := []byte{0x80, 0, 0, 0, 0, 0, 0, 0}
+	parsed, err := argParser.ParseMultiESDTTransferData([][]byte{overflowingNumOfTransfers})
+	assert.Nil(t, parsed)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	parsed, err = argParser.ParseMultiESDTTransferData([][]byte{overflowingNumOfTransfers, []byte("tkn1"), {10}})
+	assert.Nil(t, parsed)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	maxNumOfTransfers := []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+	parsed, err = argParser.ParseMultiESDTTransferData([][]byte{maxNumOfTransfers, []byte("tkn1"), {10}})
+	assert.Nil(t, parsed)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	parsed, err = argParser.ParseMultiESDTTransferData([][]byte{{0x7F, 0xFF, 0xFF, 0xFF}, []byte("tkn1"), {10}})
+	assert.Nil(t, parsed)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+}
+
+func TestArgumentParser_ParseMultiESDTTransferDataShouldWork(t *testing.T) {
+	t.Parallel()
+
+	argParser := NewArgumentParser()
+
+	parsed, err := argParser.ParseMultiESDTTransferData([][]byte{{2}, []byte("tkn1"), {10}, []byte("tkn2"), {20}})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(parsed.Transfers))
+	assert.Equal(t, []byte("tkn1"), parsed.Transfers[0].TokenIdentifier)
+	assert.Equal(t, big.NewInt(10), parsed.Transfers[0].Value)
+	assert.Equal(t, []byte("tkn2"), parsed.Transfers[1].TokenIdentifier)
+	assert.Equal(t, big.NewInt(20), parsed.Transfers[1].Value)
+	assert.Equal(t, "", parsed.CallFunction)
+
+	parsed, err = argParser.ParseMultiESDTTransferData([][]byte{{1}, []byte("tkn1"), {10}, []byte("swap"), []byte("arg")})
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(parsed.Transfers))
+	assert.Equal(t, "swap", parsed.CallFunction)
+	assert.Equal(t, [][]byte{[]byte("arg")}, parsed.CallArgs)
+}
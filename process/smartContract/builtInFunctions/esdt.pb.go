@@ -30,8 +30,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // ESDigitalToken holds the data for a elrond standard digital token transaction
 type ESDigitalToken struct {
-	Value      *math_big.Int `protobuf:"bytes,1,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"value"`
-	Properties []byte        `protobuf:"bytes,2,opt,name=Properties,proto3" json:"properties"`
+	Value         *math_big.Int `protobuf:"bytes,1,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"value"`
+	Properties    []byte        `protobuf:"bytes,2,opt,name=Properties,proto3" json:"properties"`
+	Type          uint32        `protobuf:"varint,3,opt,name=Type,proto3" json:"type"`
+	TokenMetaData *MetaData     `protobuf:"bytes,4,opt,name=TokenMetaData,proto3" json:"metadata"`
 }
 
 func (m *ESDigitalToken) Reset()      { *m = ESDigitalToken{} }
@@ -76,33 +78,190 @@ func (m *ESDigitalToken) GetProperties() []byte {
 	return nil
 }
 
+func (m *ESDigitalToken) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *ESDigitalToken) GetTokenMetaData() *MetaData {
+	if m != nil {
+		return m.TokenMetaData
+	}
+	return nil
+}
+
+// MetaData holds the data of a non-fungible or semi-fungible token, set at creation time
+type MetaData struct {
+	Nonce      uint64   `protobuf:"varint,1,opt,name=Nonce,proto3" json:"nonce"`
+	Name       []byte   `protobuf:"bytes,2,opt,name=Name,proto3" json:"name"`
+	Creator    []byte   `protobuf:"bytes,3,opt,name=Creator,proto3" json:"creator"`
+	Royalties  uint32   `protobuf:"varint,4,opt,name=Royalties,proto3" json:"royalties"`
+	Hash       []byte   `protobuf:"bytes,5,opt,name=Hash,proto3" json:"hash"`
+	URIs       [][]byte `protobuf:"bytes,6,rep,name=URIs,proto3" json:"uris"`
+	Attributes []byte   `protobuf:"bytes,7,opt,name=Attributes,proto3" json:"attributes"`
+}
+
+func (m *MetaData) Reset()      { *m = MetaData{} }
+func (*MetaData) ProtoMessage() {}
+func (*MetaData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e413e402abc6a34c, []int{1}
+}
+func (m *MetaData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetaData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MetaData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetaData.Merge(m, src)
+}
+func (m *MetaData) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetaData) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetaData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetaData proto.InternalMessageInfo
+
+func (m *MetaData) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *MetaData) GetName() []byte {
+	if m != nil {
+		return m.Name
+	}
+	return nil
+}
+
+func (m *MetaData) GetCreator() []byte {
+	if m != nil {
+		return m.Creator
+	}
+	return nil
+}
+
+func (m *MetaData) GetRoyalties() uint32 {
+	if m != nil {
+		return m.Royalties
+	}
+	return 0
+}
+
+func (m *MetaData) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *MetaData) GetURIs() [][]byte {
+	if m != nil {
+		return m.URIs
+	}
+	return nil
+}
+
+func (m *MetaData) GetAttributes() []byte {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+// ESDTRoles holds the roles an address has for an elrond standard digital token
+type ESDTRoles struct {
+	Roles [][]byte `protobuf:"bytes,1,rep,name=Roles,proto3" json:"roles"`
+}
+
+func (m *ESDTRoles) Reset()      { *m = ESDTRoles{} }
+func (*ESDTRoles) ProtoMessage() {}
+func (*ESDTRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e413e402abc6a34c, []int{2}
+}
+func (m *ESDTRoles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ESDTRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTRoles.Merge(m, src)
+}
+func (m *ESDTRoles) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTRoles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTRoles proto.InternalMessageInfo
+
+func (m *ESDTRoles) GetRoles() [][]byte {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ESDigitalToken)(nil), "protoBuiltInFunctions.ESDigitalToken")
+	proto.RegisterType((*MetaData)(nil), "protoBuiltInFunctions.MetaData")
+	proto.RegisterType((*ESDTRoles)(nil), "protoBuiltInFunctions.ESDTRoles")
 }
 
 func init() { proto.RegisterFile("esdt.proto", fileDescriptor_e413e402abc6a34c) }
 
 var fileDescriptor_e413e402abc6a34c = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xb1, 0x4a, 0x33, 0x41,
-	0x14, 0x85, 0x67, 0x7e, 0xc8, 0x5f, 0x0c, 0x12, 0x24, 0x20, 0x04, 0x8b, 0x1b, 0xb1, 0xb2, 0xc9,
-	0x6e, 0x61, 0x69, 0xe5, 0x6a, 0x84, 0xb5, 0x08, 0x12, 0xc5, 0xc2, 0x6e, 0x36, 0x3b, 0x4e, 0x86,
-	0x6c, 0x66, 0x96, 0xd9, 0xbb, 0xda, 0xfa, 0x08, 0x3e, 0x85, 0x88, 0x4f, 0x62, 0xb9, 0xe5, 0x56,
-	0xd1, 0x9d, 0x6d, 0x24, 0x55, 0x1e, 0x41, 0x9c, 0x80, 0x04, 0xab, 0x7b, 0xbf, 0xc3, 0xe1, 0x1c,
-	0xee, 0x65, 0x4c, 0x14, 0x29, 0x06, 0xb9, 0x35, 0x68, 0x7a, 0x7b, 0x7e, 0x44, 0xa5, 0xca, 0x30,
-	0xd6, 0x17, 0xa5, 0x9e, 0xa2, 0x32, 0xba, 0xd8, 0x1f, 0x4a, 0x85, 0xb3, 0x32, 0x09, 0xa6, 0x66,
-	0x11, 0x4a, 0x23, 0x4d, 0xe8, 0x6d, 0x49, 0x79, 0xef, 0xc9, 0x83, 0xdf, 0x36, 0x29, 0x87, 0x2f,
-	0x94, 0x75, 0x47, 0xd7, 0xe7, 0x4a, 0x2a, 0xe4, 0xd9, 0x8d, 0x99, 0x0b, 0xdd, 0x4b, 0x59, 0xe7,
-	0x96, 0x67, 0xa5, 0xe8, 0xd3, 0x03, 0x7a, 0xb4, 0x13, 0x8d, 0x57, 0xcb, 0x41, 0xe7, 0xe1, 0x47,
-	0x78, 0xfb, 0x18, 0x9c, 0x2e, 0x38, 0xce, 0xc2, 0x44, 0xc9, 0x20, 0xd6, 0x78, 0xb2, 0x55, 0x35,
-	0xca, 0xac, 0xd1, 0xe9, 0x58, 0xe0, 0xa3, 0xb1, 0xf3, 0x50, 0x78, 0x1a, 0x4a, 0x13, 0xa6, 0x1c,
-	0x79, 0x10, 0x29, 0x19, 0x6b, 0x3c, 0xe3, 0x05, 0x0a, 0x3b, 0xd9, 0x84, 0xf7, 0x02, 0xc6, 0xae,
-	0xac, 0xc9, 0x85, 0x45, 0x25, 0x8a, 0xfe, 0x3f, 0x5f, 0xd5, 0x5d, 0x2d, 0x07, 0x2c, 0xff, 0x55,
-	0x27, 0x5b, 0x8e, 0xe8, 0xb2, 0x6a, 0x80, 0xd4, 0x0d, 0x90, 0x75, 0x03, 0xf4, 0xc9, 0x01, 0x7d,
-	0x75, 0x40, 0xdf, 0x1d, 0xd0, 0xca, 0x01, 0xad, 0x1d, 0xd0, 0x4f, 0x07, 0xf4, 0xcb, 0x01, 0x59,
-	0x3b, 0xa0, 0xcf, 0x2d, 0x90, 0xaa, 0x05, 0x52, 0xb7, 0x40, 0xee, 0x76, 0x93, 0x3f, 0x3f, 0x4a,
-	0xfe, 0xfb, 0xdb, 0x8f, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xa7, 0xce, 0x93, 0x19, 0x4f, 0x01,
-	0x00, 0x00,
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x33, 0xdd, 0x76, 0xdb, 0xce, 0xb6, 0x8b, 0x04, 0x84, 0x20, 0x32, 0x29, 0x05, 0xa1,
+	0xa0, 0x9b, 0x82, 0x1e, 0x3d, 0x6d, 0xb6, 0x15, 0x2b, 0x58, 0x64, 0x5a, 0x3d, 0x78, 0x9b, 0xb4,
+	0x63, 0x1a, 0x36, 0xcd, 0x94, 0xc9, 0x8b, 0xd2, 0x9b, 0x57, 0x6f, 0x5e, 0xfd, 0x06, 0xe2, 0x27,
+	0xf1, 0xd8, 0x63, 0x4f, 0xd1, 0xa6, 0x17, 0xc9, 0x69, 0x3f, 0x82, 0xcc, 0xc4, 0x6c, 0xab, 0x78,
+	0x9a, 0x79, 0xbf, 0xf7, 0x78, 0xef, 0xff, 0xfe, 0x0f, 0x63, 0x1e, 0xcf, 0xc1, 0x59, 0x49, 0x01,
+	0xc2, 0xbc, 0xab, 0x1f, 0x37, 0x09, 0x42, 0x18, 0x45, 0xcf, 0x92, 0x68, 0x06, 0x81, 0x88, 0xe2,
+	0x7b, 0x17, 0x7e, 0x00, 0x8b, 0xc4, 0x73, 0x66, 0x62, 0xd9, 0xf7, 0x85, 0x2f, 0xfa, 0xba, 0xcc,
+	0x4b, 0xde, 0xe9, 0x48, 0x07, 0xfa, 0x57, 0x74, 0xe9, 0x7e, 0xa9, 0xe0, 0xf3, 0xe1, 0x64, 0x10,
+	0xf8, 0x01, 0xb0, 0x70, 0x2a, 0xae, 0x79, 0x64, 0xce, 0x71, 0xed, 0x0d, 0x0b, 0x13, 0x6e, 0xa1,
+	0x0e, 0xea, 0xb5, 0xdc, 0x71, 0x9e, 0xda, 0xb5, 0xf7, 0x0a, 0x7c, 0xfb, 0x61, 0x5f, 0x2e, 0x19,
+	0x2c, 0xfa, 0x5e, 0xe0, 0x3b, 0xa3, 0x08, 0x9e, 0x1e, 0x8d, 0x1a, 0x86, 0x52, 0x44, 0xf3, 0x31,
+	0x87, 0x0f, 0x42, 0x5e, 0xf7, 0xb9, 0x8e, 0x2e, 0x7c, 0xd1, 0x9f, 0x33, 0x60, 0x8e, 0x1b, 0xf8,
+	0xa3, 0x08, 0xae, 0x58, 0x0c, 0x5c, 0xd2, 0xa2, 0xb9, 0xe9, 0x60, 0xfc, 0x4a, 0x8a, 0x15, 0x97,
+	0x10, 0xf0, 0xd8, 0xaa, 0xe8, 0x51, 0xe7, 0x79, 0x6a, 0xe3, 0xd5, 0x2d, 0xa5, 0x47, 0x15, 0xe6,
+	0x7d, 0x5c, 0x9d, 0xae, 0x57, 0xdc, 0x3a, 0xe9, 0xa0, 0x5e, 0xdb, 0x6d, 0xe4, 0xa9, 0x5d, 0x85,
+	0xf5, 0x8a, 0x53, 0x4d, 0xcd, 0x09, 0x6e, 0x6b, 0xf1, 0x2f, 0x39, 0xb0, 0x01, 0x03, 0x66, 0x55,
+	0x3b, 0xa8, 0x77, 0xf6, 0xd8, 0x76, 0xfe, 0x6b, 0x92, 0x53, 0x96, 0xb9, 0xad, 0x3c, 0xb5, 0x1b,
+	0x4b, 0x0e, 0x4c, 0xe9, 0xa4, 0x7f, 0xf7, 0xe8, 0x7e, 0xaa, 0xe0, 0x46, 0x19, 0x98, 0x36, 0xae,
+	0x8d, 0x45, 0x34, 0x2b, 0x5c, 0xa9, 0xba, 0x4d, 0xe5, 0x4a, 0xa4, 0x00, 0x2d, 0xb8, 0x12, 0x38,
+	0x66, 0x4b, 0xfe, 0x67, 0x15, 0x2d, 0x30, 0x62, 0x4b, 0x4e, 0x35, 0x35, 0x1f, 0xe0, 0xfa, 0x95,
+	0xe4, 0x0c, 0x84, 0xd4, 0x1b, 0xb4, 0xdc, 0xb3, 0x3c, 0xb5, 0xeb, 0xb3, 0x02, 0xd1, 0x32, 0x67,
+	0x3e, 0xc4, 0x4d, 0x2a, 0xd6, 0x2c, 0xd4, 0xa6, 0x54, 0xf5, 0xaa, 0xed, 0x3c, 0xb5, 0x9b, 0xb2,
+	0x84, 0xf4, 0x90, 0x57, 0x13, 0x9f, 0xb3, 0x78, 0x61, 0xd5, 0x0e, 0x13, 0x17, 0x2c, 0x5e, 0x50,
+	0x4d, 0x55, 0xf6, 0x35, 0x1d, 0xc5, 0xd6, 0x69, 0xe7, 0xa4, 0xcc, 0x26, 0x32, 0x88, 0xa9, 0xa6,
+	0xca, 0xfe, 0x4b, 0x00, 0x19, 0x78, 0x09, 0xf0, 0xd8, 0xaa, 0x1f, 0xec, 0x67, 0xb7, 0x94, 0x1e,
+	0x55, 0x74, 0x1f, 0xe1, 0xe6, 0x70, 0x32, 0x98, 0x52, 0x11, 0xf2, 0x58, 0x79, 0xa1, 0x3f, 0x16,
+	0xd2, 0xbd, 0xb5, 0x17, 0x52, 0x01, 0x5a, 0x70, 0xf7, 0xc5, 0x66, 0x47, 0x8c, 0xed, 0x8e, 0x18,
+	0x37, 0x3b, 0x82, 0x3e, 0x66, 0x04, 0x7d, 0xcd, 0x08, 0xfa, 0x9e, 0x11, 0xb4, 0xc9, 0x08, 0xda,
+	0x66, 0x04, 0xfd, 0xcc, 0x08, 0xfa, 0x95, 0x11, 0xe3, 0x26, 0x23, 0xe8, 0xf3, 0x9e, 0x18, 0x9b,
+	0x3d, 0x31, 0xb6, 0x7b, 0x62, 0xbc, 0xbd, 0xe3, 0xfd, 0x73, 0x2b, 0xef, 0x54, 0x9f, 0xf0, 0xc9,
+	0xef, 0x01, 0x00, 0x09, 0x7f, 0x40, 0xcc, 0xfc, 0x02, 0x00, 0x00,
 }
 
 func (this *ESDigitalToken) Equal(that interface{}) bool {
@@ -133,16 +292,128 @@ func (this *ESDigitalToken) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Properties, that1.Properties) {
 		return false
 	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !this.TokenMetaData.Equal(that1.TokenMetaData) {
+		return false
+	}
+	return true
+}
+func (this *MetaData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MetaData)
+	if !ok {
+		that2, ok := that.(MetaData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if !bytes.Equal(this.Name, that1.Name) {
+		return false
+	}
+	if !bytes.Equal(this.Creator, that1.Creator) {
+		return false
+	}
+	if this.Royalties != that1.Royalties {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if len(this.URIs) != len(that1.URIs) {
+		return false
+	}
+	for i := range this.URIs {
+		if !bytes.Equal(this.URIs[i], that1.URIs[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Attributes, that1.Attributes) {
+		return false
+	}
+	return true
+}
+func (this *ESDTRoles) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTRoles)
+	if !ok {
+		that2, ok := that.(ESDTRoles)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if !bytes.Equal(this.Roles[i], that1.Roles[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ESDigitalToken) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&builtInFunctions.ESDigitalToken{")
 	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
 	s = append(s, "Properties: "+fmt.Sprintf("%#v", this.Properties)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	if this.TokenMetaData != nil {
+		s = append(s, "TokenMetaData: "+fmt.Sprintf("%#v", this.TokenMetaData)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MetaData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&builtInFunctions.MetaData{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Creator: "+fmt.Sprintf("%#v", this.Creator)+",\n")
+	s = append(s, "Royalties: "+fmt.Sprintf("%#v", this.Royalties)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "URIs: "+fmt.Sprintf("%#v", this.URIs)+",\n")
+	s = append(s, "Attributes: "+fmt.Sprintf("%#v", this.Attributes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTRoles) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&builtInFunctions.ESDTRoles{")
+	s = append(s, "Roles: "+fmt.Sprintf("%#v", this.Roles)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -174,6 +445,23 @@ func (m *ESDigitalToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TokenMetaData != nil {
+		{
+			size, err := m.TokenMetaData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEsdt(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Type != 0 {
+		i = encodeVarintEsdt(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Properties) > 0 {
 		i -= len(m.Properties)
 		copy(dAtA[i:], m.Properties)
@@ -195,6 +483,108 @@ func (m *ESDigitalToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MetaData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetaData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetaData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		i -= len(m.Attributes)
+		copy(dAtA[i:], m.Attributes)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.Attributes)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.URIs) > 0 {
+		for iNdEx := len(m.URIs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.URIs[iNdEx])
+			copy(dAtA[i:], m.URIs[iNdEx])
+			i = encodeVarintEsdt(dAtA, i, uint64(len(m.URIs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Royalties != 0 {
+		i = encodeVarintEsdt(dAtA, i, uint64(m.Royalties))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintEsdt(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ESDTRoles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTRoles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintEsdt(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEsdt(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEsdt(v)
 	base := offset
@@ -221,38 +611,125 @@ func (m *ESDigitalToken) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEsdt(uint64(l))
 	}
+	if m.Type != 0 {
+		n += 1 + sovEsdt(uint64(m.Type))
+	}
+	if m.TokenMetaData != nil {
+		l = m.TokenMetaData.Size()
+		n += 1 + l + sovEsdt(uint64(l))
+	}
 	return n
 }
 
-func sovEsdt(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEsdt(x uint64) (n int) {
-	return sovEsdt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *ESDigitalToken) String() string {
-	if this == nil {
-		return "nil"
+func (m *MetaData) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ESDigitalToken{`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`Properties:` + fmt.Sprintf("%v", this.Properties) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringEsdt(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovEsdt(uint64(m.Nonce))
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
-}
-func (m *ESDigitalToken) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
+	if m.Royalties != 0 {
+		n += 1 + sovEsdt(uint64(m.Royalties))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
+	if len(m.URIs) > 0 {
+		for _, b := range m.URIs {
+			l = len(b)
+			n += 1 + l + sovEsdt(uint64(l))
+		}
+	}
+	l = len(m.Attributes)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
+	return n
+}
+
+func (m *ESDTRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for _, b := range m.Roles {
+			l = len(b)
+			n += 1 + l + sovEsdt(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEsdt(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEsdt(x uint64) (n int) {
+	return sovEsdt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ESDigitalToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ESDigitalToken{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Properties:` + fmt.Sprintf("%v", this.Properties) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`TokenMetaData:` + strings.Replace(this.TokenMetaData.String(), "MetaData", "MetaData", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MetaData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MetaData{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Creator:` + fmt.Sprintf("%v", this.Creator) + `,`,
+		`Royalties:` + fmt.Sprintf("%v", this.Royalties) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`URIs:` + fmt.Sprintf("%v", this.URIs) + `,`,
+		`Attributes:` + fmt.Sprintf("%v", this.Attributes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTRoles) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ESDTRoles{`,
+		`Roles:` + fmt.Sprintf("%v", this.Roles) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEsdt(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ESDigitalToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -350,6 +827,405 @@ func (m *ESDigitalToken) Unmarshal(dAtA []byte) error {
 				m.Properties = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMetaData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenMetaData == nil {
+				m.TokenMetaData = &MetaData{}
+			}
+			if err := m.TokenMetaData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEsdt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetaData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEsdt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetaData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetaData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name[:0], dAtA[iNdEx:postIndex]...)
+			if m.Name == nil {
+				m.Name = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = append(m.Creator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Creator == nil {
+				m.Creator = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			m.Royalties = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Royalties |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIs = append(m.URIs, make([]byte, postIndex-iNdEx))
+			copy(m.URIs[len(m.URIs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes[:0], dAtA[iNdEx:postIndex]...)
+			if m.Attributes == nil {
+				m.Attributes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEsdt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTRoles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEsdt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTRoles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, make([]byte, postIndex-iNdEx))
+			copy(m.Roles[len(m.Roles)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEsdt(dAtA[iNdEx:])
@@ -0,0 +1,115 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.BuiltinFunction = (*esdtLocalBurn)(nil)
+
+type esdtLocalBurn struct {
+	keyPrefix    []byte
+	marshalizer  marshal.Marshalizer
+	pauseHandler process.ESDTPauseHandler
+	funcGasCost  uint64
+}
+
+// NewESDTLocalBurnFunc returns the esdt local burn built-in function component
+func NewESDTLocalBurnFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+) (*esdtLocalBurn, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+
+	e := &esdtLocalBurn{
+		keyPrefix:    []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		marshalizer:  marshalizer,
+		pauseHandler: pauseHandler,
+		funcGasCost:  funcGasCost,
+	}
+
+	return e, nil
+}
+
+// ProcessBuiltinFunction resolves ESDT local burn function call
+// The burnt quantity is sent to the ESDT system smart contract in order to keep the token supply up to date
+// Requires the following arguments:
+// arg0 - token identifier
+// arg1 - value to burn
+func (e *esdtLocalBurn) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	err := checkInputArgumentsForLocalAction(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err = checkESDTRoleForAccount(e.marshalizer, acntSnd, tokenID, []byte(core.ESDTRoleLocalBurn))
+	if err != nil {
+		return nil, err
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	esdtTokenKey := []byte(string(e.keyPrefix) + string(tokenID))
+	err = addToESDTBalance(vmInput.CallerAddr, acntSnd, esdtTokenKey, big.NewInt(0).Neg(value), e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{GasRemaining: vmInput.GasProvided - e.funcGasCost}
+	addOutPutTransferToVMOutput(core.BuiltInFunctionESDTLocalBurn, vmInput.Arguments, vm.ESDTSCAddress, vmOutput)
+
+	return vmOutput, nil
+}
+
+func checkInputArgumentsForLocalAction(
+	acnt state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	funcGasCost uint64,
+) error {
+	if vmInput == nil {
+		return process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return process.ErrBuiltInFunctionCalledWithValue
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return process.ErrBuiltInFunctionNotCalledOnOwnAddress
+	}
+	if check.IfNil(acnt) {
+		return process.ErrNilUserAccount
+	}
+	if len(vmInput.Arguments) != 2 {
+		return process.ErrInvalidArguments
+	}
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if value.Cmp(zero) <= 0 {
+		return process.ErrNegativeValue
+	}
+	if vmInput.GasProvided < funcGasCost {
+		return process.ErrNotEnoughGas
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtLocalBurn) IsInterfaceNil() bool {
+	return e == nil
+}
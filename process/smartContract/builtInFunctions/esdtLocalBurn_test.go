@@ -0,0 +1,76 @@
+package builtInFunctions
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewESDTLocalBurnFunc(t *testing.T) {
+	t.Parallel()
+
+	localBurn, err := NewESDTLocalBurnFunc(10, nil, &mock.PauseHandlerStub{})
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.Nil(t, localBurn)
+
+	localBurn, err = NewESDTLocalBurnFunc(10, &mock.MarshalizerMock{}, nil)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+	assert.Nil(t, localBurn)
+
+	localBurn, err = NewESDTLocalBurnFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{})
+	assert.Nil(t, err)
+	assert.False(t, localBurn.IsInterfaceNil())
+}
+
+func TestEsdtLocalBurn_ProcessBuiltinFunctionWithoutRoleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	localBurn, _ := NewESDTLocalBurnFunc(10, marshalizer, &mock.PauseHandlerStub{})
+	acnt, _ := state.NewUserAccount([]byte("addr"))
+	tokenID := []byte("token")
+	setRolesOnAccount(t, marshalizer, acnt, tokenID, core.ESDTRoleLocalMint)
+
+	input := createLocalMintBurnInput([]byte("addr"), tokenID, 10)
+	_, err := localBurn.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+}
+
+func TestEsdtLocalBurn_ProcessBuiltinFunctionShouldSubtractFromBalance(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	localBurn, _ := NewESDTLocalBurnFunc(10, marshalizer, &mock.PauseHandlerStub{})
+	acnt, _ := state.NewUserAccount([]byte("addr"))
+	tokenID := []byte("token")
+	setRolesOnAccount(t, marshalizer, acnt, tokenID, core.ESDTRoleLocalBurn)
+
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + string(tokenID))
+	err := saveESDTData(acnt, &ESDigitalToken{Value: big.NewInt(15)}, esdtTokenKey, marshalizer)
+	require.Nil(t, err)
+
+	input := createLocalMintBurnInput([]byte("addr"), tokenID, 10)
+	vmOutput, err := localBurn.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(90), vmOutput.GasRemaining)
+
+	expectedData := core.BuiltInFunctionESDTLocalBurn + "@" + hex.EncodeToString(tokenID) + "@" + hex.EncodeToString(big.NewInt(10).Bytes())
+	outAcc := vmOutput.OutputAccounts[string(vm.ESDTSCAddress)]
+	require.NotNil(t, outAcc)
+	require.Equal(t, 1, len(outAcc.OutputTransfers))
+	assert.Equal(t, []byte(expectedData), outAcc.OutputTransfers[0].Data)
+
+	esdtData, _ := getESDTDataFromKey(acnt, esdtTokenKey, marshalizer)
+	assert.Equal(t, big.NewInt(5), esdtData.Value)
+
+	_, err = localBurn.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+}
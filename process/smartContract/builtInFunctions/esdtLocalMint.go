@@ -0,0 +1,83 @@
+package builtInFunctions
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.BuiltinFunction = (*esdtLocalMint)(nil)
+
+type esdtLocalMint struct {
+	keyPrefix    []byte
+	marshalizer  marshal.Marshalizer
+	pauseHandler process.ESDTPauseHandler
+	funcGasCost  uint64
+}
+
+// NewESDTLocalMintFunc returns the esdt local mint built-in function component
+func NewESDTLocalMintFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+) (*esdtLocalMint, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+
+	e := &esdtLocalMint{
+		keyPrefix:    []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		marshalizer:  marshalizer,
+		pauseHandler: pauseHandler,
+		funcGasCost:  funcGasCost,
+	}
+
+	return e, nil
+}
+
+// ProcessBuiltinFunction resolves ESDT local mint function call
+// The minted quantity is sent to the ESDT system smart contract in order to keep the token supply up to date
+// Requires the following arguments:
+// arg0 - token identifier
+// arg1 - value to mint
+func (e *esdtLocalMint) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	err := checkInputArgumentsForLocalAction(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err = checkESDTRoleForAccount(e.marshalizer, acntSnd, tokenID, []byte(core.ESDTRoleLocalMint))
+	if err != nil {
+		return nil, err
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	esdtTokenKey := []byte(string(e.keyPrefix) + string(tokenID))
+	err = addToESDTBalance(vmInput.CallerAddr, acntSnd, esdtTokenKey, value, e.marshalizer, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{GasRemaining: vmInput.GasProvided - e.funcGasCost}
+	addOutPutTransferToVMOutput(core.BuiltInFunctionESDTLocalMint, vmInput.Arguments, vm.ESDTSCAddress, vmOutput)
+
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtLocalMint) IsInterfaceNil() bool {
+	return e == nil
+}
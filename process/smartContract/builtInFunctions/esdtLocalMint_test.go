@@ -0,0 +1,113 @@
+package builtInFunctions
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLocalMintBurnInput(caller []byte, tokenID []byte, value int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 100,
+			Arguments:   [][]byte{tokenID, big.NewInt(value).Bytes()},
+		},
+		RecipientAddr: caller,
+	}
+}
+
+func TestNewESDTLocalMintFunc(t *testing.T) {
+	t.Parallel()
+
+	localMint, err := NewESDTLocalMintFunc(10, nil, &mock.PauseHandlerStub{})
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.Nil(t, localMint)
+
+	localMint, err = NewESDTLocalMintFunc(10, &mock.MarshalizerMock{}, nil)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+	assert.Nil(t, localMint)
+
+	localMint, err = NewESDTLocalMintFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{})
+	assert.Nil(t, err)
+	assert.False(t, localMint.IsInterfaceNil())
+}
+
+func TestEsdtLocalMint_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	localMint, _ := NewESDTLocalMintFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{})
+	acnt, _ := state.NewUserAccount([]byte("addr"))
+
+	_, err := localMint.ProcessBuiltinFunction(acnt, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createLocalMintBurnInput([]byte("addr"), []byte("token"), 10)
+	input.CallValue = big.NewInt(1)
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input = createLocalMintBurnInput([]byte("addr"), []byte("token"), 10)
+	input.RecipientAddr = []byte("other")
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionNotCalledOnOwnAddress, err)
+
+	input = createLocalMintBurnInput([]byte("addr"), []byte("token"), 10)
+	_, err = localMint.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	input.Arguments = input.Arguments[:1]
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input = createLocalMintBurnInput([]byte("addr"), []byte("token"), 0)
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNegativeValue, err)
+
+	input = createLocalMintBurnInput([]byte("addr"), []byte("token"), 10)
+	input.GasProvided = 1
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+
+	input = createLocalMintBurnInput([]byte("addr"), []byte("token"), 10)
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+}
+
+func TestEsdtLocalMint_ProcessBuiltinFunctionShouldAddToBalance(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	localMint, _ := NewESDTLocalMintFunc(10, marshalizer, &mock.PauseHandlerStub{})
+	acnt, _ := state.NewUserAccount([]byte("addr"))
+	tokenID := []byte("token")
+	setRolesOnAccount(t, marshalizer, acnt, tokenID, core.ESDTRoleLocalMint)
+
+	input := createLocalMintBurnInput([]byte("addr"), tokenID, 10)
+	vmOutput, err := localMint.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(90), vmOutput.GasRemaining)
+
+	expectedData := core.BuiltInFunctionESDTLocalMint + "@" + hex.EncodeToString(tokenID) + "@" + hex.EncodeToString(big.NewInt(10).Bytes())
+	outAcc := vmOutput.OutputAccounts[string(vm.ESDTSCAddress)]
+	require.NotNil(t, outAcc)
+	require.Equal(t, 1, len(outAcc.OutputTransfers))
+	assert.Equal(t, []byte(expectedData), outAcc.OutputTransfers[0].Data)
+
+	_, err = localMint.ProcessBuiltinFunction(acnt, nil, input)
+	require.Nil(t, err)
+
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + string(tokenID))
+	esdtData, _ := getESDTDataFromKey(acnt, esdtTokenKey, marshalizer)
+	assert.Equal(t, big.NewInt(20), esdtData.Value)
+}
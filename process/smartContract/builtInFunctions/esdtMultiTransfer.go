@@ -0,0 +1,228 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.BuiltinFunction = (*esdtMultiTransfer)(nil)
+
+type esdtMultiTransfer struct {
+	funcGasCost    uint64
+	marshalizer    marshal.Marshalizer
+	keyPrefix      string
+	pauseHandler   process.ESDTPauseHandler
+	payableHandler process.PayableHandler
+	argsParser     process.MultiESDTTransferParser
+}
+
+// NewESDTMultiTransferFunc returns the esdt multi transfer built-in function component
+func NewESDTMultiTransferFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	argsParser process.MultiESDTTransferParser,
+) (*esdtMultiTransfer, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(argsParser) {
+		return nil, process.ErrNilMultiESDTTransferParser
+	}
+
+	e := &esdtMultiTransfer{
+		funcGasCost:    funcGasCost,
+		marshalizer:    marshalizer,
+		keyPrefix:      core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier,
+		pauseHandler:   pauseHandler,
+		payableHandler: &disabledPayableHandler{},
+		argsParser:     argsParser,
+	}
+
+	return e, nil
+}
+
+// ProcessBuiltinFunction resolves ESDT multi transfer function calls
+// Requires the following arguments:
+// arg0 - number of transfers
+// pairs of token identifier and value, one for each transfer
+// the optional arguments after the transfers are the function and its arguments to be called on the destination smart contract
+// The EGLD value of the call, if any, is moved together with the tokens
+func (e *esdtMultiTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue == nil || vmInput.CallValue.Cmp(zero) < 0 {
+		return nil, process.ErrNegativeValue
+	}
+
+	parsedTransfer, err := e.argsParser.ParseMultiESDTTransferData(vmInput.Arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	gasRemaining := uint64(0)
+	if !check.IfNil(acntSnd) {
+		// gas is paid only by sender, for each of the transferred tokens
+		gasToUse := e.funcGasCost * uint64(len(parsedTransfer.Transfers))
+		if vmInput.GasProvided < gasToUse {
+			return nil, process.ErrNotEnoughGas
+		}
+
+		gasRemaining = vmInput.GasProvided - gasToUse
+		err = e.moveTokens(vmInput.CallerAddr, acntSnd, parsedTransfer.Transfers, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	vmOutput := &vmcommon.VMOutput{GasRemaining: gasRemaining}
+	if !check.IfNil(acntDst) {
+		err = e.processOnDestination(acntDst, vmInput, parsedTransfer, vmOutput)
+		if err != nil {
+			return nil, err
+		}
+
+		return vmOutput, nil
+	}
+
+	// cross-shard multi transfer call through a smart contract
+	if core.IsSmartContractAddress(vmInput.CallerAddr) {
+		addOutPutTransferToVMOutput(
+			core.BuiltInFunctionMultiESDTTransfer,
+			vmInput.Arguments,
+			vmInput.RecipientAddr,
+			vmOutput)
+		setValueOnOutputTransfer(vmOutput, vmInput.RecipientAddr, vmInput.CallValue)
+	}
+
+	return vmOutput, nil
+}
+
+func (e *esdtMultiTransfer) processOnDestination(
+	acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	parsedTransfer *process.ParsedMultiESDTTransfer,
+	vmOutput *vmcommon.VMOutput,
+) error {
+	mustVerifyPayable := vmInput.CallType != vmcommon.AsynchronousCallBack && !bytes.Equal(vmInput.CallerAddr, vm.ESDTSCAddress)
+	if mustVerifyPayable && len(parsedTransfer.CallFunction) == 0 {
+		isPayable, err := e.payableHandler.IsPayable(vmInput.RecipientAddr)
+		if err != nil {
+			return err
+		}
+		if !isPayable {
+			return process.ErrAccountNotPayable
+		}
+	}
+
+	err := e.moveTokens(vmInput.CallerAddr, acntDst, parsedTransfer.Transfers, false)
+	if err != nil {
+		return err
+	}
+
+	isSCCallAfter := core.IsSmartContractAddress(vmInput.RecipientAddr) && len(parsedTransfer.CallFunction) > 0
+	if isSCCallAfter {
+		// the EGLD value is given to the smart contract as the call value of the subsequent execution
+		addOutPutTransferToVMOutput(
+			parsedTransfer.CallFunction,
+			parsedTransfer.CallArgs,
+			vmInput.RecipientAddr,
+			vmOutput)
+		setValueOnOutputTransfer(vmOutput, vmInput.RecipientAddr, vmInput.CallValue)
+
+		return nil
+	}
+
+	if vmInput.CallValue.Cmp(zero) > 0 {
+		return acntDst.AddToBalance(vmInput.CallValue)
+	}
+
+	return nil
+}
+
+// moveTokens checks all the transfers before changing any balance, so that the account is either updated
+// for the whole list of tokens or not at all
+func (e *esdtMultiTransfer) moveTokens(
+	senderAddr []byte,
+	userAcnt state.UserAccountHandler,
+	transfers []*process.ESDTTransferData,
+	isSender bool,
+) error {
+	esdtTokenKeys := make([][]byte, 0, len(transfers))
+	esdtTokens := make([]*ESDigitalToken, 0, len(transfers))
+	for _, transfer := range transfers {
+		esdtTokenKey := []byte(e.keyPrefix + string(transfer.TokenIdentifier))
+		esdtData, err := getESDTDataFromKey(userAcnt, esdtTokenKey, e.marshalizer)
+		if err != nil {
+			return err
+		}
+		if esdtData.Type != uint32(core.Fungible) {
+			return process.ErrOnlyFungibleTokensAccepted
+		}
+
+		err = checkFrozeAndPause(senderAddr, esdtTokenKey, esdtData, e.pauseHandler)
+		if err != nil {
+			return err
+		}
+
+		if isSender {
+			esdtData.Value.Sub(esdtData.Value, transfer.Value)
+		} else {
+			esdtData.Value.Add(esdtData.Value, transfer.Value)
+		}
+		if esdtData.Value.Cmp(zero) < 0 {
+			return process.ErrInsufficientFunds
+		}
+
+		esdtTokenKeys = append(esdtTokenKeys, esdtTokenKey)
+		esdtTokens = append(esdtTokens, esdtData)
+	}
+
+	log.Trace("esdtMultiTransfer", "address", userAcnt.AddressBytes(), "num transfers", len(transfers), "is sender", isSender)
+	for i := range esdtTokens {
+		err := saveESDTData(userAcnt, esdtTokens[i], esdtTokenKeys[i], e.marshalizer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func setValueOnOutputTransfer(vmOutput *vmcommon.VMOutput, recipient []byte, value *big.Int) {
+	outAcc, ok := vmOutput.OutputAccounts[string(recipient)]
+	if !ok || len(outAcc.OutputTransfers) == 0 {
+		return
+	}
+
+	outAcc.OutputTransfers[0].Value = big.NewInt(0).Set(value)
+}
+
+func (e *esdtMultiTransfer) setPayableHandler(payableHandler process.PayableHandler) error {
+	if check.IfNil(payableHandler) {
+		return process.ErrNilPayableHandler
+	}
+
+	e.payableHandler = payableHandler
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtMultiTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
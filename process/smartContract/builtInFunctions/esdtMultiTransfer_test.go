@@ -0,0 +1,156 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMultiTransferInput(caller []byte, recipient []byte, tokenValues ...int64) *vmcommon.ContractCallInput {
+	arguments := [][]byte{big.NewInt(int64(len(tokenValues))).Bytes()}
+	for i, value := range tokenValues {
+		arguments = append(arguments, []byte{byte('a' + i)}, big.NewInt(value).Bytes())
+	}
+
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 100,
+			Arguments:   arguments,
+		},
+		RecipientAddr: recipient,
+	}
+}
+
+func setESDTBalance(t *testing.T, marshalizer marshal.Marshalizer, acnt state.UserAccountHandler, tokenID []byte, value int64) {
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + string(tokenID))
+	err := saveESDTData(acnt, &ESDigitalToken{Value: big.NewInt(value)}, esdtTokenKey, marshalizer)
+	require.Nil(t, err)
+}
+
+func getESDTBalance(marshalizer marshal.Marshalizer, acnt state.UserAccountHandler, tokenID []byte) *big.Int {
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + string(tokenID))
+	esdtData, _ := getESDTDataFromKey(acnt, esdtTokenKey, marshalizer)
+
+	return esdtData.Value
+}
+
+func TestNewESDTMultiTransferFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer, err := NewESDTMultiTransferFunc(10, nil, &mock.PauseHandlerStub{}, smartContract.NewArgumentParser())
+	assert.Nil(t, multiTransfer)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	multiTransfer, err = NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, nil, smartContract.NewArgumentParser())
+	assert.Nil(t, multiTransfer)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	multiTransfer, err = NewESDTMultiTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil)
+	assert.Nil(t, multiTransfer)
+	assert.Equal(t, process.ErrNilMultiESDTTransferParser, err)
+}
+
+func TestEsdtMultiTransfer_ProcessBuiltinFunctionNotEnoughGasShouldErr(t *testing.T) {
+	t.Parallel()
+
+	multiTransfer, _ := NewESDTMultiTransferFunc(60, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, smartContract.NewArgumentParser())
+	acntSnd, _ := state.NewUserAccount([]byte("snd"))
+
+	_, err := multiTransfer.ProcessBuiltinFunction(acntSnd, nil, createMultiTransferInput([]byte("snd"), []byte("dst"), 1, 2))
+	assert.Equal(t, process.ErrNotEnoughGas, err)
+}
+
+func TestEsdtMultiTransfer_ProcessBuiltinFunctionInsufficientFundsShouldNotChangeBalances(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	multiTransfer, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, smartContract.NewArgumentParser())
+	acntSnd, _ := state.NewUserAccount([]byte("snd"))
+	setESDTBalance(t, marshalizer, acntSnd, []byte("a"), 100)
+	setESDTBalance(t, marshalizer, acntSnd, []byte("b"), 5)
+
+	_, err := multiTransfer.ProcessBuiltinFunction(acntSnd, nil, createMultiTransferInput([]byte("snd"), []byte("dst"), 10, 20))
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+	assert.Equal(t, big.NewInt(100), getESDTBalance(marshalizer, acntSnd, []byte("a")))
+	assert.Equal(t, big.NewInt(5), getESDTBalance(marshalizer, acntSnd, []byte("b")))
+}
+
+func TestEsdtMultiTransfer_ProcessBuiltinFunctionSingleShard(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	multiTransfer, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, smartContract.NewArgumentParser())
+	_ = multiTransfer.setPayableHandler(&mock.PayableHandlerStub{})
+	acntSnd, _ := state.NewUserAccount([]byte("snd"))
+	acntDst, _ := state.NewUserAccount([]byte("dst"))
+	setESDTBalance(t, marshalizer, acntSnd, []byte("a"), 100)
+	setESDTBalance(t, marshalizer, acntSnd, []byte("b"), 50)
+
+	input := createMultiTransferInput([]byte("snd"), []byte("dst"), 10, 20)
+	input.CallValue = big.NewInt(7)
+	vmOutput, err := multiTransfer.ProcessBuiltinFunction(acntSnd, acntDst, input)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(80), vmOutput.GasRemaining)
+
+	assert.Equal(t, big.NewInt(90), getESDTBalance(marshalizer, acntSnd, []byte("a")))
+	assert.Equal(t, big.NewInt(30), getESDTBalance(marshalizer, acntSnd, []byte("b")))
+	assert.Equal(t, big.NewInt(10), getESDTBalance(marshalizer, acntDst, []byte("a")))
+	assert.Equal(t, big.NewInt(20), getESDTBalance(marshalizer, acntDst, []byte("b")))
+	assert.Equal(t, big.NewInt(7), acntDst.GetBalance())
+}
+
+func TestEsdtMultiTransfer_ProcessBuiltinFunctionWithSCCallShouldForwardValue(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	multiTransfer, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, smartContract.NewArgumentParser())
+	scAddress := make([]byte, 32)
+	copy(scAddress[len(scAddress)-1:], "s")
+	acntDst, _ := state.NewUserAccount(scAddress)
+
+	input := createMultiTransferInput([]byte("snd"), scAddress, 10, 20)
+	input.CallValue = big.NewInt(7)
+	input.Arguments = append(input.Arguments, []byte("swap"), []byte("arg"))
+	vmOutput, err := multiTransfer.ProcessBuiltinFunction(nil, acntDst, input)
+	require.Nil(t, err)
+
+	assert.Equal(t, big.NewInt(10), getESDTBalance(marshalizer, acntDst, []byte("a")))
+	assert.Equal(t, big.NewInt(0), acntDst.GetBalance())
+
+	outputAccount := vmOutput.OutputAccounts[string(scAddress)]
+	require.NotNil(t, outputAccount)
+	assert.Equal(t, "swap@"+hex.EncodeToString([]byte("arg")), string(outputAccount.OutputTransfers[0].Data))
+	assert.Equal(t, big.NewInt(7), outputAccount.OutputTransfers[0].Value)
+}
+
+func TestEsdtMultiTransfer_ProcessBuiltinFunctionCrossShardFromSmartContract(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	multiTransfer, _ := NewESDTMultiTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, smartContract.NewArgumentParser())
+	scAddress := make([]byte, 32)
+	copy(scAddress[len(scAddress)-1:], "s")
+	acntSnd, _ := state.NewUserAccount(scAddress)
+	setESDTBalance(t, marshalizer, acntSnd, []byte("a"), 100)
+
+	input := createMultiTransferInput(scAddress, []byte("dst"), 10)
+	vmOutput, err := multiTransfer.ProcessBuiltinFunction(acntSnd, nil, input)
+	require.Nil(t, err)
+
+	outputAccount := vmOutput.OutputAccounts["dst"]
+	require.NotNil(t, outputAccount)
+	assert.True(t, bytes.HasPrefix(outputAccount.OutputTransfers[0].Data, []byte(core.BuiltInFunctionMultiESDTTransfer+"@")))
+}
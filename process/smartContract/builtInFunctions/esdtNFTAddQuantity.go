@@ -0,0 +1,88 @@
+package builtInFunctions
+
+import (
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.BuiltinFunction = (*esdtNFTAddQuantity)(nil)
+
+type esdtNFTAddQuantity struct {
+	keyPrefix   []byte
+	marshalizer marshal.Marshalizer
+	funcGasCost uint64
+}
+
+// NewESDTNFTAddQuantityFunc returns the esdt NFT add quantity built-in function component
+func NewESDTNFTAddQuantityFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+) (*esdtNFTAddQuantity, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	e := &esdtNFTAddQuantity{
+		keyPrefix:   []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		marshalizer: marshalizer,
+		funcGasCost: funcGasCost,
+	}
+
+	return e, nil
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT add quantity function call
+// Requires the following arguments:
+// arg0 - token identifier
+// arg1 - nonce
+// arg2 - quantity to add
+func (e *esdtNFTAddQuantity) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	err := checkESDTNFTCreateBurnAddInput(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) != 3 {
+		return nil, process.ErrInvalidArguments
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err = checkESDTRoleForAccount(e.marshalizer, acntSnd, tokenID, []byte(core.ESDTRoleNFTAddQuantity))
+	if err != nil {
+		return nil, err
+	}
+
+	value := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if value.Cmp(zero) <= 0 {
+		return nil, process.ErrNegativeValue
+	}
+
+	esdtTokenKey := append(e.keyPrefix, tokenID...)
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	esdtData, err := getESDTNFTTokenOnSender(acntSnd, esdtTokenKey, nonce, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtData.Value.Add(esdtData.Value, value)
+	err = saveESDTNFTToken(acntSnd, esdtTokenKey, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{GasRemaining: vmInput.GasProvided - e.funcGasCost}
+	return vmOutput, nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTAddQuantity) IsInterfaceNil() bool {
+	return e == nil
+}
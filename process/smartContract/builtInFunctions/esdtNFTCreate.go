@@ -0,0 +1,256 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const maxRoyalties = 10000
+const minNumOfArgsForNFTCreate = 7
+const nftNonceLength = 8
+
+const nonceKeyPrefix = core.ElrondProtectedKeyPrefix + core.ESDTNFTLatestNonceIdentifier
+
+var _ process.BuiltinFunction = (*esdtNFTCreate)(nil)
+
+type esdtNFTCreate struct {
+	keyPrefix   []byte
+	marshalizer marshal.Marshalizer
+	funcGasCost uint64
+	gasConfig   BaseOperationCost
+}
+
+// NewESDTNFTCreateFunc returns the esdt NFT create built-in function component
+func NewESDTNFTCreateFunc(
+	funcGasCost uint64,
+	gasConfig BaseOperationCost,
+	marshalizer marshal.Marshalizer,
+) (*esdtNFTCreate, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	e := &esdtNFTCreate{
+		keyPrefix:   []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		marshalizer: marshalizer,
+		funcGasCost: funcGasCost,
+		gasConfig:   gasConfig,
+	}
+
+	return e, nil
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT create function call
+// Requires the following arguments:
+// arg0 - token identifier
+// arg1 - initial quantity
+// arg2 - NFT name
+// arg3 - royalties - max 10000
+// arg4 - hash
+// arg5 - attributes
+// arg6+ - multiple entries of URI (minimum 1)
+func (e *esdtNFTCreate) ProcessBuiltinFunction(
+	acntSnd, _ state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	err := checkESDTNFTCreateBurnAddInput(acntSnd, vmInput, e.funcGasCost)
+	if err != nil {
+		return nil, err
+	}
+	if len(vmInput.Arguments) < minNumOfArgsForNFTCreate {
+		return nil, process.ErrInvalidArguments
+	}
+
+	tokenID := vmInput.Arguments[0]
+	err = checkESDTRoleForAccount(e.marshalizer, acntSnd, tokenID, []byte(core.ESDTRoleNFTCreate))
+	if err != nil {
+		return nil, err
+	}
+
+	totalLength := uint64(0)
+	for _, arg := range vmInput.Arguments {
+		totalLength += uint64(len(arg))
+	}
+	gasToUse := totalLength*e.gasConfig.StorePerByte + e.funcGasCost
+	if vmInput.GasProvided < gasToUse {
+		return nil, process.ErrNotEnoughGas
+	}
+
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[1])
+	if quantity.Cmp(zero) <= 0 {
+		return nil, process.ErrNegativeValue
+	}
+	if quantity.Cmp(big.NewInt(1)) > 0 {
+		err = checkESDTRoleForAccount(e.marshalizer, acntSnd, tokenID, []byte(core.ESDTRoleNFTAddQuantity))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	royalties := big.NewInt(0).SetBytes(vmInput.Arguments[3])
+	if !royalties.IsUint64() || royalties.Uint64() > maxRoyalties {
+		return nil, process.ErrInvalidRoyalties
+	}
+
+	nextNonce := getLatestNonce(acntSnd, tokenID) + 1
+	esdtData := &ESDigitalToken{
+		Type:  uint32(core.NonFungible),
+		Value: quantity,
+		TokenMetaData: &MetaData{
+			Nonce:      nextNonce,
+			Name:       vmInput.Arguments[2],
+			Creator:    vmInput.CallerAddr,
+			Royalties:  uint32(royalties.Uint64()),
+			Hash:       vmInput.Arguments[4],
+			Attributes: vmInput.Arguments[5],
+			URIs:       vmInput.Arguments[6:],
+		},
+	}
+
+	esdtTokenKey := append(e.keyPrefix, tokenID...)
+	err = saveESDTNFTToken(acntSnd, esdtTokenKey, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	saveLatestNonce(acntSnd, tokenID, nextNonce)
+	log.Trace("esdtNFTCreate", "creator", vmInput.CallerAddr, "token", tokenID, "nonce", nextNonce, "quantity", quantity)
+
+	vmOutput := &vmcommon.VMOutput{
+		GasRemaining: vmInput.GasProvided - gasToUse,
+		ReturnData:   [][]byte{big.NewInt(0).SetUint64(nextNonce).Bytes()},
+	}
+	return vmOutput, nil
+}
+
+func getLatestNonce(acnt state.UserAccountHandler, tokenID []byte) uint64 {
+	nonceKey := []byte(nonceKeyPrefix + string(tokenID))
+	nonceData, err := acnt.DataTrieTracker().RetrieveValue(nonceKey)
+	if err != nil || len(nonceData) == 0 {
+		return 0
+	}
+
+	return big.NewInt(0).SetBytes(nonceData).Uint64()
+}
+
+func saveLatestNonce(acnt state.UserAccountHandler, tokenID []byte, nonce uint64) {
+	nonceKey := []byte(nonceKeyPrefix + string(tokenID))
+	acnt.DataTrieTracker().SaveKeyValue(nonceKey, big.NewInt(0).SetUint64(nonce).Bytes())
+}
+
+// ComputeESDTNFTTokenKey returns the key under which the provided nonce of a non-fungible token is saved in an account's
+// data trie. The nonce is appended on a fixed width so the key can not collide with the key of another token or nonce
+func ComputeESDTNFTTokenKey(esdtTokenKey []byte, nonce uint64) []byte {
+	esdtNFTTokenKey := make([]byte, len(esdtTokenKey)+nftNonceLength)
+	copy(esdtNFTTokenKey, esdtTokenKey)
+	binary.BigEndian.PutUint64(esdtNFTTokenKey[len(esdtTokenKey):], nonce)
+
+	return esdtNFTTokenKey
+}
+
+func getESDTNFTTokenOnSender(
+	acnt state.UserAccountHandler,
+	esdtTokenKey []byte,
+	nonce uint64,
+	marshalizer marshal.Marshalizer,
+) (*ESDigitalToken, error) {
+	esdtData, isNew, err := getESDTNFTTokenOnDestination(acnt, esdtTokenKey, nonce, marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	if isNew {
+		return nil, process.ErrNFTTokenDoesNotExist
+	}
+
+	return esdtData, nil
+}
+
+func getESDTNFTTokenOnDestination(
+	acnt state.UserAccountHandler,
+	esdtTokenKey []byte,
+	nonce uint64,
+	marshalizer marshal.Marshalizer,
+) (*ESDigitalToken, bool, error) {
+	esdtNFTTokenKey := ComputeESDTNFTTokenKey(esdtTokenKey, nonce)
+	esdtData := &ESDigitalToken{
+		Value: big.NewInt(0),
+		Type:  uint32(core.NonFungible),
+	}
+	marshaledData, err := acnt.DataTrieTracker().RetrieveValue(esdtNFTTokenKey)
+	if err != nil || len(marshaledData) == 0 {
+		return esdtData, true, nil
+	}
+
+	err = marshalizer.Unmarshal(esdtData, marshaledData)
+	if err != nil {
+		return nil, false, err
+	}
+	if esdtData.TokenMetaData == nil || esdtData.Type != uint32(core.NonFungible) {
+		return nil, false, process.ErrNFTTokenDoesNotExist
+	}
+
+	return esdtData, false, nil
+}
+
+// saveESDTNFTToken saves the token under the key computed from its nonce, removing it when the quantity reaches 0
+func saveESDTNFTToken(
+	acnt state.UserAccountHandler,
+	esdtTokenKey []byte,
+	esdtData *ESDigitalToken,
+	marshalizer marshal.Marshalizer,
+) error {
+	nonce := uint64(0)
+	if esdtData.TokenMetaData != nil {
+		nonce = esdtData.TokenMetaData.Nonce
+	}
+	esdtNFTTokenKey := ComputeESDTNFTTokenKey(esdtTokenKey, nonce)
+	if esdtData.Value.Cmp(zero) <= 0 {
+		acnt.DataTrieTracker().SaveKeyValue(esdtNFTTokenKey, nil)
+		return nil
+	}
+
+	marshaledData, err := marshalizer.Marshal(esdtData)
+	if err != nil {
+		return err
+	}
+
+	acnt.DataTrieTracker().SaveKeyValue(esdtNFTTokenKey, marshaledData)
+	return nil
+}
+
+func checkESDTNFTCreateBurnAddInput(
+	acnt state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+	funcGasCost uint64,
+) error {
+	if vmInput == nil {
+		return process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return process.ErrBuiltInFunctionCalledWithValue
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return process.ErrBuiltInFunctionNotCalledOnOwnAddress
+	}
+	if check.IfNil(acnt) {
+		return process.ErrNilUserAccount
+	}
+	if vmInput.GasProvided < funcGasCost {
+		return process.ErrNotEnoughGas
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTCreate) IsInterfaceNil() bool {
+	return e == nil
+}
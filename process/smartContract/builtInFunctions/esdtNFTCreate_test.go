@@ -0,0 +1,208 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setRolesOnAccount(t *testing.T, marshalizer marshal.Marshalizer, acnt state.UserAccountHandler, tokenID []byte, roles ...string) {
+	rolesKey := []byte(roleKeyPrefix + string(tokenID))
+	esdtRoles := &ESDTRoles{}
+	for _, role := range roles {
+		esdtRoles.Roles = append(esdtRoles.Roles, []byte(role))
+	}
+	err := saveRolesToAccount(marshalizer, acnt, rolesKey, esdtRoles)
+	require.Nil(t, err)
+}
+
+func createNFTCreateInput(caller []byte, tokenID []byte, quantity int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+			Arguments: [][]byte{
+				tokenID,
+				big.NewInt(quantity).Bytes(),
+				[]byte("name"),
+				big.NewInt(500).Bytes(),
+				[]byte("hash"),
+				[]byte("attributes"),
+				[]byte("uri"),
+			},
+		},
+		RecipientAddr: caller,
+	}
+}
+
+func TestNewESDTNFTCreateFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftCreate, err := NewESDTNFTCreateFunc(10, BaseOperationCost{}, nil)
+
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.Nil(t, nftCreate)
+}
+
+func TestEsdtNFTCreate_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	nftCreate, _ := NewESDTNFTCreateFunc(10, BaseOperationCost{}, marshalizer)
+	caller := []byte("caller")
+	tokenID := []byte("token")
+	acnt, _ := state.NewUserAccount(caller)
+
+	_, err := nftCreate.ProcessBuiltinFunction(acnt, acnt, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := createNFTCreateInput(caller, tokenID, 1)
+	input.RecipientAddr = []byte("other")
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionNotCalledOnOwnAddress, err)
+
+	input = createNFTCreateInput(caller, tokenID, 1)
+	_, err = nftCreate.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	setRolesOnAccount(t, marshalizer, acnt, tokenID, core.ESDTRoleNFTCreate)
+	input = createNFTCreateInput(caller, tokenID, 10)
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	input = createNFTCreateInput(caller, tokenID, 1)
+	input.Arguments[3] = big.NewInt(maxRoyalties + 1).Bytes()
+	_, err = nftCreate.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInvalidRoyalties, err)
+}
+
+func TestEsdtNFTCreate_ProcessBuiltinFunctionShouldIncrementNonce(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	nftCreate, _ := NewESDTNFTCreateFunc(10, BaseOperationCost{}, marshalizer)
+	caller := []byte("caller")
+	tokenID := []byte("token")
+	acnt, _ := state.NewUserAccount(caller)
+	setRolesOnAccount(t, marshalizer, acnt, tokenID, core.ESDTRoleNFTCreate)
+
+	for expectedNonce := uint64(1); expectedNonce <= 2; expectedNonce++ {
+		vmOutput, err := nftCreate.ProcessBuiltinFunction(acnt, acnt, createNFTCreateInput(caller, tokenID, 1))
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(0).SetUint64(expectedNonce).Bytes(), vmOutput.ReturnData[0])
+	}
+
+	esdtTokenKey := append(nftCreate.keyPrefix, tokenID...)
+	esdtData, err := getESDTNFTTokenOnSender(acnt, esdtTokenKey, 2, marshalizer)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(1), esdtData.Value)
+	assert.Equal(t, uint32(500), esdtData.TokenMetaData.Royalties)
+	assert.Equal(t, caller, esdtData.TokenMetaData.Creator)
+	assert.Equal(t, [][]byte{[]byte("uri")}, esdtData.TokenMetaData.URIs)
+}
+
+func TestEsdtNFTAddQuantityAndBurn_ShouldChangeQuantity(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	nftCreate, _ := NewESDTNFTCreateFunc(10, BaseOperationCost{}, marshalizer)
+	nftAddQuantity, _ := NewESDTNFTAddQuantityFunc(10, marshalizer)
+	nftBurn, _ := NewESDTNFTBurnFunc(10, marshalizer)
+	caller := []byte("caller")
+	tokenID := []byte("token")
+	acnt, _ := state.NewUserAccount(caller)
+	setRolesOnAccount(t, marshalizer, acnt, tokenID, core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity)
+
+	_, err := nftCreate.ProcessBuiltinFunction(acnt, acnt, createNFTCreateInput(caller, tokenID, 10))
+	require.Nil(t, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  caller,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+			Arguments:   [][]byte{tokenID, big.NewInt(1).Bytes(), big.NewInt(5).Bytes()},
+		},
+		RecipientAddr: caller,
+	}
+	_, err = nftAddQuantity.ProcessBuiltinFunction(acnt, acnt, input)
+	require.Nil(t, err)
+
+	_, err = nftBurn.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrActionNotAllowed, err)
+
+	setRolesOnAccount(t, marshalizer, acnt, tokenID, core.ESDTRoleNFTBurn)
+	input.Arguments[2] = big.NewInt(16).Bytes()
+	_, err = nftBurn.ProcessBuiltinFunction(acnt, acnt, input)
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+
+	input.Arguments[2] = big.NewInt(15).Bytes()
+	_, err = nftBurn.ProcessBuiltinFunction(acnt, acnt, input)
+	require.Nil(t, err)
+
+	esdtTokenKey := append(nftCreate.keyPrefix, tokenID...)
+	_, err = getESDTNFTTokenOnSender(acnt, esdtTokenKey, 1, marshalizer)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+}
+
+func TestComputeESDTNFTTokenKey_ShouldNotCollide(t *testing.T) {
+	t.Parallel()
+
+	keyPrefix := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+	tokenAB := append(append([]byte{}, keyPrefix...), []byte("AB")...)
+	tokenABC := append(append([]byte{}, keyPrefix...), []byte("ABC")...)
+
+	assert.NotEqual(t, tokenABC, ComputeESDTNFTTokenKey(tokenAB, 0x43))
+	assert.NotEqual(t, ComputeESDTNFTTokenKey(tokenABC, 1), ComputeESDTNFTTokenKey(tokenAB, 0x4301))
+	assert.NotEqual(t, ComputeESDTNFTTokenKey(tokenAB, 1), ComputeESDTNFTTokenKey(tokenAB, 256))
+	assert.Equal(t, len(tokenAB)+nftNonceLength, len(ComputeESDTNFTTokenKey(tokenAB, 0)))
+}
+
+func TestGetESDTNFTTokenOnDestination_ShouldNotMergeWithOtherTokens(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	keyPrefix := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+	tokenAB := append(append([]byte{}, keyPrefix...), []byte("AB")...)
+	tokenABC := append(append([]byte{}, keyPrefix...), []byte("ABC")...)
+	acnt, _ := state.NewUserAccount([]byte("address"))
+
+	fungibleBalance := &ESDigitalToken{Value: big.NewInt(7), Type: uint32(core.Fungible)}
+	marshaledBalance, _ := marshalizer.Marshal(fungibleBalance)
+	acnt.DataTrieTracker().SaveKeyValue(tokenABC, marshaledBalance)
+
+	nft := &ESDigitalToken{
+		Value:         big.NewInt(5),
+		Type:          uint32(core.NonFungible),
+		TokenMetaData: &MetaData{Nonce: 0x4301},
+	}
+	err := saveESDTNFTToken(acnt, tokenAB, nft, marshalizer)
+	require.Nil(t, err)
+
+	esdtData, isNew, err := getESDTNFTTokenOnDestination(acnt, tokenAB, 0x43, marshalizer)
+	require.Nil(t, err)
+	assert.True(t, isNew)
+	assert.Equal(t, big.NewInt(0), esdtData.Value)
+
+	esdtData, isNew, err = getESDTNFTTokenOnDestination(acnt, tokenABC, 1, marshalizer)
+	require.Nil(t, err)
+	assert.True(t, isNew)
+	assert.Equal(t, big.NewInt(0), esdtData.Value)
+
+	esdtData, isNew, err = getESDTNFTTokenOnDestination(acnt, tokenAB, 0x4301, marshalizer)
+	require.Nil(t, err)
+	assert.False(t, isNew)
+	assert.Equal(t, big.NewInt(5), esdtData.Value)
+}
@@ -0,0 +1,318 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const minNumOfArgsForNFTTransfer = 4
+
+var _ process.BuiltinFunction = (*esdtNFTTransfer)(nil)
+
+type esdtNFTTransfer struct {
+	keyPrefix        []byte
+	marshalizer      marshal.Marshalizer
+	pauseHandler     process.ESDTPauseHandler
+	payableHandler   process.PayableHandler
+	funcGasCost      uint64
+	accounts         state.AccountsAdapter
+	shardCoordinator sharding.Coordinator
+}
+
+// NewESDTNFTTransferFunc returns the esdt NFT transfer built-in function component
+func NewESDTNFTTransferFunc(
+	funcGasCost uint64,
+	marshalizer marshal.Marshalizer,
+	pauseHandler process.ESDTPauseHandler,
+	accounts state.AccountsAdapter,
+	shardCoordinator sharding.Coordinator,
+) (*esdtNFTTransfer, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(pauseHandler) {
+		return nil, process.ErrNilPauseHandler
+	}
+	if check.IfNil(accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(shardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+
+	e := &esdtNFTTransfer{
+		keyPrefix:        []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
+		marshalizer:      marshalizer,
+		pauseHandler:     pauseHandler,
+		payableHandler:   &disabledPayableHandler{},
+		funcGasCost:      funcGasCost,
+		accounts:         accounts,
+		shardCoordinator: shardCoordinator,
+	}
+
+	return e, nil
+}
+
+// ProcessBuiltinFunction resolves ESDT NFT transfer function call
+// Requires the following arguments:
+// arg0 - token identifier
+// arg1 - nonce
+// arg2 - quantity to transfer
+// arg3 - destination address
+// the optional arguments after arg3 are the function and its arguments to be called on the destination smart contract
+// On the destination shard the call comes from the sender shard and arg3 holds the marshaled token data instead
+func (e *esdtNFTTransfer) ProcessBuiltinFunction(
+	acntSnd, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) < minNumOfArgsForNFTTransfer {
+		return nil, process.ErrInvalidArguments
+	}
+
+	if check.IfNil(acntSnd) {
+		return e.processNFTTransferOnDestinationShard(acntDst, vmInput)
+	}
+
+	// gas is paid only by sender
+	if vmInput.GasProvided < e.funcGasCost {
+		return nil, process.ErrNotEnoughGas
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vmInput.RecipientAddr) {
+		return nil, process.ErrBuiltInFunctionNotCalledOnOwnAddress
+	}
+
+	dstAddress := vmInput.Arguments[3]
+	if len(dstAddress) != len(vmInput.CallerAddr) || bytes.Equal(dstAddress, vmInput.CallerAddr) {
+		return nil, process.ErrInvalidArguments
+	}
+
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	if quantity.Cmp(zero) <= 0 {
+		return nil, process.ErrNegativeValue
+	}
+
+	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	esdtData, err := getESDTNFTTokenOnSender(acntSnd, esdtTokenKey, nonce, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+	if esdtData.Value.Cmp(quantity) < 0 {
+		return nil, process.ErrInsufficientFunds
+	}
+
+	err = checkFrozeAndPause(vmInput.CallerAddr, esdtTokenKey, esdtData, e.pauseHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtData.Value.Sub(esdtData.Value, quantity)
+	err = saveESDTNFTToken(acntSnd, esdtTokenKey, esdtData, e.marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Trace("esdtNFTTransfer", "sender", vmInput.CallerAddr, "receiver", dstAddress, "quantity", quantity, "token", esdtTokenKey, "nonce", nonce)
+
+	esdtData.Value.Set(quantity)
+	vmOutput := &vmcommon.VMOutput{GasRemaining: vmInput.GasProvided - e.funcGasCost}
+	if !e.shardCoordinator.SameShard(vmInput.CallerAddr, dstAddress) {
+		err = e.createNFTOutputTransfer(vmInput, dstAddress, esdtData, vmOutput)
+		if err != nil {
+			return nil, err
+		}
+
+		return vmOutput, nil
+	}
+
+	err = e.transferNFTToDestinationInShard(vmInput, dstAddress, esdtTokenKey, esdtData, vmOutput)
+	if err != nil {
+		return nil, err
+	}
+
+	return vmOutput, nil
+}
+
+func (e *esdtNFTTransfer) processNFTTransferOnDestinationShard(
+	acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	esdtTransferData := &ESDigitalToken{}
+	err := e.marshalizer.Unmarshal(esdtTransferData, vmInput.Arguments[3])
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := big.NewInt(0).SetBytes(vmInput.Arguments[1]).Uint64()
+	quantity := big.NewInt(0).SetBytes(vmInput.Arguments[2])
+	isValidTransferData := esdtTransferData.TokenMetaData != nil && esdtTransferData.Value != nil &&
+		esdtTransferData.TokenMetaData.Nonce == nonce && esdtTransferData.Value.Cmp(quantity) == 0
+	if !isValidTransferData {
+		return nil, process.ErrInvalidArguments
+	}
+
+	err = e.checkPayable(vmInput, vmInput.RecipientAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtTokenKey := append(e.keyPrefix, vmInput.Arguments[0]...)
+	err = e.addNFTToDestination(vmInput.CallerAddr, acntDst, esdtTokenKey, esdtTransferData)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{GasRemaining: vmInput.GasProvided}
+	if core.IsSmartContractAddress(vmInput.RecipientAddr) && len(vmInput.Arguments) > minNumOfArgsForNFTTransfer {
+		addOutPutTransferToVMOutput(
+			string(vmInput.Arguments[minNumOfArgsForNFTTransfer]),
+			vmInput.Arguments[minNumOfArgsForNFTTransfer+1:],
+			vmInput.RecipientAddr,
+			vmOutput)
+	}
+
+	return vmOutput, nil
+}
+
+func (e *esdtNFTTransfer) transferNFTToDestinationInShard(
+	vmInput *vmcommon.ContractCallInput,
+	dstAddress []byte,
+	esdtTokenKey []byte,
+	esdtTransferData *ESDigitalToken,
+	vmOutput *vmcommon.VMOutput,
+) error {
+	err := e.checkPayable(vmInput, dstAddress)
+	if err != nil {
+		return err
+	}
+
+	accountHandler, err := e.accounts.LoadAccount(dstAddress)
+	if err != nil {
+		return err
+	}
+	userAccount, ok := accountHandler.(state.UserAccountHandler)
+	if !ok {
+		return process.ErrWrongTypeAssertion
+	}
+
+	err = e.addNFTToDestination(vmInput.CallerAddr, userAccount, esdtTokenKey, esdtTransferData)
+	if err != nil {
+		return err
+	}
+
+	err = e.accounts.SaveAccount(userAccount)
+	if err != nil {
+		return err
+	}
+
+	if core.IsSmartContractAddress(dstAddress) && len(vmInput.Arguments) > minNumOfArgsForNFTTransfer {
+		addOutPutTransferToVMOutput(
+			string(vmInput.Arguments[minNumOfArgsForNFTTransfer]),
+			vmInput.Arguments[minNumOfArgsForNFTTransfer+1:],
+			dstAddress,
+			vmOutput)
+	}
+
+	return nil
+}
+
+func (e *esdtNFTTransfer) createNFTOutputTransfer(
+	vmInput *vmcommon.ContractCallInput,
+	dstAddress []byte,
+	esdtTransferData *ESDigitalToken,
+	vmOutput *vmcommon.VMOutput,
+) error {
+	marshaledNFTTransfer, err := e.marshalizer.Marshal(esdtTransferData)
+	if err != nil {
+		return err
+	}
+
+	arguments := [][]byte{
+		vmInput.Arguments[0],
+		vmInput.Arguments[1],
+		vmInput.Arguments[2],
+		marshaledNFTTransfer,
+	}
+	arguments = append(arguments, vmInput.Arguments[minNumOfArgsForNFTTransfer:]...)
+
+	addOutPutTransferToVMOutput(
+		core.BuiltInFunctionESDTNFTTransfer,
+		arguments,
+		dstAddress,
+		vmOutput)
+
+	return nil
+}
+
+func (e *esdtNFTTransfer) addNFTToDestination(
+	senderAddress []byte,
+	userAccount state.UserAccountHandler,
+	esdtTokenKey []byte,
+	esdtTransferData *ESDigitalToken,
+) error {
+	nonce := esdtTransferData.TokenMetaData.Nonce
+	currentESDTData, _, err := getESDTNFTTokenOnDestination(userAccount, esdtTokenKey, nonce, e.marshalizer)
+	if err != nil {
+		return err
+	}
+
+	err = checkFrozeAndPause(senderAddress, esdtTokenKey, currentESDTData, e.pauseHandler)
+	if err != nil {
+		return err
+	}
+
+	currentESDTData.TokenMetaData = esdtTransferData.TokenMetaData
+	currentESDTData.Value.Add(currentESDTData.Value, esdtTransferData.Value)
+
+	return saveESDTNFTToken(userAccount, esdtTokenKey, currentESDTData, e.marshalizer)
+}
+
+func (e *esdtNFTTransfer) checkPayable(vmInput *vmcommon.ContractCallInput, dstAddress []byte) error {
+	mustVerifyPayable := vmInput.CallType != vmcommon.AsynchronousCallBack && !bytes.Equal(vmInput.CallerAddr, vm.ESDTSCAddress)
+	if !mustVerifyPayable || len(vmInput.Arguments) != minNumOfArgsForNFTTransfer {
+		return nil
+	}
+
+	isPayable, err := e.payableHandler.IsPayable(dstAddress)
+	if err != nil {
+		return err
+	}
+	if !isPayable {
+		return process.ErrAccountNotPayable
+	}
+
+	return nil
+}
+
+func (e *esdtNFTTransfer) setPayableHandler(payableHandler process.PayableHandler) error {
+	if check.IfNil(payableHandler) {
+		return process.ErrNilPayableHandler
+	}
+
+	e.payableHandler = payableHandler
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtNFTTransfer) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,162 @@
+package builtInFunctions
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNFTTransferAndSender(
+	t *testing.T,
+	accounts state.AccountsAdapter,
+	sameShard bool,
+	sender []byte,
+	tokenID []byte,
+) (*esdtNFTTransfer, state.UserAccountHandler) {
+	marshalizer := &mock.MarshalizerMock{}
+	shardCoordinator := &mock.CoordinatorStub{
+		SameShardCalled: func(_, _ []byte) bool {
+			return sameShard
+		},
+	}
+	nftTransfer, err := NewESDTNFTTransferFunc(10, marshalizer, &mock.PauseHandlerStub{}, accounts, shardCoordinator)
+	require.Nil(t, err)
+	_ = nftTransfer.setPayableHandler(&mock.PayableHandlerStub{})
+
+	nftCreate, _ := NewESDTNFTCreateFunc(10, BaseOperationCost{}, marshalizer)
+	acntSnd, _ := state.NewUserAccount(sender)
+	setRolesOnAccount(t, marshalizer, acntSnd, tokenID, core.ESDTRoleNFTCreate, core.ESDTRoleNFTAddQuantity)
+	_, err = nftCreate.ProcessBuiltinFunction(acntSnd, acntSnd, createNFTCreateInput(sender, tokenID, 10))
+	require.Nil(t, err)
+
+	return nftTransfer, acntSnd
+}
+
+func createNFTTransferInput(sender []byte, tokenID []byte, quantity int64, destination []byte) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  sender,
+			CallValue:   big.NewInt(0),
+			GasProvided: 100,
+			Arguments:   [][]byte{tokenID, big.NewInt(1).Bytes(), big.NewInt(quantity).Bytes(), destination},
+		},
+		RecipientAddr: sender,
+	}
+}
+
+func TestNewESDTNFTTransferFunc_NilArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	nftTransfer, err := NewESDTNFTTransferFunc(10, nil, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, &mock.CoordinatorStub{})
+	assert.Nil(t, nftTransfer)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	nftTransfer, err = NewESDTNFTTransferFunc(10, &mock.MarshalizerMock{}, nil, &mock.AccountsStub{}, &mock.CoordinatorStub{})
+	assert.Nil(t, nftTransfer)
+	assert.Equal(t, process.ErrNilPauseHandler, err)
+
+	nftTransfer, err = NewESDTNFTTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, nil, &mock.CoordinatorStub{})
+	assert.Nil(t, nftTransfer)
+	assert.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	nftTransfer, err = NewESDTNFTTransferFunc(10, &mock.MarshalizerMock{}, &mock.PauseHandlerStub{}, &mock.AccountsStub{}, nil)
+	assert.Nil(t, nftTransfer)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+}
+
+func TestEsdtNFTTransfer_ProcessBuiltinFunctionOnSameShard(t *testing.T) {
+	t.Parallel()
+
+	sender := bytes.Repeat([]byte{1}, 32)
+	destination := bytes.Repeat([]byte{2}, 32)
+	tokenID := []byte("token")
+	acntDst, _ := state.NewUserAccount(destination)
+	savedDestination := false
+	accounts := &mock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (state.AccountHandler, error) {
+			return acntDst, nil
+		},
+		SaveAccountCalled: func(account state.AccountHandler) error {
+			savedDestination = bytes.Equal(account.AddressBytes(), destination)
+			return nil
+		},
+	}
+	nftTransfer, acntSnd := createNFTTransferAndSender(t, accounts, true, sender, tokenID)
+
+	_, err := nftTransfer.ProcessBuiltinFunction(acntSnd, acntSnd, createNFTTransferInput(sender, tokenID, 11, destination))
+	assert.Equal(t, process.ErrInsufficientFunds, err)
+
+	_, err = nftTransfer.ProcessBuiltinFunction(acntSnd, acntSnd, createNFTTransferInput(sender, tokenID, 4, destination))
+	require.Nil(t, err)
+	assert.True(t, savedDestination)
+
+	esdtTokenKey := append(nftTransfer.keyPrefix, tokenID...)
+	esdtData, err := getESDTNFTTokenOnSender(acntSnd, esdtTokenKey, 1, nftTransfer.marshalizer)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(6), esdtData.Value)
+
+	esdtData, err = getESDTNFTTokenOnSender(acntDst, esdtTokenKey, 1, nftTransfer.marshalizer)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(4), esdtData.Value)
+	assert.Equal(t, []byte("attributes"), esdtData.TokenMetaData.Attributes)
+}
+
+func TestEsdtNFTTransfer_ProcessBuiltinFunctionOnCrossShard(t *testing.T) {
+	t.Parallel()
+
+	sender := bytes.Repeat([]byte{1}, 32)
+	destination := bytes.Repeat([]byte{2}, 32)
+	tokenID := []byte("token")
+	nftTransfer, acntSnd := createNFTTransferAndSender(t, &mock.AccountsStub{}, false, sender, tokenID)
+
+	vmOutput, err := nftTransfer.ProcessBuiltinFunction(acntSnd, acntSnd, createNFTTransferInput(sender, tokenID, 10, destination))
+	require.Nil(t, err)
+
+	esdtTokenKey := append(nftTransfer.keyPrefix, tokenID...)
+	_, err = getESDTNFTTokenOnSender(acntSnd, esdtTokenKey, 1, nftTransfer.marshalizer)
+	assert.Equal(t, process.ErrNFTTokenDoesNotExist, err)
+
+	outputAccount := vmOutput.OutputAccounts[string(destination)]
+	require.NotNil(t, outputAccount)
+	require.Equal(t, 1, len(outputAccount.OutputTransfers))
+
+	tokens := strings.Split(string(outputAccount.OutputTransfers[0].Data), "@")
+	require.Equal(t, core.BuiltInFunctionESDTNFTTransfer, tokens[0])
+	arguments := make([][]byte, 0, len(tokens)-1)
+	for _, token := range tokens[1:] {
+		decoded, errDecode := hex.DecodeString(token)
+		require.Nil(t, errDecode)
+		arguments = append(arguments, decoded)
+	}
+
+	acntDst, _ := state.NewUserAccount(destination)
+	destinationInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: sender,
+			CallValue:  big.NewInt(0),
+			Arguments:  arguments,
+		},
+		RecipientAddr: destination,
+	}
+	_, err = nftTransfer.ProcessBuiltinFunction(nil, acntDst, destinationInput)
+	require.Nil(t, err)
+
+	esdtData, err := getESDTNFTTokenOnSender(acntDst, esdtTokenKey, 1, nftTransfer.marshalizer)
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(10), esdtData.Value)
+	assert.Equal(t, sender, esdtData.TokenMetaData.Creator)
+
+	destinationInput.Arguments[2] = big.NewInt(11).Bytes()
+	_, err = nftTransfer.ProcessBuiltinFunction(nil, acntDst, destinationInput)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+}
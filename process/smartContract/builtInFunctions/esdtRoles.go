@@ -0,0 +1,182 @@
+package builtInFunctions
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const roleKeyPrefix = core.ElrondProtectedKeyPrefix + core.ESDTRoleIdentifier + core.ESDTKeyIdentifier
+
+var _ process.BuiltinFunction = (*esdtRoles)(nil)
+
+type esdtRoles struct {
+	set         bool
+	marshalizer marshal.Marshalizer
+}
+
+// NewESDTRolesFunc returns the esdt set or unset role built-in function component
+func NewESDTRolesFunc(
+	marshalizer marshal.Marshalizer,
+	set bool,
+) (*esdtRoles, error) {
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	e := &esdtRoles{
+		set:         set,
+		marshalizer: marshalizer,
+	}
+
+	return e, nil
+}
+
+// ProcessBuiltinFunction resolves ESDT set and unset role function call
+func (e *esdtRoles) ProcessBuiltinFunction(
+	_, acntDst state.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return nil, process.ErrNilVmInput
+	}
+	if vmInput.CallValue.Cmp(zero) != 0 {
+		return nil, process.ErrBuiltInFunctionCalledWithValue
+	}
+	if len(vmInput.Arguments) < 2 {
+		return nil, process.ErrInvalidArguments
+	}
+	if !bytes.Equal(vmInput.CallerAddr, vm.ESDTSCAddress) {
+		return nil, process.ErrAddressIsNotESDTSystemSC
+	}
+	if check.IfNil(acntDst) {
+		return nil, process.ErrNilUserAccount
+	}
+
+	esdtTokenRoleKey := []byte(roleKeyPrefix + string(vmInput.Arguments[0]))
+	log.Trace(vmInput.Function, "sender", vmInput.CallerAddr, "receiver", vmInput.RecipientAddr, "token", esdtTokenRoleKey)
+
+	roles, err := getESDTRolesForAcnt(e.marshalizer, acntDst, esdtTokenRoleKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.set {
+		e.addRolesToAccount(roles, vmInput.Arguments[1:])
+	} else {
+		e.deleteRolesFromAccount(roles, vmInput.Arguments[1:])
+	}
+
+	err = saveRolesToAccount(e.marshalizer, acntDst, esdtTokenRoleKey, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	vmOutput := &vmcommon.VMOutput{}
+	return vmOutput, nil
+}
+
+func (e *esdtRoles) addRolesToAccount(roles *ESDTRoles, newRoles [][]byte) {
+	for _, role := range newRoles {
+		if !hasRole(roles, role) {
+			roles.Roles = append(roles.Roles, role)
+		}
+	}
+}
+
+func (e *esdtRoles) deleteRolesFromAccount(roles *ESDTRoles, deleteRoles [][]byte) {
+	remainingRoles := make([][]byte, 0, len(roles.Roles))
+	for _, role := range roles.Roles {
+		if !containsRole(deleteRoles, role) {
+			remainingRoles = append(remainingRoles, role)
+		}
+	}
+	roles.Roles = remainingRoles
+}
+
+func containsRole(roles [][]byte, role []byte) bool {
+	for _, currentRole := range roles {
+		if bytes.Equal(currentRole, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasRole(roles *ESDTRoles, role []byte) bool {
+	for _, currentRole := range roles.Roles {
+		if bytes.Equal(currentRole, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getESDTRolesForAcnt(
+	marshalizer marshal.Marshalizer,
+	acnt state.UserAccountHandler,
+	key []byte,
+) (*ESDTRoles, error) {
+	roles := &ESDTRoles{
+		Roles: make([][]byte, 0),
+	}
+
+	marshaledData, err := acnt.DataTrieTracker().RetrieveValue(key)
+	if err != nil || len(marshaledData) == 0 {
+		return roles, nil
+	}
+
+	err = marshalizer.Unmarshal(roles, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func saveRolesToAccount(
+	marshalizer marshal.Marshalizer,
+	acnt state.UserAccountHandler,
+	key []byte,
+	roles *ESDTRoles,
+) error {
+	marshaledData, err := marshalizer.Marshal(roles)
+	if err != nil {
+		return err
+	}
+
+	acnt.DataTrieTracker().SaveKeyValue(key, marshaledData)
+	return nil
+}
+
+// checkESDTRoleForAccount returns nil if the account has the given role for the token
+func checkESDTRoleForAccount(
+	marshalizer marshal.Marshalizer,
+	acnt state.UserAccountHandler,
+	tokenID []byte,
+	role []byte,
+) error {
+	esdtTokenRoleKey := []byte(roleKeyPrefix + string(tokenID))
+	roles, err := getESDTRolesForAcnt(marshalizer, acnt, esdtTokenRoleKey)
+	if err != nil {
+		return err
+	}
+	if !hasRole(roles, role) {
+		return process.ErrActionNotAllowed
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if underlying object in nil
+func (e *esdtRoles) IsInterfaceNil() bool {
+	return e == nil
+}
@@ -0,0 +1,105 @@
+package builtInFunctions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewESDTRolesFunc_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esdtRolesF, err := NewESDTRolesFunc(nil, true)
+
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+	assert.Nil(t, esdtRolesF)
+}
+
+func TestEsdtRoles_ProcessBuiltinFunctionErrors(t *testing.T) {
+	t.Parallel()
+
+	esdtRolesF, _ := NewESDTRolesFunc(&mock.MarshalizerMock{}, true)
+	_, err := esdtRolesF.ProcessBuiltinFunction(nil, nil, nil)
+	assert.Equal(t, process.ErrNilVmInput, err)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallValue: big.NewInt(1),
+		},
+	}
+	_, err = esdtRolesF.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrBuiltInFunctionCalledWithValue, err)
+
+	input.CallValue = big.NewInt(0)
+	_, err = esdtRolesF.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrInvalidArguments, err)
+
+	input.Arguments = [][]byte{[]byte("token"), []byte(core.ESDTRoleNFTCreate)}
+	input.CallerAddr = []byte("caller")
+	_, err = esdtRolesF.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrAddressIsNotESDTSystemSC, err)
+
+	input.CallerAddr = vm.ESDTSCAddress
+	_, err = esdtRolesF.ProcessBuiltinFunction(nil, nil, input)
+	assert.Equal(t, process.ErrNilUserAccount, err)
+}
+
+func TestEsdtRoles_ProcessBuiltinFunctionShouldSaveRolesOnce(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	esdtRolesF, _ := NewESDTRolesFunc(marshalizer, true)
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+	tokenID := []byte("token")
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.ESDTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{tokenID, []byte(core.ESDTRoleNFTCreate), []byte(core.ESDTRoleNFTBurn)},
+		},
+	}
+	_, err := esdtRolesF.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+
+	input.Arguments = [][]byte{tokenID, []byte(core.ESDTRoleNFTCreate)}
+	_, err = esdtRolesF.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+
+	roles, _ := getESDTRolesForAcnt(marshalizer, acnt, []byte(roleKeyPrefix+string(tokenID)))
+	assert.Equal(t, 2, len(roles.Roles))
+
+	assert.Nil(t, checkESDTRoleForAccount(marshalizer, acnt, tokenID, []byte(core.ESDTRoleNFTBurn)))
+	assert.Equal(t, process.ErrActionNotAllowed, checkESDTRoleForAccount(marshalizer, acnt, tokenID, []byte(core.ESDTRoleNFTAddQuantity)))
+}
+
+func TestEsdtRoles_ProcessBuiltinFunctionUnSetShouldDeleteRoles(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	esdtUnSetRolesF, _ := NewESDTRolesFunc(marshalizer, false)
+	acnt, _ := state.NewUserAccount([]byte("dst"))
+	tokenID := []byte("token")
+	setRolesOnAccount(t, marshalizer, acnt, tokenID, core.ESDTRoleLocalMint, core.ESDTRoleLocalBurn)
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: vm.ESDTSCAddress,
+			CallValue:  big.NewInt(0),
+			Arguments:  [][]byte{tokenID, []byte(core.ESDTRoleLocalMint)},
+		},
+	}
+	_, err := esdtUnSetRolesF.ProcessBuiltinFunction(nil, acnt, input)
+	require.Nil(t, err)
+
+	assert.Equal(t, process.ErrActionNotAllowed, checkESDTRoleForAccount(marshalizer, acnt, tokenID, []byte(core.ESDTRoleLocalMint)))
+	assert.Nil(t, checkESDTRoleForAccount(marshalizer, acnt, tokenID, []byte(core.ESDTRoleLocalBurn)))
+}
@@ -15,8 +15,6 @@ import (
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
-const esdtKeyIdentifier = "esdt"
-
 var _ process.BuiltinFunction = (*esdtTransfer)(nil)
 
 var zero = big.NewInt(0)
@@ -45,7 +43,7 @@ func NewESDTTransferFunc(
 	e := &esdtTransfer{
 		funcGasCost:    funcGasCost,
 		marshalizer:    marshalizer,
-		keyPrefix:      []byte(core.ElrondProtectedKeyPrefix + esdtKeyIdentifier),
+		keyPrefix:      []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier),
 		pauseHandler:   pauseHandler,
 		payableHandler: &disabledPayableHandler{},
 	}
@@ -172,15 +170,13 @@ func addToESDTBalance(
 		return err
 	}
 
-	if !bytes.Equal(senderAddr, vm.ESDTSCAddress) {
-		esdtUserMetaData := ESDTUserMetadataFromBytes(esdtData.Properties)
-		if esdtUserMetaData.Frozen {
-			return process.ErrESDTIsFrozenForAccount
-		}
+	if esdtData.Type != uint32(core.Fungible) {
+		return process.ErrOnlyFungibleTokensAccepted
+	}
 
-		if pauseHandler.IsPaused(key) {
-			return process.ErrESDTTokenIsPaused
-		}
+	err = checkFrozeAndPause(senderAddr, key, esdtData, pauseHandler)
+	if err != nil {
+		return err
 	}
 
 	esdtData.Value.Add(esdtData.Value, value)
@@ -196,6 +192,28 @@ func addToESDTBalance(
 	return nil
 }
 
+func checkFrozeAndPause(
+	senderAddr []byte,
+	key []byte,
+	esdtData *ESDigitalToken,
+	pauseHandler process.ESDTPauseHandler,
+) error {
+	if bytes.Equal(senderAddr, vm.ESDTSCAddress) {
+		return nil
+	}
+
+	esdtUserMetaData := ESDTUserMetadataFromBytes(esdtData.Properties)
+	if esdtUserMetaData.Frozen {
+		return process.ErrESDTIsFrozenForAccount
+	}
+
+	if pauseHandler.IsPaused(key) {
+		return process.ErrESDTTokenIsPaused
+	}
+
+	return nil
+}
+
 func saveESDTData(
 	userAcnt state.UserAccountHandler,
 	esdtData *ESDigitalToken,
@@ -1,12 +1,16 @@
 package builtInFunctions
 
 import (
+	"fmt"
+
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -14,116 +18,222 @@ var log = logger.GetOrCreate("process/smartContract/builtInFunctions")
 
 // ArgsCreateBuiltInFunctionContainer -
 type ArgsCreateBuiltInFunctionContainer struct {
-	GasMap               map[string]map[string]uint64
-	MapDNSAddresses      map[string]struct{}
-	EnableUserNameChange bool
-	Marshalizer          marshal.Marshalizer
-	Accounts             state.AccountsAdapter
+	GasMap                      map[string]map[string]uint64
+	MapDNSAddresses             map[string]struct{}
+	EnableUserNameChange        bool
+	Marshalizer                 marshal.Marshalizer
+	Accounts                    state.AccountsAdapter
+	ShardCoordinator            sharding.Coordinator
+	ArgumentParser              process.MultiESDTTransferParser
+	EpochNotifier               process.EpochNotifier
+	BuiltInFunctionsEnableEpoch uint32
+	ActivationEpochs            []config.BuiltInFunctionActivationConfig
+}
+
+type builtInFunctionEntry struct {
+	descriptor BuiltInFunctionDescriptor
+	create     func(gasCost uint64) (process.BuiltinFunction, error)
 }
 
-// CreateBuiltInFunctionContainer will create the list of built-in functions
-func CreateBuiltInFunctionContainer(args ArgsCreateBuiltInFunctionContainer) (process.BuiltInFunctionContainer, error) {
+// CreateBuiltInFunctionContainer will create the registry holding all the built-in functions
+func CreateBuiltInFunctionContainer(args ArgsCreateBuiltInFunctionContainer) (process.BuiltInFunctionsRegistry, error) {
 	gasConfig, err := createGasConfig(args.GasMap)
 	if err != nil {
 		return nil, err
 	}
 
-	container := NewBuiltInFunctionContainer()
-	var newFunc process.BuiltinFunction
-	newFunc = NewClaimDeveloperRewardsFunc(gasConfig.BuiltInCost.ClaimDeveloperRewards)
-	err = container.Add(core.BuiltInFunctionClaimDeveloperRewards, newFunc)
+	registry, err := NewBuiltInFunctionsRegistry(ArgsBuiltInFunctionsRegistry{
+		BuiltInGasCosts:  args.GasMap[core.BuiltInCost],
+		ShardCoordinator: args.ShardCoordinator,
+		EpochNotifier:    args.EpochNotifier,
+		EnableEpoch:      args.BuiltInFunctionsEnableEpoch,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	newFunc = NewChangeOwnerAddressFunc(gasConfig.BuiltInCost.ChangeOwnerAddress)
-	err = container.Add(core.BuiltInFunctionChangeOwnerAddress, newFunc)
+	pauseFunc, err := NewESDTPauseFunc(args.Accounts, true)
 	if err != nil {
 		return nil, err
 	}
 
-	newFunc, err = NewSaveUserNameFunc(gasConfig.BuiltInCost.SaveUserName, args.MapDNSAddresses, args.EnableUserNameChange)
-	if err != nil {
-		return nil, err
-	}
-	err = container.Add(core.BuiltInFunctionSetUserName, newFunc)
+	entries := createBuiltInFunctionEntries(args, gasConfig, pauseFunc)
+	err = setActivationEpochs(entries, args.ActivationEpochs)
 	if err != nil {
 		return nil, err
 	}
 
-	newFunc, err = NewSaveKeyValueStorageFunc(gasConfig.BaseOperationCost, gasConfig.BuiltInCost.SaveKeyValue)
-	if err != nil {
-		return nil, err
-	}
-	err = container.Add(core.BuiltInFunctionSaveKeyValue, newFunc)
-	if err != nil {
-		return nil, err
-	}
+	for _, entry := range entries {
+		newFunc, errCreate := entry.create(args.GasMap[core.BuiltInCost][entry.descriptor.GasCostKey])
+		if errCreate != nil {
+			return nil, errCreate
+		}
 
-	pauseFunc, err := NewESDTPauseFunc(args.Accounts, true)
-	if err != nil {
-		return nil, err
-	}
-	err = container.Add(core.BuiltInFunctionESDTPause, pauseFunc)
-	if err != nil {
-		return nil, err
+		err = registry.Register(entry.descriptor, newFunc)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	newFunc, err = NewESDTTransferFunc(gasConfig.BuiltInCost.ESDTTransfer, args.Marshalizer, pauseFunc)
-	if err != nil {
-		return nil, err
-	}
-	err = container.Add(core.BuiltInFunctionESDTTransfer, newFunc)
-	if err != nil {
-		return nil, err
-	}
+	return registry, nil
+}
 
-	newFunc, err = NewESDTBurnFunc(gasConfig.BuiltInCost.ESDTBurn, args.Marshalizer, pauseFunc)
-	if err != nil {
-		return nil, err
-	}
-	err = container.Add(core.BuiltInFunctionESDTBurn, newFunc)
-	if err != nil {
-		return nil, err
+// createBuiltInFunctionEntries declares all the built-in functions. A new built-in function is added by appending its
+// entry with the key of its cost in the BuiltInCost gas schedule section and the shards on which it can be executed.
+// The epoch from which it is activated is read from the BuiltInFunctionsActivationEpochs config.
+func createBuiltInFunctionEntries(
+	args ArgsCreateBuiltInFunctionContainer,
+	gasConfig *GasCost,
+	pauseFunc *esdtPause,
+) []builtInFunctionEntry {
+	return []builtInFunctionEntry{
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionClaimDeveloperRewards, "ClaimDeveloperRewards"),
+			create: func(gasCost uint64) (process.BuiltinFunction, error) {
+				return NewClaimDeveloperRewardsFunc(gasCost), nil
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionChangeOwnerAddress, "ChangeOwnerAddress"),
+			create: func(gasCost uint64) (process.BuiltinFunction, error) {
+				return NewChangeOwnerAddressFunc(gasCost), nil
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionSetUserName, "SaveUserName"),
+			create: func(gasCost uint64) (process.BuiltinFunction, error) {
+				return NewSaveUserNameFunc(gasCost, args.MapDNSAddresses, args.EnableUserNameChange)
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionSaveKeyValue, "SaveKeyValue"),
+			create: func(gasCost uint64) (process.BuiltinFunction, error) {
+				return NewSaveKeyValueStorageFunc(gasConfig.BaseOperationCost, gasCost)
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionESDTPause, ""),
+			create: func(_ uint64) (process.BuiltinFunction, error) {
+				return pauseFunc, nil
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionESDTTransfer, "ESDTTransfer"),
+			create: func(gasCost uint64) (process.BuiltinFunction, error) {
+				return NewESDTTransferFunc(gasCost, args.Marshalizer, pauseFunc)
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionESDTBurn, "ESDTBurn"),
+			create: func(gasCost uint64) (process.BuiltinFunction, error) {
+				return NewESDTBurnFunc(gasCost, args.Marshalizer, pauseFunc)
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionESDTFreeze, ""),
+			create: func(_ uint64) (process.BuiltinFunction, error) {
+				return NewESDTFreezeWipeFunc(args.Marshalizer, true, false)
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionESDTUnFreeze, ""),
+			create: func(_ uint64) (process.BuiltinFunction, error) {
+				return NewESDTFreezeWipeFunc(args.Marshalizer, false, false)
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionESDTWipe, ""),
+			create: func(_ uint64) (process.BuiltinFunction, error) {
+				return NewESDTFreezeWipeFunc(args.Marshalizer, false, true)
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionESDTUnPause, ""),
+			create: func(_ uint64) (process.BuiltinFunction, error) {
+				return NewESDTPauseFunc(args.Accounts, false)
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionESDTSetRole, ""),
+			create: func(_ uint64) (process.BuiltinFunction, error) {
+				return NewESDTRolesFunc(args.Marshalizer, true)
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionESDTUnSetRole, ""),
+			create: func(_ uint64) (process.BuiltinFunction, error) {
+				return NewESDTRolesFunc(args.Marshalizer, false)
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionESDTLocalMint, "ESDTLocalMint"),
+			create: func(gasCost uint64) (process.BuiltinFunction, error) {
+				return NewESDTLocalMintFunc(gasCost, args.Marshalizer, pauseFunc)
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionESDTLocalBurn, "ESDTLocalBurn"),
+			create: func(gasCost uint64) (process.BuiltinFunction, error) {
+				return NewESDTLocalBurnFunc(gasCost, args.Marshalizer, pauseFunc)
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionESDTNFTCreate, "ESDTNFTCreate"),
+			create: func(gasCost uint64) (process.BuiltinFunction, error) {
+				return NewESDTNFTCreateFunc(gasCost, gasConfig.BaseOperationCost, args.Marshalizer)
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionESDTNFTAddQuantity, "ESDTNFTAddQuantity"),
+			create: func(gasCost uint64) (process.BuiltinFunction, error) {
+				return NewESDTNFTAddQuantityFunc(gasCost, args.Marshalizer)
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionESDTNFTBurn, "ESDTNFTBurn"),
+			create: func(gasCost uint64) (process.BuiltinFunction, error) {
+				return NewESDTNFTBurnFunc(gasCost, args.Marshalizer)
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionESDTNFTTransfer, "ESDTNFTTransfer"),
+			create: func(gasCost uint64) (process.BuiltinFunction, error) {
+				return NewESDTNFTTransferFunc(gasCost, args.Marshalizer, pauseFunc, args.Accounts, args.ShardCoordinator)
+			},
+		},
+		{
+			descriptor: shardsDescriptor(core.BuiltInFunctionMultiESDTTransfer, "MultiESDTTransfer"),
+			create: func(gasCost uint64) (process.BuiltinFunction, error) {
+				return NewESDTMultiTransferFunc(gasCost, args.Marshalizer, pauseFunc, args.ArgumentParser)
+			},
+		},
 	}
+}
 
-	newFunc, err = NewESDTFreezeWipeFunc(args.Marshalizer, true, false)
-	if err != nil {
-		return nil, err
-	}
-	err = container.Add(core.BuiltInFunctionESDTFreeze, newFunc)
-	if err != nil {
-		return nil, err
+func setActivationEpochs(entries []builtInFunctionEntry, activationEpochs []config.BuiltInFunctionActivationConfig) error {
+	entryIndexes := make(map[string]int, len(entries))
+	for i, entry := range entries {
+		entryIndexes[entry.descriptor.Name] = i
 	}
 
-	newFunc, err = NewESDTFreezeWipeFunc(args.Marshalizer, false, false)
-	if err != nil {
-		return nil, err
-	}
-	err = container.Add(core.BuiltInFunctionESDTUnFreeze, newFunc)
-	if err != nil {
-		return nil, err
-	}
+	for _, activation := range activationEpochs {
+		index, ok := entryIndexes[activation.Name]
+		if !ok {
+			return fmt.Errorf("%w: %s", process.ErrUnknownBuiltInFunction, activation.Name)
+		}
 
-	newFunc, err = NewESDTFreezeWipeFunc(args.Marshalizer, false, true)
-	if err != nil {
-		return nil, err
-	}
-	err = container.Add(core.BuiltInFunctionESDTWipe, newFunc)
-	if err != nil {
-		return nil, err
+		entries[index].descriptor.ActivationEpoch = activation.Epoch
 	}
 
-	newFunc, err = NewESDTPauseFunc(args.Accounts, false)
-	if err != nil {
-		return nil, err
-	}
-	err = container.Add(core.BuiltInFunctionESDTUnPause, newFunc)
-	if err != nil {
-		return nil, err
-	}
+	return nil
+}
 
-	return container, nil
+func shardsDescriptor(name string, gasCostKey string) BuiltInFunctionDescriptor {
+	return BuiltInFunctionDescriptor{
+		Name:          name,
+		GasCostKey:    gasCostKey,
+		Applicability: ShardsOnly,
+	}
 }
 
 func createGasConfig(gasMap map[string]map[string]uint64) (*GasCost, error) {
@@ -158,8 +268,8 @@ func createGasConfig(gasMap map[string]map[string]uint64) (*GasCost, error) {
 }
 
 // SetPayableHandler sets the payable interface to the needed functions
-func SetPayableHandler(container process.BuiltInFunctionContainer, payableHandler process.PayableHandler) error {
-	builtInFunc, err := container.Get(core.BuiltInFunctionESDTTransfer)
+func SetPayableHandler(container process.BuiltInFunctionsRegistry, payableHandler process.PayableHandler) error {
+	builtInFunc, err := container.GetRegistered(core.BuiltInFunctionESDTTransfer)
 	if err != nil {
 		log.Warn("SetIsPayable", "error", err.Error())
 		return err
@@ -171,5 +281,39 @@ func SetPayableHandler(container process.BuiltInFunctionContainer, payableHandle
 		return process.ErrWrongTypeAssertion
 	}
 
-	return esdtTransferFunc.setPayableHandler(payableHandler)
+	err = esdtTransferFunc.setPayableHandler(payableHandler)
+	if err != nil {
+		return err
+	}
+
+	builtInFunc, err = container.GetRegistered(core.BuiltInFunctionESDTNFTTransfer)
+	if err != nil {
+		log.Warn("SetIsPayable", "error", err.Error())
+		return err
+	}
+
+	esdtNFTTransferFunc, ok := builtInFunc.(*esdtNFTTransfer)
+	if !ok {
+		log.Warn("SetIsPayable", "error", process.ErrWrongTypeAssertion)
+		return process.ErrWrongTypeAssertion
+	}
+
+	err = esdtNFTTransferFunc.setPayableHandler(payableHandler)
+	if err != nil {
+		return err
+	}
+
+	builtInFunc, err = container.GetRegistered(core.BuiltInFunctionMultiESDTTransfer)
+	if err != nil {
+		log.Warn("SetIsPayable", "error", err.Error())
+		return err
+	}
+
+	esdtMultiTransferFunc, ok := builtInFunc.(*esdtMultiTransfer)
+	if !ok {
+		log.Warn("SetIsPayable", "error", process.ErrWrongTypeAssertion)
+		return process.ErrWrongTypeAssertion
+	}
+
+	return esdtMultiTransferFunc.setPayableHandler(payableHandler)
 }
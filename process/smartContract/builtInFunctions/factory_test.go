@@ -1,8 +1,10 @@
 package builtInFunctions
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
@@ -19,6 +21,9 @@ func createMockArguments() ArgsCreateBuiltInFunctionContainer {
 		EnableUserNameChange: false,
 		Marshalizer:          &mock.MarshalizerMock{},
 		Accounts:             &mock.AccountsStub{},
+		ShardCoordinator:     mock.NewMultiShardsCoordinatorMock(1),
+		ArgumentParser:       &mock.ArgumentParserMock{},
+		EpochNotifier:        &mock.EpochNotifierStub{},
 	}
 
 	return args
@@ -50,6 +55,13 @@ func fillGasMapBuiltInCosts(value uint64) map[string]uint64 {
 	gasMap["SaveKeyValue"] = value
 	gasMap["ESDTTransfer"] = value
 	gasMap["ESDTBurn"] = value
+	gasMap["ESDTNFTCreate"] = value
+	gasMap["ESDTNFTAddQuantity"] = value
+	gasMap["ESDTNFTBurn"] = value
+	gasMap["ESDTNFTTransfer"] = value
+	gasMap["MultiESDTTransfer"] = value
+	gasMap["ESDTLocalMint"] = value
+	gasMap["ESDTLocalBurn"] = value
 
 	return gasMap
 }
@@ -70,7 +82,101 @@ func TestCreateBuiltInFunctionContainer_Errors(t *testing.T) {
 	assert.Nil(t, container)
 
 	args = createMockArguments()
+	args.ArgumentParser = nil
 	container, err = CreateBuiltInFunctionContainer(args)
+	assert.Equal(t, process.ErrNilMultiESDTTransferParser, err)
+	assert.Nil(t, container)
+
+	args = createMockArguments()
+	args.EpochNotifier = nil
+	container, err = CreateBuiltInFunctionContainer(args)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+	assert.Nil(t, container)
+
+	args = createMockArguments()
+	args.ActivationEpochs = []config.BuiltInFunctionActivationConfig{{Name: "unknown", Epoch: 1}}
+	container, err = CreateBuiltInFunctionContainer(args)
+	assert.True(t, errors.Is(err, process.ErrUnknownBuiltInFunction))
+	assert.Nil(t, container)
+
+	args = createMockArguments()
+	container, err = CreateBuiltInFunctionContainer(args)
+	assert.Nil(t, err)
+	assert.Equal(t, container.Len(), 20)
+}
+
+func TestCreateBuiltInFunctionContainer_GasCostsShouldBeReported(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	args.GasMap[core.BuiltInCost]["ESDTTransfer"] = 37
+	container, _ := CreateBuiltInFunctionContainer(args)
+
+	activeFunctions := container.GetActiveBuiltInFunctions()
+	assert.Equal(t, 20, len(activeFunctions))
+	for _, info := range activeFunctions {
+		switch info.Name {
+		case core.BuiltInFunctionESDTTransfer:
+			assert.Equal(t, uint64(37), info.GasCost)
+		case core.BuiltInFunctionESDTFreeze:
+			assert.Equal(t, uint64(0), info.GasCost)
+		}
+	}
+}
+
+func TestCreateBuiltInFunctionContainer_OnMetachainShouldNotActivateShardFunctions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(1)
+	shardCoordinator.CurrentShard = core.MetachainShardId
+	args.ShardCoordinator = shardCoordinator
+	container, err := CreateBuiltInFunctionContainer(args)
+	assert.Nil(t, err)
+	assert.Equal(t, 20, container.Len())
+	assert.Equal(t, 0, len(container.GetActiveBuiltInFunctions()))
+
+	_, err = container.Get(core.BuiltInFunctionESDTTransfer)
+	assert.True(t, errors.Is(err, process.ErrBuiltInFunctionNotActive))
+
+	err = SetPayableHandler(container, &mock.PayableHandlerStub{})
+	assert.Nil(t, err)
+}
+
+func TestCreateBuiltInFunctionContainer_ShouldActivateFunctionsByConfig(t *testing.T) {
+	t.Parallel()
+
+	var epochHandler core.EpochSubscriberHandler
+	epochNotifier := &mock.EpochNotifierStub{
+		RegisterNotifyHandlerCalled: func(handler core.EpochSubscriberHandler) {
+			epochHandler = handler
+		},
+	}
+	args := createMockArguments()
+	args.EpochNotifier = epochNotifier
+	args.BuiltInFunctionsEnableEpoch = 1
+	args.ActivationEpochs = []config.BuiltInFunctionActivationConfig{
+		{Name: core.BuiltInFunctionESDTNFTTransfer, Epoch: 3},
+	}
+	container, err := CreateBuiltInFunctionContainer(args)
+	assert.Nil(t, err)
+
+	err = SetPayableHandler(container, &mock.PayableHandlerStub{})
+	assert.Nil(t, err)
+
+	epochHandler.EpochConfirmed(0)
+	_, err = container.Get(core.BuiltInFunctionESDTTransfer)
+	assert.True(t, errors.Is(err, process.ErrBuiltInFunctionsAreDisabled))
+
+	epochHandler.EpochConfirmed(1)
+	_, err = container.Get(core.BuiltInFunctionESDTTransfer)
+	assert.Nil(t, err)
+	_, err = container.Get(core.BuiltInFunctionESDTNFTTransfer)
+	assert.True(t, errors.Is(err, process.ErrBuiltInFunctionNotActive))
+	assert.Equal(t, 19, len(container.GetActiveBuiltInFunctions()))
+
+	epochHandler.EpochConfirmed(3)
+	_, err = container.Get(core.BuiltInFunctionESDTNFTTransfer)
 	assert.Nil(t, err)
-	assert.Equal(t, container.Len(), 11)
+	assert.Equal(t, 20, len(container.GetActiveBuiltInFunctions()))
 }
@@ -0,0 +1,279 @@
+package builtInFunctions
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+)
+
+var _ process.BuiltInFunctionsRegistry = (*builtInFunctionsRegistry)(nil)
+
+// ShardApplicability defines the shards on which a built-in function can be executed
+type ShardApplicability uint8
+
+const (
+	// AllShards marks a built-in function which can be executed on every shard, including the metachain
+	AllShards ShardApplicability = iota
+	// ShardsOnly marks a built-in function which can not be executed on the metachain
+	ShardsOnly
+	// MetachainOnly marks a built-in function which can be executed only on the metachain
+	MetachainOnly
+)
+
+// BuiltInFunctionDescriptor declares how a built-in function is registered: its name, the key of its cost in the
+// built-in gas schedule, the epoch from which it is active and the shards on which it can be executed
+type BuiltInFunctionDescriptor struct {
+	Name            string
+	GasCostKey      string
+	ActivationEpoch uint32
+	Applicability   ShardApplicability
+}
+
+// ArgsBuiltInFunctionsRegistry holds the built-in functions registry arguments
+type ArgsBuiltInFunctionsRegistry struct {
+	BuiltInGasCosts  map[string]uint64
+	ShardCoordinator sharding.Coordinator
+	EpochNotifier    process.EpochNotifier
+	EnableEpoch      uint32
+}
+
+type registeredFunction struct {
+	descriptor BuiltInFunctionDescriptor
+	function   process.BuiltinFunction
+}
+
+type builtInFunctionsRegistry struct {
+	builtInGasCosts map[string]uint64
+	selfShardID     uint32
+	enableEpoch     uint32
+
+	mutFunctions sync.RWMutex
+	functions    map[string]*registeredFunction
+	currentEpoch uint32
+}
+
+// NewBuiltInFunctionsRegistry creates a built-in functions container in which every function is registered together
+// with its descriptor. A registered function can be called only after the enable epoch of all the built-in functions
+// and after its own activation epoch, on the shards it applies to.
+func NewBuiltInFunctionsRegistry(args ArgsBuiltInFunctionsRegistry) (*builtInFunctionsRegistry, error) {
+	if args.BuiltInGasCosts == nil {
+		return nil, process.ErrNilGasSchedule
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+
+	registry := &builtInFunctionsRegistry{
+		builtInGasCosts: args.BuiltInGasCosts,
+		selfShardID:     args.ShardCoordinator.SelfId(),
+		enableEpoch:     args.EnableEpoch,
+		functions:       make(map[string]*registeredFunction),
+	}
+	args.EpochNotifier.RegisterNotifyHandler(registry)
+
+	return registry, nil
+}
+
+// Register adds the provided function under the name declared by its descriptor
+func (bfr *builtInFunctionsRegistry) Register(descriptor BuiltInFunctionDescriptor, function process.BuiltinFunction) error {
+	err := checkRegistration(descriptor, function)
+	if err != nil {
+		return err
+	}
+
+	bfr.mutFunctions.Lock()
+	defer bfr.mutFunctions.Unlock()
+
+	_, exists := bfr.functions[descriptor.Name]
+	if exists {
+		return process.ErrContainerKeyAlreadyExists
+	}
+
+	bfr.functions[descriptor.Name] = &registeredFunction{
+		descriptor: descriptor,
+		function:   function,
+	}
+
+	return nil
+}
+
+func checkRegistration(descriptor BuiltInFunctionDescriptor, function process.BuiltinFunction) error {
+	if check.IfNil(function) {
+		return process.ErrNilContainerElement
+	}
+	if len(descriptor.Name) == 0 {
+		return process.ErrEmptyFunctionName
+	}
+	if descriptor.Applicability > MetachainOnly {
+		return fmt.Errorf("%w for function %s", process.ErrInvalidShardApplicability, descriptor.Name)
+	}
+
+	return nil
+}
+
+// Get returns the function registered under the provided name if it is active.
+// Returns an error if the function does not exist or it is not active
+func (bfr *builtInFunctionsRegistry) Get(key string) (process.BuiltinFunction, error) {
+	bfr.mutFunctions.RLock()
+	defer bfr.mutFunctions.RUnlock()
+
+	registered, ok := bfr.functions[key]
+	if !ok {
+		return nil, fmt.Errorf("%w in function container for key %v", process.ErrInvalidContainerKey, key)
+	}
+	if !bfr.isEnabled() {
+		return nil, fmt.Errorf("%w: %s", process.ErrBuiltInFunctionsAreDisabled, key)
+	}
+	if !bfr.isActive(registered.descriptor) {
+		return nil, fmt.Errorf("%w: %s", process.ErrBuiltInFunctionNotActive, key)
+	}
+
+	return registered.function, nil
+}
+
+// GetRegistered returns the function registered under the provided name, regardless of its activation
+func (bfr *builtInFunctionsRegistry) GetRegistered(key string) (process.BuiltinFunction, error) {
+	bfr.mutFunctions.RLock()
+	defer bfr.mutFunctions.RUnlock()
+
+	registered, ok := bfr.functions[key]
+	if !ok {
+		return nil, fmt.Errorf("%w in function container for key %v", process.ErrInvalidContainerKey, key)
+	}
+
+	return registered.function, nil
+}
+
+// Add registers the provided function as active from genesis, on all shards
+func (bfr *builtInFunctionsRegistry) Add(key string, function process.BuiltinFunction) error {
+	return bfr.Register(BuiltInFunctionDescriptor{Name: key}, function)
+}
+
+// Replace will add (or replace if it already exists) the function registered under the provided name. The descriptor
+// of an existing function is kept.
+func (bfr *builtInFunctionsRegistry) Replace(key string, function process.BuiltinFunction) error {
+	descriptor := BuiltInFunctionDescriptor{Name: key}
+	err := checkRegistration(descriptor, function)
+	if err != nil {
+		return err
+	}
+
+	bfr.mutFunctions.Lock()
+	defer bfr.mutFunctions.Unlock()
+
+	registered, exists := bfr.functions[key]
+	if exists {
+		descriptor = registered.descriptor
+	}
+
+	bfr.functions[key] = &registeredFunction{
+		descriptor: descriptor,
+		function:   function,
+	}
+
+	return nil
+}
+
+// Remove will remove the function registered under the provided name
+func (bfr *builtInFunctionsRegistry) Remove(key string) {
+	bfr.mutFunctions.Lock()
+	delete(bfr.functions, key)
+	bfr.mutFunctions.Unlock()
+}
+
+// Len returns the number of registered functions
+func (bfr *builtInFunctionsRegistry) Len() int {
+	bfr.mutFunctions.RLock()
+	defer bfr.mutFunctions.RUnlock()
+
+	return len(bfr.functions)
+}
+
+// Keys returns the names of all the registered functions, active or not. The names should not change between epochs
+// as the transactions calling a function which is not yet active still have to be treated as built-in function calls.
+func (bfr *builtInFunctionsRegistry) Keys() map[string]struct{} {
+	bfr.mutFunctions.RLock()
+	defer bfr.mutFunctions.RUnlock()
+
+	keys := make(map[string]struct{}, len(bfr.functions))
+	for name := range bfr.functions {
+		keys[name] = struct{}{}
+	}
+
+	return keys
+}
+
+// GetActiveBuiltInFunctions returns the functions active in the current epoch on the current shard, sorted by name
+func (bfr *builtInFunctionsRegistry) GetActiveBuiltInFunctions() []process.BuiltInFunctionInfo {
+	bfr.mutFunctions.RLock()
+	activeFunctions := make([]process.BuiltInFunctionInfo, 0, len(bfr.functions))
+	for _, registered := range bfr.functions {
+		if !bfr.isActive(registered.descriptor) {
+			continue
+		}
+
+		activeFunctions = append(activeFunctions, process.BuiltInFunctionInfo{
+			Name:            registered.descriptor.Name,
+			GasCost:         bfr.builtInGasCosts[registered.descriptor.GasCostKey],
+			ActivationEpoch: registered.descriptor.ActivationEpoch,
+		})
+	}
+	bfr.mutFunctions.RUnlock()
+
+	sort.Slice(activeFunctions, func(i, j int) bool {
+		return activeFunctions[i].Name < activeFunctions[j].Name
+	})
+
+	return activeFunctions
+}
+
+func (bfr *builtInFunctionsRegistry) isActive(descriptor BuiltInFunctionDescriptor) bool {
+	return bfr.isEnabled() && bfr.currentEpoch >= descriptor.ActivationEpoch && bfr.isApplicable(descriptor.Applicability)
+}
+
+func (bfr *builtInFunctionsRegistry) isEnabled() bool {
+	return bfr.currentEpoch >= bfr.enableEpoch
+}
+
+func (bfr *builtInFunctionsRegistry) isApplicable(applicability ShardApplicability) bool {
+	isMetachain := bfr.selfShardID == core.MetachainShardId
+
+	switch applicability {
+	case ShardsOnly:
+		return !isMetachain
+	case MetachainOnly:
+		return isMetachain
+	default:
+		return true
+	}
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (bfr *builtInFunctionsRegistry) EpochConfirmed(epoch uint32) {
+	bfr.mutFunctions.Lock()
+	defer bfr.mutFunctions.Unlock()
+
+	for _, registered := range bfr.functions {
+		descriptor := registered.descriptor
+		isActivatedNow := descriptor.ActivationEpoch > bfr.currentEpoch && descriptor.ActivationEpoch <= epoch
+		if isActivatedNow && bfr.isApplicable(descriptor.Applicability) {
+			log.Debug("builtInFunctionsRegistry: function activated", "name", descriptor.Name, "epoch", epoch)
+		}
+	}
+
+	bfr.currentEpoch = epoch
+	log.Debug("builtInFunctionsRegistry: built in functions", "enabled", bfr.isEnabled())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bfr *builtInFunctionsRegistry) IsInterfaceNil() bool {
+	return bfr == nil
+}
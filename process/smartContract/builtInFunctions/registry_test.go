@@ -0,0 +1,178 @@
+package builtInFunctions
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsBuiltInFunctionsRegistry() ArgsBuiltInFunctionsRegistry {
+	return ArgsBuiltInFunctionsRegistry{
+		BuiltInGasCosts:  map[string]uint64{"ESDTTransfer": 10, "ESDTBurn": 20},
+		ShardCoordinator: mock.NewMultiShardsCoordinatorMock(1),
+		EpochNotifier:    &mock.EpochNotifierStub{},
+	}
+}
+
+func TestNewBuiltInFunctionsRegistry(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsBuiltInFunctionsRegistry()
+	args.BuiltInGasCosts = nil
+	registry, err := NewBuiltInFunctionsRegistry(args)
+	assert.Equal(t, process.ErrNilGasSchedule, err)
+	assert.True(t, check.IfNil(registry))
+
+	args = createMockArgsBuiltInFunctionsRegistry()
+	args.ShardCoordinator = nil
+	registry, err = NewBuiltInFunctionsRegistry(args)
+	assert.Equal(t, process.ErrNilShardCoordinator, err)
+	assert.True(t, check.IfNil(registry))
+
+	args = createMockArgsBuiltInFunctionsRegistry()
+	args.EpochNotifier = nil
+	registry, err = NewBuiltInFunctionsRegistry(args)
+	assert.Equal(t, process.ErrNilEpochNotifier, err)
+	assert.True(t, check.IfNil(registry))
+
+	args = createMockArgsBuiltInFunctionsRegistry()
+	registry, err = NewBuiltInFunctionsRegistry(args)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(registry))
+}
+
+func TestBuiltInFunctionsRegistry_RegisterErrors(t *testing.T) {
+	t.Parallel()
+
+	registry, _ := NewBuiltInFunctionsRegistry(createMockArgsBuiltInFunctionsRegistry())
+
+	err := registry.Register(BuiltInFunctionDescriptor{Name: "f"}, nil)
+	assert.Equal(t, process.ErrNilContainerElement, err)
+
+	err = registry.Register(BuiltInFunctionDescriptor{}, &mock.BuiltInFunctionStub{})
+	assert.Equal(t, process.ErrEmptyFunctionName, err)
+
+	err = registry.Register(BuiltInFunctionDescriptor{Name: "f", Applicability: MetachainOnly + 1}, &mock.BuiltInFunctionStub{})
+	assert.True(t, errors.Is(err, process.ErrInvalidShardApplicability))
+
+	err = registry.Register(BuiltInFunctionDescriptor{Name: "f"}, &mock.BuiltInFunctionStub{})
+	assert.Nil(t, err)
+	err = registry.Register(BuiltInFunctionDescriptor{Name: "f"}, &mock.BuiltInFunctionStub{})
+	assert.Equal(t, process.ErrContainerKeyAlreadyExists, err)
+	assert.Equal(t, 1, registry.Len())
+}
+
+func TestBuiltInFunctionsRegistry_GetShouldHonorActivationEpoch(t *testing.T) {
+	t.Parallel()
+
+	registry, _ := NewBuiltInFunctionsRegistry(createMockArgsBuiltInFunctionsRegistry())
+	function := &mock.BuiltInFunctionStub{}
+	_ = registry.Register(BuiltInFunctionDescriptor{Name: "f", ActivationEpoch: 2}, function)
+
+	_, err := registry.Get("missing")
+	assert.True(t, errors.Is(err, process.ErrInvalidContainerKey))
+
+	_, err = registry.Get("f")
+	assert.True(t, errors.Is(err, process.ErrBuiltInFunctionNotActive))
+	_, ok := registry.Keys()["f"]
+	assert.True(t, ok)
+
+	registry.EpochConfirmed(1)
+	_, err = registry.Get("f")
+	assert.True(t, errors.Is(err, process.ErrBuiltInFunctionNotActive))
+
+	registry.EpochConfirmed(2)
+	recovered, err := registry.Get("f")
+	assert.Nil(t, err)
+	assert.True(t, function == recovered)
+}
+
+func TestBuiltInFunctionsRegistry_GetShouldHonorEnableEpoch(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsBuiltInFunctionsRegistry()
+	args.EnableEpoch = 3
+	registry, _ := NewBuiltInFunctionsRegistry(args)
+	function := &mock.BuiltInFunctionStub{}
+	_ = registry.Register(BuiltInFunctionDescriptor{Name: "f", ActivationEpoch: 1}, function)
+
+	registry.EpochConfirmed(2)
+	_, err := registry.Get("f")
+	assert.True(t, errors.Is(err, process.ErrBuiltInFunctionsAreDisabled))
+	assert.Equal(t, 0, len(registry.GetActiveBuiltInFunctions()))
+
+	recovered, err := registry.GetRegistered("f")
+	assert.Nil(t, err)
+	assert.True(t, function == recovered)
+
+	registry.EpochConfirmed(3)
+	recovered, err = registry.Get("f")
+	assert.Nil(t, err)
+	assert.True(t, function == recovered)
+	assert.Equal(t, 1, len(registry.GetActiveBuiltInFunctions()))
+}
+
+func TestBuiltInFunctionsRegistry_GetShouldHonorShardApplicability(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsBuiltInFunctionsRegistry()
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(1)
+	shardCoordinator.CurrentShard = core.MetachainShardId
+	args.ShardCoordinator = shardCoordinator
+	registry, _ := NewBuiltInFunctionsRegistry(args)
+
+	_ = registry.Register(BuiltInFunctionDescriptor{Name: "all", Applicability: AllShards}, &mock.BuiltInFunctionStub{})
+	_ = registry.Register(BuiltInFunctionDescriptor{Name: "shards", Applicability: ShardsOnly}, &mock.BuiltInFunctionStub{})
+	_ = registry.Register(BuiltInFunctionDescriptor{Name: "meta", Applicability: MetachainOnly}, &mock.BuiltInFunctionStub{})
+
+	_, err := registry.Get("all")
+	assert.Nil(t, err)
+	_, err = registry.Get("shards")
+	assert.True(t, errors.Is(err, process.ErrBuiltInFunctionNotActive))
+	_, err = registry.Get("meta")
+	assert.Nil(t, err)
+}
+
+func TestBuiltInFunctionsRegistry_ReplaceShouldKeepDescriptor(t *testing.T) {
+	t.Parallel()
+
+	registry, _ := NewBuiltInFunctionsRegistry(createMockArgsBuiltInFunctionsRegistry())
+	_ = registry.Register(BuiltInFunctionDescriptor{Name: "f", ActivationEpoch: 2}, &mock.BuiltInFunctionStub{})
+
+	err := registry.Replace("f", &mock.BuiltInFunctionStub{})
+	assert.Nil(t, err)
+
+	_, err = registry.Get("f")
+	assert.True(t, errors.Is(err, process.ErrBuiltInFunctionNotActive))
+
+	registry.Remove("f")
+	assert.Equal(t, 0, registry.Len())
+}
+
+func TestBuiltInFunctionsRegistry_GetActiveBuiltInFunctions(t *testing.T) {
+	t.Parallel()
+
+	registry, _ := NewBuiltInFunctionsRegistry(createMockArgsBuiltInFunctionsRegistry())
+	_ = registry.Register(BuiltInFunctionDescriptor{Name: "transfer", GasCostKey: "ESDTTransfer"}, &mock.BuiltInFunctionStub{})
+	_ = registry.Register(BuiltInFunctionDescriptor{Name: "burn", GasCostKey: "ESDTBurn", ActivationEpoch: 1}, &mock.BuiltInFunctionStub{})
+	_ = registry.Register(BuiltInFunctionDescriptor{Name: "freeze"}, &mock.BuiltInFunctionStub{})
+
+	expected := []process.BuiltInFunctionInfo{
+		{Name: "freeze", GasCost: 0, ActivationEpoch: 0},
+		{Name: "transfer", GasCost: 10, ActivationEpoch: 0},
+	}
+	assert.Equal(t, expected, registry.GetActiveBuiltInFunctions())
+
+	registry.EpochConfirmed(1)
+	expected = []process.BuiltInFunctionInfo{
+		{Name: "burn", GasCost: 20, ActivationEpoch: 1},
+		{Name: "freeze", GasCost: 0, ActivationEpoch: 0},
+		{Name: "transfer", GasCost: 10, ActivationEpoch: 0},
+	}
+	assert.Equal(t, expected, registry.GetActiveBuiltInFunctions())
+}
@@ -47,10 +47,8 @@ type scProcessor struct {
 	argsParser                     process.ArgumentsParser
 	builtInFunctions               process.BuiltInFunctionContainer
 	deployEnableEpoch              uint32
-	builtinEnableEpoch             uint32
 	penalizedTooMuchGasEnableEpoch uint32
 	flagDeploy                     atomic.Flag
-	flagBuiltin                    atomic.Flag
 	flagPenalizedTooMuchGas        atomic.Flag
 
 	badTxForwarder process.IntermediateTransactionHandler
@@ -86,7 +84,6 @@ type ArgsNewSmartContractProcessor struct {
 	TxLogsProcessor                process.TransactionLogProcessor
 	BadTxForwarder                 process.IntermediateTransactionHandler
 	DeployEnableEpoch              uint32
-	BuiltinEnableEpoch             uint32
 	PenalizedTooMuchGasEnableEpoch uint32
 	EpochNotifier                  process.EpochNotifier
 }
@@ -170,7 +167,6 @@ func NewSmartContractProcessor(args ArgsNewSmartContractProcessor) (*scProcessor
 		txLogsProcessor:                args.TxLogsProcessor,
 		badTxForwarder:                 args.BadTxForwarder,
 		deployEnableEpoch:              args.DeployEnableEpoch,
-		builtinEnableEpoch:             args.BuiltinEnableEpoch,
 		penalizedTooMuchGasEnableEpoch: args.PenalizedTooMuchGasEnableEpoch,
 	}
 
@@ -541,10 +537,6 @@ func (sc *scProcessor) ExecuteBuiltInFunction(
 	}
 
 	snapshot := sc.accounts.JournalLen()
-	if !sc.flagBuiltin.IsSet() {
-		return vmcommon.UserError, sc.resolveFailedTransaction(acntSnd, tx, txHash, process.ErrBuiltInFunctionsAreDisabled.Error(), snapshot)
-	}
-
 	vmOutput, lockedGas, err := sc.resolveBuiltInFunctions(acntSnd, acntDst, vmInput)
 	if err != nil {
 		log.Debug("processed built in functions error", "error", err.Error())
@@ -757,6 +749,9 @@ func (sc *scProcessor) isSCExecutionAfterBuiltInFunc(
 		AllowInitFunction: false,
 	}
 
+	if outAcc.OutputTransfers[0].Value != nil {
+		newVMInput.CallValue.Set(outAcc.OutputTransfers[0].Value)
+	}
 	fillWithESDTValue(vmInput, newVMInput)
 
 	return true, newVMInput, nil
@@ -787,7 +782,12 @@ func (sc *scProcessor) isCrossShardESDTTransfer(tx data.TransactionHandler) bool
 		return false
 	}
 
-	return function == core.BuiltInFunctionESDTTransfer
+	switch function {
+	case core.BuiltInFunctionESDTTransfer, core.BuiltInFunctionESDTNFTTransfer, core.BuiltInFunctionMultiESDTTransfer:
+		return true
+	default:
+		return false
+	}
 }
 
 // ProcessIfError creates a smart contract result, consumed the gas and returns the value to the user
@@ -1668,9 +1668,6 @@ func (sc *scProcessor) EpochConfirmed(epoch uint32) {
 	sc.flagDeploy.Toggle(epoch >= sc.deployEnableEpoch)
 	log.Debug("scProcessor: deployment of SC", "enabled", sc.flagDeploy.IsSet())
 
-	sc.flagBuiltin.Toggle(epoch >= sc.builtinEnableEpoch)
-	log.Debug("scProcessor: built in functions", "enabled", sc.flagBuiltin.IsSet())
-
 	sc.flagPenalizedTooMuchGas.Toggle(epoch >= sc.penalizedTooMuchGasEnableEpoch)
 	log.Debug("scProcessor: penalized too much gas", "enabled", sc.flagPenalizedTooMuchGas.IsSet())
 }
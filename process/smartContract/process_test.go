@@ -355,7 +355,13 @@ func TestScProcessor_BuiltInCallSmartContractDisabled(t *testing.T) {
 	}}
 	arguments.VmContainer = vmContainer
 	arguments.ArgsParser = argParser
-	arguments.BuiltinEnableEpoch = maxEpoch
+	builtInFuncs, _ := builtInFunctions.NewBuiltInFunctionsRegistry(builtInFunctions.ArgsBuiltInFunctionsRegistry{
+		BuiltInGasCosts:  make(map[string]uint64),
+		ShardCoordinator: arguments.Coordinator,
+		EpochNotifier:    &mock.EpochNotifierStub{},
+		EnableEpoch:      maxEpoch,
+	})
+	arguments.BuiltInFunctions = builtInFuncs
 	funcName := "builtIn"
 	_ = arguments.BuiltInFunctions.Add(funcName, &mock.BuiltInFunctionStub{})
 	sc, err := NewSmartContractProcessor(arguments)
@@ -390,7 +396,6 @@ func TestScProcessor_BuiltInCallSmartContractSenderFailed(t *testing.T) {
 	}}
 	arguments.VmContainer = vmContainer
 	arguments.ArgsParser = argParser
-	arguments.BuiltinEnableEpoch = maxEpoch
 	funcName := "builtIn"
 	localError := errors.New("failed built in call")
 	_ = arguments.BuiltInFunctions.Add(funcName, &mock.BuiltInFunctionStub{
@@ -2202,3 +2207,35 @@ func TestSCProcessor_createSCRWhenError(t *testing.T) {
 	assert.Equal(t, "@04", string(scr.Data))
 	assert.Equal(t, uint64(0), scr.GasLimit)
 }
+
+func TestSCProcessor_createSCRWhenErrorCrossShardMultiESDTTransferShouldReturnTokens(t *testing.T) {
+	t.Parallel()
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(3)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		return uint32(address[0])
+	}
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.Coordinator = shardCoordinator
+	arguments.ArgsParser = NewArgumentParser()
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	txData := core.BuiltInFunctionMultiESDTTransfer + "@02" +
+		"@" + hex.EncodeToString([]byte("tkn1")) + "@0a" +
+		"@" + hex.EncodeToString([]byte("tkn2")) + "@14"
+	tx := &transaction.Transaction{
+		SndAddr: []byte{1},
+		RcvAddr: []byte{2},
+		Value:   big.NewInt(5),
+		Data:    []byte(txData),
+	}
+
+	scr, _ := sc.createSCRsWhenError(nil, []byte("txHash"), tx, "string", []byte("msg"))
+	assert.Equal(t, tx.SndAddr, scr.RcvAddr)
+	assert.Equal(t, tx.Value, scr.Value)
+	assert.True(t, bytes.HasPrefix(scr.Data, []byte(txData)))
+
+	tx.SndAddr = []byte{0}
+	scr, _ = sc.createSCRsWhenError(nil, []byte("txHash"), tx, "string", []byte("msg"))
+	assert.False(t, bytes.HasPrefix(scr.Data, []byte(txData)))
+}
@@ -0,0 +1,124 @@
+package antiflood
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
+)
+
+const maxLoadPercent = 100
+const maxAdaptiveReservedPercent = 100.0
+
+var _ process.AdaptiveQuotaHandler = (*adaptiveQuotaHandler)(nil)
+
+// ArgAdaptiveQuotaHandler represents the argument for the adaptive quota handler constructor
+type ArgAdaptiveQuotaHandler struct {
+	LoadProvider       process.LoadProvider
+	LowLoadPercent     uint32
+	HighLoadPercent    uint32
+	MaxReservedPercent float32
+}
+
+type adaptiveQuotaHandler struct {
+	loadProvider           process.LoadProvider
+	lowLoadPercent         uint32
+	highLoadPercent        uint32
+	maxReservedPercent     float32
+	mutPeerValidatorMapper sync.RWMutex
+	peerValidatorMapper    process.PeerValidatorMapper
+}
+
+// NewAdaptiveQuotaHandler creates a component that increases the reserved percent of the flood preventers as the
+// node's load rises, shrinking the quotas of all peers that are not validators
+func NewAdaptiveQuotaHandler(arg ArgAdaptiveQuotaHandler) (*adaptiveQuotaHandler, error) {
+	if check.IfNil(arg.LoadProvider) {
+		return nil, process.ErrNilLoadProvider
+	}
+	if arg.HighLoadPercent > maxLoadPercent {
+		return nil, fmt.Errorf("%w, highLoadPercent: provided %d, maximum %d",
+			process.ErrInvalidValue,
+			arg.HighLoadPercent,
+			maxLoadPercent,
+		)
+	}
+	if arg.LowLoadPercent >= arg.HighLoadPercent {
+		return nil, fmt.Errorf("%w, lowLoadPercent should be lower than highLoadPercent: provided %d, %d",
+			process.ErrInvalidValue,
+			arg.LowLoadPercent,
+			arg.HighLoadPercent,
+		)
+	}
+	if arg.MaxReservedPercent <= 0 || arg.MaxReservedPercent > maxAdaptiveReservedPercent {
+		return nil, fmt.Errorf("%w, maxReservedPercent: provided %0.3f, should be in (0, %0.3f] interval",
+			process.ErrInvalidValue,
+			arg.MaxReservedPercent,
+			maxAdaptiveReservedPercent,
+		)
+	}
+
+	return &adaptiveQuotaHandler{
+		loadProvider:        arg.LoadProvider,
+		lowLoadPercent:      arg.LowLoadPercent,
+		highLoadPercent:     arg.HighLoadPercent,
+		maxReservedPercent:  arg.MaxReservedPercent,
+		peerValidatorMapper: &disabled.PeerValidatorMapper{},
+	}, nil
+}
+
+// AdaptReservedPercent returns the reserved percent that should be applied on the provided peer's quota.
+// Under the low load threshold or for validators, the provided reserved percent is returned unchanged. Otherwise,
+// the reserved percent increases linearly with the load, reaching the maximum reserved percent at the high load threshold
+func (aqh *adaptiveQuotaHandler) AdaptReservedPercent(pid core.PeerID, reservedPercent float32) float32 {
+	if reservedPercent >= aqh.maxReservedPercent {
+		return reservedPercent
+	}
+
+	loadRatio := aqh.computeLoadRatio()
+	if loadRatio == 0 {
+		return reservedPercent
+	}
+
+	aqh.mutPeerValidatorMapper.RLock()
+	peerInfo := aqh.peerValidatorMapper.GetPeerInfo(pid)
+	aqh.mutPeerValidatorMapper.RUnlock()
+
+	if peerInfo.PeerType == core.ValidatorPeer {
+		return reservedPercent
+	}
+
+	return reservedPercent + (aqh.maxReservedPercent-reservedPercent)*loadRatio
+}
+
+func (aqh *adaptiveQuotaHandler) computeLoadRatio() float32 {
+	load := aqh.loadProvider.LoadPercent()
+	if load <= uint64(aqh.lowLoadPercent) {
+		return 0
+	}
+	if load >= uint64(aqh.highLoadPercent) {
+		return 1
+	}
+
+	return float32(load-uint64(aqh.lowLoadPercent)) / float32(aqh.highLoadPercent-aqh.lowLoadPercent)
+}
+
+// SetPeerValidatorMapper sets the peer validator mapper used to find out which peers are validators
+func (aqh *adaptiveQuotaHandler) SetPeerValidatorMapper(validatorMapper process.PeerValidatorMapper) error {
+	if check.IfNil(validatorMapper) {
+		return process.ErrNilPeerValidatorMapper
+	}
+
+	aqh.mutPeerValidatorMapper.Lock()
+	aqh.peerValidatorMapper = validatorMapper
+	aqh.mutPeerValidatorMapper.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aqh *adaptiveQuotaHandler) IsInterfaceNil() bool {
+	return aqh == nil
+}
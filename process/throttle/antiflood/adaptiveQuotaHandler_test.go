@@ -0,0 +1,148 @@
+package antiflood_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const validatorPid = core.PeerID("validator")
+const observerPid = core.PeerID("observer")
+
+func createMockArgAdaptiveQuotaHandler(load *atomic.Uint64) antiflood.ArgAdaptiveQuotaHandler {
+	return antiflood.ArgAdaptiveQuotaHandler{
+		LoadProvider: &mock.LoadProviderStub{
+			LoadPercentCalled: func() uint64 {
+				return load.Get()
+			},
+		},
+		LowLoadPercent:     60,
+		HighLoadPercent:    90,
+		MaxReservedPercent: 80,
+	}
+}
+
+func createPeerValidatorMapper() process.PeerValidatorMapper {
+	return &mock.PeerShardMapperStub{
+		GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+			if pid == validatorPid {
+				return core.P2PPeerInfo{PeerType: core.ValidatorPeer}
+			}
+
+			return core.P2PPeerInfo{PeerType: core.ObserverPeer}
+		},
+	}
+}
+
+func TestNewAdaptiveQuotaHandler_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgAdaptiveQuotaHandler(&atomic.Uint64{})
+	arg.LoadProvider = nil
+	aqh, err := antiflood.NewAdaptiveQuotaHandler(arg)
+	assert.True(t, check.IfNil(aqh))
+	assert.Equal(t, process.ErrNilLoadProvider, err)
+
+	arg = createMockArgAdaptiveQuotaHandler(&atomic.Uint64{})
+	arg.HighLoadPercent = 101
+	aqh, err = antiflood.NewAdaptiveQuotaHandler(arg)
+	assert.True(t, check.IfNil(aqh))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	arg = createMockArgAdaptiveQuotaHandler(&atomic.Uint64{})
+	arg.LowLoadPercent = arg.HighLoadPercent
+	aqh, err = antiflood.NewAdaptiveQuotaHandler(arg)
+	assert.True(t, check.IfNil(aqh))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	arg = createMockArgAdaptiveQuotaHandler(&atomic.Uint64{})
+	arg.MaxReservedPercent = 0
+	aqh, err = antiflood.NewAdaptiveQuotaHandler(arg)
+	assert.True(t, check.IfNil(aqh))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	arg = createMockArgAdaptiveQuotaHandler(&atomic.Uint64{})
+	arg.MaxReservedPercent = 100.1
+	aqh, err = antiflood.NewAdaptiveQuotaHandler(arg)
+	assert.True(t, check.IfNil(aqh))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestNewAdaptiveQuotaHandler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	aqh, err := antiflood.NewAdaptiveQuotaHandler(createMockArgAdaptiveQuotaHandler(&atomic.Uint64{}))
+	assert.False(t, check.IfNil(aqh))
+	assert.Nil(t, err)
+}
+
+func TestAdaptiveQuotaHandler_SetPeerValidatorMapperNilMapperShouldErr(t *testing.T) {
+	t.Parallel()
+
+	aqh, _ := antiflood.NewAdaptiveQuotaHandler(createMockArgAdaptiveQuotaHandler(&atomic.Uint64{}))
+	err := aqh.SetPeerValidatorMapper(nil)
+	assert.Equal(t, process.ErrNilPeerValidatorMapper, err)
+}
+
+func TestAdaptiveQuotaHandler_AdaptReservedPercentShouldFollowTheLoad(t *testing.T) {
+	t.Parallel()
+
+	load := &atomic.Uint64{}
+	aqh, _ := antiflood.NewAdaptiveQuotaHandler(createMockArgAdaptiveQuotaHandler(load))
+	err := aqh.SetPeerValidatorMapper(createPeerValidatorMapper())
+	require.Nil(t, err)
+
+	reservedPercent := float32(20)
+
+	load.Set(10)
+	assert.Equal(t, reservedPercent, aqh.AdaptReservedPercent(observerPid, reservedPercent))
+
+	load.Set(60)
+	assert.Equal(t, reservedPercent, aqh.AdaptReservedPercent(observerPid, reservedPercent))
+
+	load.Set(75)
+	assert.Equal(t, float32(50), aqh.AdaptReservedPercent(observerPid, reservedPercent))
+
+	load.Set(90)
+	assert.Equal(t, float32(80), aqh.AdaptReservedPercent(observerPid, reservedPercent))
+
+	load.Set(100)
+	assert.Equal(t, float32(80), aqh.AdaptReservedPercent(observerPid, reservedPercent))
+
+	load.Set(30)
+	assert.Equal(t, reservedPercent, aqh.AdaptReservedPercent(observerPid, reservedPercent))
+}
+
+func TestAdaptiveQuotaHandler_AdaptReservedPercentShouldNotChangeValidatorsQuota(t *testing.T) {
+	t.Parallel()
+
+	load := &atomic.Uint64{}
+	load.Set(100)
+	aqh, _ := antiflood.NewAdaptiveQuotaHandler(createMockArgAdaptiveQuotaHandler(load))
+	err := aqh.SetPeerValidatorMapper(createPeerValidatorMapper())
+	require.Nil(t, err)
+
+	reservedPercent := float32(20)
+	assert.Equal(t, reservedPercent, aqh.AdaptReservedPercent(validatorPid, reservedPercent))
+	assert.Equal(t, float32(80), aqh.AdaptReservedPercent(observerPid, reservedPercent))
+}
+
+func TestAdaptiveQuotaHandler_AdaptReservedPercentHigherThanMaximumShouldNotChange(t *testing.T) {
+	t.Parallel()
+
+	load := &atomic.Uint64{}
+	load.Set(100)
+	aqh, _ := antiflood.NewAdaptiveQuotaHandler(createMockArgAdaptiveQuotaHandler(load))
+	_ = aqh.SetPeerValidatorMapper(createPeerValidatorMapper())
+
+	reservedPercent := float32(85)
+	assert.Equal(t, reservedPercent, aqh.AdaptReservedPercent(observerPid, reservedPercent))
+}
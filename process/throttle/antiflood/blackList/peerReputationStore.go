@@ -0,0 +1,383 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. reputationRecord.proto
+package blackList
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// PeerIDRecordType is the reputation record type used for p2p peer IDs
+const PeerIDRecordType = "pid"
+
+// PublicKeyRecordType is the reputation record type used for validators public keys
+const PublicKeyRecordType = "pk"
+
+// FloodingReason is the ban reason used for the peer IDs blacklisted by the antiflood components
+const FloodingReason = "flooding"
+
+// PeerHonestyReason is the ban reason used for the public keys blacklisted because of a low honesty score
+const PeerHonestyReason = "low peer honesty score"
+
+const defaultPublicKeySpan = 300 * time.Second
+
+// ArgPeerReputationStore represents the argument for the peer reputation store constructor
+type ArgPeerReputationStore struct {
+	Storer      storage.Storer
+	Marshalizer marshal.Marshalizer
+}
+
+type peerReputationStore struct {
+	storer      storage.Storer
+	marshalizer marshal.Marshalizer
+	mutRecords  sync.RWMutex
+	records     map[string]*ReputationRecord
+	getTimeFunc func() time.Time
+}
+
+// NewPeerReputationStore creates a peer reputation store that keeps the blacklisting decisions for both
+// peer IDs and public keys in the provided storer. The still active records are reloaded on construction.
+func NewPeerReputationStore(arg ArgPeerReputationStore) (*peerReputationStore, error) {
+	if check.IfNil(arg.Storer) {
+		return nil, fmt.Errorf("%w in NewPeerReputationStore", process.ErrNilStorage)
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, fmt.Errorf("%w in NewPeerReputationStore", process.ErrNilMarshalizer)
+	}
+
+	prs := &peerReputationStore{
+		storer:      arg.Storer,
+		marshalizer: arg.Marshalizer,
+		records:     make(map[string]*ReputationRecord),
+		getTimeFunc: time.Now,
+	}
+	prs.loadFromStorage()
+
+	return prs, nil
+}
+
+func (prs *peerReputationStore) loadFromStorage() {
+	expiredKeys := make([][]byte, 0)
+	now := prs.getTimeFunc().UnixNano()
+	prs.storer.RangeKeys(func(key []byte, val []byte) bool {
+		record := &ReputationRecord{}
+		err := prs.marshalizer.Unmarshal(record, val)
+		if err != nil {
+			log.Debug("peerReputationStore.loadFromStorage: unmarshal", "error", err)
+			expiredKeys = append(expiredKeys, key)
+			return true
+		}
+		if record.ExpiryTimestamp <= now {
+			expiredKeys = append(expiredKeys, key)
+			return true
+		}
+
+		prs.records[string(key)] = record
+		return true
+	})
+
+	for _, key := range expiredKeys {
+		prs.removeFromStorage(key)
+	}
+
+	log.Debug("peerReputationStore: loaded blacklisted peers", "num records", len(prs.records))
+}
+
+// Ban adds or replaces the reputation record of the provided identifier, making it banned for the provided span
+func (prs *peerReputationStore) Ban(recordType string, identifier []byte, span time.Duration, reason string) error {
+	err := checkRecordTypeAndIdentifier(recordType, identifier)
+	if err != nil {
+		return err
+	}
+	if span <= 0 {
+		return fmt.Errorf("%w for the ban span", process.ErrInvalidValue)
+	}
+
+	prs.mutRecords.Lock()
+	defer prs.mutRecords.Unlock()
+
+	record := &ReputationRecord{
+		Type:            recordType,
+		Identifier:      identifier,
+		Reason:          reason,
+		ExpiryTimestamp: prs.getTimeFunc().Add(span).UnixNano(),
+	}
+	oldRecord, found := prs.records[recordKey(recordType, identifier)]
+	if found {
+		record.Score = oldRecord.Score
+	}
+
+	return prs.saveRecordNoLock(record)
+}
+
+// Unban removes the reputation record of the provided identifier
+func (prs *peerReputationStore) Unban(recordType string, identifier []byte) error {
+	err := checkRecordTypeAndIdentifier(recordType, identifier)
+	if err != nil {
+		return err
+	}
+
+	prs.mutRecords.Lock()
+	defer prs.mutRecords.Unlock()
+
+	key := recordKey(recordType, identifier)
+	_, found := prs.records[key]
+	if !found {
+		return process.ErrReputationRecordNotFound
+	}
+
+	delete(prs.records, key)
+	prs.removeFromStorage([]byte(key))
+
+	return nil
+}
+
+// Records returns a copy of all the active reputation records, sorted by type and identifier
+func (prs *peerReputationStore) Records() []ReputationRecord {
+	prs.mutRecords.RLock()
+	defer prs.mutRecords.RUnlock()
+
+	now := prs.getTimeFunc().UnixNano()
+	records := make([]ReputationRecord, 0, len(prs.records))
+	for _, record := range prs.records {
+		if record.ExpiryTimestamp <= now {
+			continue
+		}
+
+		records = append(records, *record)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Type != records[j].Type {
+			return records[i].Type < records[j].Type
+		}
+
+		return string(records[i].Identifier) < string(records[j].Identifier)
+	})
+
+	return records
+}
+
+// SaveScore will record the provided score on the reputation record of the public key, if existing
+func (prs *peerReputationStore) SaveScore(pk string, score float64) {
+	prs.mutRecords.Lock()
+	defer prs.mutRecords.Unlock()
+
+	record, found := prs.records[recordKey(PublicKeyRecordType, []byte(pk))]
+	if !found {
+		return
+	}
+
+	updatedRecord := *record
+	updatedRecord.Score = score
+	err := prs.saveRecordNoLock(&updatedRecord)
+	if err != nil {
+		log.Warn("peerReputationStore.SaveScore", "error", err)
+	}
+}
+
+// PeerIDsCacher returns the peer IDs blacklist cache backed by this store
+func (prs *peerReputationStore) PeerIDsCacher() process.PeerBlackListCacher {
+	return &peerIDsReputationCacher{
+		store: prs,
+	}
+}
+
+// PublicKeysCacher returns the public keys blacklist cache backed by this store
+func (prs *peerReputationStore) PublicKeysCacher() process.TimeCacher {
+	return &publicKeysReputationCacher{
+		store: prs,
+	}
+}
+
+// upsert behaves as the time cache's upsert: the expiry time is only changed if the new one is later. An active
+// record, such as a manual ban, keeps its reason and score and only has its span extended. An expired record is
+// replaced as a new ban, without the score of the previous one.
+func (prs *peerReputationStore) upsert(recordType string, identifier []byte, span time.Duration, reason string) error {
+	err := checkRecordTypeAndIdentifier(recordType, identifier)
+	if err != nil {
+		return err
+	}
+
+	prs.mutRecords.Lock()
+	defer prs.mutRecords.Unlock()
+
+	now := prs.getTimeFunc()
+	expiryTimestamp := now.Add(span).UnixNano()
+	record, found := prs.records[recordKey(recordType, identifier)]
+	if !found {
+		return prs.saveRecordNoLock(&ReputationRecord{
+			Type:            recordType,
+			Identifier:      identifier,
+			Reason:          reason,
+			ExpiryTimestamp: expiryTimestamp,
+		})
+	}
+	if record.ExpiryTimestamp >= expiryTimestamp {
+		return nil
+	}
+
+	updatedRecord := *record
+	updatedRecord.ExpiryTimestamp = expiryTimestamp
+	if record.ExpiryTimestamp <= now.UnixNano() {
+		updatedRecord.Reason = reason
+		updatedRecord.Score = 0
+	}
+
+	return prs.saveRecordNoLock(&updatedRecord)
+}
+
+func (prs *peerReputationStore) has(recordType string, identifier []byte) bool {
+	prs.mutRecords.RLock()
+	defer prs.mutRecords.RUnlock()
+
+	record, found := prs.records[recordKey(recordType, identifier)]
+	if !found {
+		return false
+	}
+
+	return record.ExpiryTimestamp > prs.getTimeFunc().UnixNano()
+}
+
+func (prs *peerReputationStore) len(recordType string) int {
+	prs.mutRecords.RLock()
+	defer prs.mutRecords.RUnlock()
+
+	numRecords := 0
+	for _, record := range prs.records {
+		if record.Type == recordType {
+			numRecords++
+		}
+	}
+
+	return numRecords
+}
+
+func (prs *peerReputationStore) sweep() {
+	prs.mutRecords.Lock()
+	defer prs.mutRecords.Unlock()
+
+	now := prs.getTimeFunc().UnixNano()
+	for key, record := range prs.records {
+		if record.ExpiryTimestamp > now {
+			continue
+		}
+
+		delete(prs.records, key)
+		prs.removeFromStorage([]byte(key))
+	}
+}
+
+func (prs *peerReputationStore) saveRecordNoLock(record *ReputationRecord) error {
+	buff, err := prs.marshalizer.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	key := recordKey(record.Type, record.Identifier)
+	err = prs.storer.Put([]byte(key), buff)
+	if err != nil {
+		return err
+	}
+
+	prs.records[key] = record
+
+	return nil
+}
+
+func (prs *peerReputationStore) removeFromStorage(key []byte) {
+	err := prs.storer.Remove(key)
+	if err != nil {
+		log.Debug("peerReputationStore: remove record", "error", err)
+	}
+}
+
+func checkRecordTypeAndIdentifier(recordType string, identifier []byte) error {
+	if recordType != PeerIDRecordType && recordType != PublicKeyRecordType {
+		return fmt.Errorf("%w: %s", process.ErrInvalidReputationRecordType, recordType)
+	}
+	if len(identifier) == 0 {
+		return fmt.Errorf("%w for the record identifier", process.ErrInvalidValue)
+	}
+
+	return nil
+}
+
+func recordKey(recordType string, identifier []byte) string {
+	return recordType + "_" + string(identifier)
+}
+
+// Close closes the underlying storer
+func (prs *peerReputationStore) Close() error {
+	return prs.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prs *peerReputationStore) IsInterfaceNil() bool {
+	return prs == nil
+}
+
+type peerIDsReputationCacher struct {
+	store *peerReputationStore
+}
+
+// Upsert bans the provided peer ID for the provided span as a flooding peer
+func (cacher *peerIDsReputationCacher) Upsert(pid core.PeerID, span time.Duration) error {
+	return cacher.store.upsert(PeerIDRecordType, pid.Bytes(), span, FloodingReason)
+}
+
+// Has returns true if the provided peer ID is banned
+func (cacher *peerIDsReputationCacher) Has(pid core.PeerID) bool {
+	return cacher.store.has(PeerIDRecordType, pid.Bytes())
+}
+
+// Sweep removes all the expired records
+func (cacher *peerIDsReputationCacher) Sweep() {
+	cacher.store.sweep()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cacher *peerIDsReputationCacher) IsInterfaceNil() bool {
+	return cacher == nil
+}
+
+type publicKeysReputationCacher struct {
+	store *peerReputationStore
+}
+
+// Add bans the provided public key for the default span
+func (cacher *publicKeysReputationCacher) Add(pk string) error {
+	return cacher.store.upsert(PublicKeyRecordType, []byte(pk), defaultPublicKeySpan, PeerHonestyReason)
+}
+
+// Upsert bans the provided public key for the provided span because of its low honesty score
+func (cacher *publicKeysReputationCacher) Upsert(pk string, span time.Duration) error {
+	return cacher.store.upsert(PublicKeyRecordType, []byte(pk), span, PeerHonestyReason)
+}
+
+// Has returns true if the provided public key is banned
+func (cacher *publicKeysReputationCacher) Has(pk string) bool {
+	return cacher.store.has(PublicKeyRecordType, []byte(pk))
+}
+
+// Sweep removes all the expired records
+func (cacher *publicKeysReputationCacher) Sweep() {
+	cacher.store.sweep()
+}
+
+// Len returns the number of the public keys records
+func (cacher *publicKeysReputationCacher) Len() int {
+	return cacher.store.len(PublicKeyRecordType)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cacher *publicKeysReputationCacher) IsInterfaceNil() bool {
+	return cacher == nil
+}
@@ -0,0 +1,213 @@
+package blackList
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgPeerReputationStore(storer storage.Storer) ArgPeerReputationStore {
+	return ArgPeerReputationStore{
+		Storer:      storer,
+		Marshalizer: &marshal.GogoProtoMarshalizer{},
+	}
+}
+
+func createStoreWithTime(storer storage.Storer, currentTime *time.Time) *peerReputationStore {
+	prs, _ := NewPeerReputationStore(createMockArgPeerReputationStore(storer))
+	prs.getTimeFunc = func() time.Time {
+		return *currentTime
+	}
+
+	return prs
+}
+
+func TestNewPeerReputationStore_NilStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	prs, err := NewPeerReputationStore(createMockArgPeerReputationStore(nil))
+
+	assert.True(t, check.IfNil(prs))
+	assert.True(t, errors.Is(err, process.ErrNilStorage))
+}
+
+func TestNewPeerReputationStore_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgPeerReputationStore(genericmocks.NewStorerMock("", 0))
+	arg.Marshalizer = nil
+	prs, err := NewPeerReputationStore(arg)
+
+	assert.True(t, check.IfNil(prs))
+	assert.True(t, errors.Is(err, process.ErrNilMarshalizer))
+}
+
+func TestNewPeerReputationStore_ShouldReloadOnlyActiveRecords(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("", 0)
+	currentTime := time.Now().Add(-time.Minute)
+	prs := createStoreWithTime(storer, &currentTime)
+	_ = prs.PeerIDsCacher().Upsert("pid1", time.Second)
+	_ = prs.PeerIDsCacher().Upsert("pid2", time.Hour)
+	_ = prs.PublicKeysCacher().Upsert("pk", time.Hour)
+	prs.SaveScore("pk", -50)
+
+	prs, err := NewPeerReputationStore(createMockArgPeerReputationStore(storer))
+	require.Nil(t, err)
+
+	assert.False(t, prs.PeerIDsCacher().Has("pid1"))
+	assert.True(t, prs.PeerIDsCacher().Has("pid2"))
+	assert.True(t, prs.PublicKeysCacher().Has("pk"))
+	records := prs.Records()
+	require.Equal(t, 2, len(records))
+	assert.Equal(t, PeerIDRecordType, records[0].Type)
+	assert.Equal(t, FloodingReason, records[0].Reason)
+	assert.Equal(t, PublicKeyRecordType, records[1].Type)
+	assert.Equal(t, PeerHonestyReason, records[1].Reason)
+	assert.Equal(t, float64(-50), records[1].Score)
+	assert.Equal(t, 2, len(storer.GetCurrentEpochData().Keys()))
+}
+
+func TestPeerReputationStore_UpsertShouldNotShortenTheBan(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	prs := createStoreWithTime(genericmocks.NewStorerMock("", 0), &currentTime)
+	cacher := prs.PeerIDsCacher()
+	_ = cacher.Upsert("pid", time.Minute)
+	_ = cacher.Upsert("pid", time.Second)
+
+	currentTime = currentTime.Add(time.Second * 2)
+	assert.True(t, cacher.Has("pid"))
+
+	currentTime = currentTime.Add(time.Minute)
+	assert.False(t, cacher.Has("pid"))
+}
+
+func TestPeerReputationStore_UpsertShouldKeepTheManualBanReasonAndScore(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	prs := createStoreWithTime(genericmocks.NewStorerMock("", 0), &currentTime)
+	err := prs.Ban(PublicKeyRecordType, []byte("pk"), time.Minute, "manual")
+	require.Nil(t, err)
+	prs.SaveScore("pk", -10)
+
+	err = prs.PublicKeysCacher().Upsert("pk", time.Hour)
+	require.Nil(t, err)
+
+	records := prs.Records()
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, "manual", records[0].Reason)
+	assert.Equal(t, float64(-10), records[0].Score)
+	assert.Equal(t, currentTime.Add(time.Hour).UnixNano(), records[0].ExpiryTimestamp)
+}
+
+func TestPeerReputationStore_UpsertOnExpiredRecordShouldReplaceTheReason(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	prs := createStoreWithTime(genericmocks.NewStorerMock("", 0), &currentTime)
+	_ = prs.Ban(PublicKeyRecordType, []byte("pk"), time.Second, "manual")
+
+	currentTime = currentTime.Add(time.Minute)
+	_ = prs.PublicKeysCacher().Upsert("pk", time.Hour)
+
+	records := prs.Records()
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, PeerHonestyReason, records[0].Reason)
+}
+
+func TestPeerReputationStore_UpsertOnExpiredRecordShouldResetTheScore(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	prs := createStoreWithTime(genericmocks.NewStorerMock("", 0), &currentTime)
+	_ = prs.PublicKeysCacher().Upsert("pk", time.Second)
+	prs.SaveScore("pk", -10)
+
+	currentTime = currentTime.Add(time.Minute)
+	err := prs.PublicKeysCacher().Upsert("pk", time.Hour)
+	require.Nil(t, err)
+
+	records := prs.Records()
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, float64(0), records[0].Score)
+	assert.Equal(t, currentTime.Add(time.Hour).UnixNano(), records[0].ExpiryTimestamp)
+}
+
+func TestPeerReputationStore_BanShouldErrOnInvalidArguments(t *testing.T) {
+	t.Parallel()
+
+	prs, _ := NewPeerReputationStore(createMockArgPeerReputationStore(genericmocks.NewStorerMock("", 0)))
+
+	err := prs.Ban("invalid", []byte("pid"), time.Second, "")
+	assert.True(t, errors.Is(err, process.ErrInvalidReputationRecordType))
+
+	err = prs.Ban(PeerIDRecordType, nil, time.Second, "")
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	err = prs.Ban(PeerIDRecordType, []byte("pid"), 0, "")
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestPeerReputationStore_BanAndUnbanShouldWork(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("", 0)
+	prs, _ := NewPeerReputationStore(createMockArgPeerReputationStore(storer))
+
+	err := prs.Ban(PublicKeyRecordType, []byte("pk"), time.Hour, "manual")
+	require.Nil(t, err)
+	assert.True(t, prs.PublicKeysCacher().Has("pk"))
+	assert.Equal(t, 1, prs.PublicKeysCacher().Len())
+	records := prs.Records()
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, "manual", records[0].Reason)
+
+	err = prs.Unban(PublicKeyRecordType, []byte("pk"))
+	require.Nil(t, err)
+	assert.False(t, prs.PublicKeysCacher().Has("pk"))
+	assert.Equal(t, 0, len(prs.Records()))
+	assert.Equal(t, 0, len(storer.GetCurrentEpochData().Keys()))
+
+	err = prs.Unban(PublicKeyRecordType, []byte("pk"))
+	assert.Equal(t, process.ErrReputationRecordNotFound, err)
+}
+
+func TestPeerReputationStore_SweepShouldRemoveExpiredRecords(t *testing.T) {
+	t.Parallel()
+
+	storer := genericmocks.NewStorerMock("", 0)
+	currentTime := time.Unix(1000, 0)
+	prs := createStoreWithTime(storer, &currentTime)
+	_ = prs.PeerIDsCacher().Upsert(core.PeerID("pid1"), time.Second)
+	_ = prs.PeerIDsCacher().Upsert(core.PeerID("pid2"), time.Hour)
+
+	currentTime = currentTime.Add(time.Minute)
+	prs.PeerIDsCacher().Sweep()
+
+	records := prs.Records()
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, []byte("pid2"), records[0].Identifier)
+	assert.Equal(t, 1, len(storer.GetCurrentEpochData().Keys()))
+}
+
+func TestPeerReputationStore_SaveScoreOnMissingRecordShouldNotAdd(t *testing.T) {
+	t.Parallel()
+
+	prs, _ := NewPeerReputationStore(createMockArgPeerReputationStore(genericmocks.NewStorerMock("", 0)))
+	prs.SaveScore("pk", -10)
+
+	assert.Equal(t, 0, len(prs.Records()))
+}
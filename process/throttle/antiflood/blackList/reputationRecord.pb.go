@@ -0,0 +1,572 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: reputationRecord.proto
+
+package blackList
+
+import (
+	bytes "bytes"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReputationRecord holds the persisted blacklisting decision taken for a peer ID or a public key
+type ReputationRecord struct {
+	Type            string  `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	Identifier      []byte  `protobuf:"bytes,2,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Score           float64 `protobuf:"fixed64,3,opt,name=Score,proto3" json:"Score,omitempty"`
+	Reason          string  `protobuf:"bytes,4,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	ExpiryTimestamp int64   `protobuf:"varint,5,opt,name=ExpiryTimestamp,proto3" json:"ExpiryTimestamp,omitempty"`
+}
+
+func (m *ReputationRecord) Reset()      { *m = ReputationRecord{} }
+func (*ReputationRecord) ProtoMessage() {}
+func (*ReputationRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b7300d2cf14f790, []int{0}
+}
+func (m *ReputationRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReputationRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReputationRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputationRecord.Merge(m, src)
+}
+func (m *ReputationRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReputationRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputationRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputationRecord proto.InternalMessageInfo
+
+func (m *ReputationRecord) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ReputationRecord) GetIdentifier() []byte {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *ReputationRecord) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *ReputationRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ReputationRecord) GetExpiryTimestamp() int64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ReputationRecord)(nil), "proto.ReputationRecord")
+}
+
+func init() { proto.RegisterFile("reputationRecord.proto", fileDescriptor_0b7300d2cf14f790) }
+
+var fileDescriptor_0b7300d2cf14f790 = []byte{
+	// 261 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x8f, 0xbd, 0x4a, 0x04, 0x31,
+	0x14, 0x85, 0x73, 0xdd, 0x1f, 0xd8, 0x20, 0x28, 0x41, 0x96, 0x60, 0x71, 0x19, 0xac, 0xd2, 0xb8,
+	0x5b, 0xf8, 0x06, 0x8a, 0x85, 0x60, 0x15, 0xb7, 0xb2, 0x9b, 0x99, 0xcd, 0x8e, 0x41, 0x67, 0x12,
+	0x32, 0x19, 0x70, 0x3b, 0x1f, 0xc1, 0x27, 0xb0, 0xf6, 0x51, 0x2c, 0xa7, 0x9c, 0xd2, 0xc9, 0x34,
+	0x96, 0xfb, 0x08, 0x42, 0xd6, 0x42, 0xb6, 0xba, 0xe7, 0xfb, 0xe0, 0x1e, 0x38, 0x74, 0xee, 0x94,
+	0x6d, 0x7c, 0xea, 0xb5, 0xa9, 0xa4, 0xca, 0x8d, 0x5b, 0x2f, 0xac, 0x33, 0xde, 0xb0, 0x49, 0x3c,
+	0xe7, 0x97, 0x85, 0xf6, 0x4f, 0x4d, 0xb6, 0xc8, 0x4d, 0xb9, 0x2c, 0x4c, 0x61, 0x96, 0x51, 0x67,
+	0xcd, 0x26, 0x52, 0x84, 0x98, 0xf6, 0x5f, 0x17, 0x1f, 0x40, 0x4f, 0xe5, 0x41, 0x21, 0x63, 0x74,
+	0xbc, 0xda, 0x5a, 0xc5, 0x21, 0x01, 0x31, 0x93, 0x31, 0x33, 0xa4, 0xf4, 0x6e, 0xad, 0x2a, 0xaf,
+	0x37, 0x5a, 0x39, 0x7e, 0x94, 0x80, 0x38, 0x96, 0xff, 0x0c, 0x3b, 0xa3, 0x93, 0x87, 0xdc, 0x38,
+	0xc5, 0x47, 0x09, 0x08, 0x90, 0x7b, 0x60, 0x73, 0x3a, 0x95, 0x2a, 0xad, 0x4d, 0xc5, 0xc7, 0xb1,
+	0xeb, 0x8f, 0x98, 0xa0, 0x27, 0xb7, 0xaf, 0x56, 0xbb, 0xed, 0x4a, 0x97, 0xaa, 0xf6, 0x69, 0x69,
+	0xf9, 0x24, 0x01, 0x31, 0x92, 0x87, 0xfa, 0xfa, 0xa6, 0xed, 0x91, 0x74, 0x3d, 0x92, 0x5d, 0x8f,
+	0xf0, 0x16, 0x10, 0x3e, 0x03, 0xc2, 0x57, 0x40, 0x68, 0x03, 0x42, 0x17, 0x10, 0xbe, 0x03, 0xc2,
+	0x4f, 0x40, 0xb2, 0x0b, 0x08, 0xef, 0x03, 0x92, 0x76, 0x40, 0xd2, 0x0d, 0x48, 0x1e, 0x67, 0xd9,
+	0x4b, 0x9a, 0x3f, 0xdf, 0xeb, 0xda, 0x67, 0xd3, 0x38, 0xf6, 0xea, 0x77, 0x00, 0x57, 0x34, 0x25,
+	0x47, 0x3c, 0x01, 0x00, 0x00,
+}
+
+func (this *ReputationRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReputationRecord)
+	if !ok {
+		that2, ok := that.(ReputationRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.Identifier, that1.Identifier) {
+		return false
+	}
+	if this.Score != that1.Score {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.ExpiryTimestamp != that1.ExpiryTimestamp {
+		return false
+	}
+	return true
+}
+func (this *ReputationRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&blackList.ReputationRecord{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Identifier: "+fmt.Sprintf("%#v", this.Identifier)+",\n")
+	s = append(s, "Score: "+fmt.Sprintf("%#v", this.Score)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "ExpiryTimestamp: "+fmt.Sprintf("%#v", this.ExpiryTimestamp)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringReputationRecord(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ReputationRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReputationRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReputationRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintReputationRecord(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintReputationRecord(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Score != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintReputationRecord(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintReputationRecord(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReputationRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReputationRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReputationRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovReputationRecord(uint64(l))
+	}
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovReputationRecord(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 9
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovReputationRecord(uint64(l))
+	}
+	if m.ExpiryTimestamp != 0 {
+		n += 1 + sovReputationRecord(uint64(m.ExpiryTimestamp))
+	}
+	return n
+}
+
+func sovReputationRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReputationRecord(x uint64) (n int) {
+	return sovReputationRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ReputationRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReputationRecord{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Identifier:` + fmt.Sprintf("%v", this.Identifier) + `,`,
+		`Score:` + fmt.Sprintf("%v", this.Score) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`ExpiryTimestamp:` + fmt.Sprintf("%v", this.ExpiryTimestamp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringReputationRecord(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ReputationRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReputationRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReputationRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReputationRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputationRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputationRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthReputationRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputationRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = append(m.Identifier[:0], dAtA[iNdEx:postIndex]...)
+			if m.Identifier == nil {
+				m.Identifier = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReputationRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReputationRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReputationRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReputationRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthReputationRecord
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthReputationRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReputationRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReputationRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReputationRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReputationRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReputationRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReputationRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReputationRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReputationRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReputationRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReputationRecord = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.AdaptiveQuotaHandler = (*AdaptiveQuotaHandler)(nil)
+
+// AdaptiveQuotaHandler is a disabled implementation of the adaptive quota handler
+type AdaptiveQuotaHandler struct {
+}
+
+// AdaptReservedPercent returns the provided reserved percent
+func (aqh *AdaptiveQuotaHandler) AdaptReservedPercent(_ core.PeerID, reservedPercent float32) float32 {
+	return reservedPercent
+}
+
+// SetPeerValidatorMapper does nothing
+func (aqh *AdaptiveQuotaHandler) SetPeerValidatorMapper(_ process.PeerValidatorMapper) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (aqh *AdaptiveQuotaHandler) IsInterfaceNil() bool {
+	return aqh == nil
+}
@@ -0,0 +1,24 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdaptiveQuotaHandler_ShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		assert.Nil(t, r, "this shouldn't panic")
+	}()
+
+	aqh := &AdaptiveQuotaHandler{}
+	assert.False(t, check.IfNil(aqh))
+
+	reservedPercent := float32(20)
+	assert.Equal(t, reservedPercent, aqh.AdaptReservedPercent("pid", reservedPercent))
+	assert.Nil(t, aqh.SetPeerValidatorMapper(nil))
+}
@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.AntifloodLimitsHandler = (*LimitsHandler)(nil)
+
+// LimitsHandler is a disabled implementation of the AntifloodLimitsHandler interface
+type LimitsHandler struct {
+}
+
+// Limits returns empty limits
+func (lh *LimitsHandler) Limits() config.AntifloodLimitsOverrideConfig {
+	return config.AntifloodLimitsOverrideConfig{}
+}
+
+// SetLimits returns ErrAntifloodDisabled as there are no limits that can be changed
+func (lh *LimitsHandler) SetLimits(_ config.AntifloodLimitsOverrideConfig, _ string) error {
+	return process.ErrAntifloodDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lh *LimitsHandler) IsInterfaceNil() bool {
+	return lh == nil
+}
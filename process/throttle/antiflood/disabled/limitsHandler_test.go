@@ -0,0 +1,26 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitsHandler_ShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		assert.Nil(t, r, "this shouldn't panic")
+	}()
+
+	lh := &LimitsHandler{}
+	assert.False(t, check.IfNil(lh))
+
+	assert.Equal(t, config.AntifloodLimitsOverrideConfig{}, lh.Limits())
+	err := lh.SetLimits(config.AntifloodLimitsOverrideConfig{}, "source")
+	assert.Equal(t, process.ErrAntifloodDisabled, err)
+}
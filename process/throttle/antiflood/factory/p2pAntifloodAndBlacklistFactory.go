@@ -17,47 +17,77 @@ import (
 	"github.com/ElrondNetwork/elrond-go/statusHandler/p2pQuota"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
-	"github.com/ElrondNetwork/elrond-go/storage/timecache"
 )
 
 var durationSweepP2PBlacklist = time.Second * 5
 var log = logger.GetOrCreate("p2p/antiflood/factory")
 
-const defaultSpan = 300 * time.Second
 const fastReactingIdentifier = "fast_reacting"
 const slowReactingIdentifier = "slow_reacting"
 const outOfSpecsIdentifier = "out_of_specs"
 const outputIdentifier = "output"
 
-// NewP2PAntiFloodAndBlackList will return instances of antiflood and blacklist, based on the config
+type floodPreventerWithLimits interface {
+	process.FloodPreventer
+	SetLimits(limits config.FloodPreventerLimitsConfig) error
+}
+
+// AntiFloodComponents holds the components created by the antiflood factory
+type AntiFloodComponents struct {
+	AntiFloodHandler     process.P2PAntifloodHandler
+	BlacklistHandler     process.PeerBlackListCacher
+	PubKeysCacher        process.TimeCacher
+	LimitsHandler        process.AntifloodLimitsHandler
+	AdaptiveQuotaHandler process.AdaptiveQuotaHandler
+}
+
+// NewP2PAntiFloodAndBlackList will return instances of antiflood and blacklist, based on the config.
+// The blacklist caches are provided by the peer reputation store so the decisions will survive a node restart.
+// The load provider is used, if the adaptive mode is enabled, to shrink the non-validators quotas as the load rises
 func NewP2PAntiFloodAndBlackList(
 	config config.Config,
 	statusHandler core.AppStatusHandler,
 	currentPid core.PeerID,
-) (process.P2PAntifloodHandler, process.PeerBlackListCacher, process.TimeCacher, error) {
+	reputationStore process.PeerReputationStore,
+	loadProvider process.LoadProvider,
+) (*AntiFloodComponents, error) {
 	if check.IfNil(statusHandler) {
-		return nil, nil, nil, p2p.ErrNilStatusHandler
+		return nil, p2p.ErrNilStatusHandler
+	}
+	if check.IfNil(reputationStore) {
+		return nil, process.ErrNilPeerReputationStore
+	}
+	if check.IfNil(loadProvider) {
+		return nil, process.ErrNilLoadProvider
 	}
 	if config.Antiflood.Enabled {
-		return initP2PAntiFloodAndBlackList(config, statusHandler, currentPid)
+		return initP2PAntiFloodAndBlackList(config, statusHandler, currentPid, reputationStore, loadProvider)
 	}
 
-	return &disabled.AntiFlood{}, &disabled.PeerBlacklistCacher{}, &disabled.TimeCache{}, nil
+	return &AntiFloodComponents{
+		AntiFloodHandler:     &disabled.AntiFlood{},
+		BlacklistHandler:     &disabled.PeerBlacklistCacher{},
+		PubKeysCacher:        &disabled.TimeCache{},
+		LimitsHandler:        &disabled.LimitsHandler{},
+		AdaptiveQuotaHandler: &disabled.AdaptiveQuotaHandler{},
+	}, nil
 }
 
 func initP2PAntiFloodAndBlackList(
 	mainConfig config.Config,
 	statusHandler core.AppStatusHandler,
 	currentPid core.PeerID,
-) (process.P2PAntifloodHandler, process.PeerBlackListCacher, process.TimeCacher, error) {
-	cache := timecache.NewTimeCache(defaultSpan)
-	p2pPeerBlackList, err := timecache.NewPeerTimeCache(cache)
+	reputationStore process.PeerReputationStore,
+	loadProvider process.LoadProvider,
+) (*AntiFloodComponents, error) {
+	p2pPeerBlackList := reputationStore.PeerIDsCacher()
+	publicKeysCache := reputationStore.PublicKeysCacher()
+
+	adaptiveQuotaHandler, err := createAdaptiveQuotaHandler(mainConfig.Antiflood.Adaptive, loadProvider)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, fmt.Errorf("%w when creating adaptive quota handler", err)
 	}
 
-	publicKeysCache := timecache.NewTimeCache(defaultSpan)
-
 	fastReactingFloodPreventer, err := createFloodPreventer(
 		mainConfig.Antiflood.FastReacting,
 		mainConfig.Antiflood.Cache,
@@ -65,9 +95,10 @@ func initP2PAntiFloodAndBlackList(
 		fastReactingIdentifier,
 		p2pPeerBlackList,
 		currentPid,
+		adaptiveQuotaHandler,
 	)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("%w when creating fast reacting flood preventer", err)
+		return nil, fmt.Errorf("%w when creating fast reacting flood preventer", err)
 	}
 
 	slowReactingFloodPreventer, err := createFloodPreventer(
@@ -77,9 +108,10 @@ func initP2PAntiFloodAndBlackList(
 		slowReactingIdentifier,
 		p2pPeerBlackList,
 		currentPid,
+		adaptiveQuotaHandler,
 	)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("%w when creating fast reacting flood preventer", err)
+		return nil, fmt.Errorf("%w when creating fast reacting flood preventer", err)
 	}
 
 	outOfSpecsFloodPreventer, err := createFloodPreventer(
@@ -89,19 +121,33 @@ func initP2PAntiFloodAndBlackList(
 		outOfSpecsIdentifier,
 		p2pPeerBlackList,
 		currentPid,
+		adaptiveQuotaHandler,
 	)
 	if err != nil {
-		return nil, nil, nil, fmt.Errorf("%w when creating out of specs flood preventer", err)
+		return nil, fmt.Errorf("%w when creating out of specs flood preventer", err)
 	}
 
 	topicFloodPreventer, err := floodPreventers.NewTopicFloodPreventer(mainConfig.Antiflood.Topic.DefaultMaxMessagesPerSec)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, err
 	}
 
 	topicMaxMessages := mainConfig.Antiflood.Topic.MaxMessages
 	setMaxMessages(topicFloodPreventer, topicMaxMessages)
 
+	argLimitsHandler := antiflood.ArgLimitsHandler{
+		FastReacting:     fastReactingFloodPreventer,
+		SlowReacting:     slowReactingFloodPreventer,
+		OutOfSpecs:       outOfSpecsFloodPreventer,
+		TopicPreventer:   topicFloodPreventer,
+		InitialLimits:    createInitialLimits(mainConfig.Antiflood),
+		OverrideFilePath: mainConfig.Antiflood.RuntimeLimits.OverrideFilePath,
+	}
+	limitsHandler, err := antiflood.NewLimitsHandler(argLimitsHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	p2pAntiflood, err := antiflood.NewP2PAntiflood(
 		p2pPeerBlackList,
 		topicFloodPreventer,
@@ -110,13 +156,53 @@ func initP2PAntiFloodAndBlackList(
 		outOfSpecsFloodPreventer,
 	)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, err
 	}
 
-	startResettingTopicFloodPreventer(topicFloodPreventer, topicMaxMessages)
+	startResettingTopicFloodPreventer(topicFloodPreventer, limitsHandler)
 	startSweepingTimeCaches(p2pPeerBlackList, publicKeysCache)
 
-	return p2pAntiflood, p2pPeerBlackList, publicKeysCache, nil
+	return &AntiFloodComponents{
+		AntiFloodHandler:     p2pAntiflood,
+		BlacklistHandler:     p2pPeerBlackList,
+		PubKeysCacher:        publicKeysCache,
+		LimitsHandler:        limitsHandler,
+		AdaptiveQuotaHandler: adaptiveQuotaHandler,
+	}, nil
+}
+
+func createAdaptiveQuotaHandler(
+	adaptiveConfig config.AdaptiveAntifloodConfig,
+	loadProvider process.LoadProvider,
+) (process.AdaptiveQuotaHandler, error) {
+	if !adaptiveConfig.Enabled {
+		return &disabled.AdaptiveQuotaHandler{}, nil
+	}
+
+	arg := antiflood.ArgAdaptiveQuotaHandler{
+		LoadProvider:       loadProvider,
+		LowLoadPercent:     adaptiveConfig.LowLoadPercent,
+		HighLoadPercent:    adaptiveConfig.HighLoadPercent,
+		MaxReservedPercent: adaptiveConfig.MaxReservedPercent,
+	}
+
+	return antiflood.NewAdaptiveQuotaHandler(arg)
+}
+
+func createInitialLimits(antifloodConfig config.AntifloodConfig) config.AntifloodLimitsOverrideConfig {
+	return config.AntifloodLimitsOverrideConfig{
+		FastReacting: createFloodPreventerLimits(antifloodConfig.FastReacting),
+		SlowReacting: createFloodPreventerLimits(antifloodConfig.SlowReacting),
+		OutOfSpecs:   createFloodPreventerLimits(antifloodConfig.OutOfSpecs),
+		Topic:        antifloodConfig.Topic,
+	}
+}
+
+func createFloodPreventerLimits(floodPreventerConfig config.FloodPreventerConfig) config.FloodPreventerLimitsConfig {
+	return config.FloodPreventerLimitsConfig{
+		ReservedPercent: floodPreventerConfig.ReservedPercent,
+		PeerMaxInput:    floodPreventerConfig.PeerMaxInput,
+	}
 }
 
 func setMaxMessages(topicFloodPreventer process.TopicFloodPreventer, topicMaxMessages []config.TopicMaxMessagesConfig) {
@@ -125,21 +211,20 @@ func setMaxMessages(topicFloodPreventer process.TopicFloodPreventer, topicMaxMes
 	}
 }
 
+// startResettingTopicFloodPreventer resets the topics counters each second. The registered topics are read
+// from the limits handler on each iteration as they can be changed at runtime
 func startResettingTopicFloodPreventer(
 	topicFloodPreventer process.TopicFloodPreventer,
-	topicMaxMessages []config.TopicMaxMessagesConfig,
+	limitsHandler process.AntifloodLimitsHandler,
 	floodPreventers ...process.FloodPreventer,
 ) {
-	localTopicMaxMessages := make([]config.TopicMaxMessagesConfig, len(topicMaxMessages))
-	copy(localTopicMaxMessages, topicMaxMessages)
-
 	go func() {
 		for {
 			time.Sleep(time.Second)
 			for _, fp := range floodPreventers {
 				fp.Reset()
 			}
-			for _, topicMaxMsg := range localTopicMaxMessages {
+			for _, topicMaxMsg := range limitsHandler.Limits().Topic.MaxMessages {
 				topicFloodPreventer.ResetForTopic(topicMaxMsg.Topic)
 			}
 			topicFloodPreventer.ResetForNotRegisteredTopics()
@@ -164,7 +249,8 @@ func createFloodPreventer(
 	quotaIdentifier string,
 	blackListHandler process.PeerBlackListCacher,
 	selfPid core.PeerID,
-) (process.FloodPreventer, error) {
+	reservedPercentAdapter floodPreventers.ReservedPercentAdapter,
+) (floodPreventerWithLimits, error) {
 	cacheConfig := storageFactory.GetCacherFromConfig(antifloodCacheConfig)
 	blackListCache, err := storageUnit.NewCache(cacheConfig)
 	if err != nil {
@@ -208,6 +294,7 @@ func createFloodPreventer(
 		PercentReserved:           reservedPercent,
 		IncreaseThreshold:         floodPreventerConfig.PeerMaxInput.IncreaseFactor.Threshold,
 		IncreaseFactor:            floodPreventerConfig.PeerMaxInput.IncreaseFactor.Factor,
+		ReservedPercentAdapter:    reservedPercentAdapter,
 	}
 	floodPreventer, err := floodPreventers.NewQuotaFloodPreventer(argFloodPreventer)
 	if err != nil {
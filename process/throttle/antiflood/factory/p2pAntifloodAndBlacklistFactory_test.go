@@ -1,29 +1,63 @@
 package factory
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/marshal"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	processMock "github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericmocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const currentPid = core.PeerID("current pid")
 
+func createReputationStore() process.PeerReputationStore {
+	store, _ := blackList.NewPeerReputationStore(blackList.ArgPeerReputationStore{
+		Storer:      genericmocks.NewStorerMock("", 0),
+		Marshalizer: &marshal.GogoProtoMarshalizer{},
+	})
+
+	return store
+}
+
 func TestNewP2PAntiFloodAndBlackList_NilStatusHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
 	cfg := config.Config{}
-	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, nil, currentPid)
-	assert.Nil(t, af)
-	assert.Nil(t, pids)
-	assert.Nil(t, pks)
+	components, err := NewP2PAntiFloodAndBlackList(cfg, nil, currentPid, createReputationStore(), &processMock.LoadProviderStub{})
+	assert.Nil(t, components)
 	assert.Equal(t, p2p.ErrNilStatusHandler, err)
 }
 
+func TestNewP2PAntiFloodAndBlackList_NilReputationStoreShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{}
+	components, err := NewP2PAntiFloodAndBlackList(cfg, &mock.AppStatusHandlerMock{}, currentPid, nil, &processMock.LoadProviderStub{})
+	assert.Nil(t, components)
+	assert.Equal(t, process.ErrNilPeerReputationStore, err)
+}
+
+func TestNewP2PAntiFloodAndBlackList_NilLoadProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{}
+	components, err := NewP2PAntiFloodAndBlackList(cfg, &mock.AppStatusHandlerMock{}, currentPid, createReputationStore(), nil)
+	assert.Nil(t, components)
+	assert.Equal(t, process.ErrNilLoadProvider, err)
+}
+
 func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(t *testing.T) {
 	t.Parallel()
 
@@ -33,24 +67,72 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(
 		},
 	}
 	ash := &mock.AppStatusHandlerMock{}
-	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid)
-	assert.NotNil(t, af)
-	assert.NotNil(t, pids)
-	assert.NotNil(t, pks)
-	assert.Nil(t, err)
-
-	_, ok1 := af.(*disabled.AntiFlood)
-	_, ok2 := pids.(*disabled.PeerBlacklistCacher)
-	_, ok3 := pks.(*disabled.TimeCache)
+	components, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid, createReputationStore(), &processMock.LoadProviderStub{})
+	require.Nil(t, err)
+
+	_, ok1 := components.AntiFloodHandler.(*disabled.AntiFlood)
+	_, ok2 := components.BlacklistHandler.(*disabled.PeerBlacklistCacher)
+	_, ok3 := components.PubKeysCacher.(*disabled.TimeCache)
+	_, ok4 := components.LimitsHandler.(*disabled.LimitsHandler)
+	_, ok5 := components.AdaptiveQuotaHandler.(*disabled.AdaptiveQuotaHandler)
 	assert.True(t, ok1)
 	assert.True(t, ok2)
 	assert.True(t, ok3)
+	assert.True(t, ok4)
+	assert.True(t, ok5)
 }
 
 func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *testing.T) {
 	t.Parallel()
 
-	cfg := config.Config{
+	cfg := createEnabledAntifloodConfig()
+	ash := &mock.AppStatusHandlerMock{}
+	components, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid, createReputationStore(), &processMock.LoadProviderStub{})
+	require.Nil(t, err)
+	assert.NotNil(t, components.AntiFloodHandler)
+	assert.NotNil(t, components.BlacklistHandler)
+	assert.NotNil(t, components.PubKeysCacher)
+	assert.NotNil(t, components.LimitsHandler)
+	assert.Equal(t, uint32(10), components.LimitsHandler.Limits().Topic.DefaultMaxMessagesPerSec)
+	_, isDisabled := components.AdaptiveQuotaHandler.(*disabled.AdaptiveQuotaHandler)
+	assert.True(t, isDisabled)
+}
+
+func TestNewP2PAntiFloodAndBlackList_InvalidAdaptiveConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cfg := createEnabledAntifloodConfig()
+	cfg.Antiflood.Adaptive = config.AdaptiveAntifloodConfig{
+		Enabled:            true,
+		LowLoadPercent:     90,
+		HighLoadPercent:    60,
+		MaxReservedPercent: 80,
+	}
+	ash := &mock.AppStatusHandlerMock{}
+	components, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid, createReputationStore(), &processMock.LoadProviderStub{})
+	assert.Nil(t, components)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestNewP2PAntiFloodAndBlackList_AdaptiveEnabledShouldWork(t *testing.T) {
+	t.Parallel()
+
+	cfg := createEnabledAntifloodConfig()
+	cfg.Antiflood.Adaptive = config.AdaptiveAntifloodConfig{
+		Enabled:            true,
+		LowLoadPercent:     60,
+		HighLoadPercent:    90,
+		MaxReservedPercent: 80,
+	}
+	ash := &mock.AppStatusHandlerMock{}
+	components, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid, createReputationStore(), &processMock.LoadProviderStub{})
+	require.Nil(t, err)
+	_, isDisabled := components.AdaptiveQuotaHandler.(*disabled.AdaptiveQuotaHandler)
+	assert.False(t, isDisabled)
+}
+
+func createEnabledAntifloodConfig() config.Config {
+	return config.Config{
 		Antiflood: config.AntifloodConfig{
 			Enabled: true,
 			Cache: config.CacheConfig{
@@ -64,15 +146,11 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *tes
 			Topic: config.TopicAntifloodConfig{
 				DefaultMaxMessagesPerSec: 10,
 			},
+			RuntimeLimits: config.AntifloodRuntimeLimitsConfig{
+				OverrideFilePath: filepath.Join(os.TempDir(), "missing-antiflood-override.toml"),
+			},
 		},
 	}
-
-	ash := &mock.AppStatusHandlerMock{}
-	af, pids, pks, err := NewP2PAntiFloodAndBlackList(cfg, ash, currentPid)
-	assert.Nil(t, err)
-	assert.NotNil(t, af)
-	assert.NotNil(t, pids)
-	assert.NotNil(t, pks)
 }
 
 func createFloodPreventerConfig() config.FloodPreventerConfig {
@@ -39,6 +39,7 @@ func initP2POutputAntiFlood(mainConfig config.Config) (process.P2PAntifloodHandl
 		PercentReserved:           outputReservedPercent,
 		IncreaseThreshold:         0,
 		IncreaseFactor:            0,
+		ReservedPercentAdapter:    &disabled.AdaptiveQuotaHandler{},
 	}
 
 	floodPreventer, err := floodPreventers.NewQuotaFloodPreventer(arg)
@@ -47,7 +48,7 @@ func initP2POutputAntiFlood(mainConfig config.Config) (process.P2PAntifloodHandl
 	}
 
 	topicFloodPreventer := disabled.NewNilTopicFloodPreventer()
-	startResettingTopicFloodPreventer(topicFloodPreventer, make([]config.TopicMaxMessagesConfig, 0), floodPreventer)
+	startResettingTopicFloodPreventer(topicFloodPreventer, &disabled.LimitsHandler{}, floodPreventer)
 
 	return antiflood.NewP2PAntiflood(&disabled.PeerBlacklistCacher{}, topicFloodPreventer, floodPreventer)
 }
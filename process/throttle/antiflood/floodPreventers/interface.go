@@ -9,3 +9,10 @@ type QuotaStatusHandler interface {
 	AddQuota(pid core.PeerID, numReceived uint32, sizeReceived uint64, numProcessed uint32, sizeProcessed uint64)
 	IsInterfaceNil() bool
 }
+
+// ReservedPercentAdapter defines the behavior of a component able to change the reserved percent applied
+// on a peer's quota
+type ReservedPercentAdapter interface {
+	AdaptReservedPercent(pid core.PeerID, reservedPercent float32) float32
+	IsInterfaceNil() bool
+}
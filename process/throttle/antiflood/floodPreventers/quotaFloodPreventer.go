@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -20,6 +21,7 @@ type ArgQuotaFloodPreventer struct {
 	IncreaseFactor            float32
 	IncreaseThreshold         uint32
 	BaseMaxNumMessagesPerPeer uint32
+	ReservedPercentAdapter    ReservedPercentAdapter
 }
 
 var _ process.FloodPreventer = (*quotaFloodPreventer)(nil)
@@ -55,6 +57,8 @@ type quotaFloodPreventer struct {
 	percentReserved               float32
 	increaseThreshold             uint32
 	increaseFactor                float32
+	consensusSize                 int
+	reservedPercentAdapter        ReservedPercentAdapter
 }
 
 // NewQuotaFloodPreventer creates a new flood preventer based on quota / peer
@@ -68,52 +72,80 @@ func NewQuotaFloodPreventer(arg ArgQuotaFloodPreventer) (*quotaFloodPreventer, e
 			return nil, process.ErrNilQuotaStatusHandler
 		}
 	}
-	if arg.BaseMaxNumMessagesPerPeer < minMessages {
-		return nil, fmt.Errorf("%w, maxMessagesPerPeer: provided %d, minimum %d",
+	if check.IfNil(arg.ReservedPercentAdapter) {
+		return nil, process.ErrNilReservedPercentAdapter
+	}
+	err := checkQuotaLimits(arg.BaseMaxNumMessagesPerPeer, arg.MaxTotalSizePerPeer, arg.PercentReserved, arg.IncreaseFactor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &quotaFloodPreventer{
+		name:                          arg.Name,
+		cacher:                        arg.Cacher,
+		statusHandlers:                arg.StatusHandlers,
+		computedMaxNumMessagesPerPeer: arg.BaseMaxNumMessagesPerPeer,
+		baseMaxNumMessagesPerPeer:     arg.BaseMaxNumMessagesPerPeer,
+		maxTotalSizePerPeer:           arg.MaxTotalSizePerPeer,
+		percentReserved:               arg.PercentReserved,
+		increaseThreshold:             arg.IncreaseThreshold,
+		increaseFactor:                arg.IncreaseFactor,
+		reservedPercentAdapter:        arg.ReservedPercentAdapter,
+	}, nil
+}
+
+// CheckFloodPreventerLimits returns an error if the provided limits can not be applied on a quota flood preventer
+func CheckFloodPreventerLimits(limits config.FloodPreventerLimitsConfig) error {
+	return checkQuotaLimits(
+		limits.PeerMaxInput.BaseMessagesPerInterval,
+		limits.PeerMaxInput.TotalSizePerInterval,
+		limits.ReservedPercent,
+		limits.PeerMaxInput.IncreaseFactor.Factor,
+	)
+}
+
+func checkQuotaLimits(
+	baseMaxNumMessagesPerPeer uint32,
+	maxTotalSizePerPeer uint64,
+	percentReserved float32,
+	increaseFactor float32,
+) error {
+	if baseMaxNumMessagesPerPeer < minMessages {
+		return fmt.Errorf("%w, maxMessagesPerPeer: provided %d, minimum %d",
 			process.ErrInvalidValue,
-			arg.BaseMaxNumMessagesPerPeer,
+			baseMaxNumMessagesPerPeer,
 			minMessages,
 		)
 	}
-	if arg.MaxTotalSizePerPeer < minTotalSize {
-		return nil, fmt.Errorf("%w, maxTotalSizePerPeer: provided %d, minimum %d",
+	if maxTotalSizePerPeer < minTotalSize {
+		return fmt.Errorf("%w, maxTotalSizePerPeer: provided %d, minimum %d",
 			process.ErrInvalidValue,
-			arg.MaxTotalSizePerPeer,
+			maxTotalSizePerPeer,
 			minTotalSize,
 		)
 	}
-	if arg.PercentReserved > maxPercentReserved {
-		return nil, fmt.Errorf("%w, percentReserved: provided %0.3f, maximum %0.3f",
+	if percentReserved > maxPercentReserved {
+		return fmt.Errorf("%w, percentReserved: provided %0.3f, maximum %0.3f",
 			process.ErrInvalidValue,
-			arg.PercentReserved,
+			percentReserved,
 			maxPercentReserved,
 		)
 	}
-	if arg.PercentReserved < minPercentReserved {
-		return nil, fmt.Errorf("%w, percentReserved: provided %0.3f, minimum %0.3f",
+	if percentReserved < minPercentReserved {
+		return fmt.Errorf("%w, percentReserved: provided %0.3f, minimum %0.3f",
 			process.ErrInvalidValue,
-			arg.PercentReserved,
+			percentReserved,
 			minPercentReserved,
 		)
 	}
-	if arg.IncreaseFactor < 0 {
-		return nil, fmt.Errorf("%w, increaseFactor is negative: provided %0.3f",
+	if increaseFactor < 0 {
+		return fmt.Errorf("%w, increaseFactor is negative: provided %0.3f",
 			process.ErrInvalidValue,
-			arg.IncreaseFactor,
+			increaseFactor,
 		)
 	}
 
-	return &quotaFloodPreventer{
-		name:                          arg.Name,
-		cacher:                        arg.Cacher,
-		statusHandlers:                arg.StatusHandlers,
-		computedMaxNumMessagesPerPeer: arg.BaseMaxNumMessagesPerPeer,
-		baseMaxNumMessagesPerPeer:     arg.BaseMaxNumMessagesPerPeer,
-		maxTotalSizePerPeer:           arg.MaxTotalSizePerPeer,
-		percentReserved:               arg.PercentReserved,
-		increaseThreshold:             arg.IncreaseThreshold,
-		increaseFactor:                arg.IncreaseFactor,
-	}, nil
+	return nil
 }
 
 // IncreaseLoad tries to increment the counter values held at "pid" position
@@ -146,8 +178,9 @@ func (qfp *quotaFloodPreventer) increaseLoad(pid core.PeerID, size uint64) error
 	q.numReceivedMessages++
 	q.sizeReceivedMessages += size
 
-	maxNumMessagesReached := qfp.isMaximumReached(uint64(qfp.computedMaxNumMessagesPerPeer), uint64(q.numReceivedMessages))
-	maxSizeMessagesReached := qfp.isMaximumReached(qfp.maxTotalSizePerPeer, q.sizeReceivedMessages)
+	percentReserved := qfp.reservedPercentAdapter.AdaptReservedPercent(pid, qfp.percentReserved)
+	maxNumMessagesReached := isMaximumReached(percentReserved, uint64(qfp.computedMaxNumMessagesPerPeer), uint64(q.numReceivedMessages))
+	maxSizeMessagesReached := isMaximumReached(percentReserved, qfp.maxTotalSizePerPeer, q.sizeReceivedMessages)
 	isPeerQuotaReached := maxNumMessagesReached || maxSizeMessagesReached
 	if isPeerQuotaReached {
 		return fmt.Errorf("%w for pid %s", process.ErrSystemBusy, pid.Pretty())
@@ -159,8 +192,8 @@ func (qfp *quotaFloodPreventer) increaseLoad(pid core.PeerID, size uint64) error
 	return nil
 }
 
-func (qfp *quotaFloodPreventer) isMaximumReached(absoluteMax uint64, counted uint64) bool {
-	max := uint64(100-qfp.percentReserved) * absoluteMax / 100
+func isMaximumReached(percentReserved float32, absoluteMax uint64, counted uint64) bool {
+	max := uint64(100-percentReserved) * absoluteMax / 100
 
 	return counted > max
 }
@@ -238,6 +271,11 @@ func (qfp *quotaFloodPreventer) ApplyConsensusSize(size int) {
 		)
 		return
 	}
+
+	qfp.mutOperation.Lock()
+	defer qfp.mutOperation.Unlock()
+
+	qfp.consensusSize = size
 	if qfp.increaseThreshold > uint32(size) {
 		log.Debug("consensus size did not reach the threshold for quota flood preventer",
 			"name", qfp.name,
@@ -247,13 +285,8 @@ func (qfp *quotaFloodPreventer) ApplyConsensusSize(size int) {
 		return
 	}
 
-	qfp.mutOperation.Lock()
-	defer qfp.mutOperation.Unlock()
-
-	numNodesOverThreshold := float32(uint32(size) - qfp.increaseThreshold)
-	value := numNodesOverThreshold * qfp.increaseFactor
 	oldComputed := qfp.computedMaxNumMessagesPerPeer
-	qfp.computedMaxNumMessagesPerPeer = qfp.baseMaxNumMessagesPerPeer + uint32(value)
+	qfp.computeMaxNumMessagesPerPeer()
 
 	log.Debug("quotaFloodPreventer.ApplyConsensusSize",
 		"name", qfp.name,
@@ -266,6 +299,39 @@ func (qfp *quotaFloodPreventer) ApplyConsensusSize(size int) {
 	)
 }
 
+// SetLimits atomically replaces the limits of the flood preventer. The maximum number of messages per peer is
+// recomputed using the last applied consensus size
+func (qfp *quotaFloodPreventer) SetLimits(limits config.FloodPreventerLimitsConfig) error {
+	err := CheckFloodPreventerLimits(limits)
+	if err != nil {
+		return fmt.Errorf("%w for flood preventer %s", err, qfp.name)
+	}
+
+	qfp.mutOperation.Lock()
+	defer qfp.mutOperation.Unlock()
+
+	qfp.baseMaxNumMessagesPerPeer = limits.PeerMaxInput.BaseMessagesPerInterval
+	qfp.maxTotalSizePerPeer = limits.PeerMaxInput.TotalSizePerInterval
+	qfp.percentReserved = limits.ReservedPercent
+	qfp.increaseThreshold = limits.PeerMaxInput.IncreaseFactor.Threshold
+	qfp.increaseFactor = limits.PeerMaxInput.IncreaseFactor.Factor
+	qfp.computeMaxNumMessagesPerPeer()
+
+	return nil
+}
+
+func (qfp *quotaFloodPreventer) computeMaxNumMessagesPerPeer() {
+	isThresholdReached := qfp.consensusSize > 0 && qfp.increaseThreshold <= uint32(qfp.consensusSize)
+	if !isThresholdReached {
+		qfp.computedMaxNumMessagesPerPeer = qfp.baseMaxNumMessagesPerPeer
+		return
+	}
+
+	numNodesOverThreshold := float32(uint32(qfp.consensusSize) - qfp.increaseThreshold)
+	value := numNodesOverThreshold * qfp.increaseFactor
+	qfp.computedMaxNumMessagesPerPeer = qfp.baseMaxNumMessagesPerPeer + uint32(value)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (qfp *quotaFloodPreventer) IsInterfaceNil() bool {
 	return qfp == nil
@@ -7,12 +7,14 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createDefaultArgument() ArgQuotaFloodPreventer {
@@ -25,6 +27,7 @@ func createDefaultArgument() ArgQuotaFloodPreventer {
 		PercentReserved:           10,
 		IncreaseThreshold:         0,
 		IncreaseFactor:            0,
+		ReservedPercentAdapter:    &mock.ReservedPercentAdapterStub{},
 	}
 }
 
@@ -52,6 +55,17 @@ func TestNewQuotaFloodPreventer_NilStatusHandlerShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilQuotaStatusHandler, err)
 }
 
+func TestNewQuotaFloodPreventer_NilReservedPercentAdapterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.ReservedPercentAdapter = nil
+	qfp, err := NewQuotaFloodPreventer(arg)
+
+	assert.True(t, check.IfNil(qfp))
+	assert.Equal(t, process.ErrNilReservedPercentAdapter, err)
+}
+
 func TestNewQuotaFloodPreventer_LowerMinMessagesPerPeerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -279,6 +293,41 @@ func TestNewQuotaFloodPreventer_IncreaseLoadOverMaxPeerSizeShouldNotPutAndReturn
 	assert.True(t, errors.Is(err, process.ErrSystemBusy))
 }
 
+func TestNewQuotaFloodPreventer_IncreaseLoadShouldUseTheAdaptedReservedPercent(t *testing.T) {
+	t.Parallel()
+
+	existingQuota := &quota{
+		numReceivedMessages:  50,
+		sizeReceivedMessages: minTotalSize,
+	}
+	adaptedReservedPercent := float32(10)
+	arg := createDefaultArgument()
+	arg.Cacher = &testscommon.CacherStub{
+		GetCalled: func(key []byte) (value interface{}, ok bool) {
+			return existingQuota, true
+		},
+	}
+	arg.BaseMaxNumMessagesPerPeer = 100
+	arg.MaxTotalSizePerPeer = minTotalSize * 100
+	arg.PercentReserved = 10
+	arg.ReservedPercentAdapter = &mock.ReservedPercentAdapterStub{
+		AdaptReservedPercentCalled: func(pid core.PeerID, reservedPercent float32) float32 {
+			assert.Equal(t, core.PeerID("identifier"), pid)
+			assert.Equal(t, float32(10), reservedPercent)
+
+			return adaptedReservedPercent
+		},
+	}
+	qfp, _ := NewQuotaFloodPreventer(arg)
+
+	err := qfp.IncreaseLoad("identifier", minTotalSize)
+	assert.Nil(t, err)
+
+	adaptedReservedPercent = 50
+	err = qfp.IncreaseLoad("identifier", minTotalSize)
+	assert.True(t, errors.Is(err, process.ErrSystemBusy))
+}
+
 func TestCountersMap_IncreaseLoadShouldWorkConcurrently(t *testing.T) {
 	t.Parallel()
 
@@ -491,3 +540,52 @@ func TestQuotaFloodPreventer_ApplyConsensusShouldWork(t *testing.T) {
 	err := qfp.IncreaseLoad(identifier, 0)
 	assert.NotNil(t, err)
 }
+
+//------- SetLimits
+
+func TestQuotaFloodPreventer_SetLimitsInvalidLimitsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.BaseMaxNumMessagesPerPeer = 10
+	qfp, _ := NewQuotaFloodPreventer(arg)
+
+	err := qfp.SetLimits(config.FloodPreventerLimitsConfig{
+		ReservedPercent: maxPercentReserved + 1,
+		PeerMaxInput: config.AntifloodLimitsConfig{
+			BaseMessagesPerInterval: 20,
+			TotalSizePerInterval:    20,
+		},
+	})
+
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	assert.Equal(t, uint32(10), qfp.baseMaxNumMessagesPerPeer)
+	assert.Equal(t, uint32(10), qfp.computedMaxNumMessagesPerPeer)
+}
+
+func TestQuotaFloodPreventer_SetLimitsShouldRecomputeUsingTheLastConsensusSize(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.BaseMaxNumMessagesPerPeer = 2000
+	qfp, _ := NewQuotaFloodPreventer(arg)
+	qfp.ApplyConsensusSize(2000)
+	assert.Equal(t, uint32(2000), qfp.computedMaxNumMessagesPerPeer)
+
+	err := qfp.SetLimits(config.FloodPreventerLimitsConfig{
+		ReservedPercent: 30,
+		PeerMaxInput: config.AntifloodLimitsConfig{
+			BaseMessagesPerInterval: 1000,
+			TotalSizePerInterval:    5000,
+			IncreaseFactor: config.IncreaseFactorConfig{
+				Threshold: 1000,
+				Factor:    0.25,
+			},
+		},
+	})
+
+	require.Nil(t, err)
+	assert.Equal(t, uint32(1250), qfp.computedMaxNumMessagesPerPeer)
+	assert.Equal(t, uint64(5000), qfp.maxTotalSizePerPeer)
+	assert.Equal(t, float32(30), qfp.percentReserved)
+}
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -79,6 +80,58 @@ func (tfp *topicFloodPreventer) SetMaxMessagesForTopic(topic string, numMessages
 	tfp.mutTopicMaxMessages.Unlock()
 }
 
+// SetLimits atomically replaces the default maximum number of messages and all the topics limits.
+// The counters are kept so the new limits apply starting with the current interval
+func (tfp *topicFloodPreventer) SetLimits(limits config.TopicAntifloodConfig) error {
+	err := CheckTopicFloodPreventerLimits(limits)
+	if err != nil {
+		return err
+	}
+
+	topicMaxMessages := make(map[string]uint32, len(limits.MaxMessages))
+	registeredTopics := make(map[string]struct{}, len(limits.MaxMessages))
+	for _, topicMaxMsg := range limits.MaxMessages {
+		topicMaxMessages[topicMaxMsg.Topic] = topicMaxMsg.NumMessagesPerSec
+		registeredTopics[topicMaxMsg.Topic] = struct{}{}
+	}
+
+	tfp.mutTopicMaxMessages.Lock()
+	tfp.defaultMaxMessagesPerPeer = limits.DefaultMaxMessagesPerSec
+	tfp.topicMaxMessages = topicMaxMessages
+	tfp.registeredTopics = registeredTopics
+	tfp.mutTopicMaxMessages.Unlock()
+
+	return nil
+}
+
+// CheckTopicFloodPreventerLimits returns an error if the provided limits can not be applied on a topic flood preventer
+func CheckTopicFloodPreventerLimits(limits config.TopicAntifloodConfig) error {
+	if limits.DefaultMaxMessagesPerSec < topicMinMessages {
+		return fmt.Errorf("%w for the topic flood preventer, maxMessagesPerPeer: provided %d, minimum %d",
+			process.ErrInvalidValue,
+			limits.DefaultMaxMessagesPerSec,
+			topicMinMessages,
+		)
+	}
+
+	topics := make(map[string]struct{}, len(limits.MaxMessages))
+	for _, topicMaxMsg := range limits.MaxMessages {
+		if len(topicMaxMsg.Topic) == 0 {
+			return fmt.Errorf("%w for the topic flood preventer, empty topic", process.ErrInvalidValue)
+		}
+		_, exists := topics[topicMaxMsg.Topic]
+		if exists {
+			return fmt.Errorf("%w for the topic flood preventer, duplicated topic %s",
+				process.ErrInvalidValue,
+				topicMaxMsg.Topic,
+			)
+		}
+		topics[topicMaxMsg.Topic] = struct{}{}
+	}
+
+	return nil
+}
+
 // ResetForTopic clears all map values for a given topic
 func (tfp *topicFloodPreventer) ResetForTopic(topic string) {
 	tfp.mutTopicMaxMessages.Lock()
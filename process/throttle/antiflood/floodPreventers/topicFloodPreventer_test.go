@@ -4,10 +4,12 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/floodPreventers"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewTopicFloodPreventer_InvalidMaxNumOfMessagesShouldErr(t *testing.T) {
@@ -312,3 +314,50 @@ func TestTopicFloodPreventer_ResetForNotRegisteredTopicsWithWildcardShuldWork(t
 	err = tfp.IncreaseLoad(identifier, unregisteredTopic, defaultMaxMessages)
 	assert.Nil(t, err)
 }
+
+func TestTopicFloodPreventer_SetLimitsInvalidLimitsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tfp, _ := floodPreventers.NewTopicFloodPreventer(2)
+
+	err := tfp.SetLimits(config.TopicAntifloodConfig{DefaultMaxMessagesPerSec: 0})
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	err = tfp.SetLimits(config.TopicAntifloodConfig{
+		DefaultMaxMessagesPerSec: 1,
+		MaxMessages:              []config.TopicMaxMessagesConfig{{Topic: "", NumMessagesPerSec: 1}},
+	})
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	err = tfp.SetLimits(config.TopicAntifloodConfig{
+		DefaultMaxMessagesPerSec: 1,
+		MaxMessages: []config.TopicMaxMessagesConfig{
+			{Topic: "topic", NumMessagesPerSec: 1},
+			{Topic: "topic", NumMessagesPerSec: 2},
+		},
+	})
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestTopicFloodPreventer_SetLimitsShouldReplaceAllLimitsAndKeepTheCounters(t *testing.T) {
+	t.Parallel()
+
+	tfp, _ := floodPreventers.NewTopicFloodPreventer(2)
+	identifier := core.PeerID("pid")
+	tfp.SetMaxMessagesForTopic("old topic", 100)
+	_ = tfp.IncreaseLoad(identifier, "headers", 2)
+
+	err := tfp.SetLimits(config.TopicAntifloodConfig{
+		DefaultMaxMessagesPerSec: 5,
+		MaxMessages:              []config.TopicMaxMessagesConfig{{Topic: "headers", NumMessagesPerSec: 3}},
+	})
+	require.Nil(t, err)
+
+	assert.Equal(t, map[string]uint32{"headers": 3}, tfp.TopicMaxMessages())
+	assert.Equal(t, uint32(5), tfp.MaxMessagesForTopic("old topic"))
+	assert.Nil(t, tfp.IncreaseLoad(identifier, "headers", 1))
+	assert.True(t, errors.Is(tfp.IncreaseLoad(identifier, "headers", 1), process.ErrSystemBusy))
+
+	tfp.ResetForNotRegisteredTopics()
+	assert.Equal(t, uint32(4), tfp.CountForTopicAndIdentifier("headers", identifier))
+}
@@ -0,0 +1,218 @@
+package antiflood
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/floodPreventers"
+)
+
+var auditLog = logger.GetOrCreate("process/throttle/antiflood/audit")
+var _ process.AntifloodLimitsHandler = (*limitsHandler)(nil)
+
+const overrideFileSource = "override file"
+
+// ArgLimitsHandler represents the argument for the antiflood limits handler constructor
+type ArgLimitsHandler struct {
+	FastReacting     process.FloodPreventerLimitsSetter
+	SlowReacting     process.FloodPreventerLimitsSetter
+	OutOfSpecs       process.FloodPreventerLimitsSetter
+	TopicPreventer   process.TopicFloodPreventerLimitsSetter
+	InitialLimits    config.AntifloodLimitsOverrideConfig
+	OverrideFilePath string
+}
+
+type limitsHandler struct {
+	fastReacting     process.FloodPreventerLimitsSetter
+	slowReacting     process.FloodPreventerLimitsSetter
+	outOfSpecs       process.FloodPreventerLimitsSetter
+	topicPreventer   process.TopicFloodPreventerLimitsSetter
+	overrideFilePath string
+	mutLimits        sync.RWMutex
+	limits           config.AntifloodLimitsOverrideConfig
+}
+
+// NewLimitsHandler creates a component able to change all the antiflood limits at once. If the override file
+// exists, its limits are applied on the provided flood preventers before returning
+func NewLimitsHandler(arg ArgLimitsHandler) (*limitsHandler, error) {
+	if check.IfNil(arg.FastReacting) {
+		return nil, fmt.Errorf("%w for the fast reacting flood preventer", process.ErrNilFloodPreventerLimitsSetter)
+	}
+	if check.IfNil(arg.SlowReacting) {
+		return nil, fmt.Errorf("%w for the slow reacting flood preventer", process.ErrNilFloodPreventerLimitsSetter)
+	}
+	if check.IfNil(arg.OutOfSpecs) {
+		return nil, fmt.Errorf("%w for the out of specs flood preventer", process.ErrNilFloodPreventerLimitsSetter)
+	}
+	if check.IfNil(arg.TopicPreventer) {
+		return nil, process.ErrNilTopicFloodPreventer
+	}
+	if len(arg.OverrideFilePath) == 0 {
+		return nil, fmt.Errorf("%w for the antiflood limits override file path", process.ErrInvalidValue)
+	}
+
+	lh := &limitsHandler{
+		fastReacting:     arg.FastReacting,
+		slowReacting:     arg.SlowReacting,
+		outOfSpecs:       arg.OutOfSpecs,
+		topicPreventer:   arg.TopicPreventer,
+		overrideFilePath: arg.OverrideFilePath,
+		limits:           copyLimits(arg.InitialLimits),
+	}
+
+	err := lh.loadOverrideFile()
+	if err != nil {
+		return nil, err
+	}
+
+	return lh, nil
+}
+
+func (lh *limitsHandler) loadOverrideFile() error {
+	_, err := os.Stat(lh.overrideFilePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	limits := config.AntifloodLimitsOverrideConfig{}
+	err = core.LoadTomlFile(&limits, lh.overrideFilePath)
+	if err != nil {
+		return fmt.Errorf("%w while loading the antiflood limits override file %s", err, lh.overrideFilePath)
+	}
+
+	lh.mutLimits.Lock()
+	defer lh.mutLimits.Unlock()
+
+	err = lh.applyLimitsNoLock(limits)
+	if err != nil {
+		return fmt.Errorf("%w while applying the antiflood limits override file %s", err, lh.overrideFilePath)
+	}
+
+	lh.auditLimitsChangeNoLock(limits, overrideFileSource)
+	lh.limits = limits
+
+	return nil
+}
+
+// SetLimits validates and applies the provided limits on all the flood preventers. Only the applied limits are
+// saved in the override file so they will survive a node restart
+func (lh *limitsHandler) SetLimits(limits config.AntifloodLimitsOverrideConfig, source string) error {
+	limits = copyLimits(limits)
+	err := checkLimits(limits)
+	if err != nil {
+		return err
+	}
+
+	lh.mutLimits.Lock()
+	defer lh.mutLimits.Unlock()
+
+	err = lh.applyLimitsNoLock(limits)
+	if err != nil {
+		lh.restoreLimitsNoLock()
+		return err
+	}
+
+	lh.auditLimitsChangeNoLock(limits, source)
+	lh.limits = limits
+
+	err = core.SaveTomlFile(limits, lh.overrideFilePath)
+	if err != nil {
+		return fmt.Errorf("%w while saving the antiflood limits override file %s", err, lh.overrideFilePath)
+	}
+
+	return nil
+}
+
+// restoreLimitsNoLock re-applies the limits in use, as the flood preventers updated before a failure already
+// have the new limits
+func (lh *limitsHandler) restoreLimitsNoLock() {
+	err := lh.applyLimitsNoLock(lh.limits)
+	if err != nil {
+		log.Warn("limitsHandler: can not restore the antiflood limits", "error", err)
+	}
+}
+
+// Limits returns the antiflood limits currently in use
+func (lh *limitsHandler) Limits() config.AntifloodLimitsOverrideConfig {
+	lh.mutLimits.RLock()
+	defer lh.mutLimits.RUnlock()
+
+	return copyLimits(lh.limits)
+}
+
+func (lh *limitsHandler) applyLimitsNoLock(limits config.AntifloodLimitsOverrideConfig) error {
+	err := checkLimits(limits)
+	if err != nil {
+		return err
+	}
+
+	err = lh.fastReacting.SetLimits(limits.FastReacting)
+	if err != nil {
+		return err
+	}
+	err = lh.slowReacting.SetLimits(limits.SlowReacting)
+	if err != nil {
+		return err
+	}
+	err = lh.outOfSpecs.SetLimits(limits.OutOfSpecs)
+	if err != nil {
+		return err
+	}
+
+	return lh.topicPreventer.SetLimits(limits.Topic)
+}
+
+func (lh *limitsHandler) auditLimitsChangeNoLock(newLimits config.AntifloodLimitsOverrideConfig, source string) {
+	auditLog.Info("antiflood limits changed",
+		"source", source,
+		"old limits", limitsAsString(lh.limits),
+		"new limits", limitsAsString(newLimits),
+	)
+}
+
+// checkLimits validates all the limits before any of them is applied, so a change is either fully applied or not at all
+func checkLimits(limits config.AntifloodLimitsOverrideConfig) error {
+	err := floodPreventers.CheckFloodPreventerLimits(limits.FastReacting)
+	if err != nil {
+		return fmt.Errorf("%w for the fast reacting limits", err)
+	}
+	err = floodPreventers.CheckFloodPreventerLimits(limits.SlowReacting)
+	if err != nil {
+		return fmt.Errorf("%w for the slow reacting limits", err)
+	}
+	err = floodPreventers.CheckFloodPreventerLimits(limits.OutOfSpecs)
+	if err != nil {
+		return fmt.Errorf("%w for the out of specs limits", err)
+	}
+
+	return floodPreventers.CheckTopicFloodPreventerLimits(limits.Topic)
+}
+
+func copyLimits(limits config.AntifloodLimitsOverrideConfig) config.AntifloodLimitsOverrideConfig {
+	topicMaxMessages := make([]config.TopicMaxMessagesConfig, len(limits.Topic.MaxMessages))
+	copy(topicMaxMessages, limits.Topic.MaxMessages)
+	limits.Topic.MaxMessages = topicMaxMessages
+
+	return limits
+}
+
+func limitsAsString(limits config.AntifloodLimitsOverrideConfig) string {
+	buff, err := json.Marshal(limits)
+	if err != nil {
+		return err.Error()
+	}
+
+	return string(buff)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lh *limitsHandler) IsInterfaceNil() bool {
+	return lh == nil
+}
@@ -0,0 +1,256 @@
+package antiflood_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "antifloodLimits")
+	require.Nil(t, err)
+
+	return dir
+}
+
+func createValidLimits() config.AntifloodLimitsOverrideConfig {
+	floodPreventerLimits := config.FloodPreventerLimitsConfig{
+		ReservedPercent: 20,
+		PeerMaxInput: config.AntifloodLimitsConfig{
+			BaseMessagesPerInterval: 100,
+			TotalSizePerInterval:    1000,
+		},
+	}
+
+	return config.AntifloodLimitsOverrideConfig{
+		FastReacting: floodPreventerLimits,
+		SlowReacting: floodPreventerLimits,
+		OutOfSpecs:   floodPreventerLimits,
+		Topic: config.TopicAntifloodConfig{
+			DefaultMaxMessagesPerSec: 10,
+			MaxMessages:              []config.TopicMaxMessagesConfig{{Topic: "heartbeat", NumMessagesPerSec: 30}},
+		},
+	}
+}
+
+func createMockArgLimitsHandler(overrideFilePath string) antiflood.ArgLimitsHandler {
+	return antiflood.ArgLimitsHandler{
+		FastReacting:     &mock.FloodPreventerStub{},
+		SlowReacting:     &mock.FloodPreventerStub{},
+		OutOfSpecs:       &mock.FloodPreventerStub{},
+		TopicPreventer:   &mock.TopicAntiFloodStub{},
+		InitialLimits:    createValidLimits(),
+		OverrideFilePath: overrideFilePath,
+	}
+}
+
+func TestNewLimitsHandler_InvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgLimitsHandler("file")
+	arg.FastReacting = nil
+	lh, err := antiflood.NewLimitsHandler(arg)
+	assert.True(t, check.IfNil(lh))
+	assert.True(t, errors.Is(err, process.ErrNilFloodPreventerLimitsSetter))
+
+	arg = createMockArgLimitsHandler("file")
+	arg.SlowReacting = nil
+	lh, err = antiflood.NewLimitsHandler(arg)
+	assert.True(t, check.IfNil(lh))
+	assert.True(t, errors.Is(err, process.ErrNilFloodPreventerLimitsSetter))
+
+	arg = createMockArgLimitsHandler("file")
+	arg.OutOfSpecs = nil
+	lh, err = antiflood.NewLimitsHandler(arg)
+	assert.True(t, check.IfNil(lh))
+	assert.True(t, errors.Is(err, process.ErrNilFloodPreventerLimitsSetter))
+
+	arg = createMockArgLimitsHandler("file")
+	arg.TopicPreventer = nil
+	lh, err = antiflood.NewLimitsHandler(arg)
+	assert.True(t, check.IfNil(lh))
+	assert.Equal(t, process.ErrNilTopicFloodPreventer, err)
+
+	arg = createMockArgLimitsHandler("")
+	lh, err = antiflood.NewLimitsHandler(arg)
+	assert.True(t, check.IfNil(lh))
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+}
+
+func TestNewLimitsHandler_MissingOverrideFileShouldKeepTheInitialLimits(t *testing.T) {
+	t.Parallel()
+
+	dir := createTestDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	arg := createMockArgLimitsHandler(filepath.Join(dir, "override.toml"))
+	arg.FastReacting = &mock.FloodPreventerStub{
+		SetLimitsCalled: func(limits config.FloodPreventerLimitsConfig) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+	lh, err := antiflood.NewLimitsHandler(arg)
+	require.Nil(t, err)
+	assert.Equal(t, createValidLimits(), lh.Limits())
+}
+
+func TestNewLimitsHandler_InvalidOverrideFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := createTestDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	overrideFilePath := filepath.Join(dir, "override.toml")
+	err := ioutil.WriteFile(overrideFilePath, []byte("invalid toml"), core.FileModeUserReadWrite)
+	require.Nil(t, err)
+
+	lh, err := antiflood.NewLimitsHandler(createMockArgLimitsHandler(overrideFilePath))
+	assert.True(t, check.IfNil(lh))
+	assert.NotNil(t, err)
+}
+
+func TestLimitsHandler_SetLimitsInvalidLimitsShouldNotApplyAnything(t *testing.T) {
+	t.Parallel()
+
+	dir := createTestDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	overrideFilePath := filepath.Join(dir, "override.toml")
+	arg := createMockArgLimitsHandler(overrideFilePath)
+	arg.FastReacting = &mock.FloodPreventerStub{
+		SetLimitsCalled: func(limits config.FloodPreventerLimitsConfig) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		},
+	}
+	lh, _ := antiflood.NewLimitsHandler(arg)
+
+	limits := createValidLimits()
+	limits.OutOfSpecs.ReservedPercent = 95
+	err := lh.SetLimits(limits, "test")
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	limits = createValidLimits()
+	limits.Topic.MaxMessages = append(limits.Topic.MaxMessages, limits.Topic.MaxMessages[0])
+	err = lh.SetLimits(limits, "test")
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+
+	assert.Equal(t, createValidLimits(), lh.Limits())
+	_, err = os.Stat(overrideFilePath)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestLimitsHandler_SetLimitsShouldApplyAndPersist(t *testing.T) {
+	t.Parallel()
+
+	dir := createTestDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	overrideFilePath := filepath.Join(dir, "override.toml")
+	numFloodPreventersCalls := 0
+	var appliedTopicLimits config.TopicAntifloodConfig
+	floodPreventer := &mock.FloodPreventerStub{
+		SetLimitsCalled: func(limits config.FloodPreventerLimitsConfig) error {
+			numFloodPreventersCalls++
+			return nil
+		},
+	}
+	arg := createMockArgLimitsHandler(overrideFilePath)
+	arg.FastReacting = floodPreventer
+	arg.SlowReacting = floodPreventer
+	arg.OutOfSpecs = floodPreventer
+	arg.TopicPreventer = &mock.TopicAntiFloodStub{
+		SetLimitsCalled: func(limits config.TopicAntifloodConfig) error {
+			appliedTopicLimits = limits
+			return nil
+		},
+	}
+	lh, _ := antiflood.NewLimitsHandler(arg)
+
+	limits := createValidLimits()
+	limits.FastReacting.PeerMaxInput.BaseMessagesPerInterval = 500
+	limits.Topic.DefaultMaxMessagesPerSec = 50
+	err := lh.SetLimits(limits, "test")
+	require.Nil(t, err)
+	assert.Equal(t, 3, numFloodPreventersCalls)
+	assert.Equal(t, limits.Topic, appliedTopicLimits)
+	assert.Equal(t, limits, lh.Limits())
+
+	numFloodPreventersCalls = 0
+	reloadedHandler, err := antiflood.NewLimitsHandler(arg)
+	require.Nil(t, err)
+	assert.Equal(t, 3, numFloodPreventersCalls)
+	assert.Equal(t, limits, reloadedHandler.Limits())
+}
+
+func TestLimitsHandler_SetLimitsApplyFailsShouldNotPersistAndShouldRestore(t *testing.T) {
+	t.Parallel()
+
+	dir := createTestDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	overrideFilePath := filepath.Join(dir, "override.toml")
+	expectedErr := errors.New("expected error")
+	var appliedFastReactingLimits []config.FloodPreventerLimitsConfig
+	arg := createMockArgLimitsHandler(overrideFilePath)
+	arg.FastReacting = &mock.FloodPreventerStub{
+		SetLimitsCalled: func(limits config.FloodPreventerLimitsConfig) error {
+			appliedFastReactingLimits = append(appliedFastReactingLimits, limits)
+			return nil
+		},
+	}
+	arg.SlowReacting = &mock.FloodPreventerStub{
+		SetLimitsCalled: func(limits config.FloodPreventerLimitsConfig) error {
+			if limits.PeerMaxInput.BaseMessagesPerInterval == 500 {
+				return expectedErr
+			}
+			return nil
+		},
+	}
+	lh, _ := antiflood.NewLimitsHandler(arg)
+
+	limits := createValidLimits()
+	limits.FastReacting.PeerMaxInput.BaseMessagesPerInterval = 500
+	limits.SlowReacting.PeerMaxInput.BaseMessagesPerInterval = 500
+	err := lh.SetLimits(limits, "test")
+	assert.Equal(t, expectedErr, err)
+
+	assert.Equal(t, createValidLimits(), lh.Limits())
+	require.Equal(t, 2, len(appliedFastReactingLimits))
+	assert.Equal(t, createValidLimits().FastReacting, appliedFastReactingLimits[1])
+	_, err = os.Stat(overrideFilePath)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestLimitsHandler_LimitsShouldReturnACopy(t *testing.T) {
+	t.Parallel()
+
+	lh, _ := antiflood.NewLimitsHandler(createMockArgLimitsHandler("missing-override.toml"))
+
+	limits := lh.Limits()
+	limits.Topic.MaxMessages[0].NumMessagesPerSec = 0
+
+	assert.Equal(t, createValidLimits(), lh.Limits())
+}
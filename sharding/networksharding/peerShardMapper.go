@@ -197,6 +197,26 @@ func (psm *PeerShardMapper) getPeerInfoSearchingPidInFallbackCache(pid core.Peer
 	}
 }
 
+// GetLastKnownPeerID returns the most recent peer ID that was associated with the provided public key
+func (psm *PeerShardMapper) GetLastKnownPeerID(pk []byte) (*core.PeerID, bool) {
+	psm.mutUpdatePeerIdPublicKey.Lock()
+	defer psm.mutUpdatePeerIdPublicKey.Unlock()
+
+	objPidsQueue, found := psm.pkPeerId.Get(pk)
+	if !found {
+		return nil, false
+	}
+
+	pq, ok := objPidsQueue.(*pidQueue)
+	if !ok || len(pq.data) == 0 {
+		return nil, false
+	}
+
+	lastPid := pq.data[len(pq.data)-1]
+
+	return &lastPid, true
+}
+
 // UpdatePeerIdPublicKey updates the peer ID - public key pair in the corresponding map
 // It also uses the intermediate pkPeerId cache that will prevent having thousands of peer ID's with
 // the same Elrond PK that will make the node prone to an eclipse attack
@@ -212,6 +212,38 @@ func TestPeerShardMapper_UpdatePeerIdPublicKeyShouldWorkConcurrently(t *testing.
 	assert.Equal(t, pk, pkRecovered)
 }
 
+//------- GetLastKnownPeerID
+
+func TestPeerShardMapper_GetLastKnownPeerIDUnknownPkShouldReturnFalse(t *testing.T) {
+	t.Parallel()
+
+	psm := createPeerShardMapper()
+
+	pid, found := psm.GetLastKnownPeerID([]byte("unknown pk"))
+	assert.Nil(t, pid)
+	assert.False(t, found)
+}
+
+func TestPeerShardMapper_GetLastKnownPeerIDShouldReturnTheMostRecentPid(t *testing.T) {
+	t.Parallel()
+
+	psm := createPeerShardMapper()
+	pk := []byte("dummy pk")
+	pid1 := core.PeerID("pid 1")
+	pid2 := core.PeerID("pid 2")
+
+	psm.UpdatePeerIdPublicKey(pid1, pk)
+	psm.UpdatePeerIdPublicKey(pid2, pk)
+	pid, found := psm.GetLastKnownPeerID(pk)
+	assert.True(t, found)
+	assert.Equal(t, pid2, *pid)
+
+	psm.UpdatePeerIdPublicKey(pid1, pk)
+	pid, found = psm.GetLastKnownPeerID(pk)
+	assert.True(t, found)
+	assert.Equal(t, pid1, *pid)
+}
+
 //------- UpdatePublicKeyShardId
 
 func TestPeerShardMapper_UpdatePublicKeyShardIdShouldWork(t *testing.T) {
@@ -28,3 +28,6 @@ var ErrNilStorage = errors.New("nil storage")
 
 // ErrNilTermUIStartChannel signals that a nil TermUI start channel has been provided
 var ErrNilTermUIStartChannel = errors.New("nil TermUI start channel")
+
+// ErrNilPrometheusMetricsProvider signals that a nil prometheus metrics provider has been provided
+var ErrNilPrometheusMetricsProvider = errors.New("nil prometheus metrics provider")
@@ -0,0 +1,20 @@
+package mock
+
+// PrometheusMetricsProviderStub -
+type PrometheusMetricsProviderStub struct {
+	PrometheusMetricsCalled func(labels string) string
+}
+
+// PrometheusMetrics -
+func (pmps *PrometheusMetricsProviderStub) PrometheusMetrics(labels string) string {
+	if pmps.PrometheusMetricsCalled != nil {
+		return pmps.PrometheusMetricsCalled(labels)
+	}
+
+	return ""
+}
+
+// IsInterfaceNil -
+func (pmps *PrometheusMetricsProviderStub) IsInterfaceNil() bool {
+	return pmps == nil
+}
@@ -6,17 +6,21 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
 )
 
 // statusMetrics will handle displaying at /node/details all metrics already collected for other status handlers
 type statusMetrics struct {
-	nodeMetrics *sync.Map
+	nodeMetrics         *sync.Map
+	mutProviders        sync.RWMutex
+	prometheusProviders []core.PrometheusMetricsProvider
 }
 
 // NewStatusMetrics will return an instance of the struct
 func NewStatusMetrics() *statusMetrics {
 	return &statusMetrics{
-		nodeMetrics: &sync.Map{},
+		nodeMetrics:         &sync.Map{},
+		prometheusProviders: make([]core.PrometheusMetricsProvider, 0),
 	}
 }
 
@@ -141,9 +145,29 @@ func (sm *statusMetrics) StatusMetricsWithoutP2PPrometheusString() string {
 		}
 	}
 
+	labels := fmt.Sprintf("%s=\"%d\"", core.MetricShardId, shardID)
+	sm.mutProviders.RLock()
+	for _, provider := range sm.prometheusProviders {
+		stringBuilder.WriteString(provider.PrometheusMetrics(labels))
+	}
+	sm.mutProviders.RUnlock()
+
 	return stringBuilder.String()
 }
 
+// AddPrometheusMetricsProvider adds a provider whose metrics will be appended to the prometheus style metrics
+func (sm *statusMetrics) AddPrometheusMetricsProvider(provider core.PrometheusMetricsProvider) error {
+	if check.IfNil(provider) {
+		return ErrNilPrometheusMetricsProvider
+	}
+
+	sm.mutProviders.Lock()
+	sm.prometheusProviders = append(sm.prometheusProviders, provider)
+	sm.mutProviders.Unlock()
+
+	return nil
+}
+
 // EconomicsMetrics returns the economics related metrics
 func (sm *statusMetrics) EconomicsMetrics() map[string]interface{} {
 	economicsMetrics := make(map[string]interface{})
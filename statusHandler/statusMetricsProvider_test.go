@@ -7,6 +7,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/mock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -143,3 +144,32 @@ func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldPutCorrectSh
 	expectedMetricOutput := fmt.Sprintf("%s{%s=\"%d\"} %v", key1, core.MetricShardId, shardID, value1)
 	assert.True(t, strings.Contains(strRes, expectedMetricOutput))
 }
+
+func TestStatusMetrics_AddPrometheusMetricsProviderNilProviderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	sm := statusHandler.NewStatusMetrics()
+	err := sm.AddPrometheusMetricsProvider(nil)
+
+	assert.Equal(t, statusHandler.ErrNilPrometheusMetricsProvider, err)
+}
+
+func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldAppendProvidersMetrics(t *testing.T) {
+	t.Parallel()
+
+	shardID := uint32(2)
+	sm := statusHandler.NewStatusMetrics()
+	sm.SetUInt64Value(core.MetricShardId, uint64(shardID))
+	providerMetric := "provider_metric"
+	err := sm.AddPrometheusMetricsProvider(&mock.PrometheusMetricsProviderStub{
+		PrometheusMetricsCalled: func(labels string) string {
+			return fmt.Sprintf("%s{%s} 1\n", providerMetric, labels)
+		},
+	})
+	assert.Nil(t, err)
+
+	strRes := sm.StatusMetricsWithoutP2PPrometheusString()
+
+	expectedMetricOutput := fmt.Sprintf("%s{%s=\"%d\"} 1\n", providerMetric, core.MetricShardId, shardID)
+	assert.True(t, strings.Contains(strRes, expectedMetricOutput))
+}
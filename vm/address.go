@@ -1,5 +1,10 @@
 package vm
 
+import (
+	"bytes"
+	"encoding/binary"
+)
+
 // StakingSCAddress is the hard-coded address for smart contracts
 var StakingSCAddress = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 255, 255}
 
@@ -17,3 +22,32 @@ var JailingAddress = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0
 
 // EndOfEpochAddress is the hard-coded address which can call system smart contract functions at end of epoch
 var EndOfEpochAddress = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 255, 255, 255, 255, 255, 255, 255}
+
+// DelegationManagerSCAddress is the hard-coded address for the delegation manager smart contract
+var DelegationManagerSCAddress = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 255, 255}
+
+// FirstDelegationSCAddress is the hard-coded address of the delegation smart contract template. All the delegation
+// pools created by the delegation manager are resolved to this contract
+var FirstDelegationSCAddress = []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 255, 255}
+
+const delegationIndexPosition = 26
+const delegationIndexLen = 4
+
+// CreateDelegationSCAddress returns the address of the delegation pool with the provided index
+func CreateDelegationSCAddress(index uint32) []byte {
+	address := make([]byte, len(FirstDelegationSCAddress))
+	copy(address, FirstDelegationSCAddress)
+	binary.BigEndian.PutUint32(address[delegationIndexPosition:delegationIndexPosition+delegationIndexLen], index)
+
+	return address
+}
+
+// IsDelegationSCAddress returns true if the provided address belongs to a delegation pool
+func IsDelegationSCAddress(address []byte) bool {
+	if len(address) != len(FirstDelegationSCAddress) {
+		return false
+	}
+
+	return bytes.Equal(address[:delegationIndexPosition], FirstDelegationSCAddress[:delegationIndexPosition]) &&
+		bytes.Equal(address[delegationIndexPosition+delegationIndexLen:], FirstDelegationSCAddress[delegationIndexPosition+delegationIndexLen:])
+}
@@ -9,6 +9,8 @@ const (
 	UnBondNotEnabled = "unBond is not enabled"
 	// UnStakeNotEnabled defined constant for return message
 	UnStakeNotEnabled = "unStake is not enabled"
+	// ChangeValidatorKeysNotEnabled defined constant for return message
+	ChangeValidatorKeysNotEnabled = "changeValidatorKeys is not enabled"
 	// TransactionValueMustBeZero defined constant for return message
 	TransactionValueMustBeZero = "transaction value must be zero"
 	// CannotGetOrCreateRegistrationData defined constant for return message
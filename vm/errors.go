@@ -89,6 +89,9 @@ var ErrNilArgumentsParser = errors.New("nil arguments parser")
 // ErrOnExecutionAtStakingSC signals that there was an error at staking sc call
 var ErrOnExecutionAtStakingSC = errors.New("execution error at staking sc")
 
+// ErrOnExecutionAtAuctionSC signals that there was an error at auction sc call
+var ErrOnExecutionAtAuctionSC = errors.New("execution error at auction sc")
+
 // ErrNilAuctionSmartContractAddress signals that auction smart contract address is nil
 var ErrNilAuctionSmartContractAddress = errors.New("nil auction smart contract address")
 
@@ -140,6 +143,9 @@ var ErrVotedForAnExpiredProposal = errors.New("voted for an expired proposal")
 // ErrVotedForAProposalThatNotBeginsYet signals that voting was done for a proposal that not begins yet
 var ErrVotedForAProposalThatNotBeginsYet = errors.New("voted for a proposal that not begins yet")
 
+// ErrVotePowerAlreadyUsed signals that the vote power of a validator was already used on the proposal by itself or its delegates
+var ErrVotePowerAlreadyUsed = errors.New("vote power already used")
+
 // ErrNilPublicKey signals that nil public key has been provided
 var ErrNilPublicKey = errors.New("nil public key")
 
@@ -190,3 +196,27 @@ var ErrInvalidNumOfArguments = errors.New("invalid number of arguments")
 
 // ErrInvalidArgument signals that invalid argument has been provided
 var ErrInvalidArgument = errors.New("invalid argument")
+
+// ErrInvalidMinCreationDeposit signals that invalid minimum creation deposit has been provided
+var ErrInvalidMinCreationDeposit = errors.New("invalid min creation deposit")
+
+// ErrInvalidMinDelegationAmount signals that invalid minimum delegation amount has been provided
+var ErrInvalidMinDelegationAmount = errors.New("invalid min delegation amount")
+
+// ErrInvalidServiceFeeBounds signals that invalid service fee bounds have been provided
+var ErrInvalidServiceFeeBounds = errors.New("invalid service fee bounds")
+
+// ErrNilDelegationManagerSmartContractAddress signals that delegation manager smart contract address is nil
+var ErrNilDelegationManagerSmartContractAddress = errors.New("nil delegation manager smart contract address")
+
+// ErrInvalidRoleForToken signals that the given role cannot be set for the token type
+var ErrInvalidRoleForToken = errors.New("invalid role for token type")
+
+// ErrRoleAlreadyAssigned signals that the given role was already assigned to the address
+var ErrRoleAlreadyAssigned = errors.New("role already assigned")
+
+// ErrRoleNotAssigned signals that the given role is not assigned to the address
+var ErrRoleNotAssigned = errors.New("role not assigned")
+
+// ErrInvalidTokenNameLength signals that the provided token name length limits are invalid
+var ErrInvalidTokenNameLength = errors.New("invalid token name length limits")
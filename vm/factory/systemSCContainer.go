@@ -21,10 +21,13 @@ func NewSystemSCContainer() *systemSCContainer {
 	}
 }
 
-// Get returns the object stored at a certain key.
-// Returns an error if the element does not exist
+// Get returns the object stored at a certain key. All the delegation pools addresses are resolved to the
+// delegation smart contract. Returns an error if the element does not exist
 func (vmc *systemSCContainer) Get(key []byte) (vm.SystemSmartContract, error) {
 	value, ok := vmc.objects.Get(string(key))
+	if !ok && vm.IsDelegationSCAddress(key) {
+		value, ok = vmc.objects.Get(string(vm.FirstDelegationSCAddress))
+	}
 	if !ok {
 		return nil, fmt.Errorf("%w in system sc container for key %v", process.ErrInvalidContainerKey, key)
 	}
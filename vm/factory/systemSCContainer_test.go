@@ -98,6 +98,20 @@ func TestSystemSCContainer_GetShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestSystemSCContainer_GetDelegationSCAddressShouldReturnDelegationContract(t *testing.T) {
+	t.Parallel()
+
+	c := NewSystemSCContainer()
+
+	val := &mock.SystemSCStub{}
+
+	_ = c.Add(vm.FirstDelegationSCAddress, val)
+	valRecovered, err := c.Get(vm.CreateDelegationSCAddress(37))
+
+	assert.True(t, val == valRecovered)
+	assert.Nil(t, err)
+}
+
 //------- Replace
 
 func TestSystemSCContainer_ReplaceNilValueShouldErrAndNotModify(t *testing.T) {
@@ -155,13 +155,14 @@ func (scf *systemSCFactory) createAuctionContract() (vm.SystemSmartContract, err
 
 func (scf *systemSCFactory) createESDTContract() (vm.SystemSmartContract, error) {
 	argsESDT := systemSmartContracts.ArgsNewESDTSmartContract{
-		Eei:           scf.systemEI,
-		GasCost:       scf.gasCost,
-		ESDTSCAddress: vm.ESDTSCAddress,
-		Marshalizer:   scf.marshalizer,
-		Hasher:        scf.hasher,
-		ESDTSCConfig:  scf.systemSCConfig.ESDTSystemSCConfig,
-		EpochNotifier: scf.epochNotifier,
+		Eei:                 scf.systemEI,
+		GasCost:             scf.gasCost,
+		ESDTSCAddress:       vm.ESDTSCAddress,
+		GovernanceSCAddress: vm.GovernanceSCAddress,
+		Marshalizer:         scf.marshalizer,
+		Hasher:              scf.hasher,
+		ESDTSCConfig:        scf.systemSCConfig.ESDTSystemSCConfig,
+		EpochNotifier:       scf.epochNotifier,
 	}
 	esdt, err := systemSmartContracts.NewESDTSmartContract(argsESDT)
 	return esdt, err
@@ -184,6 +185,35 @@ func (scf *systemSCFactory) createGovernanceContract() (vm.SystemSmartContract,
 	return governance, err
 }
 
+func (scf *systemSCFactory) createDelegationManagerContract() (vm.SystemSmartContract, error) {
+	argsDelegationManager := systemSmartContracts.ArgsNewDelegationManager{
+		DelegationMgrSCConfig:  scf.systemSCConfig.DelegationManagerSystemSCConfig,
+		Eei:                    scf.systemEI,
+		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
+		GasCost:                scf.gasCost,
+		Marshalizer:            scf.marshalizer,
+		EpochNotifier:          scf.epochNotifier,
+	}
+	delegationManager, err := systemSmartContracts.NewDelegationManagerSystemSC(argsDelegationManager)
+	return delegationManager, err
+}
+
+func (scf *systemSCFactory) createDelegationContract() (vm.SystemSmartContract, error) {
+	argsDelegation := systemSmartContracts.ArgsNewDelegation{
+		DelegationSCConfig:     scf.systemSCConfig.DelegationSystemSCConfig,
+		Eei:                    scf.systemEI,
+		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
+		AuctionSCAddress:       vm.AuctionSCAddress,
+		EndOfEpochAddress:      vm.EndOfEpochAddress,
+		GasCost:                scf.gasCost,
+		Marshalizer:            scf.marshalizer,
+		EpochNotifier:          scf.epochNotifier,
+		DelegationEnabledEpoch: scf.systemSCConfig.DelegationManagerSystemSCConfig.EnabledEpoch,
+	}
+	delegation, err := systemSmartContracts.NewDelegationSystemSC(argsDelegation)
+	return delegation, err
+}
+
 // Create instantiates all the system smart contracts and returns a container
 func (scf *systemSCFactory) Create() (vm.SystemSCContainer, error) {
 	scContainer := NewSystemSCContainer()
@@ -228,6 +258,26 @@ func (scf *systemSCFactory) Create() (vm.SystemSCContainer, error) {
 		return nil, err
 	}
 
+	delegationManager, err := scf.createDelegationManagerContract()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scContainer.Add(vm.DelegationManagerSCAddress, delegationManager)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation, err := scf.createDelegationContract()
+	if err != nil {
+		return nil, err
+	}
+
+	err = scContainer.Add(vm.FirstDelegationSCAddress, delegation)
+	if err != nil {
+		return nil, err
+	}
+
 	err = scf.systemEI.SetSystemSCContainer(scContainer)
 	if err != nil {
 		return nil, err
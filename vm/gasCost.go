@@ -26,6 +26,8 @@ type MetaChainSystemSCsCost struct {
 	DelegateVote        uint64
 	RevokeVote          uint64
 	CloseProposal       uint64
+	DelegationOps       uint64
+	DelegationMgrOps    uint64
 }
 
 // BuiltInCost defines cost for built-in methods
@@ -36,6 +38,13 @@ type BuiltInCost struct {
 	SaveKeyValue          uint64
 	ESDTTransfer          uint64
 	ESDTBurn              uint64
+	ESDTNFTCreate         uint64
+	ESDTNFTAddQuantity    uint64
+	ESDTNFTBurn           uint64
+	ESDTNFTTransfer       uint64
+	MultiESDTTransfer     uint64
+	ESDTLocalMint         uint64
+	ESDTLocalBurn         uint64
 }
 
 // GasCost holds all the needed gas costs for system smart contracts
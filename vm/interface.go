@@ -48,6 +48,7 @@ type SystemEI interface {
 	IsValidator(blsKey []byte) bool
 	CanUnJail(blsKey []byte) bool
 	IsBadRating(blsKey []byte) bool
+	AddCode(addr []byte, code []byte)
 
 	IsInterfaceNil() bool
 }
@@ -66,7 +67,6 @@ type ContextHandler interface {
 	CreateVMOutput() *vmcommon.VMOutput
 	CleanCache()
 	SetSCAddress(addr []byte)
-	AddCode(addr []byte, code []byte)
 	AddTxValueToSmartContract(value *big.Int, scAddress []byte)
 	SetGasProvided(gasProvided uint64)
 }
@@ -20,6 +20,7 @@ import (
 
 const minArgsLenToChangeValidatorKey = 4
 const unJailedFunds = "unJailFunds"
+const pendingKeyChangeOwnerPrefix = "pendingKeyChangeOwner_"
 
 var zero = big.NewInt(0)
 
@@ -32,18 +33,20 @@ const (
 )
 
 type stakingAuctionSC struct {
-	eei                vm.SystemEI
-	unBondPeriod       uint64
-	sigVerifier        vm.MessageSignVerifier
-	baseConfig         AuctionConfig
-	enableAuctionEpoch uint32
-	stakingSCAddress   []byte
-	auctionSCAddress   []byte
-	enableStakingEpoch uint32
-	gasCost            vm.GasCost
-	marshalizer        marshal.Marshalizer
-	flagStake          atomic.Flag
-	flagAuction        atomic.Flag
+	eei                    vm.SystemEI
+	unBondPeriod           uint64
+	sigVerifier            vm.MessageSignVerifier
+	baseConfig             AuctionConfig
+	enableAuctionEpoch     uint32
+	stakingSCAddress       []byte
+	auctionSCAddress       []byte
+	enableStakingEpoch     uint32
+	enableKeyRotationEpoch uint32
+	gasCost                vm.GasCost
+	marshalizer            marshal.Marshalizer
+	flagStake              atomic.Flag
+	flagAuction            atomic.Flag
+	flagKeyRotation        atomic.Flag
 }
 
 // ArgsStakingAuctionSmartContract is the arguments structure to create a new StakingAuctionSmartContract
@@ -113,16 +116,17 @@ func NewStakingAuctionSmartContract(
 	}
 
 	reg := &stakingAuctionSC{
-		eei:                args.Eei,
-		unBondPeriod:       args.StakingSCConfig.UnBondPeriod,
-		sigVerifier:        args.SigVerifier,
-		baseConfig:         baseConfig,
-		enableAuctionEpoch: args.StakingSCConfig.AuctionEnableEpoch,
-		enableStakingEpoch: args.StakingSCConfig.StakeEnableEpoch,
-		stakingSCAddress:   args.StakingSCAddress,
-		auctionSCAddress:   args.AuctionSCAddress,
-		gasCost:            args.GasCost,
-		marshalizer:        args.Marshalizer,
+		eei:                    args.Eei,
+		unBondPeriod:           args.StakingSCConfig.UnBondPeriod,
+		sigVerifier:            args.SigVerifier,
+		baseConfig:             baseConfig,
+		enableAuctionEpoch:     args.StakingSCConfig.AuctionEnableEpoch,
+		enableStakingEpoch:     args.StakingSCConfig.StakeEnableEpoch,
+		enableKeyRotationEpoch: args.StakingSCConfig.ValidatorKeyRotationEnableEpoch,
+		stakingSCAddress:       args.StakingSCAddress,
+		auctionSCAddress:       args.AuctionSCAddress,
+		gasCost:                args.GasCost,
+		marshalizer:            args.Marshalizer,
 	}
 
 	args.EpochNotifier.RegisterNotifyHandler(reg)
@@ -155,9 +159,10 @@ func (s *stakingAuctionSC) Execute(args *vmcommon.ContractCallInput) vmcommon.Re
 		return s.setConfig(args)
 	case "changeRewardAddress":
 		return s.changeRewardAddress(args)
-	// TODO: activate this after validation
-	//case "changeValidatorKeys":
-	//	return s.changeValidatorKeys(args)
+	case "changeValidatorKeys":
+		return s.changeValidatorKeys(args)
+	case "finalizeValidatorKeyChange":
+		return s.finalizeValidatorKeyChange(args)
 	case "unJail":
 		return s.unJail(args)
 	case "getTotalStaked":
@@ -353,6 +358,10 @@ func (s *stakingAuctionSC) changeRewardAddress(args *vmcommon.ContractCallInput)
 }
 
 func (s *stakingAuctionSC) changeValidatorKeys(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !s.flagKeyRotation.IsSet() {
+		s.eei.AddReturnMessage(vm.ChangeValidatorKeysNotEnabled)
+		return vmcommon.UserError
+	}
 	if args.CallValue.Cmp(zero) != 0 {
 		s.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
 		return vmcommon.UserError
@@ -366,7 +375,7 @@ func (s *stakingAuctionSC) changeValidatorKeys(args *vmcommon.ContractCallInput)
 
 	numNodesToChange := big.NewInt(0).SetBytes(args.Arguments[0]).Uint64()
 	expectedNumArguments := numNodesToChange*3 + 1
-	if uint64(len(args.Arguments)) < expectedNumArguments {
+	if uint64(len(args.Arguments)) != expectedNumArguments {
 		retMessage := fmt.Sprintf("invalid number of arguments: expected min %d, got %d", expectedNumArguments, len(args.Arguments))
 		s.eei.AddReturnMessage(retMessage)
 		return vmcommon.UserError
@@ -399,33 +408,25 @@ func (s *stakingAuctionSC) changeValidatorKeys(args *vmcommon.ContractCallInput)
 			return vmcommon.UserError
 		}
 
-		err = s.replaceBLSKey(registrationData, oldBlsKey, newBlsKey)
+		err = s.requestBLSKeyReplacement(args.CallerAddr, registrationData, oldBlsKey, newBlsKey)
 		if err != nil {
 			s.eei.AddReturnMessage("cannot replace bls key: error " + err.Error())
 			return vmcommon.UserError
 		}
 	}
 
-	err = s.saveRegistrationData(args.CallerAddr, registrationData)
-	if err != nil {
-		s.eei.AddReturnMessage("cannot save registration data: error " + err.Error())
-		return vmcommon.UserError
-	}
-
 	return vmcommon.Ok
 }
 
-func (s *stakingAuctionSC) replaceBLSKey(registrationData *AuctionData, oldBlsKey []byte, newBlsKey []byte) error {
-	foundOldKey := false
-	for i, registeredKey := range registrationData.BlsPubKeys {
-		if bytes.Equal(registeredKey, oldBlsKey) {
-			foundOldKey = true
-			registrationData.BlsPubKeys[i] = newBlsKey
-			break
-		}
-	}
-
-	if !foundOldKey {
+// the registered keys are replaced only when the staking SC finalizes the key changes at the end of the epoch,
+// so both contracts use the old key until then
+func (s *stakingAuctionSC) requestBLSKeyReplacement(
+	ownerAddress []byte,
+	registrationData *AuctionData,
+	oldBlsKey []byte,
+	newBlsKey []byte,
+) error {
+	if !isKeyRegistered(registrationData, oldBlsKey) {
 		return vm.ErrBLSPublicKeyMismatch
 	}
 
@@ -439,9 +440,64 @@ func (s *stakingAuctionSC) replaceBLSKey(registrationData *AuctionData, oldBlsKe
 		return vm.ErrOnExecutionAtStakingSC
 	}
 
+	s.eei.SetStorage([]byte(pendingKeyChangeOwnerPrefix+string(oldBlsKey)), ownerAddress)
+
 	return nil
 }
 
+func (s *stakingAuctionSC) finalizeValidatorKeyChange(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, s.stakingSCAddress) {
+		s.eei.AddReturnMessage("finalizeValidatorKeyChange function not allowed to be called by address " + string(args.CallerAddr))
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		s.eei.AddReturnMessage("invalid number of arguments: expected exactly 2")
+		return vmcommon.UserError
+	}
+
+	oldBlsKey := args.Arguments[0]
+	newBlsKey := args.Arguments[1]
+	ownerStorageKey := []byte(pendingKeyChangeOwnerPrefix + string(oldBlsKey))
+	ownerAddress := s.eei.GetStorage(ownerStorageKey)
+	if len(ownerAddress) == 0 {
+		s.eei.AddReturnMessage("no pending key change for the given bls key")
+		return vmcommon.UserError
+	}
+	s.eei.SetStorage(ownerStorageKey, nil)
+
+	registrationData, err := s.getOrCreateRegistrationData(ownerAddress)
+	if err != nil {
+		s.eei.AddReturnMessage(vm.CannotGetOrCreateRegistrationData + err.Error())
+		return vmcommon.UserError
+	}
+
+	// the old key could have been unBonded during the epoch
+	for i, registeredKey := range registrationData.BlsPubKeys {
+		if bytes.Equal(registeredKey, oldBlsKey) {
+			registrationData.BlsPubKeys[i] = newBlsKey
+			break
+		}
+	}
+
+	err = s.saveRegistrationData(ownerAddress, registrationData)
+	if err != nil {
+		s.eei.AddReturnMessage("cannot save registration data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func isKeyRegistered(registrationData *AuctionData, blsKey []byte) bool {
+	for _, registeredKey := range registrationData.BlsPubKeys {
+		if bytes.Equal(registeredKey, blsKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (s *stakingAuctionSC) get(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if args.CallValue.Cmp(zero) != 0 {
 		s.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
@@ -1388,6 +1444,9 @@ func (s *stakingAuctionSC) EpochConfirmed(epoch uint32) {
 
 	s.flagAuction.Toggle(epoch >= s.enableAuctionEpoch)
 	log.Debug("stakingAuctionSC: auction", "enabled", s.flagAuction.IsSet())
+
+	s.flagKeyRotation.Toggle(epoch >= s.enableKeyRotationEpoch)
+	log.Debug("stakingAuctionSC: validator key rotation", "enabled", s.flagKeyRotation.IsSet())
 }
 
 func (s *stakingAuctionSC) getBlsKeysStatus(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
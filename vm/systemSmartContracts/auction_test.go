@@ -2793,7 +2793,6 @@ func TestAuctionStakingSC_ChangeRewardAddress(t *testing.T) {
 }
 
 func TestAuctionStakingSC_ChangeValidatorKeys(t *testing.T) {
-	t.Skip("function is disabled for now as it is not fully tested")
 	t.Parallel()
 
 	receiverAddr := []byte("receiverAddress")
@@ -2804,12 +2803,27 @@ func TestAuctionStakingSC_ChangeValidatorKeys(t *testing.T) {
 	nodesToRunBytes := big.NewInt(1).Bytes()
 	blockChainHook := &mock.BlockChainHookStub{}
 	args := createMockArgumentsForAuction()
-	args.Eei = createVmContextWithStakingSc(minStakeValue, unboundPeriod, blockChainHook)
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{}, &mock.RaterMock{})
+	args.Eei = eei
 
+	argsStaking := createMockStakingScArguments()
+	argsStaking.StakingSCConfig.GenesisNodePrice = minStakeValue.Text(10)
+	argsStaking.StakingSCConfig.UnBondPeriod = unboundPeriod
+	argsStaking.StakingAccessAddr = args.AuctionSCAddress
+	argsStaking.Eei = eei
+	stakingSC, _ := NewStakingSmartContract(argsStaking)
 	sc, _ := NewStakingAuctionSmartContract(args)
 
+	eei.SetSCAddress(args.AuctionSCAddress)
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+		if bytes.Equal(key, args.AuctionSCAddress) {
+			return sc, nil
+		}
+		return stakingSC, nil
+	}})
+
 	// changeValidatorKeys should err not enough arguments
-	newKey := []byte("newKey")
+	newKey := []byte("newKey1")
 	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, newKey, nil, vmcommon.UserError)
 	// changeValidatorKeys should error because address is not belongs to any validator
 	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, newKey, []byte("signed"), vmcommon.UserError)
@@ -2817,23 +2831,79 @@ func TestAuctionStakingSC_ChangeValidatorKeys(t *testing.T) {
 	nodePrice, _ := big.NewInt(0).SetString(args.StakingSCConfig.GenesisNodePrice, 10)
 	stake(t, sc, nodePrice, receiverAddr, stakerAddress, stakerPubKey, nodesToRunBytes)
 	// changeValidatorKeys should error not enough arguments
-	nodesToRunBytes = big.NewInt(2).Bytes()
-	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, newKey, []byte("signed"), vmcommon.UserError)
+	changeValidatorKeys(t, sc, big.NewInt(2).Bytes(), stakerAddress, stakerPubKey, newKey, []byte("signed"), vmcommon.UserError)
 	// changeValidatorKeys should error verify sig will return error
-	nodesToRunBytes = big.NewInt(1).Bytes()
-	args.SigVerifier = &mock.MessageSignVerifierMock{
+	sc.sigVerifier = &mock.MessageSignVerifierMock{
 		VerifyCalled: func(message []byte, signedMessage []byte, pubKey []byte) error {
 			return errors.New("new")
 		},
 	}
 	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, newKey, []byte("signed"), vmcommon.UserError)
 	// changeValidatorKeys should error wrong old key
-	args.SigVerifier = &mock.MessageSignVerifierMock{}
-	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, []byte("wrong"), newKey, []byte("signed"), vmcommon.UserError)
+	sc.sigVerifier = &mock.MessageSignVerifierMock{}
+	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, []byte("wrong12"), newKey, []byte("signed"), vmcommon.UserError)
 
 	// changeValidatorKeys should work
-	newKey = []byte("newKey1")
 	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, newKey, []byte("signed"), vmcommon.Ok)
+
+	// the old key is kept until the end of the epoch, both in the auction and in the staking SC
+	registrationData, err := sc.getOrCreateRegistrationData(stakerAddress)
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{stakerPubKey}, registrationData.BlsPubKeys)
+	vmOutput, err := sc.getBLSRegisteredData(stakerPubKey)
+	require.Nil(t, err)
+	assert.True(t, len(vmOutput.ReturnData[0]) > 0)
+
+	// the old key has a pending change and the new key is not registered yet
+	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, []byte("newKey2"), []byte("signed"), vmcommon.UserError)
+	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, newKey, []byte("newKey2"), []byte("signed"), vmcommon.UserError)
+
+	// finalizeValidatorKeyChange can be called only by the staking SC
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "finalizeValidatorKeyChange"
+	arguments.CallerAddr = stakerAddress
+	arguments.Arguments = [][]byte{stakerPubKey, newKey}
+	assert.Equal(t, vmcommon.UserError, sc.Execute(arguments))
+
+	eei.SetSCAddress(args.StakingSCAddress)
+	arguments = CreateVmContractCallInput()
+	arguments.Function = "finalizeValidatorKeyChanges"
+	arguments.CallerAddr = argsStaking.EndOfEpochAccessAddr
+	arguments.RecipientAddr = args.StakingSCAddress
+	assert.Equal(t, vmcommon.Ok, stakingSC.Execute(arguments))
+	eei.SetSCAddress(args.AuctionSCAddress)
+
+	registrationData, err = sc.getOrCreateRegistrationData(stakerAddress)
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{newKey}, registrationData.BlsPubKeys)
+	assert.Equal(t, 0, len(eei.GetStorage([]byte(pendingKeyChangeOwnerPrefix+string(stakerPubKey)))))
+	vmOutput, err = sc.getBLSRegisteredData(newKey)
+	require.Nil(t, err)
+	assert.True(t, len(vmOutput.ReturnData[0]) > 0)
+	vmOutput, err = sc.getBLSRegisteredData(stakerPubKey)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(vmOutput.ReturnData[0]))
+}
+
+func TestAuctionStakingSC_ChangeValidatorKeysNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	receiverAddr := []byte("receiverAddress")
+	stakerAddress := []byte("stakerA")
+	stakerPubKey := []byte("stakerP")
+	nodesToRunBytes := big.NewInt(1).Bytes()
+	args := createMockArgumentsForAuction()
+	eei := createVmContextWithStakingSc(big.NewInt(1000), 10, &mock.BlockChainHookStub{})
+	args.Eei = eei
+	args.StakingSCConfig.ValidatorKeyRotationEnableEpoch = 10
+
+	sc, _ := NewStakingAuctionSmartContract(args)
+
+	nodePrice, _ := big.NewInt(0).SetString(args.StakingSCConfig.GenesisNodePrice, 10)
+	stake(t, sc, nodePrice, receiverAddr, stakerAddress, stakerPubKey, nodesToRunBytes)
+
+	changeValidatorKeys(t, sc, nodesToRunBytes, stakerAddress, stakerPubKey, []byte("newKey1"), []byte("signed"), vmcommon.UserError)
+	assert.Equal(t, vm.ChangeValidatorKeysNotEnabled, eei.returnMessage)
 }
 
 func createVmContextWithStakingSc(stakeValue *big.Int, unboundPeriod uint64, blockChainHook vmcommon.BlockchainHook) *vmContext {
@@ -0,0 +1,1078 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. delegation.proto
+package systemSmartContracts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const delegationConfigKey = "delegationConfig"
+const globalFundKey = "globalFund"
+const nodesDataKey = "nodesData"
+const maxServiceFee = 10000
+
+// rewardsPrecision is used to keep the accumulated reward per delegated unit as an integer
+var rewardsPrecision = big.NewInt(0).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+// ArgsNewDelegation defines the arguments to create the delegation system smart contract
+type ArgsNewDelegation struct {
+	DelegationSCConfig     config.DelegationSystemSCConfig
+	Eei                    vm.SystemEI
+	DelegationMgrSCAddress []byte
+	AuctionSCAddress       []byte
+	EndOfEpochAddress      []byte
+	GasCost                vm.GasCost
+	Marshalizer            marshal.Marshalizer
+	EpochNotifier          vm.EpochNotifier
+	DelegationEnabledEpoch uint32
+}
+
+type delegation struct {
+	eei                    vm.SystemEI
+	delegationMgrSCAddress []byte
+	auctionSCAddress       []byte
+	endOfEpochAddress      []byte
+	gasCost                vm.GasCost
+	marshalizer            marshal.Marshalizer
+	minDelegationAmount    *big.Int
+	minServiceFee          uint64
+	maxServiceFee          uint64
+	unBondPeriodInEpochs   uint32
+	enabledEpoch           uint32
+	flagEnabled            atomic.Flag
+}
+
+// NewDelegationSystemSC creates a new delegation system smart contract. The same instance serves all the delegation
+// pools as the storage is always accessed in the context of the called pool address
+func NewDelegationSystemSC(args ArgsNewDelegation) (*delegation, error) {
+	if check.IfNil(args.Eei) {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+	if len(args.DelegationMgrSCAddress) == 0 {
+		return nil, vm.ErrNilDelegationManagerSmartContractAddress
+	}
+	if len(args.AuctionSCAddress) == 0 {
+		return nil, vm.ErrNilAuctionSmartContractAddress
+	}
+	if len(args.EndOfEpochAddress) == 0 {
+		return nil, vm.ErrInvalidEndOfEpochAccessAddress
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, vm.ErrNilMarshalizer
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, vm.ErrNilEpochNotifier
+	}
+
+	minDelegationAmount, okConvert := big.NewInt(0).SetString(args.DelegationSCConfig.MinDelegationAmount, conversionBase)
+	if !okConvert || minDelegationAmount.Cmp(zero) <= 0 {
+		return nil, fmt.Errorf("%w, value is %v", vm.ErrInvalidMinDelegationAmount, args.DelegationSCConfig.MinDelegationAmount)
+	}
+	if args.DelegationSCConfig.MinServiceFee > args.DelegationSCConfig.MaxServiceFee ||
+		args.DelegationSCConfig.MaxServiceFee > maxServiceFee {
+		return nil, fmt.Errorf("%w, min %d, max %d", vm.ErrInvalidServiceFeeBounds,
+			args.DelegationSCConfig.MinServiceFee, args.DelegationSCConfig.MaxServiceFee)
+	}
+
+	d := &delegation{
+		eei:                    args.Eei,
+		delegationMgrSCAddress: args.DelegationMgrSCAddress,
+		auctionSCAddress:       args.AuctionSCAddress,
+		endOfEpochAddress:      args.EndOfEpochAddress,
+		gasCost:                args.GasCost,
+		marshalizer:            args.Marshalizer,
+		minDelegationAmount:    minDelegationAmount,
+		minServiceFee:          args.DelegationSCConfig.MinServiceFee,
+		maxServiceFee:          args.DelegationSCConfig.MaxServiceFee,
+		unBondPeriodInEpochs:   args.DelegationSCConfig.UnBondPeriodInEpochs,
+		enabledEpoch:           args.DelegationEnabledEpoch,
+	}
+	args.EpochNotifier.RegisterNotifyHandler(d)
+
+	return d, nil
+}
+
+// Execute calls one of the functions from the delegation contract and runs the code according to the input
+func (d *delegation) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if CheckIfNil(args) != nil {
+		return vmcommon.UserError
+	}
+
+	if args.Function == core.SCDeployInitFunctionName {
+		// the delegation pools are initialized only through the delegation manager
+		return vmcommon.Ok
+	}
+
+	if !d.flagEnabled.IsSet() {
+		d.eei.AddReturnMessage("delegation contract is not enabled")
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case initFromManagerFunction:
+		return d.initFromManager(args)
+	case "delegate":
+		return d.delegate(args)
+	case "unDelegate":
+		return d.unDelegate(args)
+	case "withdraw":
+		return d.withdraw(args)
+	case "claimRewards":
+		return d.claimRewards(args)
+	case "updateRewards":
+		return d.updateRewards(args)
+	case "changeServiceFee":
+		return d.changeServiceFee(args)
+	case "setMaxDelegationCap":
+		return d.setMaxDelegationCap(args)
+	case "addNodes":
+		return d.addNodes(args)
+	case "removeNodes":
+		return d.removeNodes(args)
+	case "stakeNodes":
+		return d.stakeNodes(args)
+	case "unStakeNodes":
+		return d.unStakeNodes(args)
+	case "unBondNodes":
+		return d.unBondNodes(args)
+	case "getUserActiveStake":
+		return d.getUserActiveStake(args)
+	case "getUserUnStakedValue":
+		return d.getUserUnStakedValue(args)
+	case "getClaimableRewards":
+		return d.getClaimableRewards(args)
+	case "getTotalActiveStake":
+		return d.getTotalActiveStake(args)
+	case "getContractConfig":
+		return d.getContractConfig(args)
+	}
+
+	d.eei.AddReturnMessage("invalid function to call")
+	return vmcommon.FunctionNotFound
+}
+
+func (d *delegation) initFromManager(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !bytes.Equal(args.CallerAddr, d.delegationMgrSCAddress) {
+		d.eei.AddReturnMessage("initFromManager can be called only by the delegation manager")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 3 {
+		d.eei.AddReturnMessage("invalid number of arguments, expected owner, max delegation cap and service fee")
+		return vmcommon.FunctionWrongSignature
+	}
+	if len(d.eei.GetStorage([]byte(delegationConfigKey))) > 0 {
+		d.eei.AddReturnMessage("delegation contract already initialized")
+		return vmcommon.UserError
+	}
+
+	ownerAddress := args.Arguments[0]
+	maxDelegationCap := big.NewInt(0).SetBytes(args.Arguments[1])
+	serviceFee := big.NewInt(0).SetBytes(args.Arguments[2])
+	if !d.isServiceFeeValid(serviceFee) {
+		d.eei.AddReturnMessage(fmt.Sprintf("invalid service fee, expected between %d and %d", d.minServiceFee, d.maxServiceFee))
+		return vmcommon.UserError
+	}
+	if maxDelegationCap.Cmp(zero) != 0 && maxDelegationCap.Cmp(args.CallValue) < 0 {
+		d.eei.AddReturnMessage("max delegation cap is lower than the initial deposit")
+		return vmcommon.UserError
+	}
+
+	dConfig := &DelegationConfig{
+		OwnerAddress:     ownerAddress,
+		MaxDelegationCap: maxDelegationCap,
+		ServiceFee:       serviceFee.Uint64(),
+		CreatedEpoch:     d.eei.BlockChainHook().CurrentEpoch(),
+	}
+	err := d.saveElement([]byte(delegationConfigKey), dConfig)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save delegation config: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	globalFund := createEmptyGlobalFund()
+	globalFund.TotalActive.Set(args.CallValue)
+	err = d.saveElement([]byte(globalFundKey), globalFund)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save global fund data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	ownerData := createEmptyDelegatorData()
+	ownerData.ActiveFund.Set(args.CallValue)
+	err = d.saveElement(ownerAddress, ownerData)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save delegator data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) delegate(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(d.minDelegationAmount) < 0 {
+		d.eei.AddReturnMessage("delegate value must be higher than minDelegationAmount " + d.minDelegationAmount.String())
+		return vmcommon.UserError
+	}
+	returnCode := d.useGasAndCheckInitialized()
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	dConfig, globalFund, delegator, err := d.getDelegationData(args.CallerAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	newTotalActive := big.NewInt(0).Add(globalFund.TotalActive, args.CallValue)
+	if dConfig.MaxDelegationCap.Cmp(zero) != 0 && newTotalActive.Cmp(dConfig.MaxDelegationCap) > 0 {
+		d.eei.AddReturnMessage("total delegation cap reached")
+		return vmcommon.UserError
+	}
+
+	computeRewards(delegator, globalFund)
+	delegator.ActiveFund.Add(delegator.ActiveFund, args.CallValue)
+	resetRewardDebt(delegator, globalFund)
+	globalFund.TotalActive.Set(newTotalActive)
+
+	return d.saveFundsData(args.CallerAddr, globalFund, delegator)
+}
+
+func (d *delegation) unDelegate(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		d.eei.AddReturnMessage("invalid number of arguments, expected the value to unDelegate")
+		return vmcommon.FunctionWrongSignature
+	}
+	returnCode := d.useGasAndCheckInitialized()
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	_, globalFund, delegator, err := d.getDelegationData(args.CallerAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	valueToUnDelegate := big.NewInt(0).SetBytes(args.Arguments[0])
+	if valueToUnDelegate.Cmp(zero) <= 0 || valueToUnDelegate.Cmp(delegator.ActiveFund) > 0 {
+		d.eei.AddReturnMessage("invalid value to unDelegate")
+		return vmcommon.UserError
+	}
+	remainingActive := big.NewInt(0).Sub(delegator.ActiveFund, valueToUnDelegate)
+	if remainingActive.Cmp(zero) > 0 && remainingActive.Cmp(d.minDelegationAmount) < 0 {
+		d.eei.AddReturnMessage("invalid value to unDelegate, remaining active fund would be lower than minDelegationAmount")
+		return vmcommon.UserError
+	}
+
+	computeRewards(delegator, globalFund)
+	delegator.ActiveFund.Set(remainingActive)
+	resetRewardDebt(delegator, globalFund)
+	delegator.UnStakedFunds = append(delegator.UnStakedFunds, &UnStakedFund{
+		Value: valueToUnDelegate,
+		Epoch: d.eei.BlockChainHook().CurrentEpoch(),
+	})
+	globalFund.TotalActive.Sub(globalFund.TotalActive, valueToUnDelegate)
+	globalFund.TotalUnStaked.Add(globalFund.TotalUnStaked, valueToUnDelegate)
+
+	return d.saveFundsData(args.CallerAddr, globalFund, delegator)
+}
+
+func (d *delegation) withdraw(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	returnCode := d.useGasAndCheckInitialized()
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	_, globalFund, delegator, err := d.getDelegationData(args.CallerAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	currentEpoch := d.eei.BlockChainHook().CurrentEpoch()
+	totalWithdrawable := big.NewInt(0)
+	remainingFunds := make([]*UnStakedFund, 0, len(delegator.UnStakedFunds))
+	for _, fund := range delegator.UnStakedFunds {
+		if fund.Epoch+d.unBondPeriodInEpochs > currentEpoch {
+			remainingFunds = append(remainingFunds, fund)
+			continue
+		}
+		totalWithdrawable.Add(totalWithdrawable, fund.Value)
+	}
+	if totalWithdrawable.Cmp(zero) == 0 {
+		d.eei.AddReturnMessage("nothing to withdraw")
+		return vmcommon.UserError
+	}
+
+	liquidFunds := big.NewInt(0).Add(globalFund.TotalActive, globalFund.TotalUnStaked)
+	liquidFunds.Sub(liquidFunds, globalFund.StakedInAuction)
+	if liquidFunds.Cmp(totalWithdrawable) < 0 {
+		d.eei.AddReturnMessage("not enough liquid funds in the delegation contract, nodes have to be unBonded first")
+		return vmcommon.UserError
+	}
+
+	delegator.UnStakedFunds = remainingFunds
+	globalFund.TotalUnStaked.Sub(globalFund.TotalUnStaked, totalWithdrawable)
+
+	err = d.eei.Transfer(args.CallerAddr, args.RecipientAddr, totalWithdrawable, nil, 0)
+	if err != nil {
+		d.eei.AddReturnMessage("transfer error on withdraw function: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return d.saveFundsData(args.CallerAddr, globalFund, delegator)
+}
+
+func (d *delegation) claimRewards(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	returnCode := d.useGasAndCheckInitialized()
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	_, globalFund, delegator, err := d.getDelegationData(args.CallerAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	computeRewards(delegator, globalFund)
+	resetRewardDebt(delegator, globalFund)
+	if delegator.UnClaimedRewards.Cmp(zero) == 0 {
+		d.eei.AddReturnMessage("no rewards to claim")
+		return vmcommon.UserError
+	}
+
+	err = d.eei.Transfer(args.CallerAddr, args.RecipientAddr, delegator.UnClaimedRewards, nil, 0)
+	if err != nil {
+		d.eei.AddReturnMessage("transfer error on claimRewards function: error " + err.Error())
+		return vmcommon.UserError
+	}
+	delegator.UnClaimedRewards = big.NewInt(0)
+
+	return d.saveFundsData(args.CallerAddr, globalFund, delegator)
+}
+
+// updateRewards receives the rewards gained by the pool nodes. The service fee goes to the owner, while the rest is
+// split between the delegators proportionally to their active funds
+func (d *delegation) updateRewards(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) <= 0 {
+		d.eei.AddReturnMessage("updateRewards needs a positive call value")
+		return vmcommon.UserError
+	}
+	returnCode := d.useGasAndCheckInitialized()
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	dConfig, err := d.getDelegationConfig()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	isAllowed := bytes.Equal(args.CallerAddr, d.endOfEpochAddress) || bytes.Equal(args.CallerAddr, dConfig.OwnerAddress)
+	if !isAllowed {
+		d.eei.AddReturnMessage("updateRewards can be called only by the end of epoch address or by the owner")
+		return vmcommon.UserError
+	}
+
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	ownerData, err := d.getDelegatorData(dConfig.OwnerAddress)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	serviceFeeValue := big.NewInt(0).Mul(args.CallValue, big.NewInt(0).SetUint64(dConfig.ServiceFee))
+	serviceFeeValue.Div(serviceFeeValue, big.NewInt(maxServiceFee))
+	delegatorsRewards := big.NewInt(0).Sub(args.CallValue, serviceFeeValue)
+	if globalFund.TotalActive.Cmp(zero) == 0 {
+		serviceFeeValue.Set(args.CallValue)
+		delegatorsRewards.Set(zero)
+	}
+
+	computeRewards(ownerData, globalFund)
+	resetRewardDebt(ownerData, globalFund)
+	ownerData.UnClaimedRewards.Add(ownerData.UnClaimedRewards, serviceFeeValue)
+	if delegatorsRewards.Cmp(zero) > 0 {
+		rewardPerShareIncrease := big.NewInt(0).Mul(delegatorsRewards, rewardsPrecision)
+		rewardPerShareIncrease.Div(rewardPerShareIncrease, globalFund.TotalActive)
+		globalFund.RewardPerShare.Add(globalFund.RewardPerShare, rewardPerShareIncrease)
+	}
+
+	return d.saveFundsData(dConfig.OwnerAddress, globalFund, ownerData)
+}
+
+func (d *delegation) changeServiceFee(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	dConfig, returnCode := d.checkOwnerCallAndGetConfig(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	serviceFee := big.NewInt(0).SetBytes(args.Arguments[0])
+	if !d.isServiceFeeValid(serviceFee) {
+		d.eei.AddReturnMessage(fmt.Sprintf("invalid service fee, expected between %d and %d", d.minServiceFee, d.maxServiceFee))
+		return vmcommon.UserError
+	}
+
+	dConfig.ServiceFee = serviceFee.Uint64()
+	err := d.saveElement([]byte(delegationConfigKey), dConfig)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save delegation config: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) setMaxDelegationCap(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	dConfig, returnCode := d.checkOwnerCallAndGetConfig(args, 1)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	maxDelegationCap := big.NewInt(0).SetBytes(args.Arguments[0])
+	if maxDelegationCap.Cmp(zero) != 0 && maxDelegationCap.Cmp(globalFund.TotalActive) < 0 {
+		d.eei.AddReturnMessage("max delegation cap is lower than the total active stake")
+		return vmcommon.UserError
+	}
+
+	dConfig.MaxDelegationCap = maxDelegationCap
+	err = d.saveElement([]byte(delegationConfigKey), dConfig)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save delegation config: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// addNodes registers pairs of BLS keys and signatures of the contract address, to be later staked through auction
+func (d *delegation) addNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	_, returnCode := d.checkOwnerCallAndGetConfig(args, 0)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if len(args.Arguments) == 0 || len(args.Arguments)%2 != 0 {
+		d.eei.AddReturnMessage("invalid number of arguments, expected pairs of BLS key and signature")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	nodesData, err := d.getNodesData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	for i := 0; i < len(args.Arguments); i += 2 {
+		blsKey := args.Arguments[i]
+		if isKeyInList(blsKey, nodesData.StakedKeys) ||
+			isKeyInList(blsKey, nodesData.NotStakedKeys) ||
+			isKeyInList(blsKey, nodesData.UnStakedKeys) {
+			d.eei.AddReturnMessage("BLS key already added " + hex.EncodeToString(blsKey))
+			return vmcommon.UserError
+		}
+
+		nodesData.NotStakedKeys = append(nodesData.NotStakedKeys, blsKey)
+		nodesData.NotStakedSigs = append(nodesData.NotStakedSigs, args.Arguments[i+1])
+	}
+
+	return d.saveNodesData(nodesData)
+}
+
+func (d *delegation) removeNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	_, returnCode := d.checkOwnerCallAndGetConfig(args, 0)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if len(args.Arguments) == 0 {
+		d.eei.AddReturnMessage("invalid number of arguments, expected at least one BLS key")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	nodesData, err := d.getNodesData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, blsKey := range args.Arguments {
+		index := keyIndex(blsKey, nodesData.NotStakedKeys)
+		if index < 0 {
+			d.eei.AddReturnMessage("BLS key is not in the not staked list " + hex.EncodeToString(blsKey))
+			return vmcommon.UserError
+		}
+
+		nodesData.NotStakedKeys = append(nodesData.NotStakedKeys[:index], nodesData.NotStakedKeys[index+1:]...)
+		nodesData.NotStakedSigs = append(nodesData.NotStakedSigs[:index], nodesData.NotStakedSigs[index+1:]...)
+	}
+
+	return d.saveNodesData(nodesData)
+}
+
+// stakeNodes sends all the active funds not yet staked to the auction contract, together with the provided keys
+func (d *delegation) stakeNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	_, returnCode := d.checkOwnerCallAndGetConfig(args, 0)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if len(args.Arguments) == 0 {
+		d.eei.AddReturnMessage("invalid number of arguments, expected at least one BLS key")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	nodesData, err := d.getNodesData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	txData := "stake@" + hex.EncodeToString(big.NewInt(int64(len(args.Arguments))).Bytes())
+	for _, blsKey := range args.Arguments {
+		index := keyIndex(blsKey, nodesData.NotStakedKeys)
+		if index < 0 {
+			d.eei.AddReturnMessage("BLS key is not in the not staked list " + hex.EncodeToString(blsKey))
+			return vmcommon.UserError
+		}
+
+		txData += "@" + hex.EncodeToString(blsKey) + "@" + hex.EncodeToString(nodesData.NotStakedSigs[index])
+		nodesData.StakedKeys = append(nodesData.StakedKeys, blsKey)
+		nodesData.NotStakedKeys = append(nodesData.NotStakedKeys[:index], nodesData.NotStakedKeys[index+1:]...)
+		nodesData.NotStakedSigs = append(nodesData.NotStakedSigs[:index], nodesData.NotStakedSigs[index+1:]...)
+	}
+
+	valueToStake := big.NewInt(0).Sub(globalFund.TotalActive, globalFund.StakedInAuction)
+	if valueToStake.Cmp(zero) < 0 {
+		valueToStake.Set(zero)
+	}
+
+	vmOutput, err := d.eei.ExecuteOnDestContext(d.auctionSCAddress, args.RecipientAddr, valueToStake, []byte(txData))
+	returnCode = d.checkAuctionOutput("stake", vmOutput, err)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	globalFund.StakedInAuction.Add(globalFund.StakedInAuction, valueToStake)
+	err = d.saveElement([]byte(globalFundKey), globalFund)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save global fund data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return d.saveNodesData(nodesData)
+}
+
+func (d *delegation) unStakeNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	_, returnCode := d.checkOwnerCallAndGetConfig(args, 0)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if len(args.Arguments) == 0 {
+		d.eei.AddReturnMessage("invalid number of arguments, expected at least one BLS key")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	nodesData, err := d.getNodesData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	txData := "unStake"
+	for _, blsKey := range args.Arguments {
+		index := keyIndex(blsKey, nodesData.StakedKeys)
+		if index < 0 {
+			d.eei.AddReturnMessage("BLS key is not in the staked list " + hex.EncodeToString(blsKey))
+			return vmcommon.UserError
+		}
+
+		txData += "@" + hex.EncodeToString(blsKey)
+		nodesData.StakedKeys = append(nodesData.StakedKeys[:index], nodesData.StakedKeys[index+1:]...)
+		nodesData.UnStakedKeys = append(nodesData.UnStakedKeys, blsKey)
+	}
+
+	vmOutput, err := d.eei.ExecuteOnDestContext(d.auctionSCAddress, args.RecipientAddr, big.NewInt(0), []byte(txData))
+	returnCode = d.checkAuctionOutput("unStake", vmOutput, err)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	return d.saveNodesData(nodesData)
+}
+
+// unBondNodes unBonds the keys from the auction contract, the funds returned by the auction become liquid again
+func (d *delegation) unBondNodes(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	_, returnCode := d.checkOwnerCallAndGetConfig(args, 0)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if len(args.Arguments) == 0 {
+		d.eei.AddReturnMessage("invalid number of arguments, expected at least one BLS key")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	nodesData, err := d.getNodesData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	txData := "unBond"
+	for _, blsKey := range args.Arguments {
+		if !isKeyInList(blsKey, nodesData.UnStakedKeys) {
+			d.eei.AddReturnMessage("BLS key is not in the unStaked list " + hex.EncodeToString(blsKey))
+			return vmcommon.UserError
+		}
+		txData += "@" + hex.EncodeToString(blsKey)
+	}
+
+	auctionDataBefore, err := d.getAuctionData(args.RecipientAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	vmOutput, err := d.eei.ExecuteOnDestContext(d.auctionSCAddress, args.RecipientAddr, big.NewInt(0), []byte(txData))
+	returnCode = d.checkAuctionOutput("unBond", vmOutput, err)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	auctionDataAfter, err := d.getAuctionData(args.RecipientAddr)
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	unBondedKeys := make([][]byte, 0, len(args.Arguments))
+	for _, blsKey := range args.Arguments {
+		if !isKeyInList(blsKey, auctionDataAfter.BlsPubKeys) {
+			unBondedKeys = append(unBondedKeys, blsKey)
+		}
+	}
+	for _, blsKey := range unBondedKeys {
+		index := keyIndex(blsKey, nodesData.UnStakedKeys)
+		nodesData.UnStakedKeys = append(nodesData.UnStakedKeys[:index], nodesData.UnStakedKeys[index+1:]...)
+	}
+
+	returnedValue := big.NewInt(0).Sub(auctionDataBefore.TotalStakeValue, auctionDataAfter.TotalStakeValue)
+	globalFund.StakedInAuction.Sub(globalFund.StakedInAuction, returnedValue)
+	if globalFund.StakedInAuction.Cmp(zero) < 0 {
+		globalFund.StakedInAuction.Set(zero)
+	}
+
+	err = d.saveElement([]byte(globalFundKey), globalFund)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save global fund data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return d.saveNodesData(nodesData)
+}
+
+func (d *delegation) getUserActiveStake(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	delegator, returnCode := d.getDelegatorForView(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	d.eei.Finish(delegator.ActiveFund.Bytes())
+	return vmcommon.Ok
+}
+
+func (d *delegation) getUserUnStakedValue(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	delegator, returnCode := d.getDelegatorForView(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	totalUnStaked := big.NewInt(0)
+	for _, fund := range delegator.UnStakedFunds {
+		totalUnStaked.Add(totalUnStaked, fund.Value)
+	}
+
+	d.eei.Finish(totalUnStaked.Bytes())
+	return vmcommon.Ok
+}
+
+func (d *delegation) getClaimableRewards(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	delegator, returnCode := d.getDelegatorForView(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	computeRewards(delegator, globalFund)
+	d.eei.Finish(delegator.UnClaimedRewards.Bytes())
+	return vmcommon.Ok
+}
+
+func (d *delegation) getTotalActiveStake(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	returnCode := d.useGasAndCheckInitialized()
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	d.eei.Finish(globalFund.TotalActive.Bytes())
+	return vmcommon.Ok
+}
+
+func (d *delegation) getContractConfig(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	returnCode := d.useGasAndCheckInitialized()
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	dConfig, err := d.getDelegationConfig()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	d.eei.Finish(dConfig.OwnerAddress)
+	d.eei.Finish(big.NewInt(0).SetUint64(dConfig.ServiceFee).Bytes())
+	d.eei.Finish(dConfig.MaxDelegationCap.Bytes())
+	d.eei.Finish(big.NewInt(int64(dConfig.CreatedEpoch)).Bytes())
+	return vmcommon.Ok
+}
+
+func (d *delegation) getDelegatorForView(args *vmcommon.ContractCallInput) (*DelegatorData, vmcommon.ReturnCode) {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return nil, vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		d.eei.AddReturnMessage("invalid number of arguments, expected the delegator address")
+		return nil, vmcommon.FunctionWrongSignature
+	}
+	returnCode := d.useGasAndCheckInitialized()
+	if returnCode != vmcommon.Ok {
+		return nil, returnCode
+	}
+
+	delegator, err := d.getDelegatorData(args.Arguments[0])
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+
+	return delegator, vmcommon.Ok
+}
+
+func (d *delegation) checkOwnerCallAndGetConfig(args *vmcommon.ContractCallInput, numArgs int) (*DelegationConfig, vmcommon.ReturnCode) {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return nil, vmcommon.UserError
+	}
+	if numArgs > 0 && len(args.Arguments) != numArgs {
+		d.eei.AddReturnMessage(fmt.Sprintf("invalid number of arguments, expected %d", numArgs))
+		return nil, vmcommon.FunctionWrongSignature
+	}
+	returnCode := d.useGasAndCheckInitialized()
+	if returnCode != vmcommon.Ok {
+		return nil, returnCode
+	}
+
+	dConfig, err := d.getDelegationConfig()
+	if err != nil {
+		d.eei.AddReturnMessage(err.Error())
+		return nil, vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, dConfig.OwnerAddress) {
+		d.eei.AddReturnMessage("only the owner can call this function")
+		return nil, vmcommon.UserError
+	}
+
+	return dConfig, vmcommon.Ok
+}
+
+func (d *delegation) useGasAndCheckInitialized() vmcommon.ReturnCode {
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationOps)
+	if err != nil {
+		d.eei.AddReturnMessage(vm.InsufficientGasLimit)
+		return vmcommon.OutOfGas
+	}
+	if len(d.eei.GetStorage([]byte(delegationConfigKey))) == 0 {
+		d.eei.AddReturnMessage("delegation contract is not initialized")
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) checkAuctionOutput(function string, vmOutput *vmcommon.VMOutput, err error) vmcommon.ReturnCode {
+	if err != nil {
+		d.eei.AddReturnMessage(fmt.Sprintf("cannot call %s on auction: error %s", function, err.Error()))
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		d.eei.AddReturnMessage(fmt.Sprintf("cannot call %s on auction: %s", function, vmOutput.ReturnMessage))
+		return vmOutput.ReturnCode
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) isServiceFeeValid(serviceFee *big.Int) bool {
+	if !serviceFee.IsUint64() {
+		return false
+	}
+
+	return serviceFee.Uint64() >= d.minServiceFee && serviceFee.Uint64() <= d.maxServiceFee
+}
+
+func (d *delegation) getDelegationData(delegatorAddress []byte) (*DelegationConfig, *GlobalFundData, *DelegatorData, error) {
+	dConfig, err := d.getDelegationConfig()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	globalFund, err := d.getGlobalFundData()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	delegator, err := d.getDelegatorData(delegatorAddress)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return dConfig, globalFund, delegator, nil
+}
+
+func (d *delegation) saveFundsData(delegatorAddress []byte, globalFund *GlobalFundData, delegator *DelegatorData) vmcommon.ReturnCode {
+	err := d.saveElement([]byte(globalFundKey), globalFund)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save global fund data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	isEmpty := delegator.ActiveFund.Cmp(zero) == 0 && len(delegator.UnStakedFunds) == 0 &&
+		delegator.UnClaimedRewards.Cmp(zero) == 0
+	if isEmpty {
+		d.eei.SetStorage(delegatorAddress, nil)
+		return vmcommon.Ok
+	}
+
+	err = d.saveElement(delegatorAddress, delegator)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save delegator data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) saveNodesData(nodesData *NodesData) vmcommon.ReturnCode {
+	err := d.saveElement([]byte(nodesDataKey), nodesData)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save nodes data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegation) getDelegationConfig() (*DelegationConfig, error) {
+	dConfig := &DelegationConfig{MaxDelegationCap: big.NewInt(0)}
+	err := d.getElement([]byte(delegationConfigKey), dConfig)
+	if err != nil {
+		return nil, err
+	}
+	if dConfig.MaxDelegationCap == nil {
+		dConfig.MaxDelegationCap = big.NewInt(0)
+	}
+
+	return dConfig, nil
+}
+
+func (d *delegation) getGlobalFundData() (*GlobalFundData, error) {
+	globalFund := createEmptyGlobalFund()
+	err := d.getElement([]byte(globalFundKey), globalFund)
+	if err != nil {
+		return nil, err
+	}
+	fillNilBigInts(&globalFund.TotalActive, &globalFund.TotalUnStaked, &globalFund.StakedInAuction, &globalFund.RewardPerShare)
+
+	return globalFund, nil
+}
+
+func (d *delegation) getDelegatorData(address []byte) (*DelegatorData, error) {
+	delegator := createEmptyDelegatorData()
+	err := d.getElement(address, delegator)
+	if err != nil {
+		return nil, err
+	}
+	fillNilBigInts(&delegator.ActiveFund, &delegator.RewardDebt, &delegator.UnClaimedRewards)
+
+	return delegator, nil
+}
+
+func (d *delegation) getNodesData() (*NodesData, error) {
+	nodesData := &NodesData{}
+	err := d.getElement([]byte(nodesDataKey), nodesData)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodesData, nil
+}
+
+func (d *delegation) getAuctionData(address []byte) (*AuctionData, error) {
+	auctionData := &AuctionData{TotalStakeValue: big.NewInt(0)}
+	marshaledData := d.eei.GetStorageFromAddress(d.auctionSCAddress, address)
+	if len(marshaledData) == 0 {
+		return auctionData, nil
+	}
+
+	err := d.marshalizer.Unmarshal(auctionData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+	fillNilBigInts(&auctionData.TotalStakeValue)
+
+	return auctionData, nil
+}
+
+func (d *delegation) getElement(key []byte, element interface{}) error {
+	marshaledData := d.eei.GetStorage(key)
+	if len(marshaledData) == 0 {
+		return nil
+	}
+
+	return d.marshalizer.Unmarshal(element, marshaledData)
+}
+
+func (d *delegation) saveElement(key []byte, element interface{}) error {
+	marshaledData, err := d.marshalizer.Marshal(element)
+	if err != nil {
+		return err
+	}
+
+	d.eei.SetStorage(key, marshaledData)
+	return nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (d *delegation) EpochConfirmed(epoch uint32) {
+	d.flagEnabled.Toggle(epoch >= d.enabledEpoch)
+	log.Debug("delegation contract", "enabled", d.flagEnabled.IsSet())
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (d *delegation) IsInterfaceNil() bool {
+	return d == nil
+}
+
+func computeRewards(delegator *DelegatorData, globalFund *GlobalFundData) {
+	accumulated := big.NewInt(0).Mul(delegator.ActiveFund, globalFund.RewardPerShare)
+	accumulated.Div(accumulated, rewardsPrecision)
+	pending := accumulated.Sub(accumulated, delegator.RewardDebt)
+	if pending.Cmp(zero) > 0 {
+		delegator.UnClaimedRewards.Add(delegator.UnClaimedRewards, pending)
+	}
+}
+
+func resetRewardDebt(delegator *DelegatorData, globalFund *GlobalFundData) {
+	rewardDebt := big.NewInt(0).Mul(delegator.ActiveFund, globalFund.RewardPerShare)
+	delegator.RewardDebt = rewardDebt.Div(rewardDebt, rewardsPrecision)
+}
+
+func createEmptyGlobalFund() *GlobalFundData {
+	return &GlobalFundData{
+		TotalActive:     big.NewInt(0),
+		TotalUnStaked:   big.NewInt(0),
+		StakedInAuction: big.NewInt(0),
+		RewardPerShare:  big.NewInt(0),
+	}
+}
+
+func createEmptyDelegatorData() *DelegatorData {
+	return &DelegatorData{
+		ActiveFund:       big.NewInt(0),
+		UnStakedFunds:    make([]*UnStakedFund, 0),
+		RewardDebt:       big.NewInt(0),
+		UnClaimedRewards: big.NewInt(0),
+	}
+}
+
+func fillNilBigInts(values ...**big.Int) {
+	for _, value := range values {
+		if *value == nil {
+			*value = big.NewInt(0)
+		}
+	}
+}
+
+func isKeyInList(key []byte, list [][]byte) bool {
+	return keyIndex(key, list) >= 0
+}
+
+func keyIndex(key []byte, list [][]byte) int {
+	for i, element := range list {
+		if bytes.Equal(key, element) {
+			return i
+		}
+	}
+
+	return -1
+}
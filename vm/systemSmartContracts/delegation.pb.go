@@ -0,0 +1,2678 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: delegation.proto
+
+package systemSmartContracts
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_ElrondNetwork_elrond_go_data "github.com/ElrondNetwork/elrond-go/data"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DelegationManagement struct {
+	NumOfContracts     uint32        `protobuf:"varint,1,opt,name=NumOfContracts,proto3" json:"NumOfContracts"`
+	LastAddress        []byte        `protobuf:"bytes,2,opt,name=LastAddress,proto3" json:"LastAddress"`
+	MinCreationDeposit *math_big.Int `protobuf:"bytes,3,opt,name=MinCreationDeposit,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"MinCreationDeposit"`
+}
+
+func (m *DelegationManagement) Reset()      { *m = DelegationManagement{} }
+func (*DelegationManagement) ProtoMessage() {}
+func (*DelegationManagement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{0}
+}
+func (m *DelegationManagement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationManagement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DelegationManagement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationManagement.Merge(m, src)
+}
+func (m *DelegationManagement) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationManagement) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationManagement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationManagement proto.InternalMessageInfo
+
+func (m *DelegationManagement) GetNumOfContracts() uint32 {
+	if m != nil {
+		return m.NumOfContracts
+	}
+	return 0
+}
+
+func (m *DelegationManagement) GetLastAddress() []byte {
+	if m != nil {
+		return m.LastAddress
+	}
+	return nil
+}
+
+func (m *DelegationManagement) GetMinCreationDeposit() *math_big.Int {
+	if m != nil {
+		return m.MinCreationDeposit
+	}
+	return nil
+}
+
+type DelegationContractList struct {
+	Addresses [][]byte `protobuf:"bytes,1,rep,name=Addresses,proto3" json:"Addresses"`
+}
+
+func (m *DelegationContractList) Reset()      { *m = DelegationContractList{} }
+func (*DelegationContractList) ProtoMessage() {}
+func (*DelegationContractList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{1}
+}
+func (m *DelegationContractList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationContractList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DelegationContractList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationContractList.Merge(m, src)
+}
+func (m *DelegationContractList) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationContractList) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationContractList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationContractList proto.InternalMessageInfo
+
+func (m *DelegationContractList) GetAddresses() [][]byte {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type DelegationConfig struct {
+	OwnerAddress     []byte        `protobuf:"bytes,1,opt,name=OwnerAddress,proto3" json:"OwnerAddress"`
+	MaxDelegationCap *math_big.Int `protobuf:"bytes,2,opt,name=MaxDelegationCap,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"MaxDelegationCap"`
+	ServiceFee       uint64        `protobuf:"varint,3,opt,name=ServiceFee,proto3" json:"ServiceFee"`
+	CreatedEpoch     uint32        `protobuf:"varint,4,opt,name=CreatedEpoch,proto3" json:"CreatedEpoch"`
+}
+
+func (m *DelegationConfig) Reset()      { *m = DelegationConfig{} }
+func (*DelegationConfig) ProtoMessage() {}
+func (*DelegationConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{2}
+}
+func (m *DelegationConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DelegationConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationConfig.Merge(m, src)
+}
+func (m *DelegationConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationConfig proto.InternalMessageInfo
+
+func (m *DelegationConfig) GetOwnerAddress() []byte {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return nil
+}
+
+func (m *DelegationConfig) GetMaxDelegationCap() *math_big.Int {
+	if m != nil {
+		return m.MaxDelegationCap
+	}
+	return nil
+}
+
+func (m *DelegationConfig) GetServiceFee() uint64 {
+	if m != nil {
+		return m.ServiceFee
+	}
+	return 0
+}
+
+func (m *DelegationConfig) GetCreatedEpoch() uint32 {
+	if m != nil {
+		return m.CreatedEpoch
+	}
+	return 0
+}
+
+type GlobalFundData struct {
+	TotalActive     *math_big.Int `protobuf:"bytes,1,opt,name=TotalActive,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"TotalActive"`
+	TotalUnStaked   *math_big.Int `protobuf:"bytes,2,opt,name=TotalUnStaked,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"TotalUnStaked"`
+	StakedInAuction *math_big.Int `protobuf:"bytes,3,opt,name=StakedInAuction,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"StakedInAuction"`
+	RewardPerShare  *math_big.Int `protobuf:"bytes,4,opt,name=RewardPerShare,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"RewardPerShare"`
+}
+
+func (m *GlobalFundData) Reset()      { *m = GlobalFundData{} }
+func (*GlobalFundData) ProtoMessage() {}
+func (*GlobalFundData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{3}
+}
+func (m *GlobalFundData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GlobalFundData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GlobalFundData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GlobalFundData.Merge(m, src)
+}
+func (m *GlobalFundData) XXX_Size() int {
+	return m.Size()
+}
+func (m *GlobalFundData) XXX_DiscardUnknown() {
+	xxx_messageInfo_GlobalFundData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GlobalFundData proto.InternalMessageInfo
+
+func (m *GlobalFundData) GetTotalActive() *math_big.Int {
+	if m != nil {
+		return m.TotalActive
+	}
+	return nil
+}
+
+func (m *GlobalFundData) GetTotalUnStaked() *math_big.Int {
+	if m != nil {
+		return m.TotalUnStaked
+	}
+	return nil
+}
+
+func (m *GlobalFundData) GetStakedInAuction() *math_big.Int {
+	if m != nil {
+		return m.StakedInAuction
+	}
+	return nil
+}
+
+func (m *GlobalFundData) GetRewardPerShare() *math_big.Int {
+	if m != nil {
+		return m.RewardPerShare
+	}
+	return nil
+}
+
+type UnStakedFund struct {
+	Value *math_big.Int `protobuf:"bytes,1,opt,name=Value,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"Value"`
+	Epoch uint32        `protobuf:"varint,2,opt,name=Epoch,proto3" json:"Epoch"`
+}
+
+func (m *UnStakedFund) Reset()      { *m = UnStakedFund{} }
+func (*UnStakedFund) ProtoMessage() {}
+func (*UnStakedFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{4}
+}
+func (m *UnStakedFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnStakedFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *UnStakedFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnStakedFund.Merge(m, src)
+}
+func (m *UnStakedFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnStakedFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnStakedFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnStakedFund proto.InternalMessageInfo
+
+func (m *UnStakedFund) GetValue() *math_big.Int {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *UnStakedFund) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type DelegatorData struct {
+	ActiveFund       *math_big.Int   `protobuf:"bytes,1,opt,name=ActiveFund,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"ActiveFund"`
+	UnStakedFunds    []*UnStakedFund `protobuf:"bytes,2,rep,name=UnStakedFunds,proto3" json:"UnStakedFunds"`
+	RewardDebt       *math_big.Int   `protobuf:"bytes,3,opt,name=RewardDebt,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"RewardDebt"`
+	UnClaimedRewards *math_big.Int   `protobuf:"bytes,4,opt,name=UnClaimedRewards,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"UnClaimedRewards"`
+}
+
+func (m *DelegatorData) Reset()      { *m = DelegatorData{} }
+func (*DelegatorData) ProtoMessage() {}
+func (*DelegatorData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{5}
+}
+func (m *DelegatorData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DelegatorData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorData.Merge(m, src)
+}
+func (m *DelegatorData) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorData) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorData proto.InternalMessageInfo
+
+func (m *DelegatorData) GetActiveFund() *math_big.Int {
+	if m != nil {
+		return m.ActiveFund
+	}
+	return nil
+}
+
+func (m *DelegatorData) GetUnStakedFunds() []*UnStakedFund {
+	if m != nil {
+		return m.UnStakedFunds
+	}
+	return nil
+}
+
+func (m *DelegatorData) GetRewardDebt() *math_big.Int {
+	if m != nil {
+		return m.RewardDebt
+	}
+	return nil
+}
+
+func (m *DelegatorData) GetUnClaimedRewards() *math_big.Int {
+	if m != nil {
+		return m.UnClaimedRewards
+	}
+	return nil
+}
+
+type NodesData struct {
+	StakedKeys    [][]byte `protobuf:"bytes,1,rep,name=StakedKeys,proto3" json:"StakedKeys"`
+	NotStakedKeys [][]byte `protobuf:"bytes,2,rep,name=NotStakedKeys,proto3" json:"NotStakedKeys"`
+	NotStakedSigs [][]byte `protobuf:"bytes,3,rep,name=NotStakedSigs,proto3" json:"NotStakedSigs"`
+	UnStakedKeys  [][]byte `protobuf:"bytes,4,rep,name=UnStakedKeys,proto3" json:"UnStakedKeys"`
+}
+
+func (m *NodesData) Reset()      { *m = NodesData{} }
+func (*NodesData) ProtoMessage() {}
+func (*NodesData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{6}
+}
+func (m *NodesData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodesData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodesData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodesData.Merge(m, src)
+}
+func (m *NodesData) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodesData) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodesData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodesData proto.InternalMessageInfo
+
+func (m *NodesData) GetStakedKeys() [][]byte {
+	if m != nil {
+		return m.StakedKeys
+	}
+	return nil
+}
+
+func (m *NodesData) GetNotStakedKeys() [][]byte {
+	if m != nil {
+		return m.NotStakedKeys
+	}
+	return nil
+}
+
+func (m *NodesData) GetNotStakedSigs() [][]byte {
+	if m != nil {
+		return m.NotStakedSigs
+	}
+	return nil
+}
+
+func (m *NodesData) GetUnStakedKeys() [][]byte {
+	if m != nil {
+		return m.UnStakedKeys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DelegationManagement)(nil), "proto.DelegationManagement")
+	proto.RegisterType((*DelegationContractList)(nil), "proto.DelegationContractList")
+	proto.RegisterType((*DelegationConfig)(nil), "proto.DelegationConfig")
+	proto.RegisterType((*GlobalFundData)(nil), "proto.GlobalFundData")
+	proto.RegisterType((*UnStakedFund)(nil), "proto.UnStakedFund")
+	proto.RegisterType((*DelegatorData)(nil), "proto.DelegatorData")
+	proto.RegisterType((*NodesData)(nil), "proto.NodesData")
+}
+
+func init() { proto.RegisterFile("delegation.proto", fileDescriptor_b823c7d67e95582e) }
+
+var fileDescriptor_b823c7d67e95582e = []byte{
+	// 791 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xcd, 0x24, 0xe9, 0x27, 0x75, 0x9a, 0xa4, 0xf9, 0x86, 0x0a, 0x45, 0x2c, 0xec, 0x2a, 0xab,
+	0x48, 0xa8, 0x89, 0xf8, 0x91, 0x90, 0x60, 0x15, 0x27, 0x2d, 0xaa, 0x68, 0x53, 0xe4, 0xb4, 0xe5,
+	0x6f, 0x35, 0x89, 0xa7, 0x8e, 0xd5, 0xc4, 0x13, 0xd9, 0x93, 0x96, 0x4a, 0x48, 0x20, 0x24, 0x56,
+	0xb0, 0x40, 0x42, 0xbc, 0x03, 0xe2, 0x01, 0x78, 0x06, 0x76, 0x74, 0xd9, 0x95, 0xa1, 0xce, 0x06,
+	0x79, 0xd5, 0x47, 0x40, 0x33, 0x4e, 0xea, 0xb1, 0xdb, 0xa5, 0x57, 0x9e, 0x7b, 0x6e, 0xee, 0xf1,
+	0xbd, 0x67, 0xae, 0x4f, 0x60, 0xd9, 0x20, 0x43, 0x62, 0x62, 0x66, 0x51, 0xbb, 0x3e, 0x76, 0x28,
+	0xa3, 0x68, 0x41, 0x3c, 0x6e, 0xad, 0x99, 0x16, 0x1b, 0x4c, 0x7a, 0xf5, 0x3e, 0x1d, 0x35, 0x4c,
+	0x6a, 0xd2, 0x86, 0x80, 0x7b, 0x93, 0x03, 0x11, 0x89, 0x40, 0x9c, 0xc2, 0xaa, 0xea, 0x97, 0x2c,
+	0x5c, 0x69, 0x5f, 0x52, 0x6d, 0x63, 0x1b, 0x9b, 0x64, 0x44, 0x6c, 0x86, 0x1e, 0xc2, 0x52, 0x67,
+	0x32, 0xda, 0x39, 0x68, 0x51, 0x9b, 0x39, 0xb8, 0xcf, 0xdc, 0x0a, 0x58, 0x05, 0xb5, 0xa2, 0x86,
+	0x02, 0x4f, 0x4d, 0x64, 0xf4, 0x44, 0x8c, 0xee, 0xc0, 0xa5, 0x2d, 0xec, 0xb2, 0xa6, 0x61, 0x38,
+	0xc4, 0x75, 0x2b, 0xd9, 0x55, 0x50, 0x2b, 0x68, 0xcb, 0x81, 0xa7, 0xca, 0xb0, 0x2e, 0x07, 0xe8,
+	0x23, 0x80, 0x68, 0xdb, 0xb2, 0x5b, 0x0e, 0x11, 0x8d, 0xb4, 0xc9, 0x98, 0xba, 0x16, 0xab, 0xe4,
+	0x44, 0xe9, 0xab, 0xc0, 0x53, 0xaf, 0xc9, 0x7e, 0xff, 0xad, 0x36, 0x47, 0x98, 0x0d, 0x1a, 0x3d,
+	0xcb, 0xac, 0x6f, 0xda, 0xec, 0x91, 0x34, 0xfa, 0xfa, 0xd0, 0xa1, 0xb6, 0xd1, 0x21, 0xec, 0x98,
+	0x3a, 0x87, 0x0d, 0x22, 0xa2, 0x35, 0x93, 0x36, 0x0c, 0xcc, 0x70, 0x5d, 0xb3, 0xcc, 0x4d, 0x9b,
+	0xb5, 0xb0, 0xcb, 0x88, 0xa3, 0x5f, 0x43, 0x5c, 0x5d, 0x87, 0x37, 0x23, 0x51, 0xe6, 0x73, 0x6d,
+	0x59, 0x2e, 0x43, 0xb7, 0xe1, 0xe2, 0xac, 0x65, 0xc2, 0x15, 0xc9, 0xd5, 0x0a, 0x5a, 0x31, 0xf0,
+	0xd4, 0x08, 0xd4, 0xa3, 0x63, 0xf5, 0x47, 0x16, 0x96, 0x63, 0x3c, 0x07, 0x96, 0x89, 0xee, 0xc3,
+	0xc2, 0xce, 0xb1, 0x4d, 0x9c, 0xb9, 0x3a, 0x40, 0x8c, 0x58, 0x0e, 0x3c, 0x35, 0x86, 0xeb, 0xb1,
+	0x08, 0x7d, 0x00, 0xb0, 0xbc, 0x8d, 0x5f, 0x4b, 0x6c, 0x78, 0x3c, 0x13, 0xf6, 0x45, 0xe0, 0xa9,
+	0x57, 0x72, 0xe9, 0x68, 0x73, 0x85, 0x16, 0xd5, 0x21, 0xec, 0x12, 0xe7, 0xc8, 0xea, 0x93, 0x0d,
+	0x42, 0xc4, 0xf5, 0xe4, 0xb5, 0x52, 0xe0, 0xa9, 0x12, 0xaa, 0x4b, 0x67, 0x3e, 0xad, 0x10, 0x97,
+	0x18, 0xeb, 0x63, 0xda, 0x1f, 0x54, 0xf2, 0x62, 0x89, 0xc4, 0xb4, 0x32, 0xae, 0xc7, 0xa2, 0xea,
+	0xa7, 0x3c, 0x2c, 0x3d, 0x1e, 0xd2, 0x1e, 0x1e, 0x6e, 0x4c, 0x6c, 0xa3, 0x8d, 0x19, 0x46, 0x47,
+	0x70, 0x69, 0x97, 0x32, 0x3c, 0x6c, 0xf6, 0x99, 0x75, 0x44, 0x66, 0xaa, 0xed, 0xf2, 0x9d, 0x92,
+	0xe0, 0x74, 0xa6, 0x96, 0x19, 0xd1, 0x1b, 0x58, 0x14, 0xe1, 0x9e, 0xdd, 0x65, 0xf8, 0x90, 0x18,
+	0x33, 0xd1, 0xf7, 0x03, 0x4f, 0x8d, 0x27, 0xd2, 0x79, 0x77, 0x9c, 0x13, 0xbd, 0x07, 0x70, 0x39,
+	0x3c, 0x6e, 0xda, 0xcd, 0x49, 0x9f, 0xdf, 0xc2, 0xec, 0x9b, 0x78, 0x1e, 0x78, 0x6a, 0x32, 0x95,
+	0x4e, 0x0b, 0x49, 0x56, 0xf4, 0x16, 0x96, 0x74, 0x72, 0x8c, 0x1d, 0xe3, 0x29, 0x71, 0xba, 0x03,
+	0xec, 0x10, 0x71, 0x8b, 0x05, 0xed, 0x19, 0xb7, 0x82, 0x78, 0x26, 0x9d, 0x0e, 0x12, 0xa4, 0xd5,
+	0xaf, 0x00, 0x16, 0xe6, 0x92, 0xf0, 0x85, 0x40, 0x06, 0x5c, 0xd8, 0xc7, 0xc3, 0xc9, 0x7c, 0x0d,
+	0x3a, 0x81, 0xa7, 0x86, 0x40, 0x3a, 0xef, 0x0f, 0xb9, 0x90, 0x0a, 0x17, 0xc2, 0xa5, 0xcd, 0x8a,
+	0xa5, 0x5d, 0xe4, 0x6f, 0x09, 0xb7, 0x35, 0x7c, 0x54, 0x7f, 0xe5, 0x60, 0x71, 0xf6, 0x79, 0x50,
+	0x47, 0x6c, 0xa9, 0x0b, 0x61, 0xb8, 0x37, 0xbc, 0xcd, 0x59, 0x77, 0x5d, 0xfe, 0x79, 0x44, 0x68,
+	0x3a, 0x2d, 0x4a, 0x84, 0x68, 0x0b, 0x16, 0x65, 0x75, 0xb8, 0xe1, 0xe6, 0x6a, 0x4b, 0x77, 0x6f,
+	0x84, 0x16, 0x5f, 0x97, 0x73, 0xda, 0xff, 0x7c, 0x6f, 0x63, 0xbf, 0xd6, 0xe3, 0x21, 0x1f, 0x21,
+	0x94, 0xbf, 0x4d, 0x7a, 0x73, 0x03, 0x16, 0x23, 0x44, 0x68, 0x4a, 0x23, 0x44, 0x84, 0xc2, 0xde,
+	0xf6, 0xec, 0xd6, 0x10, 0x5b, 0x23, 0x62, 0x84, 0xb8, 0x5b, 0xc9, 0x47, 0xf6, 0x96, 0xcc, 0xa5,
+	0x64, 0x6f, 0x49, 0xda, 0xea, 0x14, 0xc0, 0xc5, 0x0e, 0x35, 0x88, 0x2b, 0x6e, 0x93, 0x9b, 0x9d,
+	0x50, 0xe6, 0x09, 0x39, 0x99, 0xbb, 0x7d, 0x68, 0x76, 0x97, 0xa8, 0x2e, 0x9d, 0xd1, 0x03, 0x58,
+	0xec, 0x50, 0x26, 0x95, 0x64, 0x45, 0x89, 0xd0, 0x3c, 0x96, 0xd0, 0xe3, 0x61, 0xac, 0xb0, 0x6b,
+	0x99, 0x6e, 0x25, 0x77, 0x4d, 0x21, 0x4f, 0xe8, 0xf1, 0x90, 0xdb, 0xeb, 0x9e, 0x1d, 0x11, 0x55,
+	0xf2, 0xa2, 0x4e, 0xd8, 0xab, 0x8c, 0xeb, 0xb1, 0x48, 0xeb, 0x9c, 0x9e, 0x2b, 0x99, 0xb3, 0x73,
+	0x25, 0x73, 0x71, 0xae, 0x80, 0x77, 0xbe, 0x02, 0xbe, 0xf9, 0x0a, 0xf8, 0xe9, 0x2b, 0xe0, 0xd4,
+	0x57, 0xc0, 0x99, 0xaf, 0x80, 0x3f, 0xbe, 0x02, 0xfe, 0xfa, 0x4a, 0xe6, 0xc2, 0x57, 0xc0, 0xe7,
+	0xa9, 0x92, 0x39, 0x9d, 0x2a, 0x99, 0xb3, 0xa9, 0x92, 0x79, 0xb9, 0xe2, 0x9e, 0xb8, 0x8c, 0x8c,
+	0xba, 0x23, 0xec, 0xb0, 0xcb, 0xff, 0xfb, 0xde, 0x7f, 0x62, 0xd1, 0xee, 0xfd, 0x1b, 0x00, 0xee,
+	0x29, 0x4a, 0x53, 0x95, 0x08, 0x00, 0x00,
+}
+
+func (this *DelegationManagement) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegationManagement)
+	if !ok {
+		that2, ok := that.(DelegationManagement)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumOfContracts != that1.NumOfContracts {
+		return false
+	}
+	if !bytes.Equal(this.LastAddress, that1.LastAddress) {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.MinCreationDeposit, that1.MinCreationDeposit) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DelegationContractList) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegationContractList)
+	if !ok {
+		that2, ok := that.(DelegationContractList)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if !bytes.Equal(this.Addresses[i], that1.Addresses[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DelegationConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegationConfig)
+	if !ok {
+		that2, ok := that.(DelegationConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.OwnerAddress, that1.OwnerAddress) {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.MaxDelegationCap, that1.MaxDelegationCap) {
+			return false
+		}
+	}
+	if this.ServiceFee != that1.ServiceFee {
+		return false
+	}
+	if this.CreatedEpoch != that1.CreatedEpoch {
+		return false
+	}
+	return true
+}
+func (this *GlobalFundData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GlobalFundData)
+	if !ok {
+		that2, ok := that.(GlobalFundData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TotalActive, that1.TotalActive) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.TotalUnStaked, that1.TotalUnStaked) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.StakedInAuction, that1.StakedInAuction) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.RewardPerShare, that1.RewardPerShare) {
+			return false
+		}
+	}
+	return true
+}
+func (this *UnStakedFund) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnStakedFund)
+	if !ok {
+		that2, ok := that.(UnStakedFund)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.Value, that1.Value) {
+			return false
+		}
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	return true
+}
+func (this *DelegatorData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatorData)
+	if !ok {
+		that2, ok := that.(DelegatorData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.ActiveFund, that1.ActiveFund) {
+			return false
+		}
+	}
+	if len(this.UnStakedFunds) != len(that1.UnStakedFunds) {
+		return false
+	}
+	for i := range this.UnStakedFunds {
+		if !this.UnStakedFunds[i].Equal(that1.UnStakedFunds[i]) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.RewardDebt, that1.RewardDebt) {
+			return false
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		if !__caster.Equal(this.UnClaimedRewards, that1.UnClaimedRewards) {
+			return false
+		}
+	}
+	return true
+}
+func (this *NodesData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NodesData)
+	if !ok {
+		that2, ok := that.(NodesData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.StakedKeys) != len(that1.StakedKeys) {
+		return false
+	}
+	for i := range this.StakedKeys {
+		if !bytes.Equal(this.StakedKeys[i], that1.StakedKeys[i]) {
+			return false
+		}
+	}
+	if len(this.NotStakedKeys) != len(that1.NotStakedKeys) {
+		return false
+	}
+	for i := range this.NotStakedKeys {
+		if !bytes.Equal(this.NotStakedKeys[i], that1.NotStakedKeys[i]) {
+			return false
+		}
+	}
+	if len(this.NotStakedSigs) != len(that1.NotStakedSigs) {
+		return false
+	}
+	for i := range this.NotStakedSigs {
+		if !bytes.Equal(this.NotStakedSigs[i], that1.NotStakedSigs[i]) {
+			return false
+		}
+	}
+	if len(this.UnStakedKeys) != len(that1.UnStakedKeys) {
+		return false
+	}
+	for i := range this.UnStakedKeys {
+		if !bytes.Equal(this.UnStakedKeys[i], that1.UnStakedKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DelegationManagement) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&systemSmartContracts.DelegationManagement{")
+	s = append(s, "NumOfContracts: "+fmt.Sprintf("%#v", this.NumOfContracts)+",\n")
+	s = append(s, "LastAddress: "+fmt.Sprintf("%#v", this.LastAddress)+",\n")
+	s = append(s, "MinCreationDeposit: "+fmt.Sprintf("%#v", this.MinCreationDeposit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DelegationContractList) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.DelegationContractList{")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DelegationConfig) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&systemSmartContracts.DelegationConfig{")
+	s = append(s, "OwnerAddress: "+fmt.Sprintf("%#v", this.OwnerAddress)+",\n")
+	s = append(s, "MaxDelegationCap: "+fmt.Sprintf("%#v", this.MaxDelegationCap)+",\n")
+	s = append(s, "ServiceFee: "+fmt.Sprintf("%#v", this.ServiceFee)+",\n")
+	s = append(s, "CreatedEpoch: "+fmt.Sprintf("%#v", this.CreatedEpoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GlobalFundData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&systemSmartContracts.GlobalFundData{")
+	s = append(s, "TotalActive: "+fmt.Sprintf("%#v", this.TotalActive)+",\n")
+	s = append(s, "TotalUnStaked: "+fmt.Sprintf("%#v", this.TotalUnStaked)+",\n")
+	s = append(s, "StakedInAuction: "+fmt.Sprintf("%#v", this.StakedInAuction)+",\n")
+	s = append(s, "RewardPerShare: "+fmt.Sprintf("%#v", this.RewardPerShare)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UnStakedFund) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.UnStakedFund{")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DelegatorData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&systemSmartContracts.DelegatorData{")
+	s = append(s, "ActiveFund: "+fmt.Sprintf("%#v", this.ActiveFund)+",\n")
+	if this.UnStakedFunds != nil {
+		s = append(s, "UnStakedFunds: "+fmt.Sprintf("%#v", this.UnStakedFunds)+",\n")
+	}
+	s = append(s, "RewardDebt: "+fmt.Sprintf("%#v", this.RewardDebt)+",\n")
+	s = append(s, "UnClaimedRewards: "+fmt.Sprintf("%#v", this.UnClaimedRewards)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NodesData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&systemSmartContracts.NodesData{")
+	s = append(s, "StakedKeys: "+fmt.Sprintf("%#v", this.StakedKeys)+",\n")
+	s = append(s, "NotStakedKeys: "+fmt.Sprintf("%#v", this.NotStakedKeys)+",\n")
+	s = append(s, "NotStakedSigs: "+fmt.Sprintf("%#v", this.NotStakedSigs)+",\n")
+	s = append(s, "UnStakedKeys: "+fmt.Sprintf("%#v", this.UnStakedKeys)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDelegation(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DelegationManagement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationManagement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationManagement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.MinCreationDeposit)
+		i -= size
+		if _, err := __caster.MarshalTo(m.MinCreationDeposit, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.LastAddress) > 0 {
+		i -= len(m.LastAddress)
+		copy(dAtA[i:], m.LastAddress)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.LastAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NumOfContracts != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.NumOfContracts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationContractList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationContractList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationContractList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintDelegation(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedEpoch != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.CreatedEpoch))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ServiceFee != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.ServiceFee))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.MaxDelegationCap)
+		i -= size
+		if _, err := __caster.MarshalTo(m.MaxDelegationCap, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GlobalFundData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalFundData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalFundData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.RewardPerShare)
+		i -= size
+		if _, err := __caster.MarshalTo(m.RewardPerShare, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.StakedInAuction)
+		i -= size
+		if _, err := __caster.MarshalTo(m.StakedInAuction, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.TotalUnStaked)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TotalUnStaked, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.TotalActive)
+		i -= size
+		if _, err := __caster.MarshalTo(m.TotalActive, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UnStakedFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnStakedFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnStakedFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintDelegation(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.Value)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Value, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.UnClaimedRewards)
+		i -= size
+		if _, err := __caster.MarshalTo(m.UnClaimedRewards, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.RewardDebt)
+		i -= size
+		if _, err := __caster.MarshalTo(m.RewardDebt, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.UnStakedFunds) > 0 {
+		for iNdEx := len(m.UnStakedFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnStakedFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		size := __caster.Size(m.ActiveFund)
+		i -= size
+		if _, err := __caster.MarshalTo(m.ActiveFund, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NodesData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodesData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodesData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnStakedKeys) > 0 {
+		for iNdEx := len(m.UnStakedKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UnStakedKeys[iNdEx])
+			copy(dAtA[i:], m.UnStakedKeys[iNdEx])
+			i = encodeVarintDelegation(dAtA, i, uint64(len(m.UnStakedKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.NotStakedSigs) > 0 {
+		for iNdEx := len(m.NotStakedSigs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NotStakedSigs[iNdEx])
+			copy(dAtA[i:], m.NotStakedSigs[iNdEx])
+			i = encodeVarintDelegation(dAtA, i, uint64(len(m.NotStakedSigs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NotStakedKeys) > 0 {
+		for iNdEx := len(m.NotStakedKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NotStakedKeys[iNdEx])
+			copy(dAtA[i:], m.NotStakedKeys[iNdEx])
+			i = encodeVarintDelegation(dAtA, i, uint64(len(m.NotStakedKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.StakedKeys) > 0 {
+		for iNdEx := len(m.StakedKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StakedKeys[iNdEx])
+			copy(dAtA[i:], m.StakedKeys[iNdEx])
+			i = encodeVarintDelegation(dAtA, i, uint64(len(m.StakedKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelegation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelegation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DelegationManagement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumOfContracts != 0 {
+		n += 1 + sovDelegation(uint64(m.NumOfContracts))
+	}
+	l = len(m.LastAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.MinCreationDeposit)
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationContractList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, b := range m.Addresses {
+			l = len(b)
+			n += 1 + l + sovDelegation(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.MaxDelegationCap)
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	if m.ServiceFee != 0 {
+		n += 1 + sovDelegation(uint64(m.ServiceFee))
+	}
+	if m.CreatedEpoch != 0 {
+		n += 1 + sovDelegation(uint64(m.CreatedEpoch))
+	}
+	return n
+}
+
+func (m *GlobalFundData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.TotalActive)
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.TotalUnStaked)
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.StakedInAuction)
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.RewardPerShare)
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func (m *UnStakedFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.Value)
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovDelegation(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *DelegatorData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.ActiveFund)
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	if len(m.UnStakedFunds) > 0 {
+		for _, e := range m.UnStakedFunds {
+			l = e.Size()
+			n += 1 + l + sovDelegation(uint64(l))
+		}
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.RewardDebt)
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+		l = __caster.Size(m.UnClaimedRewards)
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	return n
+}
+
+func (m *NodesData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StakedKeys) > 0 {
+		for _, b := range m.StakedKeys {
+			l = len(b)
+			n += 1 + l + sovDelegation(uint64(l))
+		}
+	}
+	if len(m.NotStakedKeys) > 0 {
+		for _, b := range m.NotStakedKeys {
+			l = len(b)
+			n += 1 + l + sovDelegation(uint64(l))
+		}
+	}
+	if len(m.NotStakedSigs) > 0 {
+		for _, b := range m.NotStakedSigs {
+			l = len(b)
+			n += 1 + l + sovDelegation(uint64(l))
+		}
+	}
+	if len(m.UnStakedKeys) > 0 {
+		for _, b := range m.UnStakedKeys {
+			l = len(b)
+			n += 1 + l + sovDelegation(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDelegation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDelegation(x uint64) (n int) {
+	return sovDelegation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DelegationManagement) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DelegationManagement{`,
+		`NumOfContracts:` + fmt.Sprintf("%v", this.NumOfContracts) + `,`,
+		`LastAddress:` + fmt.Sprintf("%v", this.LastAddress) + `,`,
+		`MinCreationDeposit:` + fmt.Sprintf("%v", this.MinCreationDeposit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DelegationContractList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DelegationContractList{`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DelegationConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DelegationConfig{`,
+		`OwnerAddress:` + fmt.Sprintf("%v", this.OwnerAddress) + `,`,
+		`MaxDelegationCap:` + fmt.Sprintf("%v", this.MaxDelegationCap) + `,`,
+		`ServiceFee:` + fmt.Sprintf("%v", this.ServiceFee) + `,`,
+		`CreatedEpoch:` + fmt.Sprintf("%v", this.CreatedEpoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GlobalFundData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GlobalFundData{`,
+		`TotalActive:` + fmt.Sprintf("%v", this.TotalActive) + `,`,
+		`TotalUnStaked:` + fmt.Sprintf("%v", this.TotalUnStaked) + `,`,
+		`StakedInAuction:` + fmt.Sprintf("%v", this.StakedInAuction) + `,`,
+		`RewardPerShare:` + fmt.Sprintf("%v", this.RewardPerShare) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UnStakedFund) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UnStakedFund{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DelegatorData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForUnStakedFunds := "[]*UnStakedFund{"
+	for _, f := range this.UnStakedFunds {
+		repeatedStringForUnStakedFunds += strings.Replace(f.String(), "UnStakedFund", "UnStakedFund", 1) + ","
+	}
+	repeatedStringForUnStakedFunds += "}"
+	s := strings.Join([]string{`&DelegatorData{`,
+		`ActiveFund:` + fmt.Sprintf("%v", this.ActiveFund) + `,`,
+		`UnStakedFunds:` + repeatedStringForUnStakedFunds + `,`,
+		`RewardDebt:` + fmt.Sprintf("%v", this.RewardDebt) + `,`,
+		`UnClaimedRewards:` + fmt.Sprintf("%v", this.UnClaimedRewards) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodesData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NodesData{`,
+		`StakedKeys:` + fmt.Sprintf("%v", this.StakedKeys) + `,`,
+		`NotStakedKeys:` + fmt.Sprintf("%v", this.NotStakedKeys) + `,`,
+		`NotStakedSigs:` + fmt.Sprintf("%v", this.NotStakedSigs) + `,`,
+		`UnStakedKeys:` + fmt.Sprintf("%v", this.UnStakedKeys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDelegation(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DelegationManagement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationManagement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationManagement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfContracts", wireType)
+			}
+			m.NumOfContracts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfContracts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastAddress = append(m.LastAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.LastAddress == nil {
+				m.LastAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCreationDeposit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.MinCreationDeposit = tmp
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationContractList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationContractList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationContractList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = append(m.OwnerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OwnerAddress == nil {
+				m.OwnerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegationCap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.MaxDelegationCap = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceFee", wireType)
+			}
+			m.ServiceFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServiceFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedEpoch", wireType)
+			}
+			m.CreatedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalFundData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalFundData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalFundData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalActive", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TotalActive = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUnStaked", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.TotalUnStaked = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedInAuction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.StakedInAuction = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerShare", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.RewardPerShare = tmp
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnStakedFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnStakedFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnStakedFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Value = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveFund", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.ActiveFund = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnStakedFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnStakedFunds = append(m.UnStakedFunds, &UnStakedFund{})
+			if err := m.UnStakedFunds[len(m.UnStakedFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDebt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.RewardDebt = tmp
+				}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnClaimedRewards", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.UnClaimedRewards = tmp
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodesData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodesData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodesData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakedKeys = append(m.StakedKeys, make([]byte, postIndex-iNdEx))
+			copy(m.StakedKeys[len(m.StakedKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotStakedKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotStakedKeys = append(m.NotStakedKeys, make([]byte, postIndex-iNdEx))
+			copy(m.NotStakedKeys[len(m.NotStakedKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotStakedSigs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotStakedSigs = append(m.NotStakedSigs, make([]byte, postIndex-iNdEx))
+			copy(m.NotStakedSigs[len(m.NotStakedSigs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnStakedKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnStakedKeys = append(m.UnStakedKeys, make([]byte, postIndex-iNdEx))
+			copy(m.UnStakedKeys[len(m.UnStakedKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDelegation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDelegation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDelegation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDelegation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDelegation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDelegation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDelegation = fmt.Errorf("proto: unexpected end of group")
+)
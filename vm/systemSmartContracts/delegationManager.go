@@ -0,0 +1,309 @@
+package systemSmartContracts
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const delegationManagementKey = "delegationManagement"
+const delegationContractsListKey = "delegationContracts"
+const delegationContractOwnerPrefix = "delegationContractOwner"
+const delegationContractCode = "delegation"
+const initFromManagerFunction = "initFromManager"
+
+// ArgsNewDelegationManager defines the arguments to create the delegation manager system smart contract
+type ArgsNewDelegationManager struct {
+	DelegationMgrSCConfig  config.DelegationManagerSystemSCConfig
+	Eei                    vm.SystemEI
+	DelegationMgrSCAddress []byte
+	GasCost                vm.GasCost
+	Marshalizer            marshal.Marshalizer
+	EpochNotifier          vm.EpochNotifier
+}
+
+type delegationManager struct {
+	eei                    vm.SystemEI
+	delegationMgrSCAddress []byte
+	gasCost                vm.GasCost
+	marshalizer            marshal.Marshalizer
+	minCreationDeposit     *big.Int
+	enabledEpoch           uint32
+	flagEnabled            atomic.Flag
+}
+
+// NewDelegationManagerSystemSC creates a new delegation manager system smart contract
+func NewDelegationManagerSystemSC(args ArgsNewDelegationManager) (*delegationManager, error) {
+	if check.IfNil(args.Eei) {
+		return nil, vm.ErrNilSystemEnvironmentInterface
+	}
+	if len(args.DelegationMgrSCAddress) == 0 {
+		return nil, vm.ErrNilDelegationManagerSmartContractAddress
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, vm.ErrNilMarshalizer
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, vm.ErrNilEpochNotifier
+	}
+
+	minCreationDeposit, okConvert := big.NewInt(0).SetString(args.DelegationMgrSCConfig.MinCreationDeposit, conversionBase)
+	if !okConvert || minCreationDeposit.Cmp(zero) < 0 {
+		return nil, fmt.Errorf("%w, value is %v", vm.ErrInvalidMinCreationDeposit, args.DelegationMgrSCConfig.MinCreationDeposit)
+	}
+
+	d := &delegationManager{
+		eei:                    args.Eei,
+		delegationMgrSCAddress: args.DelegationMgrSCAddress,
+		gasCost:                args.GasCost,
+		marshalizer:            args.Marshalizer,
+		minCreationDeposit:     minCreationDeposit,
+		enabledEpoch:           args.DelegationMgrSCConfig.EnabledEpoch,
+	}
+	args.EpochNotifier.RegisterNotifyHandler(d)
+
+	return d, nil
+}
+
+// Execute calls one of the functions from the delegation manager contract and runs the code according to the input
+func (d *delegationManager) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if CheckIfNil(args) != nil {
+		return vmcommon.UserError
+	}
+
+	if args.Function == core.SCDeployInitFunctionName {
+		return d.init(args)
+	}
+
+	if !d.flagEnabled.IsSet() {
+		d.eei.AddReturnMessage("delegation manager contract is not enabled")
+		return vmcommon.UserError
+	}
+
+	switch args.Function {
+	case "createNewDelegationContract":
+		return d.createNewDelegationContract(args)
+	case "getAllContractAddresses":
+		return d.getAllContractAddresses(args)
+	case "getContractAddress":
+		return d.getContractAddress(args)
+	}
+
+	d.eei.AddReturnMessage("invalid function to call")
+	return vmcommon.FunctionNotFound
+}
+
+func (d *delegationManager) init(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+
+	managementData := &DelegationManagement{
+		NumOfContracts:     0,
+		LastAddress:        vm.FirstDelegationSCAddress,
+		MinCreationDeposit: d.minCreationDeposit,
+	}
+	err := d.saveDelegationManagementData(managementData)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save delegation management data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	d.eei.SetStorage([]byte(ownerKey), args.CallerAddr)
+
+	return vmcommon.Ok
+}
+
+func (d *delegationManager) createNewDelegationContract(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if len(args.Arguments) != 2 {
+		d.eei.AddReturnMessage("invalid number of arguments, expected max delegation cap and service fee")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.DelegationMgrOps)
+	if err != nil {
+		d.eei.AddReturnMessage(vm.InsufficientGasLimit)
+		return vmcommon.OutOfGas
+	}
+
+	managementData, err := d.getDelegationManagementData()
+	if err != nil {
+		d.eei.AddReturnMessage("cannot get delegation management data: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(managementData.MinCreationDeposit) < 0 {
+		d.eei.AddReturnMessage("not enough call value, expected min " + managementData.MinCreationDeposit.String())
+		return vmcommon.OutOfFunds
+	}
+
+	contractOwnerKey := append([]byte(delegationContractOwnerPrefix), args.CallerAddr...)
+	if len(d.eei.GetStorage(contractOwnerKey)) > 0 {
+		d.eei.AddReturnMessage("caller already deployed a delegation contract")
+		return vmcommon.UserError
+	}
+
+	newAddress := vm.CreateDelegationSCAddress(managementData.NumOfContracts + 1)
+	d.eei.AddCode(newAddress, []byte(delegationContractCode))
+
+	txData := initFromManagerFunction + "@" + hex.EncodeToString(args.CallerAddr) + "@" +
+		hex.EncodeToString(args.Arguments[0]) + "@" + hex.EncodeToString(args.Arguments[1])
+	vmOutput, err := d.eei.ExecuteOnDestContext(newAddress, d.delegationMgrSCAddress, args.CallValue, []byte(txData))
+	if err != nil {
+		d.eei.AddReturnMessage("cannot initialize the delegation contract: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		d.eei.AddReturnMessage("cannot initialize the delegation contract: " + vmOutput.ReturnMessage)
+		return vmOutput.ReturnCode
+	}
+
+	managementData.NumOfContracts++
+	managementData.LastAddress = newAddress
+	err = d.saveDelegationManagementData(managementData)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save delegation management data: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	contractsList, err := d.getDelegationContractList()
+	if err != nil {
+		d.eei.AddReturnMessage("cannot get delegation contracts list: error " + err.Error())
+		return vmcommon.UserError
+	}
+	contractsList.Addresses = append(contractsList.Addresses, newAddress)
+	err = d.saveDelegationContractList(contractsList)
+	if err != nil {
+		d.eei.AddReturnMessage("cannot save delegation contracts list: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	d.eei.SetStorage(contractOwnerKey, newAddress)
+	d.eei.Finish(newAddress)
+
+	return vmcommon.Ok
+}
+
+func (d *delegationManager) getAllContractAddresses(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.Get)
+	if err != nil {
+		d.eei.AddReturnMessage(vm.InsufficientGasLimit)
+		return vmcommon.OutOfGas
+	}
+
+	contractsList, err := d.getDelegationContractList()
+	if err != nil {
+		d.eei.AddReturnMessage("cannot get delegation contracts list: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	for _, address := range contractsList.Addresses {
+		d.eei.Finish(address)
+	}
+
+	return vmcommon.Ok
+}
+
+func (d *delegationManager) getContractAddress(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if args.CallValue.Cmp(zero) != 0 {
+		d.eei.AddReturnMessage(vm.TransactionValueMustBeZero)
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 1 {
+		d.eei.AddReturnMessage("invalid number of arguments, expected the owner address")
+		return vmcommon.FunctionWrongSignature
+	}
+	err := d.eei.UseGas(d.gasCost.MetaChainSystemSCsCost.Get)
+	if err != nil {
+		d.eei.AddReturnMessage(vm.InsufficientGasLimit)
+		return vmcommon.OutOfGas
+	}
+
+	contractAddress := d.eei.GetStorage(append([]byte(delegationContractOwnerPrefix), args.Arguments[0]...))
+	if len(contractAddress) == 0 {
+		d.eei.AddReturnMessage("address does not own a delegation contract")
+		return vmcommon.UserError
+	}
+
+	d.eei.Finish(contractAddress)
+
+	return vmcommon.Ok
+}
+
+func (d *delegationManager) getDelegationManagementData() (*DelegationManagement, error) {
+	managementData := &DelegationManagement{
+		NumOfContracts:     0,
+		LastAddress:        vm.FirstDelegationSCAddress,
+		MinCreationDeposit: big.NewInt(0).Set(d.minCreationDeposit),
+	}
+
+	marshaledData := d.eei.GetStorage([]byte(delegationManagementKey))
+	if len(marshaledData) == 0 {
+		return managementData, nil
+	}
+
+	err := d.marshalizer.Unmarshal(managementData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return managementData, nil
+}
+
+func (d *delegationManager) saveDelegationManagementData(managementData *DelegationManagement) error {
+	marshaledData, err := d.marshalizer.Marshal(managementData)
+	if err != nil {
+		return err
+	}
+
+	d.eei.SetStorage([]byte(delegationManagementKey), marshaledData)
+	return nil
+}
+
+func (d *delegationManager) getDelegationContractList() (*DelegationContractList, error) {
+	contractsList := &DelegationContractList{Addresses: make([][]byte, 0)}
+
+	marshaledData := d.eei.GetStorage([]byte(delegationContractsListKey))
+	if len(marshaledData) == 0 {
+		return contractsList, nil
+	}
+
+	err := d.marshalizer.Unmarshal(contractsList, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return contractsList, nil
+}
+
+func (d *delegationManager) saveDelegationContractList(contractsList *DelegationContractList) error {
+	marshaledData, err := d.marshalizer.Marshal(contractsList)
+	if err != nil {
+		return err
+	}
+
+	d.eei.SetStorage([]byte(delegationContractsListKey), marshaledData)
+	return nil
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (d *delegationManager) EpochConfirmed(epoch uint32) {
+	d.flagEnabled.Toggle(epoch >= d.enabledEpoch)
+	log.Debug("delegation manager contract", "enabled", d.flagEnabled.IsSet())
+}
+
+// IsInterfaceNil returns true if underlying object is nil
+func (d *delegationManager) IsInterfaceNil() bool {
+	return d == nil
+}
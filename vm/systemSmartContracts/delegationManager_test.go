@@ -0,0 +1,193 @@
+package systemSmartContracts
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgumentsForDelegationManager() ArgsNewDelegationManager {
+	return ArgsNewDelegationManager{
+		DelegationMgrSCConfig: config.DelegationManagerSystemSCConfig{
+			MinCreationDeposit: "1000",
+		},
+		Eei:                    &mock.SystemEIStub{},
+		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
+		GasCost:                vm.GasCost{MetaChainSystemSCsCost: vm.MetaChainSystemSCsCost{DelegationMgrOps: 10}},
+		Marshalizer:            &mock.MarshalizerMock{},
+		EpochNotifier:          &mock.EpochNotifierStub{},
+	}
+}
+
+func createDelegationManagerWithDelegation(t *testing.T) (*delegationManager, *vmContext) {
+	atArgParser := parsers.NewCallArgsParser()
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), atArgParser, &mock.AccountsStub{}, &mock.RaterMock{})
+	eei.EpochConfirmed(0)
+
+	argsManager := createMockArgumentsForDelegationManager()
+	argsManager.Eei = eei
+	manager, err := NewDelegationManagerSystemSC(argsManager)
+	require.Nil(t, err)
+
+	argsDelegation := createMockArgumentsForDelegation()
+	argsDelegation.Eei = eei
+	delegationSC, err := NewDelegationSystemSC(argsDelegation)
+	require.Nil(t, err)
+
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+		if vm.IsDelegationSCAddress(key) {
+			return delegationSC, nil
+		}
+		return nil, errors.New("unknown contract")
+	}})
+	eei.SetSCAddress(vm.DelegationManagerSCAddress)
+
+	return manager, eei
+}
+
+func TestNewDelegationManagerSystemSC_NilEeiShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegationManager()
+	args.Eei = nil
+
+	d, err := NewDelegationManagerSystemSC(args)
+	assert.Nil(t, d)
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+}
+
+func TestNewDelegationManagerSystemSC_NilAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegationManager()
+	args.DelegationMgrSCAddress = nil
+
+	d, err := NewDelegationManagerSystemSC(args)
+	assert.Nil(t, d)
+	assert.Equal(t, vm.ErrNilDelegationManagerSmartContractAddress, err)
+}
+
+func TestNewDelegationManagerSystemSC_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegationManager()
+	args.Marshalizer = nil
+
+	d, err := NewDelegationManagerSystemSC(args)
+	assert.Nil(t, d)
+	assert.Equal(t, vm.ErrNilMarshalizer, err)
+}
+
+func TestNewDelegationManagerSystemSC_NilEpochNotifierShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegationManager()
+	args.EpochNotifier = nil
+
+	d, err := NewDelegationManagerSystemSC(args)
+	assert.Nil(t, d)
+	assert.Equal(t, vm.ErrNilEpochNotifier, err)
+}
+
+func TestNewDelegationManagerSystemSC_InvalidMinCreationDepositShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegationManager()
+	args.DelegationMgrSCConfig.MinCreationDeposit = "abc"
+
+	d, err := NewDelegationManagerSystemSC(args)
+	assert.Nil(t, d)
+	assert.True(t, errors.Is(err, vm.ErrInvalidMinCreationDeposit))
+}
+
+func TestDelegationManagerSystemSC_ExecuteNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegationManager()
+	args.DelegationMgrSCConfig.EnabledEpoch = 10
+	d, _ := NewDelegationManagerSystemSC(args)
+
+	vmInput := createVMInput(big.NewInt(1000), "createNewDelegationContract", []byte("owner"), vm.DelegationManagerSCAddress)
+	retCode := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	d.EpochConfirmed(10)
+	vmInput.Function = "unknownFunction"
+	retCode = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionNotFound, retCode)
+}
+
+func TestDelegationManagerSystemSC_CreateNewDelegationContractNotEnoughValueShouldErr(t *testing.T) {
+	t.Parallel()
+
+	d, eei := createDelegationManagerWithDelegation(t)
+	eei.gasRemaining = 100
+
+	vmInput := createVMInput(big.NewInt(999), "createNewDelegationContract", []byte("owner"), vm.DelegationManagerSCAddress)
+	vmInput.Arguments = [][]byte{big.NewInt(0).Bytes(), big.NewInt(10).Bytes()}
+	retCode := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.OutOfFunds, retCode)
+}
+
+func TestDelegationManagerSystemSC_CreateNewDelegationContractShouldWork(t *testing.T) {
+	t.Parallel()
+
+	d, eei := createDelegationManagerWithDelegation(t)
+	eei.gasRemaining = 100
+
+	initInput := createVMInput(big.NewInt(0), core.SCDeployInitFunctionName, vm.DelegationManagerSCAddress, vm.DelegationManagerSCAddress)
+	assert.Equal(t, vmcommon.Ok, d.Execute(initInput))
+
+	owner := []byte("owner")
+	vmInput := createVMInput(big.NewInt(1000), "createNewDelegationContract", owner, vm.DelegationManagerSCAddress)
+	vmInput.Arguments = [][]byte{big.NewInt(5000).Bytes(), big.NewInt(1000).Bytes()}
+	retCode := d.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	expectedAddress := vm.CreateDelegationSCAddress(1)
+	require.Equal(t, [][]byte{expectedAddress}, eei.output)
+
+	dConfig := &DelegationConfig{}
+	_ = d.marshalizer.Unmarshal(dConfig, eei.GetStorageFromAddress(expectedAddress, []byte(delegationConfigKey)))
+	assert.Equal(t, owner, dConfig.OwnerAddress)
+	assert.Equal(t, uint64(1000), dConfig.ServiceFee)
+	assert.Equal(t, big.NewInt(5000), dConfig.MaxDelegationCap)
+
+	globalFund := &GlobalFundData{}
+	_ = d.marshalizer.Unmarshal(globalFund, eei.GetStorageFromAddress(expectedAddress, []byte(globalFundKey)))
+	assert.Equal(t, big.NewInt(1000), globalFund.TotalActive)
+
+	retCode = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Contains(t, eei.returnMessage, "caller already deployed a delegation contract")
+
+	eei.output = make([][]byte, 0)
+	vmInput = createVMInput(big.NewInt(0), "getAllContractAddresses", owner, vm.DelegationManagerSCAddress)
+	eei.gasRemaining = 100
+	retCode = d.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, [][]byte{expectedAddress}, eei.output)
+}
+
+func TestDelegationManagerSystemSC_CreateNewDelegationContractInvalidServiceFeeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	d, eei := createDelegationManagerWithDelegation(t)
+	eei.gasRemaining = 100
+
+	vmInput := createVMInput(big.NewInt(1000), "createNewDelegationContract", []byte("owner"), vm.DelegationManagerSCAddress)
+	vmInput.Arguments = [][]byte{big.NewInt(0).Bytes(), big.NewInt(10001).Bytes()}
+	retCode := d.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Contains(t, eei.returnMessage, "invalid service fee")
+}
@@ -0,0 +1,341 @@
+package systemSmartContracts
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/mock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgumentsForDelegation() ArgsNewDelegation {
+	return ArgsNewDelegation{
+		DelegationSCConfig: config.DelegationSystemSCConfig{
+			MinDelegationAmount:  "10",
+			MinServiceFee:        0,
+			MaxServiceFee:        10000,
+			UnBondPeriodInEpochs: 2,
+		},
+		Eei:                    &mock.SystemEIStub{},
+		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
+		AuctionSCAddress:       vm.AuctionSCAddress,
+		EndOfEpochAddress:      vm.EndOfEpochAddress,
+		GasCost:                vm.GasCost{MetaChainSystemSCsCost: vm.MetaChainSystemSCsCost{DelegationOps: 1}},
+		Marshalizer:            &mock.MarshalizerMock{},
+		EpochNotifier:          &mock.EpochNotifierStub{},
+	}
+}
+
+type delegationTestContext struct {
+	eei          *vmContext
+	delegationSC *delegation
+	poolAddress  []byte
+	owner        []byte
+	currentEpoch uint32
+}
+
+func createDelegationTestContext(t *testing.T, ownerDeposit int64, maxCap int64, serviceFee int64) *delegationTestContext {
+	ctx := &delegationTestContext{
+		poolAddress: vm.CreateDelegationSCAddress(1),
+		owner:       []byte("owner"),
+	}
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentEpochCalled: func() uint32 {
+			return ctx.currentEpoch
+		},
+	}
+
+	atArgParser := parsers.NewCallArgsParser()
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), atArgParser, &mock.AccountsStub{}, &mock.RaterMock{})
+	eei.EpochConfirmed(0)
+	ctx.eei = eei
+
+	argsStaking := createMockStakingScArguments()
+	argsStaking.Eei = eei
+	argsStaking.StakingAccessAddr = vm.AuctionSCAddress
+	argsStaking.StakingSCConfig.GenesisNodePrice = "1000"
+	stakingSC, _ := NewStakingSmartContract(argsStaking)
+
+	argsAuction := createMockArgumentsForAuction()
+	argsAuction.Eei = eei
+	argsAuction.StakingSCAddress = vm.StakingSCAddress
+	argsAuction.AuctionSCAddress = vm.AuctionSCAddress
+	auctionSC, _ := NewStakingAuctionSmartContract(argsAuction)
+
+	argsDelegation := createMockArgumentsForDelegation()
+	argsDelegation.Eei = eei
+	delegationSC, err := NewDelegationSystemSC(argsDelegation)
+	require.Nil(t, err)
+	ctx.delegationSC = delegationSC
+
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+		switch {
+		case vm.IsDelegationSCAddress(key):
+			return delegationSC, nil
+		case string(key) == string(vm.AuctionSCAddress):
+			return auctionSC, nil
+		case string(key) == string(vm.StakingSCAddress):
+			return stakingSC, nil
+		}
+		return nil, errors.New("unknown contract")
+	}})
+
+	vmInput := createVMInput(big.NewInt(ownerDeposit), initFromManagerFunction, vm.DelegationManagerSCAddress, ctx.poolAddress)
+	vmInput.Arguments = [][]byte{ctx.owner, big.NewInt(maxCap).Bytes(), big.NewInt(serviceFee).Bytes()}
+	retCode := ctx.execute(vmInput)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	return ctx
+}
+
+func (ctx *delegationTestContext) execute(vmInput *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	ctx.eei.returnMessage = ""
+	ctx.eei.output = make([][]byte, 0)
+	ctx.eei.gasRemaining = 1000
+	ctx.eei.SetSCAddress(ctx.poolAddress)
+
+	return ctx.delegationSC.Execute(vmInput)
+}
+
+func (ctx *delegationTestContext) call(caller []byte, value int64, function string, arguments ...[]byte) vmcommon.ReturnCode {
+	vmInput := createVMInput(big.NewInt(value), function, caller, ctx.poolAddress)
+	vmInput.Arguments = arguments
+
+	return ctx.execute(vmInput)
+}
+
+func (ctx *delegationTestContext) query(t *testing.T, function string, arguments ...[]byte) *big.Int {
+	retCode := ctx.call([]byte("anyone"), 0, function, arguments...)
+	require.Equal(t, vmcommon.Ok, retCode)
+	require.Equal(t, 1, len(ctx.eei.output))
+
+	return big.NewInt(0).SetBytes(ctx.eei.output[0])
+}
+
+func (ctx *delegationTestContext) globalFund() *GlobalFundData {
+	ctx.eei.SetSCAddress(ctx.poolAddress)
+	globalFund, _ := ctx.delegationSC.getGlobalFundData()
+	return globalFund
+}
+
+func TestNewDelegationSystemSC_NilEeiShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	args.Eei = nil
+
+	d, err := NewDelegationSystemSC(args)
+	assert.Nil(t, d)
+	assert.Equal(t, vm.ErrNilSystemEnvironmentInterface, err)
+}
+
+func TestNewDelegationSystemSC_NilAuctionAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	args.AuctionSCAddress = nil
+
+	d, err := NewDelegationSystemSC(args)
+	assert.Nil(t, d)
+	assert.Equal(t, vm.ErrNilAuctionSmartContractAddress, err)
+}
+
+func TestNewDelegationSystemSC_InvalidMinDelegationAmountShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	args.DelegationSCConfig.MinDelegationAmount = "0"
+
+	d, err := NewDelegationSystemSC(args)
+	assert.Nil(t, d)
+	assert.True(t, errors.Is(err, vm.ErrInvalidMinDelegationAmount))
+}
+
+func TestNewDelegationSystemSC_InvalidServiceFeeBoundsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForDelegation()
+	args.DelegationSCConfig.MinServiceFee = 100
+	args.DelegationSCConfig.MaxServiceFee = 10
+
+	d, err := NewDelegationSystemSC(args)
+	assert.Nil(t, d)
+	assert.True(t, errors.Is(err, vm.ErrInvalidServiceFeeBounds))
+}
+
+func TestDelegationSystemSC_InitFromManagerOnlyByManager(t *testing.T) {
+	t.Parallel()
+
+	ctx := createDelegationTestContext(t, 1000, 0, 0)
+
+	retCode := ctx.call([]byte("someone"), 0, initFromManagerFunction, ctx.owner, []byte{}, []byte{})
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = ctx.call(vm.DelegationManagerSCAddress, 0, initFromManagerFunction, ctx.owner, []byte{}, []byte{})
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Contains(t, ctx.eei.returnMessage, "already initialized")
+}
+
+func TestDelegationSystemSC_DelegateShouldRespectMinAmountAndCap(t *testing.T) {
+	t.Parallel()
+
+	ctx := createDelegationTestContext(t, 1000, 1500, 0)
+	delegator := []byte("delegator")
+
+	retCode := ctx.call(delegator, 9, "delegate")
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = ctx.call(delegator, 501, "delegate")
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Contains(t, ctx.eei.returnMessage, "total delegation cap reached")
+
+	retCode = ctx.call(delegator, 500, "delegate")
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	assert.Equal(t, big.NewInt(500), ctx.query(t, "getUserActiveStake", delegator))
+	assert.Equal(t, big.NewInt(1500), ctx.query(t, "getTotalActiveStake"))
+
+	retCode = ctx.call(ctx.owner, 0, "setMaxDelegationCap", big.NewInt(1000).Bytes())
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = ctx.call(ctx.owner, 0, "setMaxDelegationCap", big.NewInt(0).Bytes())
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = ctx.call(delegator, 10000, "delegate")
+	assert.Equal(t, vmcommon.Ok, retCode)
+}
+
+func TestDelegationSystemSC_UnDelegateAndWithdrawAfterUnBondPeriod(t *testing.T) {
+	t.Parallel()
+
+	ctx := createDelegationTestContext(t, 1000, 0, 0)
+	delegator := []byte("delegator")
+
+	_ = ctx.call(delegator, 1000, "delegate")
+
+	retCode := ctx.call(delegator, 0, "unDelegate", big.NewInt(995).Bytes())
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = ctx.call(delegator, 0, "unDelegate", big.NewInt(600).Bytes())
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, big.NewInt(400), ctx.query(t, "getUserActiveStake", delegator))
+	assert.Equal(t, big.NewInt(600), ctx.query(t, "getUserUnStakedValue", delegator))
+
+	ctx.currentEpoch = 1
+	retCode = ctx.call(delegator, 0, "withdraw")
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Contains(t, ctx.eei.returnMessage, "nothing to withdraw")
+
+	ctx.currentEpoch = 2
+	retCode = ctx.call(delegator, 0, "withdraw")
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, big.NewInt(600), ctx.eei.outputAccounts[string(delegator)].BalanceDelta)
+	assert.Equal(t, big.NewInt(0), ctx.query(t, "getUserUnStakedValue", delegator))
+	assert.Equal(t, big.NewInt(0), ctx.globalFund().TotalUnStaked)
+}
+
+func TestDelegationSystemSC_RewardsShouldBeSplitWithServiceFee(t *testing.T) {
+	t.Parallel()
+
+	ctx := createDelegationTestContext(t, 1000, 0, 1000)
+	delegator := []byte("delegator")
+
+	_ = ctx.call(delegator, 1000, "delegate")
+
+	retCode := ctx.call(delegator, 200, "updateRewards")
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = ctx.call(vm.EndOfEpochAddress, 200, "updateRewards")
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, big.NewInt(90), ctx.query(t, "getClaimableRewards", delegator))
+	assert.Equal(t, big.NewInt(110), ctx.query(t, "getClaimableRewards", ctx.owner))
+
+	retCode = ctx.call(delegator, 0, "claimRewards")
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, big.NewInt(90), ctx.eei.outputAccounts[string(delegator)].BalanceDelta)
+	assert.Equal(t, big.NewInt(0), ctx.query(t, "getClaimableRewards", delegator))
+
+	retCode = ctx.call(delegator, 0, "claimRewards")
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	_ = ctx.call(delegator, 1000, "delegate")
+	retCode = ctx.call(ctx.owner, 300, "updateRewards")
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, big.NewInt(180), ctx.query(t, "getClaimableRewards", delegator))
+	assert.Equal(t, big.NewInt(110+30+90), ctx.query(t, "getClaimableRewards", ctx.owner))
+}
+
+func TestDelegationSystemSC_ChangeServiceFeeOnlyOwnerAndWithinBounds(t *testing.T) {
+	t.Parallel()
+
+	ctx := createDelegationTestContext(t, 1000, 0, 1000)
+
+	retCode := ctx.call([]byte("delegator"), 0, "changeServiceFee", big.NewInt(500).Bytes())
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = ctx.call(ctx.owner, 0, "changeServiceFee", big.NewInt(10001).Bytes())
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = ctx.call(ctx.owner, 0, "changeServiceFee", big.NewInt(500).Bytes())
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	_ = ctx.call([]byte("anyone"), 0, "getContractConfig")
+	require.Equal(t, 4, len(ctx.eei.output))
+	assert.Equal(t, ctx.owner, ctx.eei.output[0])
+	assert.Equal(t, big.NewInt(500).Bytes(), ctx.eei.output[1])
+}
+
+func TestDelegationSystemSC_StakeUnStakeAndUnBondNodesThroughAuction(t *testing.T) {
+	t.Parallel()
+
+	ctx := createDelegationTestContext(t, 1000, 0, 0)
+	delegator := []byte("delegator")
+	blsKey1 := []byte("blsKey1")
+	blsKey2 := []byte("blsKey2")
+
+	_ = ctx.call(delegator, 1000, "delegate")
+
+	retCode := ctx.call(delegator, 0, "addNodes", blsKey1, []byte("sig1"))
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = ctx.call(ctx.owner, 0, "addNodes", blsKey1, []byte("sig1"), blsKey2, []byte("sig2"))
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = ctx.call(ctx.owner, 0, "stakeNodes", blsKey1, blsKey2)
+	require.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, big.NewInt(2000), ctx.globalFund().StakedInAuction)
+
+	auctionData, _ := ctx.delegationSC.getAuctionData(ctx.poolAddress)
+	assert.Equal(t, big.NewInt(2000), auctionData.TotalStakeValue)
+	assert.Equal(t, 2, len(auctionData.BlsPubKeys))
+
+	retCode = ctx.call(delegator, 0, "unDelegate", big.NewInt(1000).Bytes())
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	ctx.currentEpoch = 2
+	retCode = ctx.call(delegator, 0, "withdraw")
+	assert.Equal(t, vmcommon.UserError, retCode)
+	assert.Contains(t, ctx.eei.returnMessage, "not enough liquid funds")
+
+	retCode = ctx.call(ctx.owner, 0, "unBondNodes", blsKey1)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	retCode = ctx.call(ctx.owner, 0, "unStakeNodes", blsKey1)
+	require.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = ctx.call(ctx.owner, 0, "unBondNodes", blsKey1)
+	require.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, big.NewInt(1000), ctx.globalFund().StakedInAuction)
+	// 2000 were sent to the auction contract when staking, 1000 came back after unBond
+	assert.Equal(t, big.NewInt(-1000), ctx.eei.outputAccounts[string(ctx.poolAddress)].BalanceDelta)
+
+	retCode = ctx.call(delegator, 0, "withdraw")
+	assert.Equal(t, vmcommon.Ok, retCode)
+}
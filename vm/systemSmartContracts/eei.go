@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/atomic"
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-go/data/state"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -26,6 +27,9 @@ type vmContext struct {
 
 	returnMessage string
 	output        [][]byte
+
+	nestedOutputMergeEnableEpoch uint32
+	flagNestedOutputMerge        atomic.Flag
 }
 
 // NewVMContext creates a context where smart contracts can run and write
@@ -74,6 +78,18 @@ func (host *vmContext) SetSystemSCContainer(scContainer vm.SystemSCContainer) er
 	return nil
 }
 
+// SetNestedOutputMergeEnableEpoch sets the epoch from which the output accounts of nested calls are merged
+// into the caller's context
+func (host *vmContext) SetNestedOutputMergeEnableEpoch(epoch uint32) {
+	host.nestedOutputMergeEnableEpoch = epoch
+}
+
+// EpochConfirmed is called whenever a new epoch is confirmed
+func (host *vmContext) EpochConfirmed(epoch uint32) {
+	host.flagNestedOutputMerge.Toggle(epoch >= host.nestedOutputMergeEnableEpoch)
+	log.Debug("system vm context: nested output merge", "enabled", host.flagNestedOutputMerge.IsSet())
+}
+
 // GetStorageFromAddress gets the storage from address and key
 func (host *vmContext) GetStorageFromAddress(address []byte, key []byte) []byte {
 	if storageAdrMap, ok := host.storageUpdate[string(address)]; ok {
@@ -247,9 +263,13 @@ func (host *vmContext) ExecuteOnDestContext(destination []byte, sender []byte, v
 	}
 
 	currContext := host.copyToNewContext()
+	var nestedOutputAccounts map[string]*vmcommon.OutputAccount
 	defer func() {
 		host.output = make([][]byte, 0)
 		host.copyFromContext(currContext)
+		if host.flagNestedOutputMerge.IsSet() {
+			host.mergeOutputAccounts(nestedOutputAccounts)
+		}
 	}()
 
 	host.softCleanCache()
@@ -272,6 +292,7 @@ func (host *vmContext) ExecuteOnDestContext(destination []byte, sender []byte, v
 	vmOutput := &vmcommon.VMOutput{}
 	if returnCode == vmcommon.Ok {
 		vmOutput = host.CreateVMOutput()
+		nestedOutputAccounts = host.outputAccounts
 	}
 	vmOutput.ReturnCode = returnCode
 	vmOutput.ReturnMessage = host.returnMessage
@@ -279,6 +300,25 @@ func (host *vmContext) ExecuteOnDestContext(destination []byte, sender []byte, v
 	return vmOutput, nil
 }
 
+// mergeOutputAccounts adds the balance changes, transfers and code produced by a nested call to the current context
+func (host *vmContext) mergeOutputAccounts(outputAccounts map[string]*vmcommon.OutputAccount) {
+	for key, outAcc := range outputAccounts {
+		currentAcc, ok := host.outputAccounts[key]
+		if !ok {
+			host.outputAccounts[key] = outAcc
+			continue
+		}
+
+		if outAcc.BalanceDelta != nil {
+			currentAcc.BalanceDelta = big.NewInt(0).Add(currentAcc.BalanceDelta, outAcc.BalanceDelta)
+		}
+		currentAcc.OutputTransfers = append(currentAcc.OutputTransfers, outAcc.OutputTransfers...)
+		if len(outAcc.Code) > 0 {
+			currentAcc.Code = outAcc.Code
+		}
+	}
+}
+
 // Finish append the value to the final output
 func (host *vmContext) Finish(value []byte) {
 	host.output = append(host.output, value)
@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/mock"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -275,3 +276,51 @@ func TestVmContext_IsValidator(t *testing.T) {
 		assert.Equal(t, tio.expectedResult, vmc.IsValidator(blsKey))
 	}
 }
+
+func TestVmContext_ExecuteOnDestContextMergesNestedOutputOnlyAfterEnableEpoch(t *testing.T) {
+	t.Parallel()
+
+	enableEpoch := uint32(5)
+	destination := []byte("dest")
+	sender := []byte("sender")
+	receiver := []byte("receiver")
+	nestedValue := big.NewInt(10)
+
+	executeOnDestContext := func(epoch uint32) *vmcommon.VMOutput {
+		vmContext, _ := NewVMContext(
+			&mock.BlockChainHookStub{},
+			hooks.NewVMCryptoHook(),
+			parsers.NewCallArgsParser(),
+			&mock.AccountsStub{},
+			&mock.RaterMock{})
+		vmContext.SetNestedOutputMergeEnableEpoch(enableEpoch)
+		vmContext.EpochConfirmed(epoch)
+
+		nestedContract := &mock.SystemSCStub{
+			ExecuteCalled: func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+				_ = vmContext.Transfer(receiver, args.RecipientAddr, nestedValue, nil, 0)
+				return vmcommon.Ok
+			},
+		}
+		_ = vmContext.SetSystemSCContainer(&mock.SystemSCContainerStub{
+			GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+				return nestedContract, nil
+			},
+		})
+
+		vmOutput, err := vmContext.ExecuteOnDestContext(destination, sender, big.NewInt(0), []byte("function"))
+		assert.Nil(t, err)
+		assert.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
+
+		return vmContext.CreateVMOutput()
+	}
+
+	vmOutput := executeOnDestContext(enableEpoch - 1)
+	_, ok := vmOutput.OutputAccounts[string(receiver)]
+	assert.False(t, ok)
+
+	vmOutput = executeOnDestContext(enableEpoch)
+	receiverAcc, ok := vmOutput.OutputAccounts[string(receiver)]
+	assert.True(t, ok)
+	assert.Equal(t, nestedValue, receiverAcc.BalanceDelta)
+}
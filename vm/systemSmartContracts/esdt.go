@@ -4,6 +4,7 @@ package systemSmartContracts
 import (
 	"bytes"
 	"encoding/hex"
+	"math"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -18,7 +19,10 @@ import (
 
 const minLengthForTokenName = 10
 const maxLengthForTokenName = 20
-const configKeyPrefix = "esdtConfig"
+
+// ESDTConfigKey is the storage key under which the esdt system smart contract keeps its configuration
+const ESDTConfigKey = "esdtConfig"
+
 const burnable = "burnable"
 const mintable = "mintable"
 const canPause = "canPause"
@@ -30,26 +34,35 @@ const upgradable = "uppgradable"
 const conversionBase = 10
 
 type esdt struct {
-	eei             vm.SystemEI
-	gasCost         vm.GasCost
-	baseIssuingCost *big.Int
-	ownerAddress    []byte
-	eSDTSCAddress   []byte
-	marshalizer     marshal.Marshalizer
-	hasher          hashing.Hasher
-	enabledEpoch    uint32
-	flagEnabled     atomic.Flag
+	eei                 vm.SystemEI
+	gasCost             vm.GasCost
+	baseIssuingCost     *big.Int
+	ownerAddress        []byte
+	eSDTSCAddress       []byte
+	governanceSCAddress []byte
+	marshalizer         marshal.Marshalizer
+	hasher              hashing.Hasher
+	enabledEpoch        uint32
+	flagEnabled         atomic.Flag
+
+	nonFungibleTokensEnableEpoch uint32
+	flagNonFungibleTokens        atomic.Flag
+	specialRolesEnableEpoch      uint32
+	flagSpecialRoles             atomic.Flag
+	configChangeEnableEpoch      uint32
+	flagConfigChange             atomic.Flag
 }
 
 // ArgsNewESDTSmartContract defines the arguments needed for the esdt contract
 type ArgsNewESDTSmartContract struct {
-	Eei           vm.SystemEI
-	GasCost       vm.GasCost
-	ESDTSCConfig  config.ESDTSystemSCConfig
-	ESDTSCAddress []byte
-	Marshalizer   marshal.Marshalizer
-	Hasher        hashing.Hasher
-	EpochNotifier vm.EpochNotifier
+	Eei                 vm.SystemEI
+	GasCost             vm.GasCost
+	ESDTSCConfig        config.ESDTSystemSCConfig
+	ESDTSCAddress       []byte
+	GovernanceSCAddress []byte
+	Marshalizer         marshal.Marshalizer
+	Hasher              hashing.Hasher
+	EpochNotifier       vm.EpochNotifier
 }
 
 // NewESDTSmartContract creates the esdt smart contract, which controls the issuing of tokens
@@ -73,14 +86,19 @@ func NewESDTSmartContract(args ArgsNewESDTSmartContract) (*esdt, error) {
 	}
 
 	e := &esdt{
-		eei:             args.Eei,
-		gasCost:         args.GasCost,
-		baseIssuingCost: baseIssuingCost,
-		ownerAddress:    []byte(args.ESDTSCConfig.OwnerAddress),
-		eSDTSCAddress:   args.ESDTSCAddress,
-		hasher:          args.Hasher,
-		marshalizer:     args.Marshalizer,
-		enabledEpoch:    args.ESDTSCConfig.EnabledEpoch,
+		eei:                 args.Eei,
+		gasCost:             args.GasCost,
+		baseIssuingCost:     baseIssuingCost,
+		ownerAddress:        []byte(args.ESDTSCConfig.OwnerAddress),
+		eSDTSCAddress:       args.ESDTSCAddress,
+		governanceSCAddress: args.GovernanceSCAddress,
+		hasher:              args.Hasher,
+		marshalizer:         args.Marshalizer,
+		enabledEpoch:        args.ESDTSCConfig.EnabledEpoch,
+
+		nonFungibleTokensEnableEpoch: args.ESDTSCConfig.NonFungibleTokensEnableEpoch,
+		specialRolesEnableEpoch:      args.ESDTSCConfig.SpecialRolesEnableEpoch,
+		configChangeEnableEpoch:      args.ESDTSCConfig.ConfigChangeEnableEpoch,
 	}
 	args.EpochNotifier.RegisterNotifyHandler(e)
 
@@ -107,6 +125,10 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return e.issue(args)
 	case "issueProtected":
 		return e.issueProtected(args)
+	case "issueNonFungible":
+		return e.issueNonFungible(args, core.NonFungibleESDT)
+	case "issueSemiFungible":
+		return e.issueNonFungible(args, core.SemiFungibleESDT)
 	case "burn":
 		return e.burn(args)
 	case "mint":
@@ -129,6 +151,14 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		return e.esdtControlChanges(args)
 	case "transferOwnership":
 		return e.transferOwnership(args)
+	case "setSpecialRole":
+		return e.setSpecialRole(args)
+	case "unSetSpecialRole":
+		return e.unSetSpecialRole(args)
+	case core.BuiltInFunctionESDTLocalMint:
+		return e.recordLocalSupplyChange(args, core.ESDTRoleLocalMint)
+	case core.BuiltInFunctionESDTLocalBurn:
+		return e.recordLocalSupplyChange(args, core.ESDTRoleLocalBurn)
 	}
 
 	e.eei.AddReturnMessage("invalid method to call")
@@ -136,21 +166,57 @@ func (e *esdt) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 }
 
 func (e *esdt) init(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	scConfig := &ESDTConfig{
+	err := e.saveESDTConfig(e.getDefaultESDTConfig())
+	log.LogIfError(err, "marshal error on esdt init function")
+
+	return vmcommon.Ok
+}
+
+func (e *esdt) getDefaultESDTConfig() *ESDTConfig {
+	return &ESDTConfig{
 		OwnerAddress:       e.ownerAddress,
-		BaseIssuingCost:    e.baseIssuingCost,
+		BaseIssuingCost:    big.NewInt(0).Set(e.baseIssuingCost),
 		MinTokenNameLength: minLengthForTokenName,
 		MaxTokenNameLength: maxLengthForTokenName,
 	}
-	marshaledData, err := e.marshalizer.Marshal(scConfig)
-	log.LogIfError(err, "marshal error on esdt init function")
+}
 
-	e.eei.SetStorage([]byte(configKeyPrefix), marshaledData)
-	return vmcommon.Ok
+func (e *esdt) getESDTConfig() *ESDTConfig {
+	if !e.flagConfigChange.IsSet() {
+		return e.getDefaultESDTConfig()
+	}
+
+	marshaledData := e.eei.GetStorage([]byte(ESDTConfigKey))
+	if len(marshaledData) == 0 {
+		return e.getDefaultESDTConfig()
+	}
+
+	esdtConfig := &ESDTConfig{}
+	err := e.marshalizer.Unmarshal(esdtConfig, marshaledData)
+	if err != nil {
+		log.Warn("unmarshal error on esdt config, using defaults", "error", err)
+		return e.getDefaultESDTConfig()
+	}
+	if esdtConfig.BaseIssuingCost == nil {
+		esdtConfig.BaseIssuingCost = big.NewInt(0)
+	}
+
+	return esdtConfig
+}
+
+func (e *esdt) saveESDTConfig(esdtConfig *ESDTConfig) error {
+	marshaledData, err := e.marshalizer.Marshal(esdtConfig)
+	if err != nil {
+		return err
+	}
+
+	e.eei.SetStorage([]byte(ESDTConfigKey), marshaledData)
+	return nil
 }
 
 func (e *esdt) issueProtected(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	if !bytes.Equal(args.CallerAddr, e.ownerAddress) {
+	esdtConfig := e.getESDTConfig()
+	if !bytes.Equal(args.CallerAddr, esdtConfig.OwnerAddress) {
 		e.eei.AddReturnMessage("issueProtected can be called by whitelisted address only")
 		return vmcommon.UserError
 	}
@@ -162,7 +228,7 @@ func (e *esdt) issueProtected(args *vmcommon.ContractCallInput) vmcommon.ReturnC
 		e.eei.AddReturnMessage("token name length not in parameters")
 		return vmcommon.FunctionWrongSignature
 	}
-	if args.CallValue.Cmp(e.baseIssuingCost) != 0 {
+	if args.CallValue.Cmp(esdtConfig.BaseIssuingCost) != 0 {
 		e.eei.AddReturnMessage("callValue not equals with baseIssuingCost")
 		return vmcommon.OutOfFunds
 	}
@@ -186,11 +252,12 @@ func (e *esdt) issue(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage("not enough arguments")
 		return vmcommon.FunctionWrongSignature
 	}
-	if len(args.Arguments[0]) < minLengthForTokenName || len(args.Arguments[0]) > maxLengthForTokenName {
+	esdtConfig := e.getESDTConfig()
+	if !isTokenNameLengthValid(esdtConfig, args.Arguments[0]) {
 		e.eei.AddReturnMessage("token name length not in parameters")
 		return vmcommon.FunctionWrongSignature
 	}
-	if args.CallValue.Cmp(e.baseIssuingCost) != 0 {
+	if args.CallValue.Cmp(esdtConfig.BaseIssuingCost) != 0 {
 		e.eei.AddReturnMessage("callValue not equals with baseIssuingCost")
 		return vmcommon.OutOfFunds
 	}
@@ -209,6 +276,39 @@ func (e *esdt) issue(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	return vmcommon.Ok
 }
 
+func (e *esdt) issueNonFungible(args *vmcommon.ContractCallInput, tokenType string) vmcommon.ReturnCode {
+	if !e.flagNonFungibleTokens.IsSet() {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if len(args.Arguments) < 1 {
+		e.eei.AddReturnMessage("not enough arguments")
+		return vmcommon.FunctionWrongSignature
+	}
+	esdtConfig := e.getESDTConfig()
+	if !isTokenNameLengthValid(esdtConfig, args.Arguments[0]) {
+		e.eei.AddReturnMessage("token name length not in parameters")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(esdtConfig.BaseIssuingCost) != 0 {
+		e.eei.AddReturnMessage("callValue not equals with baseIssuingCost")
+		return vmcommon.OutOfFunds
+	}
+	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTIssue)
+	if err != nil {
+		e.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+
+	err = e.issueNonFungibleToken(args.CallerAddr, args.Arguments, tokenType)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
 func isTokenNameHumanReadable(tokenName []byte) bool {
 	for _, ch := range tokenName {
 		isSmallCharacter := ch >= 'a' && ch <= 'z'
@@ -245,6 +345,9 @@ func (e *esdt) issueToken(owner []byte, arguments [][]byte) error {
 		BurntValue:   big.NewInt(0),
 		Upgradable:   true,
 	}
+	if e.flagNonFungibleTokens.IsSet() {
+		newESDTToken.TokenType = []byte(core.FungibleESDT)
+	}
 	err := upgradeProperties(newESDTToken, arguments[2:])
 	if err != nil {
 		return err
@@ -263,6 +366,56 @@ func (e *esdt) issueToken(owner []byte, arguments [][]byte) error {
 	return nil
 }
 
+func (e *esdt) issueNonFungibleToken(owner []byte, arguments [][]byte, tokenType string) error {
+	tokenName := arguments[0]
+	data := e.eei.GetStorage(tokenName)
+	if len(data) > 0 {
+		return vm.ErrTokenAlreadyRegistered
+	}
+
+	if !isTokenNameHumanReadable(tokenName) {
+		return vm.ErrTokenNameNotHumanReadable
+	}
+
+	roles := [][]byte{[]byte(core.ESDTRoleNFTCreate), []byte(core.ESDTRoleNFTBurn)}
+	if tokenType == core.SemiFungibleESDT {
+		roles = append(roles, []byte(core.ESDTRoleNFTAddQuantity))
+	}
+
+	newESDTToken := &ESDTData{
+		OwnerAddress: owner,
+		TokenName:    tokenName,
+		MintedValue:  big.NewInt(0),
+		BurntValue:   big.NewInt(0),
+		Upgradable:   true,
+		TokenType:    []byte(tokenType),
+		SpecialRoles: []*ESDTRoles{{Address: owner, Roles: roles}},
+	}
+	err := upgradeProperties(newESDTToken, arguments[1:])
+	if err != nil {
+		return err
+	}
+	err = e.saveToken(newESDTToken)
+	if err != nil {
+		return err
+	}
+
+	return e.sendRoleChangeData(tokenName, owner, roles, core.BuiltInFunctionESDTSetRole)
+}
+
+func (e *esdt) sendRoleChangeData(tokenName []byte, destination []byte, roles [][]byte, builtInFunc string) error {
+	esdtRoleData := builtInFunc + "@" + hex.EncodeToString(tokenName)
+	for _, role := range roles {
+		esdtRoleData += "@" + hex.EncodeToString(role)
+	}
+
+	return e.eei.Transfer(destination, e.eSDTSCAddress, big.NewInt(0), []byte(esdtRoleData), 0)
+}
+
+func isFungibleToken(token *ESDTData) bool {
+	return len(token.TokenType) == 0 || bytes.Equal(token.TokenType, []byte(core.FungibleESDT))
+}
+
 func upgradeProperties(token *ESDTData, args [][]byte) error {
 	if len(args) == 0 {
 		return nil
@@ -338,6 +491,10 @@ func (e *esdt) burn(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage("token is not burnable")
 		return vmcommon.UserError
 	}
+	if e.flagNonFungibleTokens.IsSet() && !isFungibleToken(token) {
+		e.eei.AddReturnMessage("only fungible tokens can be burnt through the esdt smart contract")
+		return vmcommon.UserError
+	}
 	token.BurntValue.Add(token.BurntValue, burntValue)
 
 	err = e.saveToken(token)
@@ -349,6 +506,62 @@ func (e *esdt) burn(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	return vmcommon.Ok
 }
 
+// recordLocalSupplyChange adds the quantity minted or burnt in a shard by an address holding the local mint/burn
+// role to the token's minted or burnt value. It accepts only the results generated by the local built-in functions:
+// these are asynchronous calls named after the built-in function and any transaction carrying a built-in function
+// name is first executed by that built-in function in the sender's shard, which rejects it unless it is a genuine local action
+func (e *esdt) recordLocalSupplyChange(args *vmcommon.ContractCallInput, role string) vmcommon.ReturnCode {
+	if !e.flagSpecialRoles.IsSet() {
+		e.eei.AddReturnMessage("invalid method to call")
+		return vmcommon.FunctionNotFound
+	}
+	if args.CallType != vmcommon.AsynchronousCall {
+		e.eei.AddReturnMessage(args.Function + " can be called only through the local built-in function")
+		return vmcommon.UserError
+	}
+	if len(args.Arguments) != 2 {
+		e.eei.AddReturnMessage("number of arguments must be equal with 2")
+		return vmcommon.FunctionWrongSignature
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		e.eei.AddReturnMessage("callValue must be 0")
+		return vmcommon.OutOfFunds
+	}
+	value := big.NewInt(0).SetBytes(args.Arguments[1])
+	if value.Cmp(zero) <= 0 {
+		e.eei.AddReturnMessage("negative or 0 value")
+		return vmcommon.UserError
+	}
+	token, err := e.getExistingToken(args.Arguments[0])
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+	if !isFungibleToken(token) {
+		e.eei.AddReturnMessage("only fungible tokens can be minted or burnt locally")
+		return vmcommon.UserError
+	}
+	esdtRoles, _ := getRolesForAddress(token, args.CallerAddr)
+	if esdtRoles == nil || !hasRole(esdtRoles, []byte(role)) {
+		e.eei.AddReturnMessage("caller does not have the " + role + " role")
+		return vmcommon.UserError
+	}
+
+	if role == core.ESDTRoleLocalMint {
+		token.MintedValue.Add(token.MintedValue, value)
+	} else {
+		token.BurntValue.Add(token.BurntValue, value)
+	}
+
+	err = e.saveToken(token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
 func (e *esdt) mint(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if len(args.Arguments) < 2 || len(args.Arguments) > 3 {
 		e.eei.AddReturnMessage("accepted arguments number 2/3")
@@ -367,6 +580,10 @@ func (e *esdt) mint(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 		e.eei.AddReturnMessage("token is not mintable")
 		return vmcommon.UserError
 	}
+	if e.flagNonFungibleTokens.IsSet() && !isFungibleToken(token) {
+		e.eei.AddReturnMessage("only fungible tokens can be minted through the esdt smart contract")
+		return vmcommon.UserError
+	}
 
 	token.MintedValue.Add(token.MintedValue, mintValue)
 	err := e.saveToken(token)
@@ -489,13 +706,113 @@ func (e *esdt) togglePause(args *vmcommon.ContractCallInput, builtInFunc string)
 	return vmcommon.Ok
 }
 
-func (e *esdt) configChange(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+func isTokenNameLengthValid(esdtConfig *ESDTConfig, tokenName []byte) bool {
+	return uint32(len(tokenName)) >= esdtConfig.MinTokenNameLength && uint32(len(tokenName)) <= esdtConfig.MaxTokenNameLength
+}
+
+func isAddressWhitelisted(address []byte, whitelistedAddresses [][]byte) bool {
+	for _, whitelistedAddress := range whitelistedAddresses {
+		if bytes.Equal(address, whitelistedAddress) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *esdt) checkWhitelistedCall(args *vmcommon.ContractCallInput, whitelistedAddresses ...[]byte) vmcommon.ReturnCode {
+	if !isAddressWhitelisted(args.CallerAddr, whitelistedAddresses) {
+		e.eei.AddReturnMessage(args.Function + " can be called by whitelisted address only")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		e.eei.AddReturnMessage("callValue must be 0")
+		return vmcommon.OutOfFunds
+	}
+	err := e.eei.UseGas(e.gasCost.MetaChainSystemSCsCost.ESDTOperations)
+	if err != nil {
+		e.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+
+	return vmcommon.Ok
+}
+
+// configChange replaces the contract configuration and can be called only by the current owner address
+// or by the governance smart contract
+// Requires the following arguments:
+// arg0 - new owner address
+// arg1 - new base issuing cost
+// arg2 - new minimum token name length
+// arg3 - new maximum token name length
+func (e *esdt) configChange(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !e.flagConfigChange.IsSet() {
+		return vmcommon.Ok
+	}
+
+	esdtConfig := e.getESDTConfig()
+	returnCode := e.checkWhitelistedCall(args, esdtConfig.OwnerAddress, e.governanceSCAddress)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+	if len(args.Arguments) != 4 {
+		e.eei.AddReturnMessage("invalid number of arguments, wanted 4")
+		return vmcommon.FunctionWrongSignature
+	}
+	if len(args.Arguments[0]) != len(args.CallerAddr) {
+		e.eei.AddReturnMessage("invalid owner address length")
+		return vmcommon.UserError
+	}
+
+	newMinTokenNameLength := big.NewInt(0).SetBytes(args.Arguments[2])
+	newMaxTokenNameLength := big.NewInt(0).SetBytes(args.Arguments[3])
+	if !newMinTokenNameLength.IsUint64() || !newMaxTokenNameLength.IsUint64() ||
+		newMinTokenNameLength.Uint64() == 0 ||
+		newMaxTokenNameLength.Uint64() > math.MaxUint32 ||
+		newMinTokenNameLength.Cmp(newMaxTokenNameLength) > 0 {
+		e.eei.AddReturnMessage(vm.ErrInvalidTokenNameLength.Error())
+		return vmcommon.UserError
+	}
+
+	newConfig := &ESDTConfig{
+		OwnerAddress:       args.Arguments[0],
+		BaseIssuingCost:    big.NewInt(0).SetBytes(args.Arguments[1]),
+		MinTokenNameLength: uint32(newMinTokenNameLength.Uint64()),
+		MaxTokenNameLength: uint32(newMaxTokenNameLength.Uint64()),
+	}
+	err := e.saveESDTConfig(newConfig)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
-func (e *esdt) claim(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	//TODO: implement me
+// claim transfers the accumulated issuing fees to the owner address
+func (e *esdt) claim(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !e.flagConfigChange.IsSet() {
+		return vmcommon.Ok
+	}
+
+	esdtConfig := e.getESDTConfig()
+	returnCode := e.checkWhitelistedCall(args, esdtConfig.OwnerAddress)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	scBalance := e.eei.GetBalance(e.eSDTSCAddress)
+	if scBalance.Cmp(zero) == 0 {
+		e.eei.AddReturnMessage("nothing to claim")
+		return vmcommon.UserError
+	}
+
+	err := e.eei.Transfer(args.CallerAddr, e.eSDTSCAddress, scBalance, nil, 0)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
 	return vmcommon.Ok
 }
 
@@ -578,6 +895,148 @@ func (e *esdt) esdtControlChanges(args *vmcommon.ContractCallInput) vmcommon.Ret
 	return vmcommon.Ok
 }
 
+func isValidRoleForToken(token *ESDTData, role []byte) bool {
+	switch string(role) {
+	case core.ESDTRoleLocalMint, core.ESDTRoleLocalBurn:
+		return isFungibleToken(token)
+	case core.ESDTRoleNFTCreate, core.ESDTRoleNFTBurn:
+		return !isFungibleToken(token)
+	case core.ESDTRoleNFTAddQuantity:
+		return bytes.Equal(token.TokenType, []byte(core.SemiFungibleESDT))
+	}
+
+	return false
+}
+
+func getRolesForAddress(token *ESDTData, address []byte) (*ESDTRoles, int) {
+	for i, esdtRole := range token.SpecialRoles {
+		if bytes.Equal(esdtRole.Address, address) {
+			return esdtRole, i
+		}
+	}
+
+	return nil, -1
+}
+
+func hasRole(esdtRoles *ESDTRoles, role []byte) bool {
+	for _, currentRole := range esdtRoles.Roles {
+		if bytes.Equal(currentRole, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *esdt) checkSpecialRoleArguments(args *vmcommon.ContractCallInput) (*ESDTData, vmcommon.ReturnCode) {
+	if !e.flagSpecialRoles.IsSet() {
+		e.eei.AddReturnMessage("invalid method to call")
+		return nil, vmcommon.FunctionNotFound
+	}
+	if len(args.Arguments) < 3 {
+		e.eei.AddReturnMessage("not enough arguments")
+		return nil, vmcommon.FunctionWrongSignature
+	}
+	token, returnCode := e.basicOwnershipChecks(args)
+	if returnCode != vmcommon.Ok {
+		return nil, returnCode
+	}
+	if len(args.Arguments[1]) != len(args.CallerAddr) {
+		e.eei.AddReturnMessage("invalid address to set roles")
+		return nil, vmcommon.UserError
+	}
+
+	return token, vmcommon.Ok
+}
+
+func (e *esdt) setSpecialRole(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	token, returnCode := e.checkSpecialRoleArguments(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	address := args.Arguments[1]
+	roles := args.Arguments[2:]
+	esdtRoles, _ := getRolesForAddress(token, address)
+	if esdtRoles == nil {
+		esdtRoles = &ESDTRoles{Address: address}
+		token.SpecialRoles = append(token.SpecialRoles, esdtRoles)
+	}
+
+	for _, role := range roles {
+		if !isValidRoleForToken(token, role) {
+			e.eei.AddReturnMessage(vm.ErrInvalidRoleForToken.Error() + " " + string(role))
+			return vmcommon.UserError
+		}
+		if hasRole(esdtRoles, role) {
+			e.eei.AddReturnMessage(vm.ErrRoleAlreadyAssigned.Error() + " " + string(role))
+			return vmcommon.UserError
+		}
+		esdtRoles.Roles = append(esdtRoles.Roles, role)
+	}
+
+	err := e.saveToken(token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	err = e.sendRoleChangeData(token.TokenName, address, roles, core.BuiltInFunctionESDTSetRole)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (e *esdt) unSetSpecialRole(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	token, returnCode := e.checkSpecialRoleArguments(args)
+	if returnCode != vmcommon.Ok {
+		return returnCode
+	}
+
+	address := args.Arguments[1]
+	roles := args.Arguments[2:]
+	esdtRoles, index := getRolesForAddress(token, address)
+	if esdtRoles == nil {
+		e.eei.AddReturnMessage(vm.ErrRoleNotAssigned.Error())
+		return vmcommon.UserError
+	}
+
+	for _, role := range roles {
+		if !hasRole(esdtRoles, role) {
+			e.eei.AddReturnMessage(vm.ErrRoleNotAssigned.Error() + " " + string(role))
+			return vmcommon.UserError
+		}
+		remainingRoles := make([][]byte, 0, len(esdtRoles.Roles))
+		for _, currentRole := range esdtRoles.Roles {
+			if !bytes.Equal(currentRole, role) {
+				remainingRoles = append(remainingRoles, currentRole)
+			}
+		}
+		esdtRoles.Roles = remainingRoles
+	}
+
+	if len(esdtRoles.Roles) == 0 {
+		token.SpecialRoles = append(token.SpecialRoles[:index], token.SpecialRoles[index+1:]...)
+	}
+
+	err := e.saveToken(token)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	err = e.sendRoleChangeData(token.TokenName, address, roles, core.BuiltInFunctionESDTUnSetRole)
+	if err != nil {
+		e.eei.AddReturnMessage(err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
 func (e *esdt) saveToken(token *ESDTData) error {
 	marshaledData, err := e.marshalizer.Marshal(token)
 	if err != nil {
@@ -603,6 +1062,15 @@ func (e *esdt) getExistingToken(tokenName []byte) (*ESDTData, error) {
 func (e *esdt) EpochConfirmed(epoch uint32) {
 	e.flagEnabled.Toggle(epoch >= e.enabledEpoch)
 	log.Debug("esdt contract", "enabled", e.flagEnabled.IsSet())
+
+	e.flagNonFungibleTokens.Toggle(epoch >= e.nonFungibleTokensEnableEpoch)
+	log.Debug("esdt contract: non-fungible tokens", "enabled", e.flagNonFungibleTokens.IsSet())
+
+	e.flagSpecialRoles.Toggle(epoch >= e.specialRolesEnableEpoch)
+	log.Debug("esdt contract: special roles", "enabled", e.flagSpecialRoles.IsSet())
+
+	e.flagConfigChange.Toggle(epoch >= e.configChangeEnableEpoch)
+	log.Debug("esdt contract: config change", "enabled", e.flagConfigChange.IsSet())
 }
 
 // IsInterfaceNil returns true if underlying object is nil
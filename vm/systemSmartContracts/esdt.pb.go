@@ -42,6 +42,8 @@ type ESDTData struct {
 	IsPaused       bool          `protobuf:"varint,12,opt,name=IsPaused,proto3" json:"IsPaused"`
 	MintedValue    *math_big.Int `protobuf:"bytes,13,opt,name=MintedValue,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"MintedValue"`
 	BurntValue     *math_big.Int `protobuf:"bytes,14,opt,name=BurntValue,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"BurntValue"`
+	TokenType      []byte        `protobuf:"bytes,15,opt,name=TokenType,proto3" json:"TokenType"`
+	SpecialRoles   []*ESDTRoles  `protobuf:"bytes,16,rep,name=SpecialRoles,proto3" json:"SpecialRoles"`
 }
 
 func (m *ESDTData) Reset()      { *m = ESDTData{} }
@@ -163,6 +165,67 @@ func (m *ESDTData) GetBurntValue() *math_big.Int {
 	return nil
 }
 
+func (m *ESDTData) GetTokenType() []byte {
+	if m != nil {
+		return m.TokenType
+	}
+	return nil
+}
+
+func (m *ESDTData) GetSpecialRoles() []*ESDTRoles {
+	if m != nil {
+		return m.SpecialRoles
+	}
+	return nil
+}
+
+type ESDTRoles struct {
+	Address []byte   `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address"`
+	Roles   [][]byte `protobuf:"bytes,2,rep,name=Roles,proto3" json:"Roles"`
+}
+
+func (m *ESDTRoles) Reset()      { *m = ESDTRoles{} }
+func (*ESDTRoles) ProtoMessage() {}
+func (*ESDTRoles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e413e402abc6a34c, []int{1}
+}
+func (m *ESDTRoles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTRoles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ESDTRoles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTRoles.Merge(m, src)
+}
+func (m *ESDTRoles) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTRoles) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTRoles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTRoles proto.InternalMessageInfo
+
+func (m *ESDTRoles) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *ESDTRoles) GetRoles() [][]byte {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
 type ESDTConfig struct {
 	OwnerAddress       []byte        `protobuf:"bytes,1,opt,name=OwnerAddress,proto3" json:"OwnerAddress"`
 	BaseIssuingCost    *math_big.Int `protobuf:"bytes,2,opt,name=BaseIssuingCost,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go/data.BigIntCaster" json:"BaseIssuingCost"`
@@ -173,7 +236,7 @@ type ESDTConfig struct {
 func (m *ESDTConfig) Reset()      { *m = ESDTConfig{} }
 func (*ESDTConfig) ProtoMessage() {}
 func (*ESDTConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e413e402abc6a34c, []int{1}
+	return fileDescriptor_e413e402abc6a34c, []int{2}
 }
 func (m *ESDTConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,50 +291,56 @@ func (m *ESDTConfig) GetMaxTokenNameLength() uint32 {
 
 func init() {
 	proto.RegisterType((*ESDTData)(nil), "proto.ESDTData")
+	proto.RegisterType((*ESDTRoles)(nil), "proto.ESDTRoles")
 	proto.RegisterType((*ESDTConfig)(nil), "proto.ESDTConfig")
 }
 
 func init() { proto.RegisterFile("esdt.proto", fileDescriptor_e413e402abc6a34c) }
 
 var fileDescriptor_e413e402abc6a34c = []byte{
-	// 588 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcb, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc7, 0x3d, 0xfd, 0x7a, 0xc9, 0x37, 0xb9, 0x80, 0x46, 0x08, 0x59, 0x2c, 0xc6, 0x55, 0x24,
-	0xa4, 0x48, 0xa8, 0xc9, 0x02, 0x56, 0xb0, 0xaa, 0xdd, 0x56, 0x8a, 0x44, 0x03, 0x9a, 0x84, 0x8b,
-	0xd8, 0x4d, 0xea, 0xa9, 0x63, 0x35, 0x99, 0x89, 0x3c, 0x63, 0x0a, 0xac, 0x10, 0x4f, 0xc0, 0x63,
-	0x20, 0x9e, 0x84, 0x65, 0x76, 0x64, 0x65, 0x88, 0x23, 0x21, 0xe4, 0x55, 0x1f, 0x01, 0x79, 0x92,
-	0xd8, 0x6e, 0xc8, 0x0a, 0x75, 0xe5, 0xff, 0xf9, 0x9d, 0xff, 0x9c, 0xd1, 0x39, 0x3e, 0x36, 0x84,
-	0x4c, 0xba, 0xaa, 0x39, 0x0e, 0x84, 0x12, 0x68, 0x47, 0x3f, 0xee, 0x1d, 0x78, 0xbe, 0x1a, 0x84,
-	0xfd, 0xe6, 0x99, 0x18, 0xb5, 0x3c, 0xe1, 0x89, 0x96, 0xc6, 0xfd, 0xf0, 0x5c, 0x47, 0x3a, 0xd0,
-	0x6a, 0x71, 0xaa, 0xfe, 0x6b, 0x07, 0x96, 0x8e, 0xbb, 0x47, 0xbd, 0x23, 0xaa, 0x28, 0x7a, 0x04,
-	0x2b, 0xcf, 0x2e, 0x39, 0x0b, 0x0e, 0x5d, 0x37, 0x60, 0x52, 0x9a, 0x60, 0x1f, 0x34, 0x2a, 0xf6,
-	0xed, 0x24, 0xb2, 0xae, 0x71, 0x72, 0x2d, 0x42, 0x0f, 0xe0, 0xff, 0x3d, 0x71, 0xc1, 0x78, 0x87,
-	0x8e, 0x98, 0xb9, 0xa5, 0x8f, 0x54, 0x93, 0xc8, 0xca, 0x21, 0xc9, 0x25, 0x6a, 0xc0, 0xd2, 0xa9,
-	0xcf, 0x15, 0xed, 0x0f, 0x99, 0xf9, 0xdf, 0x3e, 0x68, 0x94, 0xec, 0x4a, 0x12, 0x59, 0x19, 0x23,
-	0x99, 0x4a, 0x9d, 0x76, 0x18, 0x70, 0xed, 0xdc, 0xce, 0x9d, 0x2b, 0x46, 0x32, 0x95, 0x3a, 0x1d,
-	0xca, 0x9f, 0xd3, 0x50, 0x32, 0x73, 0x27, 0x77, 0xae, 0x18, 0xc9, 0x14, 0xaa, 0xc3, 0x5d, 0x2d,
-	0x5c, 0x73, 0x57, 0xfb, 0x60, 0x12, 0x59, 0x4b, 0x42, 0x96, 0xcf, 0xb4, 0x1d, 0x87, 0xf2, 0x93,
-	0x80, 0xb1, 0x0f, 0xcc, 0xdc, 0xd3, 0x36, 0xdd, 0x4e, 0x06, 0x49, 0x2e, 0xd1, 0x7d, 0xb8, 0xe7,
-	0x50, 0xfe, 0xca, 0x1f, 0x33, 0xb3, 0xa4, 0xad, 0xe5, 0x24, 0xb2, 0x56, 0x88, 0xac, 0x04, 0x6a,
-	0x42, 0xf8, 0x62, 0xec, 0x05, 0xd4, 0xd5, 0xdd, 0x40, 0xed, 0xac, 0x25, 0x91, 0x05, 0x1d, 0xca,
-	0x17, 0x09, 0x46, 0x0a, 0x0e, 0xf4, 0x18, 0xd6, 0x1c, 0xca, 0x9d, 0x01, 0xe5, 0x1e, 0xd3, 0xb3,
-	0x36, 0xcb, 0xfa, 0x0c, 0x4a, 0x22, 0x6b, 0x2d, 0x43, 0xd6, 0xe2, 0x74, 0x1a, 0x6d, 0xb9, 0xec,
-	0xb2, 0x92, 0x4f, 0x63, 0xc5, 0x48, 0xa6, 0xd0, 0x5b, 0x58, 0x4e, 0xa7, 0xcd, 0xdc, 0x97, 0x74,
-	0x18, 0x32, 0xb3, 0xaa, 0x5f, 0x5d, 0x2f, 0x89, 0xac, 0x22, 0xfe, 0xfa, 0xc3, 0x3a, 0x1c, 0x51,
-	0x35, 0x68, 0xf5, 0x7d, 0xaf, 0xd9, 0xe6, 0xea, 0x49, 0x61, 0xbf, 0x8e, 0x87, 0x81, 0xe0, 0x6e,
-	0x87, 0xa9, 0x4b, 0x11, 0x5c, 0xb4, 0x98, 0x8e, 0x0e, 0x3c, 0xd1, 0x72, 0xa9, 0xa2, 0x4d, 0xdb,
-	0xf7, 0xda, 0x5c, 0x39, 0x54, 0x2a, 0x16, 0x90, 0x62, 0x45, 0x24, 0x21, 0x4c, 0xdf, 0x9d, 0x5a,
-	0x5c, 0x5b, 0xd3, 0xd7, 0x76, 0xd3, 0x69, 0xe4, 0xf4, 0x66, 0x6e, 0x2d, 0x14, 0xac, 0x7f, 0xdf,
-	0x82, 0x30, 0x5d, 0x74, 0x47, 0xf0, 0x73, 0xdf, 0xfb, 0xc7, 0x55, 0xff, 0x04, 0xe0, 0x2d, 0x9b,
-	0x4a, 0xd6, 0x96, 0x32, 0xf4, 0xb9, 0xe7, 0x08, 0xa9, 0x96, 0x1b, 0xff, 0x3a, 0x89, 0xac, 0xf5,
-	0xd4, 0xcd, 0x34, 0xb1, 0x5e, 0x15, 0x9d, 0x40, 0x74, 0xea, 0xf3, 0xec, 0x93, 0x7a, 0xca, 0xb8,
-	0xa7, 0x06, 0xfa, 0x63, 0xaa, 0xda, 0x77, 0x93, 0xc8, 0xda, 0x90, 0x25, 0x1b, 0x98, 0xae, 0x43,
-	0xdf, 0xad, 0xd7, 0xd9, 0x2e, 0xd4, 0xf9, 0x2b, 0x4b, 0x36, 0x30, 0xbb, 0x33, 0x99, 0x61, 0x63,
-	0x3a, 0xc3, 0xc6, 0xd5, 0x0c, 0x83, 0x8f, 0x31, 0x06, 0x5f, 0x62, 0x0c, 0xbe, 0xc5, 0x18, 0x4c,
-	0x62, 0x0c, 0xa6, 0x31, 0x06, 0x3f, 0x63, 0x0c, 0x7e, 0xc7, 0xd8, 0xb8, 0x8a, 0x31, 0xf8, 0x3c,
-	0xc7, 0xc6, 0x64, 0x8e, 0x8d, 0xe9, 0x1c, 0x1b, 0x6f, 0xee, 0xc8, 0xf7, 0x52, 0xb1, 0x51, 0x77,
-	0x44, 0x03, 0xe5, 0x08, 0xae, 0x02, 0x7a, 0xa6, 0x64, 0x7f, 0x57, 0xff, 0x99, 0x1e, 0xfe, 0x09,
-	0x00, 0x00, 0xff, 0xff, 0x70, 0x93, 0xd0, 0x0b, 0xdd, 0x04, 0x00, 0x00,
+	// 665 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6b, 0x1b, 0x3d,
+	0x10, 0xf6, 0xe6, 0xc3, 0x71, 0x64, 0xe7, 0x03, 0xf1, 0xf2, 0xb2, 0xf4, 0x20, 0x19, 0x43, 0xc1,
+	0x50, 0x62, 0x43, 0xdb, 0x53, 0x7b, 0xca, 0x6e, 0x12, 0x30, 0x34, 0x69, 0x91, 0xdd, 0x0f, 0x7a,
+	0x93, 0xb3, 0xca, 0x7a, 0x89, 0x2d, 0x99, 0x95, 0xdc, 0x34, 0x3d, 0x95, 0xfe, 0x82, 0xfe, 0x8c,
+	0xd2, 0x5f, 0xd2, 0x63, 0x6e, 0x0d, 0x14, 0xb6, 0xcd, 0xe6, 0x52, 0xf6, 0x94, 0x9f, 0x50, 0x56,
+	0xeb, 0xfd, 0xf0, 0x36, 0xa7, 0x92, 0x93, 0x9e, 0x79, 0xe6, 0xd1, 0x0c, 0x33, 0x9a, 0x11, 0x00,
+	0x4c, 0x3a, 0xaa, 0x33, 0xf5, 0x85, 0x12, 0x70, 0x55, 0x1f, 0xf7, 0x76, 0x5c, 0x4f, 0x8d, 0x66,
+	0xc3, 0xce, 0xb1, 0x98, 0x74, 0x5d, 0xe1, 0x8a, 0xae, 0xa6, 0x87, 0xb3, 0x13, 0x6d, 0x69, 0x43,
+	0xa3, 0xe4, 0x56, 0xeb, 0x47, 0x15, 0xd4, 0xf6, 0xfb, 0x7b, 0x83, 0x3d, 0xaa, 0x28, 0x7c, 0x0c,
+	0x1a, 0xcf, 0xcf, 0x38, 0xf3, 0x77, 0x1d, 0xc7, 0x67, 0x52, 0x9a, 0x46, 0xd3, 0x68, 0x37, 0xac,
+	0xed, 0x28, 0xc0, 0x0b, 0x3c, 0x59, 0xb0, 0xe0, 0x03, 0xb0, 0x3e, 0x10, 0xa7, 0x8c, 0x1f, 0xd1,
+	0x09, 0x33, 0x97, 0xf4, 0x95, 0x8d, 0x28, 0xc0, 0x39, 0x49, 0x72, 0x08, 0xdb, 0xa0, 0x76, 0xe8,
+	0x71, 0x45, 0x87, 0x63, 0x66, 0x2e, 0x37, 0x8d, 0x76, 0xcd, 0x6a, 0x44, 0x01, 0xce, 0x38, 0x92,
+	0xa1, 0x58, 0x69, 0xcd, 0x7c, 0xae, 0x95, 0x2b, 0xb9, 0x32, 0xe5, 0x48, 0x86, 0x62, 0xa5, 0x4d,
+	0xf9, 0x0b, 0x3a, 0x93, 0xcc, 0x5c, 0xcd, 0x95, 0x29, 0x47, 0x32, 0x04, 0x5b, 0xa0, 0xaa, 0x81,
+	0x63, 0x56, 0xb5, 0x0e, 0x44, 0x01, 0x9e, 0x33, 0x64, 0x7e, 0xc6, 0xe5, 0xd8, 0x94, 0x1f, 0xf8,
+	0x8c, 0x7d, 0x60, 0xe6, 0x9a, 0x96, 0xe9, 0x72, 0x32, 0x92, 0xe4, 0x10, 0xde, 0x07, 0x6b, 0x36,
+	0xe5, 0xaf, 0xbd, 0x29, 0x33, 0x6b, 0x5a, 0x5a, 0x8f, 0x02, 0x9c, 0x52, 0x24, 0x05, 0xb0, 0x03,
+	0xc0, 0xcb, 0xa9, 0xeb, 0x53, 0x47, 0x57, 0x03, 0xb4, 0x72, 0x33, 0x0a, 0x30, 0xb0, 0x29, 0x4f,
+	0x1c, 0x8c, 0x14, 0x14, 0xf0, 0x09, 0xd8, 0xb4, 0x29, 0xb7, 0x47, 0x94, 0xbb, 0x4c, 0xf7, 0xda,
+	0xac, 0xeb, 0x3b, 0x30, 0x0a, 0x70, 0xc9, 0x43, 0x4a, 0x76, 0xdc, 0x8d, 0x9e, 0x9c, 0x57, 0xd9,
+	0xc8, 0xbb, 0x91, 0x72, 0x24, 0x43, 0xf0, 0x1d, 0xa8, 0xc7, 0xdd, 0x66, 0xce, 0x2b, 0x3a, 0x9e,
+	0x31, 0x73, 0x43, 0x3f, 0xdd, 0x20, 0x0a, 0x70, 0x91, 0xfe, 0xfa, 0x13, 0xef, 0x4e, 0xa8, 0x1a,
+	0x75, 0x87, 0x9e, 0xdb, 0xe9, 0x71, 0xf5, 0xb4, 0x30, 0x5f, 0xfb, 0x63, 0x5f, 0x70, 0xe7, 0x88,
+	0xa9, 0x33, 0xe1, 0x9f, 0x76, 0x99, 0xb6, 0x76, 0x5c, 0xd1, 0x75, 0xa8, 0xa2, 0x1d, 0xcb, 0x73,
+	0x7b, 0x5c, 0xd9, 0x54, 0x2a, 0xe6, 0x93, 0x62, 0x44, 0x28, 0x01, 0x88, 0xdf, 0x4e, 0x25, 0x69,
+	0x37, 0x75, 0xda, 0x7e, 0xdc, 0x8d, 0x9c, 0xbd, 0x9b, 0xac, 0x85, 0x80, 0xd9, 0x94, 0x0e, 0xce,
+	0xa7, 0xcc, 0xdc, 0x2a, 0x4d, 0x69, 0x4c, 0x92, 0x1c, 0xc2, 0x03, 0xd0, 0xe8, 0x4f, 0xd9, 0xb1,
+	0x47, 0xc7, 0x44, 0x8c, 0x99, 0x34, 0xb7, 0x9b, 0xcb, 0xed, 0xfa, 0xc3, 0xed, 0x64, 0x67, 0x3a,
+	0xf1, 0xbe, 0x68, 0x3e, 0x59, 0x8d, 0xa2, 0x92, 0x2c, 0x58, 0xad, 0x3e, 0x58, 0xcf, 0xc4, 0xf1,
+	0xac, 0x2c, 0x2e, 0x96, 0x9e, 0x95, 0x74, 0xa7, 0x52, 0x00, 0x31, 0x58, 0x4d, 0x92, 0x2e, 0x35,
+	0x97, 0xdb, 0x0d, 0x6b, 0x3d, 0x0a, 0x70, 0x42, 0x90, 0xe4, 0x68, 0x7d, 0x5f, 0x02, 0x20, 0x8e,
+	0x6a, 0x0b, 0x7e, 0xe2, 0xb9, 0xff, 0xb8, 0xb4, 0x9f, 0x0c, 0xb0, 0x65, 0x51, 0xc9, 0x7a, 0x52,
+	0xce, 0x3c, 0xee, 0xda, 0x42, 0xaa, 0xf9, 0xee, 0xbe, 0x89, 0x02, 0x5c, 0x76, 0xdd, 0xcd, 0x73,
+	0x94, 0xa3, 0xc2, 0x03, 0x00, 0x0f, 0x3d, 0x9e, 0x7d, 0x0e, 0xcf, 0x18, 0x77, 0xd5, 0x48, 0x7f,
+	0x0b, 0x1b, 0xd6, 0xff, 0x51, 0x80, 0x6f, 0xf1, 0x92, 0x5b, 0x38, 0x1d, 0x87, 0xbe, 0x2f, 0xc7,
+	0x59, 0x29, 0xc4, 0xf9, 0xcb, 0x4b, 0x6e, 0xe1, 0xac, 0xa3, 0x8b, 0x2b, 0x54, 0xb9, 0xbc, 0x42,
+	0x95, 0x9b, 0x2b, 0x64, 0x7c, 0x0c, 0x91, 0xf1, 0x25, 0x44, 0xc6, 0xb7, 0x10, 0x19, 0x17, 0x21,
+	0x32, 0x2e, 0x43, 0x64, 0xfc, 0x0a, 0x91, 0xf1, 0x3b, 0x44, 0x95, 0x9b, 0x10, 0x19, 0x9f, 0xaf,
+	0x51, 0xe5, 0xe2, 0x1a, 0x55, 0x2e, 0xaf, 0x51, 0xe5, 0xed, 0x7f, 0xf2, 0x5c, 0x2a, 0x36, 0xe9,
+	0x4f, 0xa8, 0xaf, 0x6c, 0xc1, 0x95, 0x4f, 0x8f, 0x95, 0x1c, 0x56, 0xf5, 0xbc, 0x3c, 0xfa, 0x33,
+	0x00, 0x15, 0x32, 0x73, 0x4e, 0xa7, 0x05, 0x00, 0x00,
 }
 
 func (this *ESDTData) Equal(that interface{}) bool {
@@ -338,6 +407,49 @@ func (this *ESDTData) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if !bytes.Equal(this.TokenType, that1.TokenType) {
+		return false
+	}
+	if len(this.SpecialRoles) != len(that1.SpecialRoles) {
+		return false
+	}
+	for i := range this.SpecialRoles {
+		if !this.SpecialRoles[i].Equal(that1.SpecialRoles[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ESDTRoles) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTRoles)
+	if !ok {
+		that2, ok := that.(ESDTRoles)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if !bytes.Equal(this.Roles[i], that1.Roles[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *ESDTConfig) Equal(that interface{}) bool {
@@ -380,7 +492,7 @@ func (this *ESDTData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 19)
 	s = append(s, "&systemSmartContracts.ESDTData{")
 	s = append(s, "OwnerAddress: "+fmt.Sprintf("%#v", this.OwnerAddress)+",\n")
 	s = append(s, "TokenName: "+fmt.Sprintf("%#v", this.TokenName)+",\n")
@@ -395,6 +507,21 @@ func (this *ESDTData) GoString() string {
 	s = append(s, "IsPaused: "+fmt.Sprintf("%#v", this.IsPaused)+",\n")
 	s = append(s, "MintedValue: "+fmt.Sprintf("%#v", this.MintedValue)+",\n")
 	s = append(s, "BurntValue: "+fmt.Sprintf("%#v", this.BurntValue)+",\n")
+	s = append(s, "TokenType: "+fmt.Sprintf("%#v", this.TokenType)+",\n")
+	if this.SpecialRoles != nil {
+		s = append(s, "SpecialRoles: "+fmt.Sprintf("%#v", this.SpecialRoles)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTRoles) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.ESDTRoles{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Roles: "+fmt.Sprintf("%#v", this.Roles)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -439,6 +566,29 @@ func (m *ESDTData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpecialRoles) > 0 {
+		for iNdEx := len(m.SpecialRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpecialRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEsdt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0x7a
+	}
 	{
 		__caster := &github_com_ElrondNetwork_elrond_go_data.BigIntCaster{}
 		size := __caster.Size(m.BurntValue)
@@ -568,6 +718,45 @@ func (m *ESDTData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ESDTRoles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTRoles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintEsdt(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEsdt(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ESDTConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -681,6 +870,35 @@ func (m *ESDTData) Size() (n int) {
 		l = __caster.Size(m.BurntValue)
 		n += 1 + l + sovEsdt(uint64(l))
 	}
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
+	if len(m.SpecialRoles) > 0 {
+		for _, e := range m.SpecialRoles {
+			l = e.Size()
+			n += 2 + l + sovEsdt(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ESDTRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEsdt(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, b := range m.Roles {
+			l = len(b)
+			n += 1 + l + sovEsdt(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -718,6 +936,11 @@ func (this *ESDTData) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForSpecialRoles := "[]*ESDTRoles{"
+	for _, f := range this.SpecialRoles {
+		repeatedStringForSpecialRoles += strings.Replace(f.String(), "ESDTRoles", "ESDTRoles", 1) + ","
+	}
+	repeatedStringForSpecialRoles += "}"
 	s := strings.Join([]string{`&ESDTData{`,
 		`OwnerAddress:` + fmt.Sprintf("%v", this.OwnerAddress) + `,`,
 		`TokenName:` + fmt.Sprintf("%v", this.TokenName) + `,`,
@@ -732,6 +955,19 @@ func (this *ESDTData) String() string {
 		`IsPaused:` + fmt.Sprintf("%v", this.IsPaused) + `,`,
 		`MintedValue:` + fmt.Sprintf("%v", this.MintedValue) + `,`,
 		`BurntValue:` + fmt.Sprintf("%v", this.BurntValue) + `,`,
+		`TokenType:` + fmt.Sprintf("%v", this.TokenType) + `,`,
+		`SpecialRoles:` + repeatedStringForSpecialRoles + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTRoles) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ESDTRoles{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Roles:` + fmt.Sprintf("%v", this.Roles) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1110,6 +1346,193 @@ func (m *ESDTData) Unmarshal(dAtA []byte) error {
 				}
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenType = append(m.TokenType[:0], dAtA[iNdEx:postIndex]...)
+			if m.TokenType == nil {
+				m.TokenType = []byte{}
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecialRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecialRoles = append(m.SpecialRoles, &ESDTRoles{})
+			if err := m.SpecialRoles[len(m.SpecialRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEsdt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTRoles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEsdt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTRoles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEsdt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEsdt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, make([]byte, postIndex-iNdEx))
+			copy(m.Roles[len(m.Roles)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEsdt(dAtA[iNdEx:])
@@ -4,13 +4,16 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/mock"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createMockArgumentsForESDT() ArgsNewESDTSmartContract {
@@ -20,10 +23,11 @@ func createMockArgumentsForESDT() ArgsNewESDTSmartContract {
 		ESDTSCConfig: config.ESDTSystemSCConfig{
 			BaseIssuingCost: "1000",
 		},
-		ESDTSCAddress: []byte("address"),
-		Marshalizer:   &mock.MarshalizerMock{},
-		Hasher:        &mock.HasherMock{},
-		EpochNotifier: &mock.EpochNotifierStub{},
+		ESDTSCAddress:       []byte("address"),
+		GovernanceSCAddress: []byte("governance"),
+		Marshalizer:         &mock.MarshalizerMock{},
+		Hasher:              &mock.HasherMock{},
+		EpochNotifier:       &mock.EpochNotifierStub{},
 	}
 }
 
@@ -168,3 +172,692 @@ func TestEsdt_ExecuteIssueDisabled(t *testing.T) {
 	output := e.Execute(vmInput)
 	assert.Equal(t, vmcommon.UserError, output)
 }
+
+func TestEsdt_ExecuteIssueSemiFungibleShouldSaveTokenAndSetRoles(t *testing.T) {
+	t.Parallel()
+
+	storage := make(map[string][]byte)
+	transferredData := ""
+	args := createMockArgumentsForESDT()
+	args.Eei = &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			return storage[string(key)]
+		},
+		SetStorageCalled: func(key []byte, value []byte) {
+			storage[string(key)] = value
+		},
+		TransferCalled: func(destination []byte, sender []byte, value *big.Int, input []byte) error {
+			transferredData = string(input)
+			return nil
+		},
+	}
+	e, _ := NewESDTSmartContract(args)
+
+	callValue, _ := big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	tokenName := []byte("01234567891")
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("addr"),
+			Arguments:   [][]byte{tokenName},
+			CallValue:   callValue,
+			GasProvided: args.GasCost.MetaChainSystemSCsCost.ESDTIssue,
+		},
+		RecipientAddr: []byte("addr"),
+		Function:      "issueSemiFungible",
+	}
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	token, err := e.getExistingToken(tokenName)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(core.SemiFungibleESDT), token.TokenType)
+	assert.Equal(t, big.NewInt(0), token.MintedValue)
+
+	expectedData := core.BuiltInFunctionESDTSetRole + "@" + hex.EncodeToString(tokenName) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleNFTCreate)) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleNFTBurn)) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleNFTAddQuantity))
+	assert.Equal(t, expectedData, transferredData)
+
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+}
+
+func TestEsdt_ExecuteIssueNonFungibleShouldNotGiveAddQuantityRole(t *testing.T) {
+	t.Parallel()
+
+	transferredData := ""
+	args := createMockArgumentsForESDT()
+	args.Eei = &mock.SystemEIStub{
+		TransferCalled: func(destination []byte, sender []byte, value *big.Int, input []byte) error {
+			transferredData = string(input)
+			return nil
+		},
+	}
+	e, _ := NewESDTSmartContract(args)
+
+	callValue, _ := big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	tokenName := []byte("01234567891")
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("addr"),
+			Arguments:   [][]byte{tokenName, []byte(canFreeze), []byte("true")},
+			CallValue:   callValue,
+			GasProvided: args.GasCost.MetaChainSystemSCsCost.ESDTIssue,
+		},
+		RecipientAddr: []byte("addr"),
+		Function:      "issueNonFungible",
+	}
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	expectedData := core.BuiltInFunctionESDTSetRole + "@" + hex.EncodeToString(tokenName) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleNFTCreate)) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleNFTBurn))
+	assert.Equal(t, expectedData, transferredData)
+}
+
+func TestEsdt_ExecuteMintNonFungibleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	tokenName := []byte("01234567891")
+	args := createMockArgumentsForESDT()
+	marshalizer := args.Marshalizer
+	args.Eei = &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			token := &ESDTData{
+				OwnerAddress: owner,
+				TokenName:    tokenName,
+				Mintable:     true,
+				MintedValue:  big.NewInt(0),
+				BurntValue:   big.NewInt(0),
+				TokenType:    []byte(core.NonFungibleESDT),
+			}
+			marshaledData, _ := marshalizer.Marshal(token)
+			return marshaledData
+		},
+	}
+	e, _ := NewESDTSmartContract(args)
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: owner,
+			Arguments:  [][]byte{tokenName, big.NewInt(10).Bytes()},
+			CallValue:  big.NewInt(0),
+		},
+		RecipientAddr: []byte("addr"),
+		Function:      "mint",
+	}
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+}
+
+func TestEsdt_ExecuteNonFungibleTokensBeforeActivationShouldKeepTheOldBehaviour(t *testing.T) {
+	t.Parallel()
+
+	storage := make(map[string][]byte)
+	args := createMockArgumentsForESDT()
+	args.ESDTSCConfig.NonFungibleTokensEnableEpoch = 5
+	args.Eei = &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			return storage[string(key)]
+		},
+		SetStorageCalled: func(key []byte, value []byte) {
+			storage[string(key)] = value
+		},
+	}
+	e, _ := NewESDTSmartContract(args)
+
+	callValue, _ := big.NewInt(0).SetString(args.ESDTSCConfig.BaseIssuingCost, 10)
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("addr"),
+			Arguments:   [][]byte{[]byte("01234567891")},
+			CallValue:   callValue,
+			GasProvided: args.GasCost.MetaChainSystemSCsCost.ESDTIssue,
+		},
+		RecipientAddr: []byte("addr"),
+		Function:      "issueNonFungible",
+	}
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+
+	fungibleTokenName := []byte("01234567892")
+	vmInput.Function = "issue"
+	vmInput.Arguments = [][]byte{fungibleTokenName, big.NewInt(100).Bytes()}
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	token, err := e.getExistingToken(fungibleTokenName)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(token.TokenType))
+
+	e.EpochConfirmed(5)
+	vmInput.Function = "issueNonFungible"
+	vmInput.Arguments = [][]byte{[]byte("01234567891")}
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.Ok, output)
+}
+
+func createESDTWithStorageForRoles(t *testing.T, token *ESDTData) (*esdt, *mock.SystemEIStub, map[string][]byte) {
+	args := createMockArgumentsForESDT()
+	storage := make(map[string][]byte)
+	marshaledData, err := args.Marshalizer.Marshal(token)
+	require.Nil(t, err)
+	storage[string(token.TokenName)] = marshaledData
+
+	eei := &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			return storage[string(key)]
+		},
+		SetStorageCalled: func(key []byte, value []byte) {
+			storage[string(key)] = value
+		},
+	}
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	return e, eei, storage
+}
+
+func createSpecialRoleInput(function string, caller []byte, tokenName []byte, address []byte, roles ...string) *vmcommon.ContractCallInput {
+	arguments := [][]byte{tokenName, address}
+	for _, role := range roles {
+		arguments = append(arguments, []byte(role))
+	}
+
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: caller,
+			Arguments:  arguments,
+			CallValue:  big.NewInt(0),
+		},
+		RecipientAddr: vm.ESDTSCAddress,
+		Function:      function,
+	}
+}
+
+func TestEsdt_ExecuteSetSpecialRoleShouldSaveAndSendRoles(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	address := []byte("addrr")
+	tokenName := []byte("01234567891")
+	e, eei, storage := createESDTWithStorageForRoles(t, &ESDTData{
+		OwnerAddress: owner,
+		TokenName:    tokenName,
+		MintedValue:  big.NewInt(0),
+		BurntValue:   big.NewInt(0),
+	})
+	transferredData := ""
+	eei.TransferCalled = func(destination []byte, sender []byte, value *big.Int, input []byte) error {
+		assert.Equal(t, address, destination)
+		transferredData = string(input)
+		return nil
+	}
+
+	vmInput := createSpecialRoleInput("setSpecialRole", owner, tokenName, address, core.ESDTRoleLocalMint, core.ESDTRoleLocalBurn)
+	output := e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	expectedData := core.BuiltInFunctionESDTSetRole + "@" + hex.EncodeToString(tokenName) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleLocalMint)) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleLocalBurn))
+	assert.Equal(t, expectedData, transferredData)
+
+	token := &ESDTData{}
+	_ = e.marshalizer.Unmarshal(token, storage[string(tokenName)])
+	require.Equal(t, 1, len(token.SpecialRoles))
+	assert.Equal(t, address, token.SpecialRoles[0].Address)
+	assert.Equal(t, 2, len(token.SpecialRoles[0].Roles))
+
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.ReturnMessage, vm.ErrRoleAlreadyAssigned.Error()))
+}
+
+func TestEsdt_ExecuteSetSpecialRoleInvalidCallsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	address := []byte("addrr")
+	tokenName := []byte("01234567891")
+	e, eei, _ := createESDTWithStorageForRoles(t, &ESDTData{
+		OwnerAddress: owner,
+		TokenName:    tokenName,
+		MintedValue:  big.NewInt(0),
+		BurntValue:   big.NewInt(0),
+	})
+
+	vmInput := createSpecialRoleInput("setSpecialRole", owner, tokenName, address)
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput = createSpecialRoleInput("setSpecialRole", address, tokenName, address, core.ESDTRoleLocalMint)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput = createSpecialRoleInput("setSpecialRole", owner, tokenName, []byte("invalidAddress"), core.ESDTRoleLocalMint)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput = createSpecialRoleInput("setSpecialRole", owner, tokenName, address, core.ESDTRoleNFTCreate)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.ReturnMessage, vm.ErrInvalidRoleForToken.Error()))
+}
+
+func TestEsdt_ExecuteUnSetSpecialRoleShouldRemoveAndSendRoles(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	address := []byte("addrr")
+	tokenName := []byte("01234567891")
+	e, eei, storage := createESDTWithStorageForRoles(t, &ESDTData{
+		OwnerAddress: owner,
+		TokenName:    tokenName,
+		MintedValue:  big.NewInt(0),
+		BurntValue:   big.NewInt(0),
+		SpecialRoles: []*ESDTRoles{
+			{
+				Address: address,
+				Roles:   [][]byte{[]byte(core.ESDTRoleLocalMint), []byte(core.ESDTRoleLocalBurn)},
+			},
+		},
+	})
+	transferredData := ""
+	eei.TransferCalled = func(destination []byte, sender []byte, value *big.Int, input []byte) error {
+		transferredData = string(input)
+		return nil
+	}
+
+	vmInput := createSpecialRoleInput("unSetSpecialRole", owner, tokenName, address, core.ESDTRoleLocalMint)
+	output := e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+	expectedData := core.BuiltInFunctionESDTUnSetRole + "@" + hex.EncodeToString(tokenName) +
+		"@" + hex.EncodeToString([]byte(core.ESDTRoleLocalMint))
+	assert.Equal(t, expectedData, transferredData)
+
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.ReturnMessage, vm.ErrRoleNotAssigned.Error()))
+
+	vmInput = createSpecialRoleInput("unSetSpecialRole", owner, tokenName, address, core.ESDTRoleLocalBurn)
+	output = e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	token := &ESDTData{}
+	_ = e.marshalizer.Unmarshal(token, storage[string(tokenName)])
+	assert.Equal(t, 0, len(token.SpecialRoles))
+}
+
+func createESDTWithStorage(args ArgsNewESDTSmartContract) (*esdt, *mock.SystemEIStub) {
+	storage := make(map[string][]byte)
+	eei := &mock.SystemEIStub{
+		GetStorageCalled: func(key []byte) []byte {
+			return storage[string(key)]
+		},
+		SetStorageCalled: func(key []byte, value []byte) {
+			storage[string(key)] = value
+		},
+	}
+	args.Eei = eei
+	e, _ := NewESDTSmartContract(args)
+
+	return e, eei
+}
+
+func createConfigChangeInput(caller []byte, newOwner []byte, baseIssuingCost int64, minLength int64, maxLength int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: caller,
+			CallValue:  big.NewInt(0),
+			Arguments: [][]byte{
+				newOwner,
+				big.NewInt(baseIssuingCost).Bytes(),
+				big.NewInt(minLength).Bytes(),
+				big.NewInt(maxLength).Bytes(),
+			},
+		},
+		RecipientAddr: vm.ESDTSCAddress,
+		Function:      "configChange",
+	}
+}
+
+func createRecordLocalSupplyInput(function string, caller []byte, tokenName []byte, value int64) *vmcommon.ContractCallInput {
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: caller,
+			Arguments:  [][]byte{tokenName, big.NewInt(value).Bytes()},
+			CallValue:  big.NewInt(0),
+			CallType:   vmcommon.AsynchronousCall,
+		},
+		RecipientAddr: vm.ESDTSCAddress,
+		Function:      function,
+	}
+}
+
+func TestEsdt_ExecuteRecordLocalMintAndBurnShouldUpdateSupply(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	address := []byte("addrr")
+	tokenName := []byte("01234567891")
+	e, _, storage := createESDTWithStorageForRoles(t, &ESDTData{
+		OwnerAddress: owner,
+		TokenName:    tokenName,
+		MintedValue:  big.NewInt(100),
+		BurntValue:   big.NewInt(0),
+		SpecialRoles: []*ESDTRoles{
+			{
+				Address: address,
+				Roles:   [][]byte{[]byte(core.ESDTRoleLocalMint), []byte(core.ESDTRoleLocalBurn)},
+			},
+		},
+	})
+
+	output := e.Execute(createRecordLocalSupplyInput(core.BuiltInFunctionESDTLocalMint, address, tokenName, 20))
+	require.Equal(t, vmcommon.Ok, output)
+	output = e.Execute(createRecordLocalSupplyInput(core.BuiltInFunctionESDTLocalBurn, address, tokenName, 5))
+	require.Equal(t, vmcommon.Ok, output)
+
+	token := &ESDTData{}
+	_ = e.marshalizer.Unmarshal(token, storage[string(tokenName)])
+	assert.Equal(t, big.NewInt(120), token.MintedValue)
+	assert.Equal(t, big.NewInt(5), token.BurntValue)
+}
+
+func TestEsdt_ExecuteRecordLocalMintAndBurnInvalidCallsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	address := []byte("addrr")
+	tokenName := []byte("01234567891")
+	e, eei, storage := createESDTWithStorageForRoles(t, &ESDTData{
+		OwnerAddress: owner,
+		TokenName:    tokenName,
+		MintedValue:  big.NewInt(0),
+		BurntValue:   big.NewInt(0),
+		SpecialRoles: []*ESDTRoles{
+			{
+				Address: address,
+				Roles:   [][]byte{[]byte(core.ESDTRoleLocalMint)},
+			},
+		},
+	})
+
+	output := e.Execute(createRecordLocalSupplyInput(core.BuiltInFunctionESDTLocalBurn, address, tokenName, 5))
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.ReturnMessage, core.ESDTRoleLocalBurn))
+
+	output = e.Execute(createRecordLocalSupplyInput(core.BuiltInFunctionESDTLocalMint, owner, tokenName, 5))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createRecordLocalSupplyInput(core.BuiltInFunctionESDTLocalMint, address, tokenName, 0))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	output = e.Execute(createRecordLocalSupplyInput(core.BuiltInFunctionESDTLocalMint, address, []byte("missingToken"), 5))
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput := createRecordLocalSupplyInput(core.BuiltInFunctionESDTLocalMint, address, tokenName, 5)
+	vmInput.CallType = vmcommon.DirectCall
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.True(t, strings.Contains(eei.ReturnMessage, "can be called only through the local built-in function"))
+
+	vmInput = createRecordLocalSupplyInput(core.BuiltInFunctionESDTLocalMint, address, tokenName, 5)
+	vmInput.Arguments = vmInput.Arguments[:1]
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	token := &ESDTData{}
+	_ = e.marshalizer.Unmarshal(token, storage[string(tokenName)])
+	assert.Equal(t, big.NewInt(0), token.MintedValue)
+	assert.Equal(t, big.NewInt(0), token.BurntValue)
+}
+
+func TestEsdt_ExecuteSpecialRolesBeforeActivationShouldErr(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	address := []byte("addrr")
+	tokenName := []byte("01234567891")
+	token := &ESDTData{
+		OwnerAddress: owner,
+		TokenName:    tokenName,
+		MintedValue:  big.NewInt(0),
+		BurntValue:   big.NewInt(0),
+		SpecialRoles: []*ESDTRoles{
+			{
+				Address: address,
+				Roles:   [][]byte{[]byte(core.ESDTRoleLocalMint)},
+			},
+		},
+	}
+	e, _, storage := createESDTWithStorageForRoles(t, token)
+	e.specialRolesEnableEpoch = 5
+	e.EpochConfirmed(4)
+
+	output := e.Execute(createSpecialRoleInput("setSpecialRole", owner, tokenName, address, core.ESDTRoleLocalBurn))
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+	output = e.Execute(createSpecialRoleInput("unSetSpecialRole", owner, tokenName, address, core.ESDTRoleLocalMint))
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+	output = e.Execute(createRecordLocalSupplyInput(core.BuiltInFunctionESDTLocalMint, address, tokenName, 5))
+	assert.Equal(t, vmcommon.FunctionNotFound, output)
+
+	savedToken := &ESDTData{}
+	_ = e.marshalizer.Unmarshal(savedToken, storage[string(tokenName)])
+	assert.Equal(t, token.SpecialRoles, savedToken.SpecialRoles)
+	assert.Equal(t, big.NewInt(0), savedToken.MintedValue)
+
+	e.EpochConfirmed(5)
+	output = e.Execute(createRecordLocalSupplyInput(core.BuiltInFunctionESDTLocalMint, address, tokenName, 5))
+	assert.Equal(t, vmcommon.Ok, output)
+}
+
+func TestEsdt_ExecuteConfigChangeInvalidCallsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	args := createMockArgumentsForESDT()
+	args.ESDTSCConfig.OwnerAddress = string(owner)
+	e, eei := createESDTWithStorage(args)
+
+	vmInput := createConfigChangeInput([]byte("other"), owner, 10, 3, 20)
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput = createConfigChangeInput(owner, owner, 10, 3, 20)
+	vmInput.CallValue = big.NewInt(1)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.OutOfFunds, output)
+
+	vmInput = createConfigChangeInput(owner, owner, 10, 3, 20)
+	vmInput.Arguments = vmInput.Arguments[:3]
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+
+	vmInput = createConfigChangeInput(owner, []byte("invalidOwner"), 10, 3, 20)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput = createConfigChangeInput(owner, owner, 10, 0, 20)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, vm.ErrInvalidTokenNameLength.Error(), eei.ReturnMessage)
+
+	vmInput = createConfigChangeInput(owner, owner, 10, 21, 20)
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Equal(t, vm.ErrInvalidTokenNameLength.Error(), eei.ReturnMessage)
+}
+
+func TestEsdt_ExecuteConfigChangeShouldApplyNewConfig(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	newOwner := []byte("newOw")
+	args := createMockArgumentsForESDT()
+	args.ESDTSCConfig.OwnerAddress = string(owner)
+	e, _ := createESDTWithStorage(args)
+
+	vmInput := createConfigChangeInput(owner, newOwner, 50, 3, 5)
+	output := e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	esdtConfig := e.getESDTConfig()
+	assert.Equal(t, newOwner, esdtConfig.OwnerAddress)
+	assert.Equal(t, big.NewInt(50), esdtConfig.BaseIssuingCost)
+	assert.Equal(t, uint32(3), esdtConfig.MinTokenNameLength)
+	assert.Equal(t, uint32(5), esdtConfig.MaxTokenNameLength)
+
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	issueInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("addr"),
+			Arguments:   [][]byte{[]byte("TKN"), big.NewInt(100).Bytes()},
+			CallValue:   big.NewInt(1000),
+			GasProvided: args.GasCost.MetaChainSystemSCsCost.ESDTIssue,
+		},
+		RecipientAddr: vm.ESDTSCAddress,
+		Function:      "issue",
+	}
+	output = e.Execute(issueInput)
+	assert.Equal(t, vmcommon.OutOfFunds, output)
+
+	issueInput.CallValue = big.NewInt(50)
+	output = e.Execute(issueInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	issueInput.Arguments[0] = []byte("TOKENS")
+	output = e.Execute(issueInput)
+	assert.Equal(t, vmcommon.FunctionWrongSignature, output)
+}
+
+func TestEsdt_ExecuteConfigChangeFromGovernanceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	newOwner := []byte("newOwnerAd")
+	args := createMockArgumentsForESDT()
+	args.ESDTSCConfig.OwnerAddress = string(owner)
+	e, _ := createESDTWithStorage(args)
+
+	vmInput := createConfigChangeInput(args.GovernanceSCAddress, newOwner, 50, 3, 5)
+	output := e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+
+	esdtConfig := e.getESDTConfig()
+	assert.Equal(t, newOwner, esdtConfig.OwnerAddress)
+	assert.Equal(t, big.NewInt(50), esdtConfig.BaseIssuingCost)
+
+	otherOwner := []byte("otherOwner")
+	vmInput = createConfigChangeInput(args.GovernanceSCAddress, otherOwner, 60, 3, 5)
+	output = e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, otherOwner, e.getESDTConfig().OwnerAddress)
+}
+
+func TestEsdt_ExecuteConfigChangeBeforeActivationShouldKeepTheOldBehaviour(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	args := createMockArgumentsForESDT()
+	args.ESDTSCConfig.OwnerAddress = string(owner)
+	args.ESDTSCConfig.ConfigChangeEnableEpoch = 5
+	e, eei := createESDTWithStorage(args)
+
+	storedConfig := &ESDTConfig{
+		OwnerAddress:       []byte("other"),
+		BaseIssuingCost:    big.NewInt(50),
+		MinTokenNameLength: 3,
+		MaxTokenNameLength: 5,
+	}
+	err := e.saveESDTConfig(storedConfig)
+	require.Nil(t, err)
+	eei.TransferCalled = func(destination []byte, sender []byte, value *big.Int, input []byte) error {
+		assert.NotNil(t, input, "no issuing fees should be claimed")
+		return nil
+	}
+	eei.GetBalanceCalled = func(addr []byte) *big.Int {
+		return big.NewInt(5000)
+	}
+
+	output := e.Execute(createConfigChangeInput(owner, owner, 10, 3, 20))
+	assert.Equal(t, vmcommon.Ok, output)
+	output = e.Execute(&vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: owner,
+			CallValue:  big.NewInt(0),
+		},
+		RecipientAddr: vm.ESDTSCAddress,
+		Function:      "claim",
+	})
+	assert.Equal(t, vmcommon.Ok, output)
+
+	issueInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("addr"),
+			Arguments:   [][]byte{[]byte("01234567891"), big.NewInt(100).Bytes()},
+			CallValue:   big.NewInt(1000),
+			GasProvided: args.GasCost.MetaChainSystemSCsCost.ESDTIssue,
+		},
+		RecipientAddr: vm.ESDTSCAddress,
+		Function:      "issue",
+	}
+	output = e.Execute(issueInput)
+	assert.Equal(t, vmcommon.Ok, output)
+
+	e.EpochConfirmed(5)
+	assert.Equal(t, storedConfig, e.getESDTConfig())
+	issueInput.Arguments[0] = []byte("TKN")
+	issueInput.CallValue = big.NewInt(50)
+	output = e.Execute(issueInput)
+	assert.Equal(t, vmcommon.Ok, output)
+}
+
+func TestEsdt_ExecuteClaimShouldTransferBalanceToOwner(t *testing.T) {
+	t.Parallel()
+
+	owner := []byte("owner")
+	args := createMockArgumentsForESDT()
+	args.ESDTSCConfig.OwnerAddress = string(owner)
+	e, eei := createESDTWithStorage(args)
+
+	scBalance := big.NewInt(0)
+	eei.GetBalanceCalled = func(addr []byte) *big.Int {
+		assert.Equal(t, args.ESDTSCAddress, addr)
+		return scBalance
+	}
+	var transferredValue *big.Int
+	eei.TransferCalled = func(destination []byte, sender []byte, value *big.Int, input []byte) error {
+		assert.Equal(t, owner, destination)
+		assert.Equal(t, args.ESDTSCAddress, sender)
+		transferredValue = value
+		return nil
+	}
+
+	vmInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr: []byte("other"),
+			CallValue:  big.NewInt(0),
+		},
+		RecipientAddr: vm.ESDTSCAddress,
+		Function:      "claim",
+	}
+	output := e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+
+	vmInput.CallerAddr = owner
+	output = e.Execute(vmInput)
+	assert.Equal(t, vmcommon.UserError, output)
+	assert.Nil(t, transferredValue)
+
+	scBalance = big.NewInt(5000)
+	output = e.Execute(vmInput)
+	require.Equal(t, vmcommon.Ok, output)
+	assert.Equal(t, big.NewInt(5000), transferredValue)
+}
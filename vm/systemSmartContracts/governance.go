@@ -21,6 +21,7 @@ const hardForkPrefix = "hardFork"
 const proposalPrefix = "proposal"
 const whiteListPrefix = "whiteList"
 const validatorPrefix = "validator"
+const validatorVotingPrefix = "validatorVoting"
 const hardForkEpochGracePeriod = 2
 const githubCommitLength = 40
 
@@ -51,6 +52,9 @@ type governanceContract struct {
 	governanceConfig    config.GovernanceSystemSCConfig
 	enabledEpoch        uint32
 	flagEnabled         atomic.Flag
+
+	voteDelegationEnableEpoch uint32
+	flagVoteDelegation        atomic.Flag
 }
 
 // NewGovernanceContract creates a new governance smart contract
@@ -85,6 +89,8 @@ func NewGovernanceContract(args ArgsNewGovernanceContract) (*governanceContract,
 		hasher:              args.Hasher,
 		governanceConfig:    args.GovernanceConfig,
 		enabledEpoch:        args.GovernanceConfig.EnabledEpoch,
+
+		voteDelegationEnableEpoch: args.GovernanceConfig.VoteDelegationEnableEpoch,
 	}
 	args.EpochNotifier.RegisterNotifyHandler(g)
 
@@ -279,7 +285,7 @@ func (g *governanceContract) whiteListProposal(args *vmcommon.ContractCallInput)
 	}
 	g.eei.SetStorage(key, marshaledData)
 
-	err = g.saveGeneralProposal(args.CallerAddr, generalProposal)
+	err = g.saveNewGeneralProposal(args.CallerAddr, generalProposal)
 	if err != nil {
 		g.eei.AddReturnMessage("save proposal error " + err.Error())
 		return vmcommon.UserError
@@ -299,6 +305,31 @@ func (g *governanceContract) saveGeneralProposal(reference []byte, generalPropos
 	return nil
 }
 
+// saveNewGeneralProposal saves a newly created proposal. The proposals created after the vote delegation was enabled
+// keep the votes per validator, while the older ones keep reading and writing the votes per voter
+func (g *governanceContract) saveNewGeneralProposal(reference []byte, generalProposal *GeneralProposal) error {
+	err := g.saveGeneralProposal(reference, generalProposal)
+	if err != nil {
+		return err
+	}
+
+	if g.flagVoteDelegation.IsSet() {
+		key := append([]byte(validatorVotingPrefix), reference...)
+		g.eei.SetStorage(key, []byte{1})
+	}
+
+	return nil
+}
+
+func (g *governanceContract) hasValidatorVoting(reference []byte) bool {
+	if !g.flagVoteDelegation.IsSet() {
+		return false
+	}
+
+	key := append([]byte(validatorVotingPrefix), reference...)
+	return len(g.eei.GetStorage(key)) > 0
+}
+
 func (g *governanceContract) startEndNonceFromArguments(argStart []byte, argEnd []byte) (uint64, uint64, error) {
 	startVoteNonce, okConvert := big.NewInt(0).SetString(string(argStart), conversionBase)
 	if !okConvert {
@@ -500,7 +531,7 @@ func (g *governanceContract) hardForkProposal(args *vmcommon.ContractCallInput)
 	}
 	g.eei.SetStorage(key, marshaledData)
 
-	err = g.saveGeneralProposal(args.Arguments[0], generalProposal)
+	err = g.saveNewGeneralProposal(args.Arguments[0], generalProposal)
 	if err != nil {
 		log.Warn("save general proposal", err, "error")
 		g.eei.AddReturnMessage("saveGeneralProposal" + err.Error())
@@ -556,7 +587,7 @@ func (g *governanceContract) proposal(args *vmcommon.ContractCallInput) vmcommon
 		Voted:          false,
 		Voters:         make([][]byte, 0),
 	}
-	err = g.saveGeneralProposal(gitHubCommit, generalProposal)
+	err = g.saveNewGeneralProposal(gitHubCommit, generalProposal)
 	if err != nil {
 		log.Warn("saveGeneralProposal", "err", err)
 		g.eei.AddReturnMessage("saveGeneralProposal" + err.Error())
@@ -612,7 +643,6 @@ func (g *governanceContract) vote(args *vmcommon.ContractCallInput) vmcommon.Ret
 		return vmcommon.UserError
 	}
 
-	numNodesToVote := int32(0)
 	validatorData, err := g.getOrCreateValidatorData(validatorAddress, int32(numStakedNodes))
 	if err != nil {
 		log.Warn("getOrCreateValidatorData", "err", err)
@@ -620,20 +650,17 @@ func (g *governanceContract) vote(args *vmcommon.ContractCallInput) vmcommon.Ret
 		return vmcommon.UserError
 	}
 
-	found := false
-	for _, voter := range validatorData.Delegators {
-		if bytes.Equal(voter.Address, voterAddress) {
-			found = true
-			numNodesToVote = voter.NumNodes
-			break
-		}
-	}
-	if !found || numNodesToVote <= 0 {
+	numNodesToVote := votePowerOf(validatorData, voterAddress)
+	if numNodesToVote <= 0 {
 		g.eei.AddReturnMessage("address has 0 voting power")
 		return vmcommon.UserError
 	}
 
-	err = g.voteForProposal(proposalToVote, voteString, voterAddress, numNodesToVote)
+	if g.hasValidatorVoting(proposalToVote) {
+		err = g.voteForProposalOfValidator(proposalToVote, voteString, voterAddress, validatorAddress, numNodesToVote, validatorData.NumNodes)
+	} else {
+		err = g.voteForProposal(proposalToVote, voteString, voterAddress, numNodesToVote)
+	}
 	if err != nil {
 		g.eei.AddReturnMessage("voteForProposal " + err.Error())
 		return vmcommon.UserError
@@ -708,6 +735,75 @@ func (g *governanceContract) voteForProposal(
 	return nil
 }
 
+// voteForProposalOfValidator registers the vote of the voter on behalf of the validator. All the votes cast on behalf
+// of a validator, by itself or by its delegates, can not sum up to more than the number of staked nodes of that validator
+func (g *governanceContract) voteForProposalOfValidator(
+	proposal []byte,
+	vote string,
+	voter []byte,
+	validator []byte,
+	numVotes int32,
+	maxVotes int32,
+) error {
+	generalProposal, err := g.getGeneralProposal(proposal)
+	if err != nil {
+		return err
+	}
+	currentNonce := g.eei.BlockChainHook().CurrentNonce()
+	if currentNonce < generalProposal.StartVoteNonce {
+		return vm.ErrVotedForAProposalThatNotBeginsYet
+	}
+
+	if currentNonce > generalProposal.EndVoteNonce {
+		return vm.ErrVotedForAnExpiredProposal
+	}
+
+	validatorVoteData, err := g.getOrCreateValidatorVoteData(proposal, validator)
+	if err != nil {
+		log.Warn("getOrCreateValidatorVoteData", "err", err)
+		return err
+	}
+
+	voteData := getOrAddVoteOf(validatorVoteData, voter)
+	usedByOthers := validatorVoteData.UsedVotes - voteData.NumVotes
+	if usedByOthers+numVotes > maxVotes {
+		numVotes = maxVotes - usedByOthers
+	}
+	if numVotes <= 0 {
+		return vm.ErrVotePowerAlreadyUsed
+	}
+	if voteData.NumVotes == numVotes && voteData.VoteValue == vote {
+		return nil
+	}
+
+	isFirstVoteForValidator := validatorVoteData.UsedVotes == 0
+	oldNum := voteData.NumVotes
+	oldValue := voteData.VoteValue
+
+	voteData.NumVotes = numVotes
+	voteData.VoteValue = vote
+	validatorVoteData.UsedVotes = usedByOthers + numVotes
+	err = g.saveValidatorVoteData(proposal, validator, validatorVoteData)
+	if err != nil {
+		log.Warn("saveValidatorVoteData", "err", err)
+		return err
+	}
+
+	if isFirstVoteForValidator {
+		generalProposal.Voters = append(generalProposal.Voters, validator)
+	}
+	g.addVotedDataToProposal(generalProposal, oldValue, -oldNum)
+	g.addVotedDataToProposal(generalProposal, vote, numVotes)
+
+	err = g.saveGeneralProposal(proposal, generalProposal)
+	if err != nil {
+		log.Warn("saveGeneralProposal", "err", err)
+		return err
+	}
+
+	return nil
+}
+
 func (g *governanceContract) addVotedDataToProposal(generalProposal *GeneralProposal, voteValue string, numVotes int32) {
 	switch voteValue {
 	case "yes":
@@ -748,46 +844,258 @@ func (g *governanceContract) getOrCreateVoteData(proposal []byte, voter []byte)
 	return voteData, nil
 }
 
-func (g *governanceContract) getOrCreateValidatorData(address []byte, numNodes int32) (*ValidatorData, error) {
-	validatorData := &ValidatorData{
-		Delegators: make([]*VoterData, 1),
-		NumNodes:   numNodes,
+func getOrAddVoteOf(validatorVoteData *ValidatorVoteData, voter []byte) *VoteData {
+	for _, voteData := range validatorVoteData.Votes {
+		if bytes.Equal(voteData.Voter, voter) {
+			return voteData
+		}
 	}
-	validatorData.Delegators[0] = &VoterData{
-		Address:  address,
-		NumNodes: numNodes,
+
+	voteData := &VoteData{Voter: voter}
+	validatorVoteData.Votes = append(validatorVoteData.Votes, voteData)
+
+	return voteData
+}
+
+func (g *governanceContract) saveValidatorVoteData(proposal []byte, validator []byte, validatorVoteData *ValidatorVoteData) error {
+	key := append(proposal, validator...)
+	marshaledData, err := g.marshalizer.Marshal(validatorVoteData)
+	if err != nil {
+		return err
 	}
 
-	key := append([]byte(validatorPrefix), address...)
+	g.eei.SetStorage(key, marshaledData)
+	return nil
+}
+
+func (g *governanceContract) getOrCreateValidatorVoteData(proposal []byte, validator []byte) (*ValidatorVoteData, error) {
+	validatorVoteData := &ValidatorVoteData{
+		Votes: make([]*VoteData, 0),
+	}
+	key := append(proposal, validator...)
 	marshaledData := g.eei.GetStorage(key)
 	if len(marshaledData) == 0 {
-		return validatorData, nil
+		return validatorVoteData, nil
 	}
 
-	err := g.marshalizer.Unmarshal(validatorData, marshaledData)
+	err := g.marshalizer.Unmarshal(validatorVoteData, marshaledData)
 	if err != nil {
 		return nil, err
 	}
 
-	oldNumNodes := validatorData.NumNodes
-	validatorData.NumNodes = numNodes
-	if len(validatorData.Delegators) == 1 {
-		return validatorData, nil
+	return validatorVoteData, nil
+}
+
+func (g *governanceContract) getOrCreateValidatorData(address []byte, numNodes int32) (*ValidatorData, error) {
+	validatorData := &ValidatorData{
+		Delegators: make([]*VoterData, 0),
+		NumNodes:   numNodes,
 	}
 
-	log.Trace("difference in old num nodes and new num nodes with delegated voting", oldNumNodes, numNodes)
+	key := append([]byte(validatorPrefix), address...)
+	marshaledData := g.eei.GetStorage(key)
+	if len(marshaledData) != 0 {
+		err := g.marshalizer.Unmarshal(validatorData, marshaledData)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	validatorData.NumNodes = numNodes
+	computeOwnVotePower(address, validatorData)
 
 	return validatorData, nil
 }
 
-func (g *governanceContract) delegateVotePower(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	g.eei.AddReturnMessage("delegateVotePower not yet implemented")
-	return vmcommon.UserError
+func (g *governanceContract) saveValidatorData(address []byte, validatorData *ValidatorData) error {
+	marshaledData, err := g.marshalizer.Marshal(validatorData)
+	if err != nil {
+		return err
+	}
+
+	key := append([]byte(validatorPrefix), address...)
+	g.eei.SetStorage(key, marshaledData)
+	return nil
 }
 
-func (g *governanceContract) revokeVotePower(_ *vmcommon.ContractCallInput) vmcommon.ReturnCode {
-	g.eei.AddReturnMessage("revokeVotePower not yet implemented")
-	return vmcommon.UserError
+// computeOwnVotePower sets the vote power kept by the validator itself as its number of staked nodes minus the
+// vote power delegated to others. The validator is always kept as the first entry of the delegators list
+func computeOwnVotePower(address []byte, validatorData *ValidatorData) {
+	delegated := int32(0)
+	delegators := make([]*VoterData, 1, len(validatorData.Delegators)+1)
+	delegators[0] = &VoterData{Address: address}
+	for _, voter := range validatorData.Delegators {
+		if bytes.Equal(voter.Address, address) {
+			continue
+		}
+		delegated += voter.NumNodes
+		delegators = append(delegators, voter)
+	}
+
+	delegators[0].NumNodes = validatorData.NumNodes - delegated
+	if delegators[0].NumNodes < 0 {
+		delegators[0].NumNodes = 0
+	}
+	validatorData.Delegators = delegators
+}
+
+func votePowerOf(validatorData *ValidatorData, voter []byte) int32 {
+	for _, delegator := range validatorData.Delegators {
+		if bytes.Equal(delegator.Address, voter) {
+			return delegator.NumNodes
+		}
+	}
+
+	return 0
+}
+
+func (g *governanceContract) delegateVotePower(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !g.flagVoteDelegation.IsSet() {
+		g.eei.AddReturnMessage("vote power delegation is not enabled")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage("delegateVotePower callValue expected to be 0")
+		return vmcommon.UserError
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.DelegateVote)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) != 2 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 2")
+		return vmcommon.FunctionWrongSignature
+	}
+	delegateAddress := args.Arguments[0]
+	if len(delegateAddress) != len(args.CallerAddr) || bytes.Equal(delegateAddress, args.CallerAddr) {
+		g.eei.AddReturnMessage("first argument should be a valid address, different than caller")
+		return vmcommon.FunctionWrongSignature
+	}
+	numNodesToDelegate, okConvert := big.NewInt(0).SetString(string(args.Arguments[1]), conversionBase)
+	if !okConvert || numNodesToDelegate.Cmp(zero) <= 0 || !numNodesToDelegate.IsInt64() {
+		g.eei.AddReturnMessage("invalid number of nodes to delegate")
+		return vmcommon.UserError
+	}
+
+	numStakedNodes, err := g.numOfStakedNodes(args.CallerAddr)
+	if err != nil || numStakedNodes == 0 {
+		g.eei.AddReturnMessage("address has 0 voting power")
+		return vmcommon.UserError
+	}
+	validatorData, err := g.getOrCreateValidatorData(args.CallerAddr, int32(numStakedNodes))
+	if err != nil {
+		g.eei.AddReturnMessage("getOrCreateValidator data error" + err.Error())
+		return vmcommon.UserError
+	}
+	if numNodesToDelegate.Int64() > int64(validatorData.Delegators[0].NumNodes) {
+		g.eei.AddReturnMessage("not enough vote power to delegate")
+		return vmcommon.UserError
+	}
+
+	delegatedNodes := int32(numNodesToDelegate.Int64())
+	found := false
+	for _, voter := range validatorData.Delegators[1:] {
+		if bytes.Equal(voter.Address, delegateAddress) {
+			voter.NumNodes += delegatedNodes
+			found = true
+			break
+		}
+	}
+	if !found {
+		validatorData.Delegators = append(validatorData.Delegators, &VoterData{
+			Address:  delegateAddress,
+			NumNodes: delegatedNodes,
+		})
+	}
+	computeOwnVotePower(args.CallerAddr, validatorData)
+
+	err = g.saveValidatorData(args.CallerAddr, validatorData)
+	if err != nil {
+		g.eei.AddReturnMessage("saveValidatorData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (g *governanceContract) revokeVotePower(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !g.flagVoteDelegation.IsSet() {
+		g.eei.AddReturnMessage("vote power delegation is not enabled")
+		return vmcommon.UserError
+	}
+	if args.CallValue.Cmp(zero) != 0 {
+		g.eei.AddReturnMessage("revokeVotePower callValue expected to be 0")
+		return vmcommon.UserError
+	}
+	err := g.eei.UseGas(g.gasCost.MetaChainSystemSCsCost.RevokeVote)
+	if err != nil {
+		g.eei.AddReturnMessage("not enough gas")
+		return vmcommon.OutOfGas
+	}
+	if len(args.Arguments) < 1 || len(args.Arguments) > 2 {
+		g.eei.AddReturnMessage("invalid number of arguments, expected 1 or 2")
+		return vmcommon.FunctionWrongSignature
+	}
+
+	key := append([]byte(validatorPrefix), args.CallerAddr...)
+	if len(g.eei.GetStorage(key)) == 0 {
+		g.eei.AddReturnMessage("caller has not delegated any vote power")
+		return vmcommon.UserError
+	}
+	numStakedNodes, err := g.numOfStakedNodes(args.CallerAddr)
+	if err != nil {
+		g.eei.AddReturnMessage("numOfStakedNodes error " + err.Error())
+		return vmcommon.UserError
+	}
+	validatorData, err := g.getOrCreateValidatorData(args.CallerAddr, int32(numStakedNodes))
+	if err != nil {
+		g.eei.AddReturnMessage("getOrCreateValidator data error" + err.Error())
+		return vmcommon.UserError
+	}
+
+	delegateAddress := args.Arguments[0]
+	delegateIndex := -1
+	for i, voter := range validatorData.Delegators {
+		if i > 0 && bytes.Equal(voter.Address, delegateAddress) {
+			delegateIndex = i
+			break
+		}
+	}
+	if delegateIndex < 0 {
+		g.eei.AddReturnMessage("vote power was not delegated to the given address")
+		return vmcommon.UserError
+	}
+
+	delegate := validatorData.Delegators[delegateIndex]
+	numNodesToRevoke := int64(delegate.NumNodes)
+	if len(args.Arguments) == 2 {
+		value, okConvert := big.NewInt(0).SetString(string(args.Arguments[1]), conversionBase)
+		if !okConvert || value.Cmp(zero) <= 0 || !value.IsInt64() || value.Int64() > numNodesToRevoke {
+			g.eei.AddReturnMessage("invalid number of nodes to revoke")
+			return vmcommon.UserError
+		}
+		numNodesToRevoke = value.Int64()
+	}
+
+	delegate.NumNodes -= int32(numNodesToRevoke)
+	if delegate.NumNodes == 0 {
+		validatorData.Delegators = append(validatorData.Delegators[:delegateIndex], validatorData.Delegators[delegateIndex+1:]...)
+	}
+	computeOwnVotePower(args.CallerAddr, validatorData)
+
+	if len(validatorData.Delegators) == 1 {
+		g.eei.SetStorage(key, nil)
+		return vmcommon.Ok
+	}
+
+	err = g.saveValidatorData(args.CallerAddr, validatorData)
+	if err != nil {
+		g.eei.AddReturnMessage("saveValidatorData error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
 }
 
 func (g *governanceContract) numOfStakedNodes(address []byte) (uint32, error) {
@@ -908,6 +1216,9 @@ func (g *governanceContract) computeEndResults(proposal *GeneralProposal) error
 func (g *governanceContract) EpochConfirmed(epoch uint32) {
 	g.flagEnabled.Toggle(epoch >= g.enabledEpoch)
 	log.Debug("governance contract", "enabled", g.flagEnabled.IsSet())
+
+	g.flagVoteDelegation.Toggle(epoch >= g.voteDelegationEnableEpoch)
+	log.Debug("governance contract: vote delegation", "enabled", g.flagVoteDelegation.IsSet())
 }
 
 // IsInterfaceNil returns true if underlying object is nil
@@ -425,6 +425,7 @@ func (m *ValidatorData) GetNumNodes() int32 {
 type VoteData struct {
 	NumVotes  int32  `protobuf:"varint,1,opt,name=NumVotes,proto3" json:"VoteData"`
 	VoteValue string `protobuf:"bytes,2,opt,name=VoteValue,proto3" json:"VoteValue"`
+	Voter     []byte `protobuf:"bytes,3,opt,name=Voter,proto3" json:"Voter"`
 }
 
 func (m *VoteData) Reset()      { *m = VoteData{} }
@@ -469,6 +470,60 @@ func (m *VoteData) GetVoteValue() string {
 	return ""
 }
 
+func (m *VoteData) GetVoter() []byte {
+	if m != nil {
+		return m.Voter
+	}
+	return nil
+}
+
+type ValidatorVoteData struct {
+	UsedVotes int32       `protobuf:"varint,1,opt,name=UsedVotes,proto3" json:"UsedVotes"`
+	Votes     []*VoteData `protobuf:"bytes,2,rep,name=Votes,proto3" json:"Votes"`
+}
+
+func (m *ValidatorVoteData) Reset()      { *m = ValidatorVoteData{} }
+func (*ValidatorVoteData) ProtoMessage() {}
+func (*ValidatorVoteData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a03da5266c714, []int{7}
+}
+func (m *ValidatorVoteData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorVoteData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ValidatorVoteData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorVoteData.Merge(m, src)
+}
+func (m *ValidatorVoteData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorVoteData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorVoteData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorVoteData proto.InternalMessageInfo
+
+func (m *ValidatorVoteData) GetUsedVotes() int32 {
+	if m != nil {
+		return m.UsedVotes
+	}
+	return 0
+}
+
+func (m *ValidatorVoteData) GetVotes() []*VoteData {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GeneralProposal)(nil), "proto.GeneralProposal")
 	proto.RegisterType((*WhiteListProposal)(nil), "proto.WhiteListProposal")
@@ -477,65 +532,69 @@ func init() {
 	proto.RegisterType((*VoterData)(nil), "proto.VoterData")
 	proto.RegisterType((*ValidatorData)(nil), "proto.ValidatorData")
 	proto.RegisterType((*VoteData)(nil), "proto.VoteData")
+	proto.RegisterType((*ValidatorVoteData)(nil), "proto.ValidatorVoteData")
 }
 
 func init() { proto.RegisterFile("governance.proto", fileDescriptor_e18a03da5266c714) }
 
 var fileDescriptor_e18a03da5266c714 = []byte{
-	// 834 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x8b, 0x23, 0x45,
-	0x18, 0x4e, 0xe7, 0x63, 0x26, 0xa9, 0xc9, 0xec, 0x66, 0xdb, 0x65, 0x69, 0x45, 0xba, 0x42, 0x40,
-	0x08, 0xc8, 0x26, 0xa0, 0x82, 0xa0, 0x08, 0xbb, 0x9d, 0xf9, 0xd8, 0x01, 0xb7, 0x59, 0x6b, 0x86,
-	0x88, 0xe2, 0xa5, 0x92, 0xae, 0xe9, 0x34, 0x9b, 0xf4, 0x1b, 0xaa, 0xaa, 0x77, 0x10, 0x2f, 0xfe,
-	0x00, 0x0f, 0xfa, 0x2f, 0xc4, 0x5f, 0xe2, 0x71, 0x2e, 0xc2, 0x9c, 0x5a, 0x27, 0x83, 0x20, 0x75,
-	0xda, 0x9f, 0x20, 0x55, 0x9d, 0x74, 0xd2, 0xc9, 0x1c, 0xf4, 0xd2, 0xf5, 0x3e, 0xcf, 0xd3, 0xef,
-	0x47, 0xd5, 0xfb, 0x56, 0xa1, 0x56, 0x08, 0x6f, 0x18, 0x8f, 0x69, 0x3c, 0x66, 0xbd, 0x39, 0x07,
-	0x09, 0x76, 0xcd, 0x2c, 0xef, 0x3d, 0x0d, 0x23, 0x39, 0x49, 0x46, 0xbd, 0x31, 0xcc, 0xfa, 0x21,
-	0x84, 0xd0, 0x37, 0xf4, 0x28, 0xb9, 0x34, 0xc8, 0x00, 0x63, 0x65, 0x5e, 0x9d, 0x9f, 0xaa, 0xe8,
-	0xe1, 0x29, 0x8b, 0x19, 0xa7, 0xd3, 0x57, 0x1c, 0xe6, 0x20, 0xe8, 0xd4, 0xfe, 0x14, 0x1d, 0x9e,
-	0x09, 0x91, 0x30, 0xfe, 0x3c, 0x08, 0x38, 0x13, 0xc2, 0xb1, 0xda, 0x56, 0xb7, 0xe9, 0x3d, 0x52,
-	0x29, 0x2e, 0x0a, 0xa4, 0x08, 0xed, 0x4f, 0x50, 0xf3, 0x34, 0x92, 0x2f, 0x92, 0xd1, 0x00, 0x66,
-	0xb3, 0x48, 0x3a, 0x65, 0xe3, 0xd7, 0x52, 0x29, 0x2e, 0xf0, 0xa4, 0x80, 0xec, 0xcf, 0xd0, 0x83,
-	0x73, 0x49, 0xb9, 0x1c, 0x82, 0x64, 0x3e, 0xc4, 0x63, 0xe6, 0x54, 0xda, 0x56, 0xb7, 0xea, 0xd9,
-	0x2a, 0xc5, 0x5b, 0x0a, 0xd9, 0xc2, 0x3a, 0xe3, 0x71, 0x1c, 0xac, 0x3d, 0xab, 0xc6, 0xd3, 0x64,
-	0xdc, 0xe4, 0x49, 0x01, 0xd9, 0xef, 0xa2, 0xca, 0x37, 0x4c, 0x38, 0xb5, 0xb6, 0xd5, 0xad, 0x79,
-	0xfb, 0x2a, 0xc5, 0x1a, 0x12, 0xfd, 0xb1, 0x9f, 0xa0, 0xb2, 0x0f, 0xce, 0x9e, 0x51, 0xf6, 0x54,
-	0x8a, 0xcb, 0x3e, 0x90, 0xb2, 0x0f, 0xf6, 0xfb, 0xa8, 0x3a, 0x64, 0x12, 0x9c, 0x7d, 0xa3, 0xd4,
-	0x55, 0x8a, 0x0d, 0x26, 0xe6, 0x6b, 0x77, 0x51, 0xfd, 0x08, 0x62, 0x39, 0xa0, 0x9c, 0x39, 0x75,
-	0xf3, 0x47, 0x53, 0xa5, 0x38, 0xe7, 0x48, 0x6e, 0xd9, 0x18, 0xd5, 0x74, 0x1d, 0x81, 0xd3, 0x68,
-	0x5b, 0xdd, 0xba, 0xd7, 0x50, 0x29, 0xce, 0x08, 0x92, 0x2d, 0x76, 0x07, 0xed, 0x69, 0x83, 0x0b,
-	0x07, 0xb5, 0x2b, 0xdd, 0xa6, 0x87, 0x54, 0x8a, 0x97, 0x0c, 0x59, 0xae, 0x7a, 0xd7, 0x17, 0x30,
-	0x27, 0xec, 0x92, 0x71, 0xa6, 0x77, 0x7d, 0xb0, 0x3e, 0xe7, 0x4d, 0x9e, 0x14, 0x90, 0x8e, 0x3c,
-	0x98, 0x82, 0x60, 0x81, 0xd3, 0x34, 0xb9, 0x4d, 0xe4, 0x8c, 0x21, 0xcb, 0xb5, 0xf3, 0x8b, 0x85,
-	0x1e, 0x7d, 0x3d, 0x89, 0x24, 0xfb, 0x32, 0x12, 0x32, 0x1f, 0x88, 0x67, 0xa8, 0x95, 0x93, 0xc5,
-	0x99, 0x78, 0xac, 0x52, 0xbc, 0xa3, 0x91, 0x1d, 0x46, 0xf7, 0x78, 0x15, 0xed, 0x5c, 0x52, 0x99,
-	0x88, 0xe5, 0x6c, 0x98, 0x1e, 0x17, 0x15, 0xb2, 0x85, 0x3b, 0x7f, 0x58, 0xa8, 0xf5, 0x82, 0xf2,
-	0xe0, 0x04, 0xf8, 0xeb, 0xbc, 0xa4, 0x2f, 0xd0, 0xc3, 0xe3, 0x39, 0x8c, 0x27, 0x17, 0xb0, 0x92,
-	0x4c, 0x45, 0x87, 0xde, 0x3b, 0x2a, 0xc5, 0xdb, 0x12, 0xd9, 0x26, 0xec, 0x13, 0x64, 0xfb, 0xec,
-	0xea, 0x1c, 0x2e, 0xe5, 0x15, 0xe5, 0x6c, 0xc8, 0xb8, 0x88, 0x20, 0x5e, 0xd6, 0xf4, 0x44, 0xa5,
-	0xf8, 0x1e, 0x95, 0xdc, 0xc3, 0xdd, 0xb3, 0xaf, 0xca, 0x7f, 0xde, 0xd7, 0xdf, 0x65, 0xd4, 0x3a,
-	0xcd, 0x6f, 0xf1, 0x00, 0xe2, 0xcb, 0x28, 0xd4, 0x93, 0xe4, 0x27, 0x33, 0x1f, 0x02, 0x96, 0x1d,
-	0x71, 0x25, 0x9b, 0xa4, 0x15, 0x47, 0x72, 0xcb, 0xfe, 0x10, 0x35, 0x5e, 0x46, 0xf1, 0x57, 0x09,
-	0xf0, 0x64, 0x66, 0x2a, 0xaf, 0x79, 0x87, 0x2a, 0xc5, 0x6b, 0x92, 0xac, 0x4d, 0xdd, 0xc1, 0x97,
-	0x51, 0xfc, 0x8a, 0x0a, 0x71, 0x31, 0xe1, 0x4c, 0x4c, 0x60, 0x1a, 0x98, 0x4a, 0x6b, 0x59, 0x07,
-	0xb7, 0x35, 0xb2, 0xc3, 0x2c, 0x23, 0xe8, 0x69, 0x5f, 0x47, 0xa8, 0x16, 0x22, 0x14, 0x34, 0xb2,
-	0xc3, 0xd8, 0x6f, 0xd0, 0xc1, 0xea, 0x04, 0x4e, 0x18, 0x33, 0xb7, 0xaf, 0xe9, 0x5d, 0xa8, 0x14,
-	0x6f, 0xd2, 0xbf, 0xfd, 0x89, 0x9f, 0xcf, 0xa8, 0x9c, 0xf4, 0x47, 0x51, 0xd8, 0x3b, 0x8b, 0xe5,
-	0xe7, 0x1b, 0xcf, 0xd9, 0xf1, 0x94, 0x43, 0x1c, 0xf8, 0x4c, 0x5e, 0x01, 0x7f, 0xdd, 0x67, 0x06,
-	0x3d, 0x0d, 0xa1, 0x1f, 0x50, 0x49, 0x7b, 0x5e, 0x14, 0x9e, 0xe9, 0x3b, 0x26, 0x24, 0xe3, 0x64,
-	0x33, 0x62, 0xe7, 0x3b, 0xd4, 0x30, 0xf7, 0xe6, 0x88, 0x4a, 0x6a, 0x7f, 0x80, 0xf6, 0x8b, 0x13,
-	0x7c, 0xa0, 0x52, 0xbc, 0xa2, 0xc8, 0xca, 0x28, 0xb4, 0xa1, 0xbc, 0xbe, 0xd0, 0xbb, 0x6d, 0xe8,
-	0xfc, 0x80, 0x0e, 0x87, 0x74, 0x1a, 0x05, 0x54, 0x42, 0x96, 0xe1, 0x19, 0x42, 0x47, 0x6c, 0xca,
-	0x42, 0x4d, 0xe8, 0x24, 0x95, 0xee, 0xc1, 0x47, 0xad, 0xec, 0xb5, 0xed, 0xe5, 0x75, 0x78, 0x0f,
-	0x54, 0x8a, 0x37, 0xfe, 0x23, 0x1b, 0xf6, 0xff, 0x48, 0x4e, 0x51, 0x5d, 0x87, 0x34, 0x79, 0x33,
-	0x2f, 0x0d, 0xb3, 0xad, 0x2d, 0xbd, 0x56, 0x3a, 0xc9, 0x55, 0x3d, 0x39, 0xda, 0x18, 0xd2, 0x69,
-	0xc2, 0x4c, 0x82, 0x46, 0x36, 0x39, 0x39, 0x49, 0xd6, 0xa6, 0xe7, 0x5f, 0xdf, 0xba, 0xa5, 0x9b,
-	0x5b, 0xb7, 0xf4, 0xf6, 0xd6, 0xb5, 0x7e, 0x5c, 0xb8, 0xd6, 0xaf, 0x0b, 0xd7, 0xfa, 0x7d, 0xe1,
-	0x5a, 0xd7, 0x0b, 0xd7, 0xba, 0x59, 0xb8, 0xd6, 0x5f, 0x0b, 0xd7, 0xfa, 0x67, 0xe1, 0x96, 0xde,
-	0x2e, 0x5c, 0xeb, 0xe7, 0x3b, 0xb7, 0x74, 0x7d, 0xe7, 0x96, 0x6e, 0xee, 0xdc, 0xd2, 0xb7, 0x8f,
-	0xc5, 0xf7, 0x42, 0xb2, 0xd9, 0xf9, 0x8c, 0x72, 0x39, 0x80, 0x58, 0x72, 0x3a, 0x96, 0x62, 0xb4,
-	0x67, 0x4e, 0xe2, 0xe3, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x16, 0x85, 0xae, 0x19, 0xc1, 0x06,
-	0x00, 0x00,
+	// 884 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x8b, 0x23, 0x45,
+	0x14, 0x4f, 0xe7, 0xcf, 0x4c, 0x52, 0x93, 0xd9, 0xc9, 0x94, 0xcb, 0xd2, 0x8a, 0x74, 0x85, 0x80,
+	0x10, 0x58, 0x36, 0x11, 0x15, 0x04, 0x45, 0xd8, 0xed, 0xcc, 0x9f, 0x1d, 0x70, 0x9b, 0xb5, 0x66,
+	0x8c, 0x28, 0x5e, 0x2a, 0xe9, 0x9a, 0x4e, 0xb3, 0x49, 0x57, 0xa8, 0xaa, 0xde, 0x41, 0xbc, 0x88,
+	0x67, 0x0f, 0xfa, 0x2d, 0xc4, 0x4f, 0xe2, 0x71, 0x2e, 0xc2, 0x9c, 0x5a, 0x27, 0x83, 0x20, 0x75,
+	0xda, 0x8f, 0x20, 0x55, 0x9d, 0x74, 0xba, 0x93, 0x39, 0xe8, 0xa5, 0xeb, 0xbd, 0xdf, 0xaf, 0xeb,
+	0xfd, 0x5e, 0xbd, 0xf7, 0xaa, 0x40, 0x2b, 0x60, 0xaf, 0x29, 0x8f, 0x48, 0x34, 0xa6, 0xbd, 0x39,
+	0x67, 0x92, 0xc1, 0x9a, 0x59, 0xde, 0x79, 0x12, 0x84, 0x72, 0x12, 0x8f, 0x7a, 0x63, 0x36, 0xeb,
+	0x07, 0x2c, 0x60, 0x7d, 0x03, 0x8f, 0xe2, 0x4b, 0xe3, 0x19, 0xc7, 0x58, 0xe9, 0xae, 0xce, 0x4f,
+	0x55, 0x70, 0x70, 0x4a, 0x23, 0xca, 0xc9, 0xf4, 0x25, 0x67, 0x73, 0x26, 0xc8, 0x14, 0x7e, 0x0c,
+	0xf6, 0xcf, 0x84, 0x88, 0x29, 0x7f, 0xe6, 0xfb, 0x9c, 0x0a, 0x61, 0x5b, 0x6d, 0xab, 0xdb, 0x74,
+	0x0f, 0x55, 0x82, 0x8a, 0x04, 0x2e, 0xba, 0xf0, 0x23, 0xd0, 0x3c, 0x0d, 0xe5, 0xf3, 0x78, 0x34,
+	0x60, 0xb3, 0x59, 0x28, 0xed, 0xb2, 0xd9, 0xd7, 0x52, 0x09, 0x2a, 0xe0, 0xb8, 0xe0, 0xc1, 0x4f,
+	0xc0, 0x83, 0x73, 0x49, 0xb8, 0x1c, 0x32, 0x49, 0x3d, 0x16, 0x8d, 0xa9, 0x5d, 0x69, 0x5b, 0xdd,
+	0xaa, 0x0b, 0x55, 0x82, 0x36, 0x18, 0xbc, 0xe1, 0x6b, 0xc5, 0xe3, 0xc8, 0x5f, 0xef, 0xac, 0x9a,
+	0x9d, 0x46, 0x31, 0x8f, 0xe3, 0x82, 0x07, 0xdf, 0x06, 0x95, 0xaf, 0xa9, 0xb0, 0x6b, 0x6d, 0xab,
+	0x5b, 0x73, 0x77, 0x55, 0x82, 0xb4, 0x8b, 0xf5, 0x07, 0x3e, 0x02, 0x65, 0x8f, 0xd9, 0x3b, 0x86,
+	0xd9, 0x51, 0x09, 0x2a, 0x7b, 0x0c, 0x97, 0x3d, 0x06, 0xdf, 0x05, 0xd5, 0x21, 0x95, 0xcc, 0xde,
+	0x35, 0x4c, 0x5d, 0x25, 0xc8, 0xf8, 0xd8, 0x7c, 0x61, 0x17, 0xd4, 0x8f, 0x58, 0x24, 0x07, 0x84,
+	0x53, 0xbb, 0x6e, 0xfe, 0x68, 0xaa, 0x04, 0x65, 0x18, 0xce, 0x2c, 0x88, 0x40, 0x4d, 0xe7, 0xe1,
+	0xdb, 0x8d, 0xb6, 0xd5, 0xad, 0xbb, 0x0d, 0x95, 0xa0, 0x14, 0xc0, 0xe9, 0x02, 0x3b, 0x60, 0x47,
+	0x1b, 0x5c, 0xd8, 0xa0, 0x5d, 0xe9, 0x36, 0x5d, 0xa0, 0x12, 0xb4, 0x44, 0xf0, 0x72, 0xd5, 0xa7,
+	0xbe, 0x60, 0x73, 0x4c, 0x2f, 0x29, 0xa7, 0xfa, 0xd4, 0x7b, 0xeb, 0x3a, 0xe7, 0x71, 0x5c, 0xf0,
+	0x74, 0xe4, 0xc1, 0x94, 0x09, 0xea, 0xdb, 0x4d, 0xa3, 0x6d, 0x22, 0xa7, 0x08, 0x5e, 0xae, 0x9d,
+	0x5f, 0x2c, 0x70, 0xf8, 0xd5, 0x24, 0x94, 0xf4, 0xf3, 0x50, 0xc8, 0x6c, 0x20, 0x9e, 0x82, 0x56,
+	0x06, 0x16, 0x67, 0xe2, 0xa1, 0x4a, 0xd0, 0x16, 0x87, 0xb7, 0x10, 0xdd, 0xe3, 0x55, 0xb4, 0x73,
+	0x49, 0x64, 0x2c, 0x96, 0xb3, 0x61, 0x7a, 0x5c, 0x64, 0xf0, 0x86, 0xdf, 0xf9, 0xc3, 0x02, 0xad,
+	0xe7, 0x84, 0xfb, 0x27, 0x8c, 0xbf, 0xca, 0x52, 0xfa, 0x0c, 0x1c, 0x1c, 0xcf, 0xd9, 0x78, 0x72,
+	0xc1, 0x56, 0x94, 0xc9, 0x68, 0xdf, 0x7d, 0x4b, 0x25, 0x68, 0x93, 0xc2, 0x9b, 0x00, 0x3c, 0x01,
+	0xd0, 0xa3, 0x57, 0xe7, 0xec, 0x52, 0x5e, 0x11, 0x4e, 0x87, 0x94, 0x8b, 0x90, 0x45, 0xcb, 0x9c,
+	0x1e, 0xa9, 0x04, 0xdd, 0xc3, 0xe2, 0x7b, 0xb0, 0x7b, 0xce, 0x55, 0xf9, 0xcf, 0xe7, 0xfa, 0xbb,
+	0x0c, 0x5a, 0xa7, 0xd9, 0x2d, 0x1e, 0xb0, 0xe8, 0x32, 0x0c, 0xf4, 0x24, 0x79, 0xf1, 0xcc, 0x63,
+	0x3e, 0x4d, 0x4b, 0x5c, 0x49, 0x27, 0x69, 0x85, 0xe1, 0xcc, 0x82, 0x8f, 0x41, 0xe3, 0x45, 0x18,
+	0x7d, 0x11, 0x33, 0x1e, 0xcf, 0x4c, 0xe6, 0x35, 0x77, 0x5f, 0x25, 0x68, 0x0d, 0xe2, 0xb5, 0xa9,
+	0x3b, 0xf8, 0x22, 0x8c, 0x5e, 0x12, 0x21, 0x2e, 0x26, 0x9c, 0x8a, 0x09, 0x9b, 0xfa, 0x26, 0xd3,
+	0x5a, 0xda, 0xc1, 0x4d, 0x0e, 0x6f, 0x21, 0xcb, 0x08, 0x7a, 0xda, 0xd7, 0x11, 0xaa, 0x85, 0x08,
+	0x05, 0x0e, 0x6f, 0x21, 0xf0, 0x35, 0xd8, 0x5b, 0x55, 0xe0, 0x84, 0x52, 0x73, 0xfb, 0x9a, 0xee,
+	0x85, 0x4a, 0x50, 0x1e, 0xfe, 0xed, 0x4f, 0xf4, 0x6c, 0x46, 0xe4, 0xa4, 0x3f, 0x0a, 0x83, 0xde,
+	0x59, 0x24, 0x3f, 0xcd, 0x3d, 0x67, 0xc7, 0x53, 0xce, 0x22, 0xdf, 0xa3, 0xf2, 0x8a, 0xf1, 0x57,
+	0x7d, 0x6a, 0xbc, 0x27, 0x01, 0xeb, 0xfb, 0x44, 0x92, 0x9e, 0x1b, 0x06, 0x67, 0xfa, 0x8e, 0x09,
+	0x49, 0x39, 0xce, 0x47, 0xec, 0x7c, 0x0b, 0x1a, 0xe6, 0xde, 0x1c, 0x11, 0x49, 0xe0, 0x7b, 0x60,
+	0xb7, 0x38, 0xc1, 0x7b, 0x2a, 0x41, 0x2b, 0x08, 0xaf, 0x8c, 0x42, 0x1b, 0xca, 0xeb, 0x0b, 0xbd,
+	0xdd, 0x86, 0xce, 0xf7, 0x60, 0x7f, 0x48, 0xa6, 0xa1, 0x4f, 0x24, 0x4b, 0x15, 0x9e, 0x02, 0x70,
+	0x44, 0xa7, 0x34, 0xd0, 0x80, 0x16, 0xa9, 0x74, 0xf7, 0x3e, 0x68, 0xa5, 0xaf, 0x6d, 0x2f, 0xcb,
+	0xc3, 0x7d, 0xa0, 0x12, 0x94, 0xfb, 0x0f, 0xe7, 0xec, 0xff, 0x21, 0xfe, 0xa3, 0x05, 0xea, 0x3a,
+	0xa6, 0x11, 0x4e, 0xb7, 0x69, 0x37, 0x3d, 0xdb, 0x72, 0xdb, 0x8a, 0xc7, 0x19, 0xab, 0x47, 0x47,
+	0x1b, 0x43, 0x32, 0x8d, 0xa9, 0x51, 0x68, 0xa4, 0xa3, 0x93, 0x81, 0x78, 0x6d, 0xae, 0x5e, 0x2c,
+	0xbe, 0x9c, 0xec, 0xec, 0xc5, 0xe2, 0xe9, 0x8b, 0xc5, 0x3b, 0x1c, 0x1c, 0x66, 0x15, 0xc8, 0x92,
+	0x79, 0x0c, 0x1a, 0x5f, 0x0a, 0xea, 0xe7, 0xb3, 0x31, 0x12, 0x19, 0x88, 0xd7, 0x26, 0x7c, 0x3f,
+	0x95, 0xd0, 0xa7, 0xd5, 0xd5, 0x3a, 0xc8, 0x55, 0xcb, 0x14, 0x2b, 0xd3, 0x14, 0xa9, 0xa6, 0x70,
+	0xbd, 0xeb, 0x5b, 0xa7, 0x74, 0x73, 0xeb, 0x94, 0xde, 0xdc, 0x3a, 0xd6, 0x0f, 0x0b, 0xc7, 0xfa,
+	0x75, 0xe1, 0x58, 0xbf, 0x2f, 0x1c, 0xeb, 0x7a, 0xe1, 0x58, 0x37, 0x0b, 0xc7, 0xfa, 0x6b, 0xe1,
+	0x58, 0xff, 0x2c, 0x9c, 0xd2, 0x9b, 0x85, 0x63, 0xfd, 0x7c, 0xe7, 0x94, 0xae, 0xef, 0x9c, 0xd2,
+	0xcd, 0x9d, 0x53, 0xfa, 0xe6, 0xa1, 0xf8, 0x4e, 0x48, 0x3a, 0x3b, 0x9f, 0x11, 0x2e, 0x07, 0x2c,
+	0x92, 0x9c, 0x8c, 0xa5, 0x18, 0xed, 0x18, 0xc5, 0x0f, 0xff, 0x1d, 0x00, 0x47, 0x9c, 0x35, 0xe4,
+	0x57, 0x07, 0x00, 0x00,
 }
 
 func (this *GeneralProposal) Equal(that interface{}) bool {
@@ -780,6 +839,41 @@ func (this *VoteData) Equal(that interface{}) bool {
 	if this.VoteValue != that1.VoteValue {
 		return false
 	}
+	if !bytes.Equal(this.Voter, that1.Voter) {
+		return false
+	}
+	return true
+}
+func (this *ValidatorVoteData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorVoteData)
+	if !ok {
+		that2, ok := that.(ValidatorVoteData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.UsedVotes != that1.UsedVotes {
+		return false
+	}
+	if len(this.Votes) != len(that1.Votes) {
+		return false
+	}
+	for i := range this.Votes {
+		if !this.Votes[i].Equal(that1.Votes[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *GeneralProposal) GoString() string {
@@ -868,10 +962,24 @@ func (this *VoteData) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&systemSmartContracts.VoteData{")
 	s = append(s, "NumVotes: "+fmt.Sprintf("%#v", this.NumVotes)+",\n")
 	s = append(s, "VoteValue: "+fmt.Sprintf("%#v", this.VoteValue)+",\n")
+	s = append(s, "Voter: "+fmt.Sprintf("%#v", this.Voter)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValidatorVoteData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.ValidatorVoteData{")
+	s = append(s, "UsedVotes: "+fmt.Sprintf("%#v", this.UsedVotes)+",\n")
+	if this.Votes != nil {
+		s = append(s, "Votes: "+fmt.Sprintf("%#v", this.Votes)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1216,6 +1324,13 @@ func (m *VoteData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintGovernance(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.VoteValue) > 0 {
 		i -= len(m.VoteValue)
 		copy(dAtA[i:], m.VoteValue)
@@ -1231,6 +1346,48 @@ func (m *VoteData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorVoteData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorVoteData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorVoteData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGovernance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.UsedVotes != 0 {
+		i = encodeVarintGovernance(dAtA, i, uint64(m.UsedVotes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGovernance(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGovernance(v)
 	base := offset
@@ -1403,6 +1560,28 @@ func (m *VoteData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGovernance(uint64(l))
 	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovGovernance(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorVoteData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsedVotes != 0 {
+		n += 1 + sovGovernance(uint64(m.UsedVotes))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovGovernance(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1504,6 +1683,23 @@ func (this *VoteData) String() string {
 	s := strings.Join([]string{`&VoteData{`,
 		`NumVotes:` + fmt.Sprintf("%v", this.NumVotes) + `,`,
 		`VoteValue:` + fmt.Sprintf("%v", this.VoteValue) + `,`,
+		`Voter:` + fmt.Sprintf("%v", this.Voter) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValidatorVoteData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVotes := "[]*VoteData{"
+	for _, f := range this.Votes {
+		repeatedStringForVotes += strings.Replace(f.String(), "VoteData", "VoteData", 1) + ","
+	}
+	repeatedStringForVotes += "}"
+	s := strings.Join([]string{`&ValidatorVoteData{`,
+		`UsedVotes:` + fmt.Sprintf("%v", this.UsedVotes) + `,`,
+		`Votes:` + repeatedStringForVotes + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2577,6 +2773,146 @@ func (m *VoteData) Unmarshal(dAtA []byte) error {
 			}
 			m.VoteValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovernance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorVoteData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovernance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorVoteData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorVoteData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedVotes", wireType)
+			}
+			m.UsedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedVotes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovernance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovernance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &VoteData{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGovernance(dAtA[iNdEx:])
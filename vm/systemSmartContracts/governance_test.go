@@ -254,6 +254,10 @@ func TestGovernanceContract_ExecuteWhiteListProposalShouldWork(t *testing.T) {
 			}
 		},
 		SetStorageCalled: func(key []byte, value []byte) {
+			if strings.HasPrefix(string(key), validatorVotingPrefix) {
+				return
+			}
+
 			if strings.Contains(string(key), proposalPrefix) {
 				genProposal := &GeneralProposal{}
 				_ = json.Unmarshal(value, genProposal)
@@ -356,6 +360,10 @@ func TestGovernanceContract_ExecuteHardfork(t *testing.T) {
 			return generalProposalBytes
 		},
 		SetStorageCalled: func(key []byte, value []byte) {
+			if strings.HasPrefix(string(key), validatorVotingPrefix) {
+				return
+			}
+
 			if bytes.Equal(key, append([]byte(hardForkPrefix), gitHubCommit...)) {
 				hardForkProposal := &HardForkProposal{}
 				_ = json.Unmarshal(value, hardForkProposal)
@@ -412,6 +420,10 @@ func TestGovernanceContract_ExecuteProposal(t *testing.T) {
 			return generalProposalBytes
 		},
 		SetStorageCalled: func(key []byte, value []byte) {
+			if strings.HasPrefix(string(key), validatorVotingPrefix) {
+				return
+			}
+
 			genProposal := &GeneralProposal{}
 			_ = json.Unmarshal(value, genProposal)
 			require.Equal(t, gitHubCommit, genProposal.GitHubCommit)
@@ -665,3 +677,240 @@ func openProposal(t *testing.T, g *governanceContract, propType string, WLAddr,
 	retCode := g.Execute(callInput)
 	require.Equal(t, vmcommon.Ok, retCode)
 }
+
+func createGovernanceWithStakedValidator(
+	t *testing.T,
+	validatorAddr []byte,
+	numNodes int,
+) (*governanceContract, *vmContext, *mock.BlockChainHookStub) {
+	blockChainHook := &mock.BlockChainHookStub{
+		CurrentNonceCalled: func() uint64 {
+			return 0
+		},
+	}
+	eei, _ := NewVMContext(
+		blockChainHook,
+		hooks.NewVMCryptoHook(),
+		parsers.NewCallArgsParser(),
+		&mock.AccountsStub{},
+		&mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+
+	args := createMockGovernanceArgs()
+	auctionData := &AuctionData{
+		NumRegistered: uint32(numNodes),
+		BlsPubKeys:    make([][]byte, 0, numNodes),
+	}
+	stakedDataBytes, _ := json.Marshal(&StakedDataV2{Staked: true})
+	for i := 0; i < numNodes; i++ {
+		blsKey := []byte(fmt.Sprintf("blsKey%d", i))
+		auctionData.BlsPubKeys = append(auctionData.BlsPubKeys, blsKey)
+		eei.SetStorageForAddress(args.StakingSCAddress, blsKey, stakedDataBytes)
+	}
+	auctionDataBytes, _ := json.Marshal(auctionData)
+	eei.SetStorageForAddress(args.AuctionSCAddress, validatorAddr, auctionDataBytes)
+
+	args.Eei = eei
+	gsc, err := NewGovernanceContract(args)
+	require.Nil(t, err)
+
+	return gsc, eei, blockChainHook
+}
+
+func openProposalForVoting(t *testing.T, gsc *governanceContract, blockChainHook *mock.BlockChainHookStub, gitHubCommit []byte) {
+	ownerAddress := []byte("owner")
+	recipientAddr := []byte("recipientAddress")
+	initGovernanceSc(t, gsc, ownerAddress, recipientAddr)
+	whiteListAddrAtGenesis(t, gsc, ownerAddress, recipientAddr)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1
+	}
+	openProposal(t, gsc, "proposal", ownerAddress, recipientAddr, gitHubCommit, 10, 100)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 11
+	}
+}
+
+func getGeneralProposalFromStorage(gsc *governanceContract, gitHubCommit []byte) *GeneralProposal {
+	generalProposal := &GeneralProposal{}
+	_ = json.Unmarshal(gsc.eei.GetStorage(append([]byte(proposalPrefix), gitHubCommit...)), generalProposal)
+
+	return generalProposal
+}
+
+func delegateVotePower(gsc *governanceContract, validatorAddr []byte, delegateAddr []byte, numNodes int) vmcommon.ReturnCode {
+	callInput := createVMInput(big.NewInt(0), "delegateVotePower", validatorAddr, []byte("addr"))
+	callInput.Arguments = [][]byte{delegateAddr, []byte(fmt.Sprintf("%d", numNodes))}
+
+	return gsc.Execute(callInput)
+}
+
+func voteOnBehalfOf(gsc *governanceContract, voterAddr []byte, validatorAddr []byte, gitHubCommit []byte, vote string) vmcommon.ReturnCode {
+	callInput := createVMInput(big.NewInt(0), "vote", voterAddr, []byte("addr"))
+	callInput.Arguments = [][]byte{gitHubCommit, []byte(vote), validatorAddr}
+
+	return gsc.Execute(callInput)
+}
+
+func TestGovernanceContract_DelegateVotePowerMoreThanOwnedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	validatorAddr := []byte("valid")
+	gsc, eei, _ := createGovernanceWithStakedValidator(t, validatorAddr, 3)
+	gsc.EpochConfirmed(0)
+
+	retCode := delegateVotePower(gsc, validatorAddr, []byte("deleg"), 4)
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.Equal(t, "not enough vote power to delegate", eei.returnMessage)
+
+	retCode = delegateVotePower(gsc, validatorAddr, validatorAddr, 1)
+	require.Equal(t, vmcommon.FunctionWrongSignature, retCode)
+}
+
+func TestGovernanceContract_DelegatedVotesAreWeightedByDelegatedNodes(t *testing.T) {
+	t.Parallel()
+
+	validatorAddr := []byte("valid")
+	delegateAddr := []byte("deleg")
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	gsc, _, blockChainHook := createGovernanceWithStakedValidator(t, validatorAddr, 3)
+	openProposalForVoting(t, gsc, blockChainHook, gitHubCommit)
+
+	require.Equal(t, vmcommon.Ok, delegateVotePower(gsc, validatorAddr, delegateAddr, 2))
+	require.Equal(t, vmcommon.Ok, voteOnBehalfOf(gsc, delegateAddr, validatorAddr, gitHubCommit, "yes"))
+	voteProposal(t, gsc, validatorAddr, gitHubCommit, []byte("addr"), "no")
+
+	generalProposal := getGeneralProposalFromStorage(gsc, gitHubCommit)
+	require.Equal(t, int32(2), generalProposal.Yes)
+	require.Equal(t, int32(1), generalProposal.No)
+	require.Equal(t, [][]byte{validatorAddr}, generalProposal.Voters)
+
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 101
+	}
+	closeProposal(t, gsc, []byte("owner"), gitHubCommit, []byte("addr"))
+
+	generalProposal = getGeneralProposalFromStorage(gsc, gitHubCommit)
+	require.True(t, generalProposal.Closed)
+	require.True(t, generalProposal.Voted)
+	require.Equal(t, 0, len(gsc.eei.GetStorage(append(gitHubCommit, validatorAddr...))))
+}
+
+func TestGovernanceContract_DoubleVoteOfDelegatorAndDelegateShouldNotExceedStakedNodes(t *testing.T) {
+	t.Parallel()
+
+	validatorAddr := []byte("valid")
+	delegateAddr := []byte("deleg")
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	gsc, eei, blockChainHook := createGovernanceWithStakedValidator(t, validatorAddr, 3)
+	openProposalForVoting(t, gsc, blockChainHook, gitHubCommit)
+
+	voteProposal(t, gsc, validatorAddr, gitHubCommit, []byte("addr"), "yes")
+	require.Equal(t, vmcommon.Ok, delegateVotePower(gsc, validatorAddr, delegateAddr, 2))
+
+	retCode := voteOnBehalfOf(gsc, delegateAddr, validatorAddr, gitHubCommit, "no")
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.True(t, strings.Contains(eei.returnMessage, vm.ErrVotePowerAlreadyUsed.Error()))
+
+	voteProposal(t, gsc, validatorAddr, gitHubCommit, []byte("addr"), "yes")
+	require.Equal(t, vmcommon.Ok, voteOnBehalfOf(gsc, delegateAddr, validatorAddr, gitHubCommit, "no"))
+
+	generalProposal := getGeneralProposalFromStorage(gsc, gitHubCommit)
+	require.Equal(t, int32(1), generalProposal.Yes)
+	require.Equal(t, int32(2), generalProposal.No)
+}
+
+func TestGovernanceContract_RevokeVotePowerShouldWork(t *testing.T) {
+	t.Parallel()
+
+	validatorAddr := []byte("valid")
+	delegateAddr := []byte("deleg")
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	gsc, eei, blockChainHook := createGovernanceWithStakedValidator(t, validatorAddr, 3)
+	openProposalForVoting(t, gsc, blockChainHook, gitHubCommit)
+
+	require.Equal(t, vmcommon.Ok, delegateVotePower(gsc, validatorAddr, delegateAddr, 2))
+
+	callInput := createVMInput(big.NewInt(0), "revokeVotePower", validatorAddr, []byte("addr"))
+	callInput.Arguments = [][]byte{delegateAddr, []byte("1")}
+	require.Equal(t, vmcommon.Ok, gsc.Execute(callInput))
+
+	validatorData, _ := gsc.getOrCreateValidatorData(validatorAddr, 3)
+	require.Equal(t, int32(2), votePowerOf(validatorData, validatorAddr))
+	require.Equal(t, int32(1), votePowerOf(validatorData, delegateAddr))
+
+	callInput.Arguments = [][]byte{delegateAddr}
+	require.Equal(t, vmcommon.Ok, gsc.Execute(callInput))
+	require.Equal(t, 0, len(eei.GetStorage(append([]byte(validatorPrefix), validatorAddr...))))
+
+	retCode := voteOnBehalfOf(gsc, delegateAddr, validatorAddr, gitHubCommit, "yes")
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.Equal(t, "address has 0 voting power", eei.returnMessage)
+
+	retCode = gsc.Execute(callInput)
+	require.Equal(t, vmcommon.UserError, retCode)
+}
+
+func TestGovernanceContract_ProposalCreatedBeforeVoteDelegationShouldKeepVotesPerVoter(t *testing.T) {
+	t.Parallel()
+
+	validatorAddr := []byte("valid")
+	delegateAddr := []byte("deleg")
+	gitHubCommit := []byte("0123456789012345678901234567890123456789")
+	gsc, eei, blockChainHook := createGovernanceWithStakedValidator(t, validatorAddr, 3)
+	gsc.voteDelegationEnableEpoch = 5
+	gsc.EpochConfirmed(0)
+	openProposalForVoting(t, gsc, blockChainHook, gitHubCommit)
+
+	retCode := delegateVotePower(gsc, validatorAddr, delegateAddr, 1)
+	require.Equal(t, vmcommon.UserError, retCode)
+	require.Equal(t, "vote power delegation is not enabled", eei.returnMessage)
+
+	voteProposal(t, gsc, validatorAddr, gitHubCommit, []byte("addr"), "yes")
+	gsc.EpochConfirmed(5)
+	voteProposal(t, gsc, validatorAddr, gitHubCommit, []byte("addr"), "no")
+
+	voteData := &VoteData{}
+	_ = json.Unmarshal(eei.GetStorage(append(gitHubCommit, validatorAddr...)), voteData)
+	require.Equal(t, int32(3), voteData.NumVotes)
+	require.Equal(t, "no", voteData.VoteValue)
+	generalProposal := getGeneralProposalFromStorage(gsc, gitHubCommit)
+	require.Equal(t, int32(0), generalProposal.Yes)
+	require.Equal(t, int32(3), generalProposal.No)
+
+	secondGitHubCommit := []byte("1123456789012345678901234567890123456789")
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 1
+	}
+	openProposal(t, gsc, "proposal", []byte("owner"), []byte("addr"), secondGitHubCommit, 10, 100)
+	blockChainHook.CurrentNonceCalled = func() uint64 {
+		return 11
+	}
+	require.Equal(t, vmcommon.Ok, delegateVotePower(gsc, validatorAddr, delegateAddr, 1))
+	voteProposal(t, gsc, validatorAddr, secondGitHubCommit, []byte("addr"), "yes")
+
+	validatorVoteData := &ValidatorVoteData{}
+	_ = json.Unmarshal(eei.GetStorage(append(secondGitHubCommit, validatorAddr...)), validatorVoteData)
+	require.Equal(t, int32(2), validatorVoteData.UsedVotes)
+	require.Equal(t, 1, len(validatorVoteData.Votes))
+	require.Equal(t, validatorAddr, validatorVoteData.Votes[0].Voter)
+}
+
+func TestGovernanceContract_ComputeEndResultsVetoShouldNotPass(t *testing.T) {
+	t.Parallel()
+
+	gsc, _, _ := createGovernanceWithStakedValidator(t, []byte("valid"), 1)
+	initGovernanceSc(t, gsc, []byte("owner"), []byte("addr"))
+
+	generalProposal := &GeneralProposal{Yes: 5, Veto: 3, Voted: true}
+	err := gsc.computeEndResults(generalProposal)
+	require.Nil(t, err)
+	require.False(t, generalProposal.Voted)
+
+	generalProposal = &GeneralProposal{Yes: 2, No: 1}
+	err = gsc.computeEndResults(generalProposal)
+	require.Nil(t, err)
+	require.True(t, generalProposal.Voted)
+}
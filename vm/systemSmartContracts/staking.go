@@ -25,6 +25,11 @@ const ownerKey = "owner"
 const nodesConfigKey = "nodesConfig"
 const waitingListHeadKey = "waitingList"
 const waitingElementPrefix = "w_"
+const pendingKeyChangesKey = "pendingKeyChanges"
+const replacedKeyChangesKey = "replacedKeyChanges"
+
+// SlashedEvidencePrefix is the storage prefix under which the staking SC marks the processed slashing evidence
+const SlashedEvidencePrefix = "slashedEvidence"
 
 type stakingSC struct {
 	eei                      vm.SystemEI
@@ -40,8 +45,10 @@ type stakingSC struct {
 	maxNumNodes              uint64
 	marshalizer              marshal.Marshalizer
 	enableStakingEpoch       uint32
+	enableKeyRotationEpoch   uint32
 	stakeValue               *big.Int
 	flagStake                atomic.Flag
+	flagKeyRotation          atomic.Flag
 }
 
 // ArgsNewStakingSmartContract holds the arguments needed to create a StakingSmartContract
@@ -106,6 +113,7 @@ func NewStakingSmartContract(
 		marshalizer:              args.Marshalizer,
 		endOfEpochAccessAddr:     args.EndOfEpochAccessAddr,
 		enableStakingEpoch:       args.StakingSCConfig.StakeEnableEpoch,
+		enableKeyRotationEpoch:   args.StakingSCConfig.ValidatorKeyRotationEnableEpoch,
 	}
 
 	conversionOk := true
@@ -150,6 +158,10 @@ func (r *stakingSC) Execute(args *vmcommon.ContractCallInput) vmcommon.ReturnCod
 		return r.changeRewardAddress(args)
 	case "changeValidatorKeys":
 		return r.changeValidatorKey(args)
+	case "finalizeValidatorKeyChanges":
+		return r.finalizeValidatorKeyChanges(args)
+	case "cleanReplacedValidatorKeys":
+		return r.cleanReplacedValidatorKeys(args)
 	case "switchJailedWithWaiting":
 		return r.switchJailedWithWaiting(args)
 	case "getQueueIndex":
@@ -240,6 +252,10 @@ func (r *stakingSC) canUnBond() bool {
 }
 
 func (r *stakingSC) changeValidatorKey(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !r.flagKeyRotation.IsSet() {
+		r.eei.AddReturnMessage(vm.ChangeValidatorKeysNotEnabled)
+		return vmcommon.UserError
+	}
 	if !bytes.Equal(args.CallerAddr, r.stakeAccessAddr) {
 		r.eei.AddReturnMessage("changeValidatorKey function not allowed to be called by address " + string(args.CallerAddr))
 		return vmcommon.UserError
@@ -251,7 +267,7 @@ func (r *stakingSC) changeValidatorKey(args *vmcommon.ContractCallInput) vmcommo
 
 	oldKey := args.Arguments[0]
 	newKey := args.Arguments[1]
-	if len(oldKey) != len(newKey) {
+	if len(oldKey) != len(newKey) || bytes.Equal(oldKey, newKey) {
 		r.eei.AddReturnMessage("invalid bls key")
 		return vmcommon.UserError
 	}
@@ -262,20 +278,190 @@ func (r *stakingSC) changeValidatorKey(args *vmcommon.ContractCallInput) vmcommo
 		return vmcommon.UserError
 	}
 	if len(stakedData.RewardAddress) == 0 {
-		// if not registered this is not an error
+		r.eei.AddReturnMessage("cannot change bls key as it is not registered")
+		return vmcommon.UserError
+	}
+	if stakedData.Waiting {
+		r.eei.AddReturnMessage("cannot change bls key of a node which is in the waiting list")
+		return vmcommon.UserError
+	}
+	if len(r.eei.GetStorage(newKey)) > 0 {
+		r.eei.AddReturnMessage("new bls key is already registered")
+		return vmcommon.UserError
+	}
+
+	pendingChanges, err := r.getKeyChanges(pendingKeyChangesKey)
+	if err != nil {
+		r.eei.AddReturnMessage("cannot get pending key changes: error " + err.Error())
+		return vmcommon.UserError
+	}
+	for _, change := range pendingChanges.Changes {
+		isKeyInvolved := bytes.Equal(change.OldKey, oldKey) || bytes.Equal(change.NewKey, oldKey) ||
+			bytes.Equal(change.OldKey, newKey) || bytes.Equal(change.NewKey, newKey)
+		if isKeyInvolved {
+			r.eei.AddReturnMessage("bls key already has a pending change")
+			return vmcommon.UserError
+		}
+	}
+
+	// the staking data is moved at the end of the epoch, so the peer accounts and the nodes coordinator
+	// can swap the keys at the same time
+	pendingChanges.Changes = append(pendingChanges.Changes, &ValidatorKeyChange{OldKey: oldKey, NewKey: newKey})
+	err = r.saveKeyChanges(pendingKeyChangesKey, pendingChanges)
+	if err != nil {
+		r.eei.AddReturnMessage("cannot save pending key changes: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+func (r *stakingSC) finalizeValidatorKeyChanges(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !r.flagKeyRotation.IsSet() {
+		r.eei.AddReturnMessage(vm.ChangeValidatorKeysNotEnabled)
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, r.endOfEpochAccessAddr) {
+		r.eei.AddReturnMessage("finalizeValidatorKeyChanges function not allowed to be called by address " + string(args.CallerAddr))
+		return vmcommon.UserError
+	}
+
+	pendingChanges, err := r.getKeyChanges(pendingKeyChangesKey)
+	if err != nil {
+		r.eei.AddReturnMessage("cannot get pending key changes: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if len(pendingChanges.Changes) == 0 {
 		return vmcommon.Ok
 	}
 
-	r.eei.SetStorage(oldKey, nil)
-	err = r.saveStakingData(newKey, stakedData)
+	replacedChanges := &PendingValidatorKeyChanges{
+		Changes: make([]*ValidatorKeyChange, 0, len(pendingChanges.Changes)),
+	}
+	for _, change := range pendingChanges.Changes {
+		err = r.finalizeKeyChangeOnAuctionSC(args.RecipientAddr, change)
+		if err != nil {
+			r.eei.AddReturnMessage("cannot finalize key change on auction: error " + err.Error())
+			return vmcommon.UserError
+		}
+
+		stakedData, errGet := r.getOrCreateRegisteredData(change.OldKey)
+		if errGet != nil {
+			r.eei.AddReturnMessage("cannot get or create registered data: error " + errGet.Error())
+			return vmcommon.UserError
+		}
+		if len(stakedData.RewardAddress) == 0 {
+			continue
+		}
+
+		r.eei.SetStorage(change.OldKey, nil)
+		err = r.saveStakingData(change.NewKey, stakedData)
+		if err != nil {
+			r.eei.AddReturnMessage("cannot save staking data: error " + err.Error())
+			return vmcommon.UserError
+		}
+
+		replacedChanges.Changes = append(replacedChanges.Changes, change)
+		r.eei.Finish(change.OldKey)
+		r.eei.Finish(change.NewKey)
+	}
+
+	r.eei.SetStorage([]byte(pendingKeyChangesKey), nil)
+	err = r.saveKeyChanges(replacedKeyChangesKey, replacedChanges)
 	if err != nil {
-		r.eei.AddReturnMessage("cannot save staking data: error " + err.Error())
+		r.eei.AddReturnMessage("cannot save replaced key changes: error " + err.Error())
+		return vmcommon.UserError
+	}
+
+	return vmcommon.Ok
+}
+
+// the auction SC keeps the old key in the owner's registration data until the staking data is moved
+func (r *stakingSC) finalizeKeyChangeOnAuctionSC(stakingSCAddress []byte, change *ValidatorKeyChange) error {
+	txData := "finalizeValidatorKeyChange@" + hex.EncodeToString(change.OldKey) + "@" + hex.EncodeToString(change.NewKey)
+	vmOutput, err := r.eei.ExecuteOnDestContext(r.stakeAccessAddr, stakingSCAddress, big.NewInt(0), []byte(txData))
+	if err != nil {
+		return err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return vm.ErrOnExecutionAtAuctionSC
+	}
+
+	return nil
+}
+
+// the old keys are kept one more epoch in the protocol, as the blocks from the epoch in which the keys were
+// changed are still signed by them
+func (r *stakingSC) cleanReplacedValidatorKeys(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+	if !r.flagKeyRotation.IsSet() {
+		r.eei.AddReturnMessage(vm.ChangeValidatorKeysNotEnabled)
+		return vmcommon.UserError
+	}
+	if !bytes.Equal(args.CallerAddr, r.endOfEpochAccessAddr) {
+		r.eei.AddReturnMessage("cleanReplacedValidatorKeys function not allowed to be called by address " + string(args.CallerAddr))
 		return vmcommon.UserError
 	}
 
+	replacedChanges, err := r.getKeyChanges(replacedKeyChangesKey)
+	if err != nil {
+		r.eei.AddReturnMessage("cannot get replaced key changes: error " + err.Error())
+		return vmcommon.UserError
+	}
+	if len(replacedChanges.Changes) == 0 {
+		return vmcommon.Ok
+	}
+
+	for _, change := range replacedChanges.Changes {
+		r.eei.Finish(change.OldKey)
+	}
+	r.eei.SetStorage([]byte(replacedKeyChangesKey), nil)
+
 	return vmcommon.Ok
 }
 
+func (r *stakingSC) isPendingNewKey(blsKey []byte) (bool, error) {
+	pendingChanges, err := r.getKeyChanges(pendingKeyChangesKey)
+	if err != nil {
+		return false, err
+	}
+
+	for _, change := range pendingChanges.Changes {
+		if bytes.Equal(change.NewKey, blsKey) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (r *stakingSC) getKeyChanges(storageKey string) (*PendingValidatorKeyChanges, error) {
+	keyChanges := &PendingValidatorKeyChanges{
+		Changes: make([]*ValidatorKeyChange, 0),
+	}
+
+	data := r.eei.GetStorage([]byte(storageKey))
+	if len(data) == 0 {
+		return keyChanges, nil
+	}
+
+	err := r.marshalizer.Unmarshal(keyChanges, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyChanges, nil
+}
+
+func (r *stakingSC) saveKeyChanges(storageKey string, keyChanges *PendingValidatorKeyChanges) error {
+	data, err := r.marshalizer.Marshal(keyChanges)
+	if err != nil {
+		return err
+	}
+
+	r.eei.SetStorage([]byte(storageKey), data)
+	return nil
+}
+
 func (r *stakingSC) changeRewardAddress(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	if !bytes.Equal(args.CallerAddr, r.stakeAccessAddr) {
 		r.eei.AddReturnMessage("stake function not allowed to be called by address " + string(args.CallerAddr))
@@ -563,6 +749,17 @@ func (r *stakingSC) stake(args *vmcommon.ContractCallInput, onlyRegister bool) v
 		r.eei.AddReturnMessage("cannot stake node which is jailed or with bad rating")
 		return vmcommon.UserError
 	}
+	if r.flagKeyRotation.IsSet() {
+		isPendingNewKey, errPending := r.isPendingNewKey(args.Arguments[0])
+		if errPending != nil {
+			r.eei.AddReturnMessage("cannot get pending key changes: error " + errPending.Error())
+			return vmcommon.UserError
+		}
+		if isPendingNewKey {
+			r.eei.AddReturnMessage("cannot stake bls key which is pending as a new validator key")
+			return vmcommon.UserError
+		}
+	}
 
 	registrationData.RewardAddress = args.Arguments[1]
 	registrationData.StakeValue.Set(r.stakeValue)
@@ -784,17 +981,29 @@ func (r *stakingSC) unBond(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 
 func (r *stakingSC) slash(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
 	ownerAddress := r.eei.GetStorage([]byte(ownerKey))
-	if !bytes.Equal(ownerAddress, args.CallerAddr) {
+	isCalledByOwner := bytes.Equal(ownerAddress, args.CallerAddr)
+	isCalledAtEndOfEpoch := bytes.Equal(r.endOfEpochAccessAddr, args.CallerAddr)
+	if !isCalledByOwner && !isCalledAtEndOfEpoch {
 		r.eei.AddReturnMessage("slash function called by not the owners address")
 		return vmcommon.UserError
 	}
 
-	if len(args.Arguments) != 2 {
-		retMessage := fmt.Sprintf("slash function called with wrong number of arguments: expected %d, got %d", 2, len(args.Arguments))
+	if len(args.Arguments) != 2 && len(args.Arguments) != 3 {
+		retMessage := fmt.Sprintf("slash function called with wrong number of arguments: expected %d or %d, got %d", 2, 3, len(args.Arguments))
 		r.eei.AddReturnMessage(retMessage)
 		return vmcommon.UserError
 	}
 
+	// the optional evidence identifier makes sure the same misbehaviour is not slashed twice
+	var evidenceKey []byte
+	if len(args.Arguments) == 3 {
+		evidenceKey = append([]byte(SlashedEvidencePrefix), args.Arguments[2]...)
+		if len(r.eei.GetStorage(evidenceKey)) > 0 {
+			r.eei.AddReturnMessage("evidence already processed")
+			return vmcommon.UserError
+		}
+	}
+
 	registrationData, err := r.getOrCreateRegisteredData(args.Arguments[0])
 	if err != nil {
 		r.eei.AddReturnMessage("cannot get ore create registered data: error " + err.Error())
@@ -822,6 +1031,10 @@ func (r *stakingSC) slash(args *vmcommon.ContractCallInput) vmcommon.ReturnCode
 		return vmcommon.UserError
 	}
 
+	if len(evidenceKey) > 0 {
+		r.eei.SetStorage(evidenceKey, []byte(fmt.Sprintf("%d", registrationData.JailedNonce)))
+	}
+
 	return vmcommon.Ok
 }
 
@@ -1425,6 +1638,9 @@ func (r *stakingSC) getWaitingListRegisterNonceAndRewardAddress(args *vmcommon.C
 func (r *stakingSC) EpochConfirmed(epoch uint32) {
 	r.flagStake.Toggle(epoch >= r.enableStakingEpoch)
 	log.Debug("stakingSC: stake/unstake/unbond", "enabled", r.flagStake.IsSet())
+
+	r.flagKeyRotation.Toggle(epoch >= r.enableKeyRotationEpoch)
+	log.Debug("stakingSC: validator key rotation", "enabled", r.flagKeyRotation.IsSet())
 }
 
 // IsInterfaceNil verifies if the underlying object is nil or not
@@ -479,64 +479,156 @@ func (m *WaitingList) GetLastJailedKey() []byte {
 	return nil
 }
 
+type ValidatorKeyChange struct {
+	OldKey []byte `protobuf:"bytes,1,opt,name=OldKey,proto3" json:"OldKey"`
+	NewKey []byte `protobuf:"bytes,2,opt,name=NewKey,proto3" json:"NewKey"`
+}
+
+func (m *ValidatorKeyChange) Reset()      { *m = ValidatorKeyChange{} }
+func (*ValidatorKeyChange) ProtoMessage() {}
+func (*ValidatorKeyChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{5}
+}
+func (m *ValidatorKeyChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorKeyChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ValidatorKeyChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorKeyChange.Merge(m, src)
+}
+func (m *ValidatorKeyChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorKeyChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorKeyChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorKeyChange proto.InternalMessageInfo
+
+func (m *ValidatorKeyChange) GetOldKey() []byte {
+	if m != nil {
+		return m.OldKey
+	}
+	return nil
+}
+
+func (m *ValidatorKeyChange) GetNewKey() []byte {
+	if m != nil {
+		return m.NewKey
+	}
+	return nil
+}
+
+type PendingValidatorKeyChanges struct {
+	Changes []*ValidatorKeyChange `protobuf:"bytes,1,rep,name=Changes,proto3" json:"Changes"`
+}
+
+func (m *PendingValidatorKeyChanges) Reset()      { *m = PendingValidatorKeyChanges{} }
+func (*PendingValidatorKeyChanges) ProtoMessage() {}
+func (*PendingValidatorKeyChanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_289e7c8aea278311, []int{6}
+}
+func (m *PendingValidatorKeyChanges) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingValidatorKeyChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PendingValidatorKeyChanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingValidatorKeyChanges.Merge(m, src)
+}
+func (m *PendingValidatorKeyChanges) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingValidatorKeyChanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingValidatorKeyChanges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingValidatorKeyChanges proto.InternalMessageInfo
+
+func (m *PendingValidatorKeyChanges) GetChanges() []*ValidatorKeyChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StakedDataV1)(nil), "proto.StakedDataV1")
 	proto.RegisterType((*StakedDataV2)(nil), "proto.StakedDataV2")
 	proto.RegisterType((*StakingNodesConfig)(nil), "proto.StakingNodesConfig")
 	proto.RegisterType((*ElementInList)(nil), "proto.ElementInList")
 	proto.RegisterType((*WaitingList)(nil), "proto.WaitingList")
+	proto.RegisterType((*ValidatorKeyChange)(nil), "proto.ValidatorKeyChange")
+	proto.RegisterType((*PendingValidatorKeyChanges)(nil), "proto.PendingValidatorKeyChanges")
 }
 
 func init() { proto.RegisterFile("staking.proto", fileDescriptor_289e7c8aea278311) }
 
 var fileDescriptor_289e7c8aea278311 = []byte{
-	// 722 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xbd, 0x6e, 0xdb, 0x3c,
-	0x14, 0x35, 0x6d, 0xc7, 0x49, 0x68, 0xfb, 0xfb, 0x11, 0xbe, 0x41, 0xf8, 0x06, 0xc9, 0x30, 0x50,
-	0xc0, 0x40, 0x11, 0x1b, 0x69, 0x0b, 0x74, 0xe8, 0x14, 0xa7, 0x29, 0x90, 0xd6, 0x35, 0x02, 0x1a,
-	0x4d, 0x81, 0x6e, 0xb4, 0xc5, 0xc8, 0x44, 0x2c, 0x32, 0x90, 0xa8, 0x26, 0xd9, 0xfa, 0x08, 0x7d,
-	0x83, 0xae, 0x41, 0x5f, 0xa1, 0x2f, 0x50, 0x74, 0xca, 0x98, 0x49, 0x4d, 0x94, 0xa5, 0xd0, 0x94,
-	0x47, 0x28, 0x48, 0xca, 0x16, 0xd5, 0xb9, 0x43, 0x87, 0x4c, 0xbc, 0xe7, 0x90, 0xe7, 0xf2, 0xfa,
-	0xde, 0x43, 0xdb, 0xb0, 0x1d, 0x09, 0x7c, 0x4c, 0x99, 0xdf, 0x3f, 0x09, 0xb9, 0xe0, 0xd6, 0x9a,
-	0x5a, 0xfe, 0xdf, 0xf2, 0xa9, 0x98, 0xc7, 0xd3, 0xfe, 0x8c, 0x07, 0x03, 0x9f, 0xfb, 0x7c, 0xa0,
-	0xe8, 0x69, 0x7c, 0xa4, 0x90, 0x02, 0x2a, 0xd2, 0xaa, 0xee, 0xc5, 0x1a, 0x6c, 0x4d, 0x04, 0x3e,
-	0x26, 0xde, 0x73, 0x2c, 0xf0, 0xe1, 0xb6, 0xf5, 0x14, 0xb6, 0x11, 0xf1, 0x69, 0x24, 0x48, 0x38,
-	0xe6, 0x6c, 0x46, 0x6c, 0xd0, 0x01, 0xbd, 0xfa, 0xf0, 0xdf, 0x2c, 0x71, 0xcb, 0x1b, 0xa8, 0x0c,
-	0xad, 0x6d, 0xd8, 0xd4, 0x89, 0xb4, 0xac, 0xaa, 0x64, 0x7f, 0x67, 0x89, 0x6b, 0xd2, 0xc8, 0x04,
-	0x56, 0x17, 0x36, 0x34, 0xb4, 0x6b, 0x1d, 0xd0, 0xdb, 0x18, 0xc2, 0x2c, 0x71, 0x73, 0x06, 0xe5,
-	0xab, 0xac, 0xe7, 0x0d, 0x33, 0x13, 0xd7, 0x8b, 0x7a, 0x4a, 0x1b, 0xa8, 0x0c, 0x4d, 0xe1, 0xde,
-	0x09, 0x9f, 0xcd, 0xed, 0xb5, 0x0e, 0xe8, 0xb5, 0xcb, 0x42, 0xb5, 0x81, 0xca, 0x50, 0x77, 0xe0,
-	0x14, 0x87, 0xde, 0x8e, 0xe7, 0x85, 0x24, 0x8a, 0xec, 0x46, 0x07, 0xf4, 0x5a, 0xcb, 0x0e, 0x18,
-	0x1b, 0xa8, 0x0c, 0xad, 0x08, 0x42, 0x95, 0xe7, 0x10, 0x2f, 0x62, 0x62, 0xaf, 0x2b, 0xd5, 0x24,
-	0x4b, 0x5c, 0x83, 0xfd, 0xfc, 0xdd, 0xdd, 0x09, 0xb0, 0x98, 0x0f, 0xa6, 0xd4, 0xef, 0xef, 0x33,
-	0xf1, 0xcc, 0x98, 0xd6, 0xde, 0x22, 0xe4, 0xcc, 0x1b, 0x13, 0x71, 0xca, 0xc3, 0xe3, 0x01, 0x51,
-	0x68, 0xcb, 0xe7, 0x03, 0x0f, 0x0b, 0xdc, 0x1f, 0x52, 0x7f, 0x9f, 0x89, 0x5d, 0x2c, 0xfb, 0x8d,
-	0x8c, 0x84, 0xb2, 0xed, 0x2f, 0x31, 0x5d, 0x10, 0x0f, 0xf1, 0x98, 0x79, 0xf6, 0x46, 0xd1, 0x76,
-	0x83, 0x46, 0x26, 0x28, 0x24, 0xba, 0xa1, 0x9b, 0xbf, 0x4a, 0xf2, 0x49, 0x19, 0x40, 0x37, 0xd3,
-	0x14, 0x41, 0x73, 0x0a, 0xa6, 0xac, 0x0c, 0xe5, 0x88, 0x35, 0xb4, 0x9b, 0xc5, 0x88, 0xf3, 0x62,
-	0xf2, 0xd5, 0x7a, 0x00, 0xd7, 0xdf, 0x62, 0x2a, 0x28, 0xf3, 0xed, 0x96, 0x3a, 0xd4, 0xcc, 0x12,
-	0x77, 0x49, 0xa1, 0x65, 0xd0, 0xfd, 0xd6, 0x28, 0x59, 0xf5, 0xd1, 0xbd, 0x55, 0xef, 0xad, 0xfa,
-	0x67, 0x5a, 0xd5, 0x7a, 0x08, 0x37, 0xc7, 0x71, 0x90, 0x67, 0x6b, 0xab, 0x61, 0xb6, 0xb3, 0xc4,
-	0x2d, 0x48, 0x54, 0x84, 0x6a, 0x16, 0x0b, 0x1c, 0xcd, 0xf5, 0x2c, 0xfe, 0x32, 0x66, 0xb1, 0x62,
-	0x7f, 0xd7, 0x2c, 0x56, 0x09, 0xbb, 0xd7, 0x00, 0x5a, 0x13, 0xfd, 0xfb, 0x31, 0xe6, 0x1e, 0x89,
-	0x76, 0x39, 0x3b, 0xa2, 0xbe, 0xec, 0xf7, 0x6b, 0xca, 0xc6, 0x71, 0xa0, 0x48, 0xf5, 0xa0, 0x6a,
-	0xba, 0xdf, 0x06, 0x8d, 0x4c, 0xa0, 0x24, 0xf8, 0x6c, 0x25, 0xa9, 0x1a, 0x92, 0x82, 0x46, 0x26,
-	0x30, 0xdf, 0x9f, 0x94, 0xd4, 0x0a, 0x89, 0x41, 0x23, 0x13, 0x98, 0x46, 0x90, 0x92, 0x7a, 0x21,
-	0x31, 0x68, 0x64, 0x82, 0xee, 0x27, 0x00, 0xdb, 0x7b, 0x0b, 0x12, 0x10, 0x26, 0xf6, 0xd9, 0x88,
-	0x46, 0xc2, 0x7a, 0x02, 0x5b, 0xc3, 0xd1, 0xe4, 0x20, 0x9e, 0x2e, 0xe8, 0xec, 0x15, 0x39, 0x57,
-	0x1f, 0xaf, 0x35, 0xfc, 0x27, 0x4b, 0xdc, 0x12, 0x8f, 0x4a, 0x48, 0x5e, 0x7d, 0x10, 0x92, 0xf7,
-	0x94, 0xc7, 0x91, 0x14, 0x55, 0x95, 0x48, 0x5d, 0x6d, 0xd0, 0xc8, 0x04, 0xd2, 0x26, 0x63, 0x72,
-	0x26, 0xe4, 0xf1, 0x9a, 0x3a, 0xae, 0x6c, 0x92, 0x53, 0x68, 0x19, 0x74, 0xbf, 0x00, 0xd8, 0xcc,
-	0x2d, 0xa3, 0xea, 0xeb, 0xc1, 0x8d, 0x17, 0x34, 0x8c, 0x44, 0x51, 0x5b, 0x2b, 0x4b, 0xdc, 0x15,
-	0x87, 0x56, 0x91, 0xbc, 0x60, 0x84, 0xf5, 0xc1, 0x6a, 0x71, 0x41, 0x4e, 0xa1, 0x65, 0x20, 0x2d,
-	0x3d, 0x22, 0xcc, 0x17, 0x73, 0x55, 0x46, 0x5b, 0x5b, 0x5a, 0x33, 0x28, 0x5f, 0xe5, 0x7b, 0x91,
-	0xc7, 0x75, 0xe7, 0x64, 0xc2, 0x7a, 0xf1, 0x1d, 0x52, 0xda, 0x40, 0x65, 0x38, 0x1c, 0x5f, 0xde,
-	0x38, 0x95, 0xab, 0x1b, 0xa7, 0x72, 0x77, 0xe3, 0x80, 0x0f, 0xa9, 0x03, 0x2e, 0x52, 0x07, 0x7c,
-	0x4d, 0x1d, 0x70, 0x99, 0x3a, 0xe0, 0x2a, 0x75, 0xc0, 0x75, 0xea, 0x80, 0x1f, 0xa9, 0x53, 0xb9,
-	0x4b, 0x1d, 0xf0, 0xf1, 0xd6, 0xa9, 0x5c, 0xde, 0x3a, 0x95, 0xab, 0x5b, 0xa7, 0xf2, 0xee, 0xbf,
-	0xe8, 0x3c, 0x12, 0x24, 0x98, 0x04, 0x38, 0x14, 0xbb, 0x9c, 0x89, 0x10, 0xcf, 0x44, 0x34, 0x6d,
-	0xa8, 0x7f, 0x24, 0x8f, 0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x47, 0x66, 0x7d, 0xd8, 0x08,
-	0x00, 0x00,
+	// 799 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6e, 0xf3, 0x44,
+	0x10, 0xcf, 0x36, 0x69, 0xda, 0x6f, 0x93, 0xf0, 0xc7, 0xe2, 0x60, 0xbe, 0x83, 0x1d, 0x59, 0x42,
+	0x8a, 0x84, 0xbe, 0x44, 0x2d, 0x48, 0x1c, 0xb8, 0xd0, 0x84, 0x22, 0x95, 0x06, 0x53, 0x6d, 0x44,
+	0x91, 0x10, 0x42, 0xda, 0xc4, 0x5b, 0x67, 0x55, 0x67, 0xb7, 0xb2, 0xd7, 0xb4, 0xbd, 0xf1, 0x08,
+	0xbc, 0x01, 0xd7, 0x8a, 0x57, 0xe0, 0x05, 0x10, 0xa7, 0x1e, 0x7b, 0x32, 0xad, 0x7b, 0x41, 0x3e,
+	0xf5, 0x11, 0xd0, 0xee, 0x3a, 0xf1, 0x9a, 0x5e, 0x39, 0x7c, 0x87, 0x9e, 0x76, 0x7e, 0xbf, 0x9d,
+	0xdf, 0xcc, 0x78, 0x66, 0xb6, 0x0d, 0xec, 0x25, 0x02, 0x9f, 0x53, 0x16, 0x0e, 0x2f, 0x62, 0x2e,
+	0xb8, 0xb5, 0xad, 0x8e, 0xd7, 0x6f, 0x42, 0x2a, 0x96, 0xe9, 0x7c, 0xb8, 0xe0, 0xab, 0x51, 0xc8,
+	0x43, 0x3e, 0x52, 0xf4, 0x3c, 0x3d, 0x53, 0x48, 0x01, 0x65, 0x69, 0x95, 0x77, 0xb3, 0x0d, 0xbb,
+	0x33, 0x81, 0xcf, 0x49, 0xf0, 0x25, 0x16, 0xf8, 0x74, 0xcf, 0xfa, 0x0c, 0xf6, 0x10, 0x09, 0x69,
+	0x22, 0x48, 0xec, 0x73, 0xb6, 0x20, 0x36, 0xe8, 0x83, 0x41, 0x6b, 0xfc, 0x7e, 0x91, 0xb9, 0xf5,
+	0x0b, 0x54, 0x87, 0xd6, 0x1e, 0xec, 0xe8, 0x40, 0x5a, 0xb6, 0xa5, 0x64, 0xef, 0x16, 0x99, 0x6b,
+	0xd2, 0xc8, 0x04, 0x96, 0x07, 0xdb, 0x1a, 0xda, 0xcd, 0x3e, 0x18, 0xec, 0x8e, 0x61, 0x91, 0xb9,
+	0x25, 0x83, 0xca, 0x53, 0xd6, 0xf3, 0x1d, 0x33, 0x03, 0xb7, 0xaa, 0x7a, 0x6a, 0x17, 0xa8, 0x0e,
+	0x4d, 0xe1, 0xe1, 0x05, 0x5f, 0x2c, 0xed, 0xed, 0x3e, 0x18, 0xf4, 0xea, 0x42, 0x75, 0x81, 0xea,
+	0x50, 0x77, 0xe0, 0x12, 0xc7, 0xc1, 0x41, 0x10, 0xc4, 0x24, 0x49, 0xec, 0x76, 0x1f, 0x0c, 0xba,
+	0xeb, 0x0e, 0x18, 0x17, 0xa8, 0x0e, 0xad, 0x04, 0x42, 0x15, 0xe7, 0x14, 0x47, 0x29, 0xb1, 0x77,
+	0x94, 0x6a, 0x56, 0x64, 0xae, 0xc1, 0xfe, 0xfe, 0xb7, 0x7b, 0xb0, 0xc2, 0x62, 0x39, 0x9a, 0xd3,
+	0x70, 0x78, 0xc4, 0xc4, 0xe7, 0xc6, 0xb4, 0x0e, 0xa3, 0x98, 0xb3, 0xc0, 0x27, 0xe2, 0x92, 0xc7,
+	0xe7, 0x23, 0xa2, 0xd0, 0x9b, 0x90, 0x8f, 0x02, 0x2c, 0xf0, 0x70, 0x4c, 0xc3, 0x23, 0x26, 0x26,
+	0x58, 0xf6, 0x1b, 0x19, 0x01, 0x65, 0xdb, 0xbf, 0xc6, 0x34, 0x22, 0x01, 0xe2, 0x29, 0x0b, 0xec,
+	0xdd, 0xaa, 0xed, 0x06, 0x8d, 0x4c, 0x50, 0x49, 0x74, 0x43, 0x5f, 0xfd, 0x57, 0x52, 0x4e, 0xca,
+	0x00, 0xba, 0x99, 0xa6, 0x08, 0x9a, 0x53, 0x30, 0x65, 0x75, 0x28, 0x47, 0xac, 0xa1, 0xdd, 0xa9,
+	0x46, 0x5c, 0x16, 0x53, 0x9e, 0xd6, 0x47, 0x70, 0xe7, 0x7b, 0x4c, 0x05, 0x65, 0xa1, 0xdd, 0x55,
+	0x4e, 0x9d, 0x22, 0x73, 0xd7, 0x14, 0x5a, 0x1b, 0xde, 0x5f, 0xed, 0xda, 0xaa, 0xee, 0xbf, 0xac,
+	0xea, 0xcb, 0xaa, 0xbe, 0x9d, 0xab, 0x6a, 0x7d, 0x0c, 0x5f, 0xf9, 0xe9, 0xaa, 0x8c, 0xd6, 0x53,
+	0xc3, 0xec, 0x15, 0x99, 0x5b, 0x91, 0xa8, 0x32, 0xd5, 0x2c, 0x22, 0x9c, 0x2c, 0xf5, 0x2c, 0xde,
+	0x31, 0x66, 0xb1, 0x61, 0xff, 0xaf, 0x59, 0x6c, 0x02, 0x7a, 0xf7, 0x00, 0x5a, 0x33, 0xfd, 0xff,
+	0xc3, 0xe7, 0x01, 0x49, 0x26, 0x9c, 0x9d, 0xd1, 0x50, 0xf6, 0xfb, 0x1b, 0xca, 0xfc, 0x74, 0xa5,
+	0x48, 0xf5, 0xa0, 0x9a, 0xba, 0xdf, 0x06, 0x8d, 0x4c, 0xa0, 0x24, 0xf8, 0x6a, 0x23, 0xd9, 0x32,
+	0x24, 0x15, 0x8d, 0x4c, 0x60, 0xbe, 0x3f, 0x29, 0x69, 0x56, 0x12, 0x83, 0x46, 0x26, 0x30, 0x17,
+	0x41, 0x4a, 0x5a, 0x95, 0xc4, 0xa0, 0x91, 0x09, 0xbc, 0xdf, 0x00, 0xec, 0x1d, 0x46, 0x64, 0x45,
+	0x98, 0x38, 0x62, 0x53, 0x9a, 0x08, 0xeb, 0x53, 0xd8, 0x1d, 0x4f, 0x67, 0x27, 0xe9, 0x3c, 0xa2,
+	0x8b, 0x63, 0x72, 0xad, 0x3e, 0xaf, 0x3b, 0x7e, 0xaf, 0xc8, 0xdc, 0x1a, 0x8f, 0x6a, 0x48, 0xa6,
+	0x3e, 0x89, 0xc9, 0xcf, 0x94, 0xa7, 0x89, 0x14, 0x6d, 0x29, 0x91, 0x4a, 0x6d, 0xd0, 0xc8, 0x04,
+	0x72, 0x4d, 0x7c, 0x72, 0x25, 0xa4, 0x7b, 0x53, 0xb9, 0xab, 0x35, 0x29, 0x29, 0xb4, 0x36, 0xbc,
+	0x3f, 0x00, 0xec, 0x94, 0x2b, 0xa3, 0xea, 0x1b, 0xc0, 0xdd, 0xaf, 0x68, 0x9c, 0x88, 0xaa, 0xb6,
+	0x6e, 0x91, 0xb9, 0x1b, 0x0e, 0x6d, 0x2c, 0x99, 0x60, 0x8a, 0x13, 0x51, 0xd5, 0xa3, 0x12, 0x94,
+	0x14, 0x5a, 0x1b, 0x72, 0xa5, 0xa7, 0x84, 0x85, 0x62, 0xa9, 0xca, 0xe8, 0xe9, 0x95, 0xd6, 0x0c,
+	0x2a, 0x4f, 0xf9, 0x5e, 0xa4, 0xbb, 0xee, 0x9c, 0x0c, 0xd8, 0xaa, 0xfe, 0x86, 0xd4, 0x2e, 0x50,
+	0x1d, 0x7a, 0x3f, 0x42, 0xeb, 0x14, 0x47, 0x34, 0xc0, 0x82, 0xc7, 0xc7, 0xe4, 0x7a, 0xb2, 0xc4,
+	0x2c, 0x54, 0xaf, 0xe8, 0xdb, 0x28, 0xa8, 0xbe, 0x40, 0xa5, 0xd4, 0x0c, 0x2a, 0x4f, 0xe9, 0xe3,
+	0x93, 0xcb, 0xaa, 0x78, 0xe5, 0xa3, 0x19, 0x54, 0x9e, 0xde, 0x4f, 0xf0, 0xf5, 0x09, 0x61, 0x01,
+	0x65, 0xe1, 0xf3, 0x24, 0x89, 0xf5, 0x05, 0xdc, 0x29, 0x4d, 0x1b, 0xf4, 0x9b, 0x83, 0xce, 0xfe,
+	0x87, 0xfa, 0xf7, 0xcc, 0xf0, 0xb9, 0xb3, 0x6e, 0x4d, 0xe9, 0x8d, 0xd6, 0xc6, 0xd8, 0xbf, 0x7d,
+	0x70, 0x1a, 0x77, 0x0f, 0x4e, 0xe3, 0xe9, 0xc1, 0x01, 0xbf, 0xe4, 0x0e, 0xb8, 0xc9, 0x1d, 0xf0,
+	0x67, 0xee, 0x80, 0xdb, 0xdc, 0x01, 0x77, 0xb9, 0x03, 0xee, 0x73, 0x07, 0xfc, 0x93, 0x3b, 0x8d,
+	0xa7, 0xdc, 0x01, 0xbf, 0x3e, 0x3a, 0x8d, 0xdb, 0x47, 0xa7, 0x71, 0xf7, 0xe8, 0x34, 0x7e, 0xf8,
+	0x20, 0xb9, 0x4e, 0x04, 0x59, 0xcd, 0x56, 0x38, 0x16, 0x13, 0xce, 0x44, 0x8c, 0x17, 0x22, 0x99,
+	0xb7, 0x55, 0xfe, 0x4f, 0xfe, 0x1d, 0x00, 0xa9, 0x76, 0x71, 0x80, 0x96, 0x09, 0x00, 0x00,
 }
 
 func (this *StakedDataV1) Equal(that interface{}) bool {
@@ -764,6 +856,62 @@ func (this *WaitingList) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ValidatorKeyChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorKeyChange)
+	if !ok {
+		that2, ok := that.(ValidatorKeyChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.OldKey, that1.OldKey) {
+		return false
+	}
+	if !bytes.Equal(this.NewKey, that1.NewKey) {
+		return false
+	}
+	return true
+}
+func (this *PendingValidatorKeyChanges) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingValidatorKeyChanges)
+	if !ok {
+		that2, ok := that.(PendingValidatorKeyChanges)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Changes) != len(that1.Changes) {
+		return false
+	}
+	for i := range this.Changes {
+		if !this.Changes[i].Equal(that1.Changes[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *StakedDataV1) GoString() string {
 	if this == nil {
 		return "nil"
@@ -846,6 +994,29 @@ func (this *WaitingList) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *ValidatorKeyChange) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&systemSmartContracts.ValidatorKeyChange{")
+	s = append(s, "OldKey: "+fmt.Sprintf("%#v", this.OldKey)+",\n")
+	s = append(s, "NewKey: "+fmt.Sprintf("%#v", this.NewKey)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PendingValidatorKeyChanges) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&systemSmartContracts.PendingValidatorKeyChanges{")
+	if this.Changes != nil {
+		s = append(s, "Changes: "+fmt.Sprintf("%#v", this.Changes)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func valueToGoStringStaking(v interface{}, typ string) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1218,6 +1389,80 @@ func (m *WaitingList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorKeyChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorKeyChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorKeyChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewKey) > 0 {
+		i -= len(m.NewKey)
+		copy(dAtA[i:], m.NewKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.NewKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldKey) > 0 {
+		i -= len(m.OldKey)
+		copy(dAtA[i:], m.OldKey)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OldKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingValidatorKeyChanges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingValidatorKeyChanges) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingValidatorKeyChanges) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -1399,6 +1644,38 @@ func (m *WaitingList) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorKeyChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.NewKey)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingValidatorKeyChanges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1487,6 +1764,32 @@ func (this *WaitingList) String() string {
 	}, "")
 	return s
 }
+func (this *ValidatorKeyChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValidatorKeyChange{`,
+		`OldKey:` + fmt.Sprintf("%v", this.OldKey) + `,`,
+		`NewKey:` + fmt.Sprintf("%v", this.NewKey) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PendingValidatorKeyChanges) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChanges := "[]*ValidatorKeyChange{"
+	for _, f := range this.Changes {
+		repeatedStringForChanges += strings.Replace(f.String(), "ValidatorKeyChange", "ValidatorKeyChange", 1) + ","
+	}
+	repeatedStringForChanges += "}"
+	s := strings.Join([]string{`&PendingValidatorKeyChanges{`,
+		`Changes:` + repeatedStringForChanges + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringStaking(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2646,6 +2949,214 @@ func (m *WaitingList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorKeyChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorKeyChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorKeyChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldKey = append(m.OldKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldKey == nil {
+				m.OldKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewKey = append(m.NewKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewKey == nil {
+				m.NewKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingValidatorKeyChanges) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingValidatorKeyChanges: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingValidatorKeyChanges: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &ValidatorKeyChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-go/vm/mock"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -959,6 +960,94 @@ func TestStakingSc_ExecuteSlashTwoTime(t *testing.T) {
 	assert.Equal(t, expectedSlashValue, registrationData.SlashValue)
 }
 
+func TestStakingSc_ExecuteSlashCalledAtEndOfEpochShouldWork(t *testing.T) {
+	t.Parallel()
+
+	stakeValue := big.NewInt(100)
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), &mock.ArgumentParserMock{}, &mock.AccountsStub{}, &mock.RaterMock{})
+
+	stakedRegistrationData := StakedDataV2{
+		RegisterNonce: 50,
+		Staked:        true,
+		RewardAddress: []byte("auction"),
+		StakeValue:    stakeValue,
+		JailedRound:   math.MaxUint64,
+		SlashValue:    big.NewInt(0),
+	}
+
+	args := createMockStakingScArguments()
+	args.StakingSCConfig.MinStakeValue = stakeValue.Text(10)
+	args.Eei = eei
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	blsKey := []byte("blsKey")
+	marshalizedStakedDataV2, _ := json.Marshal(&stakedRegistrationData)
+	stakingSmartContract.eei.SetStorage(blsKey, marshalizedStakedDataV2)
+	stakingSmartContract.eei.SetStorage([]byte(ownerKey), []byte("owner"))
+
+	slashValue := big.NewInt(70)
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "slash"
+	arguments.CallerAddr = args.EndOfEpochAccessAddr
+	arguments.Arguments = [][]byte{blsKey, slashValue.Bytes()}
+	retCode := stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	dataBytes := stakingSmartContract.eei.GetStorage(blsKey)
+	var registrationData StakedDataV2
+	err := json.Unmarshal(dataBytes, &registrationData)
+	assert.Nil(t, err)
+	assert.Equal(t, slashValue, registrationData.SlashValue)
+	assert.True(t, registrationData.Jailed)
+}
+
+func TestStakingSc_ExecuteSlashWithEvidenceShouldNotSlashTwice(t *testing.T) {
+	t.Parallel()
+
+	stakeValue := big.NewInt(100)
+	eei, _ := NewVMContext(&mock.BlockChainHookStub{}, hooks.NewVMCryptoHook(), &mock.ArgumentParserMock{}, &mock.AccountsStub{}, &mock.RaterMock{})
+
+	stakedRegistrationData := StakedDataV2{
+		RegisterNonce: 50,
+		Staked:        true,
+		RewardAddress: []byte("auction"),
+		StakeValue:    stakeValue,
+		JailedRound:   math.MaxUint64,
+		SlashValue:    big.NewInt(0),
+	}
+
+	args := createMockStakingScArguments()
+	args.StakingSCConfig.MinStakeValue = stakeValue.Text(10)
+	args.Eei = eei
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	blsKey := []byte("blsKey")
+	marshalizedStakedDataV2, _ := json.Marshal(&stakedRegistrationData)
+	stakingSmartContract.eei.SetStorage(blsKey, marshalizedStakedDataV2)
+
+	slashValue := big.NewInt(70)
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "slash"
+	arguments.CallerAddr = args.EndOfEpochAccessAddr
+	arguments.Arguments = [][]byte{blsKey, slashValue.Bytes(), []byte("evidence1")}
+	retCode := stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	retCode = stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	arguments.Arguments = [][]byte{blsKey, slashValue.Bytes(), []byte("evidence2")}
+	retCode = stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+
+	dataBytes := stakingSmartContract.eei.GetStorage(blsKey)
+	var registrationData StakedDataV2
+	err := json.Unmarshal(dataBytes, &registrationData)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(140), registrationData.SlashValue)
+	assert.Equal(t, uint32(2), registrationData.NumJailed)
+}
+
 func TestStakingSc_ExecuteNilArgs(t *testing.T) {
 	t.Parallel()
 
@@ -1118,6 +1207,111 @@ func TestStakingSc_StakeJailAndUnJail(t *testing.T) {
 	doUnJail(t, stakingSmartContract, stakingAccessAddress, stakerPubKey, vmcommon.Ok)
 }
 
+func TestStakingSc_ChangeValidatorKeyNotEnabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), &mock.ArgumentParserMock{}, &mock.AccountsStub{}, &mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+
+	stakingAccessAddress := []byte("stakingAccessAddress")
+	args := createMockStakingScArguments()
+	args.StakingAccessAddr = stakingAccessAddress
+	args.Eei = eei
+	args.StakingSCConfig.ValidatorKeyRotationEnableEpoch = 10
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	stakerPubKey := []byte("stakerPublicKey")
+	doStake(t, stakingSmartContract, stakingAccessAddress, []byte("stakerAddr"), stakerPubKey)
+	doChangeValidatorKey(t, stakingSmartContract, stakingAccessAddress, stakerPubKey, []byte("newPublicKeyNew"), vmcommon.UserError)
+	assert.Equal(t, vm.ChangeValidatorKeysNotEnabled, eei.returnMessage)
+}
+
+func TestStakingSc_ChangeValidatorKeyAndFinalize(t *testing.T) {
+	t.Parallel()
+
+	blockChainHook := &mock.BlockChainHookStub{}
+	eei, _ := NewVMContext(blockChainHook, hooks.NewVMCryptoHook(), parsers.NewCallArgsParser(), &mock.AccountsStub{}, &mock.RaterMock{})
+	eei.SetSCAddress([]byte("addr"))
+
+	stakingAccessAddress := []byte("stakingAccessAddress")
+	endOfEpochAccessAddress := []byte("endOfEpochAccess")
+	args := createMockStakingScArguments()
+	args.StakingAccessAddr = stakingAccessAddress
+	args.EndOfEpochAccessAddr = endOfEpochAccessAddress
+	args.Eei = eei
+	stakingSmartContract, _ := NewStakingSmartContract(args)
+
+	auctionCalls := make([]string, 0)
+	_ = eei.SetSystemSCContainer(&mock.SystemSCContainerStub{GetCalled: func(key []byte) (vm.SystemSmartContract, error) {
+		assert.Equal(t, stakingAccessAddress, key)
+		return &mock.SystemSCStub{ExecuteCalled: func(args *vmcommon.ContractCallInput) vmcommon.ReturnCode {
+			auctionCalls = append(auctionCalls, args.Function)
+			return vmcommon.Ok
+		}}, nil
+	}})
+
+	stakerAddress := []byte("stakerAddr")
+	oldKey := []byte("oldPublicKeyOld")
+	newKey := []byte("newPublicKeyNew")
+	otherKey := []byte("otherPublicKey1")
+
+	// change of an unregistered key should not work
+	doChangeValidatorKey(t, stakingSmartContract, stakingAccessAddress, oldKey, newKey, vmcommon.UserError)
+
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, oldKey)
+	doStake(t, stakingSmartContract, stakingAccessAddress, stakerAddress, otherKey)
+
+	// wrong access address should not work
+	doChangeValidatorKey(t, stakingSmartContract, []byte("addr"), oldKey, newKey, vmcommon.UserError)
+	// keys with different length should not work
+	doChangeValidatorKey(t, stakingSmartContract, stakingAccessAddress, oldKey, []byte("short"), vmcommon.UserError)
+	// new key already registered should not work
+	doChangeValidatorKey(t, stakingSmartContract, stakingAccessAddress, oldKey, otherKey, vmcommon.UserError)
+	// change should work
+	doChangeValidatorKey(t, stakingSmartContract, stakingAccessAddress, oldKey, newKey, vmcommon.Ok)
+	// a second change for keys with pending changes should not work
+	doChangeValidatorKey(t, stakingSmartContract, stakingAccessAddress, oldKey, []byte("newPublicKey002"), vmcommon.UserError)
+	doChangeValidatorKey(t, stakingSmartContract, stakingAccessAddress, otherKey, newKey, vmcommon.UserError)
+
+	// the new key cannot be staked while the change is pending
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "stake"
+	arguments.CallerAddr = stakingAccessAddress
+	arguments.Arguments = [][]byte{newKey, stakerAddress}
+	retCode := stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	// the staking data is moved only at the end of the epoch
+	doGetStatus(t, stakingSmartContract, eei, oldKey, "staked")
+	assert.Equal(t, 0, len(eei.GetStorage(newKey)))
+
+	arguments = CreateVmContractCallInput()
+	arguments.Function = "finalizeValidatorKeyChanges"
+	arguments.CallerAddr = stakingAccessAddress
+	retCode = stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.UserError, retCode)
+
+	arguments.CallerAddr = endOfEpochAccessAddress
+	retCode = stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+	// the auction SC replaces the key in the owner's registration data at the same time
+	assert.Equal(t, []string{"finalizeValidatorKeyChange"}, auctionCalls)
+	assert.Equal(t, [][]byte{oldKey, newKey}, eei.output[len(eei.output)-2:])
+
+	assert.Equal(t, 0, len(eei.GetStorage(oldKey)))
+	assert.Equal(t, 0, len(eei.GetStorage([]byte(pendingKeyChangesKey))))
+	doGetStatus(t, stakingSmartContract, eei, newKey, "staked")
+	doGetRewardAddress(t, stakingSmartContract, eei, newKey, string(stakerAddress))
+
+	// the replaced keys are returned once, at the next end of epoch
+	arguments.Function = "cleanReplacedValidatorKeys"
+	retCode = stakingSmartContract.Execute(arguments)
+	assert.Equal(t, vmcommon.Ok, retCode)
+	assert.Equal(t, [][]byte{oldKey}, eei.output[len(eei.output)-1:])
+	assert.Equal(t, 0, len(eei.GetStorage([]byte(replacedKeyChangesKey))))
+}
+
 func TestStakingSc_ExecuteStakeStakeJailAndSwitch(t *testing.T) {
 	t.Parallel()
 
@@ -1598,6 +1792,16 @@ func doUnJail(t *testing.T, sc *stakingSC, callerAddr, addrToUnJail []byte, expe
 	assert.Equal(t, expectedCode, retCode)
 }
 
+func doChangeValidatorKey(t *testing.T, sc *stakingSC, callerAddr, oldKey, newKey []byte, expectedCode vmcommon.ReturnCode) {
+	arguments := CreateVmContractCallInput()
+	arguments.Function = "changeValidatorKeys"
+	arguments.CallerAddr = callerAddr
+	arguments.Arguments = [][]byte{oldKey, newKey}
+
+	retCode := sc.Execute(arguments)
+	assert.Equal(t, expectedCode, retCode)
+}
+
 func doJail(t *testing.T, sc *stakingSC, callerAddr, addrToJail []byte, expectedCode vmcommon.ReturnCode) {
 	arguments := CreateVmContractCallInput()
 	arguments.Function = "jail"